@@ -6,6 +6,7 @@ import (
 	"github.com/maxwelbm/alkemy-g7.git/pkg/logger"
 
 	"github.com/maxwelbm/alkemy-g7.git/internal/handler"
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
 	"github.com/maxwelbm/alkemy-g7.git/internal/repository"
 	"github.com/maxwelbm/alkemy-g7.git/internal/service"
 )
@@ -13,17 +14,33 @@ import (
 func LoadDependencies(sqlDB *sql.DB, logInstance logger.Logger) (*handler.ProductHandler, *handler.EmployeeHandler,
 	*handler.SellersController, *handler.BuyerHandler, *handler.WarehouseHandler,
 	*handler.SectionController, *handler.PurchaseOrderHandler, *handler.InboundOrderHandler,
-	*handler.ProductRecHandler, *handler.ProductBatchesController, *handler.LocalitiesController, *handler.CarrierHandler) {
+	*handler.ProductRecHandler, *handler.ProductBatchesController, *handler.LocalitiesController, *handler.CarrierHandler,
+	*handler.ProductTypeHandler, *handler.TemperatureReadingHandler, *handler.TemperatureExcursionHandler, *handler.ShipmentHandler,
+	*handler.CountryHandler, *handler.ProvinceHandler, *service.ExpiryMonitor) {
+	unitOfWork := repository.NewUnitOfWork(sqlDB, logInstance)
+
+	countryRepo := repository.NewCountryRepository(sqlDB, logInstance)
+	countryServ := service.NewCountryService(countryRepo, logInstance)
+	countryHandler := handler.NewCountryHandler(countryServ, logInstance)
+
+	provinceRepo := repository.NewProvinceRepository(sqlDB, logInstance)
+	provinceServ := service.NewProvinceService(provinceRepo, countryRepo, logInstance)
+	provinceHandler := handler.NewProvinceHandler(provinceServ, logInstance)
+
 	localitiesRepository := repository.CreateRepositoryLocalities(sqlDB, logInstance)
-	localitiesService := service.CreateServiceLocalities(localitiesRepository, logInstance)
+	localitiesService := service.CreateServiceLocalities(localitiesRepository, provinceRepo, logInstance)
 	localitiesHandler := handler.CreateHandlerLocality(localitiesService, logInstance)
 
 	sellersRepository := repository.CreateRepositorySellers(sqlDB, logInstance)
-	sellersService := service.CreateServiceSellers(sellersRepository, localitiesService, logInstance)
+	sellersService := service.CreateServiceSellers(sellersRepository, localitiesService, unitOfWork, logInstance)
 	sellersHandler := handler.CreateHandlerSellers(sellersService, logInstance)
 
+	productTypeRepo := repository.NewProductTypeRepository(sqlDB, logInstance)
+	productTypeServ := service.NewProductTypeService(productTypeRepo, logInstance)
+	productTypeHandler := handler.NewProductTypeHandler(productTypeServ, logInstance)
+
 	productRepo := repository.NewProductRepository(sqlDB, logInstance)
-	productServ := service.NewProductService(productRepo, sellersRepository, logInstance)
+	productServ := service.NewProductService(productRepo, sellersRepository, productTypeRepo, logInstance)
 	productHandler := handler.NewProductHandler(productServ, logInstance)
 
 	productRecordRepo := repository.NewProductRecRepository(sqlDB, logInstance)
@@ -35,32 +52,46 @@ func LoadDependencies(sqlDB *sql.DB, logInstance logger.Logger) (*handler.Produc
 	buyerHandler := handler.NewBuyerHandler(buyerService, logInstance)
 
 	warehousesRepository := repository.NewWareHouseRepository(sqlDB, logInstance)
-	warehousesService := service.NewWareHouseService(warehousesRepository, logInstance)
+	warehousesService := service.NewWareHouseService(warehousesRepository, localitiesRepository, logInstance)
 	warehousesHandler := handler.NewWareHouseHandler(warehousesService, logInstance)
 
 	sectionsRep := repository.CreateRepositorySections(sqlDB, logInstance)
-	sectionsSvc := service.CreateServiceSection(sectionsRep, logInstance)
+
+	temperatureExcursionRepo := repository.NewTemperatureExcursionRepository(sqlDB, logInstance)
+	temperatureExcursionSvc := service.NewTemperatureExcursionService(temperatureExcursionRepo, sectionsRep, unitOfWork, logInstance)
+	temperatureExcursionHandler := handler.NewTemperatureExcursionHandler(temperatureExcursionSvc, logInstance)
+
+	sectionsSvc := service.CreateServiceSection(sectionsRep, productTypeRepo, warehousesRepository, temperatureExcursionSvc, logInstance)
 	sectionsHandler := handler.CreateHandlerSections(sectionsSvc, logInstance)
 
 	employeeRp := repository.CreateEmployeeRepository(sqlDB, logInstance)
 	employeeSv := service.CreateEmployeeService(employeeRp, warehousesRepository, logInstance)
 	employeeHd := handler.CreateEmployeeHandler(employeeSv, logInstance)
 
-	inboundRp := repository.NewInboundService(sqlDB, logInstance)
-	inboundSv := service.NewInboundOrderService(inboundRp, employeeSv, warehousesService, logInstance)
-	inboundHd := handler.NewInboundHandler(inboundSv, logInstance)
-
 	purchaseOrderRepository := repository.NewPurchaseOrderRepository(sqlDB, logInstance)
-	purchaseOrderService := service.NewPurchaseOrderService(purchaseOrderRepository, buyerService, productRecordServ, logInstance)
+	shipmentRepo := repository.NewShipmentRepository(sqlDB, logInstance)
+	purchaseOrderService := service.NewPurchaseOrderService(purchaseOrderRepository, shipmentRepo, sectionsRep, unitOfWork, buyerService, productRecordServ, logInstance)
 	purchaseOrderHandler := handler.NewPurchaseOrderHandler(purchaseOrderService, logInstance)
 
+	temperatureReadingRepo := repository.NewTemperatureReadingRepository(sqlDB, logInstance)
+	temperatureReadingSvc := service.NewTemperatureReadingService(temperatureReadingRepo, sectionsRep, unitOfWork, temperatureExcursionSvc, logInstance)
+	temperatureReadingHandler := handler.NewTemperatureReadingHandler(temperatureReadingSvc, logInstance)
+
 	productBatchesRep := repository.CreateProductBatchesRepository(sqlDB, logInstance)
-	productBatchesSvc := service.CreateProductBatchesService(productBatchesRep, productServ, sectionsSvc, logInstance)
+	productBatchesSvc := service.CreateProductBatchesService(productBatchesRep, sectionsRep, unitOfWork, productServ, sectionsSvc, temperatureExcursionSvc, logInstance)
 	productBatchesHandler := handler.CreateProductBatchesHandler(productBatchesSvc, logInstance)
+	expiryMonitor := service.NewExpiryMonitor(productBatchesRep, service.NewLogNotifier(logInstance), service.DefaultExpiryScanInterval, model.DefaultExpiryWindowDays, logInstance)
+
+	inboundRp := repository.NewInboundService(sqlDB, logInstance)
+	inboundSv := service.NewInboundOrderService(inboundRp, productBatchesRep, sectionsRep, unitOfWork, employeeSv, warehousesService, productBatchesSvc, temperatureExcursionSvc, logInstance)
+	inboundHd := handler.NewInboundHandler(inboundSv, logInstance)
 
 	carrierRep := repository.NewCarriersRepository(sqlDB, logInstance)
 	carrierSv := service.NewCarrierService(carrierRep, localitiesService, logInstance)
 	carrierHd := handler.NewCarrierHandler(carrierSv, logInstance)
 
-	return productHandler, employeeHd, sellersHandler, buyerHandler, warehousesHandler, sectionsHandler, purchaseOrderHandler, inboundHd, productRecordHandler, productBatchesHandler, localitiesHandler, carrierHd
+	shipmentSvc := service.NewShipmentService(shipmentRepo, purchaseOrderRepository, carrierSv, unitOfWork, logInstance)
+	shipmentHandler := handler.NewShipmentHandler(shipmentSvc, logInstance)
+
+	return productHandler, employeeHd, sellersHandler, buyerHandler, warehousesHandler, sectionsHandler, purchaseOrderHandler, inboundHd, productRecordHandler, productBatchesHandler, localitiesHandler, carrierHd, productTypeHandler, temperatureReadingHandler, temperatureExcursionHandler, shipmentHandler, countryHandler, provinceHandler, expiryMonitor
 }
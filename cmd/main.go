@@ -1,12 +1,14 @@
 package main
 
 import (
+	"context"
 	"log"
 	"net/http"
 
 	"github.com/maxwelbm/alkemy-g7.git/pkg/logger"
 
 	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
 	"github.com/maxwelbm/alkemy-g7.git/cmd/dependencies"
 	_ "github.com/maxwelbm/alkemy-g7.git/docs"
 	"github.com/maxwelbm/alkemy-g7.git/internal/handler"
@@ -38,9 +40,16 @@ func main() {
 		sellersHandler, buyerHandler,
 		warehousesHandler, sectionHandler,
 		purchaseOrderHandler, inboundHandler,
-		productRecHandler, productBatchesHandler, localitiesHandler, carrierHandler := dependencies.LoadDependencies(db.Connection, logInstance)
+		productRecHandler, productBatchesHandler, localitiesHandler, carrierHandler,
+		productTypeHandler, temperatureReadingHandler, temperatureExcursionHandler, shipmentHandler,
+		countryHandler, provinceHandler, expiryMonitor := dependencies.LoadDependencies(db.Connection, logInstance)
 
-	rt := initRoutes(productHandler, employeeHd, sellersHandler, buyerHandler, sectionHandler, warehousesHandler, purchaseOrderHandler, inboundHandler, productRecHandler, productBatchesHandler, localitiesHandler, carrierHandler)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go expiryMonitor.Run(ctx)
+
+	rt := initRoutes(productHandler, employeeHd, sellersHandler, buyerHandler, sectionHandler, warehousesHandler, purchaseOrderHandler, inboundHandler, productRecHandler, productBatchesHandler, localitiesHandler, carrierHandler, productTypeHandler, temperatureReadingHandler, temperatureExcursionHandler, shipmentHandler, countryHandler, provinceHandler)
 	if err := http.ListenAndServe(":8080", rt); err != nil {
 		panic(err)
 	}
@@ -51,8 +60,12 @@ func initRoutes(productHandler *handler.ProductHandler,
 	buyerHandler *handler.BuyerHandler, sectionHandler *handler.SectionController,
 	warehouseHandler *handler.WarehouseHandler, purchaseOrderHandler *handler.PurchaseOrderHandler,
 	inboundHandler *handler.InboundOrderHandler, productRecHandler *handler.ProductRecHandler,
-	productBatchesHandler *handler.ProductBatchesController, localitiesHandler *handler.LocalitiesController, carrierHandler *handler.CarrierHandler) *chi.Mux {
+	productBatchesHandler *handler.ProductBatchesController, localitiesHandler *handler.LocalitiesController, carrierHandler *handler.CarrierHandler,
+	productTypeHandler *handler.ProductTypeHandler, temperatureReadingHandler *handler.TemperatureReadingHandler,
+	temperatureExcursionHandler *handler.TemperatureExcursionHandler, shipmentHandler *handler.ShipmentHandler,
+	countryHandler *handler.CountryHandler, provinceHandler *handler.ProvinceHandler) *chi.Mux {
 	rt := chi.NewRouter()
+	rt.Use(middleware.RequestID)
 
 	rt.Get("/ping", func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
@@ -63,7 +76,10 @@ func initRoutes(productHandler *handler.ProductHandler,
 
 	rt.Route("/api/v1/warehouses", func(r chi.Router) {
 		r.Get("/", warehouseHandler.GetAllWareHouse())
+		r.Get("/nearest", warehouseHandler.GetNearestWareHouses())
+		r.Get("/reportCapacity", warehouseHandler.GetCapacityReportWareHouse())
 		r.Get("/{id}", warehouseHandler.GetWareHouseByID())
+		r.Get("/{id}/inventory", warehouseHandler.GetInventoryWareHouse())
 		r.Post("/", warehouseHandler.PostWareHouse())
 		r.Patch("/{id}", warehouseHandler.UpdateWareHouse())
 		r.Delete("/{id}", warehouseHandler.DeleteByIDWareHouse())
@@ -76,19 +92,38 @@ func initRoutes(productHandler *handler.ProductHandler,
 		r.Patch("/{id}", sectionHandler.Update)
 		r.Delete("/{id}", sectionHandler.Delete)
 		r.Get("/reportProducts", sectionHandler.CountProductBatchesSections)
+		r.Get("/reportCapacity", sectionHandler.GetCapacityReport)
+		r.Get("/reportExcursions", temperatureExcursionHandler.GetReport)
+		r.Post("/{id}/temperatures", temperatureReadingHandler.Ingest)
+		r.Get("/{id}/temperatures", temperatureReadingHandler.GetAggregates)
+		r.Get("/{id}/excursions", temperatureExcursionHandler.GetBySection)
 	})
 
 	rt.Route("/api/v1/products", func(r chi.Router) {
 		r.Get("/", productHandler.GetAllProducts)
 		r.Get("/{id}", productHandler.GetProductByID)
 		r.Get("/reportRecords", productRecHandler.GetProductRecReport)
+		r.Get("/reportMargins", productRecHandler.GetProductMarginReport)
+		r.Get("/{id}/priceHistory", productRecHandler.GetProductPriceHistory)
 		r.Post("/", productHandler.CreateProduct)
 		r.Patch("/{id}", productHandler.UpdateProduct)
 		r.Delete("/{id}", productHandler.DeleteProductByID)
 	})
 
+	rt.Route("/api/v1/productTypes", func(r chi.Router) {
+		r.Get("/", productTypeHandler.GetAll)
+		r.Get("/reportUsage", productTypeHandler.GetUsageReport)
+		r.Get("/{id}", productTypeHandler.GetByID)
+		r.Post("/", productTypeHandler.Create)
+		r.Patch("/{id}", productTypeHandler.Update)
+		r.Delete("/{id}", productTypeHandler.Delete)
+	})
+
 	rt.Route("/api/v1/productRecords", func(r chi.Router) {
+		r.Get("/", productRecHandler.GetProductRecords)
+		r.Get("/{id}", productRecHandler.GetProductRecordByID)
 		r.Post("/", productRecHandler.CreateProductRecServ)
+		r.Delete("/{id}", productRecHandler.DeleteProductRecord)
 	})
 
 	rt.Route("/api/v1/buyers", func(r chi.Router) {
@@ -118,26 +153,69 @@ func initRoutes(productHandler *handler.ProductHandler,
 	})
 
 	rt.Route("/api/v1/localities", func(r chi.Router) {
+		r.Get("/", localitiesHandler.GetAll)
 		r.Post("/", localitiesHandler.CreateLocality)
 		r.Get("/{id}", localitiesHandler.GetByID)
+		r.Patch("/{id}", localitiesHandler.UpdateLocality)
+		r.Delete("/{id}", localitiesHandler.DeleteLocality)
 		r.Get("/reportCarriers", localitiesHandler.GetCarriers)
 		r.Get("/reportSellers", localitiesHandler.GetSellers)
 	})
 
+	rt.Route("/api/v1/countries", func(r chi.Router) {
+		r.Get("/", countryHandler.GetAll)
+		r.Get("/reportLocalities", countryHandler.GetReport)
+		r.Get("/{id}", countryHandler.GetByID)
+		r.Post("/", countryHandler.Create)
+		r.Patch("/{id}", countryHandler.Update)
+		r.Delete("/{id}", countryHandler.Delete)
+	})
+
+	rt.Route("/api/v1/provinces", func(r chi.Router) {
+		r.Get("/", provinceHandler.GetAll)
+		r.Get("/reportLocalities", provinceHandler.GetReport)
+		r.Get("/{id}", provinceHandler.GetByID)
+		r.Post("/", provinceHandler.Create)
+		r.Patch("/{id}", provinceHandler.Update)
+		r.Delete("/{id}", provinceHandler.Delete)
+	})
+
 	rt.Route("/api/v1/carries", func(r chi.Router) {
+		r.Get("/", carrierHandler.GetCarriers())
+		r.Get("/{id}", carrierHandler.GetCarrierByID())
 		r.Post("/", carrierHandler.PostCarriers())
+		r.Patch("/{id}", carrierHandler.PatchCarriers())
+		r.Delete("/{id}", carrierHandler.DeleteCarriers())
 	})
 
 	rt.Route("/api/v1/productBatches", func(r chi.Router) {
+		r.Get("/", productBatchesHandler.GetAll)
+		r.Get("/reportExpiring", productBatchesHandler.ReportExpiring)
+		r.Get("/{id}", productBatchesHandler.GetByID)
 		r.Post("/", productBatchesHandler.Post)
+		r.Patch("/{id}", productBatchesHandler.Update)
+		r.Delete("/{id}", productBatchesHandler.Delete)
 	})
 
 	rt.Route("/api/v1/inboundOrders", func(r chi.Router) {
+		r.Get("/", inboundHandler.GetInboundOrders)
+		r.Get("/{id}", inboundHandler.GetInboundOrderByID)
 		r.Post("/", inboundHandler.PostInboundOrder)
 	})
 
 	rt.Route("/api/v1/purchaseOrders", func(r chi.Router) {
+		r.Get("/", purchaseOrderHandler.HandlerGetPurchaseOrders)
+		r.Get("/{id}", purchaseOrderHandler.HandlerGetPurchaseOrderByID)
 		r.Post("/", purchaseOrderHandler.HandlerCreatePurchaseOrder)
+		r.Patch("/{id}/status", purchaseOrderHandler.HandlerUpdatePurchaseOrderStatus)
+		r.Post("/{id}/cancel", purchaseOrderHandler.HandlerCancelPurchaseOrder)
+		r.Get("/{id}/tracking", shipmentHandler.GetTracking)
+	})
+
+	rt.Route("/api/v1/shipments", func(r chi.Router) {
+		r.Get("/{id}", shipmentHandler.GetByID)
+		r.Post("/", shipmentHandler.Create)
+		r.Patch("/{id}/status", shipmentHandler.UpdateStatus)
 	})
 
 	return rt
@@ -12,6 +12,8 @@ CREATE TABLE `warehouses` (
     `telephone` varchar(15) NOT NULL,
     `minimum_capacity` int NOT NULL,
     `minimum_temperature` float NOT NULL,
+    `latitude` decimal(9,6) NULL,
+    `longitude` decimal(9,6) NULL,
     PRIMARY KEY (`id`)
 ) ENGINE = InnoDB DEFAULT CHARSET = utf8;
 
@@ -64,7 +66,8 @@ CREATE TABLE `buyers` (
 CREATE TABLE `countries`(
     `id` int(11) NOT NULL AUTO_INCREMENT,
     `country_name` varchar(255),
-    PRIMARY KEY(`id`)
+    PRIMARY KEY(`id`),
+    UNIQUE(`country_name`)
 ) ENGINE = InnoDB DEFAULT CHARSET = utf8;
 
 CREATE TABLE `provinces`(
@@ -72,15 +75,19 @@ CREATE TABLE `provinces`(
     `province_name` varchar(255),
     `id_country_fk` int(11),
     PRIMARY KEY(`id`),
+    UNIQUE(`province_name`, `id_country_fk`),
     FOREIGN KEY (`id_country_fk`) REFERENCES `countries`(`id`)
 ) ENGINE = InnoDB DEFAULT CHARSET = utf8;
 
 CREATE TABLE `locality`(
     `id` int(11) NOT NULL AUTO_INCREMENT,
     `locality_name` varchar(255),
-    `province_name` varchar(255),
-    `country_name` varchar(255),
-    PRIMARY KEY (`id`)
+    `province_id` int(11) NOT NULL,
+    `latitude` decimal(9,6) NULL,
+    `longitude` decimal(9,6) NULL,
+    PRIMARY KEY (`id`),
+    INDEX `idx_locality_name` (`locality_name`),
+    FOREIGN KEY (`province_id`) REFERENCES `provinces`(`id`)
 ) ENGINE = InnoDB DEFAULT CHARSET = utf8;
 
 -- table `sellers`
@@ -153,6 +160,7 @@ CREATE TABLE `product_records`(
     `sale_price` DECIMAL(19,2),
     `product_id` int(11),
     PRIMARY KEY (`id`),
+    INDEX `idx_product_records_product_updated` (`product_id`, `last_update_date`),
     FOREIGN KEY (`product_id`) REFERENCES `products`(`id`)  -- Corrigido para 'products'
 ) ENGINE = InnoDB DEFAULT CHARSET = utf8;
 
@@ -165,11 +173,22 @@ CREATE TABLE `inbound_orders`(
     `warehouse_id` int(11),
     PRIMARY KEY(`id`),
     UNIQUE(`order_number`),
+    INDEX `idx_inbound_orders_warehouse_date` (`warehouse_id`, `order_date`),
     FOREIGN KEY (`employee_id`) REFERENCES `employees`(`id`),  -- Corrigido para 'employees'
     FOREIGN KEY (`product_batch_id`) REFERENCES `product_batches`(`id`),  -- Corrigido para 'product_batches'
     FOREIGN KEY (`warehouse_id`) REFERENCES `warehouses`(`id`)  -- Corrigido para 'warehouses'
 ) ENGINE = InnoDB DEFAULT CHARSET = utf8;
 
+CREATE TABLE `inbound_order_batches`(
+    `id` int(11) NOT NULL AUTO_INCREMENT,
+    `inbound_order_id` int(11) NOT NULL,
+    `product_batch_id` int(11) NOT NULL,
+    PRIMARY KEY(`id`),
+    UNIQUE(`product_batch_id`),
+    FOREIGN KEY (`inbound_order_id`) REFERENCES `inbound_orders`(`id`),
+    FOREIGN KEY (`product_batch_id`) REFERENCES `product_batches`(`id`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8;
+
 CREATE TABLE `purchase_orders`(
     `id` int(11) NOT NULL AUTO_INCREMENT,
     `order_number` varchar(255),
@@ -177,12 +196,99 @@ CREATE TABLE `purchase_orders`(
     `tracking_code` varchar(255),
     `buyer_id` int(11),
     `product_record_id` int(11),
+    `quantity` int NOT NULL DEFAULT 1,
+    `status` varchar(20) NOT NULL DEFAULT 'pending',
+    `total_amount` DECIMAL(19,2) NOT NULL DEFAULT 0,
     PRIMARY KEY(`id`),
     UNIQUE(`order_number`),
+    INDEX `idx_purchase_orders_buyer_date` (`buyer_id`, `order_date`),
     FOREIGN KEY (`buyer_id`) REFERENCES `buyers`(`id`),  -- Corrigido para 'buyers'
     FOREIGN KEY (`product_record_id`) REFERENCES `product_records`(`id`)  -- Corrigido para 'product_records'
 ) ENGINE = InnoDB DEFAULT CHARSET = utf8;
 
+CREATE TABLE `purchase_order_lines`(
+    `id` int(11) NOT NULL AUTO_INCREMENT,
+    `purchase_order_id` int(11) NOT NULL,
+    `line_number` int NOT NULL,
+    `product_id` int(11) NOT NULL,
+    `product_record_id` int(11) NOT NULL,
+    `quantity` int NOT NULL,
+    `unit_price` DECIMAL(19,2) NOT NULL,
+    PRIMARY KEY(`id`),
+    UNIQUE(`purchase_order_id`, `line_number`),
+    FOREIGN KEY (`purchase_order_id`) REFERENCES `purchase_orders`(`id`),
+    FOREIGN KEY (`product_id`) REFERENCES `products`(`id`),
+    FOREIGN KEY (`product_record_id`) REFERENCES `product_records`(`id`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8;
+
+CREATE TABLE `purchase_order_batches`(
+    `id` int(11) NOT NULL AUTO_INCREMENT,
+    `purchase_order_id` int(11) NOT NULL,
+    `product_batch_id` int(11) NOT NULL,
+    `quantity` int NOT NULL,
+    PRIMARY KEY(`id`),
+    FOREIGN KEY (`purchase_order_id`) REFERENCES `purchase_orders`(`id`),
+    FOREIGN KEY (`product_batch_id`) REFERENCES `product_batches`(`id`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8;
+
+CREATE TABLE `temperature_readings`(
+    `id` int(11) NOT NULL AUTO_INCREMENT,
+    `section_id` int(11) NOT NULL,
+    `sensor_id` varchar(100),
+    `temperature` DECIMAL(19,2) NOT NULL,
+    `recorded_at` DATETIME(6) NOT NULL,
+    PRIMARY KEY(`id`),
+    INDEX `idx_temperature_readings_section_recorded_at` (`section_id`, `recorded_at`),
+    FOREIGN KEY (`section_id`) REFERENCES `sections`(`id`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8;
+
+CREATE TABLE `temperature_excursions`(
+    `id` int(11) NOT NULL AUTO_INCREMENT,
+    `section_id` int(11) NOT NULL,
+    `product_batch_id` int(11) NOT NULL,
+    `kind` varchar(20) NOT NULL,
+    `limit_temperature` DECIMAL(19,2) NOT NULL,
+    `peak_temperature` DECIMAL(19,2) NOT NULL,
+    `started_at` DATETIME(6) NOT NULL,
+    `ended_at` DATETIME(6) NULL,
+    PRIMARY KEY(`id`),
+    INDEX `idx_temperature_excursions_section_ended_at` (`section_id`, `ended_at`),
+    FOREIGN KEY (`section_id`) REFERENCES `sections`(`id`),
+    FOREIGN KEY (`product_batch_id`) REFERENCES `product_batches`(`id`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8;
+
+CREATE TABLE `shipments`(
+    `id` int(11) NOT NULL AUTO_INCREMENT,
+    `tracking_code` varchar(50) NOT NULL,
+    `carrier_id` int(11) NOT NULL,
+    `status` varchar(20) NOT NULL,
+    `created_at` DATETIME(6) NOT NULL,
+    `updated_at` DATETIME(6) NOT NULL,
+    PRIMARY KEY(`id`),
+    UNIQUE(`tracking_code`),
+    FOREIGN KEY (`carrier_id`) REFERENCES `carriers`(`id`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8;
+
+CREATE TABLE `shipment_purchase_orders`(
+    `shipment_id` int(11) NOT NULL,
+    `purchase_order_id` int(11) NOT NULL,
+    PRIMARY KEY(`shipment_id`, `purchase_order_id`),
+    INDEX `idx_shipment_purchase_orders_purchase_order` (`purchase_order_id`),
+    FOREIGN KEY (`shipment_id`) REFERENCES `shipments`(`id`),
+    FOREIGN KEY (`purchase_order_id`) REFERENCES `purchase_orders`(`id`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8;
+
+CREATE TABLE `shipment_events`(
+    `id` int(11) NOT NULL AUTO_INCREMENT,
+    `shipment_id` int(11) NOT NULL,
+    `status` varchar(20) NOT NULL,
+    `description` varchar(255) NOT NULL DEFAULT '',
+    `occurred_at` DATETIME(6) NOT NULL,
+    PRIMARY KEY(`id`),
+    INDEX `idx_shipment_events_shipment_occurred_at` (`shipment_id`, `occurred_at`),
+    FOREIGN KEY (`shipment_id`) REFERENCES `shipments`(`id`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8;
+
 
 CREATE TABLE logs (
                       DROP DATABASE IF EXISTS `meli_fresh`;
@@ -199,6 +305,8 @@ CREATE TABLE `warehouses` (
                               `telephone` varchar(15) NOT NULL,
                               `minimum_capacity` int NOT NULL,
                               `minimum_temperature` float NOT NULL,
+                              `latitude` decimal(9,6) NULL,
+                              `longitude` decimal(9,6) NULL,
                               PRIMARY KEY (`id`)
 ) ENGINE = InnoDB DEFAULT CHARSET = utf8;
 
@@ -251,7 +359,8 @@ CREATE TABLE `buyers` (
 CREATE TABLE `countries`(
                             `id` int(11) NOT NULL AUTO_INCREMENT,
                             `country_name` varchar(255),
-                            PRIMARY KEY(`id`)
+                            PRIMARY KEY(`id`),
+                            UNIQUE(`country_name`)
 ) ENGINE = InnoDB DEFAULT CHARSET = utf8;
 
 CREATE TABLE `provinces`(
@@ -259,15 +368,19 @@ CREATE TABLE `provinces`(
                             `province_name` varchar(255),
                             `id_country_fk` int(11),
                             PRIMARY KEY(`id`),
+                            UNIQUE(`province_name`, `id_country_fk`),
                             FOREIGN KEY (`id_country_fk`) REFERENCES `countries`(`id`)
 ) ENGINE = InnoDB DEFAULT CHARSET = utf8;
 
 CREATE TABLE `locality`(
                            `id` int(11) NOT NULL AUTO_INCREMENT,
                            `locality_name` varchar(255),
-                           `province_name` varchar(255),
-                           `country_name` varchar(255),
-                           PRIMARY KEY (`id`)
+                           `province_id` int(11) NOT NULL,
+                           `latitude` decimal(9,6) NULL,
+                           `longitude` decimal(9,6) NULL,
+                           PRIMARY KEY (`id`),
+                           INDEX `idx_locality_name` (`locality_name`),
+                           FOREIGN KEY (`province_id`) REFERENCES `provinces`(`id`)
 ) ENGINE = InnoDB DEFAULT CHARSET = utf8;
 
 -- table `sellers`
@@ -340,6 +453,7 @@ CREATE TABLE `product_records`(
                                   `sale_price` DECIMAL(19,2),
                                   `product_id` int(11),
                                   PRIMARY KEY (`id`),
+                                  INDEX `idx_product_records_product_updated` (`product_id`, `last_update_date`),
                                   FOREIGN KEY (`product_id`) REFERENCES `products`(`id`)  -- Corrigido para 'products'
 ) ENGINE = InnoDB DEFAULT CHARSET = utf8;
 
@@ -352,11 +466,22 @@ CREATE TABLE `inbound_orders`(
                                  `warehouse_id` int(11),
                                  PRIMARY KEY(`id`),
                                  UNIQUE(`order_number`),
+                                 INDEX `idx_inbound_orders_warehouse_date` (`warehouse_id`, `order_date`),
                                  FOREIGN KEY (`employee_id`) REFERENCES `employees`(`id`),  -- Corrigido para 'employees'
                                  FOREIGN KEY (`product_batch_id`) REFERENCES `product_batches`(`id`),  -- Corrigido para 'product_batches'
                                  FOREIGN KEY (`warehouse_id`) REFERENCES `warehouses`(`id`)  -- Corrigido para 'warehouses'
 ) ENGINE = InnoDB DEFAULT CHARSET = utf8;
 
+CREATE TABLE `inbound_order_batches`(
+                                  `id` int(11) NOT NULL AUTO_INCREMENT,
+                                  `inbound_order_id` int(11) NOT NULL,
+                                  `product_batch_id` int(11) NOT NULL,
+                                  PRIMARY KEY(`id`),
+                                  UNIQUE(`product_batch_id`),
+                                  FOREIGN KEY (`inbound_order_id`) REFERENCES `inbound_orders`(`id`),
+                                  FOREIGN KEY (`product_batch_id`) REFERENCES `product_batches`(`id`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8;
+
 CREATE TABLE `purchase_orders`(
                                   `id` int(11) NOT NULL AUTO_INCREMENT,
                                   `order_number` varchar(255),
@@ -364,12 +489,99 @@ CREATE TABLE `purchase_orders`(
                                   `tracking_code` varchar(255),
                                   `buyer_id` int(11),
                                   `product_record_id` int(11),
+                                  `quantity` int NOT NULL DEFAULT 1,
+                                  `status` varchar(20) NOT NULL DEFAULT 'pending',
+                                  `total_amount` DECIMAL(19,2) NOT NULL DEFAULT 0,
                                   PRIMARY KEY(`id`),
                                   UNIQUE(`order_number`),
+                                  INDEX `idx_purchase_orders_buyer_date` (`buyer_id`, `order_date`),
                                   FOREIGN KEY (`buyer_id`) REFERENCES `buyers`(`id`),  -- Corrigido para 'buyers'
                                   FOREIGN KEY (`product_record_id`) REFERENCES `product_records`(`id`)  -- Corrigido para 'product_records'
 ) ENGINE = InnoDB DEFAULT CHARSET = utf8;
 
+CREATE TABLE `purchase_order_lines`(
+                                  `id` int(11) NOT NULL AUTO_INCREMENT,
+                                  `purchase_order_id` int(11) NOT NULL,
+                                  `line_number` int NOT NULL,
+                                  `product_id` int(11) NOT NULL,
+                                  `product_record_id` int(11) NOT NULL,
+                                  `quantity` int NOT NULL,
+                                  `unit_price` DECIMAL(19,2) NOT NULL,
+                                  PRIMARY KEY(`id`),
+                                  UNIQUE(`purchase_order_id`, `line_number`),
+                                  FOREIGN KEY (`purchase_order_id`) REFERENCES `purchase_orders`(`id`),
+                                  FOREIGN KEY (`product_id`) REFERENCES `products`(`id`),
+                                  FOREIGN KEY (`product_record_id`) REFERENCES `product_records`(`id`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8;
+
+CREATE TABLE `purchase_order_batches`(
+                                  `id` int(11) NOT NULL AUTO_INCREMENT,
+                                  `purchase_order_id` int(11) NOT NULL,
+                                  `product_batch_id` int(11) NOT NULL,
+                                  `quantity` int NOT NULL,
+                                  PRIMARY KEY(`id`),
+                                  FOREIGN KEY (`purchase_order_id`) REFERENCES `purchase_orders`(`id`),
+                                  FOREIGN KEY (`product_batch_id`) REFERENCES `product_batches`(`id`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8;
+
+CREATE TABLE `temperature_readings`(
+                                  `id` int(11) NOT NULL AUTO_INCREMENT,
+                                  `section_id` int(11) NOT NULL,
+                                  `sensor_id` varchar(100),
+                                  `temperature` DECIMAL(19,2) NOT NULL,
+                                  `recorded_at` DATETIME(6) NOT NULL,
+                                  PRIMARY KEY(`id`),
+                                  INDEX `idx_temperature_readings_section_recorded_at` (`section_id`, `recorded_at`),
+                                  FOREIGN KEY (`section_id`) REFERENCES `sections`(`id`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8;
+
+CREATE TABLE `temperature_excursions`(
+                                  `id` int(11) NOT NULL AUTO_INCREMENT,
+                                  `section_id` int(11) NOT NULL,
+                                  `product_batch_id` int(11) NOT NULL,
+                                  `kind` varchar(20) NOT NULL,
+                                  `limit_temperature` DECIMAL(19,2) NOT NULL,
+                                  `peak_temperature` DECIMAL(19,2) NOT NULL,
+                                  `started_at` DATETIME(6) NOT NULL,
+                                  `ended_at` DATETIME(6) NULL,
+                                  PRIMARY KEY(`id`),
+                                  INDEX `idx_temperature_excursions_section_ended_at` (`section_id`, `ended_at`),
+                                  FOREIGN KEY (`section_id`) REFERENCES `sections`(`id`),
+                                  FOREIGN KEY (`product_batch_id`) REFERENCES `product_batches`(`id`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8;
+
+CREATE TABLE `shipments`(
+                                  `id` int(11) NOT NULL AUTO_INCREMENT,
+                                  `tracking_code` varchar(50) NOT NULL,
+                                  `carrier_id` int(11) NOT NULL,
+                                  `status` varchar(20) NOT NULL,
+                                  `created_at` DATETIME(6) NOT NULL,
+                                  `updated_at` DATETIME(6) NOT NULL,
+                                  PRIMARY KEY(`id`),
+                                  UNIQUE(`tracking_code`),
+                                  FOREIGN KEY (`carrier_id`) REFERENCES `carriers`(`id`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8;
+
+CREATE TABLE `shipment_purchase_orders`(
+                                  `shipment_id` int(11) NOT NULL,
+                                  `purchase_order_id` int(11) NOT NULL,
+                                  PRIMARY KEY(`shipment_id`, `purchase_order_id`),
+                                  INDEX `idx_shipment_purchase_orders_purchase_order` (`purchase_order_id`),
+                                  FOREIGN KEY (`shipment_id`) REFERENCES `shipments`(`id`),
+                                  FOREIGN KEY (`purchase_order_id`) REFERENCES `purchase_orders`(`id`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8;
+
+CREATE TABLE `shipment_events`(
+                                  `id` int(11) NOT NULL AUTO_INCREMENT,
+                                  `shipment_id` int(11) NOT NULL,
+                                  `status` varchar(20) NOT NULL,
+                                  `description` varchar(255) NOT NULL DEFAULT '',
+                                  `occurred_at` DATETIME(6) NOT NULL,
+                                  PRIMARY KEY(`id`),
+                                  INDEX `idx_shipment_events_shipment_occurred_at` (`shipment_id`, `occurred_at`),
+                                  FOREIGN KEY (`shipment_id`) REFERENCES `shipments`(`id`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8;
+
 
 CREATE TABLE logs (
                       id INT AUTO_INCREMENT PRIMARY KEY,   -- ID único para cada log
@@ -408,11 +620,11 @@ INSERT INTO meli_fresh.product_type (`type_name`) VALUES
                                                       ('Spices');
 
 INSERT INTO meli_fresh.sections (`section_number`, `current_temperature`, `minimum_temperature`, `current_capacity`, `minimum_capacity`, `maximum_capacity`, `warehouse_id`, `product_type_id`) VALUES
-                                                                                                                                                                                                    ("S01", 10, 10, 1, 1, 1, 1, 1),
-                                                                                                                                                                                                    ("S02", 12, 12, 2, 2, 2, 2, 2),
-                                                                                                                                                                                                    ("S03", 13, 13, 3, 3, 3, 3, 3),
-                                                                                                                                                                                                    ("S04", 14, 14, 4, 4, 4, 4, 4),
-                                                                                                                                                                                                    ("S05", 15, 15, 5, 5, 5, 5, 5);
+                                                                                                                                                                                                    ("S01", 10, 10, 500, 100, 1000, 1, 1),
+                                                                                                                                                                                                    ("S02", 12, 12, 1150, 200, 2000, 2, 2),
+                                                                                                                                                                                                    ("S03", 13, 13, 2100, 300, 3000, 3, 3),
+                                                                                                                                                                                                    ("S04", 14, 14, 2550, 400, 3000, 4, 4),
+                                                                                                                                                                                                    ("S05", 15, 15, 1000, 500, 2000, 5, 5);
 
 INSERT INTO meli_fresh.employees (`card_number_id`, `first_name`, `last_name`, `warehouse_id`) VALUES
                                                                                                    ('E1001', 'John', 'Doe', 1),
@@ -455,12 +667,12 @@ INSERT INTO meli_fresh.provinces (`province_name`, `id_country_fk`) VALUES
                                                                         ('Province E', 4);
 
 -- Insert records into the 'locality' table
-INSERT INTO meli_fresh.locality (locality_name, province_name, country_name) VALUES
-                                                                                 ('Locality X', 'Province 1', 'Country A'),
-                                                                                 ('Locality Y', 'Province 2', 'Country B'),
-                                                                                 ('Locality Z', 'Province 3', 'Country C'),
-                                                                                 ('Locality W', 'Province 4', 'Country D'),
-                                                                                 ('Locality V', 'Province 5', 'Country E');
+INSERT INTO meli_fresh.locality (locality_name, province_id) VALUES
+                                                                                 ('Locality X', 1),
+                                                                                 ('Locality Y', 2),
+                                                                                 ('Locality Z', 3),
+                                                                                 ('Locality W', 4),
+                                                                                 ('Locality V', 5);
 
 INSERT INTO meli_fresh.sellers (cid, company_name, address, telephone, locality_id) VALUES
                                                                                         (1, 'Company A', '123 Main St', '123-456-7890', 1),
@@ -565,11 +777,11 @@ INSERT INTO meli_fresh.product_type (`type_name`) VALUES
 ('Spices');
 
 INSERT INTO meli_fresh.sections (`section_number`, `current_temperature`, `minimum_temperature`, `current_capacity`, `minimum_capacity`, `maximum_capacity`, `warehouse_id`, `product_type_id`) VALUES
-("S01", 10, 10, 1, 1, 1, 1, 1),
-("S02", 12, 12, 2, 2, 2, 2, 2),
-("S03", 13, 13, 3, 3, 3, 3, 3),
-("S04", 14, 14, 4, 4, 4, 4, 4),
-("S05", 15, 15, 5, 5, 5, 5, 5);
+("S01", 10, 10, 500, 100, 1000, 1, 1),
+("S02", 12, 12, 1150, 200, 2000, 2, 2),
+("S03", 13, 13, 2100, 300, 3000, 3, 3),
+("S04", 14, 14, 2550, 400, 3000, 4, 4),
+("S05", 15, 15, 1000, 500, 2000, 5, 5);
 
 INSERT INTO meli_fresh.employees (`card_number_id`, `first_name`, `last_name`, `warehouse_id`) VALUES
 ('E1001', 'John', 'Doe', 1),
@@ -612,12 +824,12 @@ INSERT INTO meli_fresh.provinces (`province_name`, `id_country_fk`) VALUES
 ('Province E', 4);
 
 -- Insert records into the 'locality' table
-INSERT INTO meli_fresh.locality (locality_name, province_name, country_name) VALUES
-('Locality X', 'Province 1', 'Country A'),
-('Locality Y', 'Province 2', 'Country B'),
-('Locality Z', 'Province 3', 'Country C'),
-('Locality W', 'Province 4', 'Country D'),
-('Locality V', 'Province 5', 'Country E');
+INSERT INTO meli_fresh.locality (locality_name, province_id) VALUES
+('Locality X', 1),
+('Locality Y', 2),
+('Locality Z', 3),
+('Locality W', 4),
+('Locality V', 5);
 
 INSERT INTO meli_fresh.sellers (cid, company_name, address, telephone, locality_id) VALUES
 (1, 'Company A', '123 Main St', '123-456-7890', 1),
@@ -28,12 +28,28 @@ func NewBuyerHandler(svc interfaces.IBuyerservice, log logger.Logger) *BuyerHand
 // @Description Fetch all registered buyers from the database
 // @Tags Buyer
 // @Produce json
+// @Param page query int false "Page number, starting at 1"
+// @Param page_size query int false "Items per page (max 100)"
+// @Param sort query string false "Sort field, prefix with - for descending"
+// @Param card_number_id query string false "Card number ID"
+// @Param first_name query string false "First name"
+// @Param last_name query string false "Last name"
 // @Success 200 {object} model.BuyerResponseSwagger
+// @Failure 400 {object} model.ErrorResponseSwagger "Invalid pagination, sort or filter parameter"
 // @Failure 500 {object} model.ErrorResponseSwagger "Unable to list Buyers"
 // @Router /buyers [get]
 func (bh *BuyerHandler) HandlerGetAllBuyers(w http.ResponseWriter, r *http.Request) {
 	bh.log.Log("BuyerHandler", "INFO", "initializing Request GetAllBUyers")
-	buyers, err := bh.Svc.GetAllBuyer()
+	params, err := model.ParseListParams(r.URL.Query(), model.BuyerListOptions)
+
+	if err != nil {
+		bh.log.Log("BuyerHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+		response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody(err.Error(), nil))
+
+		return
+	}
+
+	buyers, total, err := bh.Svc.GetAllBuyer(r.Context(), params)
 
 	if err != nil {
 		bh.log.Log("BuyerHandler", "ERROR", fmt.Sprintf("Error: %v", err))
@@ -43,7 +59,7 @@ func (bh *BuyerHandler) HandlerGetAllBuyers(w http.ResponseWriter, r *http.Reque
 	}
 
 	bh.log.Log("BuyerHandler", "INFO", "Return buyers searched in format JSON")
-	response.JSON(w, http.StatusOK, responses.CreateResponseBody("", buyers))
+	response.JSON(w, http.StatusOK, responses.CreatePaginatedResponseBody("", buyers, params.Page, params.PageSize, total))
 }
 
 // HandlerGetBuyerByID retrieves a buyer by their ID.
@@ -72,7 +88,7 @@ func (bh *BuyerHandler) HandlerGetBuyerByID(w http.ResponseWriter, r *http.Reque
 	}
 
 	bh.log.Log("BuyerHandler", "INFO", fmt.Sprintf("initializing search GetBuyerByID in BuyerService with ID: %d", id))
-	buyer, err := bh.Svc.GetBuyerByID(id)
+	buyer, err := bh.Svc.GetBuyerByID(r.Context(), id)
 
 	if err != nil {
 		if err, ok := err.(*customerror.BuyerError); ok {
@@ -118,7 +134,7 @@ func (bh *BuyerHandler) HandlerDeleteBuyerByID(w http.ResponseWriter, r *http.Re
 	}
 
 	bh.log.Log("BuyerHandler", "INFO", fmt.Sprintf("initializing  DeleteBuyerByID in BuyerService with ID: %d", id))
-	err = bh.Svc.DeleteBuyerByID(id)
+	err = bh.Svc.DeleteBuyerByID(r.Context(), id)
 
 	if err != nil {
 		if err, ok := err.(*customerror.BuyerError); ok {
@@ -181,7 +197,7 @@ func (bh *BuyerHandler) HandlerCreateBuyer(w http.ResponseWriter, r *http.Reques
 	}
 
 	bh.log.Log("BuyerHandler", "INFO", "fields received validated successful ")
-	buyer, err := bh.Svc.CreateBuyer(reqBody)
+	buyer, err := bh.Svc.CreateBuyer(r.Context(), reqBody)
 
 	if err != nil {
 		if err, ok := err.(*customerror.BuyerError); ok {
@@ -267,7 +283,7 @@ func (bh *BuyerHandler) HandlerUpdateBuyer(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	buyer, err := bh.Svc.UpdateBuyer(id, reqBody)
+	buyer, err := bh.Svc.UpdateBuyer(r.Context(), id, reqBody)
 
 	if err != nil {
 		if err, ok := err.(*customerror.BuyerError); ok {
@@ -304,7 +320,7 @@ func (bh *BuyerHandler) HandlerCountPurchaseOrderBuyer(w http.ResponseWriter, r
 
 	if idStr == "" {
 		bh.log.Log("BuyerHandler", "INFO", "called buyerService CountPurchaseOrderBuyer why did the ID parameter come empty")
-		count, err := bh.Svc.CountPurchaseOrderBuyer()
+		count, err := bh.Svc.CountPurchaseOrderBuyer(r.Context())
 
 		if err != nil {
 			if err, ok := err.(*customerror.BuyerError); ok {
@@ -335,7 +351,7 @@ func (bh *BuyerHandler) HandlerCountPurchaseOrderBuyer(w http.ResponseWriter, r
 	}
 
 	bh.log.Log("BuyerHandler", "INFO", fmt.Sprintf("called buyerService CountPurchaseOrderByBuyerID with ID %d", id))
-	count, err := bh.Svc.CountPurchaseOrderByBuyerID(id)
+	count, err := bh.Svc.CountPurchaseOrderByBuyerID(r.Context(), id)
 
 	if err != nil {
 		if err, ok := err.(*customerror.BuyerError); ok {
@@ -12,6 +12,7 @@ import (
 	"github.com/maxwelbm/alkemy-g7.git/internal/model"
 	"github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func setup(t *testing.T) *handler.BuyerHandler {
@@ -27,7 +28,7 @@ func TestHandlerGetBuyerById(t *testing.T) {
 		expectedBuyer := model.Buyer{ID: 2, FirstName: "Ac", LastName: "Milan", CardNumberID: "4321"}
 
 		mockSvc := hd.Svc.(*mocks.MockIBuyerservice)
-		mockSvc.On("GetBuyerByID", 2).Return(expectedBuyer, nil)
+		mockSvc.On("GetBuyerByID", mock.Anything, 2).Return(expectedBuyer, nil)
 
 		request := httptest.NewRequest(http.MethodGet, "/api/v1/buyers/2", nil)
 		response := httptest.NewRecorder()
@@ -54,7 +55,7 @@ func TestHandlerGetBuyerById(t *testing.T) {
 		hd := setup(t)
 
 		mockSvc := hd.Svc.(*mocks.MockIBuyerservice)
-		mockSvc.On("GetBuyerByID", 99).Return(model.Buyer{}, customerror.NewBuyerError(http.StatusNotFound, customerror.ErrNotFound.Error(), "Buyer"))
+		mockSvc.On("GetBuyerByID", mock.Anything, 99).Return(model.Buyer{}, customerror.NewBuyerError(http.StatusNotFound, customerror.ErrNotFound.Error(), "Buyer"))
 
 		request := httptest.NewRequest(http.MethodGet, "/api/v1/buyers/99", nil)
 		response := httptest.NewRecorder()
@@ -88,7 +89,7 @@ func TestHandlerGetBuyerById(t *testing.T) {
 		hd := setup(t)
 
 		mockSvc := hd.Svc.(*mocks.MockIBuyerservice)
-		mockSvc.On("GetBuyerByID", 2).Return(model.Buyer{}, errors.New("Unmapped error"))
+		mockSvc.On("GetBuyerByID", mock.Anything, 2).Return(model.Buyer{}, errors.New("Unmapped error"))
 
 		request := httptest.NewRequest(http.MethodGet, "/api/v1/buyers/2", nil)
 		response := httptest.NewRecorder()
@@ -111,7 +112,7 @@ func TestHandlerCreateBuyer(t *testing.T) {
 		createdBuyer := model.Buyer{ID: 1, FirstName: "Ac", LastName: "Milan", CardNumberID: "4321"}
 
 		mockSvc := hd.Svc.(*mocks.MockIBuyerservice)
-		mockSvc.On("CreateBuyer", model.Buyer{FirstName: "Ac", LastName: "Milan", CardNumberID: "4321"}).
+		mockSvc.On("CreateBuyer", mock.Anything, model.Buyer{FirstName: "Ac", LastName: "Milan", CardNumberID: "4321"}).
 			Return(createdBuyer, nil)
 
 		body := []byte(`{           
@@ -167,7 +168,7 @@ func TestHandlerCreateBuyer(t *testing.T) {
 		hd := setup(t)
 
 		mockSvc := hd.Svc.(*mocks.MockIBuyerservice)
-		mockSvc.On("CreateBuyer", model.Buyer{FirstName: "Ac", LastName: "Milan", CardNumberID: "4321"}).
+		mockSvc.On("CreateBuyer", mock.Anything, model.Buyer{FirstName: "Ac", LastName: "Milan", CardNumberID: "4321"}).
 			Return(model.Buyer{}, customerror.NewBuyerError(http.StatusConflict, customerror.ErrConflict.Error(), "card_number_id"))
 
 		body := []byte(`{           
@@ -216,7 +217,7 @@ func TestHandlerCreateBuyer(t *testing.T) {
 		hd := setup(t)
 
 		mockSvc := hd.Svc.(*mocks.MockIBuyerservice)
-		mockSvc.On("CreateBuyer", model.Buyer{FirstName: "Ac", LastName: "Milan", CardNumberID: "4321"}).Return(model.Buyer{}, errors.New("Unmapped error"))
+		mockSvc.On("CreateBuyer", mock.Anything, model.Buyer{FirstName: "Ac", LastName: "Milan", CardNumberID: "4321"}).Return(model.Buyer{}, errors.New("Unmapped error"))
 
 		body := []byte(`{           
            
@@ -245,7 +246,7 @@ func TestHandlerUpdateBuyer(t *testing.T) {
 
 		UpdatedBuyer := model.Buyer{ID: 1, FirstName: "Abilio", LastName: "Milan", CardNumberID: "4321"}
 		mockSvc := hd.Svc.(*mocks.MockIBuyerservice)
-		mockSvc.On("UpdateBuyer", 1, model.Buyer{FirstName: "Abilio"}).Return(UpdatedBuyer, nil)
+		mockSvc.On("UpdateBuyer", mock.Anything, 1, model.Buyer{FirstName: "Abilio"}).Return(UpdatedBuyer, nil)
 
 		body := []byte(`{           
            
@@ -278,7 +279,7 @@ func TestHandlerUpdateBuyer(t *testing.T) {
 		hd := setup(t)
 
 		mockSvc := hd.Svc.(*mocks.MockIBuyerservice)
-		mockSvc.On("UpdateBuyer", 99, model.Buyer{FirstName: "Jonas"}).
+		mockSvc.On("UpdateBuyer", mock.Anything, 99, model.Buyer{FirstName: "Jonas"}).
 			Return(model.Buyer{}, customerror.NewBuyerError(http.StatusNotFound, customerror.ErrNotFound.Error(), "Buyer"))
 
 		body := []byte(`{           
@@ -304,7 +305,7 @@ func TestHandlerUpdateBuyer(t *testing.T) {
 		hd := setup(t)
 
 		mockSvc := hd.Svc.(*mocks.MockIBuyerservice)
-		mockSvc.On("UpdateBuyer", 1, model.Buyer{CardNumberID: "1234"}).
+		mockSvc.On("UpdateBuyer", mock.Anything, 1, model.Buyer{CardNumberID: "1234"}).
 			Return(model.Buyer{}, customerror.NewBuyerError(http.StatusConflict, customerror.ErrConflict.Error(), "card_number_id"))
 
 		body := []byte(`{           
@@ -374,7 +375,7 @@ func TestHandlerUpdateBuyer(t *testing.T) {
 		hd := setup(t)
 
 		mockSvc := hd.Svc.(*mocks.MockIBuyerservice)
-		mockSvc.On("UpdateBuyer", 1, model.Buyer{FirstName: "Ac", LastName: "Milan", CardNumberID: "4321"}).Return(model.Buyer{}, errors.New("Unmapped error"))
+		mockSvc.On("UpdateBuyer", mock.Anything, 1, model.Buyer{FirstName: "Ac", LastName: "Milan", CardNumberID: "4321"}).Return(model.Buyer{}, errors.New("Unmapped error"))
 
 		body := []byte(`{           
            
@@ -417,7 +418,7 @@ func TestHandlerDeleteBuyerById(t *testing.T) {
 		hd := setup(t)
 
 		mockSvc := hd.Svc.(*mocks.MockIBuyerservice)
-		mockSvc.On("DeleteBuyerByID", 1).Return(nil)
+		mockSvc.On("DeleteBuyerByID", mock.Anything, 1).Return(nil)
 
 		request := httptest.NewRequest(http.MethodDelete, "/api/v1/buyers/1", nil)
 		response := httptest.NewRecorder()
@@ -433,7 +434,7 @@ func TestHandlerDeleteBuyerById(t *testing.T) {
 		hd := setup(t)
 
 		mockSvc := hd.Svc.(*mocks.MockIBuyerservice)
-		mockSvc.On("DeleteBuyerByID", 99).Return(customerror.NewBuyerError(http.StatusNotFound, customerror.ErrNotFound.Error(), "Buyer"))
+		mockSvc.On("DeleteBuyerByID", mock.Anything, 99).Return(customerror.NewBuyerError(http.StatusNotFound, customerror.ErrNotFound.Error(), "Buyer"))
 
 		request := httptest.NewRequest(http.MethodDelete, "/api/v1/buyers/99", nil)
 		response := httptest.NewRecorder()
@@ -452,7 +453,7 @@ func TestHandlerDeleteBuyerById(t *testing.T) {
 		hd := setup(t)
 
 		mockSvc := hd.Svc.(*mocks.MockIBuyerservice)
-		mockSvc.On("DeleteBuyerByID", 1).Return(customerror.NewBuyerError(http.StatusConflict, customerror.ErrDependencies.Error(), "Buyer"))
+		mockSvc.On("DeleteBuyerByID", mock.Anything, 1).Return(customerror.NewBuyerError(http.StatusConflict, customerror.ErrDependencies.Error(), "Buyer"))
 
 		request := httptest.NewRequest(http.MethodDelete, "/api/v1/buyers/1", nil)
 		response := httptest.NewRecorder()
@@ -486,7 +487,7 @@ func TestHandlerDeleteBuyerById(t *testing.T) {
 		hd := setup(t)
 
 		mockSvc := hd.Svc.(*mocks.MockIBuyerservice)
-		mockSvc.On("DeleteBuyerByID", 1).Return(errors.New("Unmapped error"))
+		mockSvc.On("DeleteBuyerByID", mock.Anything, 1).Return(errors.New("Unmapped error"))
 
 		request := httptest.NewRequest(http.MethodDelete, "/api/v1/buyers/1", nil)
 		response := httptest.NewRecorder()
@@ -512,10 +513,12 @@ func TestHandlerGetBuyers(t *testing.T) {
 		expectedBuyers := []model.Buyer{{ID: 1, FirstName: "John", LastName: "Doe", CardNumberID: "1234"},
 			{ID: 2, FirstName: "Ac", LastName: "Milan", CardNumberID: "4321"}}
 
-		request := httptest.NewRequest(http.MethodGet, "/api/v1/buyers", nil)
+		expectedParams := model.ListParams{Page: 1, PageSize: 2, Sort: "last_name", Desc: true, Filters: map[string]string{"first_name": "John"}}
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/buyers?page=1&page_size=2&sort=-last_name&first_name=John", nil)
 		response := httptest.NewRecorder()
 		mockSvc := hd.Svc.(*mocks.MockIBuyerservice)
-		mockSvc.On("GetAllBuyer").Return(expectedBuyers, nil)
+		mockSvc.On("GetAllBuyer", mock.Anything, expectedParams).Return(expectedBuyers, 3, nil)
 
 		hd.HandlerGetAllBuyers(response, request)
 
@@ -533,7 +536,13 @@ func TestHandlerGetBuyers(t *testing.T) {
             "first_name": "Ac",
             "last_name": "Milan"
         }
-    ]
+    ],
+    "pagination": {
+        "page": 1,
+        "page_size": 2,
+        "total_items": 3,
+        "total_pages": 2
+    }
 }`
 
 		assert.Equal(t, http.StatusOK, response.Code)
@@ -546,7 +555,7 @@ func TestHandlerGetBuyers(t *testing.T) {
 		hd := setup(t)
 
 		mockSvc := hd.Svc.(*mocks.MockIBuyerservice)
-		mockSvc.On("GetAllBuyer").Return([]model.Buyer{}, errors.New("Unmapped error"))
+		mockSvc.On("GetAllBuyer", mock.Anything, mock.Anything).Return([]model.Buyer{}, 0, errors.New("Unmapped error"))
 
 		request := httptest.NewRequest(http.MethodGet, "/api/v1/buyers", nil)
 		response := httptest.NewRecorder()
@@ -560,6 +569,20 @@ func TestHandlerGetBuyers(t *testing.T) {
 		mockSvc.AssertExpectations(t)
 
 	})
+
+	t.Run("return bad request when the sort field is not allowed", func(t *testing.T) {
+		hd := setup(t)
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/buyers?sort=password", nil)
+		response := httptest.NewRecorder()
+
+		hd.HandlerGetAllBuyers(response, request)
+
+		expectedJson := `{"message":"invalid sort: password, allowed values are card_number_id,first_name,id,last_name"}`
+
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+		assert.JSONEq(t, expectedJson, response.Body.String())
+	})
 }
 
 func TestHandlerCountPurchaseOrderBuyer(t *testing.T) {
@@ -575,7 +598,7 @@ func TestHandlerCountPurchaseOrderBuyer(t *testing.T) {
 		}}
 
 		mockSvc := hd.Svc.(*mocks.MockIBuyerservice)
-		mockSvc.On("CountPurchaseOrderBuyer").Return(countBuyers, nil)
+		mockSvc.On("CountPurchaseOrderBuyer", mock.Anything).Return(countBuyers, nil)
 
 		request := httptest.NewRequest(http.MethodGet, "/api/v1/buyers/reportPurchaseOrders", nil)
 		response := httptest.NewRecorder()
@@ -611,7 +634,7 @@ func TestHandlerCountPurchaseOrderBuyer(t *testing.T) {
 		}
 
 		mockSvc := hd.Svc.(*mocks.MockIBuyerservice)
-		mockSvc.On("CountPurchaseOrderByBuyerID", countBuyer.ID).Return(countBuyer, nil)
+		mockSvc.On("CountPurchaseOrderByBuyerID", mock.Anything, countBuyer.ID).Return(countBuyer, nil)
 
 		request := httptest.NewRequest(http.MethodGet, "/api/v1/buyers/reportPurchaseOrders?id=1", nil)
 		response := httptest.NewRecorder()
@@ -654,7 +677,7 @@ func TestHandlerCountPurchaseOrderBuyer(t *testing.T) {
 		hd := setup(t)
 
 		mockSvc := hd.Svc.(*mocks.MockIBuyerservice)
-		mockSvc.On("CountPurchaseOrderByBuyerID", 99).Return(model.BuyerPurchaseOrder{}, customerror.NewBuyerError(http.StatusNotFound, customerror.ErrNotFound.Error(), "Buyer"))
+		mockSvc.On("CountPurchaseOrderByBuyerID", mock.Anything, 99).Return(model.BuyerPurchaseOrder{}, customerror.NewBuyerError(http.StatusNotFound, customerror.ErrNotFound.Error(), "Buyer"))
 
 		request := httptest.NewRequest(http.MethodGet, "/api/v1/buyers/reportPurchaseOrders?id=99", nil)
 		response := httptest.NewRecorder()
@@ -674,7 +697,7 @@ func TestHandlerCountPurchaseOrderBuyer(t *testing.T) {
 		hd := setup(t)
 
 		mockSvc := hd.Svc.(*mocks.MockIBuyerservice)
-		mockSvc.On("CountPurchaseOrderBuyer").Return([]model.BuyerPurchaseOrder{}, errors.New("Unmapped error"))
+		mockSvc.On("CountPurchaseOrderBuyer", mock.Anything).Return([]model.BuyerPurchaseOrder{}, errors.New("Unmapped error"))
 
 		request := httptest.NewRequest(http.MethodGet, "/api/v1/buyers/reportPurchaseOrders", nil)
 		response := httptest.NewRecorder()
@@ -694,7 +717,7 @@ func TestHandlerCountPurchaseOrderBuyer(t *testing.T) {
 		hd := setup(t)
 
 		mockSvc := hd.Svc.(*mocks.MockIBuyerservice)
-		mockSvc.On("CountPurchaseOrderByBuyerID", 1).Return(model.BuyerPurchaseOrder{}, errors.New("Unmapped error"))
+		mockSvc.On("CountPurchaseOrderByBuyerID", mock.Anything, 1).Return(model.BuyerPurchaseOrder{}, errors.New("Unmapped error"))
 
 		request := httptest.NewRequest(http.MethodGet, "/api/v1/buyers/reportPurchaseOrders?id=1", nil)
 		response := httptest.NewRecorder()
@@ -4,9 +4,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/bootcamp-go/web/response"
+	"github.com/go-chi/chi/v5"
 	"github.com/maxwelbm/alkemy-g7.git/internal/handler/responses"
 	"github.com/maxwelbm/alkemy-g7.git/internal/model"
 	svc "github.com/maxwelbm/alkemy-g7.git/internal/service/interfaces"
@@ -55,7 +57,7 @@ func (h *CarrierHandler) PostCarriers() http.HandlerFunc {
 			return
 		}
 
-		carrier, err := h.Srv.PostCarrier(reqBody)
+		carrier, err := h.Srv.PostCarrier(r.Context(), reqBody)
 
 		if err != nil {
 			if err, ok := err.(*customerror.CarrierError); ok {
@@ -80,3 +82,187 @@ func (h *CarrierHandler) PostCarriers() http.HandlerFunc {
 		response.JSON(w, http.StatusCreated, responses.CreateResponseBody("", carrier))
 	}
 }
+
+// GetCarriers lists the carriers.
+// @Summary List carriers
+// @Description Returns the carriers, paginated and optionally filtered by locality
+// @Tags Carriers
+// @Produce json
+// @Param page query int false "Page number"
+// @Param page_size query int false "Page size"
+// @Param sort query string false "Sort key (id, cid, company_name, locality_id), prefixed with - for descending order"
+// @Param locality_id query int false "Filter by locality"
+// @Success 200 {object} model.CarrierResponseSwagger
+// @Failure 400 {object} model.ErrorResponseSwagger "Invalid query parameters"
+// @Failure 500 {object} model.ErrorResponseSwagger "Unable to list carriers"
+// @Router /carries [get]
+func (h *CarrierHandler) GetCarriers() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		h.log.Log("CarrierHandler", "INFO", "initializing GetCarriers function")
+
+		params, err := model.ParseListParams(r.URL.Query(), model.CarrierListOptions)
+		if err != nil {
+			h.log.Log("CarrierHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+			response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody(err.Error(), nil))
+
+			return
+		}
+
+		carriers, total, err := h.Srv.Get(r.Context(), params)
+		if err != nil {
+			h.handleError(w, err, "unable to list carriers")
+			return
+		}
+
+		if carriers == nil {
+			carriers = []model.Carries{}
+		}
+
+		h.log.Log("CarrierHandler", "INFO", "GetCarriers completed successfully")
+		response.JSON(w, http.StatusOK, responses.CreatePaginatedResponseBody("", carriers, params.Page, params.PageSize, total))
+	}
+}
+
+// GetCarrierByID returns a carrier.
+// @Summary Get a carrier
+// @Description Returns the carrier with the given ID
+// @Tags Carriers
+// @Produce json
+// @Param id path int true "Carrier ID"
+// @Success 200 {object} model.CarrierResponseSwagger
+// @Failure 400 {object} model.ErrorResponseSwagger "Invalid ID"
+// @Failure 404 {object} model.ErrorResponseSwagger "Carrier not found"
+// @Failure 500 {object} model.ErrorResponseSwagger "Unable to get carrier"
+// @Router /carries/{id} [get]
+func (h *CarrierHandler) GetCarrierByID() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		h.log.Log("CarrierHandler", "INFO", "initializing GetCarrierByID function")
+
+		id, err := strconv.Atoi(chi.URLParam(r, "id"))
+		if err != nil {
+			h.log.Log("CarrierHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+			response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody("invalid id", nil))
+
+			return
+		}
+
+		carrier, err := h.Srv.GetByID(r.Context(), id)
+		if err != nil {
+			h.handleError(w, err, "unable to get carrier")
+			return
+		}
+
+		h.log.Log("CarrierHandler", "INFO", "GetCarrierByID completed successfully")
+		response.JSON(w, http.StatusOK, responses.CreateResponseBody("", carrier))
+	}
+}
+
+// PatchCarriers updates a carrier.
+// @Summary Update a carrier
+// @Description Updates only the fields sent in the body; none of them can be left empty
+// @Tags Carriers
+// @Accept json
+// @Produce json
+// @Param id path int true "Carrier ID"
+// @Param carrier body model.Carries true "Carrier fields to update"
+// @Success 200 {object} model.CarrierResponseSwagger
+// @Failure 400 {object} model.ErrorResponseSwagger "Invalid ID or request body"
+// @Failure 404 {object} model.ErrorResponseSwagger "Carrier or locality not found"
+// @Failure 409 {object} model.ErrorResponseSwagger "CID already exists"
+// @Failure 422 {object} model.ErrorResponseSwagger "Empty fields"
+// @Failure 500 {object} model.ErrorResponseSwagger "Unable to update carrier"
+// @Router /carries/{id} [patch]
+func (h *CarrierHandler) PatchCarriers() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		h.log.Log("CarrierHandler", "INFO", "initializing PatchCarriers function")
+
+		id, err := strconv.Atoi(chi.URLParam(r, "id"))
+		if err != nil {
+			h.log.Log("CarrierHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+			response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody("invalid id", nil))
+
+			return
+		}
+
+		carrier, err := h.Srv.GetByID(r.Context(), id)
+		if err != nil {
+			h.handleError(w, err, "unable to update carrier")
+			return
+		}
+
+		// the body is decoded over the current carrier, so fields left out keep their values
+		if err = json.NewDecoder(r.Body).Decode(&carrier); err != nil {
+			h.log.Log("CarrierHandler", "ERROR", "invalid request body")
+			response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody("invalid request body", nil))
+
+			return
+		}
+
+		carrier.ID = id
+
+		if err = carrier.ValidateEmptyFields(true); err != nil {
+			h.log.Log("CarrierHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+			response.JSON(w, http.StatusUnprocessableEntity, responses.CreateResponseBody(err.Error(), nil))
+
+			return
+		}
+
+		updated, err := h.Srv.PatchCarrier(r.Context(), id, carrier)
+		if err != nil {
+			h.handleError(w, err, "unable to update carrier")
+			return
+		}
+
+		h.log.Log("CarrierHandler", "INFO", "PatchCarriers completed successfully")
+		response.JSON(w, http.StatusOK, responses.CreateResponseBody("", updated))
+	}
+}
+
+// DeleteCarriers removes a carrier.
+// @Summary Delete a carrier
+// @Description Deletes the carrier with the given ID unless other records still reference it
+// @Tags Carriers
+// @Param id path int true "Carrier ID"
+// @Success 204
+// @Failure 400 {object} model.ErrorResponseSwagger "Invalid ID"
+// @Failure 404 {object} model.ErrorResponseSwagger "Carrier not found"
+// @Failure 409 {object} model.ErrorResponseSwagger "Carrier has dependencies"
+// @Failure 500 {object} model.ErrorResponseSwagger "Unable to delete carrier"
+// @Router /carries/{id} [delete]
+func (h *CarrierHandler) DeleteCarriers() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		h.log.Log("CarrierHandler", "INFO", "initializing DeleteCarriers function")
+
+		id, err := strconv.Atoi(chi.URLParam(r, "id"))
+		if err != nil {
+			h.log.Log("CarrierHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+			response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody("invalid id", nil))
+
+			return
+		}
+
+		if err = h.Srv.DeleteCarrier(r.Context(), id); err != nil {
+			h.handleError(w, err, "unable to delete carrier")
+			return
+		}
+
+		h.log.Log("CarrierHandler", "INFO", "DeleteCarriers completed successfully")
+		response.JSON(w, http.StatusNoContent, nil)
+	}
+}
+
+func (h *CarrierHandler) handleError(w http.ResponseWriter, err error, message string) {
+	h.log.Log("CarrierHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+
+	if err, ok := err.(*customerror.CarrierError); ok {
+		response.JSON(w, err.Code, responses.CreateResponseBody(err.Error(), nil))
+		return
+	}
+
+	if strings.Contains(err.Error(), customerror.ErrLocalityNotFound.Error()) {
+		response.JSON(w, http.StatusNotFound, responses.CreateResponseBody(err.Error(), nil))
+		return
+	}
+
+	response.JSON(w, http.StatusInternalServerError, responses.CreateResponseBody(message, nil))
+}
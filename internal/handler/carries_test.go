@@ -13,6 +13,7 @@ import (
 	"github.com/maxwelbm/alkemy-g7.git/internal/model"
 	"github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func setupCarrierHandler(t *testing.T) *handler.CarrierHandler {
@@ -36,7 +37,7 @@ func TestHandlerPostCarriers(t *testing.T) {
 			LocalityID:  1,
 		}
 
-		mockServiceCarrier.On("PostCarrier", carrier).Return(model.Carries{
+		mockServiceCarrier.On("PostCarrier", mock.Anything, carrier).Return(model.Carries{
 			ID:          1,
 			CID:         "CID001",
 			CompanyName: "ABC Company",
@@ -110,7 +111,7 @@ func TestHandlerPostCarriers(t *testing.T) {
 			LocalityID:  1,
 		}
 
-		mockServiceCarrier.On("PostCarrier", carrier).Return(model.Carries{}, customerror.NewCarrierError(customerror.ErrConflict.Error(), "cid", http.StatusConflict))
+		mockServiceCarrier.On("PostCarrier", mock.Anything, carrier).Return(model.Carries{}, customerror.NewCarrierError(customerror.ErrConflict.Error(), "cid", http.StatusConflict))
 
 		reqBody := []byte(`{
 			"cid": "CID001",
@@ -141,7 +142,7 @@ func TestHandlerPostCarriers(t *testing.T) {
 			LocalityID:  99,
 		}
 
-		mockServiceCarrier.On("PostCarrier", carrier).Return(model.Carries{}, customerror.NewCarrierError(customerror.ErrLocalityNotFound.Error(), "locality", http.StatusNotFound))
+		mockServiceCarrier.On("PostCarrier", mock.Anything, carrier).Return(model.Carries{}, customerror.NewCarrierError(customerror.ErrLocalityNotFound.Error(), "locality", http.StatusNotFound))
 
 		reqBody := []byte(`{
 			"cid": "CID001",
@@ -177,7 +178,7 @@ func TestHandlerPostCarriers(t *testing.T) {
 			LocalityID:  1,
 		}
 
-		mockServiceCarrier.On("PostCarrier", carrier).Return(model.Carries{}, errors.New("some unexpected error"))
+		mockServiceCarrier.On("PostCarrier", mock.Anything, carrier).Return(model.Carries{}, errors.New("some unexpected error"))
 
 		reqBody := []byte(`{
 			"cid": "CID001",
@@ -198,3 +199,212 @@ func TestHandlerPostCarriers(t *testing.T) {
 		assert.JSONEq(t, expectedJson, response.Body.String())
 	})
 }
+
+func setupCarrierRouter(t *testing.T) (*mocks.MockICarrierService, *chi.Mux) {
+	hd := setupCarrierHandler(t)
+
+	r := chi.NewRouter()
+	r.Get("/api/v1/carries", hd.GetCarriers())
+	r.Get("/api/v1/carries/{id}", hd.GetCarrierByID())
+	r.Patch("/api/v1/carries/{id}", hd.PatchCarriers())
+	r.Delete("/api/v1/carries/{id}", hd.DeleteCarriers())
+
+	return hd.Srv.(*mocks.MockICarrierService), r
+}
+
+func TestHandlerGetCarriers(t *testing.T) {
+	t.Run("GetCarriers paginated success", func(t *testing.T) {
+		mockServiceCarrier, r := setupCarrierRouter(t)
+
+		params := model.ListParams{Page: 1, PageSize: 1, Sort: "id", Filters: map[string]string{"locality_id": "1"}}
+		mockServiceCarrier.On("Get", mock.Anything, params).Return([]model.Carries{
+			{ID: 1, CID: "CID001", CompanyName: "ABC Company", Address: "123 Main St", Telephone: "1234567890", LocalityID: 1},
+		}, 3, nil)
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/carries?page_size=1&locality_id=1", nil)
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusOK, response.Code)
+		assert.JSONEq(t, `{
+			"data": [{"id": 1, "cid": "CID001", "company_name": "ABC Company", "address": "123 Main St", "telephone": "1234567890", "locality_id": 1}],
+			"pagination": {"page": 1, "page_size": 1, "total_items": 3, "total_pages": 3}
+		}`, response.Body.String())
+	})
+
+	t.Run("GetCarriers invalid sort", func(t *testing.T) {
+		_, r := setupCarrierRouter(t)
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/carries?sort=telephone", nil)
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+	})
+
+	t.Run("GetCarriers internal server error", func(t *testing.T) {
+		mockServiceCarrier, r := setupCarrierRouter(t)
+
+		mockServiceCarrier.On("Get", mock.Anything, mock.Anything).Return(nil, 0, errors.New("unmapped error"))
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/carries", nil)
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusInternalServerError, response.Code)
+		assert.JSONEq(t, `{"message": "unable to list carriers"}`, response.Body.String())
+	})
+}
+
+func TestHandlerGetCarrierByID(t *testing.T) {
+	t.Run("GetCarrierByID success", func(t *testing.T) {
+		mockServiceCarrier, r := setupCarrierRouter(t)
+
+		mockServiceCarrier.On("GetByID", mock.Anything, 1).Return(model.Carries{ID: 1, CID: "CID001", CompanyName: "ABC Company", Address: "123 Main St", Telephone: "1234567890", LocalityID: 1}, nil)
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/carries/1", nil)
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusOK, response.Code)
+		assert.JSONEq(t, `{"data": {"id": 1, "cid": "CID001", "company_name": "ABC Company", "address": "123 Main St", "telephone": "1234567890", "locality_id": 1}}`, response.Body.String())
+	})
+
+	t.Run("GetCarrierByID invalid id", func(t *testing.T) {
+		_, r := setupCarrierRouter(t)
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/carries/abc", nil)
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+		assert.JSONEq(t, `{"message": "invalid id"}`, response.Body.String())
+	})
+
+	t.Run("GetCarrierByID not found", func(t *testing.T) {
+		mockServiceCarrier, r := setupCarrierRouter(t)
+
+		mockServiceCarrier.On("GetByID", mock.Anything, 99).Return(model.Carries{}, customerror.NewCarrierError(customerror.ErrNotFound.Error(), "carrier", http.StatusNotFound))
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/carries/99", nil)
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusNotFound, response.Code)
+		assert.JSONEq(t, `{"message": "carrier, not found"}`, response.Body.String())
+	})
+}
+
+func TestHandlerPatchCarriers(t *testing.T) {
+	existing := model.Carries{ID: 1, CID: "CID001", CompanyName: "ABC Company", Address: "123 Main St", Telephone: "1234567890", LocalityID: 1}
+
+	t.Run("PatchCarriers updates only the fields sent", func(t *testing.T) {
+		mockServiceCarrier, r := setupCarrierRouter(t)
+
+		updated := existing
+		updated.CompanyName = "New Company"
+
+		mockServiceCarrier.On("GetByID", mock.Anything, 1).Return(existing, nil)
+		mockServiceCarrier.On("PatchCarrier", mock.Anything, 1, updated).Return(updated, nil)
+
+		request := httptest.NewRequest(http.MethodPatch, "/api/v1/carries/1", bytes.NewReader([]byte(`{"company_name": "New Company"}`)))
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusOK, response.Code)
+		assert.JSONEq(t, `{"data": {"id": 1, "cid": "CID001", "company_name": "New Company", "address": "123 Main St", "telephone": "1234567890", "locality_id": 1}}`, response.Body.String())
+	})
+
+	t.Run("PatchCarriers empty fields", func(t *testing.T) {
+		mockServiceCarrier, r := setupCarrierRouter(t)
+
+		mockServiceCarrier.On("GetByID", mock.Anything, 1).Return(existing, nil)
+
+		request := httptest.NewRequest(http.MethodPatch, "/api/v1/carries/1", bytes.NewReader([]byte(`{"company_name": "", "telephone": ""}`)))
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, response.Code)
+		assert.JSONEq(t, `{"message": "the following fields are empty: company_name, telephone"}`, response.Body.String())
+		mockServiceCarrier.AssertNotCalled(t, "PatchCarrier", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("PatchCarriers invalid request body", func(t *testing.T) {
+		mockServiceCarrier, r := setupCarrierRouter(t)
+
+		mockServiceCarrier.On("GetByID", mock.Anything, 1).Return(existing, nil)
+
+		request := httptest.NewRequest(http.MethodPatch, "/api/v1/carries/1", bytes.NewReader([]byte(`{"locality_id": "one"}`)))
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+	})
+
+	t.Run("PatchCarriers carrier not found", func(t *testing.T) {
+		mockServiceCarrier, r := setupCarrierRouter(t)
+
+		mockServiceCarrier.On("GetByID", mock.Anything, 99).Return(model.Carries{}, customerror.NewCarrierError(customerror.ErrNotFound.Error(), "carrier", http.StatusNotFound))
+
+		request := httptest.NewRequest(http.MethodPatch, "/api/v1/carries/99", bytes.NewReader([]byte(`{"company_name": "New Company"}`)))
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusNotFound, response.Code)
+	})
+
+	t.Run("PatchCarriers locality not found", func(t *testing.T) {
+		mockServiceCarrier, r := setupCarrierRouter(t)
+
+		updated := existing
+		updated.LocalityID = 99
+
+		mockServiceCarrier.On("GetByID", mock.Anything, 1).Return(existing, nil)
+		mockServiceCarrier.On("PatchCarrier", mock.Anything, 1, updated).Return(model.Carries{}, customerror.ErrLocalityNotFound)
+
+		request := httptest.NewRequest(http.MethodPatch, "/api/v1/carries/1", bytes.NewReader([]byte(`{"locality_id": 99}`)))
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusNotFound, response.Code)
+	})
+}
+
+func TestHandlerDeleteCarriers(t *testing.T) {
+	t.Run("DeleteCarriers success", func(t *testing.T) {
+		mockServiceCarrier, r := setupCarrierRouter(t)
+
+		mockServiceCarrier.On("DeleteCarrier", mock.Anything, 1).Return(nil)
+
+		request := httptest.NewRequest(http.MethodDelete, "/api/v1/carries/1", nil)
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusNoContent, response.Code)
+	})
+
+	t.Run("DeleteCarriers with dependencies", func(t *testing.T) {
+		mockServiceCarrier, r := setupCarrierRouter(t)
+
+		mockServiceCarrier.On("DeleteCarrier", mock.Anything, 1).Return(customerror.NewCarrierError(customerror.ErrDependencies.Error(), "carrier", http.StatusConflict))
+
+		request := httptest.NewRequest(http.MethodDelete, "/api/v1/carries/1", nil)
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusConflict, response.Code)
+		assert.JSONEq(t, `{"message": "carrier, cannot be deleted because there are dependencies"}`, response.Body.String())
+	})
+
+	t.Run("DeleteCarriers internal server error", func(t *testing.T) {
+		mockServiceCarrier, r := setupCarrierRouter(t)
+
+		mockServiceCarrier.On("DeleteCarrier", mock.Anything, 1).Return(errors.New("unmapped error"))
+
+		request := httptest.NewRequest(http.MethodDelete, "/api/v1/carries/1", nil)
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusInternalServerError, response.Code)
+	})
+}
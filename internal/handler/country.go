@@ -0,0 +1,255 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/bootcamp-go/web/response"
+	"github.com/go-chi/chi/v5"
+	"github.com/maxwelbm/alkemy-g7.git/internal/handler/responses"
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+	"github.com/maxwelbm/alkemy-g7.git/internal/service/interfaces"
+	"github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
+	"github.com/maxwelbm/alkemy-g7.git/pkg/logger"
+)
+
+type CountryHandler struct {
+	Svc interfaces.ICountryService
+	log logger.Logger
+}
+
+func NewCountryHandler(svc interfaces.ICountryService, log logger.Logger) *CountryHandler {
+	return &CountryHandler{Svc: svc, log: log}
+}
+
+// GetAll lists the countries.
+// @Summary List countries
+// @Description Returns the countries, paginated
+// @Tags Country
+// @Produce json
+// @Param page query int false "Page number"
+// @Param page_size query int false "Page size"
+// @Param sort query string false "Sort key (id, country_name), prefixed with - for descending order"
+// @Success 200 {object} model.CountryResponseSwagger
+// @Failure 400 {object} model.ErrorResponseSwagger "Invalid query parameters"
+// @Failure 500 {object} model.ErrorResponseSwagger "Unable to list countries"
+// @Router /countries [get]
+func (h *CountryHandler) GetAll(w http.ResponseWriter, r *http.Request) {
+	h.log.Log("CountryHandler", "INFO", "initializing GetAll function")
+
+	params, err := model.ParseListParams(r.URL.Query(), model.CountryListOptions)
+	if err != nil {
+		response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody(err.Error(), nil))
+		h.log.Log("CountryHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	countries, total, err := h.Svc.GetAll(r.Context(), params)
+	if err != nil {
+		h.handleError(w, err, "unable to list countries")
+		return
+	}
+
+	if countries == nil {
+		countries = []model.Country{}
+	}
+
+	response.JSON(w, http.StatusOK, responses.CreatePaginatedResponseBody("", countries, params.Page, params.PageSize, total))
+	h.log.Log("CountryHandler", "INFO", "returning countries")
+}
+
+// GetByID retrieves a country by its ID.
+// @Summary Retrieve a country
+// @Description Fetch the country identified by the provided ID
+// @Tags Country
+// @Produce json
+// @Param id path int true "Country ID"
+// @Success 200 {object} model.CountryResponseSwagger{data=model.Country}
+// @Failure 400 {object} model.ErrorResponseSwagger "Invalid ID"
+// @Failure 404 {object} model.ErrorResponseSwagger "Country not found"
+// @Failure 500 {object} model.ErrorResponseSwagger "Unable to search for country"
+// @Router /countries/{id} [get]
+func (h *CountryHandler) GetByID(w http.ResponseWriter, r *http.Request) {
+	h.log.Log("CountryHandler", "INFO", "initializing GetByID function")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody("invalid id", nil))
+		h.log.Log("CountryHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	country, err := h.Svc.GetByID(r.Context(), id)
+	if err != nil {
+		h.handleError(w, err, "unable to search for country")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, responses.CreateResponseBody("", country))
+	h.log.Log("CountryHandler", "INFO", fmt.Sprintf("returning country with id %d", id))
+}
+
+// Create creates a new country.
+// @Summary Create a new country
+// @Description This endpoint allows for creating a new country; country names are unique.
+// @Tags Country
+// @Accept json
+// @Produce json
+// @Param country body model.Country true "Country information"
+// @Success 201 {object} model.CountryResponseSwagger{data=model.Country}
+// @Failure 409 {object} model.ErrorResponseSwagger "Country already exists"
+// @Failure 422 {object} model.ErrorResponseSwagger "Invalid input"
+// @Failure 500 {object} model.ErrorResponseSwagger "Unable to create country"
+// @Router /countries [post]
+func (h *CountryHandler) Create(w http.ResponseWriter, r *http.Request) {
+	h.log.Log("CountryHandler", "INFO", "initializing Create function")
+
+	var body model.Country
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		response.JSON(w, http.StatusUnprocessableEntity, responses.CreateResponseBody("invalid json syntax", nil))
+		h.log.Log("CountryHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	country, err := h.Svc.Create(r.Context(), body)
+	if err != nil {
+		h.handleError(w, err, "unable to create country")
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, responses.CreateResponseBody("", country))
+	h.log.Log("CountryHandler", "INFO", "country created successfully")
+}
+
+// Update updates an existing country.
+// @Summary Update a country
+// @Description This endpoint allows for renaming the country identified by the provided ID.
+// @Tags Country
+// @Accept json
+// @Produce json
+// @Param id path int true "Country ID"
+// @Param country body model.Country true "Country information"
+// @Success 200 {object} model.CountryResponseSwagger{data=model.Country}
+// @Failure 400 {object} model.ErrorResponseSwagger "Invalid ID"
+// @Failure 404 {object} model.ErrorResponseSwagger "Country not found"
+// @Failure 409 {object} model.ErrorResponseSwagger "Country already exists"
+// @Failure 422 {object} model.ErrorResponseSwagger "Invalid input"
+// @Failure 500 {object} model.ErrorResponseSwagger "Unable to update country"
+// @Router /countries/{id} [patch]
+func (h *CountryHandler) Update(w http.ResponseWriter, r *http.Request) {
+	h.log.Log("CountryHandler", "INFO", "initializing Update function")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody("invalid id", nil))
+		h.log.Log("CountryHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	var body model.Country
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		response.JSON(w, http.StatusUnprocessableEntity, responses.CreateResponseBody("invalid json syntax", nil))
+		h.log.Log("CountryHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	country, err := h.Svc.Update(r.Context(), id, body)
+	if err != nil {
+		h.handleError(w, err, "unable to update country")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, responses.CreateResponseBody("", country))
+	h.log.Log("CountryHandler", "INFO", fmt.Sprintf("country with id %d updated successfully", id))
+}
+
+// Delete deletes a country by its ID.
+// @Summary Delete a country
+// @Description Deletes the country identified by the provided ID, as long as no province belongs to it.
+// @Tags Country
+// @Param id path int true "Country ID"
+// @Success 204 {object} nil "Country successfully deleted"
+// @Failure 400 {object} model.ErrorResponseSwagger "Invalid ID"
+// @Failure 404 {object} model.ErrorResponseSwagger "Country not found"
+// @Failure 409 {object} model.ErrorResponseSwagger "Country has provinces"
+// @Failure 500 {object} model.ErrorResponseSwagger "Unable to delete country"
+// @Router /countries/{id} [delete]
+func (h *CountryHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	h.log.Log("CountryHandler", "INFO", "initializing Delete function")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody("invalid id", nil))
+		h.log.Log("CountryHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	if err := h.Svc.Delete(r.Context(), id); err != nil {
+		h.handleError(w, err, "unable to delete country")
+		return
+	}
+
+	response.JSON(w, http.StatusNoContent, nil)
+	h.log.Log("CountryHandler", "INFO", fmt.Sprintf("country with id %d deleted successfully", id))
+}
+
+// GetReport reports the provinces, localities, sellers and carriers of each country.
+// @Summary Country localities report
+// @Description Rolls the sellers and carriers of every locality up to its country, or for a single country when id is given.
+// @Tags Country
+// @Produce json
+// @Param id query int false "Country ID"
+// @Success 200 {object} model.CountryReportResponseSwagger
+// @Failure 400 {object} model.ErrorResponseSwagger "Invalid ID"
+// @Failure 404 {object} model.ErrorResponseSwagger "Country not found"
+// @Failure 500 {object} model.ErrorResponseSwagger "Unable to build the country report"
+// @Router /countries/reportLocalities [get]
+func (h *CountryHandler) GetReport(w http.ResponseWriter, r *http.Request) {
+	h.log.Log("CountryHandler", "INFO", "initializing GetReport function")
+
+	id := 0
+
+	if idStr := r.URL.Query().Get("id"); idStr != "" {
+		var err error
+
+		id, err = strconv.Atoi(idStr)
+		if err != nil || id <= 0 {
+			response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody("invalid id", nil))
+			h.log.Log("CountryHandler", "ERROR", fmt.Sprintf("Error: invalid id %s", idStr))
+
+			return
+		}
+	}
+
+	report, err := h.Svc.GetReport(r.Context(), id)
+	if err != nil {
+		h.handleError(w, err, "unable to build the country report")
+		return
+	}
+
+	if report == nil {
+		report = []model.CountryReport{}
+	}
+
+	response.JSON(w, http.StatusOK, responses.CreateResponseBody("", report))
+	h.log.Log("CountryHandler", "INFO", "returning country report")
+}
+
+func (h *CountryHandler) handleError(w http.ResponseWriter, err error, message string) {
+	h.log.Log("CountryHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+
+	if err, ok := err.(*customerror.GenericError); ok {
+		response.JSON(w, err.Code, responses.CreateResponseBody(err.Error(), nil))
+		return
+	}
+
+	response.JSON(w, http.StatusInternalServerError, responses.CreateResponseBody(message, nil))
+}
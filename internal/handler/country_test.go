@@ -0,0 +1,221 @@
+package handler_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/maxwelbm/alkemy-g7.git/internal/handler"
+	"github.com/maxwelbm/alkemy-g7.git/internal/mocks"
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+	"github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func setupCountryRouter(t *testing.T) (*mocks.MockICountryService, *chi.Mux) {
+	srv := mocks.NewMockICountryService(t)
+	hd := handler.NewCountryHandler(srv, logMock)
+
+	r := chi.NewRouter()
+	r.Get("/api/v1/countries", hd.GetAll)
+	r.Get("/api/v1/countries/reportLocalities", hd.GetReport)
+	r.Get("/api/v1/countries/{id}", hd.GetByID)
+	r.Post("/api/v1/countries", hd.Create)
+	r.Patch("/api/v1/countries/{id}", hd.Update)
+	r.Delete("/api/v1/countries/{id}", hd.Delete)
+
+	return srv, r
+}
+
+func TestCountryHandler_GetAll(t *testing.T) {
+	t.Run("should return 200 with the sorted countries", func(t *testing.T) {
+		srv, r := setupCountryRouter(t)
+		params := model.ListParams{Page: 1, PageSize: 20, Sort: "country_name", Filters: map[string]string{}}
+
+		srv.On("GetAll", mock.Anything, params).Return([]model.Country{{ID: 1, Name: "Argentina"}, {ID: 2, Name: "Brazil"}}, 2, nil).Once()
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/countries?sort=country_name", nil)
+		res := httptest.NewRecorder()
+		r.ServeHTTP(res, req)
+
+		expected := `{"data":[{"id":1,"country_name":"Argentina"},{"id":2,"country_name":"Brazil"}],"pagination":{"page":1,"page_size":20,"total_items":2,"total_pages":1}}`
+
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.JSONEq(t, expected, res.Body.String())
+	})
+
+	t.Run("should return 400 bad request for an unknown sort", func(t *testing.T) {
+		_, r := setupCountryRouter(t)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/countries?sort=population", nil)
+		res := httptest.NewRecorder()
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+}
+
+func TestCountryHandler_GetByID(t *testing.T) {
+	t.Run("should return 200 with the country", func(t *testing.T) {
+		srv, r := setupCountryRouter(t)
+		srv.On("GetByID", mock.Anything, 1).Return(model.Country{ID: 1, Name: "Argentina"}, nil).Once()
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/countries/1", nil)
+		res := httptest.NewRecorder()
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.JSONEq(t, `{"data":{"id":1,"country_name":"Argentina"}}`, res.Body.String())
+	})
+
+	t.Run("should return 400 bad request for an invalid id", func(t *testing.T) {
+		_, r := setupCountryRouter(t)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/countries/abc", nil)
+		res := httptest.NewRecorder()
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+		assert.JSONEq(t, `{"message":"invalid id"}`, res.Body.String())
+	})
+
+	t.Run("should return 404 not found when the country does not exist", func(t *testing.T) {
+		srv, r := setupCountryRouter(t)
+		srv.On("GetByID", mock.Anything, 99).Return(model.Country{}, customerror.HandleError("country", customerror.ErrorNotFound, "")).Once()
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/countries/99", nil)
+		res := httptest.NewRecorder()
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusNotFound, res.Code)
+		assert.JSONEq(t, `{"message":"country not found"}`, res.Body.String())
+	})
+}
+
+func TestCountryHandler_Create(t *testing.T) {
+	t.Run("should return 201 with the created country", func(t *testing.T) {
+		srv, r := setupCountryRouter(t)
+		srv.On("Create", mock.Anything, model.Country{Name: "Chile"}).Return(model.Country{ID: 3, Name: "Chile"}, nil).Once()
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/countries", strings.NewReader(`{"country_name":"Chile"}`))
+		res := httptest.NewRecorder()
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusCreated, res.Code)
+		assert.JSONEq(t, `{"data":{"id":3,"country_name":"Chile"}}`, res.Body.String())
+	})
+
+	t.Run("should return 422 unprocessable entity for an invalid json", func(t *testing.T) {
+		_, r := setupCountryRouter(t)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/countries", strings.NewReader(`{"country_name":`))
+		res := httptest.NewRecorder()
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, res.Code)
+		assert.JSONEq(t, `{"message":"invalid json syntax"}`, res.Body.String())
+	})
+
+	t.Run("should return 409 conflict when the country already exists", func(t *testing.T) {
+		srv, r := setupCountryRouter(t)
+		srv.On("Create", mock.Anything, model.Country{Name: "Chile"}).Return(model.Country{}, customerror.HandleError("country", customerror.ErrorConflict, "")).Once()
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/countries", strings.NewReader(`{"country_name":"Chile"}`))
+		res := httptest.NewRecorder()
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusConflict, res.Code)
+		assert.JSONEq(t, `{"message":"country it already exists"}`, res.Body.String())
+	})
+}
+
+func TestCountryHandler_Update(t *testing.T) {
+	srv, r := setupCountryRouter(t)
+	srv.On("Update", mock.Anything, 2, model.Country{Name: "Brazil"}).Return(model.Country{ID: 2, Name: "Brazil"}, nil).Once()
+
+	req := httptest.NewRequest(http.MethodPatch, "/api/v1/countries/2", strings.NewReader(`{"country_name":"Brazil"}`))
+	res := httptest.NewRecorder()
+	r.ServeHTTP(res, req)
+
+	assert.Equal(t, http.StatusOK, res.Code)
+	assert.JSONEq(t, `{"data":{"id":2,"country_name":"Brazil"}}`, res.Body.String())
+}
+
+func TestCountryHandler_Delete(t *testing.T) {
+	t.Run("should return 204 no content", func(t *testing.T) {
+		srv, r := setupCountryRouter(t)
+		srv.On("Delete", mock.Anything, 1).Return(nil).Once()
+
+		req := httptest.NewRequest(http.MethodDelete, "/api/v1/countries/1", nil)
+		res := httptest.NewRecorder()
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusNoContent, res.Code)
+	})
+
+	t.Run("should return 409 conflict when the country has provinces", func(t *testing.T) {
+		srv, r := setupCountryRouter(t)
+		srv.On("Delete", mock.Anything, 1).Return(customerror.HandleError("country", customerror.ErrorDep, "")).Once()
+
+		req := httptest.NewRequest(http.MethodDelete, "/api/v1/countries/1", nil)
+		res := httptest.NewRecorder()
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusConflict, res.Code)
+		assert.JSONEq(t, `{"message":"country cannot be deleted because there are dependencies"}`, res.Body.String())
+	})
+
+	t.Run("should return 500 internal error in case of unexpected error", func(t *testing.T) {
+		srv, r := setupCountryRouter(t)
+		srv.On("Delete", mock.Anything, 1).Return(errors.New("unexpected error")).Once()
+
+		req := httptest.NewRequest(http.MethodDelete, "/api/v1/countries/1", nil)
+		res := httptest.NewRecorder()
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusInternalServerError, res.Code)
+		assert.JSONEq(t, `{"message":"unable to delete country"}`, res.Body.String())
+	})
+}
+
+func TestCountryHandler_GetReport(t *testing.T) {
+	t.Run("should return 200 with the report of every country", func(t *testing.T) {
+		srv, r := setupCountryRouter(t)
+		srv.On("GetReport", mock.Anything, 0).Return([]model.CountryReport{{ID: 1, Name: "Argentina", ProvincesCount: 2, LocalitiesCount: 3, SellersCount: 4, CarriersCount: 1}}, nil).Once()
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/countries/reportLocalities", nil)
+		res := httptest.NewRecorder()
+		r.ServeHTTP(res, req)
+
+		expected := `{"data":[{"country_id":1,"country_name":"Argentina","provinces_count":2,"localities_count":3,"sellers_count":4,"carriers_count":1}]}`
+
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.JSONEq(t, expected, res.Body.String())
+	})
+
+	t.Run("should return 400 bad request for an invalid id", func(t *testing.T) {
+		_, r := setupCountryRouter(t)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/countries/reportLocalities?id=-1", nil)
+		res := httptest.NewRecorder()
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+		assert.JSONEq(t, `{"message":"invalid id"}`, res.Body.String())
+	})
+
+	t.Run("should return 404 not found when the country does not exist", func(t *testing.T) {
+		srv, r := setupCountryRouter(t)
+		srv.On("GetReport", mock.Anything, 99).Return(nil, customerror.HandleError("country", customerror.ErrorNotFound, "")).Once()
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/countries/reportLocalities?id=99", nil)
+		res := httptest.NewRecorder()
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusNotFound, res.Code)
+	})
+}
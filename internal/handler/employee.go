@@ -55,14 +55,28 @@ func CreateEmployeeHandler(service interfaces.IEmployeeService, log logger.Logge
 // @Description Fetch all registered employees from the database
 // @Tags Employee
 // @Produce json
+// @Param page query int false "Page number, starting at 1"
+// @Param page_size query int false "Items per page (max 100)"
+// @Param sort query string false "Sort field, prefix with - for descending"
+// @Param warehouse_id query int false "Warehouse ID"
 // @Success 200 {object} handler.EmployeeJSON
+// @Failure 400 {object} model.ErrorResponseSwagger "Invalid pagination, sort or filter parameter"
 // @Failure 404 {object} model.ErrorResponseSwagger "Employee not found"
 // @Failure 500 {object} model.ErrorResponseSwagger "Unable to retrieve employee"
 // @Router /employees [get]
 func (e *EmployeeHandler) GetEmployeesHandler(w http.ResponseWriter, r *http.Request) {
 	e.log.Log("EmployeeHandler", "INFO", "initializing GetEmployeesHandler")
 
-	data, err := e.sv.GetEmployees()
+	params, err := model.ParseListParams(r.URL.Query(), model.EmployeeListOptions)
+
+	if err != nil {
+		e.log.Log("EmployeeHandler", "ERROR", fmt.Sprintf("invalid list parameters: %v", err))
+		response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody(err.Error(), nil))
+
+		return
+	}
+
+	data, total, err := e.sv.GetEmployees(r.Context(), params)
 
 	if err != nil {
 		e.log.Log("EmployeeHandler", "ERROR", fmt.Sprintf("failed to retrieve employees: %v", err))
@@ -90,7 +104,7 @@ func (e *EmployeeHandler) GetEmployeesHandler(w http.ResponseWriter, r *http.Req
 	}
 
 	e.log.Log("EmployeeHandler", "INFO", "GetEmployeesHandler finished successfully")
-	response.JSON(w, http.StatusOK, responses.CreateResponseBody("", employeesJSON))
+	response.JSON(w, http.StatusOK, responses.CreatePaginatedResponseBody("", employeesJSON, params.Page, params.PageSize, total))
 }
 
 // GetEmployeeByID retrieves a single employee by ID.
@@ -116,7 +130,7 @@ func (e *EmployeeHandler) GetEmployeeByID(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	data, err := e.sv.GetEmployeeByID(id)
+	data, err := e.sv.GetEmployeeByID(r.Context(), id)
 
 	if err != nil {
 		e.log.Log("EmployeeHandler", "ERROR", fmt.Sprintf("failed to retrieve employee with ID %d: %v", id, err))
@@ -164,7 +178,7 @@ func (e *EmployeeHandler) InsertEmployee(w http.ResponseWriter, r *http.Request)
 
 	employee := newEmployee.toEmployeeEntity()
 
-	data, err := e.sv.InsertEmployee(*employee)
+	data, err := e.sv.InsertEmployee(r.Context(), *employee)
 
 	if err != nil {
 		e.log.Log("EmployeeHandler", "ERROR", fmt.Sprintf("failed to insert employee: %v", err))
@@ -225,7 +239,7 @@ func (e *EmployeeHandler) UpdateEmployee(w http.ResponseWriter, r *http.Request)
 
 	employee := *reqBody.toEmployeeEntity()
 
-	updatedEmployee, err := e.sv.UpdateEmployee(id, employee)
+	updatedEmployee, err := e.sv.UpdateEmployee(r.Context(), id, employee)
 
 	if err != nil {
 		e.log.Log("EmployeeHandler", "ERROR", fmt.Sprintf("failed to update employee with ID %d: %v", id, err))
@@ -268,7 +282,7 @@ func (e *EmployeeHandler) DeleteEmployee(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	err = e.sv.DeleteEmployee(id)
+	err = e.sv.DeleteEmployee(r.Context(), id)
 
 	if err != nil {
 		e.log.Log("EmployeeHandler", "ERROR", fmt.Sprintf("failed to delete employee with ID %d: %v", id, err))
@@ -304,7 +318,7 @@ func (e *EmployeeHandler) GetInboundOrdersReports(w http.ResponseWriter, r *http
 	id := r.URL.Query().Get("id")
 
 	if id == "" {
-		data, err := e.sv.GetInboundOrdersReports()
+		data, err := e.sv.GetInboundOrdersReports(r.Context())
 
 		if err != nil {
 			e.log.Log("EmployeeHandler", "ERROR", fmt.Sprintf("failed to retrieve inbound orders reports: %v", err))
@@ -334,7 +348,7 @@ func (e *EmployeeHandler) GetInboundOrdersReports(w http.ResponseWriter, r *http
 		return
 	}
 
-	data, err := e.sv.GetInboundOrdersReportByEmployee(idInt)
+	data, err := e.sv.GetInboundOrdersReportByEmployee(r.Context(), idInt)
 
 	if err != nil {
 		e.log.Log("EmployeeHandler", "ERROR", fmt.Sprintf("failed to retrieve inbound orders report for employee ID %d: %v", idInt, err))
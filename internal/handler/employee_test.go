@@ -23,21 +23,21 @@ func TestGetEmployeesHandler(t *testing.T) {
 	employeeHd := handler.CreateEmployeeHandler(srv, logMock)
 
 	t.Run("should return a list of employees", func(t *testing.T) {
-		srv.On("GetEmployees", mock.Anything).Return([]model.Employee{
+		srv.On("GetEmployees", mock.Anything, mock.Anything).Return([]model.Employee{
 			{ID: 1, CardNumberID: "1", FirstName: "John", LastName: "Cena", WarehouseID: 1},
-			{ID: 2, CardNumberID: "2", FirstName: "Martha", LastName: "Piana", WarehouseID: 2}}, nil).Once()
+			{ID: 2, CardNumberID: "2", FirstName: "Martha", LastName: "Piana", WarehouseID: 2}}, 2, nil).Once()
 
 		req := httptest.NewRequest("GET", "/api/v1/employees", nil)
 		res := httptest.NewRecorder()
 
 		employeeHd.GetEmployeesHandler(res, req)
-		expected := `{"data":[{"id":1,"card_number_id":"1","first_name":"John","last_name":"Cena","warehouse_id":1},{"id":2,"card_number_id":"2","first_name":"Martha","last_name":"Piana","warehouse_id":2}]}`
+		expected := `{"data":[{"id":1,"card_number_id":"1","first_name":"John","last_name":"Cena","warehouse_id":1},{"id":2,"card_number_id":"2","first_name":"Martha","last_name":"Piana","warehouse_id":2}],"pagination":{"page":1,"page_size":20,"total_items":2,"total_pages":1}}`
 		assert.Equal(t, res.Code, http.StatusOK)
 		assert.JSONEq(t, res.Body.String(), expected)
 	})
 
 	t.Run("should return 500 internal error in case of unexpected error", func(t *testing.T) {
-		srv.On("GetEmployees", mock.Anything).Return([]model.Employee{}, errors.New("something went wrong")).Once()
+		srv.On("GetEmployees", mock.Anything, mock.Anything).Return([]model.Employee{}, 0, errors.New("something went wrong")).Once()
 
 		req := httptest.NewRequest("GET", "/api/v1/employees", nil)
 		res := httptest.NewRecorder()
@@ -49,7 +49,7 @@ func TestGetEmployeesHandler(t *testing.T) {
 	})
 
 	t.Run("should return error in case of expected error", func(t *testing.T) {
-		srv.On("GetEmployees", mock.Anything).Return([]model.Employee{}, customerror.EmployeeErrNotFound).Once()
+		srv.On("GetEmployees", mock.Anything, mock.Anything).Return([]model.Employee{}, 0, customerror.EmployeeErrNotFound).Once()
 
 		req := httptest.NewRequest("GET", "/api/v1/employees", nil)
 		res := httptest.NewRecorder()
@@ -60,6 +60,17 @@ func TestGetEmployeesHandler(t *testing.T) {
 		assert.Equal(t, res.Code, http.StatusNotFound)
 		assert.Equal(t, res.Body.String(), expected)
 	})
+
+	t.Run("should return 400 bad request in case of invalid page size", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/v1/employees?page_size=500", nil)
+		res := httptest.NewRecorder()
+
+		employeeHd.GetEmployeesHandler(res, req)
+
+		expected := `{"message":"invalid page_size: 500, must be between 1 and 100"}`
+		assert.Equal(t, res.Code, http.StatusBadRequest)
+		assert.JSONEq(t, res.Body.String(), expected)
+	})
 }
 
 func TestGetEmployeeById(t *testing.T) {
@@ -70,7 +81,7 @@ func TestGetEmployeeById(t *testing.T) {
 	r.Get("/api/v1/employees/{id}", employeeHd.GetEmployeeByID)
 
 	t.Run("should return the employee requested and 200 ok", func(t *testing.T) {
-		srv.On("GetEmployeeByID", mock.Anything).Return(model.Employee{ID: 1, CardNumberID: "1", FirstName: "John", LastName: "Cena", WarehouseID: 1}, nil).Once()
+		srv.On("GetEmployeeByID", mock.Anything, mock.Anything).Return(model.Employee{ID: 1, CardNumberID: "1", FirstName: "John", LastName: "Cena", WarehouseID: 1}, nil).Once()
 		req := httptest.NewRequest("GET", "/api/v1/employees/1", nil)
 		res := httptest.NewRecorder()
 
@@ -82,7 +93,7 @@ func TestGetEmployeeById(t *testing.T) {
 	})
 
 	t.Run("should return a not found when employee id not exists", func(t *testing.T) {
-		srv.On("GetEmployeeByID", mock.Anything).Return(model.Employee{}, customerror.EmployeeErrNotFound).Once()
+		srv.On("GetEmployeeByID", mock.Anything, mock.Anything).Return(model.Employee{}, customerror.EmployeeErrNotFound).Once()
 
 		r.Get("/api/v1/employees/{id}", employeeHd.GetEmployeeByID)
 
@@ -109,7 +120,7 @@ func TestGetEmployeeById(t *testing.T) {
 	})
 
 	t.Run("should return an error in case of unexpected error", func(t *testing.T) {
-		srv.On("GetEmployeeByID", mock.Anything).Return(model.Employee{}, errors.New("unexpected error")).Once()
+		srv.On("GetEmployeeByID", mock.Anything, mock.Anything).Return(model.Employee{}, errors.New("unexpected error")).Once()
 
 		req := httptest.NewRequest("GET", "/api/v1/employees/1", nil)
 		res := httptest.NewRecorder()
@@ -154,7 +165,7 @@ func TestInsertEmployee(t *testing.T) {
 			LastName:     "Makhachev",
 			WarehouseID:  1,
 		}
-		srv.On("InsertEmployee", mock.Anything).Return(mockEmployee, nil).Once()
+		srv.On("InsertEmployee", mock.Anything, mock.Anything).Return(mockEmployee, nil).Once()
 		req := createRequest(string(employeeJSON))
 		res := httptest.NewRecorder()
 
@@ -167,7 +178,7 @@ func TestInsertEmployee(t *testing.T) {
 	})
 
 	t.Run("should return 422 unprocessable entity when the input is missing fields", func(t *testing.T) {
-		srv.On("InsertEmployee", mock.Anything).Return(model.Employee{}, customerror.EmployeeErrInvalid).Once()
+		srv.On("InsertEmployee", mock.Anything, mock.Anything).Return(model.Employee{}, customerror.EmployeeErrInvalid).Once()
 		newEmployee := `
 		{
 			"first_name": "islam"
@@ -185,7 +196,7 @@ func TestInsertEmployee(t *testing.T) {
 	})
 
 	t.Run("should return 409 conflict when cardnumberid already exists", func(t *testing.T) {
-		srv.On("InsertEmployee", mock.Anything).Return(model.Employee{}, customerror.EmployeeErrDuplicatedCardNumber).Once()
+		srv.On("InsertEmployee", mock.Anything, mock.Anything).Return(model.Employee{}, customerror.EmployeeErrDuplicatedCardNumber).Once()
 
 		req := createRequest(string(employeeJSON))
 		res := httptest.NewRecorder()
@@ -212,7 +223,7 @@ func TestInsertEmployee(t *testing.T) {
 	})
 
 	t.Run("should return 500 internal error in case of unexpected error", func(t *testing.T) {
-		srv.On("InsertEmployee", mock.Anything).Return(model.Employee{}, errors.New("unexpected error")).Once()
+		srv.On("InsertEmployee", mock.Anything, mock.Anything).Return(model.Employee{}, errors.New("unexpected error")).Once()
 
 		req := createRequest(string(employeeJSON))
 		res := httptest.NewRecorder()
@@ -242,7 +253,7 @@ func TestUpdateEmployee(t *testing.T) {
 	}
 	`
 	t.Run("should return 200 ok and the employee with the new data", func(t *testing.T) {
-		srv.On("UpdateEmployee", mock.Anything, mock.Anything).Return(model.Employee{ID: 1, CardNumberID: "1", FirstName: "Miguel", LastName: "Cena", WarehouseID: 1}, nil).Once()
+		srv.On("UpdateEmployee", mock.Anything, mock.Anything, mock.Anything).Return(model.Employee{ID: 1, CardNumberID: "1", FirstName: "Miguel", LastName: "Cena", WarehouseID: 1}, nil).Once()
 
 		req := updateRequest(newEmployee)
 		res := httptest.NewRecorder()
@@ -256,7 +267,7 @@ func TestUpdateEmployee(t *testing.T) {
 	})
 
 	t.Run("should return 404 not found when employee not found", func(t *testing.T) {
-		srv.On("UpdateEmployee", mock.Anything, mock.Anything).Return(model.Employee{}, customerror.EmployeeErrNotFound).Once()
+		srv.On("UpdateEmployee", mock.Anything, mock.Anything, mock.Anything).Return(model.Employee{}, customerror.EmployeeErrNotFound).Once()
 
 		r.Patch("/api/v1/employees/{id}", employeeHd.UpdateEmployee)
 
@@ -297,7 +308,7 @@ func TestUpdateEmployee(t *testing.T) {
 	})
 
 	t.Run("should return 500 internal error in case of unexpected error", func(t *testing.T) {
-		srv.On("UpdateEmployee", mock.Anything, mock.Anything).Return(model.Employee{}, errors.New("unexpected error")).Once()
+		srv.On("UpdateEmployee", mock.Anything, mock.Anything, mock.Anything).Return(model.Employee{}, errors.New("unexpected error")).Once()
 
 		req := updateRequest(newEmployee)
 		res := httptest.NewRecorder()
@@ -320,7 +331,7 @@ func TestDeleteEmployee(t *testing.T) {
 	r.Delete("/api/v1/employees/{id}", employeeHd.DeleteEmployee)
 
 	t.Run("should return 204 no content when delete with success", func(t *testing.T) {
-		srv.On("DeleteEmployee", mock.Anything).Return(nil).Once()
+		srv.On("DeleteEmployee", mock.Anything, mock.Anything).Return(nil).Once()
 
 		req := httptest.NewRequest("DELETE", "/api/v1/employees/2", nil)
 		res := httptest.NewRecorder()
@@ -333,7 +344,7 @@ func TestDeleteEmployee(t *testing.T) {
 	})
 
 	t.Run("should return 404 not found when employee id does not exist", func(t *testing.T) {
-		srv.On("DeleteEmployee", mock.Anything).Return(customerror.EmployeeErrNotFound).Once()
+		srv.On("DeleteEmployee", mock.Anything, mock.Anything).Return(customerror.EmployeeErrNotFound).Once()
 
 		r.Delete("/api/v1/employees/{id}", employeeHd.DeleteEmployee)
 
@@ -361,7 +372,7 @@ func TestDeleteEmployee(t *testing.T) {
 	})
 
 	t.Run("should return 500 internal error in case of unexpected error", func(t *testing.T) {
-		srv.On("DeleteEmployee", mock.Anything).Return(errors.New("unexpected")).Once()
+		srv.On("DeleteEmployee", mock.Anything, mock.Anything).Return(errors.New("unexpected")).Once()
 
 		req := httptest.NewRequest("DELETE", "/api/v1/employees/1", nil)
 		res := httptest.NewRecorder()
@@ -385,7 +396,7 @@ func TestGetInboundOrdersReports(t *testing.T) {
 	employeeHd := handler.CreateEmployeeHandler(srv, logMock)
 
 	t.Run("should return 200 OK and reports when no ID is provided", func(t *testing.T) {
-		srv.On("GetInboundOrdersReports").Return([]model.InboundOrdersReportByEmployee{
+		srv.On("GetInboundOrdersReports", mock.Anything).Return([]model.InboundOrdersReportByEmployee{
 			{ID: 1, CardNumberID: "#123", FirstName: "Jon", LastName: "Jones", WarehouseID: 1, InboundOrdersCount: 20},
 			{ID: 2, CardNumberID: "#456", FirstName: "Islam", LastName: "Makachev", WarehouseID: 6, InboundOrdersCount: 26},
 		}, nil).Once()
@@ -432,7 +443,7 @@ func TestGetInboundOrdersReports(t *testing.T) {
 	})
 
 	t.Run("should return error in case of expected error without ID", func(t *testing.T) {
-		srv.On("GetInboundOrdersReports").Return(nil, customerror.EmployeeErrNotFoundInboundOrders).Once()
+		srv.On("GetInboundOrdersReports", mock.Anything).Return(nil, customerror.EmployeeErrNotFoundInboundOrders).Once()
 
 		req := createRequest("")
 		res := httptest.NewRecorder()
@@ -446,7 +457,7 @@ func TestGetInboundOrdersReports(t *testing.T) {
 	})
 
 	t.Run("should return 500 internal server error when service fails without ID", func(t *testing.T) {
-		srv.On("GetInboundOrdersReports", mock.Anything).Return(nil, errors.New("something went wrong")).Once()
+		srv.On("GetInboundOrdersReports", mock.Anything, mock.Anything).Return(nil, errors.New("something went wrong")).Once()
 
 		req := createRequest("")
 		res := httptest.NewRecorder()
@@ -460,7 +471,7 @@ func TestGetInboundOrdersReports(t *testing.T) {
 
 	t.Run("should return 200 OK and reports for valid employee ID", func(t *testing.T) {
 		id := 1
-		srv.On("GetInboundOrdersReportByEmployee", id).Return(model.InboundOrdersReportByEmployee{ID: 1, CardNumberID: "#123", FirstName: "Jon", LastName: "Jones", WarehouseID: 1, InboundOrdersCount: 20}, nil).Once()
+		srv.On("GetInboundOrdersReportByEmployee", mock.Anything, id).Return(model.InboundOrdersReportByEmployee{ID: 1, CardNumberID: "#123", FirstName: "Jon", LastName: "Jones", WarehouseID: 1, InboundOrdersCount: 20}, nil).Once()
 
 		req := createRequest(strconv.Itoa(id))
 		res := httptest.NewRecorder()
@@ -474,7 +485,7 @@ func TestGetInboundOrdersReports(t *testing.T) {
 
 	t.Run("should return 500 internal server error when getting report by employee fails", func(t *testing.T) {
 		id := 1
-		srv.On("GetInboundOrdersReportByEmployee", id).Return(model.InboundOrdersReportByEmployee{}, errors.New("something went wrong")).Once()
+		srv.On("GetInboundOrdersReportByEmployee", mock.Anything, id).Return(model.InboundOrdersReportByEmployee{}, errors.New("something went wrong")).Once()
 
 		req := createRequest(strconv.Itoa(id))
 		res := httptest.NewRecorder()
@@ -487,7 +498,7 @@ func TestGetInboundOrdersReports(t *testing.T) {
 	})
 
 	t.Run("should return error in case of expected error with ID", func(t *testing.T) {
-		srv.On("GetInboundOrdersReportByEmployee", mock.Anything).Return(model.InboundOrdersReportByEmployee{}, customerror.EmployeeErrNotFoundInboundOrders).Once()
+		srv.On("GetInboundOrdersReportByEmployee", mock.Anything, mock.Anything).Return(model.InboundOrdersReportByEmployee{}, customerror.EmployeeErrNotFoundInboundOrders).Once()
 
 		req := createRequest("12")
 		res := httptest.NewRecorder()
@@ -1,11 +1,14 @@
 package handler
 
 import (
+	"fmt"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/bootcamp-go/web/request"
 	"github.com/bootcamp-go/web/response"
+	"github.com/go-chi/chi/v5"
 	"github.com/maxwelbm/alkemy-g7.git/internal/handler/responses"
 	"github.com/maxwelbm/alkemy-g7.git/internal/model"
 	"github.com/maxwelbm/alkemy-g7.git/internal/service/interfaces"
@@ -19,12 +22,14 @@ type InboundOrderHandler struct {
 }
 
 type InboundOrderJSON struct {
-	ID             int    `json:"id"`
-	OrderDate      string `json:"order_date"`
-	OrderNumber    string `json:"order_number"`
-	EmployeeID     int    `json:"employee_id"`
-	ProductBatchID int    `json:"product_batch_id"`
-	WareHouseID    int    `json:"warehouse_id"`
+	ID              int                  `json:"id"`
+	OrderDate       string               `json:"order_date"`
+	OrderNumber     string               `json:"order_number"`
+	EmployeeID      int                  `json:"employee_id"`
+	ProductBatchID  int                  `json:"product_batch_id"`
+	WareHouseID     int                  `json:"warehouse_id"`
+	ProductBatchIDs []int                `json:"product_batch_ids,omitempty"`
+	ProductBatches  []ProductBatchesJSON `json:"product_batches,omitempty"`
 }
 
 func NewInboundHandler(sv interfaces.IInboundOrderService, log logger.Logger) *InboundOrderHandler {
@@ -50,24 +55,94 @@ func (h *InboundOrderHandler) PostInboundOrder(w http.ResponseWriter, r *http.Re
 
 	newInboundOrder := toInboundOrder(reqBody)
 
-	entry, err := h.sv.Post(newInboundOrder)
+	entry, err := h.sv.Post(r.Context(), newInboundOrder)
 
 	if err != nil {
-		if err, ok := err.(*customerror.InboundOrderErr); ok {
-			h.log.Log("InboundOrderHandler", "ERROR", "Business error: "+err.Error())
-			response.JSON(w, err.StatusCode, responses.CreateResponseBody(err.Error(), nil))
+		h.handleError(w, err)
+		return
+	}
+
+	h.log.Log("InboundOrderHandler", "INFO", "Inbound order created successfully")
+	response.JSON(w, http.StatusCreated, responses.CreateResponseBody("success", toInboundOrderJSON(entry)))
+}
+
+func (h *InboundOrderHandler) GetInboundOrders(w http.ResponseWriter, r *http.Request) {
+	h.log.Log("InboundOrderHandler", "INFO", "Received request to list inbound orders")
+
+	params, err := model.ParseListParams(r.URL.Query(), model.InboundOrderListOptions)
+
+	if err != nil {
+		h.log.Log("InboundOrderHandler", "ERROR", "Error parsing list params: "+err.Error())
+		response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody(err.Error(), nil))
+
+		return
+	}
+
+	for _, key := range []string{"order_date_from", "order_date_to"} {
+		if value, ok := params.Filters[key]; ok {
+			if _, err := time.Parse(time.DateOnly, value); err != nil {
+				h.log.Log("InboundOrderHandler", "ERROR", "Error parsing date filter: "+err.Error())
+				response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody(fmt.Sprintf("invalid %s: %s, expected format YYYY-MM-DD", key, value), nil))
 
-			return
+				return
+			}
 		}
+	}
 
-		h.log.Log("InboundOrderHandler", "ERROR", "Internal server error: "+err.Error())
-		response.JSON(w, http.StatusInternalServerError, responses.CreateResponseBody("something went wrong", nil))
+	inboundOrders, total, err := h.sv.Get(r.Context(), params)
 
+	if err != nil {
+		h.handleError(w, err)
 		return
 	}
 
-	h.log.Log("InboundOrderHandler", "INFO", "Inbound order created successfully")
-	response.JSON(w, http.StatusCreated, responses.CreateResponseBody("success", toInboundOrderJSON(entry)))
+	data := make([]InboundOrderJSON, 0, len(inboundOrders))
+
+	for _, inboundOrder := range inboundOrders {
+		data = append(data, toInboundOrderJSON(inboundOrder))
+	}
+
+	h.log.Log("InboundOrderHandler", "INFO", "Inbound orders listed successfully")
+	response.JSON(w, http.StatusOK, responses.CreatePaginatedResponseBody("success", data, params.Page, params.PageSize, total))
+}
+
+func (h *InboundOrderHandler) GetInboundOrderByID(w http.ResponseWriter, r *http.Request) {
+	h.log.Log("InboundOrderHandler", "INFO", "Received request to get inbound order")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+
+	if err != nil {
+		h.log.Log("InboundOrderHandler", "ERROR", "Error parsing id: "+err.Error())
+		response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody("invalid id", nil))
+
+		return
+	}
+
+	inboundOrder, err := h.sv.GetByID(r.Context(), id)
+
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	h.log.Log("InboundOrderHandler", "INFO", "Inbound order found")
+	response.JSON(w, http.StatusOK, responses.CreateResponseBody("success", toInboundOrderJSON(inboundOrder)))
+}
+
+// handleError answers with the status of the business errors raised by the inbound order flow,
+// including the ones of the batches received with the order.
+func (h *InboundOrderHandler) handleError(w http.ResponseWriter, err error) {
+	switch e := err.(type) {
+	case *customerror.InboundOrderErr:
+		h.log.Log("InboundOrderHandler", "ERROR", "Business error: "+e.Error())
+		response.JSON(w, e.StatusCode, responses.CreateResponseBody(e.Error(), nil))
+	case *customerror.GenericError:
+		h.log.Log("InboundOrderHandler", "ERROR", "Business error: "+e.Error())
+		response.JSON(w, e.Code, responses.CreateResponseBody(e.Error(), nil))
+	default:
+		h.log.Log("InboundOrderHandler", "ERROR", "Internal server error: "+err.Error())
+		response.JSON(w, http.StatusInternalServerError, responses.CreateResponseBody("something went wrong", nil))
+	}
 }
 
 func toInboundOrder(inboundOrder InboundOrderJSON) model.InboundOrder {
@@ -80,16 +155,49 @@ func toInboundOrder(inboundOrder InboundOrderJSON) model.InboundOrder {
 		EmployeeID:     inboundOrder.EmployeeID,
 		ProductBatchID: inboundOrder.ProductBatchID,
 		WareHouseID:    inboundOrder.WareHouseID,
+		ProductBatches: toProductBatchesList(inboundOrder.ProductBatches),
 	}
 }
 
+func toProductBatchesList(batches []ProductBatchesJSON) []model.ProductBatches {
+	if len(batches) == 0 {
+		return nil
+	}
+
+	productBatches := make([]model.ProductBatches, 0, len(batches))
+
+	for _, pb := range batches {
+		productBatches = append(productBatches, model.ProductBatches{
+			BatchNumber:        pb.BatchNumber,
+			CurrentQuantity:    pb.CurrentQuantity,
+			CurrentTemperature: pb.CurrentTemperature,
+			MinimumTemperature: pb.MinimumTemperature,
+			DueDate:            pb.DueDate,
+			InitialQuantity:    pb.InitialQuantity,
+			ManufacturingDate:  pb.ManufacturingDate,
+			ManufacturingHour:  pb.ManufacturingHour,
+			ProductID:          pb.ProductID,
+			SectionID:          pb.SectionID,
+		})
+	}
+
+	return productBatches
+}
+
 func toInboundOrderJSON(inboundOrder model.InboundOrder) InboundOrderJSON {
-	return InboundOrderJSON{
-		ID:             inboundOrder.ID,
-		OrderDate:      inboundOrder.OrderDate.Format("2006-01-02"),
-		OrderNumber:    inboundOrder.OrderNumber,
-		EmployeeID:     inboundOrder.EmployeeID,
-		ProductBatchID: inboundOrder.ProductBatchID,
-		WareHouseID:    inboundOrder.WareHouseID,
+	inboundOrderJSON := InboundOrderJSON{
+		ID:              inboundOrder.ID,
+		OrderDate:       inboundOrder.OrderDate.Format("2006-01-02"),
+		OrderNumber:     inboundOrder.OrderNumber,
+		EmployeeID:      inboundOrder.EmployeeID,
+		ProductBatchID:  inboundOrder.ProductBatchID,
+		WareHouseID:     inboundOrder.WareHouseID,
+		ProductBatchIDs: inboundOrder.ProductBatchIDs,
 	}
+
+	for _, pb := range inboundOrder.ProductBatches {
+		inboundOrderJSON.ProductBatches = append(inboundOrderJSON.ProductBatches, toProductBatchesJSON(pb))
+	}
+
+	return inboundOrderJSON
 }
@@ -9,6 +9,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/go-chi/chi/v5"
 	"github.com/maxwelbm/alkemy-g7.git/internal/handler"
 	"github.com/maxwelbm/alkemy-g7.git/internal/mocks"
 	"github.com/maxwelbm/alkemy-g7.git/internal/model"
@@ -50,7 +51,7 @@ func TestPostInboundOrder(t *testing.T) {
 			ProductBatchID: 1,
 			WareHouseID:    1,
 		}
-		srv.On("Post", mockInboundOrder).Return(mockInboundOrder, nil).Once()
+		srv.On("Post", mock.Anything, mockInboundOrder).Return(mockInboundOrder, nil).Once()
 		req := createRequest(string(inboundOrderJSON))
 		res := httptest.NewRecorder()
 
@@ -76,7 +77,7 @@ func TestPostInboundOrder(t *testing.T) {
 	})
 
 	t.Run("should return 422 unprocessable entity when the input is missing fields", func(t *testing.T) {
-		srv.On("Post", mock.Anything).Return(model.InboundOrder{}, customerror.NewInboundOrderErr("invalid input", http.StatusUnprocessableEntity)).Once()
+		srv.On("Post", mock.Anything, mock.Anything).Return(model.InboundOrder{}, customerror.NewInboundOrderErr("invalid input", http.StatusUnprocessableEntity)).Once()
 		newInboundOrder := `
 		{
 			"order_number": "ORD123"
@@ -94,7 +95,7 @@ func TestPostInboundOrder(t *testing.T) {
 	})
 
 	t.Run("should return 409 conflict when order number already exists", func(t *testing.T) {
-		srv.On("Post", mock.Anything).Return(model.InboundOrder{}, customerror.NewInboundOrderErr("duplicated order number", http.StatusConflict)).Once()
+		srv.On("Post", mock.Anything, mock.Anything).Return(model.InboundOrder{}, customerror.NewInboundOrderErr("duplicated order number", http.StatusConflict)).Once()
 
 		req := createRequest(string(inboundOrderJSON))
 		res := httptest.NewRecorder()
@@ -108,7 +109,7 @@ func TestPostInboundOrder(t *testing.T) {
 	})
 
 	t.Run("should return 500 internal error in case of unexpected error", func(t *testing.T) {
-		srv.On("Post", mock.Anything).Return(model.InboundOrder{}, errors.New("unexpected error")).Once()
+		srv.On("Post", mock.Anything, mock.Anything).Return(model.InboundOrder{}, errors.New("unexpected error")).Once()
 
 		req := createRequest(string(inboundOrderJSON))
 		res := httptest.NewRecorder()
@@ -119,3 +120,158 @@ func TestPostInboundOrder(t *testing.T) {
 		assert.JSONEq(t, res.Body.String(), expected)
 	})
 }
+
+func TestPostInboundOrderWithBatches(t *testing.T) {
+	srv := mocks.NewMockIInboundOrderService(t)
+	inboundOrderHandler := handler.NewInboundHandler(srv, logMock)
+
+	dueDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	body := `{"order_date":"2023-10-01","order_number":"ORD123","employee_id":1,"warehouse_id":1,"product_batches":[{"batch_number":"B01","current_quantity":10,"current_temperature":5,"minimum_temperature":2,"due_date":"2025-01-01T00:00:00Z","initial_quantity":10,"manufacturing_date":"2025-01-01T00:00:00Z","manufacturing_hour":10,"product_id":1,"section_id":1}]}`
+
+	t.Run("should return 201 created with the batches created with the order", func(t *testing.T) {
+		batch := model.ProductBatches{BatchNumber: "B01", CurrentQuantity: 10, CurrentTemperature: 5, MinimumTemperature: 2, DueDate: dueDate, InitialQuantity: 10, ManufacturingDate: dueDate, ManufacturingHour: 10, ProductID: 1, SectionID: 1}
+		inboundOrder := model.InboundOrder{OrderDate: time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC), OrderNumber: "ORD123", EmployeeID: 1, WareHouseID: 1, ProductBatches: []model.ProductBatches{batch}}
+
+		created := inboundOrder
+		created.ID = 1
+		created.ProductBatchID = 4
+		created.ProductBatchIDs = []int{4}
+		created.ProductBatches = []model.ProductBatches{batch}
+		created.ProductBatches[0].ID = 4
+
+		srv.On("Post", mock.Anything, inboundOrder).Return(created, nil).Once()
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/inboundOrders", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		res := httptest.NewRecorder()
+
+		inboundOrderHandler.PostInboundOrder(res, req)
+
+		assert.Equal(t, http.StatusCreated, res.Code)
+		assert.Contains(t, res.Body.String(), `"product_batch_id":4`)
+		assert.Contains(t, res.Body.String(), `"product_batch_ids":[4]`)
+		assert.Contains(t, res.Body.String(), `"batch_number":"B01"`)
+	})
+
+	t.Run("should return the status of a batch validation error", func(t *testing.T) {
+		srv.On("Post", mock.Anything, mock.Anything).Return(model.InboundOrder{}, customerror.NewError(http.StatusConflict, customerror.ErrCapacityExceeded.Error(), "section", "")).Once()
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/inboundOrders", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		res := httptest.NewRecorder()
+
+		inboundOrderHandler.PostInboundOrder(res, req)
+
+		assert.Equal(t, http.StatusConflict, res.Code)
+		assert.JSONEq(t, `{"message":"section `+customerror.ErrCapacityExceeded.Error()+`"}`, res.Body.String())
+	})
+}
+
+func setupInboundOrderRouter(t *testing.T) (*mocks.MockIInboundOrderService, *chi.Mux) {
+	srv := mocks.NewMockIInboundOrderService(t)
+	hd := handler.NewInboundHandler(srv, logMock)
+
+	r := chi.NewRouter()
+	r.Get("/api/v1/inboundOrders", hd.GetInboundOrders)
+	r.Get("/api/v1/inboundOrders/{id}", hd.GetInboundOrderByID)
+
+	return srv, r
+}
+
+func TestGetInboundOrders(t *testing.T) {
+	t.Run("should return 200 with the inbound orders filtered by warehouse, employee and date range", func(t *testing.T) {
+		srv, r := setupInboundOrderRouter(t)
+		params := model.ListParams{Page: 1, PageSize: 20, Sort: "id", Filters: map[string]string{
+			"warehouse_id":    "1",
+			"employee_id":     "2",
+			"order_date_from": "2023-10-01",
+			"order_date_to":   "2023-10-31",
+		}}
+
+		srv.On("Get", mock.Anything, params).Return([]model.InboundOrder{{ID: 1, OrderDate: time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC), OrderNumber: "ORD123", EmployeeID: 2, ProductBatchID: 1, WareHouseID: 1}}, 1, nil).Once()
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/inboundOrders?warehouse_id=1&employee_id=2&order_date_from=2023-10-01&order_date_to=2023-10-31", nil)
+		res := httptest.NewRecorder()
+		r.ServeHTTP(res, req)
+
+		expected := `{"message":"success","data":[{"id":1,"order_date":"2023-10-01","order_number":"ORD123","employee_id":2,"product_batch_id":1,"warehouse_id":1}],"pagination":{"page":1,"page_size":20,"total_items":1,"total_pages":1}}`
+
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.JSONEq(t, expected, res.Body.String())
+	})
+
+	t.Run("should return 400 bad request for an invalid date", func(t *testing.T) {
+		_, r := setupInboundOrderRouter(t)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/inboundOrders?order_date_from=01-10-2023", nil)
+		res := httptest.NewRecorder()
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+		assert.JSONEq(t, `{"message":"invalid order_date_from: 01-10-2023, expected format YYYY-MM-DD"}`, res.Body.String())
+	})
+
+	t.Run("should return 400 bad request for an unknown sort", func(t *testing.T) {
+		_, r := setupInboundOrderRouter(t)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/inboundOrders?sort=quantity", nil)
+		res := httptest.NewRecorder()
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("should return 500 internal error in case of unexpected error", func(t *testing.T) {
+		srv, r := setupInboundOrderRouter(t)
+
+		srv.On("Get", mock.Anything, mock.Anything).Return(nil, 0, errors.New("unexpected error")).Once()
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/inboundOrders", nil)
+		res := httptest.NewRecorder()
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusInternalServerError, res.Code)
+		assert.JSONEq(t, `{"message":"something went wrong"}`, res.Body.String())
+	})
+}
+
+func TestGetInboundOrderByID(t *testing.T) {
+	t.Run("should return 200 with the inbound order and its batches", func(t *testing.T) {
+		srv, r := setupInboundOrderRouter(t)
+
+		srv.On("GetByID", mock.Anything, 1).Return(model.InboundOrder{ID: 1, OrderDate: time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC), OrderNumber: "ORD123", EmployeeID: 1, ProductBatchID: 4, WareHouseID: 1, ProductBatchIDs: []int{4, 5}}, nil).Once()
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/inboundOrders/1", nil)
+		res := httptest.NewRecorder()
+		r.ServeHTTP(res, req)
+
+		expected := `{"message":"success","data":{"id":1,"order_date":"2023-10-01","order_number":"ORD123","employee_id":1,"product_batch_id":4,"warehouse_id":1,"product_batch_ids":[4,5]}}`
+
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.JSONEq(t, expected, res.Body.String())
+	})
+
+	t.Run("should return 400 bad request for an invalid id", func(t *testing.T) {
+		_, r := setupInboundOrderRouter(t)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/inboundOrders/abc", nil)
+		res := httptest.NewRecorder()
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+		assert.JSONEq(t, `{"message":"invalid id"}`, res.Body.String())
+	})
+
+	t.Run("should return 404 not found when the inbound order does not exist", func(t *testing.T) {
+		srv, r := setupInboundOrderRouter(t)
+
+		srv.On("GetByID", mock.Anything, 99).Return(model.InboundOrder{}, customerror.InboundErrNotFound).Once()
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/inboundOrders/99", nil)
+		res := httptest.NewRecorder()
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusNotFound, res.Code)
+		assert.JSONEq(t, `{"message":"inbound order not found"}`, res.Body.String())
+	})
+}
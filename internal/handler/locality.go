@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"errors"
 	"fmt"
 	"github.com/maxwelbm/alkemy-g7.git/pkg/logger"
 	"net/http"
@@ -55,7 +56,7 @@ func (hd *LocalitiesController) GetByID(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	locality, err := hd.Service.GetByID(id)
+	locality, err := hd.Service.GetByID(r.Context(), id)
 	if ok := hd.handlerError(err, w); ok {
 		hd.log.Log("LocalitiesHandler", "ERROR", fmt.Sprintf("Error: %v", err))
 
@@ -70,12 +71,13 @@ func (hd *LocalitiesController) GetByID(w http.ResponseWriter, r *http.Request)
 
 // CreateLocality creates a new locality.
 // @Summary Create a new locality
-// @Description This endpoint allows for creating a new locality.
+// @Description This endpoint allows for creating a new locality in a province given by province_id, or by the province_name and country_name of an existing province.
 // @Tags Locality
 // @Produce json
 // @Param locality body model.Locality true "Locality information"
 // @Success 201 {object} model.LocalityResponseSwagger{data=model.Locality}
 // @Failure 400 {object} model.ErrorResponseSwagger "Unprocessable Entity"
+// @Failure 404 {object} model.ErrorResponseSwagger "province not found"
 // @Failure 500 {object} model.ErrorResponseSwagger "Unable to create locality"
 // @Router /localities [post]
 func (hd *LocalitiesController) CreateLocality(w http.ResponseWriter, r *http.Request) {
@@ -89,7 +91,7 @@ func (hd *LocalitiesController) CreateLocality(w http.ResponseWriter, r *http.Re
 		return
 	}
 
-	createdLocality, err := hd.Service.CreateLocality(&locality)
+	createdLocality, err := hd.Service.CreateLocality(r.Context(), &locality)
 	if ok := hd.handlerError(err, w); ok {
 		hd.log.Log("LocalitiesHandler", "ERROR", fmt.Sprintf("Error: %v", err))
 
@@ -102,6 +104,138 @@ func (hd *LocalitiesController) CreateLocality(w http.ResponseWriter, r *http.Re
 	response.JSON(w, http.StatusCreated, responses.CreateResponseBody("", createdLocality))
 }
 
+// GetAll lists the localities.
+// @Summary List localities
+// @Description This endpoint lists the localities with their province and country, searching by locality_name, province_name or country_name and filtering by province_id or country_id.
+// @Tags Locality
+// @Produce json
+// @Param locality_name query string false "Text contained in the locality name"
+// @Param province_name query string false "Text contained in the province name"
+// @Param country_name query string false "Text contained in the country name"
+// @Param province_id query int false "Province ID"
+// @Param country_id query int false "Country ID"
+// @Param page query int false "Page number"
+// @Param page_size query int false "Page size"
+// @Param sort query string false "Sort key (id, locality_name, province_name, country_name), prefixed with - for descending order"
+// @Success 200 {object} model.LocalityResponseSwagger
+// @Failure 400 {object} model.ErrorResponseSwagger "Invalid query parameters"
+// @Failure 500 {object} model.ErrorResponseSwagger "Unable to list localities"
+// @Router /localities [get]
+func (hd *LocalitiesController) GetAll(w http.ResponseWriter, r *http.Request) {
+	hd.log.Log("LocalitiesHandler", "INFO", "Get localities initializing")
+
+	params, err := model.ParseListParams(r.URL.Query(), model.LocalityListOptions)
+	if err != nil {
+		hd.log.Log("LocalitiesHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+		response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody(err.Error(), nil))
+
+		return
+	}
+
+	localities, total, err := hd.Service.GetAll(r.Context(), params)
+	if ok := hd.handlerError(err, w); ok {
+		hd.log.Log("LocalitiesHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	if localities == nil {
+		localities = []model.Locality{}
+	}
+
+	hd.log.Log("LocalitiesHandler", "INFO", fmt.Sprintf("Retrieved %d localities successfully", len(localities)))
+	hd.log.Log("LocalitiesHandler", "INFO", "Get localities completed")
+
+	response.JSON(w, http.StatusOK, responses.CreatePaginatedResponseBody("", localities, params.Page, params.PageSize, total))
+}
+
+// UpdateLocality updates an existing locality.
+// @Summary Update a locality
+// @Description This endpoint renames the locality and moves it to another province, given by province_id or by province_name and country_name. Omitted fields keep their value.
+// @Tags Locality
+// @Accept json
+// @Produce json
+// @Param id path int true "Locality ID"
+// @Param locality body model.Locality true "Locality information"
+// @Success 200 {object} model.LocalityResponseSwagger{data=model.Locality}
+// @Failure 400 {object} model.ErrorResponseSwagger "missing 'id' parameter in the request"
+// @Failure 404 {object} model.ErrorResponseSwagger "locality or province not found"
+// @Failure 422 {object} model.ErrorResponseSwagger "Unprocessable Entity"
+// @Failure 500 {object} model.ErrorResponseSwagger "Unable to update locality"
+// @Router /localities/{id} [patch]
+func (hd *LocalitiesController) UpdateLocality(w http.ResponseWriter, r *http.Request) {
+	hd.log.Log("LocalitiesHandler", "INFO", "Update locality initializing")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if id == 0 || err != nil {
+		hd.log.Log("LocalitiesHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+		response.JSON(w, er.ErrMissingLocalityID.Code, responses.CreateResponseBody(er.ErrMissingLocalityID.Error(), nil))
+
+		return
+	}
+
+	var locality model.Locality
+	if err := request.JSON(r, &locality); err != nil {
+		hd.log.Log("LocalitiesHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+		response.JSON(w, er.ErrInvalidLocalityJSONFormat.Code, responses.CreateResponseBody(er.ErrInvalidLocalityJSONFormat.Error(), nil))
+
+		return
+	}
+
+	updatedLocality, err := hd.Service.UpdateLocality(r.Context(), id, &locality)
+	if ok := hd.handlerError(err, w); ok {
+		hd.log.Log("LocalitiesHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	hd.log.Log("LocalitiesHandler", "INFO", fmt.Sprintf("Updated locality successfully: %+v", updatedLocality))
+	hd.log.Log("LocalitiesHandler", "INFO", "Update locality completed")
+
+	response.JSON(w, http.StatusOK, responses.CreateResponseBody("", updatedLocality))
+}
+
+// DeleteLocality deletes a locality by its ID.
+// @Summary Delete a locality
+// @Description This endpoint deletes the locality unless sellers or carriers still reference it; the conflict response carries their counts.
+// @Tags Locality
+// @Param id path int true "Locality ID"
+// @Success 204 {object} nil "Locality successfully deleted"
+// @Failure 400 {object} model.ErrorResponseSwagger "missing 'id' parameter in the request"
+// @Failure 404 {object} model.ErrorResponseSwagger "locality not found"
+// @Failure 409 {object} model.LocalityDependenciesResponseSwagger "locality referenced by sellers or carriers"
+// @Failure 500 {object} model.ErrorResponseSwagger "Unable to delete locality"
+// @Router /localities/{id} [delete]
+func (hd *LocalitiesController) DeleteLocality(w http.ResponseWriter, r *http.Request) {
+	hd.log.Log("LocalitiesHandler", "INFO", "Delete locality initializing")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if id == 0 || err != nil {
+		hd.log.Log("LocalitiesHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+		response.JSON(w, er.ErrMissingLocalityID.Code, responses.CreateResponseBody(er.ErrMissingLocalityID.Error(), nil))
+
+		return
+	}
+
+	dependencies, err := hd.Service.DeleteLocality(r.Context(), id)
+	if errors.Is(err, er.ErrLocalityDependencies) {
+		hd.log.Log("LocalitiesHandler", "ERROR", fmt.Sprintf("Error: %v, dependencies: %+v", err, dependencies))
+		response.JSON(w, er.ErrLocalityDependencies.Code, responses.CreateResponseBody(err.Error(), dependencies))
+
+		return
+	}
+
+	if ok := hd.handlerError(err, w); ok {
+		hd.log.Log("LocalitiesHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	hd.log.Log("LocalitiesHandler", "INFO", "Delete locality completed")
+
+	response.JSON(w, http.StatusNoContent, nil)
+}
+
 // GetSellers retrieves a count of sellers from locality by their ID.
 // @Summary Retrieve locality and count sellers
 // @Description This endpoint fetches the details of a specific locality abount sellers count based on the provided locality ID.
@@ -144,7 +278,7 @@ func (hd *LocalitiesController) GetSellers(w http.ResponseWriter, r *http.Reques
 		id = idParam
 	}
 
-	result, err := hd.Service.GetSellers(id)
+	result, err := hd.Service.GetSellers(r.Context(), id)
 	if ok := hd.handlerError(err, w); ok {
 		hd.log.Log("LocalitiesHandler", "ERROR", fmt.Sprintf("Error: %v", err))
 
@@ -199,7 +333,7 @@ func (hd *LocalitiesController) GetCarriers(w http.ResponseWriter, r *http.Reque
 		id = idParam
 	}
 
-	result, err := hd.Service.GetCarriers(id)
+	result, err := hd.Service.GetCarriers(r.Context(), id)
 	if ok := hd.handlerError(err, w); ok {
 		hd.log.Log("LocalitiesHandler", "ERROR", fmt.Sprintf("Error: %v", err))
 
@@ -9,6 +9,7 @@ import (
 	"github.com/maxwelbm/alkemy-g7.git/internal/model"
 	"github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
 	"github.com/stretchr/testify/assert"
+	testifymock "github.com/stretchr/testify/mock"
 	"net/http"
 	"net/http/httptest"
 	"strconv"
@@ -31,7 +32,7 @@ func TestLocalitiesController_CreateLocality(t *testing.T) {
 		mock := hd.Service.(*mocks.MockILocalityService)
 
 		arg := model.Locality{Locality: "Brooklyn", Province: "New York", Country: "EUA"}
-		returnService := model.Locality{ID: 1, Locality: "Brooklyn", Province: "New York", Country: "EUA"}
+		returnService := model.Locality{ID: 1, Locality: "Brooklyn", ProvinceID: 2, Province: "New York", Country: "EUA"}
 		body := []byte(`{           
 						"locality_name": "Brooklyn",
 						"province_name": "New York",
@@ -41,12 +42,13 @@ func TestLocalitiesController_CreateLocality(t *testing.T) {
 					"data": {
 						"id": 1,
 						"locality_name": "Brooklyn",
+						"province_id": 2,
 						"province_name": "New York",
 						"country_name": "EUA"
 					}
 				}`
 		statusCode := http.StatusCreated
-		mock.On("CreateLocality", &arg).Return(returnService, nil)
+		mock.On("CreateLocality", testifymock.Anything, &arg).Return(returnService, nil)
 
 		request := httptest.NewRequest(http.MethodPost, url, bytes.NewReader(body))
 		request.Header.Set("Content-Type", "application/json")
@@ -92,7 +94,7 @@ func TestLocalitiesController_CreateLocality(t *testing.T) {
 		statusCode := http.StatusUnprocessableEntity
 		errS := customerror.ErrNullLocalityAttribute
 
-		mock.On("CreateLocality", &arg).Return(returnService, errS)
+		mock.On("CreateLocality", testifymock.Anything, &arg).Return(returnService, errS)
 
 		request := httptest.NewRequest(http.MethodPost, endpoint, bytes.NewReader(body))
 		request.Header.Set("Content-Type", "application/json")
@@ -113,19 +115,20 @@ func TestLocalitiesController_GetByID(t *testing.T) {
 	r.Get("/api/v1/localities/{id}", hd.GetByID)
 
 	t.Run("test handler method for get locality by ID successfully", func(t *testing.T) {
-		returnService := model.Locality{ID: 3, Locality: "Phoenix", Province: "Arizona", Country: "EUA"}
+		returnService := model.Locality{ID: 3, Locality: "Phoenix", ProvinceID: 4, Province: "Arizona", Country: "EUA"}
 		ID := 3
 		res := `{
 					"data": {
 						"id": 3,
 						"locality_name": "Phoenix",
+						"province_id": 4,
 						"province_name": "Arizona",
 						"country_name": "EUA"
 					}
 				}`
 		statusCode := http.StatusOK
 
-		mock.On("GetByID", ID).Return(returnService, nil).Once()
+		mock.On("GetByID", testifymock.Anything, ID).Return(returnService, nil).Once()
 
 		request := httptest.NewRequest(http.MethodGet, url+strconv.Itoa(ID), nil)
 		response := httptest.NewRecorder()
@@ -156,7 +159,7 @@ func TestLocalitiesController_GetByID(t *testing.T) {
 		statusCode := http.StatusNotFound
 		errS := customerror.ErrLocalityNotFound
 
-		mock.On("GetByID", ID).Return(returnService, errS).Once()
+		mock.On("GetByID", testifymock.Anything, ID).Return(returnService, errS).Once()
 
 		request := httptest.NewRequest(http.MethodGet, url+strconv.Itoa(ID), nil)
 		response := httptest.NewRecorder()
@@ -167,6 +170,152 @@ func TestLocalitiesController_GetByID(t *testing.T) {
 	})
 }
 
+func TestLocalitiesController_GetAll(t *testing.T) {
+	hd := setupLocality(t)
+	mock := hd.Service.(*mocks.MockILocalityService)
+
+	r := chi.NewRouter()
+	r.Get("/api/v1/localities", hd.GetAll)
+
+	t.Run("test handler method for search localities successfully", func(t *testing.T) {
+		params := model.ListParams{Page: 1, PageSize: 20, Sort: "locality_name", Filters: map[string]string{"locality_name": "Pho", "country_id": "1"}}
+		returnService := []model.Locality{{ID: 3, Locality: "Phoenix", ProvinceID: 4, Province: "Arizona", Country: "EUA"}}
+		res := `{
+					"data": [{
+						"id": 3,
+						"locality_name": "Phoenix",
+						"province_id": 4,
+						"province_name": "Arizona",
+						"country_name": "EUA"
+					}],
+					"pagination": {"page": 1, "page_size": 20, "total_items": 1, "total_pages": 1}
+				}`
+
+		mock.On("GetAll", testifymock.Anything, params).Return(returnService, 1, nil).Once()
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/localities?locality_name=Pho&country_id=1&sort=locality_name", nil)
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusOK, response.Code)
+		assert.JSONEq(t, res, response.Body.String())
+		mock.AssertExpectations(t)
+	})
+
+	t.Run("test handler method for list localities with invalid page size", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/localities?page_size=0", nil)
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+	})
+}
+
+func TestLocalitiesController_UpdateLocality(t *testing.T) {
+	hd := setupLocality(t)
+	mock := hd.Service.(*mocks.MockILocalityService)
+
+	r := chi.NewRouter()
+	r.Patch("/api/v1/localities/{id}", hd.UpdateLocality)
+
+	t.Run("test handler method for update locality successfully", func(t *testing.T) {
+		arg := model.Locality{Locality: "Denver", ProvinceID: 2}
+		returnService := model.Locality{ID: 1, Locality: "Denver", ProvinceID: 2, Province: "Colorado", Country: "EUA"}
+		body := []byte(`{"locality_name": "Denver", "province_id": 2}`)
+		res := `{
+					"data": {
+						"id": 1,
+						"locality_name": "Denver",
+						"province_id": 2,
+						"province_name": "Colorado",
+						"country_name": "EUA"
+					}
+				}`
+
+		mock.On("UpdateLocality", testifymock.Anything, 1, &arg).Return(returnService, nil).Once()
+
+		request := httptest.NewRequest(http.MethodPatch, url+"1", bytes.NewReader(body))
+		request.Header.Set("Content-Type", "application/json")
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusOK, response.Code)
+		assert.JSONEq(t, res, response.Body.String())
+		mock.AssertExpectations(t)
+	})
+
+	t.Run("test handler method for update locality with invalid id", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodPatch, url+"abc", bytes.NewReader([]byte(`{}`)))
+		request.Header.Set("Content-Type", "application/json")
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+		assert.JSONEq(t, `{"message":"missing 'id' parameter in the request"}`, response.Body.String())
+	})
+
+	t.Run("test handler method for update locality with unknown province", func(t *testing.T) {
+		arg := model.Locality{ProvinceID: 99}
+
+		mock.On("UpdateLocality", testifymock.Anything, 1, &arg).Return(model.Locality{}, customerror.ErrLocalityProvinceNotFound).Once()
+
+		request := httptest.NewRequest(http.MethodPatch, url+"1", bytes.NewReader([]byte(`{"province_id": 99}`)))
+		request.Header.Set("Content-Type", "application/json")
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusNotFound, response.Code)
+		assert.JSONEq(t, `{"message":"province not found"}`, response.Body.String())
+	})
+}
+
+func TestLocalitiesController_DeleteLocality(t *testing.T) {
+	hd := setupLocality(t)
+	mock := hd.Service.(*mocks.MockILocalityService)
+
+	r := chi.NewRouter()
+	r.Delete("/api/v1/localities/{id}", hd.DeleteLocality)
+
+	t.Run("test handler method for delete locality successfully", func(t *testing.T) {
+		mock.On("DeleteLocality", testifymock.Anything, 3).Return(model.LocalityDependencies{ID: 3}, nil).Once()
+
+		request := httptest.NewRequest(http.MethodDelete, url+"3", nil)
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusNoContent, response.Code)
+		mock.AssertExpectations(t)
+	})
+
+	t.Run("test handler method for delete locality with dependencies", func(t *testing.T) {
+		d := model.LocalityDependencies{ID: 1, Sellers: 2, Carriers: 1}
+		res := `{
+					"message": "locality cannot be deleted while sellers or carriers reference it",
+					"data": {"locality_id": 1, "sellers_count": 2, "carriers_count": 1}
+				}`
+
+		mock.On("DeleteLocality", testifymock.Anything, 1).Return(d, customerror.ErrLocalityDependencies).Once()
+
+		request := httptest.NewRequest(http.MethodDelete, url+"1", nil)
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusConflict, response.Code)
+		assert.JSONEq(t, res, response.Body.String())
+	})
+
+	t.Run("test handler method for delete locality not found", func(t *testing.T) {
+		mock.On("DeleteLocality", testifymock.Anything, 999).Return(model.LocalityDependencies{}, customerror.ErrLocalityNotFound).Once()
+
+		request := httptest.NewRequest(http.MethodDelete, url+"999", nil)
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusNotFound, response.Code)
+		assert.JSONEq(t, `{"message":"locality not found"}`, response.Body.String())
+	})
+}
+
 func TestLocalitiesController_GetSellers(t *testing.T) {
 	hd := setupLocality(t)
 	mock := hd.Service.(*mocks.MockILocalityService)
@@ -190,7 +339,7 @@ func TestLocalitiesController_GetSellers(t *testing.T) {
 				}`
 		statusCode := http.StatusOK
 
-		mock.On("GetSellers", ID).Return(returnService, nil).Once()
+		mock.On("GetSellers", testifymock.Anything, ID).Return(returnService, nil).Once()
 
 		url := "/api/v1/localities/reportSellers?id="
 		request := httptest.NewRequest(http.MethodGet, url+strconv.Itoa(ID), nil)
@@ -222,7 +371,7 @@ func TestLocalitiesController_GetSellers(t *testing.T) {
 		res := `{"message":"unmapped locality handler error"}`
 		statusCode := http.StatusInternalServerError
 
-		mock.On("GetSellers", ID).Return(nil, errors.New("service error")).Once()
+		mock.On("GetSellers", testifymock.Anything, ID).Return(nil, errors.New("service error")).Once()
 
 		url := "/api/v1/localities/reportSellers?id=" + strconv.Itoa(ID)
 		request := httptest.NewRequest(http.MethodGet, url, nil)
@@ -242,7 +391,7 @@ func TestLocalitiesController_GetSellers(t *testing.T) {
 
 		url := "/api/v1/localities/reportSellers?id=999"
 
-		mock.On("GetSellers", 999).Return(nil, errS).Once()
+		mock.On("GetSellers", testifymock.Anything, 999).Return(nil, errS).Once()
 
 		request := httptest.NewRequest(http.MethodGet, url, nil)
 		response := httptest.NewRecorder()
@@ -293,7 +442,7 @@ func TestLocalitiesController_GetCarriers(t *testing.T) {
 		}`
 		statusCode := http.StatusOK
 
-		mock.On("GetCarriers", ID).Return(returnService, nil).Once()
+		mock.On("GetCarriers", testifymock.Anything, ID).Return(returnService, nil).Once()
 
 		url := "/api/v1/localities/reportCarriers?id="
 		request := httptest.NewRequest(http.MethodGet, url+strconv.Itoa(ID), nil)
@@ -326,7 +475,7 @@ func TestLocalitiesController_GetCarriers(t *testing.T) {
 		res := `{"message":"unmapped locality handler error"}`
 		statusCode := http.StatusInternalServerError
 
-		mock.On("GetCarriers", ID).Return(nil, errors.New("service error")).Once()
+		mock.On("GetCarriers", testifymock.Anything, ID).Return(nil, errors.New("service error")).Once()
 
 		url := "/api/v1/localities/reportCarriers?id=" + strconv.Itoa(ID)
 		request := httptest.NewRequest(http.MethodGet, url, nil)
@@ -346,7 +495,7 @@ func TestLocalitiesController_GetCarriers(t *testing.T) {
 
 		url := "/api/v1/localities/reportCarriers?id=999"
 
-		mock.On("GetCarriers", 999).Return(nil, errS).Once()
+		mock.On("GetCarriers", testifymock.Anything, 999).Return(nil, errS).Once()
 
 		request := httptest.NewRequest(http.MethodGet, url, nil)
 		response := httptest.NewRecorder()
@@ -32,13 +32,27 @@ func NewProductHandler(ps interfaces.IProductService, logger logger.Logger) *Pro
 // @Description Fetch all registered products from the database
 // @Tags Product
 // @Produce json
+// @Param page query int false "Page number, starting at 1"
+// @Param page_size query int false "Items per page (max 100)"
+// @Param sort query string false "Sort field, prefix with - for descending"
+// @Param seller_id query int false "Seller ID"
+// @Param product_type_id query int false "Product type ID"
 // @Success 200 {object} model.ProductResponseSwagger
+// @Failure 400 {object} model.ErrorResponseSwagger "Invalid pagination, sort or filter parameter"
 // @Failure 500 {object} model.ErrorResponseSwagger "Unable to list products"
 // @Router /products [get]
 func (ph *ProductHandler) GetAllProducts(w http.ResponseWriter, r *http.Request) {
 	ph.log.Log("ProductHandler", "INFO", "GetAllProducts function initializing")
 
-	products, err := ph.ProductService.GetAllProducts()
+	params, err := model.ParseListParams(r.URL.Query(), model.ProductListOptions)
+
+	if err != nil {
+		ph.log.Log("ProductHandler", "ERROR", "Invalid list parameters: "+err.Error())
+		response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody(err.Error(), nil))
+		return
+	}
+
+	products, total, err := ph.ProductService.GetAllProducts(r.Context(), params)
 
 	if err != nil {
 		ph.log.Log("ProductHandler", "ERROR", "Unable to retrieve products: "+err.Error())
@@ -47,7 +61,7 @@ func (ph *ProductHandler) GetAllProducts(w http.ResponseWriter, r *http.Request)
 	}
 
 	ph.log.Log("ProductHandler", "INFO", "Successfully retrieved products")
-	response.JSON(w, http.StatusOK, responses.CreateResponseBody("", products))
+	response.JSON(w, http.StatusOK, responses.CreatePaginatedResponseBody("", products, params.Page, params.PageSize, total))
 }
 
 // GetProductByID retrieves a product by its ID.
@@ -70,7 +84,7 @@ func (ph *ProductHandler) GetProductByID(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	product, err := ph.ProductService.GetProductByID(id)
+	product, err := ph.ProductService.GetProductByID(r.Context(), id)
 
 	if err != nil {
 		ph.log.Log("ProductHandler", "ERROR", fmt.Sprintf("Product not found for ID: %d, error: %v", id, err))
@@ -102,7 +116,7 @@ func (ph *ProductHandler) DeleteProductByID(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	err = ph.ProductService.DeleteProduct(id)
+	err = ph.ProductService.DeleteProduct(r.Context(), id)
 
 	if err != nil {
 		if appErr, ok := err.(*customerror.GenericError); ok {
@@ -128,6 +142,7 @@ func (ph *ProductHandler) DeleteProductByID(w http.ResponseWriter, r *http.Reque
 // @Param product body model.Product true "Product information"
 // @Success 201 {object} model.ProductResponseSwagger{data=model.Product}
 // @Failure 400 {object} model.ErrorResponseSwagger "Invalid input"
+// @Failure 404 {object} model.ErrorResponseSwagger "Seller or product type not found"
 // @Failure 500 {object} model.ErrorResponseSwagger "Unable to create product"
 // @Router /products [post]
 func (ph *ProductHandler) CreateProduct(w http.ResponseWriter, r *http.Request) {
@@ -139,9 +154,15 @@ func (ph *ProductHandler) CreateProduct(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	product, err := ph.ProductService.CreateProduct(productBody)
+	product, err := ph.ProductService.CreateProduct(r.Context(), productBody)
 
 	if err != nil {
+		if appErr, ok := err.(*customerror.GenericError); ok {
+			ph.log.Log("ProductHandler", "ERROR", "Unable to create product: "+appErr.Error())
+			response.JSON(w, appErr.Code, responses.CreateResponseBody(appErr.Error(), nil))
+			return
+		}
+
 		ph.log.Log("ProductHandler", "ERROR", "Unable to create product: "+err.Error())
 		response.JSON(w, http.StatusInternalServerError, responses.CreateResponseBody(err.Error(), nil))
 		return
@@ -180,7 +201,7 @@ func (ph *ProductHandler) UpdateProduct(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	product, err := ph.ProductService.UpdateProduct(id, productBody)
+	product, err := ph.ProductService.UpdateProduct(r.Context(), id, productBody)
 
 	if err != nil {
 		ph.log.Log("ProductHandler", "ERROR", fmt.Sprintf("Unable to update product with ID: %d, error: %v", id, err))
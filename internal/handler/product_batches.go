@@ -4,9 +4,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/bootcamp-go/web/response"
+	"github.com/go-chi/chi/v5"
 	"github.com/maxwelbm/alkemy-g7.git/internal/handler/responses"
 	"github.com/maxwelbm/alkemy-g7.git/internal/model"
 	"github.com/maxwelbm/alkemy-g7.git/internal/service/interfaces"
@@ -28,6 +30,13 @@ type ProductBatchesJSON struct {
 	SectionID          int       `json:"section_id"`
 }
 
+// ProductBatchesUpdateJSON is the body of a batch correction; omitted fields are left unchanged.
+type ProductBatchesUpdateJSON struct {
+	CurrentQuantity    *int     `json:"current_quantity"`
+	CurrentTemperature *float64 `json:"current_temperature"`
+	MinimumTemperature *float64 `json:"minimum_temperature"`
+}
+
 type ProductBatchesController struct {
 	Sv  interfaces.IProductBatchesService
 	log logger.Logger
@@ -74,7 +83,7 @@ func (h *ProductBatchesController) Post(w http.ResponseWriter, r *http.Request)
 		SectionID:          reqBody.SectionID,
 	}
 
-	pb, err := h.Sv.Post(&productBatches)
+	pb, err := h.Sv.Post(r.Context(), &productBatches)
 
 	if err != nil {
 		if err, ok := err.(*customerror.GenericError); ok {
@@ -93,3 +102,200 @@ func (h *ProductBatchesController) Post(w http.ResponseWriter, r *http.Request)
 	response.JSON(w, http.StatusCreated, responses.CreateResponseBody("", pb))
 	h.log.Log("ProductBatchesController", "INFO", "post function executed successfully")
 }
+
+func toProductBatchesJSON(pb model.ProductBatches) ProductBatchesJSON {
+	return ProductBatchesJSON{
+		ID:                 pb.ID,
+		BatchNumber:        pb.BatchNumber,
+		CurrentQuantity:    pb.CurrentQuantity,
+		CurrentTemperature: pb.CurrentTemperature,
+		MinimumTemperature: pb.MinimumTemperature,
+		DueDate:            pb.DueDate,
+		InitialQuantity:    pb.InitialQuantity,
+		ManufacturingDate:  pb.ManufacturingDate,
+		ManufacturingHour:  pb.ManufacturingHour,
+		ProductID:          pb.ProductID,
+		SectionID:          pb.SectionID,
+	}
+}
+
+func (h *ProductBatchesController) GetAll(w http.ResponseWriter, r *http.Request) {
+	h.log.Log("ProductBatchesController", "INFO", "initializing GetAll controller function")
+
+	params, err := model.ParseListParams(r.URL.Query(), model.ProductBatchesListOptions)
+	if err != nil {
+		response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody(err.Error(), nil))
+		h.log.Log("ProductBatchesController", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	for _, key := range []string{"due_date_from", "due_date_to"} {
+		if value, ok := params.Filters[key]; ok {
+			if _, err := time.Parse(time.DateOnly, value); err != nil {
+				response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody(fmt.Sprintf("invalid %s: %s, expected format YYYY-MM-DD", key, value), nil))
+				h.log.Log("ProductBatchesController", "ERROR", fmt.Sprintf("Error: %v", err))
+
+				return
+			}
+		}
+	}
+
+	batches, total, err := h.Sv.Get(r.Context(), params)
+	if err != nil {
+		response.JSON(w, http.StatusInternalServerError, responses.CreateResponseBody("unable to list product batches", nil))
+		h.log.Log("ProductBatchesController", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	data := []ProductBatchesJSON{}
+	for _, pb := range batches {
+		data = append(data, toProductBatchesJSON(pb))
+	}
+
+	response.JSON(w, http.StatusOK, responses.CreatePaginatedResponseBody("", data, params.Page, params.PageSize, total))
+	h.log.Log("ProductBatchesController", "INFO", "returning a slice with product batches in JSON format")
+}
+
+func (h *ProductBatchesController) GetByID(w http.ResponseWriter, r *http.Request) {
+	h.log.Log("ProductBatchesController", "INFO", "initializing GetByID controller function")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody("invalid id param", nil))
+		h.log.Log("ProductBatchesController", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	pb, err := h.Sv.GetByID(r.Context(), id)
+	if err != nil {
+		if err, ok := err.(*customerror.GenericError); ok {
+			response.JSON(w, err.Code, responses.CreateResponseBody(err.Error(), nil))
+			h.log.Log("ProductBatchesController", "ERROR", fmt.Sprintf("Error: %v", err))
+
+			return
+		}
+
+		response.JSON(w, http.StatusInternalServerError, responses.CreateResponseBody("unable to search for product batches", nil))
+		h.log.Log("ProductBatchesController", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	response.JSON(w, http.StatusOK, responses.CreateResponseBody("", toProductBatchesJSON(pb)))
+	h.log.Log("ProductBatchesController", "INFO", "returning a product batches in JSON format")
+}
+
+func (h *ProductBatchesController) Update(w http.ResponseWriter, r *http.Request) {
+	h.log.Log("ProductBatchesController", "INFO", "initializing Update controller function")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody("invalid id param", nil))
+		h.log.Log("ProductBatchesController", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	var reqBody ProductBatchesUpdateJSON
+
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+
+	if err = decoder.Decode(&reqBody); err != nil {
+		response.JSON(w, http.StatusUnprocessableEntity, responses.CreateResponseBody("invalid request body", nil))
+		h.log.Log("ProductBatchesController", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	pb, err := h.Sv.Update(r.Context(), id, model.ProductBatchesUpdate{
+		CurrentQuantity:    reqBody.CurrentQuantity,
+		CurrentTemperature: reqBody.CurrentTemperature,
+		MinimumTemperature: reqBody.MinimumTemperature,
+	})
+	if err != nil {
+		if err, ok := err.(*customerror.GenericError); ok {
+			response.JSON(w, err.Code, responses.CreateResponseBody(err.Error(), nil))
+			h.log.Log("ProductBatchesController", "ERROR", fmt.Sprintf("Error: %v", err))
+
+			return
+		}
+
+		response.JSON(w, http.StatusInternalServerError, responses.CreateResponseBody("unable to update product batches", nil))
+		h.log.Log("ProductBatchesController", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	response.JSON(w, http.StatusOK, responses.CreateResponseBody("", toProductBatchesJSON(pb)))
+	h.log.Log("ProductBatchesController", "INFO", "update function executed successfully")
+}
+
+func (h *ProductBatchesController) Delete(w http.ResponseWriter, r *http.Request) {
+	h.log.Log("ProductBatchesController", "INFO", "initializing Delete controller function")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody("invalid id param", nil))
+		h.log.Log("ProductBatchesController", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	err = h.Sv.Delete(r.Context(), id)
+	if err != nil {
+		if err, ok := err.(*customerror.GenericError); ok {
+			response.JSON(w, err.Code, responses.CreateResponseBody(err.Error(), nil))
+			h.log.Log("ProductBatchesController", "ERROR", fmt.Sprintf("Error: %v", err))
+
+			return
+		}
+
+		response.JSON(w, http.StatusInternalServerError, responses.CreateResponseBody("unable to delete product batches", nil))
+		h.log.Log("ProductBatchesController", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	response.JSON(w, http.StatusNoContent, nil)
+	h.log.Log("ProductBatchesController", "INFO", "delete function executed successfully")
+}
+
+func (h *ProductBatchesController) ReportExpiring(w http.ResponseWriter, r *http.Request) {
+	h.log.Log("ProductBatchesController", "INFO", "initializing ReportExpiring controller function")
+
+	days := model.DefaultExpiryWindowDays
+
+	if daysStr := r.URL.Query().Get("days"); daysStr != "" {
+		var err error
+
+		days, err = strconv.Atoi(daysStr)
+		if err != nil {
+			response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody("invalid days param", nil))
+			h.log.Log("ProductBatchesController", "ERROR", fmt.Sprintf("Error: %v", err))
+
+			return
+		}
+	}
+
+	report, err := h.Sv.GetExpiringReport(r.Context(), days)
+	if err != nil {
+		if err, ok := err.(*customerror.GenericError); ok {
+			response.JSON(w, err.Code, responses.CreateResponseBody(err.Error(), nil))
+			h.log.Log("ProductBatchesController", "ERROR", fmt.Sprintf("Error: %v", err))
+
+			return
+		}
+
+		response.JSON(w, http.StatusInternalServerError, responses.CreateResponseBody("unable to generate the expiring product batches report", nil))
+		h.log.Log("ProductBatchesController", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	response.JSON(w, http.StatusOK, responses.CreateResponseBody("", report))
+	h.log.Log("ProductBatchesController", "INFO", "ReportExpiring executed successfully")
+}
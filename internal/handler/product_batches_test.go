@@ -8,11 +8,13 @@ import (
 	"testing"
 	"time"
 
+	"github.com/go-chi/chi/v5"
 	"github.com/maxwelbm/alkemy-g7.git/internal/handler"
 	"github.com/maxwelbm/alkemy-g7.git/internal/mocks"
 	"github.com/maxwelbm/alkemy-g7.git/internal/model"
 	"github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func setupProductBatches(t *testing.T) *handler.ProductBatchesController {
@@ -46,7 +48,7 @@ func TestHandler_Post(t *testing.T) {
 		}
 
 		mockService := hd.Sv.(*mocks.MockIProductBatchesService)
-		mockService.On("Post", &model.ProductBatches{
+		mockService.On("Post", mock.Anything, &model.ProductBatches{
 			ID:                 0,
 			BatchNumber:        "B01",
 			CurrentQuantity:    10,
@@ -105,7 +107,7 @@ func TestHandler_Post(t *testing.T) {
 		assert.NoError(t, err)
 
 		mockService := hd.Sv.(*mocks.MockIProductBatchesService)
-		mockService.On("Post", &model.ProductBatches{
+		mockService.On("Post", mock.Anything, &model.ProductBatches{
 			ID:                 0,
 			BatchNumber:        "B01",
 			CurrentQuantity:    10,
@@ -151,7 +153,7 @@ func TestHandler_Post(t *testing.T) {
 		assert.NoError(t, err)
 
 		mockService := hd.Sv.(*mocks.MockIProductBatchesService)
-		mockService.On("Post", &model.ProductBatches{
+		mockService.On("Post", mock.Anything, &model.ProductBatches{
 			ID:                 0,
 			BatchNumber:        "B01",
 			CurrentQuantity:    10,
@@ -218,3 +220,192 @@ func TestHandler_Post(t *testing.T) {
 		assert.JSONEq(t, expectedJson, response.Body.String())
 	})
 }
+
+func setupProductBatchesRouter(t *testing.T) (*mocks.MockIProductBatchesService, *chi.Mux) {
+	hd := setupProductBatches(t)
+
+	r := chi.NewRouter()
+	r.Get("/api/v1/productBatches", hd.GetAll)
+	r.Get("/api/v1/productBatches/{id}", hd.GetByID)
+	r.Patch("/api/v1/productBatches/{id}", hd.Update)
+	r.Delete("/api/v1/productBatches/{id}", hd.Delete)
+
+	return hd.Sv.(*mocks.MockIProductBatchesService), r
+}
+
+func TestHandler_GetAllProductBatches(t *testing.T) {
+	dueDate := time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC)
+
+	t.Run("given filters then return the paginated product batches", func(t *testing.T) {
+		mockService, r := setupProductBatchesRouter(t)
+
+		params := model.ListParams{Page: 1, PageSize: 20, Sort: "id", Filters: map[string]string{"section_id": "1", "due_date_from": "2025-01-01"}}
+		mockService.On("Get", mock.Anything, params).Return([]model.ProductBatches{{ID: 1, BatchNumber: "B01", CurrentQuantity: 10, CurrentTemperature: 10, MinimumTemperature: 5, DueDate: dueDate, InitialQuantity: 10, ManufacturingDate: dueDate, ManufacturingHour: 8, ProductID: 1, SectionID: 1}}, 1, nil)
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/productBatches?section_id=1&due_date_from=2025-01-01", nil)
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		expectedJson := `{
+		"data": [{
+		"id": 1,
+		"batch_number": "B01",
+		"current_quantity": 10,
+		"current_temperature": 10,
+		"minimum_temperature": 5,
+		"due_date": "2025-01-15T00:00:00Z",
+		"initial_quantity": 10,
+		"manufacturing_date": "2025-01-15T00:00:00Z",
+		"manufacturing_hour": 8,
+		"product_id": 1,
+		"section_id": 1}],
+		"pagination": {"page": 1, "page_size": 20, "total_items": 1, "total_pages": 1}
+		}`
+		assert.Equal(t, http.StatusOK, response.Code)
+		assert.JSONEq(t, expectedJson, response.Body.String())
+	})
+
+	t.Run("given an invalid due date then return bad request", func(t *testing.T) {
+		_, r := setupProductBatchesRouter(t)
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/productBatches?due_date_to=31-01-2025", nil)
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+		assert.JSONEq(t, `{"message": "invalid due_date_to: 31-01-2025, expected format YYYY-MM-DD"}`, response.Body.String())
+	})
+}
+
+func TestHandler_GetProductBatchesByID(t *testing.T) {
+	t.Run("given an unknown id then return not found", func(t *testing.T) {
+		mockService, r := setupProductBatchesRouter(t)
+
+		mockService.On("GetByID", mock.Anything, 99).Return(model.ProductBatches{}, customerror.HandleError("product batches", customerror.ErrorNotFound, ""))
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/productBatches/99", nil)
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusNotFound, response.Code)
+		assert.JSONEq(t, `{"message": "product batches not found"}`, response.Body.String())
+	})
+
+	t.Run("given an invalid id then return bad request", func(t *testing.T) {
+		_, r := setupProductBatchesRouter(t)
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/productBatches/abc", nil)
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+	})
+}
+
+func TestHandler_UpdateProductBatches(t *testing.T) {
+	t.Run("given a quantity correction then return the updated batch", func(t *testing.T) {
+		mockService, r := setupProductBatchesRouter(t)
+
+		quantity := 8
+		mockService.On("Update", mock.Anything, 1, model.ProductBatchesUpdate{CurrentQuantity: &quantity}).Return(model.ProductBatches{ID: 1, BatchNumber: "B01", CurrentQuantity: 8, ProductID: 1, SectionID: 1}, nil)
+
+		request := httptest.NewRequest(http.MethodPatch, "/api/v1/productBatches/1", bytes.NewReader([]byte(`{"current_quantity": 8}`)))
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusOK, response.Code)
+		assert.Contains(t, response.Body.String(), `"current_quantity":8`)
+	})
+
+	t.Run("given an unknown field then return unprocessable entity", func(t *testing.T) {
+		_, r := setupProductBatchesRouter(t)
+
+		request := httptest.NewRequest(http.MethodPatch, "/api/v1/productBatches/1", bytes.NewReader([]byte(`{"batch_number": "B02"}`)))
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, response.Code)
+	})
+}
+
+func TestHandler_DeleteProductBatches(t *testing.T) {
+	t.Run("given a batch without dependencies then return no content", func(t *testing.T) {
+		mockService, r := setupProductBatchesRouter(t)
+
+		mockService.On("Delete", mock.Anything, 1).Return(nil)
+
+		request := httptest.NewRequest(http.MethodDelete, "/api/v1/productBatches/1", nil)
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusNoContent, response.Code)
+	})
+
+	t.Run("given a batch referenced by inbound orders then return conflict", func(t *testing.T) {
+		mockService, r := setupProductBatchesRouter(t)
+
+		mockService.On("Delete", mock.Anything, 1).Return(customerror.NewError(http.StatusConflict, "cannot be deleted while referenced by inbound orders", "product batches", ""))
+
+		request := httptest.NewRequest(http.MethodDelete, "/api/v1/productBatches/1", nil)
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusConflict, response.Code)
+		assert.JSONEq(t, `{"message": "product batches cannot be deleted while referenced by inbound orders"}`, response.Body.String())
+	})
+}
+
+func TestHandler_ReportExpiringProductBatches(t *testing.T) {
+	t.Run("given a number of days then return the grouped report", func(t *testing.T) {
+		hd := setupProductBatches(t)
+
+		dueDate := time.Date(2025, 1, 5, 0, 0, 0, 0, time.UTC)
+		mockService := hd.Sv.(*mocks.MockIProductBatchesService)
+		mockService.On("GetExpiringReport", mock.Anything, 10).Return([]model.ExpiringBatchesWarehouse{{
+			WarehouseID: 1, WarehouseCode: "W01",
+			Sections: []model.ExpiringBatchesSection{{SectionID: 2, SectionNumber: "S02", Batches: []model.ExpiringBatch{
+				{ID: 3, BatchNumber: "B03", CurrentQuantity: 4, DueDate: dueDate, ProductID: 5, Status: model.ExpiryStatusExpiring, SectionID: 2, WarehouseID: 1},
+			}}},
+		}}, nil)
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/productBatches/reportExpiring?days=10", nil)
+		response := httptest.NewRecorder()
+		hd.ReportExpiring(response, request)
+
+		expectedJson := `{"data": [{
+		"warehouse_id": 1,
+		"warehouse_code": "W01",
+		"sections": [{
+		"section_id": 2,
+		"section_number": "S02",
+		"batches": [{"id": 3, "batch_number": "B03", "current_quantity": 4, "due_date": "2025-01-05T00:00:00Z", "product_id": 5, "status": "expiring"}]
+		}]
+		}]}`
+		assert.Equal(t, http.StatusOK, response.Code)
+		assert.JSONEq(t, expectedJson, response.Body.String())
+	})
+
+	t.Run("given no days then use the default window", func(t *testing.T) {
+		hd := setupProductBatches(t)
+
+		mockService := hd.Sv.(*mocks.MockIProductBatchesService)
+		mockService.On("GetExpiringReport", mock.Anything, model.DefaultExpiryWindowDays).Return([]model.ExpiringBatchesWarehouse{}, nil)
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/productBatches/reportExpiring", nil)
+		response := httptest.NewRecorder()
+		hd.ReportExpiring(response, request)
+
+		assert.Equal(t, http.StatusOK, response.Code)
+		assert.JSONEq(t, `{"data": []}`, response.Body.String())
+	})
+
+	t.Run("given an invalid days param then return bad request", func(t *testing.T) {
+		hd := setupProductBatches(t)
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/productBatches/reportExpiring?days=abc", nil)
+		response := httptest.NewRecorder()
+		hd.ReportExpiring(response, request)
+
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+	})
+}
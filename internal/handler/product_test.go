@@ -21,10 +21,10 @@ func TestGetProductsHandler(t *testing.T) {
 	t.Run("GetAllProducts - should return list of products", func(t *testing.T) {
 		productServiceMock := new(mocks.MockIProductService)
 
-		productServiceMock.On("GetAllProducts").Return([]model.Product{
+		productServiceMock.On("GetAllProducts", mock.Anything, mock.Anything).Return([]model.Product{
 			{ID: 1, ProductCode: "P001", Description: "Product 1", Width: 10, Height: 20, Length: 0, NetWeight: 0, ExpirationRate: 0, RecommendedFreezingTemperature: 0, FreezingRate: 0, ProductTypeID: 0, SellerID: 0},
 			{ID: 2, ProductCode: "P002", Description: "Product 2", Width: 15, Height: 25, Length: 0, NetWeight: 0, ExpirationRate: 0, RecommendedFreezingTemperature: 0, FreezingRate: 0, ProductTypeID: 0, SellerID: 0},
-		}, nil)
+		}, 2, nil)
 
 		productHd := handler.NewProductHandler(productServiceMock, logMock)
 
@@ -33,7 +33,7 @@ func TestGetProductsHandler(t *testing.T) {
 
 		productHd.GetAllProducts(res, req)
 
-		expected := `{"data":[{"id":1,"product_code":"P001","description":"Product 1","width":10,"height":20,"length":0,"net_weight":0,"expiration_rate":0,"recommended_freezing_temperature":0,"freezing_rate":0,"product_type_id":0,"seller_id":0},{"id":2,"product_code":"P002","description":"Product 2","width":15,"height":25,"length":0,"net_weight":0,"expiration_rate":0,"recommended_freezing_temperature":0,"freezing_rate":0,"product_type_id":0,"seller_id":0}]}`
+		expected := `{"data":[{"id":1,"product_code":"P001","description":"Product 1","width":10,"height":20,"length":0,"net_weight":0,"expiration_rate":0,"recommended_freezing_temperature":0,"freezing_rate":0,"product_type_id":0,"seller_id":0},{"id":2,"product_code":"P002","description":"Product 2","width":15,"height":25,"length":0,"net_weight":0,"expiration_rate":0,"recommended_freezing_temperature":0,"freezing_rate":0,"product_type_id":0,"seller_id":0}],"pagination":{"page":1,"page_size":20,"total_items":2,"total_pages":1}}`
 
 		assert.Equal(t, http.StatusOK, res.Code)
 		assert.JSONEq(t, expected, res.Body.String())
@@ -44,7 +44,7 @@ func TestGetProductsHandler(t *testing.T) {
 	t.Run("GetAllProducts - Return error", func(t *testing.T) {
 		productServiceMock := new(mocks.MockIProductService)
 
-		productServiceMock.On("GetAllProducts").Return([]model.Product{}, errors.New("error to get all products"))
+		productServiceMock.On("GetAllProducts", mock.Anything, mock.Anything).Return([]model.Product{}, 0, errors.New("error to get all products"))
 
 		productHd := handler.NewProductHandler(productServiceMock, logMock)
 
@@ -61,13 +61,31 @@ func TestGetProductsHandler(t *testing.T) {
 		productServiceMock.AssertExpectations(t)
 	})
 
+	t.Run("GetAllProducts - Return bad request for invalid page", func(t *testing.T) {
+		productServiceMock := new(mocks.MockIProductService)
+
+		productHd := handler.NewProductHandler(productServiceMock, logMock)
+
+		req := httptest.NewRequest("GET", "/api/v1/products?page=0", nil)
+		res := httptest.NewRecorder()
+
+		productHd.GetAllProducts(res, req)
+
+		expected := `{"message":"invalid page: 0"}`
+
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+		assert.JSONEq(t, expected, res.Body.String())
+
+		productServiceMock.AssertNotCalled(t, "GetAllProducts", mock.Anything, mock.Anything)
+	})
+
 }
 
 func TestGetProductById(t *testing.T) {
 	t.Run("GetProductByID - Success", func(t *testing.T) {
 		productServiceMock := new(mocks.MockIProductService)
 
-		productServiceMock.On("GetProductByID", 1).Return(model.Product{
+		productServiceMock.On("GetProductByID", mock.Anything, 1).Return(model.Product{
 			ID:                             1,
 			ProductCode:                    "P001",
 			Description:                    "Product 1",
@@ -124,7 +142,7 @@ func TestGetProductById(t *testing.T) {
 	t.Run("GetProductByID - Error when getting a product", func(t *testing.T) {
 		productServiceMock := new(mocks.MockIProductService)
 
-		productServiceMock.On("GetProductByID", 1).Return(model.Product{}, errors.New("product not found"))
+		productServiceMock.On("GetProductByID", mock.Anything, 1).Return(model.Product{}, errors.New("product not found"))
 
 		productHd := handler.NewProductHandler(productServiceMock, logMock)
 
@@ -150,7 +168,7 @@ func TestInsertProduct(t *testing.T) {
 	t.Run("Create Product - Success", func(t *testing.T) {
 		productServiceMock := new(mocks.MockIProductService)
 
-		productServiceMock.On("CreateProduct", mock.Anything).Return(model.Product{ID: 1, ProductCode: "P003", Description: "New Product", Width: 1, Height: 1, Length: 1, NetWeight: 1, ExpirationRate: 1, RecommendedFreezingTemperature: 1, FreezingRate: 1, ProductTypeID: 1, SellerID: 1}, nil)
+		productServiceMock.On("CreateProduct", mock.Anything, mock.Anything).Return(model.Product{ID: 1, ProductCode: "P003", Description: "New Product", Width: 1, Height: 1, Length: 1, NetWeight: 1, ExpirationRate: 1, RecommendedFreezingTemperature: 1, FreezingRate: 1, ProductTypeID: 1, SellerID: 1}, nil)
 
 		productHd := handler.NewProductHandler(productServiceMock, logMock)
 
@@ -202,7 +220,7 @@ func TestInsertProduct(t *testing.T) {
 	t.Run("Create Product - Success", func(t *testing.T) {
 		productServiceMock := new(mocks.MockIProductService)
 
-		productServiceMock.On("CreateProduct", mock.Anything).Return(model.Product{}, errors.New("product error"))
+		productServiceMock.On("CreateProduct", mock.Anything, mock.Anything).Return(model.Product{}, errors.New("product error"))
 
 		productHd := handler.NewProductHandler(productServiceMock, logMock)
 
@@ -238,7 +256,7 @@ func TestUpdateProduct(t *testing.T) {
 	t.Run("Update - Success", func(t *testing.T) {
 		productServiceMock := new(mocks.MockIProductService)
 
-		productServiceMock.On("UpdateProduct", 1, mock.Anything).Return(model.Product{ID: 1, ProductCode: "P003", Description: "Updated Product", Width: 0, Height: 0, Length: 0, NetWeight: 0, ExpirationRate: 0, RecommendedFreezingTemperature: 0, FreezingRate: 0, ProductTypeID: 0, SellerID: 0}, nil)
+		productServiceMock.On("UpdateProduct", mock.Anything, 1, mock.Anything).Return(model.Product{ID: 1, ProductCode: "P003", Description: "Updated Product", Width: 0, Height: 0, Length: 0, NetWeight: 0, ExpirationRate: 0, RecommendedFreezingTemperature: 0, FreezingRate: 0, ProductTypeID: 0, SellerID: 0}, nil)
 
 		productHd := handler.NewProductHandler(productServiceMock, logMock)
 
@@ -262,7 +280,7 @@ func TestUpdateProduct(t *testing.T) {
 	t.Run("Update - Not found", func(t *testing.T) {
 		productServiceMock := new(mocks.MockIProductService)
 
-		productServiceMock.On("UpdateProduct", 2, mock.Anything).Return(model.Product{}, customerror.HandleError("product", customerror.ErrorNotFound, ""))
+		productServiceMock.On("UpdateProduct", mock.Anything, 2, mock.Anything).Return(model.Product{}, customerror.HandleError("product", customerror.ErrorNotFound, ""))
 
 		productHd := handler.NewProductHandler(productServiceMock, logMock)
 
@@ -338,7 +356,7 @@ func TestDeleteProduct(t *testing.T) {
 	t.Run("DeleteProduct - Success", func(t *testing.T) {
 		productServiceMock := new(mocks.MockIProductService)
 
-		productServiceMock.On("DeleteProduct", 1).Return(nil)
+		productServiceMock.On("DeleteProduct", mock.Anything, 1).Return(nil)
 
 		productHd := handler.NewProductHandler(productServiceMock, logMock)
 
@@ -376,7 +394,7 @@ func TestDeleteProduct(t *testing.T) {
 	t.Run("DeleteProduct - Error not found", func(t *testing.T) {
 		productServiceMock := new(mocks.MockIProductService)
 
-		productServiceMock.On("DeleteProduct", 2).Return(customerror.HandleError("product", customerror.ErrorNotFound, ""))
+		productServiceMock.On("DeleteProduct", mock.Anything, 2).Return(customerror.HandleError("product", customerror.ErrorNotFound, ""))
 
 		productHd := handler.NewProductHandler(productServiceMock, logMock)
 
@@ -397,7 +415,7 @@ func TestDeleteProduct(t *testing.T) {
 	t.Run("DeleteProduct - Error generic", func(t *testing.T) {
 		productServiceMock := new(mocks.MockIProductService)
 
-		productServiceMock.On("DeleteProduct", 2).Return(errors.New("generic error"))
+		productServiceMock.On("DeleteProduct", mock.Anything, 2).Return(errors.New("generic error"))
 
 		productHd := handler.NewProductHandler(productServiceMock, logMock)
 
@@ -0,0 +1,295 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/bootcamp-go/web/response"
+	"github.com/go-chi/chi/v5"
+	"github.com/maxwelbm/alkemy-g7.git/internal/handler/responses"
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+	"github.com/maxwelbm/alkemy-g7.git/internal/service/interfaces"
+	"github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
+	"github.com/maxwelbm/alkemy-g7.git/pkg/logger"
+)
+
+type ProductTypeHandler struct {
+	Svc interfaces.IProductTypeService
+	log logger.Logger
+}
+
+func NewProductTypeHandler(svc interfaces.IProductTypeService, log logger.Logger) *ProductTypeHandler {
+	return &ProductTypeHandler{Svc: svc, log: log}
+}
+
+// GetAll retrieves all product types.
+// @Summary Retrieve all product types
+// @Description Fetch all registered product types from the database
+// @Tags ProductType
+// @Produce json
+// @Success 200 {object} model.ProductTypeResponseSwagger
+// @Failure 500 {object} model.ErrorResponseSwagger "Unable to list product types"
+// @Router /productTypes [get]
+func (h *ProductTypeHandler) GetAll(w http.ResponseWriter, r *http.Request) {
+	h.log.Log("ProductTypeHandler", "INFO", "initializing GetAll function")
+
+	productTypes, err := h.Svc.GetAll(r.Context())
+	if err != nil {
+		if err, ok := err.(*customerror.GenericError); ok {
+			response.JSON(w, err.Code, responses.CreateResponseBody(err.Error(), nil))
+			h.log.Log("ProductTypeHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+
+			return
+		}
+
+		response.JSON(w, http.StatusInternalServerError, responses.CreateResponseBody("unable to list product types", nil))
+		h.log.Log("ProductTypeHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	response.JSON(w, http.StatusOK, responses.CreateResponseBody("", productTypes))
+	h.log.Log("ProductTypeHandler", "INFO", "returning all product types")
+}
+
+// GetByID retrieves a product type by its ID.
+// @Summary Retrieve a product type
+// @Description Fetch the product type identified by the provided ID
+// @Tags ProductType
+// @Produce json
+// @Param id path int true "Product type ID"
+// @Success 200 {object} model.ProductTypeResponseSwagger{data=model.ProductType}
+// @Failure 400 {object} model.ErrorResponseSwagger "Invalid ID"
+// @Failure 404 {object} model.ErrorResponseSwagger "Product type not found"
+// @Failure 500 {object} model.ErrorResponseSwagger "Unable to search for product type"
+// @Router /productTypes/{id} [get]
+func (h *ProductTypeHandler) GetByID(w http.ResponseWriter, r *http.Request) {
+	h.log.Log("ProductTypeHandler", "INFO", "initializing GetByID function")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody("invalid id", nil))
+		h.log.Log("ProductTypeHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	productType, err := h.Svc.GetByID(r.Context(), id)
+	if err != nil {
+		if err, ok := err.(*customerror.GenericError); ok {
+			response.JSON(w, err.Code, responses.CreateResponseBody(err.Error(), nil))
+			h.log.Log("ProductTypeHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+
+			return
+		}
+
+		response.JSON(w, http.StatusInternalServerError, responses.CreateResponseBody("unable to search for product type", nil))
+		h.log.Log("ProductTypeHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	response.JSON(w, http.StatusOK, responses.CreateResponseBody("", productType))
+	h.log.Log("ProductTypeHandler", "INFO", fmt.Sprintf("returning product type with id %d", id))
+}
+
+// Create creates a new product type.
+// @Summary Create a new product type
+// @Description This endpoint allows for creating a new product type.
+// @Tags ProductType
+// @Accept json
+// @Produce json
+// @Param productType body model.ProductType true "Product type information"
+// @Success 201 {object} model.ProductTypeResponseSwagger{data=model.ProductType}
+// @Failure 422 {object} model.ErrorResponseSwagger "Invalid input"
+// @Failure 500 {object} model.ErrorResponseSwagger "Unable to create product type"
+// @Router /productTypes [post]
+func (h *ProductTypeHandler) Create(w http.ResponseWriter, r *http.Request) {
+	h.log.Log("ProductTypeHandler", "INFO", "initializing Create function")
+
+	var body model.ProductType
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		response.JSON(w, http.StatusUnprocessableEntity, responses.CreateResponseBody("invalid json syntax", nil))
+		h.log.Log("ProductTypeHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	productType, err := h.Svc.Create(r.Context(), body)
+	if err != nil {
+		if err, ok := err.(*customerror.GenericError); ok {
+			response.JSON(w, err.Code, responses.CreateResponseBody(err.Error(), nil))
+			h.log.Log("ProductTypeHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+
+			return
+		}
+
+		response.JSON(w, http.StatusInternalServerError, responses.CreateResponseBody("unable to create product type", nil))
+		h.log.Log("ProductTypeHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, responses.CreateResponseBody("", productType))
+	h.log.Log("ProductTypeHandler", "INFO", "product type created successfully")
+}
+
+// Update updates an existing product type.
+// @Summary Update a product type
+// @Description This endpoint allows for updating the product type identified by the provided ID.
+// @Tags ProductType
+// @Accept json
+// @Produce json
+// @Param id path int true "Product type ID"
+// @Param productType body model.ProductType true "Product type information"
+// @Success 200 {object} model.ProductTypeResponseSwagger{data=model.ProductType}
+// @Failure 400 {object} model.ErrorResponseSwagger "Invalid ID"
+// @Failure 404 {object} model.ErrorResponseSwagger "Product type not found"
+// @Failure 422 {object} model.ErrorResponseSwagger "Invalid input"
+// @Failure 500 {object} model.ErrorResponseSwagger "Unable to update product type"
+// @Router /productTypes/{id} [patch]
+func (h *ProductTypeHandler) Update(w http.ResponseWriter, r *http.Request) {
+	h.log.Log("ProductTypeHandler", "INFO", "initializing Update function")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody("invalid id", nil))
+		h.log.Log("ProductTypeHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	var body model.ProductType
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		response.JSON(w, http.StatusUnprocessableEntity, responses.CreateResponseBody("invalid json syntax", nil))
+		h.log.Log("ProductTypeHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	productType, err := h.Svc.Update(r.Context(), id, body)
+	if err != nil {
+		if err, ok := err.(*customerror.GenericError); ok {
+			response.JSON(w, err.Code, responses.CreateResponseBody(err.Error(), nil))
+			h.log.Log("ProductTypeHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+
+			return
+		}
+
+		response.JSON(w, http.StatusInternalServerError, responses.CreateResponseBody("unable to update product type", nil))
+		h.log.Log("ProductTypeHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	response.JSON(w, http.StatusOK, responses.CreateResponseBody("", productType))
+	h.log.Log("ProductTypeHandler", "INFO", fmt.Sprintf("product type with id %d updated successfully", id))
+}
+
+// Delete deletes a product type by its ID.
+// @Summary Delete a product type
+// @Description Deletes the product type identified by the provided ID, as long as no product or section uses it.
+// @Tags ProductType
+// @Param id path int true "Product type ID"
+// @Success 204 {object} nil "Product type successfully deleted"
+// @Failure 400 {object} model.ErrorResponseSwagger "Invalid ID"
+// @Failure 404 {object} model.ErrorResponseSwagger "Product type not found"
+// @Failure 409 {object} model.ErrorResponseSwagger "Product type in use by products or sections"
+// @Failure 500 {object} model.ErrorResponseSwagger "Unable to delete product type"
+// @Router /productTypes/{id} [delete]
+func (h *ProductTypeHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	h.log.Log("ProductTypeHandler", "INFO", "initializing Delete function")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody("invalid id", nil))
+		h.log.Log("ProductTypeHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	err = h.Svc.Delete(r.Context(), id)
+	if err != nil {
+		if err, ok := err.(*customerror.GenericError); ok {
+			response.JSON(w, err.Code, responses.CreateResponseBody(err.Error(), nil))
+			h.log.Log("ProductTypeHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+
+			return
+		}
+
+		response.JSON(w, http.StatusInternalServerError, responses.CreateResponseBody("unable to delete product type", nil))
+		h.log.Log("ProductTypeHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	response.JSON(w, http.StatusNoContent, nil)
+	h.log.Log("ProductTypeHandler", "INFO", fmt.Sprintf("product type with id %d deleted successfully", id))
+}
+
+// GetUsageReport reports how many products and sections use each product type.
+// @Summary Product type usage report
+// @Description Returns the number of products and sections per product type, or for a single product type when id is given.
+// @Tags ProductType
+// @Produce json
+// @Param id query int false "Product type ID"
+// @Success 200 {object} model.ProductTypeUsageResponseSwagger
+// @Failure 400 {object} model.ErrorResponseSwagger "Invalid ID"
+// @Failure 404 {object} model.ErrorResponseSwagger "Product type not found"
+// @Failure 500 {object} model.ErrorResponseSwagger "Unable to build the product type report"
+// @Router /productTypes/reportUsage [get]
+func (h *ProductTypeHandler) GetUsageReport(w http.ResponseWriter, r *http.Request) {
+	h.log.Log("ProductTypeHandler", "INFO", "initializing GetUsageReport function")
+
+	idStr := r.URL.Query().Get("id")
+
+	if idStr == "" {
+		usage, err := h.Svc.GetUsageReport(r.Context())
+		if err != nil {
+			if err, ok := err.(*customerror.GenericError); ok {
+				response.JSON(w, err.Code, responses.CreateResponseBody(err.Error(), nil))
+				h.log.Log("ProductTypeHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+
+				return
+			}
+
+			response.JSON(w, http.StatusInternalServerError, responses.CreateResponseBody("unable to build the product type report", nil))
+			h.log.Log("ProductTypeHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+
+			return
+		}
+
+		response.JSON(w, http.StatusOK, responses.CreateResponseBody("", usage))
+		h.log.Log("ProductTypeHandler", "INFO", "returning product type usage report")
+
+		return
+	}
+
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody("invalid id", nil))
+		h.log.Log("ProductTypeHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	usage, err := h.Svc.GetUsageReportByID(r.Context(), id)
+	if err != nil {
+		if err, ok := err.(*customerror.GenericError); ok {
+			response.JSON(w, err.Code, responses.CreateResponseBody(err.Error(), nil))
+			h.log.Log("ProductTypeHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+
+			return
+		}
+
+		response.JSON(w, http.StatusInternalServerError, responses.CreateResponseBody("unable to build the product type report", nil))
+		h.log.Log("ProductTypeHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	response.JSON(w, http.StatusOK, responses.CreateResponseBody("", []model.ProductTypeUsage{usage}))
+	h.log.Log("ProductTypeHandler", "INFO", fmt.Sprintf("returning usage report for product type with id %d", id))
+}
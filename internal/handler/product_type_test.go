@@ -0,0 +1,203 @@
+package handler_test
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/maxwelbm/alkemy-g7.git/internal/handler"
+	"github.com/maxwelbm/alkemy-g7.git/internal/mocks"
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+	"github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func setupProductType(t *testing.T) (*handler.ProductTypeHandler, *mocks.MockIProductTypeService, *chi.Mux) {
+	mockSvc := mocks.NewMockIProductTypeService(t)
+	hd := handler.NewProductTypeHandler(mockSvc, logMock)
+
+	r := chi.NewRouter()
+	r.Get("/api/v1/productTypes", hd.GetAll)
+	r.Get("/api/v1/productTypes/reportUsage", hd.GetUsageReport)
+	r.Get("/api/v1/productTypes/{id}", hd.GetByID)
+	r.Post("/api/v1/productTypes", hd.Create)
+	r.Patch("/api/v1/productTypes/{id}", hd.Update)
+	r.Delete("/api/v1/productTypes/{id}", hd.Delete)
+
+	return hd, mockSvc, r
+}
+
+func TestProductTypeHandler_GetAll(t *testing.T) {
+	t.Run("return all product types", func(t *testing.T) {
+		_, mockSvc, r := setupProductType(t)
+
+		mockSvc.On("GetAll", mock.Anything).Return([]model.ProductType{{ID: 1, TypeName: "Frozen"}}, nil)
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/productTypes", nil)
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusOK, response.Code)
+		assert.JSONEq(t, `{"data":[{"id":1,"type_name":"Frozen"}]}`, response.Body.String())
+	})
+
+	t.Run("return internal server error", func(t *testing.T) {
+		_, mockSvc, r := setupProductType(t)
+
+		mockSvc.On("GetAll", mock.Anything).Return(nil, errors.New("db down"))
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/productTypes", nil)
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusInternalServerError, response.Code)
+		assert.JSONEq(t, `{"message":"unable to list product types"}`, response.Body.String())
+	})
+}
+
+func TestProductTypeHandler_GetByID(t *testing.T) {
+	t.Run("return the product type", func(t *testing.T) {
+		_, mockSvc, r := setupProductType(t)
+
+		mockSvc.On("GetByID", mock.Anything, 1).Return(model.ProductType{ID: 1, TypeName: "Frozen"}, nil)
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/productTypes/1", nil)
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusOK, response.Code)
+		assert.JSONEq(t, `{"data":{"id":1,"type_name":"Frozen"}}`, response.Body.String())
+	})
+
+	t.Run("return not found", func(t *testing.T) {
+		_, mockSvc, r := setupProductType(t)
+
+		mockSvc.On("GetByID", mock.Anything, 99).Return(model.ProductType{}, customerror.HandleError("product type", customerror.ErrorNotFound, ""))
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/productTypes/99", nil)
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusNotFound, response.Code)
+		assert.JSONEq(t, `{"message":"product type not found"}`, response.Body.String())
+	})
+
+	t.Run("return bad request for an invalid id", func(t *testing.T) {
+		_, _, r := setupProductType(t)
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/productTypes/abc", nil)
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+	})
+}
+
+func TestProductTypeHandler_Create(t *testing.T) {
+	t.Run("create the product type", func(t *testing.T) {
+		_, mockSvc, r := setupProductType(t)
+
+		mockSvc.On("Create", mock.Anything, model.ProductType{TypeName: "Frozen"}).Return(model.ProductType{ID: 1, TypeName: "Frozen"}, nil)
+
+		request := httptest.NewRequest(http.MethodPost, "/api/v1/productTypes", bytes.NewReader([]byte(`{"type_name":"Frozen"}`)))
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusCreated, response.Code)
+		assert.JSONEq(t, `{"data":{"id":1,"type_name":"Frozen"}}`, response.Body.String())
+	})
+
+	t.Run("return unprocessable entity for an invalid body", func(t *testing.T) {
+		_, _, r := setupProductType(t)
+
+		request := httptest.NewRequest(http.MethodPost, "/api/v1/productTypes", bytes.NewReader([]byte(`{"type_name":`)))
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, response.Code)
+	})
+}
+
+func TestProductTypeHandler_Update(t *testing.T) {
+	t.Run("update the product type", func(t *testing.T) {
+		_, mockSvc, r := setupProductType(t)
+
+		mockSvc.On("Update", mock.Anything, 1, model.ProductType{TypeName: "Chilled"}).Return(model.ProductType{ID: 1, TypeName: "Chilled"}, nil)
+
+		request := httptest.NewRequest(http.MethodPatch, "/api/v1/productTypes/1", bytes.NewReader([]byte(`{"type_name":"Chilled"}`)))
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusOK, response.Code)
+		assert.JSONEq(t, `{"data":{"id":1,"type_name":"Chilled"}}`, response.Body.String())
+	})
+}
+
+func TestProductTypeHandler_Delete(t *testing.T) {
+	t.Run("delete the product type", func(t *testing.T) {
+		_, mockSvc, r := setupProductType(t)
+
+		mockSvc.On("Delete", mock.Anything, 1).Return(nil)
+
+		request := httptest.NewRequest(http.MethodDelete, "/api/v1/productTypes/1", nil)
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusNoContent, response.Code)
+	})
+
+	t.Run("return conflict when the product type is in use", func(t *testing.T) {
+		_, mockSvc, r := setupProductType(t)
+
+		mockSvc.On("Delete", mock.Anything, 1).Return(customerror.HandleError("product type", customerror.ErrorDep, ""))
+
+		request := httptest.NewRequest(http.MethodDelete, "/api/v1/productTypes/1", nil)
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusConflict, response.Code)
+		assert.JSONEq(t, `{"message":"product type cannot be deleted because there are dependencies"}`, response.Body.String())
+	})
+}
+
+func TestProductTypeHandler_GetUsageReport(t *testing.T) {
+	t.Run("return the usage of every product type", func(t *testing.T) {
+		_, mockSvc, r := setupProductType(t)
+
+		mockSvc.On("GetUsageReport", mock.Anything).Return([]model.ProductTypeUsage{{ID: 1, TypeName: "Frozen", ProductsCount: 3, SectionsCount: 1}}, nil)
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/productTypes/reportUsage", nil)
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusOK, response.Code)
+		assert.JSONEq(t, `{"data":[{"id":1,"type_name":"Frozen","products_count":3,"sections_count":1}]}`, response.Body.String())
+	})
+
+	t.Run("return the usage of a single product type", func(t *testing.T) {
+		_, mockSvc, r := setupProductType(t)
+
+		mockSvc.On("GetUsageReportByID", mock.Anything, 1).Return(model.ProductTypeUsage{ID: 1, TypeName: "Frozen", ProductsCount: 3}, nil)
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/productTypes/reportUsage?id=1", nil)
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusOK, response.Code)
+		assert.JSONEq(t, `{"data":[{"id":1,"type_name":"Frozen","products_count":3,"sections_count":0}]}`, response.Body.String())
+	})
+
+	t.Run("return bad request for an invalid id", func(t *testing.T) {
+		_, _, r := setupProductType(t)
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/productTypes/reportUsage?id=x", nil)
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+	})
+}
@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/bootcamp-go/web/response"
+	"github.com/go-chi/chi/v5"
 	responses "github.com/maxwelbm/alkemy-g7.git/internal/handler/responses"
 	"github.com/maxwelbm/alkemy-g7.git/internal/model"
 	"github.com/maxwelbm/alkemy-g7.git/internal/service/interfaces"
@@ -47,7 +49,7 @@ func (prh *ProductRecHandler) CreateProductRecServ(w http.ResponseWriter, r *htt
 		return
 	}
 
-	product, err := prh.ProductRecServ.CreateProductRecords(productRecBody)
+	product, err := prh.ProductRecServ.CreateProductRecords(r.Context(), productRecBody)
 	if err != nil {
 		if appErr, ok := err.(*customerror.GenericError); ok {
 			prh.log.Log("ProductRecHandler", "ERROR", fmt.Sprintf("Error creating product record: %s", appErr.Error()))
@@ -64,6 +66,117 @@ func (prh *ProductRecHandler) CreateProductRecServ(w http.ResponseWriter, r *htt
 	response.JSON(w, http.StatusCreated, responses.CreateResponseBody("", product))
 }
 
+// GetProductRecords retrieves the product records.
+// @Summary Retrieve product records
+// @Description This endpoint lists the product records, optionally filtered by product and update date range, with pagination.
+// @Tags ProductRecord
+// @Produce json
+// @Param product_id query int false "Product ID"
+// @Param last_update_date_from query string false "First update day (YYYY-MM-DD)"
+// @Param last_update_date_to query string false "Last update day (YYYY-MM-DD)"
+// @Param page query int false "Page number"
+// @Param page_size query int false "Page size"
+// @Param sort query string false "Sort key, prefixed with - for descending order"
+// @Success 200 {object} model.ProductRecordResponseSwagger{data=[]model.ProductRecords}
+// @Failure 400 {object} model.ErrorResponseSwagger "Invalid Parameter"
+// @Failure 500 {object} model.ErrorResponseSwagger "Internal Server Error"
+// @Router /productRecords [get]
+func (prh *ProductRecHandler) GetProductRecords(w http.ResponseWriter, r *http.Request) {
+	prh.log.Log("ProductRecHandler", "INFO", "GetProductRecords function initializing")
+
+	params, err := model.ParseListParams(r.URL.Query(), model.ProductRecordsListOptions)
+	if err != nil {
+		prh.log.Log("ProductRecHandler", "ERROR", "Invalid list parameters: "+err.Error())
+		response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody(err.Error(), nil))
+		return
+	}
+
+	for _, key := range []string{"last_update_date_from", "last_update_date_to"} {
+		if value, ok := params.Filters[key]; ok {
+			if _, err := time.Parse(time.DateOnly, value); err != nil {
+				prh.log.Log("ProductRecHandler", "ERROR", "Invalid date filter: "+err.Error())
+				response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody(fmt.Sprintf("invalid %s: %s, expected format YYYY-MM-DD", key, value), nil))
+				return
+			}
+		}
+	}
+
+	productRecords, total, err := prh.ProductRecServ.GetProductRecords(r.Context(), params)
+	if err != nil {
+		prh.handleError(w, err, "Error getting product records")
+		return
+	}
+
+	if productRecords == nil {
+		productRecords = []model.ProductRecords{}
+	}
+
+	prh.log.Log("ProductRecHandler", "INFO", "Product records retrieved successfully")
+	response.JSON(w, http.StatusOK, responses.CreatePaginatedResponseBody("", productRecords, params.Page, params.PageSize, total))
+}
+
+// GetProductRecordByID retrieves a product record by its ID.
+// @Summary Retrieve a product record
+// @Description This endpoint returns the product record with the given ID.
+// @Tags ProductRecord
+// @Produce json
+// @Param id path int true "Product record ID"
+// @Success 200 {object} model.ProductRecordResponseSwagger{data=model.ProductRecords}
+// @Failure 400 {object} model.ErrorResponseSwagger "Invalid ID"
+// @Failure 404 {object} model.ErrorResponseSwagger "Product record not found"
+// @Failure 500 {object} model.ErrorResponseSwagger "Internal Server Error"
+// @Router /productRecords/{id} [get]
+func (prh *ProductRecHandler) GetProductRecordByID(w http.ResponseWriter, r *http.Request) {
+	prh.log.Log("ProductRecHandler", "INFO", "GetProductRecordByID function initializing")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		prh.log.Log("ProductRecHandler", "ERROR", "Invalid ID provided in the request: "+chi.URLParam(r, "id"))
+		response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody("invalid id", nil))
+		return
+	}
+
+	productRecord, err := prh.ProductRecServ.GetProductRecordByID(r.Context(), id)
+	if err != nil {
+		prh.handleError(w, err, "Error getting product record")
+		return
+	}
+
+	prh.log.Log("ProductRecHandler", "INFO", fmt.Sprintf("Successfully retrieved product record with ID: %d", id))
+	response.JSON(w, http.StatusOK, responses.CreateResponseBody("", productRecord))
+}
+
+// DeleteProductRecord deletes a product record by its ID.
+// @Summary Delete a product record
+// @Description This endpoint deletes the product record with the given ID, unless a purchase order is priced with it.
+// @Tags ProductRecord
+// @Produce json
+// @Param id path int true "Product record ID"
+// @Success 204 {object} nil "Product record successfully deleted"
+// @Failure 400 {object} model.ErrorResponseSwagger "Invalid ID"
+// @Failure 404 {object} model.ErrorResponseSwagger "Product record not found"
+// @Failure 409 {object} model.ErrorResponseSwagger "Product record referenced by purchase orders"
+// @Failure 500 {object} model.ErrorResponseSwagger "Internal Server Error"
+// @Router /productRecords/{id} [delete]
+func (prh *ProductRecHandler) DeleteProductRecord(w http.ResponseWriter, r *http.Request) {
+	prh.log.Log("ProductRecHandler", "INFO", "DeleteProductRecord function initializing")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		prh.log.Log("ProductRecHandler", "ERROR", "Invalid ID provided for deletion: "+chi.URLParam(r, "id"))
+		response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody("invalid id", nil))
+		return
+	}
+
+	if err := prh.ProductRecServ.DeleteProductRecord(r.Context(), id); err != nil {
+		prh.handleError(w, err, "Error deleting product record")
+		return
+	}
+
+	prh.log.Log("ProductRecHandler", "INFO", fmt.Sprintf("Product record with ID: %d successfully deleted", id))
+	response.JSON(w, http.StatusNoContent, nil)
+}
+
 // GetProductRecReport retrieves a product record report.
 // @Summary Retrieve a product record report
 // @Description This endpoint retrieves the product record report based on the provided product ID. If no ID is provided, it returns all records.
@@ -90,7 +203,7 @@ func (prh *ProductRecHandler) GetProductRecReport(w http.ResponseWriter, r *http
 		}
 	}
 
-	product, err := prh.ProductRecServ.GetProductRecordReport(idProduct)
+	product, err := prh.ProductRecServ.GetProductRecordReport(r.Context(), idProduct)
 	if err != nil {
 		if appErr, ok := err.(*customerror.GenericError); ok {
 			prh.log.Log("ProductRecHandler", "ERROR", fmt.Sprintf("Error getting product record report: %s", appErr.Error()))
@@ -111,4 +224,139 @@ func (prh *ProductRecHandler) GetProductRecReport(w http.ResponseWriter, r *http
 
 	prh.log.Log("ProductRecHandler", "INFO", "Product record report retrieved successfully")
 	response.JSON(w, http.StatusOK, responses.CreateResponseBody("", product))
-}
\ No newline at end of file
+}
+// GetProductPriceHistory retrieves the price history of a product.
+// @Summary Retrieve the price history of a product
+// @Description This endpoint returns the purchase and sale prices recorded for the product, oldest first, with the margin of each record and the current prices. The history can be limited to a period.
+// @Tags ProductRecord
+// @Produce json
+// @Param id path int true "Product ID"
+// @Param from query string false "First day of the period (YYYY-MM-DD)"
+// @Param to query string false "Last day of the period (YYYY-MM-DD)"
+// @Success 200 {object} model.ProductPriceHistory
+// @Failure 400 {object} model.ErrorResponseSwagger "Invalid Parameter"
+// @Failure 404 {object} model.ErrorResponseSwagger "Product not found"
+// @Failure 422 {object} model.ErrorResponseSwagger "Invalid period"
+// @Failure 500 {object} model.ErrorResponseSwagger "Internal Server Error"
+// @Router /products/{id}/priceHistory [get]
+func (prh *ProductRecHandler) GetProductPriceHistory(w http.ResponseWriter, r *http.Request) {
+	prh.log.Log("ProductRecHandler", "INFO", "GetProductPriceHistory function initializing")
+
+	idProduct, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		prh.log.Log("ProductRecHandler", "ERROR", "Invalid ID provided in the request: "+chi.URLParam(r, "id"))
+		response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody("invalid id", nil))
+		return
+	}
+
+	from, to, err := parsePeriod(r)
+	if err != nil {
+		prh.log.Log("ProductRecHandler", "ERROR", "Invalid period: "+err.Error())
+		response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody(err.Error(), nil))
+		return
+	}
+
+	history, err := prh.ProductRecServ.GetProductPriceHistory(r.Context(), idProduct, from, to)
+	if err != nil {
+		prh.handleError(w, err, "Error getting product price history")
+		return
+	}
+
+	prh.log.Log("ProductRecHandler", "INFO", "Product price history retrieved successfully")
+	response.JSON(w, http.StatusOK, responses.CreateResponseBody("", history))
+}
+
+// GetProductMarginReport retrieves the margin report of the products.
+// @Summary Retrieve the margin report
+// @Description This endpoint returns the average, minimum and maximum margin and the sale price change of each product over a period. Margins are percentages of the sale price. With group_by=seller the products of each seller are rolled up.
+// @Tags ProductRecord
+// @Produce json
+// @Param seller_id query int false "Seller ID"
+// @Param product_id query int false "Product ID"
+// @Param from query string false "First day of the period (YYYY-MM-DD)"
+// @Param to query string false "Last day of the period (YYYY-MM-DD)"
+// @Param group_by query string false "product (default) or seller"
+// @Success 200 {object} model.ProductMarginReport
+// @Failure 400 {object} model.ErrorResponseSwagger "Invalid Parameter"
+// @Failure 404 {object} model.ErrorResponseSwagger "Product not found"
+// @Failure 422 {object} model.ErrorResponseSwagger "Invalid period"
+// @Failure 500 {object} model.ErrorResponseSwagger "Internal Server Error"
+// @Router /products/reportMargins [get]
+func (prh *ProductRecHandler) GetProductMarginReport(w http.ResponseWriter, r *http.Request) {
+	prh.log.Log("ProductRecHandler", "INFO", "GetProductMarginReport function initializing")
+
+	var filter model.ProductMarginFilter
+
+	var err error
+
+	params := []struct {
+		key    string
+		target *int
+	}{{"seller_id", &filter.SellerID}, {"product_id", &filter.ProductID}}
+
+	for _, param := range params {
+		if value := r.URL.Query().Get(param.key); value != "" {
+			if *param.target, err = strconv.Atoi(value); err != nil || *param.target <= 0 {
+				prh.log.Log("ProductRecHandler", "ERROR", fmt.Sprintf("Invalid parameter: %s %s", param.key, value))
+				response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody(fmt.Sprintf("invalid %s: %s", param.key, value), nil))
+				return
+			}
+		}
+	}
+
+	filter.From, filter.To, err = parsePeriod(r)
+	if err != nil {
+		prh.log.Log("ProductRecHandler", "ERROR", "Invalid period: "+err.Error())
+		response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody(err.Error(), nil))
+		return
+	}
+
+	var report any
+
+	switch groupBy := r.URL.Query().Get("group_by"); groupBy {
+	case "", "product":
+		report, err = prh.ProductRecServ.GetProductMarginReport(r.Context(), filter)
+	case "seller":
+		report, err = prh.ProductRecServ.GetSellerMarginReport(r.Context(), filter)
+	default:
+		prh.log.Log("ProductRecHandler", "ERROR", "Invalid parameter: group_by "+groupBy)
+		response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody(fmt.Sprintf("invalid group_by: %s, allowed values are product,seller", groupBy), nil))
+		return
+	}
+
+	if err != nil {
+		prh.handleError(w, err, "Error getting margin report")
+		return
+	}
+
+	prh.log.Log("ProductRecHandler", "INFO", "Margin report retrieved successfully")
+	response.JSON(w, http.StatusOK, responses.CreateResponseBody("", report))
+}
+
+func (prh *ProductRecHandler) handleError(w http.ResponseWriter, err error, message string) {
+	if appErr, ok := err.(*customerror.GenericError); ok {
+		prh.log.Log("ProductRecHandler", "ERROR", fmt.Sprintf("%s: %s", message, appErr.Error()))
+		response.JSON(w, appErr.Code, responses.CreateResponseBody(appErr.Error(), nil))
+		return
+	}
+
+	prh.log.Log("ProductRecHandler", "ERROR", fmt.Sprintf("%s: %v", message, err))
+	response.JSON(w, http.StatusInternalServerError, responses.CreateResponseBody("Internal Server Error", nil))
+}
+
+// parsePeriod reads the optional from and to days of the query.
+func parsePeriod(r *http.Request) (from, to time.Time, err error) {
+	if value := r.URL.Query().Get("from"); value != "" {
+		if from, err = time.Parse(time.DateOnly, value); err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid from: %s, expected format YYYY-MM-DD", value)
+		}
+	}
+
+	if value := r.URL.Query().Get("to"); value != "" {
+		if to, err = time.Parse(time.DateOnly, value); err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid to: %s, expected format YYYY-MM-DD", value)
+		}
+	}
+
+	return from, to, nil
+}
@@ -7,7 +7,9 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
+	"github.com/go-chi/chi/v5"
 	"github.com/maxwelbm/alkemy-g7.git/internal/handler"
 	"github.com/maxwelbm/alkemy-g7.git/internal/mocks"
 	"github.com/maxwelbm/alkemy-g7.git/internal/model"
@@ -28,7 +30,7 @@ func TestCreateProductRecServ(t *testing.T) {
 			SalePrice:     32.4,
 		}
 
-		productRecServiceMock.On("CreateProductRecords", productRecord).Return(productRecord, nil)
+		productRecServiceMock.On("CreateProductRecords", mock.Anything, productRecord).Return(productRecord, nil)
 
 		body, _ := json.Marshal(productRecord)
 		req := httptest.NewRequest("POST", "/product-records", bytes.NewBuffer(body))
@@ -69,7 +71,7 @@ func TestCreateProductRecServ(t *testing.T) {
 			SalePrice:     32.4,
 		}
 
-		productRecServiceMock.On("CreateProductRecords", productRecord).Return(model.ProductRecords{}, &customerror.GenericError{Code: http.StatusInternalServerError, Message: "Unable to create product record"})
+		productRecServiceMock.On("CreateProductRecords", mock.Anything, productRecord).Return(model.ProductRecords{}, &customerror.GenericError{Code: http.StatusInternalServerError, Message: "Unable to create product record"})
 
 		body, _ := json.Marshal(productRecord)
 		req := httptest.NewRequest("POST", "/product-records", bytes.NewBuffer(body))
@@ -94,7 +96,7 @@ func TestCreateProductRecServ(t *testing.T) {
 			SalePrice:     32.4,
 		}
 
-		productRecServiceMock.On("CreateProductRecords", productRecord).Return(model.ProductRecords{}, errors.New("An error"))
+		productRecServiceMock.On("CreateProductRecords", mock.Anything, productRecord).Return(model.ProductRecords{}, errors.New("An error"))
 
 		body, _ := json.Marshal(productRecord)
 		req := httptest.NewRequest("POST", "/product-records", bytes.NewBuffer(body))
@@ -120,7 +122,7 @@ func TestGetProductRecReport(t *testing.T) {
 			{ProductID: 1, Description: "Product A", RecordsCount: 2},
 			{ProductID: 2, Description: "Product B", RecordsCount: 3},
 		}
-		productRecServiceMock.On("GetProductRecordReport", productId).Return(mockReports, nil)
+		productRecServiceMock.On("GetProductRecordReport", mock.Anything, productId).Return(mockReports, nil)
 
 		req := httptest.NewRequest("GET", "/product-records/report?id=1", nil)
 		res := httptest.NewRecorder()
@@ -155,7 +157,7 @@ func TestGetProductRecReport(t *testing.T) {
 		prh := handler.NewProductRecHandler(productRecServiceMock, logMock)
 
 		productId := 1
-		productRecServiceMock.On("GetProductRecordReport", productId).Return(nil, &customerror.GenericError{Code: http.StatusInternalServerError, Message: "Internal Server Error"})
+		productRecServiceMock.On("GetProductRecordReport", mock.Anything, productId).Return(nil, &customerror.GenericError{Code: http.StatusInternalServerError, Message: "Internal Server Error"})
 
 		req := httptest.NewRequest("GET", "/product-records/report?id=1", nil)
 		res := httptest.NewRecorder()
@@ -173,7 +175,7 @@ func TestGetProductRecReport(t *testing.T) {
 		prh := handler.NewProductRecHandler(productRecServiceMock, logMock)
 
 
-		productRecServiceMock.On("GetProductRecordReport", mock.Anything).Return(nil, errors.New("An error"))
+		productRecServiceMock.On("GetProductRecordReport", mock.Anything, mock.Anything).Return(nil, errors.New("An error"))
 
 		req := httptest.NewRequest("GET", "/product-records/report?id=1", nil)
 		res := httptest.NewRecorder()
@@ -194,7 +196,7 @@ func TestGetProductRecReport(t *testing.T) {
 		expected := "{\"message\":\"empty list\"}"
 		mockReports := []model.ProductRecordsReport{}
 
-		productRecServiceMock.On("GetProductRecordReport", productId).Return(mockReports, nil)
+		productRecServiceMock.On("GetProductRecordReport", mock.Anything, productId).Return(mockReports, nil)
 
 		req := httptest.NewRequest("GET", "/product-records/report?id=1", nil)
 		res := httptest.NewRecorder()
@@ -206,3 +208,282 @@ func TestGetProductRecReport(t *testing.T) {
 
 	})
 }
+
+func setupProductRecRouter() (*mocks.MockIProductRecService, *chi.Mux) {
+	productRecServiceMock := new(mocks.MockIProductRecService)
+	prh := handler.NewProductRecHandler(productRecServiceMock, logMock)
+
+	r := chi.NewRouter()
+	r.Get("/api/v1/products/reportMargins", prh.GetProductMarginReport)
+	r.Get("/api/v1/products/{id}/priceHistory", prh.GetProductPriceHistory)
+	r.Get("/api/v1/productRecords", prh.GetProductRecords)
+	r.Get("/api/v1/productRecords/{id}", prh.GetProductRecordByID)
+	r.Delete("/api/v1/productRecords/{id}", prh.DeleteProductRecord)
+
+	return productRecServiceMock, r
+}
+
+func TestGetProductPriceHistory(t *testing.T) {
+	t.Run("Success Get Product Price History within a period", func(t *testing.T) {
+		productRecServiceMock, r := setupProductRecRouter()
+		from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		to := time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)
+		history := model.ProductPriceHistory{
+			ProductID: 1,
+			Current:   &model.ProductPricePoint{ID: 2, LastUpdateDate: time.Date(2025, 1, 20, 0, 0, 0, 0, time.UTC), PurchasePrice: 12, SalePrice: 16, Margin: 25},
+			Records: []model.ProductPricePoint{
+				{ID: 2, LastUpdateDate: time.Date(2025, 1, 20, 0, 0, 0, 0, time.UTC), PurchasePrice: 12, SalePrice: 16, Margin: 25},
+			},
+		}
+
+		productRecServiceMock.On("GetProductPriceHistory", mock.Anything, 1, from, to).Return(history, nil)
+
+		req := httptest.NewRequest("GET", "/api/v1/products/1/priceHistory?from=2025-01-01&to=2025-01-31", nil)
+		res := httptest.NewRecorder()
+		r.ServeHTTP(res, req)
+
+		expected := `{"data":{"product_id":1,"current":{"id":2,"last_update_date":"2025-01-20T00:00:00Z","purchase_price":12,"sale_price":16,"margin":25},"records":[{"id":2,"last_update_date":"2025-01-20T00:00:00Z","purchase_price":12,"sale_price":16,"margin":25}]}}`
+
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.JSONEq(t, expected, res.Body.String())
+	})
+
+	t.Run("Error Get Product Price History - Invalid ID", func(t *testing.T) {
+		_, r := setupProductRecRouter()
+
+		req := httptest.NewRequest("GET", "/api/v1/products/abc/priceHistory", nil)
+		res := httptest.NewRecorder()
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+		assert.JSONEq(t, `{"message":"invalid id"}`, res.Body.String())
+	})
+
+	t.Run("Error Get Product Price History - Invalid date", func(t *testing.T) {
+		_, r := setupProductRecRouter()
+
+		req := httptest.NewRequest("GET", "/api/v1/products/1/priceHistory?to=31-01-2025", nil)
+		res := httptest.NewRecorder()
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+		assert.JSONEq(t, `{"message":"invalid to: 31-01-2025, expected format YYYY-MM-DD"}`, res.Body.String())
+	})
+
+	t.Run("Error Get Product Price History - Product not found", func(t *testing.T) {
+		productRecServiceMock, r := setupProductRecRouter()
+
+		productRecServiceMock.On("GetProductPriceHistory", mock.Anything, 9, time.Time{}, time.Time{}).Return(model.ProductPriceHistory{}, customerror.HandleError("product", customerror.ErrorNotFound, ""))
+
+		req := httptest.NewRequest("GET", "/api/v1/products/9/priceHistory", nil)
+		res := httptest.NewRecorder()
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusNotFound, res.Code)
+	})
+
+	t.Run("Error Get Product Price History - Internal server error", func(t *testing.T) {
+		productRecServiceMock, r := setupProductRecRouter()
+
+		productRecServiceMock.On("GetProductPriceHistory", mock.Anything, 1, time.Time{}, time.Time{}).Return(model.ProductPriceHistory{}, errors.New("Database error"))
+
+		req := httptest.NewRequest("GET", "/api/v1/products/1/priceHistory", nil)
+		res := httptest.NewRecorder()
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusInternalServerError, res.Code)
+		assert.JSONEq(t, `{"message":"Internal Server Error"}`, res.Body.String())
+	})
+}
+
+func TestGetProductMarginReport(t *testing.T) {
+	t.Run("Success Get Margin Report per product", func(t *testing.T) {
+		productRecServiceMock, r := setupProductRecRouter()
+		filter := model.ProductMarginFilter{SellerID: 1, From: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+		productRecServiceMock.On("GetProductMarginReport", mock.Anything, filter).Return([]model.ProductMarginReport{
+			{ProductID: 1, Description: "Product A", SellerID: 1, RecordsCount: 2, AverageMargin: 45, MinMargin: 40, MaxMargin: 50, PriceChangePercentage: 25},
+		}, nil)
+
+		req := httptest.NewRequest("GET", "/api/v1/products/reportMargins?seller_id=1&from=2025-01-01", nil)
+		res := httptest.NewRecorder()
+		r.ServeHTTP(res, req)
+
+		expected := `{"data":[{"product_id":1,"description":"Product A","seller_id":1,"records_count":2,"average_margin":45,"min_margin":40,"max_margin":50,"price_change_percentage":25}]}`
+
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.JSONEq(t, expected, res.Body.String())
+	})
+
+	t.Run("Success Get Margin Report per seller", func(t *testing.T) {
+		productRecServiceMock, r := setupProductRecRouter()
+
+		productRecServiceMock.On("GetSellerMarginReport", mock.Anything, model.ProductMarginFilter{}).Return([]model.SellerMarginReport{
+			{SellerID: 1, ProductsCount: 2, RecordsCount: 3, AverageMargin: 38.33, MinMargin: 25, MaxMargin: 50, PriceChangePercentage: 12.5},
+		}, nil)
+
+		req := httptest.NewRequest("GET", "/api/v1/products/reportMargins?group_by=seller", nil)
+		res := httptest.NewRecorder()
+		r.ServeHTTP(res, req)
+
+		expected := `{"data":[{"seller_id":1,"products_count":2,"records_count":3,"average_margin":38.33,"min_margin":25,"max_margin":50,"price_change_percentage":12.5}]}`
+
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.JSONEq(t, expected, res.Body.String())
+	})
+
+	t.Run("Error Get Margin Report - Invalid seller", func(t *testing.T) {
+		_, r := setupProductRecRouter()
+
+		req := httptest.NewRequest("GET", "/api/v1/products/reportMargins?seller_id=abc", nil)
+		res := httptest.NewRecorder()
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+		assert.JSONEq(t, `{"message":"invalid seller_id: abc"}`, res.Body.String())
+	})
+
+	t.Run("Error Get Margin Report - Invalid group", func(t *testing.T) {
+		_, r := setupProductRecRouter()
+
+		req := httptest.NewRequest("GET", "/api/v1/products/reportMargins?group_by=warehouse", nil)
+		res := httptest.NewRecorder()
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+		assert.JSONEq(t, `{"message":"invalid group_by: warehouse, allowed values are product,seller"}`, res.Body.String())
+	})
+
+	t.Run("Error Get Margin Report - Reversed period", func(t *testing.T) {
+		productRecServiceMock, r := setupProductRecRouter()
+		filter := model.ProductMarginFilter{From: time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC), To: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+		productRecServiceMock.On("GetProductMarginReport", mock.Anything, filter).Return(nil, customerror.HandleError("product record", customerror.ErrorInvalid, "from cannot be after to"))
+
+		req := httptest.NewRequest("GET", "/api/v1/products/reportMargins?from=2025-02-01&to=2025-01-01", nil)
+		res := httptest.NewRecorder()
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, res.Code)
+	})
+}
+
+func TestGetProductRecords(t *testing.T) {
+	t.Run("Success Get Product Records filtered by product and date range", func(t *testing.T) {
+		productRecServiceMock, r := setupProductRecRouter()
+		params := model.ListParams{Page: 1, PageSize: 20, Sort: "id", Filters: map[string]string{
+			"product_id":            "1",
+			"last_update_date_from": "2025-01-01",
+			"last_update_date_to":   "2025-01-31",
+		}}
+
+		productRecServiceMock.On("GetProductRecords", mock.Anything, params).Return([]model.ProductRecords{
+			{ID: 1, LastUpdateDate: time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC), PurchasePrice: 10, SalePrice: 20, ProductID: 1},
+		}, 1, nil)
+
+		req := httptest.NewRequest("GET", "/api/v1/productRecords?product_id=1&last_update_date_from=2025-01-01&last_update_date_to=2025-01-31", nil)
+		res := httptest.NewRecorder()
+		r.ServeHTTP(res, req)
+
+		expected := `{"data":[{"id":1,"last_update_date":"2025-01-10T00:00:00Z","purchase_price":10,"sale_price":20,"product_id":1}],"pagination":{"page":1,"page_size":20,"total_items":1,"total_pages":1}}`
+
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.JSONEq(t, expected, res.Body.String())
+	})
+
+	t.Run("Error Get Product Records - Invalid date", func(t *testing.T) {
+		_, r := setupProductRecRouter()
+
+		req := httptest.NewRequest("GET", "/api/v1/productRecords?last_update_date_to=31-01-2025", nil)
+		res := httptest.NewRecorder()
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+		assert.JSONEq(t, `{"message":"invalid last_update_date_to: 31-01-2025, expected format YYYY-MM-DD"}`, res.Body.String())
+	})
+
+	t.Run("Error Get Product Records - Internal server error", func(t *testing.T) {
+		productRecServiceMock, r := setupProductRecRouter()
+
+		productRecServiceMock.On("GetProductRecords", mock.Anything, mock.Anything).Return(nil, 0, errors.New("Database error"))
+
+		req := httptest.NewRequest("GET", "/api/v1/productRecords", nil)
+		res := httptest.NewRecorder()
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusInternalServerError, res.Code)
+	})
+}
+
+func TestGetProductRecordByID(t *testing.T) {
+	t.Run("Success Get Product Record by ID", func(t *testing.T) {
+		productRecServiceMock, r := setupProductRecRouter()
+
+		productRecServiceMock.On("GetProductRecordByID", mock.Anything, 1).Return(model.ProductRecords{ID: 1, PurchasePrice: 10, SalePrice: 20, ProductID: 1}, nil)
+
+		req := httptest.NewRequest("GET", "/api/v1/productRecords/1", nil)
+		res := httptest.NewRecorder()
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.JSONEq(t, `{"data":{"id":1,"last_update_date":"0001-01-01T00:00:00Z","purchase_price":10,"sale_price":20,"product_id":1}}`, res.Body.String())
+	})
+
+	t.Run("Error Get Product Record by ID - Invalid ID", func(t *testing.T) {
+		_, r := setupProductRecRouter()
+
+		req := httptest.NewRequest("GET", "/api/v1/productRecords/abc", nil)
+		res := httptest.NewRecorder()
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("Error Get Product Record by ID - Not found", func(t *testing.T) {
+		productRecServiceMock, r := setupProductRecRouter()
+
+		productRecServiceMock.On("GetProductRecordByID", mock.Anything, 9).Return(model.ProductRecords{}, customerror.HandleError("product record", customerror.ErrorNotFound, ""))
+
+		req := httptest.NewRequest("GET", "/api/v1/productRecords/9", nil)
+		res := httptest.NewRecorder()
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusNotFound, res.Code)
+	})
+}
+
+func TestDeleteProductRecord(t *testing.T) {
+	t.Run("Success Delete Product Record", func(t *testing.T) {
+		productRecServiceMock, r := setupProductRecRouter()
+
+		productRecServiceMock.On("DeleteProductRecord", mock.Anything, 1).Return(nil)
+
+		req := httptest.NewRequest("DELETE", "/api/v1/productRecords/1", nil)
+		res := httptest.NewRecorder()
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusNoContent, res.Code)
+	})
+
+	t.Run("Error Delete Product Record - Referenced by purchase orders", func(t *testing.T) {
+		productRecServiceMock, r := setupProductRecRouter()
+
+		productRecServiceMock.On("DeleteProductRecord", mock.Anything, 1).Return(customerror.HandleError("product record", customerror.ErrorDep, ""))
+
+		req := httptest.NewRequest("DELETE", "/api/v1/productRecords/1", nil)
+		res := httptest.NewRecorder()
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusConflict, res.Code)
+	})
+
+	t.Run("Error Delete Product Record - Invalid ID", func(t *testing.T) {
+		_, r := setupProductRecRouter()
+
+		req := httptest.NewRequest("DELETE", "/api/v1/productRecords/abc", nil)
+		res := httptest.NewRecorder()
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+}
@@ -0,0 +1,257 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/bootcamp-go/web/response"
+	"github.com/go-chi/chi/v5"
+	"github.com/maxwelbm/alkemy-g7.git/internal/handler/responses"
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+	"github.com/maxwelbm/alkemy-g7.git/internal/service/interfaces"
+	"github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
+	"github.com/maxwelbm/alkemy-g7.git/pkg/logger"
+)
+
+type ProvinceHandler struct {
+	Svc interfaces.IProvinceService
+	log logger.Logger
+}
+
+func NewProvinceHandler(svc interfaces.IProvinceService, log logger.Logger) *ProvinceHandler {
+	return &ProvinceHandler{Svc: svc, log: log}
+}
+
+// GetAll lists the provinces.
+// @Summary List provinces
+// @Description Returns the provinces, paginated and optionally filtered by country
+// @Tags Province
+// @Produce json
+// @Param page query int false "Page number"
+// @Param page_size query int false "Page size"
+// @Param sort query string false "Sort key (id, province_name, country_id), prefixed with - for descending order"
+// @Param country_id query int false "Filter by country"
+// @Success 200 {object} model.ProvinceResponseSwagger
+// @Failure 400 {object} model.ErrorResponseSwagger "Invalid query parameters"
+// @Failure 500 {object} model.ErrorResponseSwagger "Unable to list provinces"
+// @Router /provinces [get]
+func (h *ProvinceHandler) GetAll(w http.ResponseWriter, r *http.Request) {
+	h.log.Log("ProvinceHandler", "INFO", "initializing GetAll function")
+
+	params, err := model.ParseListParams(r.URL.Query(), model.ProvinceListOptions)
+	if err != nil {
+		response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody(err.Error(), nil))
+		h.log.Log("ProvinceHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	provinces, total, err := h.Svc.GetAll(r.Context(), params)
+	if err != nil {
+		h.handleError(w, err, "unable to list provinces")
+		return
+	}
+
+	if provinces == nil {
+		provinces = []model.Province{}
+	}
+
+	response.JSON(w, http.StatusOK, responses.CreatePaginatedResponseBody("", provinces, params.Page, params.PageSize, total))
+	h.log.Log("ProvinceHandler", "INFO", "returning provinces")
+}
+
+// GetByID retrieves a province by its ID.
+// @Summary Retrieve a province
+// @Description Fetch the province identified by the provided ID
+// @Tags Province
+// @Produce json
+// @Param id path int true "Province ID"
+// @Success 200 {object} model.ProvinceResponseSwagger{data=model.Province}
+// @Failure 400 {object} model.ErrorResponseSwagger "Invalid ID"
+// @Failure 404 {object} model.ErrorResponseSwagger "Province not found"
+// @Failure 500 {object} model.ErrorResponseSwagger "Unable to search for province"
+// @Router /provinces/{id} [get]
+func (h *ProvinceHandler) GetByID(w http.ResponseWriter, r *http.Request) {
+	h.log.Log("ProvinceHandler", "INFO", "initializing GetByID function")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody("invalid id", nil))
+		h.log.Log("ProvinceHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	province, err := h.Svc.GetByID(r.Context(), id)
+	if err != nil {
+		h.handleError(w, err, "unable to search for province")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, responses.CreateResponseBody("", province))
+	h.log.Log("ProvinceHandler", "INFO", fmt.Sprintf("returning province with id %d", id))
+}
+
+// Create creates a new province.
+// @Summary Create a new province
+// @Description This endpoint allows for creating a new province of an existing country; province names are unique within a country.
+// @Tags Province
+// @Accept json
+// @Produce json
+// @Param province body model.Province true "Province information"
+// @Success 201 {object} model.ProvinceResponseSwagger{data=model.Province}
+// @Failure 404 {object} model.ErrorResponseSwagger "Country not found"
+// @Failure 409 {object} model.ErrorResponseSwagger "Province already exists"
+// @Failure 422 {object} model.ErrorResponseSwagger "Invalid input"
+// @Failure 500 {object} model.ErrorResponseSwagger "Unable to create province"
+// @Router /provinces [post]
+func (h *ProvinceHandler) Create(w http.ResponseWriter, r *http.Request) {
+	h.log.Log("ProvinceHandler", "INFO", "initializing Create function")
+
+	var body model.Province
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		response.JSON(w, http.StatusUnprocessableEntity, responses.CreateResponseBody("invalid json syntax", nil))
+		h.log.Log("ProvinceHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	province, err := h.Svc.Create(r.Context(), body)
+	if err != nil {
+		h.handleError(w, err, "unable to create province")
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, responses.CreateResponseBody("", province))
+	h.log.Log("ProvinceHandler", "INFO", "province created successfully")
+}
+
+// Update updates an existing province.
+// @Summary Update a province
+// @Description This endpoint allows for renaming the province identified by the provided ID or moving it to another country.
+// @Tags Province
+// @Accept json
+// @Produce json
+// @Param id path int true "Province ID"
+// @Param province body model.Province true "Province information"
+// @Success 200 {object} model.ProvinceResponseSwagger{data=model.Province}
+// @Failure 400 {object} model.ErrorResponseSwagger "Invalid ID"
+// @Failure 404 {object} model.ErrorResponseSwagger "Province or country not found"
+// @Failure 409 {object} model.ErrorResponseSwagger "Province already exists"
+// @Failure 422 {object} model.ErrorResponseSwagger "Invalid input"
+// @Failure 500 {object} model.ErrorResponseSwagger "Unable to update province"
+// @Router /provinces/{id} [patch]
+func (h *ProvinceHandler) Update(w http.ResponseWriter, r *http.Request) {
+	h.log.Log("ProvinceHandler", "INFO", "initializing Update function")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody("invalid id", nil))
+		h.log.Log("ProvinceHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	var body model.Province
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		response.JSON(w, http.StatusUnprocessableEntity, responses.CreateResponseBody("invalid json syntax", nil))
+		h.log.Log("ProvinceHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	province, err := h.Svc.Update(r.Context(), id, body)
+	if err != nil {
+		h.handleError(w, err, "unable to update province")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, responses.CreateResponseBody("", province))
+	h.log.Log("ProvinceHandler", "INFO", fmt.Sprintf("province with id %d updated successfully", id))
+}
+
+// Delete deletes a province by its ID.
+// @Summary Delete a province
+// @Description Deletes the province identified by the provided ID, as long as no locality belongs to it.
+// @Tags Province
+// @Param id path int true "Province ID"
+// @Success 204 {object} nil "Province successfully deleted"
+// @Failure 400 {object} model.ErrorResponseSwagger "Invalid ID"
+// @Failure 404 {object} model.ErrorResponseSwagger "Province not found"
+// @Failure 409 {object} model.ErrorResponseSwagger "Province has localities"
+// @Failure 500 {object} model.ErrorResponseSwagger "Unable to delete province"
+// @Router /provinces/{id} [delete]
+func (h *ProvinceHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	h.log.Log("ProvinceHandler", "INFO", "initializing Delete function")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody("invalid id", nil))
+		h.log.Log("ProvinceHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	if err := h.Svc.Delete(r.Context(), id); err != nil {
+		h.handleError(w, err, "unable to delete province")
+		return
+	}
+
+	response.JSON(w, http.StatusNoContent, nil)
+	h.log.Log("ProvinceHandler", "INFO", fmt.Sprintf("province with id %d deleted successfully", id))
+}
+
+// GetReport reports the localities, sellers and carriers of each province.
+// @Summary Province localities report
+// @Description Rolls the sellers and carriers of every locality up to its province, or for a single province when id is given.
+// @Tags Province
+// @Produce json
+// @Param id query int false "Province ID"
+// @Success 200 {object} model.ProvinceReportResponseSwagger
+// @Failure 400 {object} model.ErrorResponseSwagger "Invalid ID"
+// @Failure 404 {object} model.ErrorResponseSwagger "Province not found"
+// @Failure 500 {object} model.ErrorResponseSwagger "Unable to build the province report"
+// @Router /provinces/reportLocalities [get]
+func (h *ProvinceHandler) GetReport(w http.ResponseWriter, r *http.Request) {
+	h.log.Log("ProvinceHandler", "INFO", "initializing GetReport function")
+
+	id := 0
+
+	if idStr := r.URL.Query().Get("id"); idStr != "" {
+		var err error
+
+		id, err = strconv.Atoi(idStr)
+		if err != nil || id <= 0 {
+			response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody("invalid id", nil))
+			h.log.Log("ProvinceHandler", "ERROR", fmt.Sprintf("Error: invalid id %s", idStr))
+
+			return
+		}
+	}
+
+	report, err := h.Svc.GetReport(r.Context(), id)
+	if err != nil {
+		h.handleError(w, err, "unable to build the province report")
+		return
+	}
+
+	if report == nil {
+		report = []model.ProvinceReport{}
+	}
+
+	response.JSON(w, http.StatusOK, responses.CreateResponseBody("", report))
+	h.log.Log("ProvinceHandler", "INFO", "returning province report")
+}
+
+func (h *ProvinceHandler) handleError(w http.ResponseWriter, err error, message string) {
+	h.log.Log("ProvinceHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+
+	if err, ok := err.(*customerror.GenericError); ok {
+		response.JSON(w, err.Code, responses.CreateResponseBody(err.Error(), nil))
+		return
+	}
+
+	response.JSON(w, http.StatusInternalServerError, responses.CreateResponseBody(message, nil))
+}
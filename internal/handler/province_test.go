@@ -0,0 +1,149 @@
+package handler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/maxwelbm/alkemy-g7.git/internal/handler"
+	"github.com/maxwelbm/alkemy-g7.git/internal/mocks"
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+	"github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func setupProvinceRouter(t *testing.T) (*mocks.MockIProvinceService, *chi.Mux) {
+	srv := mocks.NewMockIProvinceService(t)
+	hd := handler.NewProvinceHandler(srv, logMock)
+
+	r := chi.NewRouter()
+	r.Get("/api/v1/provinces", hd.GetAll)
+	r.Get("/api/v1/provinces/reportLocalities", hd.GetReport)
+	r.Get("/api/v1/provinces/{id}", hd.GetByID)
+	r.Post("/api/v1/provinces", hd.Create)
+	r.Patch("/api/v1/provinces/{id}", hd.Update)
+	r.Delete("/api/v1/provinces/{id}", hd.Delete)
+
+	return srv, r
+}
+
+func TestProvinceHandler_GetAll(t *testing.T) {
+	srv, r := setupProvinceRouter(t)
+	params := model.ListParams{Page: 1, PageSize: 20, Sort: "id", Filters: map[string]string{"country_id": "1"}}
+
+	srv.On("GetAll", mock.Anything, params).Return([]model.Province{{ID: 1, Name: "Cordoba", CountryID: 1}}, 1, nil).Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/provinces?country_id=1", nil)
+	res := httptest.NewRecorder()
+	r.ServeHTTP(res, req)
+
+	expected := `{"data":[{"id":1,"province_name":"Cordoba","country_id":1}],"pagination":{"page":1,"page_size":20,"total_items":1,"total_pages":1}}`
+
+	assert.Equal(t, http.StatusOK, res.Code)
+	assert.JSONEq(t, expected, res.Body.String())
+}
+
+func TestProvinceHandler_GetByID(t *testing.T) {
+	t.Run("should return 200 with the province", func(t *testing.T) {
+		srv, r := setupProvinceRouter(t)
+		srv.On("GetByID", mock.Anything, 1).Return(model.Province{ID: 1, Name: "Cordoba", CountryID: 1}, nil).Once()
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/provinces/1", nil)
+		res := httptest.NewRecorder()
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.JSONEq(t, `{"data":{"id":1,"province_name":"Cordoba","country_id":1}}`, res.Body.String())
+	})
+
+	t.Run("should return 404 not found when the province does not exist", func(t *testing.T) {
+		srv, r := setupProvinceRouter(t)
+		srv.On("GetByID", mock.Anything, 99).Return(model.Province{}, customerror.HandleError("province", customerror.ErrorNotFound, "")).Once()
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/provinces/99", nil)
+		res := httptest.NewRecorder()
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusNotFound, res.Code)
+		assert.JSONEq(t, `{"message":"province not found"}`, res.Body.String())
+	})
+}
+
+func TestProvinceHandler_Create(t *testing.T) {
+	t.Run("should return 201 with the created province", func(t *testing.T) {
+		srv, r := setupProvinceRouter(t)
+		srv.On("Create", mock.Anything, model.Province{Name: "Mendoza", CountryID: 1}).Return(model.Province{ID: 3, Name: "Mendoza", CountryID: 1}, nil).Once()
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/provinces", strings.NewReader(`{"province_name":"Mendoza","country_id":1}`))
+		res := httptest.NewRecorder()
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusCreated, res.Code)
+		assert.JSONEq(t, `{"data":{"id":3,"province_name":"Mendoza","country_id":1}}`, res.Body.String())
+	})
+
+	t.Run("should return 404 not found when the country does not exist", func(t *testing.T) {
+		srv, r := setupProvinceRouter(t)
+		srv.On("Create", mock.Anything, model.Province{Name: "Mendoza", CountryID: 99}).Return(model.Province{}, customerror.HandleError("country", customerror.ErrorNotFound, "")).Once()
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/provinces", strings.NewReader(`{"province_name":"Mendoza","country_id":99}`))
+		res := httptest.NewRecorder()
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusNotFound, res.Code)
+		assert.JSONEq(t, `{"message":"country not found"}`, res.Body.String())
+	})
+}
+
+func TestProvinceHandler_Update(t *testing.T) {
+	srv, r := setupProvinceRouter(t)
+	srv.On("Update", mock.Anything, 1, model.Province{CountryID: 2}).Return(model.Province{ID: 1, Name: "Cordoba", CountryID: 2}, nil).Once()
+
+	req := httptest.NewRequest(http.MethodPatch, "/api/v1/provinces/1", strings.NewReader(`{"country_id":2}`))
+	res := httptest.NewRecorder()
+	r.ServeHTTP(res, req)
+
+	assert.Equal(t, http.StatusOK, res.Code)
+	assert.JSONEq(t, `{"data":{"id":1,"province_name":"Cordoba","country_id":2}}`, res.Body.String())
+}
+
+func TestProvinceHandler_Delete(t *testing.T) {
+	t.Run("should return 204 no content", func(t *testing.T) {
+		srv, r := setupProvinceRouter(t)
+		srv.On("Delete", mock.Anything, 1).Return(nil).Once()
+
+		req := httptest.NewRequest(http.MethodDelete, "/api/v1/provinces/1", nil)
+		res := httptest.NewRecorder()
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusNoContent, res.Code)
+	})
+
+	t.Run("should return 409 conflict when the province has localities", func(t *testing.T) {
+		srv, r := setupProvinceRouter(t)
+		srv.On("Delete", mock.Anything, 1).Return(customerror.HandleError("province", customerror.ErrorDep, "")).Once()
+
+		req := httptest.NewRequest(http.MethodDelete, "/api/v1/provinces/1", nil)
+		res := httptest.NewRecorder()
+		r.ServeHTTP(res, req)
+
+		assert.Equal(t, http.StatusConflict, res.Code)
+	})
+}
+
+func TestProvinceHandler_GetReport(t *testing.T) {
+	srv, r := setupProvinceRouter(t)
+	srv.On("GetReport", mock.Anything, 1).Return([]model.ProvinceReport{{ID: 1, Name: "Cordoba", CountryID: 1, LocalitiesCount: 2, SellersCount: 3, CarriersCount: 1}}, nil).Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/provinces/reportLocalities?id=1", nil)
+	res := httptest.NewRecorder()
+	r.ServeHTTP(res, req)
+
+	expected := `{"data":[{"province_id":1,"province_name":"Cordoba","country_id":1,"localities_count":2,"sellers_count":3,"carriers_count":1}]}`
+
+	assert.Equal(t, http.StatusOK, res.Code)
+	assert.JSONEq(t, expected, res.Body.String())
+}
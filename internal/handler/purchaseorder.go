@@ -5,8 +5,11 @@ import (
 	"fmt"
 	"github.com/maxwelbm/alkemy-g7.git/pkg/logger"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/bootcamp-go/web/response"
+	"github.com/go-chi/chi/v5"
 	"github.com/maxwelbm/alkemy-g7.git/internal/handler/responses"
 	"github.com/maxwelbm/alkemy-g7.git/internal/model"
 	"github.com/maxwelbm/alkemy-g7.git/internal/service/interfaces"
@@ -25,13 +28,15 @@ func NewPurchaseOrderHandler(svc interfaces.IPurchaseOrdersService, log logger.L
 // HandlerCreatePurchaseOrder handles the creation of a new purchase order.
 // @Summary Create a new purchase order
 // @Description This endpoint allows you to create a new purchase order by providing the necessary details in the request body.
+// @Description The order is placed either for a single product record (product_record_id and quantity) or as lines of products,
+// @Description each priced with the sale price of the product's latest record. The whole order is created in a single transaction.
 // @Tags PurchaseOrder
 // @Accept json
 // @Produce json
 // @Param purchaseOrder body model.PurchaseOrder true "Purchase Order"
 // @Success 201 {object} model.PurchaseOrderResponseSwagger{data=model.PurchaseOrder} "Purchase order created successfully"
-// @Failure 404 {object} model.ErrorResponseSwagger "Buyer or ProductRec not found"
-// @Failure 409 {object} model.ErrorResponseSwagger "Order number already exists"
+// @Failure 404 {object} model.ErrorResponseSwagger "Buyer, Product or ProductRec not found"
+// @Failure 409 {object} model.ErrorResponseSwagger "Order number already exists Or insufficient stock in product batches"
 // @Failure 422 {object} model.ErrorResponseSwagger "JSON syntax error Or Mandatory fields not filled in"
 // @Failure 500 {object} model.ErrorResponseSwagger "Internal Server Error"
 // @Router /purchaseOrders [post]
@@ -63,7 +68,7 @@ func (h *PurchaseOrderHandler) HandlerCreatePurchaseOrder(w http.ResponseWriter,
 		return
 	}
 
-	purchaseOrder, err := h.Svc.CreatePurchaseOrder(reqBody)
+	purchaseOrder, err := h.Svc.CreatePurchaseOrder(r.Context(), reqBody)
 
 	if err != nil {
 		if err, ok := err.(*customerror.BuyerError); ok {
@@ -96,3 +101,189 @@ func (h *PurchaseOrderHandler) HandlerCreatePurchaseOrder(w http.ResponseWriter,
 	h.log.Log("PurchaseOrderHandler", "INFO", "Purchase created successful")
 	response.JSON(w, http.StatusCreated, responses.CreateResponseBody("", purchaseOrder))
 }
+
+// HandlerGetPurchaseOrders lists the purchase orders.
+// @Summary List purchase orders
+// @Description Returns the purchase orders, paginated and optionally filtered by buyer, order date range and status
+// @Tags PurchaseOrder
+// @Produce json
+// @Param page query int false "Page number"
+// @Param page_size query int false "Page size"
+// @Param sort query string false "Sort key (id, order_number, order_date, buyer_id, status), prefixed with - for descending order"
+// @Param buyer_id query int false "Filter by buyer"
+// @Param order_date_from query string false "Orders placed on or after this date (YYYY-MM-DD)"
+// @Param order_date_to query string false "Orders placed on or before this date (YYYY-MM-DD)"
+// @Param status query string false "Filter by status (pending, confirmed, cancelled, fulfilled)"
+// @Success 200 {object} model.PurchaseOrderResponseSwagger
+// @Failure 400 {object} model.ErrorResponseSwagger "Invalid query parameters"
+// @Failure 500 {object} model.ErrorResponseSwagger "Unable to list purchase orders"
+// @Router /purchaseOrders [get]
+func (h *PurchaseOrderHandler) HandlerGetPurchaseOrders(w http.ResponseWriter, r *http.Request) {
+	h.log.Log("PurchaseOrderHandler", "INFO", "initializing Request GetPurchaseOrders")
+
+	params, err := model.ParseListParams(r.URL.Query(), model.PurchaseOrderListOptions)
+	if err != nil {
+		h.log.Log("PurchaseOrderHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+		response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody(err.Error(), nil))
+
+		return
+	}
+
+	for _, key := range []string{"order_date_from", "order_date_to"} {
+		if value, ok := params.Filters[key]; ok {
+			if _, err := time.Parse(time.DateOnly, value); err != nil {
+				h.log.Log("PurchaseOrderHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+				response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody(fmt.Sprintf("invalid %s: %s, expected format YYYY-MM-DD", key, value), nil))
+
+				return
+			}
+		}
+	}
+
+	if status, ok := params.Filters["status"]; ok && !model.IsPurchaseOrderStatus(status) {
+		h.log.Log("PurchaseOrderHandler", "ERROR", fmt.Sprintf("Error: invalid status %s", status))
+		response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody(fmt.Sprintf("invalid status: %s", status), nil))
+
+		return
+	}
+
+	purchaseOrders, total, err := h.Svc.GetPurchaseOrders(r.Context(), params)
+	if err != nil {
+		h.handleError(w, err, "Unable to list purchase orders")
+		return
+	}
+
+	if purchaseOrders == nil {
+		purchaseOrders = []model.PurchaseOrder{}
+	}
+
+	h.log.Log("PurchaseOrderHandler", "INFO", "Purchase orders listed successful")
+	response.JSON(w, http.StatusOK, responses.CreatePaginatedResponseBody("", purchaseOrders, params.Page, params.PageSize, total))
+}
+
+// HandlerGetPurchaseOrderByID returns a purchase order.
+// @Summary Get a purchase order
+// @Description Returns the purchase order with the given ID
+// @Tags PurchaseOrder
+// @Produce json
+// @Param id path int true "Purchase order ID"
+// @Success 200 {object} model.PurchaseOrderResponseSwagger
+// @Failure 400 {object} model.ErrorResponseSwagger "Invalid ID"
+// @Failure 404 {object} model.ErrorResponseSwagger "Purchase order not found"
+// @Failure 500 {object} model.ErrorResponseSwagger "Unable to get purchase order"
+// @Router /purchaseOrders/{id} [get]
+func (h *PurchaseOrderHandler) HandlerGetPurchaseOrderByID(w http.ResponseWriter, r *http.Request) {
+	h.log.Log("PurchaseOrderHandler", "INFO", "initializing Request GetPurchaseOrderByID")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		h.log.Log("PurchaseOrderHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+		response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody("invalid id", nil))
+
+		return
+	}
+
+	purchaseOrder, err := h.Svc.GetPurchaseOrderByID(r.Context(), id)
+	if err != nil {
+		h.handleError(w, err, "Unable to get purchase order")
+		return
+	}
+
+	h.log.Log("PurchaseOrderHandler", "INFO", "Purchase order found")
+	response.JSON(w, http.StatusOK, responses.CreateResponseBody("", purchaseOrder))
+}
+
+// HandlerUpdatePurchaseOrderStatus moves a purchase order to another status.
+// @Summary Update a purchase order status
+// @Description Moves the purchase order from pending to confirmed and from confirmed to fulfilled. Pending and confirmed orders may be cancelled, which gives their quantity back to the product batches.
+// @Tags PurchaseOrder
+// @Accept json
+// @Produce json
+// @Param id path int true "Purchase order ID"
+// @Param status body model.PurchaseOrderStatusUpdate true "New status"
+// @Success 200 {object} model.PurchaseOrderResponseSwagger
+// @Failure 400 {object} model.ErrorResponseSwagger "Invalid ID"
+// @Failure 404 {object} model.ErrorResponseSwagger "Purchase order not found"
+// @Failure 409 {object} model.ErrorResponseSwagger "Transition not allowed or active shipment"
+// @Failure 422 {object} model.ErrorResponseSwagger "Invalid input"
+// @Failure 500 {object} model.ErrorResponseSwagger "Unable to update purchase order status"
+// @Router /purchaseOrders/{id}/status [patch]
+func (h *PurchaseOrderHandler) HandlerUpdatePurchaseOrderStatus(w http.ResponseWriter, r *http.Request) {
+	h.log.Log("PurchaseOrderHandler", "INFO", "initializing Request UpdatePurchaseOrderStatus")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		h.log.Log("PurchaseOrderHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+		response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody("invalid id", nil))
+
+		return
+	}
+
+	var reqBody model.PurchaseOrderStatusUpdate
+
+	decoder := json.NewDecoder(r.Body)
+
+	decoder.DisallowUnknownFields()
+
+	if err = decoder.Decode(&reqBody); err != nil {
+		h.log.Log("PurchaseOrderHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+		response.JSON(w, http.StatusUnprocessableEntity, responses.CreateResponseBody("JSON syntax error. Please verify your input.", nil))
+
+		return
+	}
+
+	purchaseOrder, err := h.Svc.UpdatePurchaseOrderStatus(r.Context(), id, reqBody)
+	if err != nil {
+		h.handleError(w, err, "Unable to update purchase order status")
+		return
+	}
+
+	h.log.Log("PurchaseOrderHandler", "INFO", fmt.Sprintf("Purchase order %d is now %s", id, purchaseOrder.Status))
+	response.JSON(w, http.StatusOK, responses.CreateResponseBody("", purchaseOrder))
+}
+
+// HandlerCancelPurchaseOrder cancels a purchase order.
+// @Summary Cancel a purchase order
+// @Description Cancels a pending or confirmed purchase order and gives its quantity back to the product batches and their sections
+// @Tags PurchaseOrder
+// @Produce json
+// @Param id path int true "Purchase order ID"
+// @Success 200 {object} model.PurchaseOrderResponseSwagger
+// @Failure 400 {object} model.ErrorResponseSwagger "Invalid ID"
+// @Failure 404 {object} model.ErrorResponseSwagger "Purchase order not found"
+// @Failure 409 {object} model.ErrorResponseSwagger "Order already cancelled or fulfilled, or active shipment"
+// @Failure 500 {object} model.ErrorResponseSwagger "Unable to cancel purchase order"
+// @Router /purchaseOrders/{id}/cancel [post]
+func (h *PurchaseOrderHandler) HandlerCancelPurchaseOrder(w http.ResponseWriter, r *http.Request) {
+	h.log.Log("PurchaseOrderHandler", "INFO", "initializing Request CancelPurchaseOrder")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		h.log.Log("PurchaseOrderHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+		response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody("invalid id", nil))
+
+		return
+	}
+
+	purchaseOrder, err := h.Svc.CancelPurchaseOrder(r.Context(), id)
+	if err != nil {
+		h.handleError(w, err, "Unable to cancel purchase order")
+		return
+	}
+
+	h.log.Log("PurchaseOrderHandler", "INFO", fmt.Sprintf("Purchase order %d cancelled", id))
+	response.JSON(w, http.StatusOK, responses.CreateResponseBody("", purchaseOrder))
+}
+
+func (h *PurchaseOrderHandler) handleError(w http.ResponseWriter, err error, message string) {
+	h.log.Log("PurchaseOrderHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+
+	switch e := err.(type) {
+	case *customerror.PurcahseOrderError:
+		response.JSON(w, e.Code, responses.CreateResponseBody(e.Error(), nil))
+	case *customerror.GenericError:
+		response.JSON(w, e.Code, responses.CreateResponseBody(e.Error(), nil))
+	default:
+		response.JSON(w, http.StatusInternalServerError, responses.CreateResponseBody(message, nil))
+	}
+}
@@ -9,10 +9,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/go-chi/chi/v5"
 	"github.com/maxwelbm/alkemy-g7.git/internal/handler"
 	"github.com/maxwelbm/alkemy-g7.git/internal/model"
 	"github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func setupPurchaseOrder(t *testing.T) *handler.PurchaseOrderHandler {
@@ -37,15 +39,19 @@ func TestHandlerCreatePurchaseOrder(t *testing.T) {
 			TrackingCode:    "TC001",
 			BuyerID:         1,
 			ProductRecordID: 1,
+			Quantity:        1,
+			Status:          model.PurchaseOrderStatusPending,
+			Total:           1,
 		}
 		mockService := hd.Svc.(*mocks.MockIPurchaseOrdersService)
-		mockService.On("CreatePurchaseOrder", model.PurchaseOrder{
+		mockService.On("CreatePurchaseOrder", mock.Anything, model.PurchaseOrder{
 			ID:              0,
 			OrderNumber:     "ON001",
 			OrderDate:       parsedTime,
 			TrackingCode:    "TC001",
 			BuyerID:         1,
 			ProductRecordID: 1,
+			Quantity:        1,
 		}).Return(createdOrder, nil)
 
 		body := []byte(`{
@@ -53,7 +59,8 @@ func TestHandlerCreatePurchaseOrder(t *testing.T) {
     "order_date": "2025-01-01T00:00:00Z",
     "tracking_code": "TC001",
     "buyer_id": 1,
-    "product_record_id": 1
+    "product_record_id": 1,
+    "quantity": 1
 }`)
 
 		request := httptest.NewRequest(http.MethodPost, "/purchaseorders", bytes.NewReader(body))
@@ -67,7 +74,10 @@ func TestHandlerCreatePurchaseOrder(t *testing.T) {
         "order_date": "2025-01-01T00:00:00Z",
         "tracking_code": "TC001",
         "buyer_id": 1,
-        "product_record_id": 1
+        "product_record_id": 1,
+        "quantity": 1,
+        "status": "pending",
+        "total": 1
     }
 }`
 		assert.Equal(t, http.StatusCreated, response.Code)
@@ -85,13 +95,14 @@ func TestHandlerCreatePurchaseOrder(t *testing.T) {
 		assert.NoError(t, err)
 
 		mockService := hd.Svc.(*mocks.MockIPurchaseOrdersService)
-		mockService.On("CreatePurchaseOrder", model.PurchaseOrder{
+		mockService.On("CreatePurchaseOrder", mock.Anything, model.PurchaseOrder{
 			ID:              0,
 			OrderNumber:     "ON001",
 			OrderDate:       parsedTime,
 			TrackingCode:    "TC001",
 			BuyerID:         99,
 			ProductRecordID: 1,
+			Quantity:        1,
 		}).Return(model.PurchaseOrder{}, customerror.NewBuyerError(http.StatusNotFound, customerror.ErrNotFound.Error(), "Buyer"))
 
 		body := []byte(`{
@@ -99,7 +110,8 @@ func TestHandlerCreatePurchaseOrder(t *testing.T) {
     "order_date": "2025-01-01T00:00:00Z",
     "tracking_code": "TC001",
     "buyer_id": 99,
-    "product_record_id": 1
+    "product_record_id": 1,
+    "quantity": 1
 }`)
 
 		request := httptest.NewRequest(http.MethodPost, "/purchaseorders", bytes.NewReader(body))
@@ -122,13 +134,14 @@ func TestHandlerCreatePurchaseOrder(t *testing.T) {
 		assert.NoError(t, err)
 
 		mockService := hd.Svc.(*mocks.MockIPurchaseOrdersService)
-		mockService.On("CreatePurchaseOrder", model.PurchaseOrder{
+		mockService.On("CreatePurchaseOrder", mock.Anything, model.PurchaseOrder{
 			ID:              0,
 			OrderNumber:     "ON001",
 			OrderDate:       parsedTime,
 			TrackingCode:    "TC001",
 			BuyerID:         99,
 			ProductRecordID: 1,
+			Quantity:        1,
 		}).Return(model.PurchaseOrder{}, customerror.HandleError("product record", customerror.ErrorNotFound, ""))
 
 		body := []byte(`{
@@ -136,7 +149,8 @@ func TestHandlerCreatePurchaseOrder(t *testing.T) {
     "order_date": "2025-01-01T00:00:00Z",
     "tracking_code": "TC001",
     "buyer_id": 99,
-    "product_record_id": 1
+    "product_record_id": 1,
+    "quantity": 1
 }`)
 
 		request := httptest.NewRequest(http.MethodPost, "/purchaseorders", bytes.NewReader(body))
@@ -159,13 +173,14 @@ func TestHandlerCreatePurchaseOrder(t *testing.T) {
 		assert.NoError(t, err)
 
 		mockService := hd.Svc.(*mocks.MockIPurchaseOrdersService)
-		mockService.On("CreatePurchaseOrder", model.PurchaseOrder{
+		mockService.On("CreatePurchaseOrder", mock.Anything, model.PurchaseOrder{
 			ID:              0,
 			OrderNumber:     "ON001",
 			OrderDate:       parsedTime,
 			TrackingCode:    "TC001",
 			BuyerID:         99,
 			ProductRecordID: 1,
+			Quantity:        1,
 		}).Return(model.PurchaseOrder{}, customerror.NewPurcahseOrderError(http.StatusConflict, customerror.ErrConflict.Error(), "order_number"))
 
 		body := []byte(`{
@@ -173,7 +188,8 @@ func TestHandlerCreatePurchaseOrder(t *testing.T) {
     "order_date": "2025-01-01T00:00:00Z",
     "tracking_code": "TC001",
     "buyer_id": 99,
-    "product_record_id": 1
+    "product_record_id": 1,
+    "quantity": 1
 }`)
 
 		request := httptest.NewRequest(http.MethodPost, "/purchaseorders", bytes.NewReader(body))
@@ -187,6 +203,46 @@ func TestHandlerCreatePurchaseOrder(t *testing.T) {
 		mockService.AssertExpectations(t)
 	})
 
+	t.Run("Error insufficient stock in product batches", func(t *testing.T) {
+		hd := setupPurchaseOrder(t)
+
+		dateString := "2025-01-01T00:00:00Z"
+		layout := time.RFC3339
+
+		parsedTime, err := time.Parse(layout, dateString)
+		assert.NoError(t, err)
+
+		mockService := hd.Svc.(*mocks.MockIPurchaseOrdersService)
+		mockService.On("CreatePurchaseOrder", mock.Anything, model.PurchaseOrder{
+			ID:              0,
+			OrderNumber:     "ON001",
+			OrderDate:       parsedTime,
+			TrackingCode:    "TC001",
+			BuyerID:         1,
+			ProductRecordID: 1,
+			Quantity:        500,
+		}).Return(model.PurchaseOrder{}, customerror.NewPurcahseOrderError(http.StatusConflict, customerror.ErrInsufficientStock.Error(), "product batches"))
+
+		body := []byte(`{
+    "order_number": "ON001",
+    "order_date": "2025-01-01T00:00:00Z",
+    "tracking_code": "TC001",
+    "buyer_id": 1,
+    "product_record_id": 1,
+    "quantity": 500
+}`)
+
+		request := httptest.NewRequest(http.MethodPost, "/purchaseorders", bytes.NewReader(body))
+		response := httptest.NewRecorder()
+		hd.HandlerCreatePurchaseOrder(response, request)
+
+		expectedJson := `{"message":"product batches insufficient stock to fulfill the order"}`
+
+		assert.Equal(t, http.StatusConflict, response.Code)
+		assert.JSONEq(t, expectedJson, response.Body.String())
+		mockService.AssertExpectations(t)
+	})
+
 	t.Run("Error JSON syntax", func(t *testing.T) {
 		hd := setupPurchaseOrder(t)
 
@@ -195,7 +251,8 @@ func TestHandlerCreatePurchaseOrder(t *testing.T) {
     "order_date": "2025-01-01T00:00:00Z",
     "tracking_code": "TC001",
     "buyer_id": 99,
-    "product_record_id": 1
+    "product_record_id": 1,
+    "quantity": 1
 }`)
 
 		request := httptest.NewRequest(http.MethodPost, "/purchaseorders", bytes.NewReader(body))
@@ -217,7 +274,8 @@ func TestHandlerCreatePurchaseOrder(t *testing.T) {
     "order_date": "2025-01-01T00:00:00Z",
     "tracking_code": "",
     "buyer_id": 99,
-    "product_record_id": 1
+    "product_record_id": 1,
+    "quantity": 1
 }`)
 
 		request := httptest.NewRequest(http.MethodPost, "/purchaseorders", bytes.NewReader(body))
@@ -241,13 +299,14 @@ func TestHandlerCreatePurchaseOrder(t *testing.T) {
 		assert.NoError(t, err)
 
 		mockService := hd.Svc.(*mocks.MockIPurchaseOrdersService)
-		mockService.On("CreatePurchaseOrder", model.PurchaseOrder{
+		mockService.On("CreatePurchaseOrder", mock.Anything, model.PurchaseOrder{
 			ID:              0,
 			OrderNumber:     "ON001",
 			OrderDate:       parsedTime,
 			TrackingCode:    "TC001",
 			BuyerID:         99,
 			ProductRecordID: 1,
+			Quantity:        1,
 		}).Return(model.PurchaseOrder{}, errors.New("unmapped error"))
 
 		body := []byte(`{
@@ -255,7 +314,8 @@ func TestHandlerCreatePurchaseOrder(t *testing.T) {
     "order_date": "2025-01-01T00:00:00Z",
     "tracking_code": "TC001",
     "buyer_id": 99,
-    "product_record_id": 1
+    "product_record_id": 1,
+    "quantity": 1
 }`)
 
 		request := httptest.NewRequest(http.MethodPost, "/purchaseorders", bytes.NewReader(body))
@@ -270,3 +330,302 @@ func TestHandlerCreatePurchaseOrder(t *testing.T) {
 
 	})
 }
+
+func TestHandlerCreateMultiLinePurchaseOrder(t *testing.T) {
+	t.Run("Created multi-line Purchase Order successfully", func(t *testing.T) {
+		hd := setupPurchaseOrder(t)
+
+		parsedTime, err := time.Parse(time.RFC3339, "2025-01-01T00:00:00Z")
+		assert.NoError(t, err)
+
+		mockService := hd.Svc.(*mocks.MockIPurchaseOrdersService)
+		mockService.On("CreatePurchaseOrder", mock.Anything, model.PurchaseOrder{
+			OrderNumber:  "ON002",
+			OrderDate:    parsedTime,
+			TrackingCode: "TC002",
+			BuyerID:      1,
+			Lines:        []model.PurchaseOrderLine{{ProductID: 1, Quantity: 3}, {ProductID: 2, Quantity: 2}},
+		}).Return(model.PurchaseOrder{
+			ID:           2,
+			OrderNumber:  "ON002",
+			OrderDate:    parsedTime,
+			TrackingCode: "TC002",
+			BuyerID:      1,
+			Quantity:     5,
+			Status:       model.PurchaseOrderStatusPending,
+			Total:        27.05,
+			Lines: []model.PurchaseOrderLine{
+				{ID: 1, LineNumber: 1, ProductID: 1, ProductRecordID: 5, Quantity: 3, UnitPrice: 2.35, Subtotal: 7.05},
+				{ID: 2, LineNumber: 2, ProductID: 2, ProductRecordID: 9, Quantity: 2, UnitPrice: 10, Subtotal: 20},
+			},
+		}, nil)
+
+		body := []byte(`{
+    "order_number": "ON002",
+    "order_date": "2025-01-01T00:00:00Z",
+    "tracking_code": "TC002",
+    "buyer_id": 1,
+    "lines": [{"product_id": 1, "quantity": 3}, {"product_id": 2, "quantity": 2}]
+}`)
+
+		request := httptest.NewRequest(http.MethodPost, "/purchaseorders", bytes.NewReader(body))
+		response := httptest.NewRecorder()
+		hd.HandlerCreatePurchaseOrder(response, request)
+
+		expectedJson := `{
+    "data": {
+        "id": 2,
+        "order_number": "ON002",
+        "order_date": "2025-01-01T00:00:00Z",
+        "tracking_code": "TC002",
+        "buyer_id": 1,
+        "product_record_id": 0,
+        "quantity": 5,
+        "status": "pending",
+        "total": 27.05,
+        "lines": [
+            {"id": 1, "line_number": 1, "product_id": 1, "product_record_id": 5, "quantity": 3, "unit_price": 2.35, "subtotal": 7.05},
+            {"id": 2, "line_number": 2, "product_id": 2, "product_record_id": 9, "quantity": 2, "unit_price": 10, "subtotal": 20}
+        ]
+    }
+}`
+		assert.Equal(t, http.StatusCreated, response.Code)
+		assert.JSONEq(t, expectedJson, response.Body.String())
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("Error line fields required", func(t *testing.T) {
+		hd := setupPurchaseOrder(t)
+
+		body := []byte(`{
+    "order_number": "ON002",
+    "order_date": "2025-01-01T00:00:00Z",
+    "tracking_code": "TC002",
+    "buyer_id": 1,
+    "lines": [{"product_id": 1, "quantity": 3}, {"quantity": 0}]
+}`)
+
+		request := httptest.NewRequest(http.MethodPost, "/purchaseorders", bytes.NewReader(body))
+		response := httptest.NewRecorder()
+		hd.HandlerCreatePurchaseOrder(response, request)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, response.Code)
+		assert.JSONEq(t, `{"message":"Field(s) lines[1].product_id,lines[1].quantity cannot be empty"}`, response.Body.String())
+	})
+
+	t.Run("Error product repeated in lines", func(t *testing.T) {
+		hd := setupPurchaseOrder(t)
+
+		body := []byte(`{
+    "order_number": "ON002",
+    "order_date": "2025-01-01T00:00:00Z",
+    "tracking_code": "TC002",
+    "buyer_id": 1,
+    "lines": [{"product_id": 1, "quantity": 3}, {"product_id": 1, "quantity": 2}]
+}`)
+
+		request := httptest.NewRequest(http.MethodPost, "/purchaseorders", bytes.NewReader(body))
+		response := httptest.NewRecorder()
+		hd.HandlerCreatePurchaseOrder(response, request)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, response.Code)
+		assert.JSONEq(t, `{"message":"product 1 appears in more than one line"}`, response.Body.String())
+	})
+
+	t.Run("Error product record combined with lines", func(t *testing.T) {
+		hd := setupPurchaseOrder(t)
+
+		body := []byte(`{
+    "order_number": "ON002",
+    "order_date": "2025-01-01T00:00:00Z",
+    "tracking_code": "TC002",
+    "buyer_id": 1,
+    "product_record_id": 1,
+    "quantity": 1,
+    "lines": [{"product_id": 1, "quantity": 3}]
+}`)
+
+		request := httptest.NewRequest(http.MethodPost, "/purchaseorders", bytes.NewReader(body))
+		response := httptest.NewRecorder()
+		hd.HandlerCreatePurchaseOrder(response, request)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, response.Code)
+		assert.JSONEq(t, `{"message":"product_record_id and quantity cannot be combined with lines"}`, response.Body.String())
+	})
+}
+
+func setupPurchaseOrderRouter(t *testing.T) (*mocks.MockIPurchaseOrdersService, *chi.Mux) {
+	hd := setupPurchaseOrder(t)
+
+	r := chi.NewRouter()
+	r.Get("/api/v1/purchaseOrders", hd.HandlerGetPurchaseOrders)
+	r.Get("/api/v1/purchaseOrders/{id}", hd.HandlerGetPurchaseOrderByID)
+	r.Patch("/api/v1/purchaseOrders/{id}/status", hd.HandlerUpdatePurchaseOrderStatus)
+	r.Post("/api/v1/purchaseOrders/{id}/cancel", hd.HandlerCancelPurchaseOrder)
+
+	return hd.Svc.(*mocks.MockIPurchaseOrdersService), r
+}
+
+func TestHandlerGetPurchaseOrders(t *testing.T) {
+	t.Run("list purchase orders with filters", func(t *testing.T) {
+		mockService, r := setupPurchaseOrderRouter(t)
+		params := model.ListParams{Page: 1, PageSize: 20, Sort: "id", Filters: map[string]string{
+			"buyer_id":        "1",
+			"order_date_from": "2025-01-01",
+			"status":          "confirmed",
+		}}
+
+		mockService.On("GetPurchaseOrders", mock.Anything, params).Return([]model.PurchaseOrder{{ID: 1, BuyerID: 1, Status: model.PurchaseOrderStatusConfirmed}}, 1, nil)
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/purchaseOrders?buyer_id=1&order_date_from=2025-01-01&status=confirmed", nil)
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusOK, response.Code)
+		assert.Contains(t, response.Body.String(), `"status":"confirmed"`)
+		assert.Contains(t, response.Body.String(), `"total_items":1`)
+	})
+
+	t.Run("return bad request for an invalid date", func(t *testing.T) {
+		_, r := setupPurchaseOrderRouter(t)
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/purchaseOrders?order_date_to=31-01-2025", nil)
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+		assert.JSONEq(t, `{"message": "invalid order_date_to: 31-01-2025, expected format YYYY-MM-DD"}`, response.Body.String())
+	})
+
+	t.Run("return bad request for an unknown status", func(t *testing.T) {
+		_, r := setupPurchaseOrderRouter(t)
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/purchaseOrders?status=shipped", nil)
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+		assert.JSONEq(t, `{"message": "invalid status: shipped"}`, response.Body.String())
+	})
+
+	t.Run("return internal server error on unmapped error", func(t *testing.T) {
+		mockService, r := setupPurchaseOrderRouter(t)
+
+		mockService.On("GetPurchaseOrders", mock.Anything, mock.Anything).Return(nil, 0, errors.New("unmapped error"))
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/purchaseOrders", nil)
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusInternalServerError, response.Code)
+		assert.JSONEq(t, `{"message": "Unable to list purchase orders"}`, response.Body.String())
+	})
+}
+
+func TestHandlerGetPurchaseOrderByID(t *testing.T) {
+	t.Run("get an existing purchase order", func(t *testing.T) {
+		mockService, r := setupPurchaseOrderRouter(t)
+
+		mockService.On("GetPurchaseOrderByID", mock.Anything, 1).Return(model.PurchaseOrder{ID: 1, OrderNumber: "ON001", Status: model.PurchaseOrderStatusPending}, nil)
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/purchaseOrders/1", nil)
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusOK, response.Code)
+		assert.Contains(t, response.Body.String(), `"order_number":"ON001"`)
+	})
+
+	t.Run("return not found", func(t *testing.T) {
+		mockService, r := setupPurchaseOrderRouter(t)
+
+		mockService.On("GetPurchaseOrderByID", mock.Anything, 99).Return(model.PurchaseOrder{}, customerror.NewPurcahseOrderError(http.StatusNotFound, customerror.ErrNotFound.Error(), "Purchase Order"))
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/purchaseOrders/99", nil)
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusNotFound, response.Code)
+		assert.JSONEq(t, `{"message": "Purchase Order not found"}`, response.Body.String())
+	})
+
+	t.Run("return bad request for an invalid id", func(t *testing.T) {
+		_, r := setupPurchaseOrderRouter(t)
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/purchaseOrders/abc", nil)
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+		assert.JSONEq(t, `{"message": "invalid id"}`, response.Body.String())
+	})
+}
+
+func TestHandlerUpdatePurchaseOrderStatus(t *testing.T) {
+	t.Run("confirm a purchase order", func(t *testing.T) {
+		mockService, r := setupPurchaseOrderRouter(t)
+
+		mockService.On("UpdatePurchaseOrderStatus", mock.Anything, 1, model.PurchaseOrderStatusUpdate{Status: model.PurchaseOrderStatusConfirmed}).
+			Return(model.PurchaseOrder{ID: 1, Status: model.PurchaseOrderStatusConfirmed}, nil)
+
+		request := httptest.NewRequest(http.MethodPatch, "/api/v1/purchaseOrders/1/status", bytes.NewReader([]byte(`{"status": "confirmed"}`)))
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusOK, response.Code)
+		assert.Contains(t, response.Body.String(), `"status":"confirmed"`)
+	})
+
+	t.Run("return conflict for a transition not allowed", func(t *testing.T) {
+		mockService, r := setupPurchaseOrderRouter(t)
+
+		mockService.On("UpdatePurchaseOrderStatus", mock.Anything, 1, model.PurchaseOrderStatusUpdate{Status: model.PurchaseOrderStatusPending}).
+			Return(model.PurchaseOrder{}, customerror.NewPurcahseOrderError(http.StatusConflict, "cannot change status from fulfilled to pending", "Purchase Order"))
+
+		request := httptest.NewRequest(http.MethodPatch, "/api/v1/purchaseOrders/1/status", bytes.NewReader([]byte(`{"status": "pending"}`)))
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusConflict, response.Code)
+		assert.JSONEq(t, `{"message": "Purchase Order cannot change status from fulfilled to pending"}`, response.Body.String())
+	})
+
+	t.Run("return unprocessable entity for an unknown field", func(t *testing.T) {
+		_, r := setupPurchaseOrderRouter(t)
+
+		request := httptest.NewRequest(http.MethodPatch, "/api/v1/purchaseOrders/1/status", bytes.NewReader([]byte(`{"state": "confirmed"}`)))
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, response.Code)
+	})
+}
+
+func TestHandlerCancelPurchaseOrder(t *testing.T) {
+	t.Run("cancel a purchase order", func(t *testing.T) {
+		mockService, r := setupPurchaseOrderRouter(t)
+
+		mockService.On("CancelPurchaseOrder", mock.Anything, 1).Return(model.PurchaseOrder{ID: 1, Status: model.PurchaseOrderStatusCancelled}, nil)
+
+		request := httptest.NewRequest(http.MethodPost, "/api/v1/purchaseOrders/1/cancel", nil)
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusOK, response.Code)
+		assert.Contains(t, response.Body.String(), `"status":"cancelled"`)
+	})
+
+	t.Run("return conflict when the order has an active shipment", func(t *testing.T) {
+		mockService, r := setupPurchaseOrderRouter(t)
+
+		mockService.On("CancelPurchaseOrder", mock.Anything, 1).
+			Return(model.PurchaseOrder{}, customerror.NewPurcahseOrderError(http.StatusConflict, "cannot be cancelled while it has an active shipment", "Purchase Order"))
+
+		request := httptest.NewRequest(http.MethodPost, "/api/v1/purchaseOrders/1/cancel", nil)
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusConflict, response.Code)
+		assert.JSONEq(t, `{"message": "Purchase Order cannot be cancelled while it has an active shipment"}`, response.Body.String())
+	})
+}
@@ -1,8 +1,16 @@
 package responses
 
 type ResponseBody struct {
-	Message string `json:"message,omitempty"`
-	Data    any    `json:"data,omitempty"`
+	Message    string      `json:"message,omitempty"`
+	Data       any         `json:"data,omitempty"`
+	Pagination *Pagination `json:"pagination,omitempty"`
+}
+
+type Pagination struct {
+	Page       int `json:"page"`
+	PageSize   int `json:"page_size"`
+	TotalItems int `json:"total_items"`
+	TotalPages int `json:"total_pages"`
 }
 
 func CreateResponseBody(m string, d any) *ResponseBody {
@@ -11,3 +19,21 @@ func CreateResponseBody(m string, d any) *ResponseBody {
 		Data:    d,
 	}
 }
+
+func CreatePaginatedResponseBody(m string, d any, page int, pageSize int, totalItems int) *ResponseBody {
+	totalPages := 0
+	if pageSize > 0 {
+		totalPages = (totalItems + pageSize - 1) / pageSize
+	}
+
+	return &ResponseBody{
+		Message: m,
+		Data:    d,
+		Pagination: &Pagination{
+			Page:       page,
+			PageSize:   pageSize,
+			TotalItems: totalItems,
+			TotalPages: totalPages,
+		},
+	}
+}
@@ -41,7 +41,16 @@ type SectionController struct {
 
 func (h *SectionController) GetAll(w http.ResponseWriter, r *http.Request) {
 	h.log.Log("SectionController", "INFO", "initializing GetAll controller function")
-	s, err := h.Sv.Get()
+	params, err := model.ParseListParams(r.URL.Query(), model.SectionListOptions)
+
+	if err != nil {
+		response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody(err.Error(), nil))
+		h.log.Log("SectionController", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	s, total, err := h.Sv.Get(r.Context(), params)
 
 	if err != nil {
 		response.JSON(w, http.StatusInternalServerError, responses.CreateResponseBody("unable to list sections", nil))
@@ -65,7 +74,7 @@ func (h *SectionController) GetAll(w http.ResponseWriter, r *http.Request) {
 		})
 	}
 
-	response.JSON(w, http.StatusOK, responses.CreateResponseBody("", data))
+	response.JSON(w, http.StatusOK, responses.CreatePaginatedResponseBody("", data, params.Page, params.PageSize, total))
 	h.log.Log("SectionController", "INFO", "returning a slice with all sections in JSON format")
 }
 
@@ -82,7 +91,7 @@ func (h *SectionController) GetByID(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	s, err := h.Sv.GetByID(idInt)
+	s, err := h.Sv.GetByID(r.Context(), idInt)
 	if err != nil {
 		if err, ok := err.(*customerror.GenericError); ok {
 			response.JSON(w, err.Code, responses.CreateResponseBody(err.Error(), nil))
@@ -133,7 +142,7 @@ func (h *SectionController) Post(w http.ResponseWriter, r *http.Request) {
 		ProductTypeID:      reqBody.ProductTypeID,
 	}
 
-	s, err := h.Sv.Post(&section)
+	s, err := h.Sv.Post(r.Context(), &section)
 	if err != nil {
 		if err, ok := err.(*customerror.GenericError); ok {
 			response.JSON(w, err.Code, responses.CreateResponseBody(err.Error(), nil))
@@ -194,7 +203,7 @@ func (h *SectionController) Update(w http.ResponseWriter, r *http.Request) {
 		ProductTypeID:      reqBody.ProductTypeID,
 	}
 
-	s, err := h.Sv.Update(idInt, &sec)
+	s, err := h.Sv.Update(r.Context(), idInt, &sec)
 	if err != nil {
 		if err, ok := err.(*customerror.GenericError); ok {
 			response.JSON(w, err.Code, responses.CreateResponseBody(err.Error(), nil))
@@ -226,7 +235,7 @@ func (h *SectionController) Delete(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = h.Sv.Delete(idInt)
+	err = h.Sv.Delete(r.Context(), idInt)
 	if err != nil {
 		if err, ok := err.(*customerror.GenericError); ok {
 			response.JSON(w, err.Code, responses.CreateResponseBody(err.Error(), nil))
@@ -251,7 +260,7 @@ func (h *SectionController) CountProductBatchesSections(w http.ResponseWriter, r
 	idStr := r.URL.Query().Get("id")
 
 	if idStr == "" {
-		count, err := h.Sv.CountProductBatchesSections()
+		count, err := h.Sv.CountProductBatchesSections(r.Context())
 		if err != nil {
 			response.JSON(w, http.StatusInternalServerError, responses.CreateResponseBody("unable to count section product batches", nil))
 			h.log.Log("SectionController", "ERROR", fmt.Sprintf("Error: %v", err))
@@ -273,7 +282,7 @@ func (h *SectionController) CountProductBatchesSections(w http.ResponseWriter, r
 		return
 	}
 
-	count, err := h.Sv.CountProductBatchesBySectionID(id)
+	count, err := h.Sv.CountProductBatchesBySectionID(r.Context(), id)
 	if err != nil {
 		if err, ok := err.(*customerror.GenericError); ok {
 			response.JSON(w, err.Code, responses.CreateResponseBody(err.Error(), nil))
@@ -291,3 +300,39 @@ func (h *SectionController) CountProductBatchesSections(w http.ResponseWriter, r
 	response.JSON(w, http.StatusOK, responses.CreateResponseBody("", count))
 	h.log.Log("SectionController", "INFO", "CountProductBatchesSections executed successfully")
 }
+
+func (h *SectionController) GetCapacityReport(w http.ResponseWriter, r *http.Request) {
+	h.log.Log("SectionController", "INFO", "initializing GetCapacityReport controller function")
+
+	nearFullRatio := model.DefaultNearFullRatio
+
+	if ratioStr := r.URL.Query().Get("near_full_ratio"); ratioStr != "" {
+		ratio, err := strconv.ParseFloat(ratioStr, 64)
+		if err != nil {
+			response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody("invalid near_full_ratio", nil))
+			h.log.Log("SectionController", "ERROR", fmt.Sprintf("Error: %v", err))
+
+			return
+		}
+
+		nearFullRatio = ratio
+	}
+
+	report, err := h.Sv.GetCapacityReport(r.Context(), nearFullRatio)
+	if err != nil {
+		if err, ok := err.(*customerror.GenericError); ok {
+			response.JSON(w, err.Code, responses.CreateResponseBody(err.Error(), nil))
+			h.log.Log("SectionController", "ERROR", fmt.Sprintf("Error: %v", err))
+
+			return
+		}
+
+		response.JSON(w, http.StatusInternalServerError, responses.CreateResponseBody("unable to generate the section capacity report", nil))
+		h.log.Log("SectionController", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	response.JSON(w, http.StatusOK, responses.CreateResponseBody("", report))
+	h.log.Log("SectionController", "INFO", "GetCapacityReport executed successfully")
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/maxwelbm/alkemy-g7.git/internal/model"
 	"github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func setupSectionService(t *testing.T) *handler.SectionController {
@@ -27,9 +28,10 @@ func TestHandlerGet(t *testing.T) {
 		expectedSections := []model.Section{{ID: 1, SectionNumber: "S01", CurrentTemperature: 10.0, MinimumTemperature: 5.0, CurrentCapacity: 10, MinimumCapacity: 5, MaximumCapacity: 20, WarehouseID: 1, ProductTypeID: 1}, {ID: 2, SectionNumber: "S02", CurrentTemperature: 15.0, MinimumTemperature: 10.0, CurrentCapacity: 20, MinimumCapacity: 10, MaximumCapacity: 30, WarehouseID: 2, ProductTypeID: 2}}
 
 		mockService := hd.Sv.(*mocks.MockISectionService)
-		mockService.On("Get").Return(expectedSections, nil)
+		expectedParams := model.ListParams{Page: 2, PageSize: 2, Sort: "section_number", Filters: map[string]string{"warehouse_id": "1"}}
+		mockService.On("Get", mock.Anything, expectedParams).Return(expectedSections, 4, nil)
 
-		request := httptest.NewRequest(http.MethodGet, "/api/v1/sections", nil)
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/sections?page=2&page_size=2&sort=section_number&warehouse_id=1", nil)
 		response := httptest.NewRecorder()
 
 		hd.GetAll(response, request)
@@ -58,7 +60,8 @@ func TestHandlerGet(t *testing.T) {
 			"warehouse_id": 2,
 			"product_type_id": 2
 		}
-		]
+		],
+		"pagination": {"page": 2, "page_size": 2, "total_items": 4, "total_pages": 2}
 		}`
 
 		assert.Equal(t, http.StatusOK, response.Code)
@@ -70,7 +73,7 @@ func TestHandlerGet(t *testing.T) {
 		hd := setupSectionService(t)
 
 		mockService := hd.Sv.(*mocks.MockISectionService)
-		mockService.On("Get").Return([]model.Section{}, errors.New("unable to list sections"))
+		mockService.On("Get", mock.Anything, mock.Anything).Return([]model.Section{}, 0, errors.New("unable to list sections"))
 
 		request := httptest.NewRequest(http.MethodGet, "/api/v1/sections", nil)
 		response := httptest.NewRecorder()
@@ -91,7 +94,7 @@ func TestHandlerGetSectionByID(t *testing.T) {
 		expectedSection := model.Section{ID: 1, SectionNumber: "S01", CurrentTemperature: 10.0, MinimumTemperature: 5.0, CurrentCapacity: 10, MinimumCapacity: 5, MaximumCapacity: 20, WarehouseID: 1, ProductTypeID: 1}
 
 		mockService := hd.Sv.(*mocks.MockISectionService)
-		mockService.On("GetByID", expectedSection.ID).Return(expectedSection, nil)
+		mockService.On("GetByID", mock.Anything, expectedSection.ID).Return(expectedSection, nil)
 
 		request := httptest.NewRequest(http.MethodGet, "/api/v1/sections/1", nil)
 		response := httptest.NewRecorder()
@@ -120,7 +123,7 @@ func TestHandlerGetSectionByID(t *testing.T) {
 		hd := setupSectionService(t)
 
 		mockService := hd.Sv.(*mocks.MockISectionService)
-		mockService.On("GetByID", 100).Return(model.Section{}, customerror.HandleError("section", customerror.ErrorNotFound, ""))
+		mockService.On("GetByID", mock.Anything, 100).Return(model.Section{}, customerror.HandleError("section", customerror.ErrorNotFound, ""))
 
 		request := httptest.NewRequest(http.MethodGet, "/api/v1/sections/100", nil)
 		response := httptest.NewRecorder()
@@ -152,7 +155,7 @@ func TestHandlerGetSectionByID(t *testing.T) {
 		hd := setupSectionService(t)
 
 		mockService := hd.Sv.(*mocks.MockISectionService)
-		mockService.On("GetByID", 100).Return(model.Section{}, errors.New("unable to search for section"))
+		mockService.On("GetByID", mock.Anything, 100).Return(model.Section{}, errors.New("unable to search for section"))
 
 		request := httptest.NewRequest(http.MethodGet, "/api/v1/sections/100", nil)
 		response := httptest.NewRecorder()
@@ -174,7 +177,7 @@ func TestHandlerCreateSection(t *testing.T) {
 		expectedSection := model.Section{ID: 1, SectionNumber: "S01", CurrentTemperature: 10.0, MinimumTemperature: 5.0, CurrentCapacity: 10, MinimumCapacity: 5, MaximumCapacity: 20, WarehouseID: 1, ProductTypeID: 1}
 
 		mockService := hd.Sv.(*mocks.MockISectionService)
-		mockService.On("Post", &model.Section{SectionNumber: "S01", CurrentTemperature: 10.0, MinimumTemperature: 5.0, CurrentCapacity: 10, MinimumCapacity: 5, MaximumCapacity: 20, WarehouseID: 1, ProductTypeID: 1}).Return(expectedSection, nil)
+		mockService.On("Post", mock.Anything, &model.Section{SectionNumber: "S01", CurrentTemperature: 10.0, MinimumTemperature: 5.0, CurrentCapacity: 10, MinimumCapacity: 5, MaximumCapacity: 20, WarehouseID: 1, ProductTypeID: 1}).Return(expectedSection, nil)
 
 		reqBody := []byte(`{
 			"section_number": "S01",
@@ -233,7 +236,7 @@ func TestHandlerCreateSection(t *testing.T) {
 		hd := setupSectionService(t)
 
 		mockService := hd.Sv.(*mocks.MockISectionService)
-		mockService.On("Post", &model.Section{SectionNumber: "S01", CurrentTemperature: 10.0, MinimumTemperature: 5.0, CurrentCapacity: 10, MinimumCapacity: 5, MaximumCapacity: 20, WarehouseID: 1, ProductTypeID: 1}).Return(model.Section{}, customerror.HandleError("section", customerror.ErrorConflict, ""))
+		mockService.On("Post", mock.Anything, &model.Section{SectionNumber: "S01", CurrentTemperature: 10.0, MinimumTemperature: 5.0, CurrentCapacity: 10, MinimumCapacity: 5, MaximumCapacity: 20, WarehouseID: 1, ProductTypeID: 1}).Return(model.Section{}, customerror.HandleError("section", customerror.ErrorConflict, ""))
 
 		reqBody := []byte(`{
 			"section_number": "S01",
@@ -295,7 +298,7 @@ func TestHandlerCreateSection(t *testing.T) {
 		}`)
 
 		mockService := hd.Sv.(*mocks.MockISectionService)
-		mockService.On("Post", &expSection).Return(model.Section{}, errors.New("unable to create section"))
+		mockService.On("Post", mock.Anything, &expSection).Return(model.Section{}, errors.New("unable to create section"))
 
 		request := httptest.NewRequest(http.MethodPost, "/api/v1/sections/", bytes.NewReader(reqBody))
 		response := httptest.NewRecorder()
@@ -317,7 +320,7 @@ func TestHandlerUpdateSection(t *testing.T) {
 		updatedSection := model.Section{ID: 1, SectionNumber: "S01", CurrentTemperature: 12.0, MinimumTemperature: 5.0, CurrentCapacity: 10, MinimumCapacity: 5, MaximumCapacity: 20, WarehouseID: 1, ProductTypeID: 1}
 
 		mockService := hd.Sv.(*mocks.MockISectionService)
-		mockService.On("Update", 1, &model.Section{ID: 1, CurrentTemperature: 14.0}).Return(updatedSection, nil)
+		mockService.On("Update", mock.Anything, 1, &model.Section{ID: 1, CurrentTemperature: 14.0}).Return(updatedSection, nil)
 
 		reqBody := []byte(`{"current_temperature": 14.0}`)
 
@@ -350,7 +353,7 @@ func TestHandlerUpdateSection(t *testing.T) {
 		hd := setupSectionService(t)
 
 		mockService := hd.Sv.(*mocks.MockISectionService)
-		mockService.On("Update", 50, &model.Section{ID: 50, CurrentTemperature: 5.0}).Return(model.Section{}, customerror.HandleError("section", customerror.ErrorNotFound, ""))
+		mockService.On("Update", mock.Anything, 50, &model.Section{ID: 50, CurrentTemperature: 5.0}).Return(model.Section{}, customerror.HandleError("section", customerror.ErrorNotFound, ""))
 
 		reqBody := []byte(`{
 			"current_temperature": 5.0
@@ -421,7 +424,7 @@ func TestHandlerUpdateSection(t *testing.T) {
 		hd := setupSectionService(t)
 
 		mockService := hd.Sv.(*mocks.MockISectionService)
-		mockService.On("Update", 50, &model.Section{ID: 50, CurrentTemperature: 5.0}).Return(model.Section{}, errors.New("unable to update section"))
+		mockService.On("Update", mock.Anything, 50, &model.Section{ID: 50, CurrentTemperature: 5.0}).Return(model.Section{}, errors.New("unable to update section"))
 
 		reqBody := []byte(`{
 			"current_temperature": 5.0
@@ -445,7 +448,7 @@ func TestHandlerDeleteSection(t *testing.T) {
 		hd := setupSectionService(t)
 
 		mockService := hd.Sv.(*mocks.MockISectionService)
-		mockService.On("Delete", 1).Return(nil)
+		mockService.On("Delete", mock.Anything, 1).Return(nil)
 
 		request := httptest.NewRequest(http.MethodDelete, "/api/v1/sections/1", nil)
 		response := httptest.NewRecorder()
@@ -460,7 +463,7 @@ func TestHandlerDeleteSection(t *testing.T) {
 		hd := setupSectionService(t)
 
 		mockService := hd.Sv.(*mocks.MockISectionService)
-		mockService.On("Delete", 50).Return(customerror.HandleError("section", customerror.ErrorNotFound, ""))
+		mockService.On("Delete", mock.Anything, 50).Return(customerror.HandleError("section", customerror.ErrorNotFound, ""))
 
 		request := httptest.NewRequest(http.MethodDelete, "/api/v1/sections/50", nil)
 		response := httptest.NewRecorder()
@@ -492,7 +495,7 @@ func TestHandlerDeleteSection(t *testing.T) {
 		hd := setupSectionService(t)
 
 		mockService := hd.Sv.(*mocks.MockISectionService)
-		mockService.On("Delete", 50).Return(errors.New("unable to delete section"))
+		mockService.On("Delete", mock.Anything, 50).Return(errors.New("unable to delete section"))
 
 		request := httptest.NewRequest(http.MethodDelete, "/api/v1/sections/50", nil)
 		response := httptest.NewRecorder()
@@ -516,7 +519,7 @@ func TestHandlerCountProductBatchesSections(t *testing.T) {
 		}
 
 		mockService := hd.Sv.(*mocks.MockISectionService)
-		mockService.On("CountProductBatchesSections").Return(countProductBatchesSections, nil)
+		mockService.On("CountProductBatchesSections", mock.Anything).Return(countProductBatchesSections, nil)
 
 		request := httptest.NewRequest(http.MethodGet, "/api/v1/sections/reportProducts", nil)
 		response := httptest.NewRecorder()
@@ -542,7 +545,7 @@ func TestHandlerCountProductBatchesSections(t *testing.T) {
 		hd := setupSectionService(t)
 
 		mockService := hd.Sv.(*mocks.MockISectionService)
-		mockService.On("CountProductBatchesSections").Return([]model.SectionProductBatches{}, errors.New("unable to count section product batches"))
+		mockService.On("CountProductBatchesSections", mock.Anything).Return([]model.SectionProductBatches{}, errors.New("unable to count section product batches"))
 
 		request := httptest.NewRequest(http.MethodGet, "/api/v1/sections/reportProducts", nil)
 		response := httptest.NewRecorder()
@@ -566,7 +569,7 @@ func TestHandlerCountProductBatchesSections(t *testing.T) {
 		}
 
 		mockService := hd.Sv.(*mocks.MockISectionService)
-		mockService.On("CountProductBatchesBySectionID", countProductBatchesSection.ID).Return(countProductBatchesSection, nil)
+		mockService.On("CountProductBatchesBySectionID", mock.Anything, countProductBatchesSection.ID).Return(countProductBatchesSection, nil)
 
 		request := httptest.NewRequest(http.MethodGet, "/api/v1/sections/reportProducts?id=1", nil)
 		response := httptest.NewRecorder()
@@ -591,7 +594,7 @@ func TestHandlerCountProductBatchesSections(t *testing.T) {
 		hd := setupSectionService(t)
 
 		mockService := hd.Sv.(*mocks.MockISectionService)
-		mockService.On("CountProductBatchesBySectionID", 1).Return(model.SectionProductBatches{}, errors.New("unable to count section product batches"))
+		mockService.On("CountProductBatchesBySectionID", mock.Anything, 1).Return(model.SectionProductBatches{}, errors.New("unable to count section product batches"))
 
 		request := httptest.NewRequest(http.MethodGet, "/api/v1/sections/reportProducts?id=1", nil)
 		response := httptest.NewRecorder()
@@ -609,7 +612,7 @@ func TestHandlerCountProductBatchesSections(t *testing.T) {
 		hd := setupSectionService(t)
 
 		mockService := hd.Sv.(*mocks.MockISectionService)
-		mockService.On("CountProductBatchesBySectionID", 1).Return(model.SectionProductBatches{}, customerror.HandleError("section", 0, ""))
+		mockService.On("CountProductBatchesBySectionID", mock.Anything, 1).Return(model.SectionProductBatches{}, customerror.HandleError("section", 0, ""))
 
 		request := httptest.NewRequest(http.MethodGet, "/api/v1/sections/reportProducts?id=1", nil)
 		response := httptest.NewRecorder()
@@ -637,3 +640,66 @@ func TestHandlerCountProductBatchesSections(t *testing.T) {
 		assert.JSONEq(t, expectedJson, response.Body.String())
 	})
 }
+
+func TestHandlerGetCapacityReport(t *testing.T) {
+	t.Run("return the sections outside the capacity thresholds", func(t *testing.T) {
+		hd := setupSectionService(t)
+
+		report := []model.SectionCapacity{
+			{ID: 1, SectionNumber: "S01", WarehouseID: 1, CurrentCapacity: 19, MinimumCapacity: 5, MaximumCapacity: 20, Status: model.SectionCapacityNearFull},
+		}
+
+		mockService := hd.Sv.(*mocks.MockISectionService)
+		mockService.On("GetCapacityReport", mock.Anything, model.DefaultNearFullRatio).Return(report, nil)
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/sections/reportCapacity", nil)
+		response := httptest.NewRecorder()
+
+		hd.GetCapacityReport(response, request)
+
+		expectedJson := `{
+    "data": [
+        {
+            "id": 1,
+            "section_number": "S01",
+            "warehouse_id": 1,
+            "current_capacity": 19,
+            "minimum_capacity": 5,
+            "maximum_capacity": 20,
+            "status": "near_full"
+        }
+    ]
+}`
+
+		assert.Equal(t, http.StatusOK, response.Code)
+		assert.JSONEq(t, expectedJson, response.Body.String())
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("return bad request for an invalid ratio", func(t *testing.T) {
+		hd := setupSectionService(t)
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/sections/reportCapacity?near_full_ratio=abc", nil)
+		response := httptest.NewRecorder()
+
+		hd.GetCapacityReport(response, request)
+
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+		assert.JSONEq(t, `{"message": "invalid near_full_ratio"}`, response.Body.String())
+	})
+
+	t.Run("return unprocessable entity for an out of range ratio", func(t *testing.T) {
+		hd := setupSectionService(t)
+
+		mockService := hd.Sv.(*mocks.MockISectionService)
+		mockService.On("GetCapacityReport", mock.Anything, 2.0).Return(nil, customerror.HandleError("section", customerror.ErrorInvalid, "near full ratio must be greater than 0 and at most 1"))
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/sections/reportCapacity?near_full_ratio=2", nil)
+		response := httptest.NewRecorder()
+
+		hd.GetCapacityReport(response, request)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, response.Code)
+		mockService.AssertExpectations(t)
+	})
+}
@@ -29,13 +29,26 @@ type SellersController struct {
 // @Description Fetch all registered sellers from the database
 // @Tags Seller
 // @Produce json
+// @Param page query int false "Page number, starting at 1"
+// @Param page_size query int false "Items per page (max 100)"
+// @Param sort query string false "Sort field, prefix with - for descending"
+// @Param locality_id query int false "Locality ID"
 // @Success 200 {object} model.SellerResponseSwagger
+// @Failure 400 {object} model.ErrorResponseSwagger "Invalid pagination, sort or filter parameter"
 // @Failure 500 {object} model.ErrorResponseSwagger "Unable to list sellers"
 // @Router /sellers [get]
 func (hd *SellersController) GetAllSellers(w http.ResponseWriter, r *http.Request) {
 	hd.log.Log("SellersHandler", "INFO", "Get all sellers initializing")
 
-	sellers, err := hd.Service.GetAll()
+	params, err := model.ParseListParams(r.URL.Query(), model.SellerListOptions)
+	if err != nil {
+		hd.log.Log("SellersHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+		response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody(err.Error(), nil))
+
+		return
+	}
+
+	sellers, total, err := hd.Service.GetAll(r.Context(), params)
 	if ok := hd.handlerError(err, w); ok {
 		hd.log.Log("SellersHandler", "ERROR", fmt.Sprintf("Error: %v", err))
 
@@ -57,7 +70,7 @@ func (hd *SellersController) GetAllSellers(w http.ResponseWriter, r *http.Reques
 	hd.log.Log("SellersHandler", "INFO", fmt.Sprintf("Retrieved sellers successfully: %+v", sellers))
 	hd.log.Log("SellersHandler", "INFO", "Get all sellers completed")
 
-	response.JSON(w, http.StatusOK, responses.CreateResponseBody("", data))
+	response.JSON(w, http.StatusOK, responses.CreatePaginatedResponseBody("", data, params.Page, params.PageSize, total))
 }
 
 // GetByID retrieves a seller by their ID.
@@ -85,7 +98,7 @@ func (hd *SellersController) GetByID(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	seller, err := hd.Service.GetByID(id)
+	seller, err := hd.Service.GetByID(r.Context(), id)
 	if ok := hd.handlerError(err, w); ok {
 		hd.log.Log("SellersHandler", "ERROR", fmt.Sprintf("Error: %v", err))
 
@@ -122,7 +135,7 @@ func (hd *SellersController) CreateSellers(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	createdseller, err := hd.Service.CreateSeller(&seller)
+	createdseller, err := hd.Service.CreateSeller(r.Context(), &seller)
 	if ok := hd.handlerError(err, w); ok {
 		hd.log.Log("SellersHandler", "ERROR", fmt.Sprintf("Error: %v", err))
 
@@ -163,7 +176,7 @@ func (hd *SellersController) UpdateSellers(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	_, err = hd.Service.GetByID(id)
+	_, err = hd.Service.GetByID(r.Context(), id)
 	if ok := hd.handlerError(err, w); ok {
 		hd.log.Log("SellersHandler", "ERROR", fmt.Sprintf("Error: %v", err))
 
@@ -179,7 +192,7 @@ func (hd *SellersController) UpdateSellers(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	seller, err := hd.Service.UpdateSeller(id, &s)
+	seller, err := hd.Service.UpdateSeller(r.Context(), id, &s)
 	if ok := hd.handlerError(err, w); ok {
 		hd.log.Log("SellersHandler", "ERROR", fmt.Sprintf("Error: %v", err))
 
@@ -218,14 +231,14 @@ func (hd *SellersController) DeleteSellers(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	_, err = hd.Service.GetByID(id)
+	_, err = hd.Service.GetByID(r.Context(), id)
 	if ok := hd.handlerError(err, w); ok {
 		hd.log.Log("SellersHandler", "ERROR", fmt.Sprintf("Error: %v", err))
 
 		return
 	}
 
-	err = hd.Service.DeleteSeller(id)
+	err = hd.Service.DeleteSeller(r.Context(), id)
 	if ok := hd.handlerError(err, w); ok {
 		hd.log.Log("SellersHandler", "ERROR", fmt.Sprintf("Error: %v", err))
 
@@ -14,6 +14,7 @@ import (
 	"github.com/maxwelbm/alkemy-g7.git/internal/model"
 	"github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
 	"github.com/stretchr/testify/assert"
+	testifymock "github.com/stretchr/testify/mock"
 )
 
 var logMock = mocks.MockLog{}
@@ -51,11 +52,17 @@ func TestSellersController_GetAllSellers(t *testing.T) {
 						"address": "123 Montain St Avenue",
 						"telephone": "5554545999",
 						"locality_id": 2
-					}]
+					}],
+					"pagination": {
+						"page": 1,
+						"page_size": 20,
+						"total_items": 2,
+						"total_pages": 1
+					}
 				}`
 		statusCode := http.StatusOK
 
-		mock.On("GetAll").Return(returnService, nil)
+		mock.On("GetAll", testifymock.Anything, testifymock.Anything).Return(returnService, 2, nil)
 
 		request := httptest.NewRequest(http.MethodGet, endpoint, nil)
 		response := httptest.NewRecorder()
@@ -75,7 +82,7 @@ func TestSellersController_GetAllSellers(t *testing.T) {
 		statusCode := http.StatusInternalServerError
 		er := errors.New("internal server error")
 
-		mock.On("GetAll").Return(returnService, er)
+		mock.On("GetAll", testifymock.Anything, testifymock.Anything).Return(returnService, 0, er)
 
 		request := httptest.NewRequest(http.MethodGet, endpoint, nil)
 		response := httptest.NewRecorder()
@@ -109,7 +116,7 @@ func TestSellersController_GetByID(t *testing.T) {
 				}`
 		statusCode := http.StatusOK
 
-		mock.On("GetByID", ID).Return(returnService, nil)
+		mock.On("GetByID", testifymock.Anything, ID).Return(returnService, nil)
 
 		request := httptest.NewRequest(http.MethodGet, endpoint+strconv.Itoa(ID), nil)
 		response := httptest.NewRecorder()
@@ -127,7 +134,7 @@ func TestSellersController_GetByID(t *testing.T) {
 		statusCode := http.StatusNotFound
 		errS := customerror.ErrSellerNotFound
 
-		mock.On("GetByID", ID).Return(returnService, errS)
+		mock.On("GetByID", testifymock.Anything, ID).Return(returnService, errS)
 
 		request := httptest.NewRequest(http.MethodGet, endpoint+strconv.Itoa(ID), nil)
 		response := httptest.NewRecorder()
@@ -145,7 +152,7 @@ func TestSellersController_GetByID(t *testing.T) {
 		statusCode := http.StatusInternalServerError
 		errS := customerror.ErrDefaultSeller
 
-		mock.On("GetByID", ID).Return(returnService, errS)
+		mock.On("GetByID", testifymock.Anything, ID).Return(returnService, errS)
 
 		request := httptest.NewRequest(http.MethodGet, endpoint+strconv.Itoa(ID), nil)
 		response := httptest.NewRecorder()
@@ -195,7 +202,7 @@ func TestSellersController_CreateSellers(t *testing.T) {
                         }
                     }`
 		statusCode := http.StatusCreated
-		mock.On("CreateSeller", &arg).Return(returnService, nil)
+		mock.On("CreateSeller", testifymock.Anything, &arg).Return(returnService, nil)
 
 		request := httptest.NewRequest(http.MethodPost, endpoint, bytes.NewReader(body))
 		request.Header.Set("Content-Type", "application/json")
@@ -245,7 +252,7 @@ func TestSellersController_CreateSellers(t *testing.T) {
 		statusCode := http.StatusUnprocessableEntity
 		errS := customerror.ErrNullSellerAttribute
 
-		mock.On("CreateSeller", &arg).Return(returnService, errS)
+		mock.On("CreateSeller", testifymock.Anything, &arg).Return(returnService, errS)
 
 		request := httptest.NewRequest(http.MethodPost, endpoint, bytes.NewReader(body))
 		request.Header.Set("Content-Type", "application/json")
@@ -268,7 +275,7 @@ func TestSellersController_CreateSellers(t *testing.T) {
 		statusCode := http.StatusUnprocessableEntity
 		errS := customerror.ErrNullSellerAttribute
 
-		mock.On("CreateSeller", &arg).Return(returnService, errS)
+		mock.On("CreateSeller", testifymock.Anything, &arg).Return(returnService, errS)
 
 		request := httptest.NewRequest(http.MethodPost, endpoint, bytes.NewReader(body))
 		request.Header.Set("Content-Type", "application/json")
@@ -297,7 +304,7 @@ func TestSellersController_CreateSellers(t *testing.T) {
 		statusCode := http.StatusConflict
 		errS := customerror.ErrCIDSellerAlreadyExist
 
-		mock.On("CreateSeller", &arg).Return(returnService, errS)
+		mock.On("CreateSeller", testifymock.Anything, &arg).Return(returnService, errS)
 
 		request := httptest.NewRequest(http.MethodPost, endpoint, bytes.NewReader(body))
 		request.Header.Set("Content-Type", "application/json")
@@ -326,7 +333,7 @@ func TestSellersController_CreateSellers(t *testing.T) {
 		statusCode := http.StatusNotFound
 		errS := customerror.ErrLocalityNotFound
 
-		mock.On("CreateSeller", &arg).Return(returnService, errS)
+		mock.On("CreateSeller", testifymock.Anything, &arg).Return(returnService, errS)
 
 		request := httptest.NewRequest(http.MethodPost, endpoint, bytes.NewReader(body))
 		request.Header.Set("Content-Type", "application/json")
@@ -369,8 +376,8 @@ func TestSellersController_UpdateSellers(t *testing.T) {
                     }`
 		statusCode := http.StatusOK
 
-		mock.On("UpdateSeller", ID, &arg).Return(returnService, nil)
-		mock.On("GetByID", ID).Return(returnService, nil)
+		mock.On("UpdateSeller", testifymock.Anything, ID, &arg).Return(returnService, nil)
+		mock.On("GetByID", testifymock.Anything, ID).Return(returnService, nil)
 
 		request := httptest.NewRequest(http.MethodPatch, endpoint+strconv.Itoa(ID), bytes.NewReader(body))
 		request.Header.Set("Content-Type", "application/json")
@@ -396,7 +403,7 @@ func TestSellersController_UpdateSellers(t *testing.T) {
 		statusCode := http.StatusNotFound
 		errS := customerror.ErrSellerNotFound
 
-		mock.On("GetByID", ID).Return(returnService, errS)
+		mock.On("GetByID", testifymock.Anything, ID).Return(returnService, errS)
 
 		request := httptest.NewRequest(http.MethodPatch, endpoint+strconv.Itoa(ID), bytes.NewReader(body))
 		request.Header.Set("Content-Type", "application/json")
@@ -422,7 +429,7 @@ func TestSellersController_UpdateSellers(t *testing.T) {
 		statusCode := http.StatusBadRequest
 		errS := customerror.ErrInvalidSellerJSONFormat
 
-		mock.On("GetByID", ID).Return(returnService, errS)
+		mock.On("GetByID", testifymock.Anything, ID).Return(returnService, errS)
 
 		request := httptest.NewRequest(http.MethodPatch, endpoint+strconv.Itoa(ID), bytes.NewReader(body))
 		request.Header.Set("Content-Type", "application/json")
@@ -448,7 +455,7 @@ func TestSellersController_UpdateSellers(t *testing.T) {
 		statusCode := http.StatusUnprocessableEntity
 		errS := customerror.ErrNullSellerAttribute
 
-		mock.On("GetByID", ID).Return(returnService, errS)
+		mock.On("GetByID", testifymock.Anything, ID).Return(returnService, errS)
 
 		request := httptest.NewRequest(http.MethodPatch, endpoint+strconv.Itoa(ID), bytes.NewReader(body))
 		request.Header.Set("Content-Type", "application/json")
@@ -474,7 +481,7 @@ func TestSellersController_UpdateSellers(t *testing.T) {
 		statusCode := http.StatusConflict
 		errS := customerror.ErrCIDSellerAlreadyExist
 
-		mock.On("GetByID", ID).Return(returnService, errS)
+		mock.On("GetByID", testifymock.Anything, ID).Return(returnService, errS)
 
 		request := httptest.NewRequest(http.MethodPatch, endpoint+strconv.Itoa(ID), bytes.NewReader(body))
 		request.Header.Set("Content-Type", "application/json")
@@ -500,7 +507,7 @@ func TestSellersController_UpdateSellers(t *testing.T) {
 		statusCode := http.StatusNotFound
 		errS := customerror.ErrLocalityNotFound
 
-		mock.On("GetByID", ID).Return(returnService, errS)
+		mock.On("GetByID", testifymock.Anything, ID).Return(returnService, errS)
 
 		request := httptest.NewRequest(http.MethodPatch, endpoint+strconv.Itoa(ID), bytes.NewReader(body))
 		request.Header.Set("Content-Type", "application/json")
@@ -547,8 +554,8 @@ func TestSellersController_DeleteSellers(t *testing.T) {
 		res := `{}`
 		statusCode := http.StatusNoContent
 
-		mock.On("DeleteSeller", ID).Return(nil)
-		mock.On("GetByID", ID).Return(returnService, nil)
+		mock.On("DeleteSeller", testifymock.Anything, ID).Return(nil)
+		mock.On("GetByID", testifymock.Anything, ID).Return(returnService, nil)
 
 		request := httptest.NewRequest(http.MethodDelete, endpoint+strconv.Itoa(ID), nil)
 		response := httptest.NewRecorder()
@@ -566,7 +573,7 @@ func TestSellersController_DeleteSellers(t *testing.T) {
 		statusCode := http.StatusNotFound
 		errS := customerror.ErrSellerNotFound
 
-		mock.On("GetByID", ID).Return(returnService, errS)
+		mock.On("GetByID", testifymock.Anything, ID).Return(returnService, errS)
 
 		request := httptest.NewRequest(http.MethodDelete, endpoint+strconv.Itoa(ID), nil)
 		response := httptest.NewRecorder()
@@ -584,7 +591,7 @@ func TestSellersController_DeleteSellers(t *testing.T) {
 		statusCode := http.StatusInternalServerError
 		errS := customerror.ErrDefaultSeller
 
-		mock.On("GetByID", ID).Return(returnService, errS)
+		mock.On("GetByID", testifymock.Anything, ID).Return(returnService, errS)
 
 		request := httptest.NewRequest(http.MethodDelete, endpoint+strconv.Itoa(ID), nil)
 		response := httptest.NewRecorder()
@@ -0,0 +1,190 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/bootcamp-go/web/response"
+	"github.com/go-chi/chi/v5"
+	"github.com/maxwelbm/alkemy-g7.git/internal/handler/responses"
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+	"github.com/maxwelbm/alkemy-g7.git/internal/service/interfaces"
+	"github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
+	"github.com/maxwelbm/alkemy-g7.git/pkg/logger"
+)
+
+type ShipmentHandler struct {
+	Svc interfaces.IShipmentService
+	log logger.Logger
+}
+
+func NewShipmentHandler(svc interfaces.IShipmentService, log logger.Logger) *ShipmentHandler {
+	return &ShipmentHandler{Svc: svc, log: log}
+}
+
+// Create assigns purchase orders to a carrier.
+// @Summary Create a shipment
+// @Description Assigns one or more purchase orders to a carrier, generating the tracking code stamped on every order.
+// @Tags Shipment
+// @Accept json
+// @Produce json
+// @Param shipment body model.ShipmentCreate true "Carrier and purchase orders"
+// @Success 201 {object} model.ShipmentResponseSwagger
+// @Failure 404 {object} model.ErrorResponseSwagger "Carrier or purchase order not found"
+// @Failure 409 {object} model.ErrorResponseSwagger "Purchase order already has an active shipment"
+// @Failure 422 {object} model.ErrorResponseSwagger "Invalid input"
+// @Failure 500 {object} model.ErrorResponseSwagger "Unable to create shipment"
+// @Router /shipments [post]
+func (h *ShipmentHandler) Create(w http.ResponseWriter, r *http.Request) {
+	h.log.Log("ShipmentHandler", "INFO", "initializing Create function")
+
+	var body model.ShipmentCreate
+
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&body); err != nil {
+		response.JSON(w, http.StatusUnprocessableEntity, responses.CreateResponseBody("invalid request body", nil))
+		h.log.Log("ShipmentHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	shipment, err := h.Svc.Create(r.Context(), body)
+	if err != nil {
+		h.handleError(w, err, "unable to create shipment")
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, responses.CreateResponseBody("", shipment))
+	h.log.Log("ShipmentHandler", "INFO", fmt.Sprintf("shipment %d created", shipment.ID))
+}
+
+// GetByID returns a shipment with its purchase orders and events.
+// @Summary Get a shipment
+// @Description Returns the shipment with its purchase orders and status events.
+// @Tags Shipment
+// @Produce json
+// @Param id path int true "Shipment ID"
+// @Success 200 {object} model.ShipmentResponseSwagger
+// @Failure 400 {object} model.ErrorResponseSwagger "Invalid ID"
+// @Failure 404 {object} model.ErrorResponseSwagger "Shipment not found"
+// @Failure 500 {object} model.ErrorResponseSwagger "Unable to get shipment"
+// @Router /shipments/{id} [get]
+func (h *ShipmentHandler) GetByID(w http.ResponseWriter, r *http.Request) {
+	h.log.Log("ShipmentHandler", "INFO", "initializing GetByID function")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody("invalid id", nil))
+		h.log.Log("ShipmentHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	shipment, err := h.Svc.GetByID(r.Context(), id)
+	if err != nil {
+		h.handleError(w, err, "unable to get shipment")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, responses.CreateResponseBody("", shipment))
+	h.log.Log("ShipmentHandler", "INFO", fmt.Sprintf("returning shipment %d", id))
+}
+
+// UpdateStatus moves a shipment to its next status.
+// @Summary Update a shipment status
+// @Description Records a new status of the shipment: created, picked, in_transit and then delivered, or failed at any step before delivery.
+// @Tags Shipment
+// @Accept json
+// @Produce json
+// @Param id path int true "Shipment ID"
+// @Param status body model.ShipmentStatusUpdate true "New status"
+// @Success 200 {object} model.ShipmentResponseSwagger
+// @Failure 400 {object} model.ErrorResponseSwagger "Invalid ID"
+// @Failure 404 {object} model.ErrorResponseSwagger "Shipment not found"
+// @Failure 409 {object} model.ErrorResponseSwagger "Transition not allowed"
+// @Failure 422 {object} model.ErrorResponseSwagger "Invalid input"
+// @Failure 500 {object} model.ErrorResponseSwagger "Unable to update shipment status"
+// @Router /shipments/{id}/status [patch]
+func (h *ShipmentHandler) UpdateStatus(w http.ResponseWriter, r *http.Request) {
+	h.log.Log("ShipmentHandler", "INFO", "initializing UpdateStatus function")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody("invalid id", nil))
+		h.log.Log("ShipmentHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	var body model.ShipmentStatusUpdate
+
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+
+	if err = decoder.Decode(&body); err != nil {
+		response.JSON(w, http.StatusUnprocessableEntity, responses.CreateResponseBody("invalid request body", nil))
+		h.log.Log("ShipmentHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	shipment, err := h.Svc.UpdateStatus(r.Context(), id, body)
+	if err != nil {
+		h.handleError(w, err, "unable to update shipment status")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, responses.CreateResponseBody("", shipment))
+	h.log.Log("ShipmentHandler", "INFO", fmt.Sprintf("shipment %d is now %s", id, shipment.Status))
+}
+
+// GetTracking returns the tracking of a purchase order.
+// @Summary Track a purchase order
+// @Description Returns the carrier, tracking code, status and timestamped events of the latest shipment of the purchase order.
+// @Tags PurchaseOrder
+// @Produce json
+// @Param id path int true "Purchase order ID"
+// @Success 200 {object} model.PurchaseOrderTrackingResponseSwagger
+// @Failure 400 {object} model.ErrorResponseSwagger "Invalid ID"
+// @Failure 404 {object} model.ErrorResponseSwagger "Purchase order or shipment not found"
+// @Failure 500 {object} model.ErrorResponseSwagger "Unable to get tracking"
+// @Router /purchaseOrders/{id}/tracking [get]
+func (h *ShipmentHandler) GetTracking(w http.ResponseWriter, r *http.Request) {
+	h.log.Log("ShipmentHandler", "INFO", "initializing GetTracking function")
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody("invalid id", nil))
+		h.log.Log("ShipmentHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	tracking, err := h.Svc.GetTracking(r.Context(), id)
+	if err != nil {
+		h.handleError(w, err, "unable to get tracking")
+		return
+	}
+
+	response.JSON(w, http.StatusOK, responses.CreateResponseBody("", tracking))
+	h.log.Log("ShipmentHandler", "INFO", fmt.Sprintf("returning tracking of purchase order %d", id))
+}
+
+func (h *ShipmentHandler) handleError(w http.ResponseWriter, err error, message string) {
+	h.log.Log("ShipmentHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+
+	switch e := err.(type) {
+	case *customerror.GenericError:
+		response.JSON(w, e.Code, responses.CreateResponseBody(e.Error(), nil))
+	case *customerror.CarrierError:
+		response.JSON(w, e.Code, responses.CreateResponseBody(e.Error(), nil))
+	case *customerror.PurcahseOrderError:
+		response.JSON(w, e.Code, responses.CreateResponseBody(e.Error(), nil))
+	default:
+		response.JSON(w, http.StatusInternalServerError, responses.CreateResponseBody(message, nil))
+	}
+}
@@ -0,0 +1,201 @@
+package handler_test
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/maxwelbm/alkemy-g7.git/internal/handler"
+	"github.com/maxwelbm/alkemy-g7.git/internal/mocks"
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+	"github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func setupShipment(t *testing.T) (*mocks.MockIShipmentService, *chi.Mux) {
+	mockSvc := mocks.NewMockIShipmentService(t)
+	hd := handler.NewShipmentHandler(mockSvc, logMock)
+
+	r := chi.NewRouter()
+	r.Post("/api/v1/shipments", hd.Create)
+	r.Get("/api/v1/shipments/{id}", hd.GetByID)
+	r.Patch("/api/v1/shipments/{id}/status", hd.UpdateStatus)
+	r.Get("/api/v1/purchaseOrders/{id}/tracking", hd.GetTracking)
+
+	return mockSvc, r
+}
+
+func TestShipmentHandler_Create(t *testing.T) {
+	createdAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	t.Run("create the shipment", func(t *testing.T) {
+		mockSvc, r := setupShipment(t)
+
+		mockSvc.On("Create", mock.Anything, model.ShipmentCreate{CarrierID: 1, PurchaseOrderIDs: []int{1, 2}}).Return(model.Shipment{
+			ID: 3, TrackingCode: "ABCDEFGH2345", CarrierID: 1, Status: model.ShipmentStatusCreated, PurchaseOrderIDs: []int{1, 2},
+			CreatedAt: createdAt, UpdatedAt: createdAt,
+			Events: []model.ShipmentEvent{{ID: 1, ShipmentID: 3, Status: model.ShipmentStatusCreated, Description: "shipment created", OccurredAt: createdAt}},
+		}, nil)
+
+		request := httptest.NewRequest(http.MethodPost, "/api/v1/shipments", bytes.NewReader([]byte(`{"carrier_id": 1, "purchase_order_ids": [1, 2]}`)))
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusCreated, response.Code)
+		assert.JSONEq(t, `{"data": {
+			"id": 3, "tracking_code": "ABCDEFGH2345", "carrier_id": 1, "status": "created", "purchase_order_ids": [1, 2],
+			"created_at": "2025-01-01T10:00:00Z", "updated_at": "2025-01-01T10:00:00Z",
+			"events": [{"id": 1, "status": "created", "description": "shipment created", "occurred_at": "2025-01-01T10:00:00Z"}]
+		}}`, response.Body.String())
+	})
+
+	t.Run("return unprocessable entity for unknown fields", func(t *testing.T) {
+		_, r := setupShipment(t)
+
+		request := httptest.NewRequest(http.MethodPost, "/api/v1/shipments", bytes.NewReader([]byte(`{"carrier": 1}`)))
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, response.Code)
+		assert.JSONEq(t, `{"message": "invalid request body"}`, response.Body.String())
+	})
+
+	t.Run("return not found for an unknown carrier", func(t *testing.T) {
+		mockSvc, r := setupShipment(t)
+
+		mockSvc.On("Create", mock.Anything, mock.Anything).Return(model.Shipment{}, customerror.NewCarrierError(customerror.ErrNotFound.Error(), "carrier", http.StatusNotFound))
+
+		request := httptest.NewRequest(http.MethodPost, "/api/v1/shipments", bytes.NewReader([]byte(`{"carrier_id": 9, "purchase_order_ids": [1]}`)))
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusNotFound, response.Code)
+	})
+
+	t.Run("return conflict for a purchase order already shipped", func(t *testing.T) {
+		mockSvc, r := setupShipment(t)
+
+		mockSvc.On("Create", mock.Anything, mock.Anything).Return(model.Shipment{}, customerror.NewError(http.StatusConflict, "already has an active shipment", "purchase order", ""))
+
+		request := httptest.NewRequest(http.MethodPost, "/api/v1/shipments", bytes.NewReader([]byte(`{"carrier_id": 1, "purchase_order_ids": [1]}`)))
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusConflict, response.Code)
+		assert.JSONEq(t, `{"message": "purchase order already has an active shipment"}`, response.Body.String())
+	})
+
+	t.Run("return internal server error for an unexpected error", func(t *testing.T) {
+		mockSvc, r := setupShipment(t)
+
+		mockSvc.On("Create", mock.Anything, mock.Anything).Return(model.Shipment{}, errors.New("unmapped error"))
+
+		request := httptest.NewRequest(http.MethodPost, "/api/v1/shipments", bytes.NewReader([]byte(`{"carrier_id": 1, "purchase_order_ids": [1]}`)))
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusInternalServerError, response.Code)
+		assert.JSONEq(t, `{"message": "unable to create shipment"}`, response.Body.String())
+	})
+}
+
+func TestShipmentHandler_GetByID(t *testing.T) {
+	t.Run("return bad request for an invalid id", func(t *testing.T) {
+		_, r := setupShipment(t)
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/shipments/abc", nil)
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+	})
+
+	t.Run("return not found for an unknown shipment", func(t *testing.T) {
+		mockSvc, r := setupShipment(t)
+
+		mockSvc.On("GetByID", mock.Anything, 99).Return(model.Shipment{}, customerror.HandleError("shipment", customerror.ErrorNotFound, ""))
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/shipments/99", nil)
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusNotFound, response.Code)
+		assert.JSONEq(t, `{"message": "shipment not found"}`, response.Body.String())
+	})
+}
+
+func TestShipmentHandler_UpdateStatus(t *testing.T) {
+	t.Run("move the shipment to the next status", func(t *testing.T) {
+		mockSvc, r := setupShipment(t)
+
+		mockSvc.On("UpdateStatus", mock.Anything, 3, model.ShipmentStatusUpdate{Status: model.ShipmentStatusPicked, Description: "picked"}).
+			Return(model.Shipment{ID: 3, Status: model.ShipmentStatusPicked}, nil)
+
+		request := httptest.NewRequest(http.MethodPatch, "/api/v1/shipments/3/status", bytes.NewReader([]byte(`{"status": "picked", "description": "picked"}`)))
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusOK, response.Code)
+	})
+
+	t.Run("return conflict for a transition not allowed", func(t *testing.T) {
+		mockSvc, r := setupShipment(t)
+
+		mockSvc.On("UpdateStatus", mock.Anything, 3, mock.Anything).
+			Return(model.Shipment{}, customerror.NewError(http.StatusConflict, "cannot change status from created to delivered", "shipment", ""))
+
+		request := httptest.NewRequest(http.MethodPatch, "/api/v1/shipments/3/status", bytes.NewReader([]byte(`{"status": "delivered"}`)))
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusConflict, response.Code)
+		assert.JSONEq(t, `{"message": "shipment cannot change status from created to delivered"}`, response.Body.String())
+	})
+}
+
+func TestShipmentHandler_GetTracking(t *testing.T) {
+	occurredAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	t.Run("return the tracking of the purchase order", func(t *testing.T) {
+		mockSvc, r := setupShipment(t)
+
+		mockSvc.On("GetTracking", mock.Anything, 1).Return(model.PurchaseOrderTracking{
+			PurchaseOrderID: 1, ShipmentID: 3, TrackingCode: "ABCDEFGH2345", CarrierID: 2, Status: model.ShipmentStatusInTransit,
+			Events: []model.ShipmentEvent{
+				{ID: 1, Status: model.ShipmentStatusCreated, Description: "shipment created", OccurredAt: occurredAt},
+				{ID: 2, Status: model.ShipmentStatusInTransit, OccurredAt: occurredAt.Add(time.Hour)},
+			},
+		}, nil)
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/purchaseOrders/1/tracking", nil)
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusOK, response.Code)
+		assert.JSONEq(t, `{"data": {
+			"purchase_order_id": 1, "shipment_id": 3, "tracking_code": "ABCDEFGH2345", "carrier_id": 2, "status": "in_transit",
+			"events": [
+				{"id": 1, "status": "created", "description": "shipment created", "occurred_at": "2025-01-01T10:00:00Z"},
+				{"id": 2, "status": "in_transit", "description": "", "occurred_at": "2025-01-01T11:00:00Z"}
+			]
+		}}`, response.Body.String())
+	})
+
+	t.Run("return not found for an unknown purchase order", func(t *testing.T) {
+		mockSvc, r := setupShipment(t)
+
+		mockSvc.On("GetTracking", mock.Anything, 99).Return(model.PurchaseOrderTracking{}, customerror.NewPurcahseOrderError(http.StatusNotFound, customerror.ErrNotFound.Error(), "Purchase Order"))
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/purchaseOrders/99/tracking", nil)
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusNotFound, response.Code)
+		assert.JSONEq(t, `{"message": "Purchase Order not found"}`, response.Body.String())
+	})
+}
@@ -0,0 +1,133 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/bootcamp-go/web/response"
+	"github.com/go-chi/chi/v5"
+	"github.com/maxwelbm/alkemy-g7.git/internal/handler/responses"
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+	"github.com/maxwelbm/alkemy-g7.git/internal/service/interfaces"
+	"github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
+	"github.com/maxwelbm/alkemy-g7.git/pkg/logger"
+)
+
+type TemperatureExcursionHandler struct {
+	Svc interfaces.ITemperatureExcursionService
+	log logger.Logger
+}
+
+func NewTemperatureExcursionHandler(svc interfaces.ITemperatureExcursionService, log logger.Logger) *TemperatureExcursionHandler {
+	return &TemperatureExcursionHandler{Svc: svc, log: log}
+}
+
+// GetBySection lists the temperature excursions recorded in a section.
+// @Summary List section temperature excursions
+// @Description Returns the incidents where a batch stored in the section was kept outside its temperature limits, the most recent first.
+// @Tags TemperatureExcursion
+// @Produce json
+// @Param id path int true "Section ID"
+// @Param status query string false "Filter by status (open or closed)"
+// @Success 200 {object} model.TemperatureExcursionResponseSwagger
+// @Failure 400 {object} model.ErrorResponseSwagger "Invalid ID"
+// @Failure 404 {object} model.ErrorResponseSwagger "Section not found"
+// @Failure 422 {object} model.ErrorResponseSwagger "Invalid status"
+// @Failure 500 {object} model.ErrorResponseSwagger "Unable to list temperature excursions"
+// @Router /sections/{id}/excursions [get]
+func (h *TemperatureExcursionHandler) GetBySection(w http.ResponseWriter, r *http.Request) {
+	h.log.Log("TemperatureExcursionHandler", "INFO", "initializing GetBySection function")
+
+	sectionID, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody("invalid id", nil))
+		h.log.Log("TemperatureExcursionHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	excursions, err := h.Svc.GetBySection(r.Context(), sectionID, r.URL.Query().Get("status"))
+	if err != nil {
+		if err, ok := err.(*customerror.GenericError); ok {
+			response.JSON(w, err.Code, responses.CreateResponseBody(err.Error(), nil))
+			h.log.Log("TemperatureExcursionHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+
+			return
+		}
+
+		response.JSON(w, http.StatusInternalServerError, responses.CreateResponseBody("unable to list temperature excursions", nil))
+		h.log.Log("TemperatureExcursionHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	if excursions == nil {
+		excursions = []model.TemperatureExcursion{}
+	}
+
+	response.JSON(w, http.StatusOK, responses.CreateResponseBody("", excursions))
+	h.log.Log("TemperatureExcursionHandler", "INFO", fmt.Sprintf("returning %d temperature excursions for section %d", len(excursions), sectionID))
+}
+
+// GetReport summarizes the temperature excursions of every section.
+// @Summary Temperature excursions report
+// @Description Returns, per section with incidents, how many excursions started in the period, how many are still open and their total duration in minutes.
+// @Tags TemperatureExcursion
+// @Produce json
+// @Param from query string false "Period start (RFC3339)"
+// @Param to query string false "Period end, exclusive (RFC3339)"
+// @Success 200 {object} model.SectionExcursionReportResponseSwagger
+// @Failure 400 {object} model.ErrorResponseSwagger "Invalid query parameters"
+// @Failure 422 {object} model.ErrorResponseSwagger "Invalid period"
+// @Failure 500 {object} model.ErrorResponseSwagger "Unable to generate temperature excursions report"
+// @Router /sections/reportExcursions [get]
+func (h *TemperatureExcursionHandler) GetReport(w http.ResponseWriter, r *http.Request) {
+	h.log.Log("TemperatureExcursionHandler", "INFO", "initializing GetReport function")
+
+	var (
+		filter model.ExcursionReportFilter
+		err    error
+	)
+
+	if from := r.URL.Query().Get("from"); from != "" {
+		if filter.From, err = time.Parse(time.RFC3339, from); err != nil {
+			response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody("invalid from param, expected RFC3339", nil))
+			h.log.Log("TemperatureExcursionHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+
+			return
+		}
+	}
+
+	if to := r.URL.Query().Get("to"); to != "" {
+		if filter.To, err = time.Parse(time.RFC3339, to); err != nil {
+			response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody("invalid to param, expected RFC3339", nil))
+			h.log.Log("TemperatureExcursionHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+
+			return
+		}
+	}
+
+	report, err := h.Svc.GetReport(r.Context(), filter)
+	if err != nil {
+		if err, ok := err.(*customerror.GenericError); ok {
+			response.JSON(w, err.Code, responses.CreateResponseBody(err.Error(), nil))
+			h.log.Log("TemperatureExcursionHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+
+			return
+		}
+
+		response.JSON(w, http.StatusInternalServerError, responses.CreateResponseBody("unable to generate temperature excursions report", nil))
+		h.log.Log("TemperatureExcursionHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	if report == nil {
+		report = []model.SectionExcursionReport{}
+	}
+
+	response.JSON(w, http.StatusOK, responses.CreateResponseBody("", report))
+	h.log.Log("TemperatureExcursionHandler", "INFO", fmt.Sprintf("returning temperature excursions report for %d sections", len(report)))
+}
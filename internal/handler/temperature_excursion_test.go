@@ -0,0 +1,138 @@
+package handler_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/maxwelbm/alkemy-g7.git/internal/handler"
+	"github.com/maxwelbm/alkemy-g7.git/internal/mocks"
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+	"github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func setupTemperatureExcursion(t *testing.T) (*mocks.MockITemperatureExcursionService, *chi.Mux) {
+	mockSvc := mocks.NewMockITemperatureExcursionService(t)
+	hd := handler.NewTemperatureExcursionHandler(mockSvc, logMock)
+
+	r := chi.NewRouter()
+	r.Get("/api/v1/sections/reportExcursions", hd.GetReport)
+	r.Get("/api/v1/sections/{id}/excursions", hd.GetBySection)
+
+	return mockSvc, r
+}
+
+func TestTemperatureExcursionHandler_GetBySection(t *testing.T) {
+	startedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	t.Run("return the excursions of the section", func(t *testing.T) {
+		mockSvc, r := setupTemperatureExcursion(t)
+
+		mockSvc.On("GetBySection", mock.Anything, 1, model.ExcursionStatusOpen).Return([]model.TemperatureExcursion{
+			{ID: 1, SectionID: 1, ProductBatchID: 2, Kind: model.ExcursionAboveMaximum, LimitTemperature: -15, PeakTemperature: -12, StartedAt: startedAt},
+		}, nil)
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/sections/1/excursions?status=open", nil)
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusOK, response.Code)
+		assert.JSONEq(t, `{"data": [{"id": 1, "section_id": 1, "product_batch_id": 2, "kind": "above_maximum", "limit_temperature": -15, "peak_temperature": -12, "started_at": "2025-01-01T10:00:00Z", "ended_at": null}]}`, response.Body.String())
+	})
+
+	t.Run("return an empty list when the section has no excursions", func(t *testing.T) {
+		mockSvc, r := setupTemperatureExcursion(t)
+
+		mockSvc.On("GetBySection", mock.Anything, 1, "").Return(nil, nil)
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/sections/1/excursions", nil)
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusOK, response.Code)
+		assert.JSONEq(t, `{"data": []}`, response.Body.String())
+	})
+
+	t.Run("return bad request for an invalid id", func(t *testing.T) {
+		_, r := setupTemperatureExcursion(t)
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/sections/abc/excursions", nil)
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+		assert.JSONEq(t, `{"message": "invalid id"}`, response.Body.String())
+	})
+
+	t.Run("return not found for an unknown section", func(t *testing.T) {
+		mockSvc, r := setupTemperatureExcursion(t)
+
+		mockSvc.On("GetBySection", mock.Anything, 99, "").Return(nil, customerror.HandleError("section", customerror.ErrorNotFound, ""))
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/sections/99/excursions", nil)
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusNotFound, response.Code)
+	})
+
+	t.Run("return internal server error for an unexpected error", func(t *testing.T) {
+		mockSvc, r := setupTemperatureExcursion(t)
+
+		mockSvc.On("GetBySection", mock.Anything, 1, "").Return(nil, errors.New("unmapped error"))
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/sections/1/excursions", nil)
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusInternalServerError, response.Code)
+		assert.JSONEq(t, `{"message": "unable to list temperature excursions"}`, response.Body.String())
+	})
+}
+
+func TestTemperatureExcursionHandler_GetReport(t *testing.T) {
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("return the incidents report of the period", func(t *testing.T) {
+		mockSvc, r := setupTemperatureExcursion(t)
+
+		mockSvc.On("GetReport", mock.Anything, model.ExcursionReportFilter{From: from, To: from.Add(24 * time.Hour)}).Return([]model.SectionExcursionReport{
+			{SectionID: 1, SectionNumber: "S01", WarehouseID: 1, IncidentsCount: 2, OpenCount: 1, TotalDurationMinutes: 90, LastStartedAt: from},
+		}, nil)
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/sections/reportExcursions?from=2025-01-01T00:00:00Z&to=2025-01-02T00:00:00Z", nil)
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusOK, response.Code)
+		assert.JSONEq(t, `{"data": [{"section_id": 1, "section_number": "S01", "warehouse_id": 1, "incidents_count": 2, "open_count": 1, "total_duration_minutes": 90, "last_started_at": "2025-01-01T00:00:00Z"}]}`, response.Body.String())
+	})
+
+	t.Run("return bad request for an invalid from param", func(t *testing.T) {
+		_, r := setupTemperatureExcursion(t)
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/sections/reportExcursions?from=yesterday", nil)
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+		assert.JSONEq(t, `{"message": "invalid from param, expected RFC3339"}`, response.Body.String())
+	})
+
+	t.Run("return unprocessable entity for an invalid period", func(t *testing.T) {
+		mockSvc, r := setupTemperatureExcursion(t)
+
+		mockSvc.On("GetReport", mock.Anything, mock.Anything).Return(nil, customerror.HandleError("temperature excursion", customerror.ErrorInvalid, "from must be before to"))
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/sections/reportExcursions?from=2025-01-02T00:00:00Z&to=2025-01-01T00:00:00Z", nil)
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, response.Code)
+	})
+}
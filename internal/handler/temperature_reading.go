@@ -0,0 +1,182 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/bootcamp-go/web/response"
+	"github.com/go-chi/chi/v5"
+	"github.com/maxwelbm/alkemy-g7.git/internal/handler/responses"
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+	"github.com/maxwelbm/alkemy-g7.git/internal/service/interfaces"
+	"github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
+	"github.com/maxwelbm/alkemy-g7.git/pkg/logger"
+)
+
+type TemperatureReadingJSON struct {
+	SensorID    string    `json:"sensor_id"`
+	Temperature float64   `json:"temperature"`
+	RecordedAt  time.Time `json:"recorded_at"`
+}
+
+type TemperatureReadingsJSON struct {
+	Readings []TemperatureReadingJSON `json:"readings"`
+}
+
+type TemperatureReadingHandler struct {
+	Svc interfaces.ITemperatureReadingService
+	log logger.Logger
+}
+
+func NewTemperatureReadingHandler(svc interfaces.ITemperatureReadingService, log logger.Logger) *TemperatureReadingHandler {
+	return &TemperatureReadingHandler{Svc: svc, log: log}
+}
+
+// Ingest stores a bulk of sensor readings for a section.
+// @Summary Ingest section temperature readings
+// @Description Stores the sensor readings of a section and updates its current temperature with the most recent one.
+// @Tags TemperatureReading
+// @Accept json
+// @Produce json
+// @Param id path int true "Section ID"
+// @Param readings body handler.TemperatureReadingsJSON true "Sensor readings"
+// @Success 201 {object} model.TemperatureIngestResult
+// @Failure 400 {object} model.ErrorResponseSwagger "Invalid ID"
+// @Failure 404 {object} model.ErrorResponseSwagger "Section not found"
+// @Failure 422 {object} model.ErrorResponseSwagger "Invalid input"
+// @Failure 500 {object} model.ErrorResponseSwagger "Unable to store temperature readings"
+// @Router /sections/{id}/temperatures [post]
+func (h *TemperatureReadingHandler) Ingest(w http.ResponseWriter, r *http.Request) {
+	h.log.Log("TemperatureReadingHandler", "INFO", "initializing Ingest function")
+
+	sectionID, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody("invalid id", nil))
+		h.log.Log("TemperatureReadingHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	var body TemperatureReadingsJSON
+
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+
+	if err = decoder.Decode(&body); err != nil {
+		response.JSON(w, http.StatusUnprocessableEntity, responses.CreateResponseBody("invalid request body", nil))
+		h.log.Log("TemperatureReadingHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	readings := make([]model.TemperatureReading, 0, len(body.Readings))
+	for _, reading := range body.Readings {
+		readings = append(readings, model.TemperatureReading{
+			SectionID:   sectionID,
+			SensorID:    reading.SensorID,
+			Temperature: reading.Temperature,
+			RecordedAt:  reading.RecordedAt,
+		})
+	}
+
+	result, err := h.Svc.Ingest(r.Context(), sectionID, readings)
+	if err != nil {
+		if err, ok := err.(*customerror.GenericError); ok {
+			response.JSON(w, err.Code, responses.CreateResponseBody(err.Error(), nil))
+			h.log.Log("TemperatureReadingHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+
+			return
+		}
+
+		response.JSON(w, http.StatusInternalServerError, responses.CreateResponseBody("unable to store temperature readings", nil))
+		h.log.Log("TemperatureReadingHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, responses.CreateResponseBody("", result))
+	h.log.Log("TemperatureReadingHandler", "INFO", fmt.Sprintf("%d temperature readings stored for section %d", result.Inserted, sectionID))
+}
+
+// GetAggregates returns the temperature statistics of a section per interval.
+// @Summary Section temperature history
+// @Description Returns the minimum, maximum and average temperature of a section per interval. The period defaults to the last 24 hours and the interval to one hour.
+// @Tags TemperatureReading
+// @Produce json
+// @Param id path int true "Section ID"
+// @Param from query string false "Period start (RFC3339)"
+// @Param to query string false "Period end, exclusive (RFC3339)"
+// @Param interval query string false "Aggregation interval (e.g. 15m, 1h, 24h)"
+// @Success 200 {object} model.TemperatureAggregateResponseSwagger
+// @Failure 400 {object} model.ErrorResponseSwagger "Invalid query parameters"
+// @Failure 404 {object} model.ErrorResponseSwagger "Section not found"
+// @Failure 422 {object} model.ErrorResponseSwagger "Invalid period or interval"
+// @Failure 500 {object} model.ErrorResponseSwagger "Unable to aggregate temperature readings"
+// @Router /sections/{id}/temperatures [get]
+func (h *TemperatureReadingHandler) GetAggregates(w http.ResponseWriter, r *http.Request) {
+	h.log.Log("TemperatureReadingHandler", "INFO", "initializing GetAggregates function")
+
+	sectionID, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody("invalid id", nil))
+		h.log.Log("TemperatureReadingHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	query := model.TemperatureQuery{SectionID: sectionID, To: time.Now(), Interval: model.DefaultTemperatureBucket}
+
+	if to := r.URL.Query().Get("to"); to != "" {
+		if query.To, err = time.Parse(time.RFC3339, to); err != nil {
+			response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody("invalid to param, expected RFC3339", nil))
+			h.log.Log("TemperatureReadingHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+
+			return
+		}
+	}
+
+	query.From = query.To.Add(-model.DefaultTemperatureRange)
+
+	if from := r.URL.Query().Get("from"); from != "" {
+		if query.From, err = time.Parse(time.RFC3339, from); err != nil {
+			response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody("invalid from param, expected RFC3339", nil))
+			h.log.Log("TemperatureReadingHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+
+			return
+		}
+	}
+
+	if interval := r.URL.Query().Get("interval"); interval != "" {
+		if query.Interval, err = time.ParseDuration(interval); err != nil {
+			response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody("invalid interval param", nil))
+			h.log.Log("TemperatureReadingHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+
+			return
+		}
+	}
+
+	aggregates, err := h.Svc.GetAggregates(r.Context(), query)
+	if err != nil {
+		if err, ok := err.(*customerror.GenericError); ok {
+			response.JSON(w, err.Code, responses.CreateResponseBody(err.Error(), nil))
+			h.log.Log("TemperatureReadingHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+
+			return
+		}
+
+		response.JSON(w, http.StatusInternalServerError, responses.CreateResponseBody("unable to aggregate temperature readings", nil))
+		h.log.Log("TemperatureReadingHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	if aggregates == nil {
+		aggregates = []model.TemperatureAggregate{}
+	}
+
+	response.JSON(w, http.StatusOK, responses.CreateResponseBody("", aggregates))
+	h.log.Log("TemperatureReadingHandler", "INFO", fmt.Sprintf("returning %d temperature aggregates for section %d", len(aggregates), sectionID))
+}
@@ -0,0 +1,127 @@
+package handler_test
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/maxwelbm/alkemy-g7.git/internal/handler"
+	"github.com/maxwelbm/alkemy-g7.git/internal/mocks"
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+	"github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func setupTemperatureReading(t *testing.T) (*mocks.MockITemperatureReadingService, *chi.Mux) {
+	mockSvc := mocks.NewMockITemperatureReadingService(t)
+	hd := handler.NewTemperatureReadingHandler(mockSvc, logMock)
+
+	r := chi.NewRouter()
+	r.Post("/api/v1/sections/{id}/temperatures", hd.Ingest)
+	r.Get("/api/v1/sections/{id}/temperatures", hd.GetAggregates)
+
+	return mockSvc, r
+}
+
+func TestTemperatureReadingHandler_Ingest(t *testing.T) {
+	recordedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	t.Run("store the readings of the section", func(t *testing.T) {
+		mockSvc, r := setupTemperatureReading(t)
+
+		mockSvc.On("Ingest", mock.Anything, 1, []model.TemperatureReading{
+			{SectionID: 1, SensorID: "A1", Temperature: -18.5, RecordedAt: recordedAt},
+		}).Return(model.TemperatureIngestResult{SectionID: 1, Inserted: 1}, nil)
+
+		body := `{"readings": [{"sensor_id": "A1", "temperature": -18.5, "recorded_at": "2025-01-01T10:00:00Z"}]}`
+		request := httptest.NewRequest(http.MethodPost, "/api/v1/sections/1/temperatures", bytes.NewReader([]byte(body)))
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusCreated, response.Code)
+		assert.JSONEq(t, `{"data": {"section_id": 1, "inserted": 1}}`, response.Body.String())
+	})
+
+	t.Run("return not found for an unknown section", func(t *testing.T) {
+		mockSvc, r := setupTemperatureReading(t)
+
+		mockSvc.On("Ingest", mock.Anything, 99, mock.Anything).Return(model.TemperatureIngestResult{}, customerror.HandleError("section", customerror.ErrorNotFound, ""))
+
+		body := `{"readings": [{"sensor_id": "A1", "temperature": -18.5, "recorded_at": "2025-01-01T10:00:00Z"}]}`
+		request := httptest.NewRequest(http.MethodPost, "/api/v1/sections/99/temperatures", bytes.NewReader([]byte(body)))
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusNotFound, response.Code)
+		assert.JSONEq(t, `{"message": "section not found"}`, response.Body.String())
+	})
+
+	t.Run("return unprocessable entity for an invalid body", func(t *testing.T) {
+		_, r := setupTemperatureReading(t)
+
+		request := httptest.NewRequest(http.MethodPost, "/api/v1/sections/1/temperatures", bytes.NewReader([]byte(`{"readings": [{"celsius": 4}]}`)))
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, response.Code)
+	})
+}
+
+func TestTemperatureReadingHandler_GetAggregates(t *testing.T) {
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(2 * time.Hour)
+
+	t.Run("return the aggregates for the requested period and interval", func(t *testing.T) {
+		mockSvc, r := setupTemperatureReading(t)
+
+		mockSvc.On("GetAggregates", mock.Anything, model.TemperatureQuery{SectionID: 1, From: from, To: to, Interval: 30 * time.Minute}).
+			Return([]model.TemperatureAggregate{{PeriodStart: from, MinTemperature: -19, MaxTemperature: -17, AvgTemperature: -18, ReadingsCount: 3}}, nil)
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/sections/1/temperatures?from=2025-01-01T00:00:00Z&to=2025-01-01T02:00:00Z&interval=30m", nil)
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusOK, response.Code)
+		assert.JSONEq(t, `{"data": [{"period_start": "2025-01-01T00:00:00Z", "min_temperature": -19, "max_temperature": -17, "avg_temperature": -18, "readings_count": 3}]}`, response.Body.String())
+	})
+
+	t.Run("return an empty list when there are no readings", func(t *testing.T) {
+		mockSvc, r := setupTemperatureReading(t)
+
+		mockSvc.On("GetAggregates", mock.Anything, mock.Anything).Return(nil, nil)
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/sections/1/temperatures", nil)
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusOK, response.Code)
+		assert.JSONEq(t, `{"data": []}`, response.Body.String())
+	})
+
+	t.Run("return bad request for an invalid interval", func(t *testing.T) {
+		_, r := setupTemperatureReading(t)
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/sections/1/temperatures?interval=hourly", nil)
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+	})
+
+	t.Run("return internal server error for an unexpected error", func(t *testing.T) {
+		mockSvc, r := setupTemperatureReading(t)
+
+		mockSvc.On("GetAggregates", mock.Anything, mock.Anything).Return(nil, errors.New("db down"))
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/sections/1/temperatures", nil)
+		response := httptest.NewRecorder()
+		r.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusInternalServerError, response.Code)
+	})
+}
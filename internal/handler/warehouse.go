@@ -1,10 +1,14 @@
 package handler
 
 import (
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"net/url"
 	"strconv"
+	"time"
 
 	"github.com/bootcamp-go/web/response"
 	"github.com/go-chi/chi/v5"
@@ -29,13 +33,25 @@ func NewWareHouseHandler(srv interfaces.IWarehouseService, log logger.Logger) *W
 // @Description Fetch all registered warehouses from the database
 // @Tags Warehouses
 // @Produce json
+// @Param page query int false "Page number, starting at 1"
+// @Param page_size query int false "Items per page (max 100)"
+// @Param sort query string false "Sort field, prefix with - for descending"
+// @Param warehouse_code query string false "Warehouse code"
 // @Success 200 {object} model.WareHousesResponseSwagger
+// @Failure 400 {object} model.ErrorResponseSwagger "Invalid pagination, sort or filter parameter"
 // @Failure 500 {object} model.ErrorResponseSwagger "Unable to search warehouse"
 // @Router /warehouses [get]
 func (h *WarehouseHandler) GetAllWareHouse() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		h.log.Log("WarehouseHandler", "INFO", "initializing GetAllWareHouse function")
-		wareHouse, err := h.Srv.GetAllWareHouse()
+		params, err := model.ParseListParams(r.URL.Query(), model.WareHouseListOptions)
+		if err != nil {
+			h.log.Log("WarehouseHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+			response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody(err.Error(), nil))
+			return
+		}
+
+		wareHouse, total, err := h.Srv.GetAllWareHouse(r.Context(), params)
 		if err != nil {
 			h.log.Log("WarehouseHandler", "ERROR", fmt.Sprintf("Error: %v", err))
 			response.JSON(w, http.StatusInternalServerError, responses.CreateResponseBody(err.Error(), nil))
@@ -43,10 +59,107 @@ func (h *WarehouseHandler) GetAllWareHouse() http.HandlerFunc {
 		}
 
 		h.log.Log("WarehouseHandler", "INFO", "GetAllWareHouse completed successfully")
-		response.JSON(w, http.StatusOK, responses.CreateResponseBody("", wareHouse))
+		response.JSON(w, http.StatusOK, responses.CreatePaginatedResponseBody("", wareHouse, params.Page, params.PageSize, total))
+	}
+}
+
+// GetNearestWareHouses lists the warehouses closest to a locality or to a pair of coordinates.
+// @Summary Retrieve the nearest warehouses
+// @Description Rank warehouses by great-circle distance, keeping only those with a section that can take the quantity of the product type
+// @Tags Warehouses
+// @Produce json
+// @Param locality_id query int false "Locality whose coordinates are used"
+// @Param lat query number false "Latitude, required with lng when locality_id is not given"
+// @Param lng query number false "Longitude, required with lat when locality_id is not given"
+// @Param product_type_id query int false "Product type the sections must store"
+// @Param quantity query int false "Free capacity required in a single section"
+// @Param limit query int false "Maximum number of warehouses (default 10, max 100)"
+// @Success 200 {object} model.NearestWareHousesResponseSwagger
+// @Failure 400 {object} model.ErrorResponseSwagger "Invalid query parameter"
+// @Failure 404 {object} model.ErrorResponseSwagger "Locality not found"
+// @Failure 422 {object} model.ErrorResponseSwagger "Locality has no coordinates"
+// @Failure 500 {object} model.ErrorResponseSwagger "Unable to search warehouse"
+// @Router /warehouses/nearest [get]
+func (h *WarehouseHandler) GetNearestWareHouses() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		h.log.Log("WarehouseHandler", "INFO", "initializing GetNearestWareHouses function")
+		params, err := parseNearestWareHouseParams(r.URL.Query())
+
+		if err != nil {
+			h.log.Log("WarehouseHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+			response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody(err.Error(), nil))
+			return
+		}
+
+		warehouses, err := h.Srv.GetNearestWareHouses(r.Context(), params)
+
+		if err != nil {
+			if err, ok := err.(*customerror.WareHouseError); ok {
+				h.log.Log("WarehouseHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+				response.JSON(w, err.Code, responses.CreateResponseBody(err.Error(), nil))
+				return
+			}
+
+			h.log.Log("WarehouseHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+			response.JSON(w, http.StatusInternalServerError, responses.CreateResponseBody("unable to search warehouse", nil))
+
+			return
+		}
+
+		if warehouses == nil {
+			warehouses = []model.NearestWareHouse{}
+		}
+
+		h.log.Log("WarehouseHandler", "INFO", "GetNearestWareHouses completed successfully")
+		response.JSON(w, http.StatusOK, responses.CreateResponseBody("", warehouses))
 	}
 }
 
+// parseNearestWareHouseParams reads the origin, either locality_id or lat and lng,
+// and the optional product_type_id, quantity and limit filters.
+func parseNearestWareHouseParams(q url.Values) (params model.NearestWareHouseParams, err error) {
+	keys := []string{"locality_id", "product_type_id", "quantity", "limit"}
+	ints := []*int{&params.LocalityID, &params.ProductTypeID, &params.Quantity, &params.Limit}
+
+	for i, key := range keys {
+		value := q.Get(key)
+		if value == "" {
+			continue
+		}
+
+		*ints[i], err = strconv.Atoi(value)
+		if err != nil || *ints[i] < 1 {
+			return params, fmt.Errorf("invalid %s: %s", key, value)
+		}
+	}
+
+	if params.Limit > model.MaxPageSize {
+		return params, fmt.Errorf("invalid limit: %d, must be between 1 and %d", params.Limit, model.MaxPageSize)
+	}
+
+	if params.LocalityID > 0 {
+		return params, nil
+	}
+
+	coordinates := []**float64{&params.Latitude, &params.Longitude}
+
+	for i, key := range []string{"lat", "lng"} {
+		value := q.Get(key)
+		if value == "" {
+			return params, fmt.Errorf("locality_id or lat and lng are required")
+		}
+
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return params, fmt.Errorf("invalid %s: %s", key, value)
+		}
+
+		*coordinates[i] = &f
+	}
+
+	return params, model.ValidateCoordinates(params.Latitude, params.Longitude)
+}
+
 func (h *WarehouseHandler) GetWareHouseByID() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		h.log.Log("WarehouseHandler", "INFO", "initializing GetWareHouseByID function")
@@ -58,7 +171,7 @@ func (h *WarehouseHandler) GetWareHouseByID() http.HandlerFunc {
 			return
 		}
 
-		warehouse, err := h.Srv.GetByIDWareHouse(id)
+		warehouse, err := h.Srv.GetByIDWareHouse(r.Context(), id)
 
 		if err != nil {
 			if err, ok := err.(*customerror.WareHouseError); ok {
@@ -77,6 +190,154 @@ func (h *WarehouseHandler) GetWareHouseByID() http.HandlerFunc {
 	}
 }
 
+// GetInventoryWareHouse summarizes the stock held in a warehouse.
+// @Summary Retrieve the inventory of a warehouse
+// @Description Per product total quantity, number of batches and nearest due date, with the breakdown by section. Use format=csv to download one row per product and section
+// @Tags Warehouses
+// @Produce json,text/csv
+// @Param id path int true "Warehouse ID"
+// @Param format query string false "Response format" Enums(json, csv)
+// @Success 200 {object} model.WareHouseInventoryResponseSwagger
+// @Failure 400 {object} model.ErrorResponseSwagger "Invalid ID or format"
+// @Failure 404 {object} model.ErrorResponseSwagger "Warehouse not found"
+// @Failure 500 {object} model.ErrorResponseSwagger "Unable to search warehouse inventory"
+// @Router /warehouses/{id}/inventory [get]
+func (h *WarehouseHandler) GetInventoryWareHouse() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		h.log.Log("WarehouseHandler", "INFO", "initializing GetInventoryWareHouse function")
+		id, err := strconv.Atoi(chi.URLParam(r, "id"))
+
+		if err != nil {
+			h.log.Log("WarehouseHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+			response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody("invalid id", nil))
+			return
+		}
+
+		format := r.URL.Query().Get("format")
+
+		if format != "" && format != "json" && format != "csv" {
+			h.log.Log("WarehouseHandler", "ERROR", fmt.Sprintf("Error: invalid format %s", format))
+			response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody(fmt.Sprintf("invalid format: %s, must be json or csv", format), nil))
+			return
+		}
+
+		inventory, err := h.Srv.GetInventoryWareHouse(r.Context(), id)
+
+		if err != nil {
+			if err, ok := err.(*customerror.WareHouseError); ok {
+				h.log.Log("WarehouseHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+				response.JSON(w, err.Code, responses.CreateResponseBody(err.Error(), nil))
+				return
+			}
+
+			h.log.Log("WarehouseHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+			response.JSON(w, http.StatusInternalServerError, responses.CreateResponseBody("unable to search warehouse inventory", nil))
+
+			return
+		}
+
+		h.log.Log("WarehouseHandler", "INFO", "GetInventoryWareHouse completed successfully")
+
+		if format == "csv" {
+			w.Header().Set("Content-Type", "text/csv")
+			w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"warehouse-%d-inventory.csv\"", inventory.WarehouseID))
+			w.WriteHeader(http.StatusOK)
+
+			if err := writeInventoryCSV(w, inventory); err != nil {
+				h.log.Log("WarehouseHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+			}
+
+			return
+		}
+
+		response.JSON(w, http.StatusOK, responses.CreateResponseBody("", inventory))
+	}
+}
+
+// writeInventoryCSV writes one record per product and section, leaving the due date empty when unknown.
+func writeInventoryCSV(w io.Writer, inventory model.WareHouseInventory) error {
+	writer := csv.NewWriter(w)
+
+	err := writer.Write([]string{"product_id", "product_code", "description", "section_id", "section_number", "quantity", "batches_count", "nearest_due_date"})
+	if err != nil {
+		return err
+	}
+
+	for _, p := range inventory.Products {
+		for _, s := range p.Sections {
+			dueDate := ""
+			if s.NearestDueDate != nil {
+				dueDate = s.NearestDueDate.Format(time.DateOnly)
+			}
+
+			err = writer.Write([]string{
+				strconv.Itoa(p.ProductID), p.ProductCode, p.Description,
+				strconv.Itoa(s.SectionID), s.SectionNumber,
+				strconv.Itoa(s.Quantity), strconv.Itoa(s.BatchesCount), dueDate,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	writer.Flush()
+
+	return writer.Error()
+}
+
+// GetCapacityReportWareHouse reports the section capacity of the warehouses.
+// @Summary Retrieve the warehouse capacity report
+// @Description Used vs. total section capacity per warehouse, flagging warehouses whose sections add up to less than their minimum capacity
+// @Tags Warehouses
+// @Produce json
+// @Param id query int false "Warehouse ID, every warehouse when omitted"
+// @Success 200 {object} model.WareHouseCapacityResponseSwagger
+// @Failure 400 {object} model.ErrorResponseSwagger "Invalid ID"
+// @Failure 404 {object} model.ErrorResponseSwagger "Warehouse not found"
+// @Failure 500 {object} model.ErrorResponseSwagger "Unable to generate the warehouse capacity report"
+// @Router /warehouses/reportCapacity [get]
+func (h *WarehouseHandler) GetCapacityReportWareHouse() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		h.log.Log("WarehouseHandler", "INFO", "initializing GetCapacityReportWareHouse function")
+
+		var id int
+
+		if idStr := r.URL.Query().Get("id"); idStr != "" {
+			var err error
+
+			id, err = strconv.Atoi(idStr)
+			if err != nil || id < 1 {
+				h.log.Log("WarehouseHandler", "ERROR", fmt.Sprintf("Error: invalid id %s", idStr))
+				response.JSON(w, http.StatusBadRequest, responses.CreateResponseBody("invalid id", nil))
+				return
+			}
+		}
+
+		report, err := h.Srv.GetCapacityReportWareHouse(r.Context(), id)
+
+		if err != nil {
+			if err, ok := err.(*customerror.WareHouseError); ok {
+				h.log.Log("WarehouseHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+				response.JSON(w, err.Code, responses.CreateResponseBody(err.Error(), nil))
+				return
+			}
+
+			h.log.Log("WarehouseHandler", "ERROR", fmt.Sprintf("Error: %v", err))
+			response.JSON(w, http.StatusInternalServerError, responses.CreateResponseBody("unable to generate the warehouse capacity report", nil))
+
+			return
+		}
+
+		if report == nil {
+			report = []model.WareHouseCapacity{}
+		}
+
+		h.log.Log("WarehouseHandler", "INFO", "GetCapacityReportWareHouse completed successfully")
+		response.JSON(w, http.StatusOK, responses.CreateResponseBody("", report))
+	}
+}
+
 // DeleteByIDWareHouse deletes a warehouse by its ID.
 // @Summary Delete a warehouse
 // @Description Delete a warehouse by its ID
@@ -98,7 +359,7 @@ func (h *WarehouseHandler) DeleteByIDWareHouse() http.HandlerFunc {
 			return
 		}
 
-		err = h.Srv.DeleteByIDWareHouse(id)
+		err = h.Srv.DeleteByIDWareHouse(r.Context(), id)
 
 		if err != nil {
 			if err, ok := err.(*customerror.WareHouseError); ok {
@@ -144,7 +405,7 @@ func (h *WarehouseHandler) PostWareHouse() http.HandlerFunc {
 			return
 		}
 
-		warehouse, err := h.Srv.PostWareHouse(reqBody)
+		warehouse, err := h.Srv.PostWareHouse(r.Context(), reqBody)
 
 		if err != nil {
 			if err, ok := err.(*customerror.WareHouseError); ok {
@@ -205,7 +466,7 @@ func (h *WarehouseHandler) UpdateWareHouse() http.HandlerFunc {
 			return
 		}
 
-		warehouse, err := h.Srv.UpdateWareHouse(id, reqBody)
+		warehouse, err := h.Srv.UpdateWareHouse(r.Context(), id, reqBody)
 
 		if err != nil {
 			if err, ok := err.(*customerror.WareHouseError); ok {
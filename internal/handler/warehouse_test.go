@@ -7,6 +7,7 @@ import (
 	"net/http/httptest"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/maxwelbm/alkemy-g7.git/internal/handler"
@@ -15,6 +16,7 @@ import (
 	"github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func setupWarehouse(t *testing.T) *handler.WarehouseHandler {
@@ -46,7 +48,7 @@ func TestHandlerGetAllWarehouse(t *testing.T) {
 		request := httptest.NewRequest(http.MethodGet, "/api/v1/warehouses", nil)
 		response := httptest.NewRecorder()
 		mockServiceWarehouse := hd.Srv.(*mocks.MockIWarehouseService)
-		mockServiceWarehouse.On("GetAllWareHouse").Return(expectedWarehouse, nil)
+		mockServiceWarehouse.On("GetAllWareHouse", mock.Anything, mock.Anything).Return(expectedWarehouse, 2, nil)
 
 		handler := hd.GetAllWareHouse()
 		handler.ServeHTTP(response, request)
@@ -71,7 +73,13 @@ func TestHandlerGetAllWarehouse(t *testing.T) {
 				"minimun_temperature": 1,
 				"address": "test"
 			}
-		]}`
+		],
+		"pagination": {
+			"page": 1,
+			"page_size": 20,
+			"total_items": 2,
+			"total_pages": 1
+		}}`
 
 		assert.Equal(t, http.StatusOK, response.Code)
 		assert.JSONEq(t, expectedJson, response.Body.String())
@@ -85,7 +93,7 @@ func TestHandlerGetAllWarehouse(t *testing.T) {
 		request := httptest.NewRequest(http.MethodGet, "/api/v1/warehouses", nil)
 		response := httptest.NewRecorder()
 		mockServiceWarehouse := hd.Srv.(*mocks.MockIWarehouseService)
-		mockServiceWarehouse.On("GetAllWareHouse").Return([]model.WareHouse{}, errors.New("not found warehouses"))
+		mockServiceWarehouse.On("GetAllWareHouse", mock.Anything, mock.Anything).Return([]model.WareHouse{}, 0, errors.New("not found warehouses"))
 
 		handler := hd.GetAllWareHouse()
 		handler.ServeHTTP(response, request)
@@ -116,7 +124,7 @@ func TestHandlerGetWarehouseById(t *testing.T) {
 			Address:            "test",
 		}
 
-		mockServiceWarehouse.On("GetByIDWareHouse", 1).Return(expectedWarehouse, nil)
+		mockServiceWarehouse.On("GetByIDWareHouse", mock.Anything, 1).Return(expectedWarehouse, nil)
 
 		request := httptest.NewRequest(http.MethodGet, "/api/v1/warehouses/"+strconv.Itoa(1), nil)
 
@@ -147,7 +155,7 @@ func TestHandlerGetWarehouseById(t *testing.T) {
 		r := chi.NewRouter()
 		r.Get("/api/v1/warehouses/{id}", hd.GetWareHouseByID())
 
-		mockServiceWarehouse.On("GetByIDWareHouse", 30).Return(model.WareHouse{}, customerror.NewWareHouseError(customerror.ErrNotFound.Error(), "warehouse", http.StatusNotFound))
+		mockServiceWarehouse.On("GetByIDWareHouse", mock.Anything, 30).Return(model.WareHouse{}, customerror.NewWareHouseError(customerror.ErrNotFound.Error(), "warehouse", http.StatusNotFound))
 
 		request := httptest.NewRequest(http.MethodGet, "/api/v1/warehouses/"+strconv.Itoa(30), nil)
 
@@ -187,7 +195,7 @@ func TestHandlerGetWarehouseById(t *testing.T) {
 		r := chi.NewRouter()
 		r.Get("/api/v1/warehouses/{id}", hd.GetWareHouseByID())
 
-		mockServiceWarehouse.On("GetByIDWareHouse", 2).Return(model.WareHouse{}, errors.New("internal server error"))
+		mockServiceWarehouse.On("GetByIDWareHouse", mock.Anything, 2).Return(model.WareHouse{}, errors.New("internal server error"))
 
 		request := httptest.NewRequest(http.MethodGet, "/api/v1/warehouses/"+strconv.Itoa(2), nil)
 
@@ -211,7 +219,7 @@ func TestHandlerDeleteByIdWarehouse(t *testing.T) {
 		r := chi.NewRouter()
 		r.Delete("/api/v1/warehouses/{id}", hd.DeleteByIDWareHouse())
 
-		mockServiceWarehouse.On("DeleteByIDWareHouse", 1).Return(nil)
+		mockServiceWarehouse.On("DeleteByIDWareHouse", mock.Anything, 1).Return(nil)
 
 		request := httptest.NewRequest(http.MethodDelete, "/api/v1/warehouses/"+strconv.Itoa(1), nil)
 
@@ -229,7 +237,7 @@ func TestHandlerDeleteByIdWarehouse(t *testing.T) {
 		r := chi.NewRouter()
 		r.Delete("/api/v1/warehouses/{id}", hd.DeleteByIDWareHouse())
 
-		mockServiceWarehouse.On("DeleteByIDWareHouse", 30).Return(customerror.NewWareHouseError(customerror.ErrNotFound.Error(), "warehouse", http.StatusNotFound))
+		mockServiceWarehouse.On("DeleteByIDWareHouse", mock.Anything, 30).Return(customerror.NewWareHouseError(customerror.ErrNotFound.Error(), "warehouse", http.StatusNotFound))
 
 		request := httptest.NewRequest(http.MethodDelete, "/api/v1/warehouses/"+strconv.Itoa(30), nil)
 
@@ -278,7 +286,7 @@ func TestHandlerPostWarehouse(t *testing.T) {
 			MinimunTemperature: 1,
 		}
 
-		mockServiceWarehouse.On("PostWareHouse", warehouse).Return(model.WareHouse{
+		mockServiceWarehouse.On("PostWareHouse", mock.Anything, warehouse).Return(model.WareHouse{
 			ID:                 1,
 			WareHouseCode:      "warehouse_code",
 			Address:            "address",
@@ -382,7 +390,7 @@ func TestHandlerPostWarehouse(t *testing.T) {
 			MinimunTemperature: 1,
 		}
 
-		mockServiceWarehouse.On("PostWareHouse", warehouse).Return(model.WareHouse{}, customerror.NewWareHouseError(customerror.ErrConflict.Error(), "warehouse_code", http.StatusConflict))
+		mockServiceWarehouse.On("PostWareHouse", mock.Anything, warehouse).Return(model.WareHouse{}, customerror.NewWareHouseError(customerror.ErrConflict.Error(), "warehouse_code", http.StatusConflict))
 
 		reqBody := []byte(`{
 			"warehouse_code": "warehouse_code",
@@ -420,7 +428,7 @@ func TestHandlerPostWarehouse(t *testing.T) {
 			MinimunTemperature: 1,
 		}
 
-		mockServiceWarehouse.On("PostWareHouse", warehouse).Return(model.WareHouse{}, errors.New("internal server error"))
+		mockServiceWarehouse.On("PostWareHouse", mock.Anything, warehouse).Return(model.WareHouse{}, errors.New("internal server error"))
 
 		reqBody := []byte(`{
 			"warehouse_code": "warehouse_code",
@@ -458,7 +466,7 @@ func TestHandlerUpdateWarehouse(t *testing.T) {
 			MinimunTemperature: 1,
 		}
 
-		mockServiceWarehouse.On("UpdateWareHouse", 1, model.WareHouse{
+		mockServiceWarehouse.On("UpdateWareHouse", mock.Anything, 1, model.WareHouse{
 			Address: "Update Address",
 		}).Return(warehouse, nil)
 
@@ -533,7 +541,7 @@ func TestHandlerUpdateWarehouse(t *testing.T) {
 		hd := setupWarehouse(t)
 		mockServiceWarehouse := hd.Srv.(*mocks.MockIWarehouseService)
 
-		mockServiceWarehouse.On("UpdateWareHouse", 1, model.WareHouse{
+		mockServiceWarehouse.On("UpdateWareHouse", mock.Anything, 1, model.WareHouse{
 			Address: "Update Address",
 		}).Return(model.WareHouse{}, errors.New("internal server error"))
 
@@ -556,3 +564,217 @@ func TestHandlerUpdateWarehouse(t *testing.T) {
 		mockServiceWarehouse.AssertExpectations(t)
 	})
 }
+
+func TestHandlerGetNearestWarehouses(t *testing.T) {
+	latitude, longitude := -31.42, -64.19
+
+	t.Run("GetNearestWarehouses from coordinates return sucess", func(t *testing.T) {
+		hd := setupWarehouse(t)
+		mockServiceWarehouse := hd.Srv.(*mocks.MockIWarehouseService)
+
+		mockServiceWarehouse.On("GetNearestWareHouses", mock.Anything, model.NearestWareHouseParams{
+			Latitude: &latitude, Longitude: &longitude, ProductTypeID: 2, Quantity: 10,
+		}).Return([]model.NearestWareHouse{{
+			WareHouse:         model.WareHouse{ID: 1, WareHouseCode: "test", Address: "test", Telephone: "test", MinimunCapacity: 1, MinimunTemperature: 1},
+			DistanceKm:        3.2,
+			AvailableCapacity: 40,
+		}}, nil)
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/warehouses/nearest?lat=-31.42&lng=-64.19&product_type_id=2&quantity=10", nil)
+		response := httptest.NewRecorder()
+
+		hd.GetNearestWareHouses().ServeHTTP(response, request)
+
+		expectedJson := `{"data":[{"id":1,"warehouse_code":"test","address":"test","telephone":"test","minimun_capacity":1,"minimun_temperature":1,"distance_km":3.2,"available_capacity":40}]}`
+
+		assert.Equal(t, http.StatusOK, response.Code)
+		assert.JSONEq(t, expectedJson, response.Body.String())
+		mockServiceWarehouse.AssertExpectations(t)
+	})
+
+	t.Run("GetNearestWarehouses from locality returns empty list", func(t *testing.T) {
+		hd := setupWarehouse(t)
+		mockServiceWarehouse := hd.Srv.(*mocks.MockIWarehouseService)
+
+		mockServiceWarehouse.On("GetNearestWareHouses", mock.Anything, model.NearestWareHouseParams{LocalityID: 3, Limit: 5}).Return(nil, nil)
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/warehouses/nearest?locality_id=3&limit=5", nil)
+		response := httptest.NewRecorder()
+
+		hd.GetNearestWareHouses().ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusOK, response.Code)
+		assert.JSONEq(t, `{"data":[]}`, response.Body.String())
+	})
+
+	t.Run("GetNearestWarehouses without origin", func(t *testing.T) {
+		hd := setupWarehouse(t)
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/warehouses/nearest?lat=-31.42", nil)
+		response := httptest.NewRecorder()
+
+		hd.GetNearestWareHouses().ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+		assert.JSONEq(t, `{"message":"locality_id or lat and lng are required"}`, response.Body.String())
+	})
+
+	t.Run("GetNearestWarehouses invalid quantity", func(t *testing.T) {
+		hd := setupWarehouse(t)
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/warehouses/nearest?locality_id=3&quantity=-1", nil)
+		response := httptest.NewRecorder()
+
+		hd.GetNearestWareHouses().ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+		assert.JSONEq(t, `{"message":"invalid quantity: -1"}`, response.Body.String())
+	})
+
+	t.Run("GetNearestWarehouses locality not found", func(t *testing.T) {
+		hd := setupWarehouse(t)
+		mockServiceWarehouse := hd.Srv.(*mocks.MockIWarehouseService)
+
+		mockServiceWarehouse.On("GetNearestWareHouses", mock.Anything, model.NearestWareHouseParams{LocalityID: 99}).
+			Return(nil, customerror.NewWareHouseError(customerror.ErrNotFound.Error(), "locality", http.StatusNotFound))
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/warehouses/nearest?locality_id=99", nil)
+		response := httptest.NewRecorder()
+
+		hd.GetNearestWareHouses().ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusNotFound, response.Code)
+		assert.JSONEq(t, `{"message":"locality not found"}`, response.Body.String())
+	})
+}
+
+func TestHandlerGetInventoryWarehouse(t *testing.T) {
+	dueDate := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+	inventory := model.WareHouseInventory{
+		WarehouseID:   1,
+		WarehouseCode: "WH001",
+		TotalQuantity: 120,
+		Products: []model.WareHouseInventoryProduct{{
+			ProductID: 1, ProductCode: "P001", Description: "Milk, whole", TotalQuantity: 120, BatchesCount: 2, NearestDueDate: &dueDate,
+			Sections: []model.WareHouseInventorySection{{SectionID: 3, SectionNumber: "S3", Quantity: 120, BatchesCount: 2, NearestDueDate: &dueDate}},
+		}},
+	}
+
+	setupRouter := func(hd *handler.WarehouseHandler) *chi.Mux {
+		r := chi.NewRouter()
+		r.Get("/api/v1/warehouses/{id}/inventory", hd.GetInventoryWareHouse())
+
+		return r
+	}
+
+	t.Run("GetInventoryWarehouse return sucess", func(t *testing.T) {
+		hd := setupWarehouse(t)
+		mockServiceWarehouse := hd.Srv.(*mocks.MockIWarehouseService)
+		mockServiceWarehouse.On("GetInventoryWareHouse", mock.Anything, 1).Return(inventory, nil)
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/warehouses/1/inventory", nil)
+		response := httptest.NewRecorder()
+
+		setupRouter(hd).ServeHTTP(response, request)
+
+		expectedJson := `{"data":{"warehouse_id":1,"warehouse_code":"WH001","total_quantity":120,"products":[{
+			"product_id":1,"product_code":"P001","description":"Milk, whole","total_quantity":120,"batches_count":2,"nearest_due_date":"2025-03-10T00:00:00Z",
+			"sections":[{"section_id":3,"section_number":"S3","quantity":120,"batches_count":2,"nearest_due_date":"2025-03-10T00:00:00Z"}]}]}}`
+
+		assert.Equal(t, http.StatusOK, response.Code)
+		assert.JSONEq(t, expectedJson, response.Body.String())
+	})
+
+	t.Run("GetInventoryWarehouse as csv", func(t *testing.T) {
+		hd := setupWarehouse(t)
+		mockServiceWarehouse := hd.Srv.(*mocks.MockIWarehouseService)
+		mockServiceWarehouse.On("GetInventoryWareHouse", mock.Anything, 1).Return(inventory, nil)
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/warehouses/1/inventory?format=csv", nil)
+		response := httptest.NewRecorder()
+
+		setupRouter(hd).ServeHTTP(response, request)
+
+		expectedCsv := "product_id,product_code,description,section_id,section_number,quantity,batches_count,nearest_due_date\n" +
+			"1,P001,\"Milk, whole\",3,S3,120,2,2025-03-10\n"
+
+		assert.Equal(t, http.StatusOK, response.Code)
+		assert.Equal(t, "text/csv", response.Header().Get("Content-Type"))
+		assert.Equal(t, `attachment; filename="warehouse-1-inventory.csv"`, response.Header().Get("Content-Disposition"))
+		assert.Equal(t, expectedCsv, response.Body.String())
+	})
+
+	t.Run("GetInventoryWarehouse invalid format", func(t *testing.T) {
+		hd := setupWarehouse(t)
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/warehouses/1/inventory?format=xml", nil)
+		response := httptest.NewRecorder()
+
+		setupRouter(hd).ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+		assert.JSONEq(t, `{"message":"invalid format: xml, must be json or csv"}`, response.Body.String())
+	})
+
+	t.Run("GetInventoryWarehouse not found", func(t *testing.T) {
+		hd := setupWarehouse(t)
+		mockServiceWarehouse := hd.Srv.(*mocks.MockIWarehouseService)
+		mockServiceWarehouse.On("GetInventoryWareHouse", mock.Anything, 99).
+			Return(model.WareHouseInventory{}, customerror.NewWareHouseError(customerror.ErrNotFound.Error(), "warehouse", http.StatusNotFound))
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/warehouses/99/inventory", nil)
+		response := httptest.NewRecorder()
+
+		setupRouter(hd).ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusNotFound, response.Code)
+		assert.JSONEq(t, `{"message":"warehouse not found"}`, response.Body.String())
+	})
+}
+
+func TestHandlerGetCapacityReportWarehouse(t *testing.T) {
+	t.Run("GetCapacityReportWarehouse return sucess", func(t *testing.T) {
+		hd := setupWarehouse(t)
+		mockServiceWarehouse := hd.Srv.(*mocks.MockIWarehouseService)
+		mockServiceWarehouse.On("GetCapacityReportWareHouse", mock.Anything, 1).Return([]model.WareHouseCapacity{{
+			WarehouseID: 1, WarehouseCode: "WH001", MinimumCapacity: 100, SectionsCount: 2, TotalCapacity: 200, UsedCapacity: 50, AvailableCapacity: 150, Utilization: 0.25,
+		}}, nil)
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/warehouses/reportCapacity?id=1", nil)
+		response := httptest.NewRecorder()
+
+		hd.GetCapacityReportWareHouse().ServeHTTP(response, request)
+
+		expectedJson := `{"data":[{"warehouse_id":1,"warehouse_code":"WH001","minimum_capacity":100,"sections_count":2,"total_capacity":200,
+			"used_capacity":50,"available_capacity":150,"utilization":0.25,"below_minimum_capacity":false}]}`
+
+		assert.Equal(t, http.StatusOK, response.Code)
+		assert.JSONEq(t, expectedJson, response.Body.String())
+	})
+
+	t.Run("GetCapacityReportWarehouse invalid id", func(t *testing.T) {
+		hd := setupWarehouse(t)
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/warehouses/reportCapacity?id=abc", nil)
+		response := httptest.NewRecorder()
+
+		hd.GetCapacityReportWareHouse().ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+		assert.JSONEq(t, `{"message":"invalid id"}`, response.Body.String())
+	})
+
+	t.Run("GetCapacityReportWarehouse internal server error", func(t *testing.T) {
+		hd := setupWarehouse(t)
+		mockServiceWarehouse := hd.Srv.(*mocks.MockIWarehouseService)
+		mockServiceWarehouse.On("GetCapacityReportWareHouse", mock.Anything, 0).Return(nil, errors.New("database error"))
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/warehouses/reportCapacity", nil)
+		response := httptest.NewRecorder()
+
+		hd.GetCapacityReportWareHouse().ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusInternalServerError, response.Code)
+		assert.JSONEq(t, `{"message":"unable to generate the warehouse capacity report"}`, response.Body.String())
+	})
+}
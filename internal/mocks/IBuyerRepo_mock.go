@@ -3,8 +3,14 @@
 package mocks
 
 import (
-	model "github.com/maxwelbm/alkemy-g7.git/internal/model"
+	context "context"
+
+	interfaces "github.com/maxwelbm/alkemy-g7.git/internal/repository/interfaces"
 	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/maxwelbm/alkemy-g7.git/internal/model"
+
+	sql "database/sql"
 )
 
 // MockIBuyerRepo is an autogenerated mock type for the IBuyerRepo type
@@ -12,9 +18,9 @@ type MockIBuyerRepo struct {
 	mock.Mock
 }
 
-// CountPurchaseOrderBuyers provides a mock function with no fields
-func (_m *MockIBuyerRepo) CountPurchaseOrderBuyers() ([]model.BuyerPurchaseOrder, error) {
-	ret := _m.Called()
+// CountPurchaseOrderBuyers provides a mock function with given fields: ctx
+func (_m *MockIBuyerRepo) CountPurchaseOrderBuyers(ctx context.Context) ([]model.BuyerPurchaseOrder, error) {
+	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CountPurchaseOrderBuyers")
@@ -22,19 +28,19 @@ func (_m *MockIBuyerRepo) CountPurchaseOrderBuyers() ([]model.BuyerPurchaseOrder
 
 	var r0 []model.BuyerPurchaseOrder
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]model.BuyerPurchaseOrder, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]model.BuyerPurchaseOrder, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []model.BuyerPurchaseOrder); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []model.BuyerPurchaseOrder); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.BuyerPurchaseOrder)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -42,9 +48,9 @@ func (_m *MockIBuyerRepo) CountPurchaseOrderBuyers() ([]model.BuyerPurchaseOrder
 	return r0, r1
 }
 
-// CountPurchaseOrderByBuyerID provides a mock function with given fields: id
-func (_m *MockIBuyerRepo) CountPurchaseOrderByBuyerID(id int) (model.BuyerPurchaseOrder, error) {
-	ret := _m.Called(id)
+// CountPurchaseOrderByBuyerID provides a mock function with given fields: ctx, id
+func (_m *MockIBuyerRepo) CountPurchaseOrderByBuyerID(ctx context.Context, id int) (model.BuyerPurchaseOrder, error) {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CountPurchaseOrderByBuyerID")
@@ -52,17 +58,17 @@ func (_m *MockIBuyerRepo) CountPurchaseOrderByBuyerID(id int) (model.BuyerPurcha
 
 	var r0 model.BuyerPurchaseOrder
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) (model.BuyerPurchaseOrder, error)); ok {
-		return rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) (model.BuyerPurchaseOrder, error)); ok {
+		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(int) model.BuyerPurchaseOrder); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) model.BuyerPurchaseOrder); ok {
+		r0 = rf(ctx, id)
 	} else {
 		r0 = ret.Get(0).(model.BuyerPurchaseOrder)
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -70,17 +76,17 @@ func (_m *MockIBuyerRepo) CountPurchaseOrderByBuyerID(id int) (model.BuyerPurcha
 	return r0, r1
 }
 
-// Delete provides a mock function with given fields: id
-func (_m *MockIBuyerRepo) Delete(id int) error {
-	ret := _m.Called(id)
+// Delete provides a mock function with given fields: ctx, id
+func (_m *MockIBuyerRepo) Delete(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Delete")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(int) error); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -88,39 +94,46 @@ func (_m *MockIBuyerRepo) Delete(id int) error {
 	return r0
 }
 
-// Get provides a mock function with no fields
-func (_m *MockIBuyerRepo) Get() ([]model.Buyer, error) {
-	ret := _m.Called()
+// Get provides a mock function with given fields: ctx, params
+func (_m *MockIBuyerRepo) Get(ctx context.Context, params model.ListParams) ([]model.Buyer, int, error) {
+	ret := _m.Called(ctx, params)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Get")
 	}
 
 	var r0 []model.Buyer
-	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]model.Buyer, error)); ok {
-		return rf()
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.ListParams) ([]model.Buyer, int, error)); ok {
+		return rf(ctx, params)
 	}
-	if rf, ok := ret.Get(0).(func() []model.Buyer); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context, model.ListParams) []model.Buyer); ok {
+		r0 = rf(ctx, params)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.Buyer)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context, model.ListParams) int); ok {
+		r1 = rf(ctx, params)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(int)
 	}
 
-	return r0, r1
+	if rf, ok := ret.Get(2).(func(context.Context, model.ListParams) error); ok {
+		r2 = rf(ctx, params)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
-// GetByID provides a mock function with given fields: id
-func (_m *MockIBuyerRepo) GetByID(id int) (model.Buyer, error) {
-	ret := _m.Called(id)
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *MockIBuyerRepo) GetByID(ctx context.Context, id int) (model.Buyer, error) {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetByID")
@@ -128,17 +141,17 @@ func (_m *MockIBuyerRepo) GetByID(id int) (model.Buyer, error) {
 
 	var r0 model.Buyer
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) (model.Buyer, error)); ok {
-		return rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) (model.Buyer, error)); ok {
+		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(int) model.Buyer); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) model.Buyer); ok {
+		r0 = rf(ctx, id)
 	} else {
 		r0 = ret.Get(0).(model.Buyer)
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -146,9 +159,9 @@ func (_m *MockIBuyerRepo) GetByID(id int) (model.Buyer, error) {
 	return r0, r1
 }
 
-// Post provides a mock function with given fields: newBuyer
-func (_m *MockIBuyerRepo) Post(newBuyer model.Buyer) (int64, error) {
-	ret := _m.Called(newBuyer)
+// Post provides a mock function with given fields: ctx, newBuyer
+func (_m *MockIBuyerRepo) Post(ctx context.Context, newBuyer model.Buyer) (int64, error) {
+	ret := _m.Called(ctx, newBuyer)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Post")
@@ -156,17 +169,17 @@ func (_m *MockIBuyerRepo) Post(newBuyer model.Buyer) (int64, error) {
 
 	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(model.Buyer) (int64, error)); ok {
-		return rf(newBuyer)
+	if rf, ok := ret.Get(0).(func(context.Context, model.Buyer) (int64, error)); ok {
+		return rf(ctx, newBuyer)
 	}
-	if rf, ok := ret.Get(0).(func(model.Buyer) int64); ok {
-		r0 = rf(newBuyer)
+	if rf, ok := ret.Get(0).(func(context.Context, model.Buyer) int64); ok {
+		r0 = rf(ctx, newBuyer)
 	} else {
 		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(model.Buyer) error); ok {
-		r1 = rf(newBuyer)
+	if rf, ok := ret.Get(1).(func(context.Context, model.Buyer) error); ok {
+		r1 = rf(ctx, newBuyer)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -174,17 +187,17 @@ func (_m *MockIBuyerRepo) Post(newBuyer model.Buyer) (int64, error) {
 	return r0, r1
 }
 
-// Update provides a mock function with given fields: id, newBuyer
-func (_m *MockIBuyerRepo) Update(id int, newBuyer model.Buyer) error {
-	ret := _m.Called(id, newBuyer)
+// Update provides a mock function with given fields: ctx, id, newBuyer
+func (_m *MockIBuyerRepo) Update(ctx context.Context, id int, newBuyer model.Buyer) error {
+	ret := _m.Called(ctx, id, newBuyer)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Update")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(int, model.Buyer) error); ok {
-		r0 = rf(id, newBuyer)
+	if rf, ok := ret.Get(0).(func(context.Context, int, model.Buyer) error); ok {
+		r0 = rf(ctx, id, newBuyer)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -192,6 +205,26 @@ func (_m *MockIBuyerRepo) Update(id int, newBuyer model.Buyer) error {
 	return r0
 }
 
+// WithTx provides a mock function with given fields: tx
+func (_m *MockIBuyerRepo) WithTx(tx *sql.Tx) interfaces.IBuyerRepo {
+	ret := _m.Called(tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithTx")
+	}
+
+	var r0 interfaces.IBuyerRepo
+	if rf, ok := ret.Get(0).(func(*sql.Tx) interfaces.IBuyerRepo); ok {
+		r0 = rf(tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interfaces.IBuyerRepo)
+		}
+	}
+
+	return r0
+}
+
 // NewMockIBuyerRepo creates a new instance of MockIBuyerRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockIBuyerRepo(t interface {
@@ -3,8 +3,11 @@
 package mocks
 
 import (
-	model "github.com/maxwelbm/alkemy-g7.git/internal/model"
+	context "context"
+
 	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/maxwelbm/alkemy-g7.git/internal/model"
 )
 
 // MockIBuyerservice is an autogenerated mock type for the IBuyerservice type
@@ -12,9 +15,9 @@ type MockIBuyerservice struct {
 	mock.Mock
 }
 
-// CountPurchaseOrderBuyer provides a mock function with no fields
-func (_m *MockIBuyerservice) CountPurchaseOrderBuyer() ([]model.BuyerPurchaseOrder, error) {
-	ret := _m.Called()
+// CountPurchaseOrderBuyer provides a mock function with given fields: ctx
+func (_m *MockIBuyerservice) CountPurchaseOrderBuyer(ctx context.Context) ([]model.BuyerPurchaseOrder, error) {
+	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CountPurchaseOrderBuyer")
@@ -22,19 +25,19 @@ func (_m *MockIBuyerservice) CountPurchaseOrderBuyer() ([]model.BuyerPurchaseOrd
 
 	var r0 []model.BuyerPurchaseOrder
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]model.BuyerPurchaseOrder, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]model.BuyerPurchaseOrder, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []model.BuyerPurchaseOrder); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []model.BuyerPurchaseOrder); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.BuyerPurchaseOrder)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -42,9 +45,9 @@ func (_m *MockIBuyerservice) CountPurchaseOrderBuyer() ([]model.BuyerPurchaseOrd
 	return r0, r1
 }
 
-// CountPurchaseOrderByBuyerID provides a mock function with given fields: id
-func (_m *MockIBuyerservice) CountPurchaseOrderByBuyerID(id int) (model.BuyerPurchaseOrder, error) {
-	ret := _m.Called(id)
+// CountPurchaseOrderByBuyerID provides a mock function with given fields: ctx, id
+func (_m *MockIBuyerservice) CountPurchaseOrderByBuyerID(ctx context.Context, id int) (model.BuyerPurchaseOrder, error) {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CountPurchaseOrderByBuyerID")
@@ -52,17 +55,17 @@ func (_m *MockIBuyerservice) CountPurchaseOrderByBuyerID(id int) (model.BuyerPur
 
 	var r0 model.BuyerPurchaseOrder
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) (model.BuyerPurchaseOrder, error)); ok {
-		return rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) (model.BuyerPurchaseOrder, error)); ok {
+		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(int) model.BuyerPurchaseOrder); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) model.BuyerPurchaseOrder); ok {
+		r0 = rf(ctx, id)
 	} else {
 		r0 = ret.Get(0).(model.BuyerPurchaseOrder)
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -70,9 +73,9 @@ func (_m *MockIBuyerservice) CountPurchaseOrderByBuyerID(id int) (model.BuyerPur
 	return r0, r1
 }
 
-// CreateBuyer provides a mock function with given fields: newBuyer
-func (_m *MockIBuyerservice) CreateBuyer(newBuyer model.Buyer) (model.Buyer, error) {
-	ret := _m.Called(newBuyer)
+// CreateBuyer provides a mock function with given fields: ctx, newBuyer
+func (_m *MockIBuyerservice) CreateBuyer(ctx context.Context, newBuyer model.Buyer) (model.Buyer, error) {
+	ret := _m.Called(ctx, newBuyer)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateBuyer")
@@ -80,17 +83,17 @@ func (_m *MockIBuyerservice) CreateBuyer(newBuyer model.Buyer) (model.Buyer, err
 
 	var r0 model.Buyer
 	var r1 error
-	if rf, ok := ret.Get(0).(func(model.Buyer) (model.Buyer, error)); ok {
-		return rf(newBuyer)
+	if rf, ok := ret.Get(0).(func(context.Context, model.Buyer) (model.Buyer, error)); ok {
+		return rf(ctx, newBuyer)
 	}
-	if rf, ok := ret.Get(0).(func(model.Buyer) model.Buyer); ok {
-		r0 = rf(newBuyer)
+	if rf, ok := ret.Get(0).(func(context.Context, model.Buyer) model.Buyer); ok {
+		r0 = rf(ctx, newBuyer)
 	} else {
 		r0 = ret.Get(0).(model.Buyer)
 	}
 
-	if rf, ok := ret.Get(1).(func(model.Buyer) error); ok {
-		r1 = rf(newBuyer)
+	if rf, ok := ret.Get(1).(func(context.Context, model.Buyer) error); ok {
+		r1 = rf(ctx, newBuyer)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -98,17 +101,17 @@ func (_m *MockIBuyerservice) CreateBuyer(newBuyer model.Buyer) (model.Buyer, err
 	return r0, r1
 }
 
-// DeleteBuyerByID provides a mock function with given fields: id
-func (_m *MockIBuyerservice) DeleteBuyerByID(id int) error {
-	ret := _m.Called(id)
+// DeleteBuyerByID provides a mock function with given fields: ctx, id
+func (_m *MockIBuyerservice) DeleteBuyerByID(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeleteBuyerByID")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(int) error); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -116,39 +119,46 @@ func (_m *MockIBuyerservice) DeleteBuyerByID(id int) error {
 	return r0
 }
 
-// GetAllBuyer provides a mock function with no fields
-func (_m *MockIBuyerservice) GetAllBuyer() ([]model.Buyer, error) {
-	ret := _m.Called()
+// GetAllBuyer provides a mock function with given fields: ctx, params
+func (_m *MockIBuyerservice) GetAllBuyer(ctx context.Context, params model.ListParams) ([]model.Buyer, int, error) {
+	ret := _m.Called(ctx, params)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetAllBuyer")
 	}
 
 	var r0 []model.Buyer
-	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]model.Buyer, error)); ok {
-		return rf()
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.ListParams) ([]model.Buyer, int, error)); ok {
+		return rf(ctx, params)
 	}
-	if rf, ok := ret.Get(0).(func() []model.Buyer); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context, model.ListParams) []model.Buyer); ok {
+		r0 = rf(ctx, params)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.Buyer)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context, model.ListParams) int); ok {
+		r1 = rf(ctx, params)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(int)
 	}
 
-	return r0, r1
+	if rf, ok := ret.Get(2).(func(context.Context, model.ListParams) error); ok {
+		r2 = rf(ctx, params)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
-// GetBuyerByID provides a mock function with given fields: id
-func (_m *MockIBuyerservice) GetBuyerByID(id int) (model.Buyer, error) {
-	ret := _m.Called(id)
+// GetBuyerByID provides a mock function with given fields: ctx, id
+func (_m *MockIBuyerservice) GetBuyerByID(ctx context.Context, id int) (model.Buyer, error) {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetBuyerByID")
@@ -156,17 +166,17 @@ func (_m *MockIBuyerservice) GetBuyerByID(id int) (model.Buyer, error) {
 
 	var r0 model.Buyer
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) (model.Buyer, error)); ok {
-		return rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) (model.Buyer, error)); ok {
+		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(int) model.Buyer); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) model.Buyer); ok {
+		r0 = rf(ctx, id)
 	} else {
 		r0 = ret.Get(0).(model.Buyer)
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -174,9 +184,9 @@ func (_m *MockIBuyerservice) GetBuyerByID(id int) (model.Buyer, error) {
 	return r0, r1
 }
 
-// UpdateBuyer provides a mock function with given fields: id, newBuyer
-func (_m *MockIBuyerservice) UpdateBuyer(id int, newBuyer model.Buyer) (model.Buyer, error) {
-	ret := _m.Called(id, newBuyer)
+// UpdateBuyer provides a mock function with given fields: ctx, id, newBuyer
+func (_m *MockIBuyerservice) UpdateBuyer(ctx context.Context, id int, newBuyer model.Buyer) (model.Buyer, error) {
+	ret := _m.Called(ctx, id, newBuyer)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateBuyer")
@@ -184,17 +194,17 @@ func (_m *MockIBuyerservice) UpdateBuyer(id int, newBuyer model.Buyer) (model.Bu
 
 	var r0 model.Buyer
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, model.Buyer) (model.Buyer, error)); ok {
-		return rf(id, newBuyer)
+	if rf, ok := ret.Get(0).(func(context.Context, int, model.Buyer) (model.Buyer, error)); ok {
+		return rf(ctx, id, newBuyer)
 	}
-	if rf, ok := ret.Get(0).(func(int, model.Buyer) model.Buyer); ok {
-		r0 = rf(id, newBuyer)
+	if rf, ok := ret.Get(0).(func(context.Context, int, model.Buyer) model.Buyer); ok {
+		r0 = rf(ctx, id, newBuyer)
 	} else {
 		r0 = ret.Get(0).(model.Buyer)
 	}
 
-	if rf, ok := ret.Get(1).(func(int, model.Buyer) error); ok {
-		r1 = rf(id, newBuyer)
+	if rf, ok := ret.Get(1).(func(context.Context, int, model.Buyer) error); ok {
+		r1 = rf(ctx, id, newBuyer)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3,8 +3,11 @@
 package mocks
 
 import (
-	model "github.com/maxwelbm/alkemy-g7.git/internal/model"
+	context "context"
+
 	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/maxwelbm/alkemy-g7.git/internal/model"
 )
 
 // MockICarrierService is an autogenerated mock type for the ICarrierService type
@@ -12,9 +15,64 @@ type MockICarrierService struct {
 	mock.Mock
 }
 
-// GetByID provides a mock function with given fields: id
-func (_m *MockICarrierService) GetByID(id int) (model.Carries, error) {
-	ret := _m.Called(id)
+// DeleteCarrier provides a mock function with given fields: ctx, id
+func (_m *MockICarrierService) DeleteCarrier(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCarrier")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Get provides a mock function with given fields: ctx, params
+func (_m *MockICarrierService) Get(ctx context.Context, params model.ListParams) ([]model.Carries, int, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 []model.Carries
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.ListParams) ([]model.Carries, int, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.ListParams) []model.Carries); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Carries)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.ListParams) int); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, model.ListParams) error); ok {
+		r2 = rf(ctx, params)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *MockICarrierService) GetByID(ctx context.Context, id int) (model.Carries, error) {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetByID")
@@ -22,17 +80,45 @@ func (_m *MockICarrierService) GetByID(id int) (model.Carries, error) {
 
 	var r0 model.Carries
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) (model.Carries, error)); ok {
-		return rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) (model.Carries, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) model.Carries); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(model.Carries)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PatchCarrier provides a mock function with given fields: ctx, id, carrier
+func (_m *MockICarrierService) PatchCarrier(ctx context.Context, id int, carrier model.Carries) (model.Carries, error) {
+	ret := _m.Called(ctx, id, carrier)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PatchCarrier")
+	}
+
+	var r0 model.Carries
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, model.Carries) (model.Carries, error)); ok {
+		return rf(ctx, id, carrier)
 	}
-	if rf, ok := ret.Get(0).(func(int) model.Carries); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int, model.Carries) model.Carries); ok {
+		r0 = rf(ctx, id, carrier)
 	} else {
 		r0 = ret.Get(0).(model.Carries)
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(context.Context, int, model.Carries) error); ok {
+		r1 = rf(ctx, id, carrier)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -40,9 +126,9 @@ func (_m *MockICarrierService) GetByID(id int) (model.Carries, error) {
 	return r0, r1
 }
 
-// PostCarrier provides a mock function with given fields: newCarrier
-func (_m *MockICarrierService) PostCarrier(newCarrier model.Carries) (model.Carries, error) {
-	ret := _m.Called(newCarrier)
+// PostCarrier provides a mock function with given fields: ctx, newCarrier
+func (_m *MockICarrierService) PostCarrier(ctx context.Context, newCarrier model.Carries) (model.Carries, error) {
+	ret := _m.Called(ctx, newCarrier)
 
 	if len(ret) == 0 {
 		panic("no return value specified for PostCarrier")
@@ -50,17 +136,17 @@ func (_m *MockICarrierService) PostCarrier(newCarrier model.Carries) (model.Carr
 
 	var r0 model.Carries
 	var r1 error
-	if rf, ok := ret.Get(0).(func(model.Carries) (model.Carries, error)); ok {
-		return rf(newCarrier)
+	if rf, ok := ret.Get(0).(func(context.Context, model.Carries) (model.Carries, error)); ok {
+		return rf(ctx, newCarrier)
 	}
-	if rf, ok := ret.Get(0).(func(model.Carries) model.Carries); ok {
-		r0 = rf(newCarrier)
+	if rf, ok := ret.Get(0).(func(context.Context, model.Carries) model.Carries); ok {
+		r0 = rf(ctx, newCarrier)
 	} else {
 		r0 = ret.Get(0).(model.Carries)
 	}
 
-	if rf, ok := ret.Get(1).(func(model.Carries) error); ok {
-		r1 = rf(newCarrier)
+	if rf, ok := ret.Get(1).(func(context.Context, model.Carries) error); ok {
+		r1 = rf(ctx, newCarrier)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3,8 +3,14 @@
 package mocks
 
 import (
-	model "github.com/maxwelbm/alkemy-g7.git/internal/model"
+	context "context"
+
+	interfaces "github.com/maxwelbm/alkemy-g7.git/internal/repository/interfaces"
 	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/maxwelbm/alkemy-g7.git/internal/model"
+
+	sql "database/sql"
 )
 
 // MockICarriersRepo is an autogenerated mock type for the ICarriersRepo type
@@ -12,9 +18,64 @@ type MockICarriersRepo struct {
 	mock.Mock
 }
 
-// GetByID provides a mock function with given fields: id
-func (_m *MockICarriersRepo) GetByID(id int) (model.Carries, error) {
-	ret := _m.Called(id)
+// DeleteCarrier provides a mock function with given fields: ctx, id
+func (_m *MockICarriersRepo) DeleteCarrier(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCarrier")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Get provides a mock function with given fields: ctx, params
+func (_m *MockICarriersRepo) Get(ctx context.Context, params model.ListParams) ([]model.Carries, int, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 []model.Carries
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.ListParams) ([]model.Carries, int, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.ListParams) []model.Carries); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Carries)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.ListParams) int); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, model.ListParams) error); ok {
+		r2 = rf(ctx, params)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *MockICarriersRepo) GetByID(ctx context.Context, id int) (model.Carries, error) {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetByID")
@@ -22,17 +83,17 @@ func (_m *MockICarriersRepo) GetByID(id int) (model.Carries, error) {
 
 	var r0 model.Carries
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) (model.Carries, error)); ok {
-		return rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) (model.Carries, error)); ok {
+		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(int) model.Carries); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) model.Carries); ok {
+		r0 = rf(ctx, id)
 	} else {
 		r0 = ret.Get(0).(model.Carries)
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -40,9 +101,27 @@ func (_m *MockICarriersRepo) GetByID(id int) (model.Carries, error) {
 	return r0, r1
 }
 
-// PostCarrier provides a mock function with given fields: newCarrier
-func (_m *MockICarriersRepo) PostCarrier(newCarrier model.Carries) (int64, error) {
-	ret := _m.Called(newCarrier)
+// PatchCarrier provides a mock function with given fields: ctx, id, carrier
+func (_m *MockICarriersRepo) PatchCarrier(ctx context.Context, id int, carrier model.Carries) error {
+	ret := _m.Called(ctx, id, carrier)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PatchCarrier")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, model.Carries) error); ok {
+		r0 = rf(ctx, id, carrier)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PostCarrier provides a mock function with given fields: ctx, newCarrier
+func (_m *MockICarriersRepo) PostCarrier(ctx context.Context, newCarrier model.Carries) (int64, error) {
+	ret := _m.Called(ctx, newCarrier)
 
 	if len(ret) == 0 {
 		panic("no return value specified for PostCarrier")
@@ -50,17 +129,17 @@ func (_m *MockICarriersRepo) PostCarrier(newCarrier model.Carries) (int64, error
 
 	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(model.Carries) (int64, error)); ok {
-		return rf(newCarrier)
+	if rf, ok := ret.Get(0).(func(context.Context, model.Carries) (int64, error)); ok {
+		return rf(ctx, newCarrier)
 	}
-	if rf, ok := ret.Get(0).(func(model.Carries) int64); ok {
-		r0 = rf(newCarrier)
+	if rf, ok := ret.Get(0).(func(context.Context, model.Carries) int64); ok {
+		r0 = rf(ctx, newCarrier)
 	} else {
 		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(model.Carries) error); ok {
-		r1 = rf(newCarrier)
+	if rf, ok := ret.Get(1).(func(context.Context, model.Carries) error); ok {
+		r1 = rf(ctx, newCarrier)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -68,6 +147,26 @@ func (_m *MockICarriersRepo) PostCarrier(newCarrier model.Carries) (int64, error
 	return r0, r1
 }
 
+// WithTx provides a mock function with given fields: tx
+func (_m *MockICarriersRepo) WithTx(tx *sql.Tx) interfaces.ICarriersRepo {
+	ret := _m.Called(tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithTx")
+	}
+
+	var r0 interfaces.ICarriersRepo
+	if rf, ok := ret.Get(0).(func(*sql.Tx) interfaces.ICarriersRepo); ok {
+		r0 = rf(tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interfaces.ICarriersRepo)
+		}
+	}
+
+	return r0
+}
+
 // NewMockICarriersRepo creates a new instance of MockICarriersRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockICarriersRepo(t interface {
@@ -3,8 +3,14 @@
 package mocks
 
 import (
-	model "github.com/maxwelbm/alkemy-g7.git/internal/model"
+	context "context"
+
+	interfaces "github.com/maxwelbm/alkemy-g7.git/internal/repository/interfaces"
 	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/maxwelbm/alkemy-g7.git/internal/model"
+
+	sql "database/sql"
 )
 
 // MockIEmployeeRepo is an autogenerated mock type for the IEmployeeRepo type
@@ -12,17 +18,17 @@ type MockIEmployeeRepo struct {
 	mock.Mock
 }
 
-// Delete provides a mock function with given fields: id
-func (_m *MockIEmployeeRepo) Delete(id int) error {
-	ret := _m.Called(id)
+// Delete provides a mock function with given fields: ctx, id
+func (_m *MockIEmployeeRepo) Delete(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Delete")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(int) error); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -30,39 +36,46 @@ func (_m *MockIEmployeeRepo) Delete(id int) error {
 	return r0
 }
 
-// Get provides a mock function with no fields
-func (_m *MockIEmployeeRepo) Get() ([]model.Employee, error) {
-	ret := _m.Called()
+// Get provides a mock function with given fields: ctx, params
+func (_m *MockIEmployeeRepo) Get(ctx context.Context, params model.ListParams) ([]model.Employee, int, error) {
+	ret := _m.Called(ctx, params)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Get")
 	}
 
 	var r0 []model.Employee
-	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]model.Employee, error)); ok {
-		return rf()
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.ListParams) ([]model.Employee, int, error)); ok {
+		return rf(ctx, params)
 	}
-	if rf, ok := ret.Get(0).(func() []model.Employee); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context, model.ListParams) []model.Employee); ok {
+		r0 = rf(ctx, params)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.Employee)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context, model.ListParams) int); ok {
+		r1 = rf(ctx, params)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(int)
 	}
 
-	return r0, r1
+	if rf, ok := ret.Get(2).(func(context.Context, model.ListParams) error); ok {
+		r2 = rf(ctx, params)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
-// GetByID provides a mock function with given fields: id
-func (_m *MockIEmployeeRepo) GetByID(id int) (model.Employee, error) {
-	ret := _m.Called(id)
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *MockIEmployeeRepo) GetByID(ctx context.Context, id int) (model.Employee, error) {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetByID")
@@ -70,17 +83,17 @@ func (_m *MockIEmployeeRepo) GetByID(id int) (model.Employee, error) {
 
 	var r0 model.Employee
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) (model.Employee, error)); ok {
-		return rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) (model.Employee, error)); ok {
+		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(int) model.Employee); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) model.Employee); ok {
+		r0 = rf(ctx, id)
 	} else {
 		r0 = ret.Get(0).(model.Employee)
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -88,9 +101,9 @@ func (_m *MockIEmployeeRepo) GetByID(id int) (model.Employee, error) {
 	return r0, r1
 }
 
-// GetInboundOrdersReportByEmployee provides a mock function with given fields: employeeID
-func (_m *MockIEmployeeRepo) GetInboundOrdersReportByEmployee(employeeID int) (model.InboundOrdersReportByEmployee, error) {
-	ret := _m.Called(employeeID)
+// GetInboundOrdersReportByEmployee provides a mock function with given fields: ctx, employeeID
+func (_m *MockIEmployeeRepo) GetInboundOrdersReportByEmployee(ctx context.Context, employeeID int) (model.InboundOrdersReportByEmployee, error) {
+	ret := _m.Called(ctx, employeeID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetInboundOrdersReportByEmployee")
@@ -98,17 +111,17 @@ func (_m *MockIEmployeeRepo) GetInboundOrdersReportByEmployee(employeeID int) (m
 
 	var r0 model.InboundOrdersReportByEmployee
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) (model.InboundOrdersReportByEmployee, error)); ok {
-		return rf(employeeID)
+	if rf, ok := ret.Get(0).(func(context.Context, int) (model.InboundOrdersReportByEmployee, error)); ok {
+		return rf(ctx, employeeID)
 	}
-	if rf, ok := ret.Get(0).(func(int) model.InboundOrdersReportByEmployee); ok {
-		r0 = rf(employeeID)
+	if rf, ok := ret.Get(0).(func(context.Context, int) model.InboundOrdersReportByEmployee); ok {
+		r0 = rf(ctx, employeeID)
 	} else {
 		r0 = ret.Get(0).(model.InboundOrdersReportByEmployee)
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(employeeID)
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, employeeID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -116,9 +129,9 @@ func (_m *MockIEmployeeRepo) GetInboundOrdersReportByEmployee(employeeID int) (m
 	return r0, r1
 }
 
-// GetInboundOrdersReports provides a mock function with no fields
-func (_m *MockIEmployeeRepo) GetInboundOrdersReports() ([]model.InboundOrdersReportByEmployee, error) {
-	ret := _m.Called()
+// GetInboundOrdersReports provides a mock function with given fields: ctx
+func (_m *MockIEmployeeRepo) GetInboundOrdersReports(ctx context.Context) ([]model.InboundOrdersReportByEmployee, error) {
+	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetInboundOrdersReports")
@@ -126,19 +139,19 @@ func (_m *MockIEmployeeRepo) GetInboundOrdersReports() ([]model.InboundOrdersRep
 
 	var r0 []model.InboundOrdersReportByEmployee
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]model.InboundOrdersReportByEmployee, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]model.InboundOrdersReportByEmployee, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []model.InboundOrdersReportByEmployee); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []model.InboundOrdersReportByEmployee); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.InboundOrdersReportByEmployee)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -146,9 +159,9 @@ func (_m *MockIEmployeeRepo) GetInboundOrdersReports() ([]model.InboundOrdersRep
 	return r0, r1
 }
 
-// Post provides a mock function with given fields: employee
-func (_m *MockIEmployeeRepo) Post(employee model.Employee) (model.Employee, error) {
-	ret := _m.Called(employee)
+// Post provides a mock function with given fields: ctx, employee
+func (_m *MockIEmployeeRepo) Post(ctx context.Context, employee model.Employee) (model.Employee, error) {
+	ret := _m.Called(ctx, employee)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Post")
@@ -156,17 +169,17 @@ func (_m *MockIEmployeeRepo) Post(employee model.Employee) (model.Employee, erro
 
 	var r0 model.Employee
 	var r1 error
-	if rf, ok := ret.Get(0).(func(model.Employee) (model.Employee, error)); ok {
-		return rf(employee)
+	if rf, ok := ret.Get(0).(func(context.Context, model.Employee) (model.Employee, error)); ok {
+		return rf(ctx, employee)
 	}
-	if rf, ok := ret.Get(0).(func(model.Employee) model.Employee); ok {
-		r0 = rf(employee)
+	if rf, ok := ret.Get(0).(func(context.Context, model.Employee) model.Employee); ok {
+		r0 = rf(ctx, employee)
 	} else {
 		r0 = ret.Get(0).(model.Employee)
 	}
 
-	if rf, ok := ret.Get(1).(func(model.Employee) error); ok {
-		r1 = rf(employee)
+	if rf, ok := ret.Get(1).(func(context.Context, model.Employee) error); ok {
+		r1 = rf(ctx, employee)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -174,9 +187,9 @@ func (_m *MockIEmployeeRepo) Post(employee model.Employee) (model.Employee, erro
 	return r0, r1
 }
 
-// Update provides a mock function with given fields: id, employee
-func (_m *MockIEmployeeRepo) Update(id int, employee model.Employee) (model.Employee, error) {
-	ret := _m.Called(id, employee)
+// Update provides a mock function with given fields: ctx, id, employee
+func (_m *MockIEmployeeRepo) Update(ctx context.Context, id int, employee model.Employee) (model.Employee, error) {
+	ret := _m.Called(ctx, id, employee)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Update")
@@ -184,17 +197,17 @@ func (_m *MockIEmployeeRepo) Update(id int, employee model.Employee) (model.Empl
 
 	var r0 model.Employee
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, model.Employee) (model.Employee, error)); ok {
-		return rf(id, employee)
+	if rf, ok := ret.Get(0).(func(context.Context, int, model.Employee) (model.Employee, error)); ok {
+		return rf(ctx, id, employee)
 	}
-	if rf, ok := ret.Get(0).(func(int, model.Employee) model.Employee); ok {
-		r0 = rf(id, employee)
+	if rf, ok := ret.Get(0).(func(context.Context, int, model.Employee) model.Employee); ok {
+		r0 = rf(ctx, id, employee)
 	} else {
 		r0 = ret.Get(0).(model.Employee)
 	}
 
-	if rf, ok := ret.Get(1).(func(int, model.Employee) error); ok {
-		r1 = rf(id, employee)
+	if rf, ok := ret.Get(1).(func(context.Context, int, model.Employee) error); ok {
+		r1 = rf(ctx, id, employee)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -202,6 +215,26 @@ func (_m *MockIEmployeeRepo) Update(id int, employee model.Employee) (model.Empl
 	return r0, r1
 }
 
+// WithTx provides a mock function with given fields: tx
+func (_m *MockIEmployeeRepo) WithTx(tx *sql.Tx) interfaces.IEmployeeRepo {
+	ret := _m.Called(tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithTx")
+	}
+
+	var r0 interfaces.IEmployeeRepo
+	if rf, ok := ret.Get(0).(func(*sql.Tx) interfaces.IEmployeeRepo); ok {
+		r0 = rf(tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interfaces.IEmployeeRepo)
+		}
+	}
+
+	return r0
+}
+
 // NewMockIEmployeeRepo creates a new instance of MockIEmployeeRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockIEmployeeRepo(t interface {
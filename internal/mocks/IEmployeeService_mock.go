@@ -3,8 +3,11 @@
 package mocks
 
 import (
-	model "github.com/maxwelbm/alkemy-g7.git/internal/model"
+	context "context"
+
 	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/maxwelbm/alkemy-g7.git/internal/model"
 )
 
 // MockIEmployeeService is an autogenerated mock type for the IEmployeeService type
@@ -12,17 +15,17 @@ type MockIEmployeeService struct {
 	mock.Mock
 }
 
-// DeleteEmployee provides a mock function with given fields: id
-func (_m *MockIEmployeeService) DeleteEmployee(id int) error {
-	ret := _m.Called(id)
+// DeleteEmployee provides a mock function with given fields: ctx, id
+func (_m *MockIEmployeeService) DeleteEmployee(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeleteEmployee")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(int) error); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -30,9 +33,9 @@ func (_m *MockIEmployeeService) DeleteEmployee(id int) error {
 	return r0
 }
 
-// GetEmployeeByID provides a mock function with given fields: id
-func (_m *MockIEmployeeService) GetEmployeeByID(id int) (model.Employee, error) {
-	ret := _m.Called(id)
+// GetEmployeeByID provides a mock function with given fields: ctx, id
+func (_m *MockIEmployeeService) GetEmployeeByID(ctx context.Context, id int) (model.Employee, error) {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetEmployeeByID")
@@ -40,17 +43,17 @@ func (_m *MockIEmployeeService) GetEmployeeByID(id int) (model.Employee, error)
 
 	var r0 model.Employee
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) (model.Employee, error)); ok {
-		return rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) (model.Employee, error)); ok {
+		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(int) model.Employee); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) model.Employee); ok {
+		r0 = rf(ctx, id)
 	} else {
 		r0 = ret.Get(0).(model.Employee)
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -58,39 +61,46 @@ func (_m *MockIEmployeeService) GetEmployeeByID(id int) (model.Employee, error)
 	return r0, r1
 }
 
-// GetEmployees provides a mock function with no fields
-func (_m *MockIEmployeeService) GetEmployees() ([]model.Employee, error) {
-	ret := _m.Called()
+// GetEmployees provides a mock function with given fields: ctx, params
+func (_m *MockIEmployeeService) GetEmployees(ctx context.Context, params model.ListParams) ([]model.Employee, int, error) {
+	ret := _m.Called(ctx, params)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetEmployees")
 	}
 
 	var r0 []model.Employee
-	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]model.Employee, error)); ok {
-		return rf()
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.ListParams) ([]model.Employee, int, error)); ok {
+		return rf(ctx, params)
 	}
-	if rf, ok := ret.Get(0).(func() []model.Employee); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context, model.ListParams) []model.Employee); ok {
+		r0 = rf(ctx, params)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.Employee)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context, model.ListParams) int); ok {
+		r1 = rf(ctx, params)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(int)
 	}
 
-	return r0, r1
+	if rf, ok := ret.Get(2).(func(context.Context, model.ListParams) error); ok {
+		r2 = rf(ctx, params)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
-// GetInboundOrdersReportByEmployee provides a mock function with given fields: employeeID
-func (_m *MockIEmployeeService) GetInboundOrdersReportByEmployee(employeeID int) (model.InboundOrdersReportByEmployee, error) {
-	ret := _m.Called(employeeID)
+// GetInboundOrdersReportByEmployee provides a mock function with given fields: ctx, employeeID
+func (_m *MockIEmployeeService) GetInboundOrdersReportByEmployee(ctx context.Context, employeeID int) (model.InboundOrdersReportByEmployee, error) {
+	ret := _m.Called(ctx, employeeID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetInboundOrdersReportByEmployee")
@@ -98,17 +108,17 @@ func (_m *MockIEmployeeService) GetInboundOrdersReportByEmployee(employeeID int)
 
 	var r0 model.InboundOrdersReportByEmployee
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) (model.InboundOrdersReportByEmployee, error)); ok {
-		return rf(employeeID)
+	if rf, ok := ret.Get(0).(func(context.Context, int) (model.InboundOrdersReportByEmployee, error)); ok {
+		return rf(ctx, employeeID)
 	}
-	if rf, ok := ret.Get(0).(func(int) model.InboundOrdersReportByEmployee); ok {
-		r0 = rf(employeeID)
+	if rf, ok := ret.Get(0).(func(context.Context, int) model.InboundOrdersReportByEmployee); ok {
+		r0 = rf(ctx, employeeID)
 	} else {
 		r0 = ret.Get(0).(model.InboundOrdersReportByEmployee)
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(employeeID)
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, employeeID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -116,9 +126,9 @@ func (_m *MockIEmployeeService) GetInboundOrdersReportByEmployee(employeeID int)
 	return r0, r1
 }
 
-// GetInboundOrdersReports provides a mock function with no fields
-func (_m *MockIEmployeeService) GetInboundOrdersReports() ([]model.InboundOrdersReportByEmployee, error) {
-	ret := _m.Called()
+// GetInboundOrdersReports provides a mock function with given fields: ctx
+func (_m *MockIEmployeeService) GetInboundOrdersReports(ctx context.Context) ([]model.InboundOrdersReportByEmployee, error) {
+	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetInboundOrdersReports")
@@ -126,19 +136,19 @@ func (_m *MockIEmployeeService) GetInboundOrdersReports() ([]model.InboundOrders
 
 	var r0 []model.InboundOrdersReportByEmployee
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]model.InboundOrdersReportByEmployee, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]model.InboundOrdersReportByEmployee, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []model.InboundOrdersReportByEmployee); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []model.InboundOrdersReportByEmployee); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.InboundOrdersReportByEmployee)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -146,9 +156,9 @@ func (_m *MockIEmployeeService) GetInboundOrdersReports() ([]model.InboundOrders
 	return r0, r1
 }
 
-// InsertEmployee provides a mock function with given fields: employee
-func (_m *MockIEmployeeService) InsertEmployee(employee model.Employee) (model.Employee, error) {
-	ret := _m.Called(employee)
+// InsertEmployee provides a mock function with given fields: ctx, employee
+func (_m *MockIEmployeeService) InsertEmployee(ctx context.Context, employee model.Employee) (model.Employee, error) {
+	ret := _m.Called(ctx, employee)
 
 	if len(ret) == 0 {
 		panic("no return value specified for InsertEmployee")
@@ -156,17 +166,17 @@ func (_m *MockIEmployeeService) InsertEmployee(employee model.Employee) (model.E
 
 	var r0 model.Employee
 	var r1 error
-	if rf, ok := ret.Get(0).(func(model.Employee) (model.Employee, error)); ok {
-		return rf(employee)
+	if rf, ok := ret.Get(0).(func(context.Context, model.Employee) (model.Employee, error)); ok {
+		return rf(ctx, employee)
 	}
-	if rf, ok := ret.Get(0).(func(model.Employee) model.Employee); ok {
-		r0 = rf(employee)
+	if rf, ok := ret.Get(0).(func(context.Context, model.Employee) model.Employee); ok {
+		r0 = rf(ctx, employee)
 	} else {
 		r0 = ret.Get(0).(model.Employee)
 	}
 
-	if rf, ok := ret.Get(1).(func(model.Employee) error); ok {
-		r1 = rf(employee)
+	if rf, ok := ret.Get(1).(func(context.Context, model.Employee) error); ok {
+		r1 = rf(ctx, employee)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -174,9 +184,9 @@ func (_m *MockIEmployeeService) InsertEmployee(employee model.Employee) (model.E
 	return r0, r1
 }
 
-// UpdateEmployee provides a mock function with given fields: id, employee
-func (_m *MockIEmployeeService) UpdateEmployee(id int, employee model.Employee) (model.Employee, error) {
-	ret := _m.Called(id, employee)
+// UpdateEmployee provides a mock function with given fields: ctx, id, employee
+func (_m *MockIEmployeeService) UpdateEmployee(ctx context.Context, id int, employee model.Employee) (model.Employee, error) {
+	ret := _m.Called(ctx, id, employee)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateEmployee")
@@ -184,17 +194,17 @@ func (_m *MockIEmployeeService) UpdateEmployee(id int, employee model.Employee)
 
 	var r0 model.Employee
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, model.Employee) (model.Employee, error)); ok {
-		return rf(id, employee)
+	if rf, ok := ret.Get(0).(func(context.Context, int, model.Employee) (model.Employee, error)); ok {
+		return rf(ctx, id, employee)
 	}
-	if rf, ok := ret.Get(0).(func(int, model.Employee) model.Employee); ok {
-		r0 = rf(id, employee)
+	if rf, ok := ret.Get(0).(func(context.Context, int, model.Employee) model.Employee); ok {
+		r0 = rf(ctx, id, employee)
 	} else {
 		r0 = ret.Get(0).(model.Employee)
 	}
 
-	if rf, ok := ret.Get(1).(func(int, model.Employee) error); ok {
-		r1 = rf(id, employee)
+	if rf, ok := ret.Get(1).(func(context.Context, int, model.Employee) error); ok {
+		r1 = rf(ctx, id, employee)
 	} else {
 		r1 = ret.Error(1)
 	}
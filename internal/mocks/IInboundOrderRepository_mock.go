@@ -3,8 +3,14 @@
 package mocks
 
 import (
-	model "github.com/maxwelbm/alkemy-g7.git/internal/model"
+	context "context"
+
+	interfaces "github.com/maxwelbm/alkemy-g7.git/internal/repository/interfaces"
 	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/maxwelbm/alkemy-g7.git/internal/model"
+
+	sql "database/sql"
 )
 
 // MockIInboundOrderRepository is an autogenerated mock type for the IInboundOrderRepository type
@@ -12,9 +18,92 @@ type MockIInboundOrderRepository struct {
 	mock.Mock
 }
 
-// Post provides a mock function with given fields: inboundOrder
-func (_m *MockIInboundOrderRepository) Post(inboundOrder model.InboundOrder) (model.InboundOrder, error) {
-	ret := _m.Called(inboundOrder)
+// AddBatches provides a mock function with given fields: ctx, inboundOrderID, productBatchIDs
+func (_m *MockIInboundOrderRepository) AddBatches(ctx context.Context, inboundOrderID int, productBatchIDs []int) error {
+	ret := _m.Called(ctx, inboundOrderID, productBatchIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddBatches")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, []int) error); ok {
+		r0 = rf(ctx, inboundOrderID, productBatchIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Get provides a mock function with given fields: ctx, params
+func (_m *MockIInboundOrderRepository) Get(ctx context.Context, params model.ListParams) ([]model.InboundOrder, int, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 []model.InboundOrder
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.ListParams) ([]model.InboundOrder, int, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.ListParams) []model.InboundOrder); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.InboundOrder)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.ListParams) int); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, model.ListParams) error); ok {
+		r2 = rf(ctx, params)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *MockIInboundOrderRepository) GetByID(ctx context.Context, id int) (model.InboundOrder, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 model.InboundOrder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (model.InboundOrder, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) model.InboundOrder); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(model.InboundOrder)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Post provides a mock function with given fields: ctx, inboundOrder
+func (_m *MockIInboundOrderRepository) Post(ctx context.Context, inboundOrder model.InboundOrder) (model.InboundOrder, error) {
+	ret := _m.Called(ctx, inboundOrder)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Post")
@@ -22,17 +111,17 @@ func (_m *MockIInboundOrderRepository) Post(inboundOrder model.InboundOrder) (mo
 
 	var r0 model.InboundOrder
 	var r1 error
-	if rf, ok := ret.Get(0).(func(model.InboundOrder) (model.InboundOrder, error)); ok {
-		return rf(inboundOrder)
+	if rf, ok := ret.Get(0).(func(context.Context, model.InboundOrder) (model.InboundOrder, error)); ok {
+		return rf(ctx, inboundOrder)
 	}
-	if rf, ok := ret.Get(0).(func(model.InboundOrder) model.InboundOrder); ok {
-		r0 = rf(inboundOrder)
+	if rf, ok := ret.Get(0).(func(context.Context, model.InboundOrder) model.InboundOrder); ok {
+		r0 = rf(ctx, inboundOrder)
 	} else {
 		r0 = ret.Get(0).(model.InboundOrder)
 	}
 
-	if rf, ok := ret.Get(1).(func(model.InboundOrder) error); ok {
-		r1 = rf(inboundOrder)
+	if rf, ok := ret.Get(1).(func(context.Context, model.InboundOrder) error); ok {
+		r1 = rf(ctx, inboundOrder)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -40,6 +129,26 @@ func (_m *MockIInboundOrderRepository) Post(inboundOrder model.InboundOrder) (mo
 	return r0, r1
 }
 
+// WithTx provides a mock function with given fields: tx
+func (_m *MockIInboundOrderRepository) WithTx(tx *sql.Tx) interfaces.IInboundOrderRepository {
+	ret := _m.Called(tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithTx")
+	}
+
+	var r0 interfaces.IInboundOrderRepository
+	if rf, ok := ret.Get(0).(func(*sql.Tx) interfaces.IInboundOrderRepository); ok {
+		r0 = rf(tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interfaces.IInboundOrderRepository)
+		}
+	}
+
+	return r0
+}
+
 // NewMockIInboundOrderRepository creates a new instance of MockIInboundOrderRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockIInboundOrderRepository(t interface {
@@ -3,8 +3,11 @@
 package mocks
 
 import (
-	model "github.com/maxwelbm/alkemy-g7.git/internal/model"
+	context "context"
+
 	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/maxwelbm/alkemy-g7.git/internal/model"
 )
 
 // MockIInboundOrderService is an autogenerated mock type for the IInboundOrderService type
@@ -12,9 +15,74 @@ type MockIInboundOrderService struct {
 	mock.Mock
 }
 
-// Post provides a mock function with given fields: inboundOrder
-func (_m *MockIInboundOrderService) Post(inboundOrder model.InboundOrder) (model.InboundOrder, error) {
-	ret := _m.Called(inboundOrder)
+// Get provides a mock function with given fields: ctx, params
+func (_m *MockIInboundOrderService) Get(ctx context.Context, params model.ListParams) ([]model.InboundOrder, int, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 []model.InboundOrder
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.ListParams) ([]model.InboundOrder, int, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.ListParams) []model.InboundOrder); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.InboundOrder)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.ListParams) int); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, model.ListParams) error); ok {
+		r2 = rf(ctx, params)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *MockIInboundOrderService) GetByID(ctx context.Context, id int) (model.InboundOrder, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 model.InboundOrder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (model.InboundOrder, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) model.InboundOrder); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(model.InboundOrder)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Post provides a mock function with given fields: ctx, inboundOrder
+func (_m *MockIInboundOrderService) Post(ctx context.Context, inboundOrder model.InboundOrder) (model.InboundOrder, error) {
+	ret := _m.Called(ctx, inboundOrder)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Post")
@@ -22,17 +90,17 @@ func (_m *MockIInboundOrderService) Post(inboundOrder model.InboundOrder) (model
 
 	var r0 model.InboundOrder
 	var r1 error
-	if rf, ok := ret.Get(0).(func(model.InboundOrder) (model.InboundOrder, error)); ok {
-		return rf(inboundOrder)
+	if rf, ok := ret.Get(0).(func(context.Context, model.InboundOrder) (model.InboundOrder, error)); ok {
+		return rf(ctx, inboundOrder)
 	}
-	if rf, ok := ret.Get(0).(func(model.InboundOrder) model.InboundOrder); ok {
-		r0 = rf(inboundOrder)
+	if rf, ok := ret.Get(0).(func(context.Context, model.InboundOrder) model.InboundOrder); ok {
+		r0 = rf(ctx, inboundOrder)
 	} else {
 		r0 = ret.Get(0).(model.InboundOrder)
 	}
 
-	if rf, ok := ret.Get(1).(func(model.InboundOrder) error); ok {
-		r1 = rf(inboundOrder)
+	if rf, ok := ret.Get(1).(func(context.Context, model.InboundOrder) error); ok {
+		r1 = rf(ctx, inboundOrder)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3,8 +3,14 @@
 package mocks
 
 import (
-	model "github.com/maxwelbm/alkemy-g7.git/internal/model"
+	context "context"
+
+	interfaces "github.com/maxwelbm/alkemy-g7.git/internal/repository/interfaces"
 	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/maxwelbm/alkemy-g7.git/internal/model"
+
+	sql "database/sql"
 )
 
 // MockILocalityRepo is an autogenerated mock type for the ILocalityRepo type
@@ -12,27 +18,27 @@ type MockILocalityRepo struct {
 	mock.Mock
 }
 
-// CreateLocality provides a mock function with given fields: l
-func (_m *MockILocalityRepo) CreateLocality(l *model.Locality) (model.Locality, error) {
-	ret := _m.Called(l)
+// CountDependencies provides a mock function with given fields: ctx, id
+func (_m *MockILocalityRepo) CountDependencies(ctx context.Context, id int) (model.LocalityDependencies, error) {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateLocality")
+		panic("no return value specified for CountDependencies")
 	}
 
-	var r0 model.Locality
+	var r0 model.LocalityDependencies
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*model.Locality) (model.Locality, error)); ok {
-		return rf(l)
+	if rf, ok := ret.Get(0).(func(context.Context, int) (model.LocalityDependencies, error)); ok {
+		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(*model.Locality) model.Locality); ok {
-		r0 = rf(l)
+	if rf, ok := ret.Get(0).(func(context.Context, int) model.LocalityDependencies); ok {
+		r0 = rf(ctx, id)
 	} else {
-		r0 = ret.Get(0).(model.Locality)
+		r0 = ret.Get(0).(model.LocalityDependencies)
 	}
 
-	if rf, ok := ret.Get(1).(func(*model.Locality) error); ok {
-		r1 = rf(l)
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -40,27 +46,27 @@ func (_m *MockILocalityRepo) CreateLocality(l *model.Locality) (model.Locality,
 	return r0, r1
 }
 
-// GetByID provides a mock function with given fields: id
-func (_m *MockILocalityRepo) GetByID(id int) (model.Locality, error) {
-	ret := _m.Called(id)
+// CreateLocality provides a mock function with given fields: ctx, l
+func (_m *MockILocalityRepo) CreateLocality(ctx context.Context, l *model.Locality) (model.Locality, error) {
+	ret := _m.Called(ctx, l)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetByID")
+		panic("no return value specified for CreateLocality")
 	}
 
 	var r0 model.Locality
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) (model.Locality, error)); ok {
-		return rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Locality) (model.Locality, error)); ok {
+		return rf(ctx, l)
 	}
-	if rf, ok := ret.Get(0).(func(int) model.Locality); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Locality) model.Locality); ok {
+		r0 = rf(ctx, l)
 	} else {
 		r0 = ret.Get(0).(model.Locality)
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(context.Context, *model.Locality) error); ok {
+		r1 = rf(ctx, l)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -68,29 +74,82 @@ func (_m *MockILocalityRepo) GetByID(id int) (model.Locality, error) {
 	return r0, r1
 }
 
-// GetCarriers provides a mock function with given fields: id
-func (_m *MockILocalityRepo) GetCarriers(id int) ([]model.LocalitiesJSONCarriers, error) {
-	ret := _m.Called(id)
+// DeleteLocality provides a mock function with given fields: ctx, id
+func (_m *MockILocalityRepo) DeleteLocality(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetCarriers")
+		panic("no return value specified for DeleteLocality")
 	}
 
-	var r0 []model.LocalitiesJSONCarriers
-	var r1 error
-	if rf, ok := ret.Get(0).(func(int) ([]model.LocalitiesJSONCarriers, error)); ok {
-		return rf(id)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Get provides a mock function with given fields: ctx, params
+func (_m *MockILocalityRepo) Get(ctx context.Context, params model.ListParams) ([]model.Locality, int, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
 	}
-	if rf, ok := ret.Get(0).(func(int) []model.LocalitiesJSONCarriers); ok {
-		r0 = rf(id)
+
+	var r0 []model.Locality
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.ListParams) ([]model.Locality, int, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.ListParams) []model.Locality); ok {
+		r0 = rf(ctx, params)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]model.LocalitiesJSONCarriers)
+			r0 = ret.Get(0).([]model.Locality)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(context.Context, model.ListParams) int); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, model.ListParams) error); ok {
+		r2 = rf(ctx, params)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *MockILocalityRepo) GetByID(ctx context.Context, id int) (model.Locality, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 model.Locality
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (model.Locality, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) model.Locality); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(model.Locality)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -98,29 +157,29 @@ func (_m *MockILocalityRepo) GetCarriers(id int) ([]model.LocalitiesJSONCarriers
 	return r0, r1
 }
 
-// GetReportCarriersWithID provides a mock function with given fields: id
-func (_m *MockILocalityRepo) GetReportCarriersWithID(id int) ([]model.LocalitiesJSONCarriers, error) {
-	ret := _m.Called(id)
+// GetCarriers provides a mock function with given fields: ctx, id
+func (_m *MockILocalityRepo) GetCarriers(ctx context.Context, id int) ([]model.LocalitiesJSONCarriers, error) {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetReportCarriersWithID")
+		panic("no return value specified for GetCarriers")
 	}
 
 	var r0 []model.LocalitiesJSONCarriers
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) ([]model.LocalitiesJSONCarriers, error)); ok {
-		return rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]model.LocalitiesJSONCarriers, error)); ok {
+		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(int) []model.LocalitiesJSONCarriers); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) []model.LocalitiesJSONCarriers); ok {
+		r0 = rf(ctx, id)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.LocalitiesJSONCarriers)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -128,29 +187,29 @@ func (_m *MockILocalityRepo) GetReportCarriersWithID(id int) ([]model.Localities
 	return r0, r1
 }
 
-// GetReportSellersWithID provides a mock function with given fields: id
-func (_m *MockILocalityRepo) GetReportSellersWithID(id int) ([]model.LocalitiesJSONSellers, error) {
-	ret := _m.Called(id)
+// GetSellers provides a mock function with given fields: ctx, id
+func (_m *MockILocalityRepo) GetSellers(ctx context.Context, id int) ([]model.LocalitiesJSONSellers, error) {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetReportSellersWithID")
+		panic("no return value specified for GetSellers")
 	}
 
 	var r0 []model.LocalitiesJSONSellers
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) ([]model.LocalitiesJSONSellers, error)); ok {
-		return rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]model.LocalitiesJSONSellers, error)); ok {
+		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(int) []model.LocalitiesJSONSellers); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) []model.LocalitiesJSONSellers); ok {
+		r0 = rf(ctx, id)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.LocalitiesJSONSellers)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -158,29 +217,27 @@ func (_m *MockILocalityRepo) GetReportSellersWithID(id int) ([]model.LocalitiesJ
 	return r0, r1
 }
 
-// GetSellers provides a mock function with given fields: id
-func (_m *MockILocalityRepo) GetSellers(id int) ([]model.LocalitiesJSONSellers, error) {
-	ret := _m.Called(id)
+// UpdateLocality provides a mock function with given fields: ctx, id, l
+func (_m *MockILocalityRepo) UpdateLocality(ctx context.Context, id int, l *model.Locality) (model.Locality, error) {
+	ret := _m.Called(ctx, id, l)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetSellers")
+		panic("no return value specified for UpdateLocality")
 	}
 
-	var r0 []model.LocalitiesJSONSellers
+	var r0 model.Locality
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) ([]model.LocalitiesJSONSellers, error)); ok {
-		return rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int, *model.Locality) (model.Locality, error)); ok {
+		return rf(ctx, id, l)
 	}
-	if rf, ok := ret.Get(0).(func(int) []model.LocalitiesJSONSellers); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int, *model.Locality) model.Locality); ok {
+		r0 = rf(ctx, id, l)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]model.LocalitiesJSONSellers)
-		}
+		r0 = ret.Get(0).(model.Locality)
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(context.Context, int, *model.Locality) error); ok {
+		r1 = rf(ctx, id, l)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -188,6 +245,26 @@ func (_m *MockILocalityRepo) GetSellers(id int) ([]model.LocalitiesJSONSellers,
 	return r0, r1
 }
 
+// WithTx provides a mock function with given fields: tx
+func (_m *MockILocalityRepo) WithTx(tx *sql.Tx) interfaces.ILocalityRepo {
+	ret := _m.Called(tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithTx")
+	}
+
+	var r0 interfaces.ILocalityRepo
+	if rf, ok := ret.Get(0).(func(*sql.Tx) interfaces.ILocalityRepo); ok {
+		r0 = rf(tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interfaces.ILocalityRepo)
+		}
+	}
+
+	return r0
+}
+
 // NewMockILocalityRepo creates a new instance of MockILocalityRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockILocalityRepo(t interface {
@@ -3,8 +3,11 @@
 package mocks
 
 import (
-	model "github.com/maxwelbm/alkemy-g7.git/internal/model"
+	context "context"
+
 	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/maxwelbm/alkemy-g7.git/internal/model"
 )
 
 // MockILocalityService is an autogenerated mock type for the ILocalityService type
@@ -12,9 +15,9 @@ type MockILocalityService struct {
 	mock.Mock
 }
 
-// CreateLocality provides a mock function with given fields: locality
-func (_m *MockILocalityService) CreateLocality(locality *model.Locality) (model.Locality, error) {
-	ret := _m.Called(locality)
+// CreateLocality provides a mock function with given fields: ctx, locality
+func (_m *MockILocalityService) CreateLocality(ctx context.Context, locality *model.Locality) (model.Locality, error) {
+	ret := _m.Called(ctx, locality)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateLocality")
@@ -22,17 +25,45 @@ func (_m *MockILocalityService) CreateLocality(locality *model.Locality) (model.
 
 	var r0 model.Locality
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*model.Locality) (model.Locality, error)); ok {
-		return rf(locality)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Locality) (model.Locality, error)); ok {
+		return rf(ctx, locality)
 	}
-	if rf, ok := ret.Get(0).(func(*model.Locality) model.Locality); ok {
-		r0 = rf(locality)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Locality) model.Locality); ok {
+		r0 = rf(ctx, locality)
 	} else {
 		r0 = ret.Get(0).(model.Locality)
 	}
 
-	if rf, ok := ret.Get(1).(func(*model.Locality) error); ok {
-		r1 = rf(locality)
+	if rf, ok := ret.Get(1).(func(context.Context, *model.Locality) error); ok {
+		r1 = rf(ctx, locality)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteLocality provides a mock function with given fields: ctx, id
+func (_m *MockILocalityService) DeleteLocality(ctx context.Context, id int) (model.LocalityDependencies, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteLocality")
+	}
+
+	var r0 model.LocalityDependencies
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (model.LocalityDependencies, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) model.LocalityDependencies); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(model.LocalityDependencies)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -40,9 +71,46 @@ func (_m *MockILocalityService) CreateLocality(locality *model.Locality) (model.
 	return r0, r1
 }
 
-// GetByID provides a mock function with given fields: id
-func (_m *MockILocalityService) GetByID(id int) (model.Locality, error) {
-	ret := _m.Called(id)
+// GetAll provides a mock function with given fields: ctx, params
+func (_m *MockILocalityService) GetAll(ctx context.Context, params model.ListParams) ([]model.Locality, int, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 []model.Locality
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.ListParams) ([]model.Locality, int, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.ListParams) []model.Locality); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Locality)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.ListParams) int); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, model.ListParams) error); ok {
+		r2 = rf(ctx, params)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *MockILocalityService) GetByID(ctx context.Context, id int) (model.Locality, error) {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetByID")
@@ -50,17 +118,17 @@ func (_m *MockILocalityService) GetByID(id int) (model.Locality, error) {
 
 	var r0 model.Locality
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) (model.Locality, error)); ok {
-		return rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) (model.Locality, error)); ok {
+		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(int) model.Locality); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) model.Locality); ok {
+		r0 = rf(ctx, id)
 	} else {
 		r0 = ret.Get(0).(model.Locality)
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -68,9 +136,9 @@ func (_m *MockILocalityService) GetByID(id int) (model.Locality, error) {
 	return r0, r1
 }
 
-// GetCarriers provides a mock function with given fields: id
-func (_m *MockILocalityService) GetCarriers(id int) ([]model.LocalitiesJSONCarriers, error) {
-	ret := _m.Called(id)
+// GetCarriers provides a mock function with given fields: ctx, id
+func (_m *MockILocalityService) GetCarriers(ctx context.Context, id int) ([]model.LocalitiesJSONCarriers, error) {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetCarriers")
@@ -78,19 +146,19 @@ func (_m *MockILocalityService) GetCarriers(id int) ([]model.LocalitiesJSONCarri
 
 	var r0 []model.LocalitiesJSONCarriers
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) ([]model.LocalitiesJSONCarriers, error)); ok {
-		return rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]model.LocalitiesJSONCarriers, error)); ok {
+		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(int) []model.LocalitiesJSONCarriers); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) []model.LocalitiesJSONCarriers); ok {
+		r0 = rf(ctx, id)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.LocalitiesJSONCarriers)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -98,9 +166,9 @@ func (_m *MockILocalityService) GetCarriers(id int) ([]model.LocalitiesJSONCarri
 	return r0, r1
 }
 
-// GetSellers provides a mock function with given fields: id
-func (_m *MockILocalityService) GetSellers(id int) ([]model.LocalitiesJSONSellers, error) {
-	ret := _m.Called(id)
+// GetSellers provides a mock function with given fields: ctx, id
+func (_m *MockILocalityService) GetSellers(ctx context.Context, id int) ([]model.LocalitiesJSONSellers, error) {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetSellers")
@@ -108,19 +176,47 @@ func (_m *MockILocalityService) GetSellers(id int) ([]model.LocalitiesJSONSeller
 
 	var r0 []model.LocalitiesJSONSellers
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) ([]model.LocalitiesJSONSellers, error)); ok {
-		return rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]model.LocalitiesJSONSellers, error)); ok {
+		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(int) []model.LocalitiesJSONSellers); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) []model.LocalitiesJSONSellers); ok {
+		r0 = rf(ctx, id)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.LocalitiesJSONSellers)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateLocality provides a mock function with given fields: ctx, id, locality
+func (_m *MockILocalityService) UpdateLocality(ctx context.Context, id int, locality *model.Locality) (model.Locality, error) {
+	ret := _m.Called(ctx, id, locality)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateLocality")
+	}
+
+	var r0 model.Locality
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, *model.Locality) (model.Locality, error)); ok {
+		return rf(ctx, id, locality)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, *model.Locality) model.Locality); ok {
+		r0 = rf(ctx, id, locality)
+	} else {
+		r0 = ret.Get(0).(model.Locality)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, *model.Locality) error); ok {
+		r1 = rf(ctx, id, locality)
 	} else {
 		r1 = ret.Error(1)
 	}
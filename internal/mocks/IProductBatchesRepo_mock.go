@@ -3,8 +3,16 @@
 package mocks
 
 import (
-	model "github.com/maxwelbm/alkemy-g7.git/internal/model"
+	context "context"
+
+	interfaces "github.com/maxwelbm/alkemy-g7.git/internal/repository/interfaces"
 	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/maxwelbm/alkemy-g7.git/internal/model"
+
+	sql "database/sql"
+
+	time "time"
 )
 
 // MockIProductBatchesRepo is an autogenerated mock type for the IProductBatchesRepo type
@@ -12,9 +20,92 @@ type MockIProductBatchesRepo struct {
 	mock.Mock
 }
 
-// GetByID provides a mock function with given fields: id
-func (_m *MockIProductBatchesRepo) GetByID(id int) (model.ProductBatches, error) {
-	ret := _m.Called(id)
+// CountReferences provides a mock function with given fields: ctx, id
+func (_m *MockIProductBatchesRepo) CountReferences(ctx context.Context, id int) (model.ProductBatchesReferences, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountReferences")
+	}
+
+	var r0 model.ProductBatchesReferences
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (model.ProductBatchesReferences, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) model.ProductBatchesReferences); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(model.ProductBatchesReferences)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *MockIProductBatchesRepo) Delete(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Get provides a mock function with given fields: ctx, params
+func (_m *MockIProductBatchesRepo) Get(ctx context.Context, params model.ListParams) ([]model.ProductBatches, int, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 []model.ProductBatches
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.ListParams) ([]model.ProductBatches, int, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.ListParams) []model.ProductBatches); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.ProductBatches)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.ListParams) int); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, model.ListParams) error); ok {
+		r2 = rf(ctx, params)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *MockIProductBatchesRepo) GetByID(ctx context.Context, id int) (model.ProductBatches, error) {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetByID")
@@ -22,17 +113,75 @@ func (_m *MockIProductBatchesRepo) GetByID(id int) (model.ProductBatches, error)
 
 	var r0 model.ProductBatches
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) (model.ProductBatches, error)); ok {
-		return rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) (model.ProductBatches, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) model.ProductBatches); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(model.ProductBatches)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByIDForUpdate provides a mock function with given fields: ctx, id
+func (_m *MockIProductBatchesRepo) GetByIDForUpdate(ctx context.Context, id int) (model.ProductBatches, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByIDForUpdate")
+	}
+
+	var r0 model.ProductBatches
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (model.ProductBatches, error)); ok {
+		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(int) model.ProductBatches); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) model.ProductBatches); ok {
+		r0 = rf(ctx, id)
 	} else {
 		r0 = ret.Get(0).(model.ProductBatches)
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetExpiring provides a mock function with given fields: ctx, until
+func (_m *MockIProductBatchesRepo) GetExpiring(ctx context.Context, until time.Time) ([]model.ExpiringBatch, error) {
+	ret := _m.Called(ctx, until)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExpiring")
+	}
+
+	var r0 []model.ExpiringBatch
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]model.ExpiringBatch, error)); ok {
+		return rf(ctx, until)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []model.ExpiringBatch); ok {
+		r0 = rf(ctx, until)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.ExpiringBatch)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, until)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -40,9 +189,9 @@ func (_m *MockIProductBatchesRepo) GetByID(id int) (model.ProductBatches, error)
 	return r0, r1
 }
 
-// Post provides a mock function with given fields: prodBatches
-func (_m *MockIProductBatchesRepo) Post(prodBatches *model.ProductBatches) (model.ProductBatches, error) {
-	ret := _m.Called(prodBatches)
+// Post provides a mock function with given fields: ctx, prodBatches
+func (_m *MockIProductBatchesRepo) Post(ctx context.Context, prodBatches *model.ProductBatches) (model.ProductBatches, error) {
+	ret := _m.Called(ctx, prodBatches)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Post")
@@ -50,17 +199,17 @@ func (_m *MockIProductBatchesRepo) Post(prodBatches *model.ProductBatches) (mode
 
 	var r0 model.ProductBatches
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*model.ProductBatches) (model.ProductBatches, error)); ok {
-		return rf(prodBatches)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ProductBatches) (model.ProductBatches, error)); ok {
+		return rf(ctx, prodBatches)
 	}
-	if rf, ok := ret.Get(0).(func(*model.ProductBatches) model.ProductBatches); ok {
-		r0 = rf(prodBatches)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ProductBatches) model.ProductBatches); ok {
+		r0 = rf(ctx, prodBatches)
 	} else {
 		r0 = ret.Get(0).(model.ProductBatches)
 	}
 
-	if rf, ok := ret.Get(1).(func(*model.ProductBatches) error); ok {
-		r1 = rf(prodBatches)
+	if rf, ok := ret.Get(1).(func(context.Context, *model.ProductBatches) error); ok {
+		r1 = rf(ctx, prodBatches)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -68,6 +217,54 @@ func (_m *MockIProductBatchesRepo) Post(prodBatches *model.ProductBatches) (mode
 	return r0, r1
 }
 
+// Update provides a mock function with given fields: ctx, id, prodBatches
+func (_m *MockIProductBatchesRepo) Update(ctx context.Context, id int, prodBatches *model.ProductBatches) (model.ProductBatches, error) {
+	ret := _m.Called(ctx, id, prodBatches)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 model.ProductBatches
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, *model.ProductBatches) (model.ProductBatches, error)); ok {
+		return rf(ctx, id, prodBatches)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, *model.ProductBatches) model.ProductBatches); ok {
+		r0 = rf(ctx, id, prodBatches)
+	} else {
+		r0 = ret.Get(0).(model.ProductBatches)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, *model.ProductBatches) error); ok {
+		r1 = rf(ctx, id, prodBatches)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WithTx provides a mock function with given fields: tx
+func (_m *MockIProductBatchesRepo) WithTx(tx *sql.Tx) interfaces.IProductBatchesRepo {
+	ret := _m.Called(tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithTx")
+	}
+
+	var r0 interfaces.IProductBatchesRepo
+	if rf, ok := ret.Get(0).(func(*sql.Tx) interfaces.IProductBatchesRepo); ok {
+		r0 = rf(tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interfaces.IProductBatchesRepo)
+		}
+	}
+
+	return r0
+}
+
 // NewMockIProductBatchesRepo creates a new instance of MockIProductBatchesRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockIProductBatchesRepo(t interface {
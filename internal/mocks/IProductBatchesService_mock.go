@@ -3,8 +3,11 @@
 package mocks
 
 import (
-	model "github.com/maxwelbm/alkemy-g7.git/internal/model"
+	context "context"
+
 	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/maxwelbm/alkemy-g7.git/internal/model"
 )
 
 // MockIProductBatchesService is an autogenerated mock type for the IProductBatchesService type
@@ -12,27 +15,112 @@ type MockIProductBatchesService struct {
 	mock.Mock
 }
 
-// GetById provides a mock function with given fields: id
-func (_m *MockIProductBatchesService) GetByID(id int) (model.ProductBatches, error) {
-	ret := _m.Called(id)
+// Delete provides a mock function with given fields: ctx, id
+func (_m *MockIProductBatchesService) Delete(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Get provides a mock function with given fields: ctx, params
+func (_m *MockIProductBatchesService) Get(ctx context.Context, params model.ListParams) ([]model.ProductBatches, int, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 []model.ProductBatches
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.ListParams) ([]model.ProductBatches, int, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.ListParams) []model.ProductBatches); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.ProductBatches)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.ListParams) int); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, model.ListParams) error); ok {
+		r2 = rf(ctx, params)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *MockIProductBatchesService) GetByID(ctx context.Context, id int) (model.ProductBatches, error) {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetById")
+		panic("no return value specified for GetByID")
 	}
 
 	var r0 model.ProductBatches
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) (model.ProductBatches, error)); ok {
-		return rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) (model.ProductBatches, error)); ok {
+		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(int) model.ProductBatches); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) model.ProductBatches); ok {
+		r0 = rf(ctx, id)
 	} else {
 		r0 = ret.Get(0).(model.ProductBatches)
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetExpiringReport provides a mock function with given fields: ctx, days
+func (_m *MockIProductBatchesService) GetExpiringReport(ctx context.Context, days int) ([]model.ExpiringBatchesWarehouse, error) {
+	ret := _m.Called(ctx, days)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExpiringReport")
+	}
+
+	var r0 []model.ExpiringBatchesWarehouse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]model.ExpiringBatchesWarehouse, error)); ok {
+		return rf(ctx, days)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []model.ExpiringBatchesWarehouse); ok {
+		r0 = rf(ctx, days)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.ExpiringBatchesWarehouse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, days)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -40,9 +128,9 @@ func (_m *MockIProductBatchesService) GetByID(id int) (model.ProductBatches, err
 	return r0, r1
 }
 
-// Post provides a mock function with given fields: prodBatches
-func (_m *MockIProductBatchesService) Post(prodBatches *model.ProductBatches) (model.ProductBatches, error) {
-	ret := _m.Called(prodBatches)
+// Post provides a mock function with given fields: ctx, prodBatches
+func (_m *MockIProductBatchesService) Post(ctx context.Context, prodBatches *model.ProductBatches) (model.ProductBatches, error) {
+	ret := _m.Called(ctx, prodBatches)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Post")
@@ -50,17 +138,45 @@ func (_m *MockIProductBatchesService) Post(prodBatches *model.ProductBatches) (m
 
 	var r0 model.ProductBatches
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*model.ProductBatches) (model.ProductBatches, error)); ok {
-		return rf(prodBatches)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ProductBatches) (model.ProductBatches, error)); ok {
+		return rf(ctx, prodBatches)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ProductBatches) model.ProductBatches); ok {
+		r0 = rf(ctx, prodBatches)
+	} else {
+		r0 = ret.Get(0).(model.ProductBatches)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.ProductBatches) error); ok {
+		r1 = rf(ctx, prodBatches)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, id, update
+func (_m *MockIProductBatchesService) Update(ctx context.Context, id int, update model.ProductBatchesUpdate) (model.ProductBatches, error) {
+	ret := _m.Called(ctx, id, update)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 model.ProductBatches
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, model.ProductBatchesUpdate) (model.ProductBatches, error)); ok {
+		return rf(ctx, id, update)
 	}
-	if rf, ok := ret.Get(0).(func(*model.ProductBatches) model.ProductBatches); ok {
-		r0 = rf(prodBatches)
+	if rf, ok := ret.Get(0).(func(context.Context, int, model.ProductBatchesUpdate) model.ProductBatches); ok {
+		r0 = rf(ctx, id, update)
 	} else {
 		r0 = ret.Get(0).(model.ProductBatches)
 	}
 
-	if rf, ok := ret.Get(1).(func(*model.ProductBatches) error); ok {
-		r1 = rf(prodBatches)
+	if rf, ok := ret.Get(1).(func(context.Context, int, model.ProductBatchesUpdate) error); ok {
+		r1 = rf(ctx, id, update)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -68,6 +184,24 @@ func (_m *MockIProductBatchesService) Post(prodBatches *model.ProductBatches) (m
 	return r0, r1
 }
 
+// ValidateNew provides a mock function with given fields: ctx, batches
+func (_m *MockIProductBatchesService) ValidateNew(ctx context.Context, batches []*model.ProductBatches) error {
+	ret := _m.Called(ctx, batches)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateNew")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*model.ProductBatches) error); ok {
+		r0 = rf(ctx, batches)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewMockIProductBatchesService creates a new instance of MockIProductBatchesService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockIProductBatchesService(t interface {
@@ -3,8 +3,16 @@
 package mocks
 
 import (
-	model "github.com/maxwelbm/alkemy-g7.git/internal/model"
+	context "context"
+
+	interfaces "github.com/maxwelbm/alkemy-g7.git/internal/repository/interfaces"
 	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/maxwelbm/alkemy-g7.git/internal/model"
+
+	sql "database/sql"
+
+	time "time"
 )
 
 // MockIProductRecRepository is an autogenerated mock type for the IProductRecRepository type
@@ -12,9 +20,37 @@ type MockIProductRecRepository struct {
 	mock.Mock
 }
 
-// Create provides a mock function with given fields: pr
-func (_m *MockIProductRecRepository) Create(pr model.ProductRecords) (model.ProductRecords, error) {
-	ret := _m.Called(pr)
+// CountPurchaseOrders provides a mock function with given fields: ctx, id
+func (_m *MockIProductRecRepository) CountPurchaseOrders(ctx context.Context, id int) (int, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountPurchaseOrders")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (int, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) int); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Create provides a mock function with given fields: ctx, pr
+func (_m *MockIProductRecRepository) Create(ctx context.Context, pr model.ProductRecords) (model.ProductRecords, error) {
+	ret := _m.Called(ctx, pr)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Create")
@@ -22,17 +58,17 @@ func (_m *MockIProductRecRepository) Create(pr model.ProductRecords) (model.Prod
 
 	var r0 model.ProductRecords
 	var r1 error
-	if rf, ok := ret.Get(0).(func(model.ProductRecords) (model.ProductRecords, error)); ok {
-		return rf(pr)
+	if rf, ok := ret.Get(0).(func(context.Context, model.ProductRecords) (model.ProductRecords, error)); ok {
+		return rf(ctx, pr)
 	}
-	if rf, ok := ret.Get(0).(func(model.ProductRecords) model.ProductRecords); ok {
-		r0 = rf(pr)
+	if rf, ok := ret.Get(0).(func(context.Context, model.ProductRecords) model.ProductRecords); ok {
+		r0 = rf(ctx, pr)
 	} else {
 		r0 = ret.Get(0).(model.ProductRecords)
 	}
 
-	if rf, ok := ret.Get(1).(func(model.ProductRecords) error); ok {
-		r1 = rf(pr)
+	if rf, ok := ret.Get(1).(func(context.Context, model.ProductRecords) error); ok {
+		r1 = rf(ctx, pr)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -40,39 +76,64 @@ func (_m *MockIProductRecRepository) Create(pr model.ProductRecords) (model.Prod
 	return r0, r1
 }
 
-// GetAll provides a mock function with no fields
-func (_m *MockIProductRecRepository) GetAll() ([]model.ProductRecords, error) {
-	ret := _m.Called()
+// Delete provides a mock function with given fields: ctx, id
+func (_m *MockIProductRecRepository) Delete(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAll provides a mock function with given fields: ctx, params
+func (_m *MockIProductRecRepository) GetAll(ctx context.Context, params model.ListParams) ([]model.ProductRecords, int, error) {
+	ret := _m.Called(ctx, params)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetAll")
 	}
 
 	var r0 []model.ProductRecords
-	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]model.ProductRecords, error)); ok {
-		return rf()
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.ListParams) ([]model.ProductRecords, int, error)); ok {
+		return rf(ctx, params)
 	}
-	if rf, ok := ret.Get(0).(func() []model.ProductRecords); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context, model.ListParams) []model.ProductRecords); ok {
+		r0 = rf(ctx, params)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.ProductRecords)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context, model.ListParams) int); ok {
+		r1 = rf(ctx, params)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(int)
 	}
 
-	return r0, r1
+	if rf, ok := ret.Get(2).(func(context.Context, model.ListParams) error); ok {
+		r2 = rf(ctx, params)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
-// GetAllReport provides a mock function with no fields
-func (_m *MockIProductRecRepository) GetAllReport() ([]model.ProductRecordsReport, error) {
-	ret := _m.Called()
+// GetAllReport provides a mock function with given fields: ctx, idProduct
+func (_m *MockIProductRecRepository) GetAllReport(ctx context.Context, idProduct int) ([]model.ProductRecordsReport, error) {
+	ret := _m.Called(ctx, idProduct)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetAllReport")
@@ -80,19 +141,19 @@ func (_m *MockIProductRecRepository) GetAllReport() ([]model.ProductRecordsRepor
 
 	var r0 []model.ProductRecordsReport
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]model.ProductRecordsReport, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]model.ProductRecordsReport, error)); ok {
+		return rf(ctx, idProduct)
 	}
-	if rf, ok := ret.Get(0).(func() []model.ProductRecordsReport); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context, int) []model.ProductRecordsReport); ok {
+		r0 = rf(ctx, idProduct)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.ProductRecordsReport)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, idProduct)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -100,9 +161,9 @@ func (_m *MockIProductRecRepository) GetAllReport() ([]model.ProductRecordsRepor
 	return r0, r1
 }
 
-// GetByID provides a mock function with given fields: id
-func (_m *MockIProductRecRepository) GetByID(id int) (model.ProductRecords, error) {
-	ret := _m.Called(id)
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *MockIProductRecRepository) GetByID(ctx context.Context, id int) (model.ProductRecords, error) {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetByID")
@@ -110,17 +171,17 @@ func (_m *MockIProductRecRepository) GetByID(id int) (model.ProductRecords, erro
 
 	var r0 model.ProductRecords
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) (model.ProductRecords, error)); ok {
-		return rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) (model.ProductRecords, error)); ok {
+		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(int) model.ProductRecords); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) model.ProductRecords); ok {
+		r0 = rf(ctx, id)
 	} else {
 		r0 = ret.Get(0).(model.ProductRecords)
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -128,9 +189,9 @@ func (_m *MockIProductRecRepository) GetByID(id int) (model.ProductRecords, erro
 	return r0, r1
 }
 
-// GetByIDProduct provides a mock function with given fields: idProduct
-func (_m *MockIProductRecRepository) GetByIDProduct(idProduct int) ([]model.ProductRecords, error) {
-	ret := _m.Called(idProduct)
+// GetByIDProduct provides a mock function with given fields: ctx, idProduct
+func (_m *MockIProductRecRepository) GetByIDProduct(ctx context.Context, idProduct int) ([]model.ProductRecords, error) {
+	ret := _m.Called(ctx, idProduct)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetByIDProduct")
@@ -138,19 +199,77 @@ func (_m *MockIProductRecRepository) GetByIDProduct(idProduct int) ([]model.Prod
 
 	var r0 []model.ProductRecords
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) ([]model.ProductRecords, error)); ok {
-		return rf(idProduct)
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]model.ProductRecords, error)); ok {
+		return rf(ctx, idProduct)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []model.ProductRecords); ok {
+		r0 = rf(ctx, idProduct)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.ProductRecords)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, idProduct)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLatestByIDProduct provides a mock function with given fields: ctx, idProduct
+func (_m *MockIProductRecRepository) GetLatestByIDProduct(ctx context.Context, idProduct int) (model.ProductRecords, error) {
+	ret := _m.Called(ctx, idProduct)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestByIDProduct")
+	}
+
+	var r0 model.ProductRecords
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (model.ProductRecords, error)); ok {
+		return rf(ctx, idProduct)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) model.ProductRecords); ok {
+		r0 = rf(ctx, idProduct)
+	} else {
+		r0 = ret.Get(0).(model.ProductRecords)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, idProduct)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPriceHistory provides a mock function with given fields: ctx, idProduct, from, to
+func (_m *MockIProductRecRepository) GetPriceHistory(ctx context.Context, idProduct int, from time.Time, to time.Time) ([]model.ProductRecords, error) {
+	ret := _m.Called(ctx, idProduct, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPriceHistory")
+	}
+
+	var r0 []model.ProductRecords
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time, time.Time) ([]model.ProductRecords, error)); ok {
+		return rf(ctx, idProduct, from, to)
 	}
-	if rf, ok := ret.Get(0).(func(int) []model.ProductRecords); ok {
-		r0 = rf(idProduct)
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time, time.Time) []model.ProductRecords); ok {
+		r0 = rf(ctx, idProduct, from, to)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.ProductRecords)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(idProduct)
+	if rf, ok := ret.Get(1).(func(context.Context, int, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, idProduct, from, to)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -158,6 +277,86 @@ func (_m *MockIProductRecRepository) GetByIDProduct(idProduct int) ([]model.Prod
 	return r0, r1
 }
 
+// GetProductMargins provides a mock function with given fields: ctx, filter
+func (_m *MockIProductRecRepository) GetProductMargins(ctx context.Context, filter model.ProductMarginFilter) ([]model.ProductMarginReport, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProductMargins")
+	}
+
+	var r0 []model.ProductMarginReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.ProductMarginFilter) ([]model.ProductMarginReport, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.ProductMarginFilter) []model.ProductMarginReport); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.ProductMarginReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.ProductMarginFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSellerMargins provides a mock function with given fields: ctx, filter
+func (_m *MockIProductRecRepository) GetSellerMargins(ctx context.Context, filter model.ProductMarginFilter) ([]model.SellerMarginReport, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSellerMargins")
+	}
+
+	var r0 []model.SellerMarginReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.ProductMarginFilter) ([]model.SellerMarginReport, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.ProductMarginFilter) []model.SellerMarginReport); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.SellerMarginReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.ProductMarginFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WithTx provides a mock function with given fields: tx
+func (_m *MockIProductRecRepository) WithTx(tx *sql.Tx) interfaces.IProductRecRepository {
+	ret := _m.Called(tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithTx")
+	}
+
+	var r0 interfaces.IProductRecRepository
+	if rf, ok := ret.Get(0).(func(*sql.Tx) interfaces.IProductRecRepository); ok {
+		r0 = rf(tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interfaces.IProductRecRepository)
+		}
+	}
+
+	return r0
+}
+
 // NewMockIProductRecRepository creates a new instance of MockIProductRecRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockIProductRecRepository(t interface {
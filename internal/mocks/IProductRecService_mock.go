@@ -3,8 +3,13 @@
 package mocks
 
 import (
-	model "github.com/maxwelbm/alkemy-g7.git/internal/model"
+	context "context"
+
 	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/maxwelbm/alkemy-g7.git/internal/model"
+
+	time "time"
 )
 
 // MockIProductRecService is an autogenerated mock type for the IProductRecService type
@@ -12,9 +17,9 @@ type MockIProductRecService struct {
 	mock.Mock
 }
 
-// CreateProductRecords provides a mock function with given fields: pr
-func (_m *MockIProductRecService) CreateProductRecords(pr model.ProductRecords) (model.ProductRecords, error) {
-	ret := _m.Called(pr)
+// CreateProductRecords provides a mock function with given fields: ctx, pr
+func (_m *MockIProductRecService) CreateProductRecords(ctx context.Context, pr model.ProductRecords) (model.ProductRecords, error) {
+	ret := _m.Called(ctx, pr)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateProductRecords")
@@ -22,17 +27,121 @@ func (_m *MockIProductRecService) CreateProductRecords(pr model.ProductRecords)
 
 	var r0 model.ProductRecords
 	var r1 error
-	if rf, ok := ret.Get(0).(func(model.ProductRecords) (model.ProductRecords, error)); ok {
-		return rf(pr)
+	if rf, ok := ret.Get(0).(func(context.Context, model.ProductRecords) (model.ProductRecords, error)); ok {
+		return rf(ctx, pr)
 	}
-	if rf, ok := ret.Get(0).(func(model.ProductRecords) model.ProductRecords); ok {
-		r0 = rf(pr)
+	if rf, ok := ret.Get(0).(func(context.Context, model.ProductRecords) model.ProductRecords); ok {
+		r0 = rf(ctx, pr)
 	} else {
 		r0 = ret.Get(0).(model.ProductRecords)
 	}
 
-	if rf, ok := ret.Get(1).(func(model.ProductRecords) error); ok {
-		r1 = rf(pr)
+	if rf, ok := ret.Get(1).(func(context.Context, model.ProductRecords) error); ok {
+		r1 = rf(ctx, pr)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteProductRecord provides a mock function with given fields: ctx, id
+func (_m *MockIProductRecService) DeleteProductRecord(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteProductRecord")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetLatestProductRecord provides a mock function with given fields: ctx, idProduct
+func (_m *MockIProductRecService) GetLatestProductRecord(ctx context.Context, idProduct int) (model.ProductRecords, error) {
+	ret := _m.Called(ctx, idProduct)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestProductRecord")
+	}
+
+	var r0 model.ProductRecords
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (model.ProductRecords, error)); ok {
+		return rf(ctx, idProduct)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) model.ProductRecords); ok {
+		r0 = rf(ctx, idProduct)
+	} else {
+		r0 = ret.Get(0).(model.ProductRecords)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, idProduct)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProductMarginReport provides a mock function with given fields: ctx, filter
+func (_m *MockIProductRecService) GetProductMarginReport(ctx context.Context, filter model.ProductMarginFilter) ([]model.ProductMarginReport, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProductMarginReport")
+	}
+
+	var r0 []model.ProductMarginReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.ProductMarginFilter) ([]model.ProductMarginReport, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.ProductMarginFilter) []model.ProductMarginReport); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.ProductMarginReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.ProductMarginFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProductPriceHistory provides a mock function with given fields: ctx, idProduct, from, to
+func (_m *MockIProductRecService) GetProductPriceHistory(ctx context.Context, idProduct int, from time.Time, to time.Time) (model.ProductPriceHistory, error) {
+	ret := _m.Called(ctx, idProduct, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProductPriceHistory")
+	}
+
+	var r0 model.ProductPriceHistory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time, time.Time) (model.ProductPriceHistory, error)); ok {
+		return rf(ctx, idProduct, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time, time.Time) model.ProductPriceHistory); ok {
+		r0 = rf(ctx, idProduct, from, to)
+	} else {
+		r0 = ret.Get(0).(model.ProductPriceHistory)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, idProduct, from, to)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -40,9 +149,9 @@ func (_m *MockIProductRecService) CreateProductRecords(pr model.ProductRecords)
 	return r0, r1
 }
 
-// GetProductRecordByID provides a mock function with given fields: id
-func (_m *MockIProductRecService) GetProductRecordByID(id int) (model.ProductRecords, error) {
-	ret := _m.Called(id)
+// GetProductRecordByID provides a mock function with given fields: ctx, id
+func (_m *MockIProductRecService) GetProductRecordByID(ctx context.Context, id int) (model.ProductRecords, error) {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetProductRecordByID")
@@ -50,17 +159,17 @@ func (_m *MockIProductRecService) GetProductRecordByID(id int) (model.ProductRec
 
 	var r0 model.ProductRecords
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) (model.ProductRecords, error)); ok {
-		return rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) (model.ProductRecords, error)); ok {
+		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(int) model.ProductRecords); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) model.ProductRecords); ok {
+		r0 = rf(ctx, id)
 	} else {
 		r0 = ret.Get(0).(model.ProductRecords)
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -68,9 +177,9 @@ func (_m *MockIProductRecService) GetProductRecordByID(id int) (model.ProductRec
 	return r0, r1
 }
 
-// GetProductRecordReport provides a mock function with given fields: idProduct
-func (_m *MockIProductRecService) GetProductRecordReport(idProduct int) ([]model.ProductRecordsReport, error) {
-	ret := _m.Called(idProduct)
+// GetProductRecordReport provides a mock function with given fields: ctx, idProduct
+func (_m *MockIProductRecService) GetProductRecordReport(ctx context.Context, idProduct int) ([]model.ProductRecordsReport, error) {
+	ret := _m.Called(ctx, idProduct)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetProductRecordReport")
@@ -78,19 +187,86 @@ func (_m *MockIProductRecService) GetProductRecordReport(idProduct int) ([]model
 
 	var r0 []model.ProductRecordsReport
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) ([]model.ProductRecordsReport, error)); ok {
-		return rf(idProduct)
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]model.ProductRecordsReport, error)); ok {
+		return rf(ctx, idProduct)
 	}
-	if rf, ok := ret.Get(0).(func(int) []model.ProductRecordsReport); ok {
-		r0 = rf(idProduct)
+	if rf, ok := ret.Get(0).(func(context.Context, int) []model.ProductRecordsReport); ok {
+		r0 = rf(ctx, idProduct)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.ProductRecordsReport)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(idProduct)
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, idProduct)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProductRecords provides a mock function with given fields: ctx, params
+func (_m *MockIProductRecService) GetProductRecords(ctx context.Context, params model.ListParams) ([]model.ProductRecords, int, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProductRecords")
+	}
+
+	var r0 []model.ProductRecords
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.ListParams) ([]model.ProductRecords, int, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.ListParams) []model.ProductRecords); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.ProductRecords)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.ListParams) int); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, model.ListParams) error); ok {
+		r2 = rf(ctx, params)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetSellerMarginReport provides a mock function with given fields: ctx, filter
+func (_m *MockIProductRecService) GetSellerMarginReport(ctx context.Context, filter model.ProductMarginFilter) ([]model.SellerMarginReport, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSellerMarginReport")
+	}
+
+	var r0 []model.SellerMarginReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.ProductMarginFilter) ([]model.SellerMarginReport, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.ProductMarginFilter) []model.SellerMarginReport); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.SellerMarginReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.ProductMarginFilter) error); ok {
+		r1 = rf(ctx, filter)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3,8 +3,11 @@
 package mocks
 
 import (
-	model "github.com/maxwelbm/alkemy-g7.git/internal/model"
+	context "context"
+
 	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/maxwelbm/alkemy-g7.git/internal/model"
 )
 
 // MockIProductService is an autogenerated mock type for the IProductService type
@@ -12,9 +15,9 @@ type MockIProductService struct {
 	mock.Mock
 }
 
-// CreateProduct provides a mock function with given fields: product
-func (_m *MockIProductService) CreateProduct(product model.Product) (model.Product, error) {
-	ret := _m.Called(product)
+// CreateProduct provides a mock function with given fields: ctx, product
+func (_m *MockIProductService) CreateProduct(ctx context.Context, product model.Product) (model.Product, error) {
+	ret := _m.Called(ctx, product)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateProduct")
@@ -22,17 +25,17 @@ func (_m *MockIProductService) CreateProduct(product model.Product) (model.Produ
 
 	var r0 model.Product
 	var r1 error
-	if rf, ok := ret.Get(0).(func(model.Product) (model.Product, error)); ok {
-		return rf(product)
+	if rf, ok := ret.Get(0).(func(context.Context, model.Product) (model.Product, error)); ok {
+		return rf(ctx, product)
 	}
-	if rf, ok := ret.Get(0).(func(model.Product) model.Product); ok {
-		r0 = rf(product)
+	if rf, ok := ret.Get(0).(func(context.Context, model.Product) model.Product); ok {
+		r0 = rf(ctx, product)
 	} else {
 		r0 = ret.Get(0).(model.Product)
 	}
 
-	if rf, ok := ret.Get(1).(func(model.Product) error); ok {
-		r1 = rf(product)
+	if rf, ok := ret.Get(1).(func(context.Context, model.Product) error); ok {
+		r1 = rf(ctx, product)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -40,17 +43,17 @@ func (_m *MockIProductService) CreateProduct(product model.Product) (model.Produ
 	return r0, r1
 }
 
-// DeleteProduct provides a mock function with given fields: id
-func (_m *MockIProductService) DeleteProduct(id int) error {
-	ret := _m.Called(id)
+// DeleteProduct provides a mock function with given fields: ctx, id
+func (_m *MockIProductService) DeleteProduct(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeleteProduct")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(int) error); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -58,39 +61,46 @@ func (_m *MockIProductService) DeleteProduct(id int) error {
 	return r0
 }
 
-// GetAllProducts provides a mock function with no fields
-func (_m *MockIProductService) GetAllProducts() ([]model.Product, error) {
-	ret := _m.Called()
+// GetAllProducts provides a mock function with given fields: ctx, params
+func (_m *MockIProductService) GetAllProducts(ctx context.Context, params model.ListParams) ([]model.Product, int, error) {
+	ret := _m.Called(ctx, params)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetAllProducts")
 	}
 
 	var r0 []model.Product
-	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]model.Product, error)); ok {
-		return rf()
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.ListParams) ([]model.Product, int, error)); ok {
+		return rf(ctx, params)
 	}
-	if rf, ok := ret.Get(0).(func() []model.Product); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context, model.ListParams) []model.Product); ok {
+		r0 = rf(ctx, params)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.Product)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context, model.ListParams) int); ok {
+		r1 = rf(ctx, params)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(int)
 	}
 
-	return r0, r1
+	if rf, ok := ret.Get(2).(func(context.Context, model.ListParams) error); ok {
+		r2 = rf(ctx, params)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
-// GetProductByID provides a mock function with given fields: id
-func (_m *MockIProductService) GetProductByID(id int) (model.Product, error) {
-	ret := _m.Called(id)
+// GetProductByID provides a mock function with given fields: ctx, id
+func (_m *MockIProductService) GetProductByID(ctx context.Context, id int) (model.Product, error) {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetProductByID")
@@ -98,17 +108,17 @@ func (_m *MockIProductService) GetProductByID(id int) (model.Product, error) {
 
 	var r0 model.Product
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) (model.Product, error)); ok {
-		return rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) (model.Product, error)); ok {
+		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(int) model.Product); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) model.Product); ok {
+		r0 = rf(ctx, id)
 	} else {
 		r0 = ret.Get(0).(model.Product)
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -116,9 +126,9 @@ func (_m *MockIProductService) GetProductByID(id int) (model.Product, error) {
 	return r0, r1
 }
 
-// UpdateProduct provides a mock function with given fields: id, product
-func (_m *MockIProductService) UpdateProduct(id int, product model.Product) (model.Product, error) {
-	ret := _m.Called(id, product)
+// UpdateProduct provides a mock function with given fields: ctx, id, product
+func (_m *MockIProductService) UpdateProduct(ctx context.Context, id int, product model.Product) (model.Product, error) {
+	ret := _m.Called(ctx, id, product)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateProduct")
@@ -126,17 +136,17 @@ func (_m *MockIProductService) UpdateProduct(id int, product model.Product) (mod
 
 	var r0 model.Product
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, model.Product) (model.Product, error)); ok {
-		return rf(id, product)
+	if rf, ok := ret.Get(0).(func(context.Context, int, model.Product) (model.Product, error)); ok {
+		return rf(ctx, id, product)
 	}
-	if rf, ok := ret.Get(0).(func(int, model.Product) model.Product); ok {
-		r0 = rf(id, product)
+	if rf, ok := ret.Get(0).(func(context.Context, int, model.Product) model.Product); ok {
+		r0 = rf(ctx, id, product)
 	} else {
 		r0 = ret.Get(0).(model.Product)
 	}
 
-	if rf, ok := ret.Get(1).(func(int, model.Product) error); ok {
-		r1 = rf(id, product)
+	if rf, ok := ret.Get(1).(func(context.Context, int, model.Product) error); ok {
+		r1 = rf(ctx, id, product)
 	} else {
 		r1 = ret.Error(1)
 	}
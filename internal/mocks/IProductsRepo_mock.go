@@ -3,8 +3,14 @@
 package mocks
 
 import (
-	model "github.com/maxwelbm/alkemy-g7.git/internal/model"
+	context "context"
+
+	interfaces "github.com/maxwelbm/alkemy-g7.git/internal/repository/interfaces"
 	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/maxwelbm/alkemy-g7.git/internal/model"
+
+	sql "database/sql"
 )
 
 // MockIProductsRepo is an autogenerated mock type for the IProductsRepo type
@@ -12,9 +18,9 @@ type MockIProductsRepo struct {
 	mock.Mock
 }
 
-// Create provides a mock function with given fields: product
-func (_m *MockIProductsRepo) Create(product model.Product) (model.Product, error) {
-	ret := _m.Called(product)
+// Create provides a mock function with given fields: ctx, product
+func (_m *MockIProductsRepo) Create(ctx context.Context, product model.Product) (model.Product, error) {
+	ret := _m.Called(ctx, product)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Create")
@@ -22,17 +28,17 @@ func (_m *MockIProductsRepo) Create(product model.Product) (model.Product, error
 
 	var r0 model.Product
 	var r1 error
-	if rf, ok := ret.Get(0).(func(model.Product) (model.Product, error)); ok {
-		return rf(product)
+	if rf, ok := ret.Get(0).(func(context.Context, model.Product) (model.Product, error)); ok {
+		return rf(ctx, product)
 	}
-	if rf, ok := ret.Get(0).(func(model.Product) model.Product); ok {
-		r0 = rf(product)
+	if rf, ok := ret.Get(0).(func(context.Context, model.Product) model.Product); ok {
+		r0 = rf(ctx, product)
 	} else {
 		r0 = ret.Get(0).(model.Product)
 	}
 
-	if rf, ok := ret.Get(1).(func(model.Product) error); ok {
-		r1 = rf(product)
+	if rf, ok := ret.Get(1).(func(context.Context, model.Product) error); ok {
+		r1 = rf(ctx, product)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -40,17 +46,17 @@ func (_m *MockIProductsRepo) Create(product model.Product) (model.Product, error
 	return r0, r1
 }
 
-// Delete provides a mock function with given fields: id
-func (_m *MockIProductsRepo) Delete(id int) error {
-	ret := _m.Called(id)
+// Delete provides a mock function with given fields: ctx, id
+func (_m *MockIProductsRepo) Delete(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Delete")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(int) error); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -58,39 +64,46 @@ func (_m *MockIProductsRepo) Delete(id int) error {
 	return r0
 }
 
-// GetAll provides a mock function with no fields
-func (_m *MockIProductsRepo) GetAll() (map[int]model.Product, error) {
-	ret := _m.Called()
+// GetAll provides a mock function with given fields: ctx, params
+func (_m *MockIProductsRepo) GetAll(ctx context.Context, params model.ListParams) ([]model.Product, int, error) {
+	ret := _m.Called(ctx, params)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetAll")
 	}
 
-	var r0 map[int]model.Product
-	var r1 error
-	if rf, ok := ret.Get(0).(func() (map[int]model.Product, error)); ok {
-		return rf()
+	var r0 []model.Product
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.ListParams) ([]model.Product, int, error)); ok {
+		return rf(ctx, params)
 	}
-	if rf, ok := ret.Get(0).(func() map[int]model.Product); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context, model.ListParams) []model.Product); ok {
+		r0 = rf(ctx, params)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(map[int]model.Product)
+			r0 = ret.Get(0).([]model.Product)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context, model.ListParams) int); ok {
+		r1 = rf(ctx, params)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(int)
 	}
 
-	return r0, r1
+	if rf, ok := ret.Get(2).(func(context.Context, model.ListParams) error); ok {
+		r2 = rf(ctx, params)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
-// GetByID provides a mock function with given fields: id
-func (_m *MockIProductsRepo) GetByID(id int) (model.Product, error) {
-	ret := _m.Called(id)
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *MockIProductsRepo) GetByID(ctx context.Context, id int) (model.Product, error) {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetByID")
@@ -98,17 +111,17 @@ func (_m *MockIProductsRepo) GetByID(id int) (model.Product, error) {
 
 	var r0 model.Product
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) (model.Product, error)); ok {
-		return rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) (model.Product, error)); ok {
+		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(int) model.Product); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) model.Product); ok {
+		r0 = rf(ctx, id)
 	} else {
 		r0 = ret.Get(0).(model.Product)
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -116,9 +129,9 @@ func (_m *MockIProductsRepo) GetByID(id int) (model.Product, error) {
 	return r0, r1
 }
 
-// Update provides a mock function with given fields: id, product
-func (_m *MockIProductsRepo) Update(id int, product model.Product) (model.Product, error) {
-	ret := _m.Called(id, product)
+// Update provides a mock function with given fields: ctx, id, product
+func (_m *MockIProductsRepo) Update(ctx context.Context, id int, product model.Product) (model.Product, error) {
+	ret := _m.Called(ctx, id, product)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Update")
@@ -126,17 +139,17 @@ func (_m *MockIProductsRepo) Update(id int, product model.Product) (model.Produc
 
 	var r0 model.Product
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, model.Product) (model.Product, error)); ok {
-		return rf(id, product)
+	if rf, ok := ret.Get(0).(func(context.Context, int, model.Product) (model.Product, error)); ok {
+		return rf(ctx, id, product)
 	}
-	if rf, ok := ret.Get(0).(func(int, model.Product) model.Product); ok {
-		r0 = rf(id, product)
+	if rf, ok := ret.Get(0).(func(context.Context, int, model.Product) model.Product); ok {
+		r0 = rf(ctx, id, product)
 	} else {
 		r0 = ret.Get(0).(model.Product)
 	}
 
-	if rf, ok := ret.Get(1).(func(int, model.Product) error); ok {
-		r1 = rf(id, product)
+	if rf, ok := ret.Get(1).(func(context.Context, int, model.Product) error); ok {
+		r1 = rf(ctx, id, product)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -144,6 +157,26 @@ func (_m *MockIProductsRepo) Update(id int, product model.Product) (model.Produc
 	return r0, r1
 }
 
+// WithTx provides a mock function with given fields: tx
+func (_m *MockIProductsRepo) WithTx(tx *sql.Tx) interfaces.IProductsRepo {
+	ret := _m.Called(tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithTx")
+	}
+
+	var r0 interfaces.IProductsRepo
+	if rf, ok := ret.Get(0).(func(*sql.Tx) interfaces.IProductsRepo); ok {
+		r0 = rf(tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interfaces.IProductsRepo)
+		}
+	}
+
+	return r0
+}
+
 // NewMockIProductsRepo creates a new instance of MockIProductsRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockIProductsRepo(t interface {
@@ -3,8 +3,14 @@
 package mocks
 
 import (
-	model "github.com/maxwelbm/alkemy-g7.git/internal/model"
+	context "context"
+
+	interfaces "github.com/maxwelbm/alkemy-g7.git/internal/repository/interfaces"
 	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/maxwelbm/alkemy-g7.git/internal/model"
+
+	sql "database/sql"
 )
 
 // MockIPurchaseOrdersRepo is an autogenerated mock type for the IPurchaseOrdersRepo type
@@ -12,9 +18,46 @@ type MockIPurchaseOrdersRepo struct {
 	mock.Mock
 }
 
-// GetByID provides a mock function with given fields: id
-func (_m *MockIPurchaseOrdersRepo) GetByID(id int) (model.PurchaseOrder, error) {
-	ret := _m.Called(id)
+// Get provides a mock function with given fields: ctx, params
+func (_m *MockIPurchaseOrdersRepo) Get(ctx context.Context, params model.ListParams) ([]model.PurchaseOrder, int, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 []model.PurchaseOrder
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.ListParams) ([]model.PurchaseOrder, int, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.ListParams) []model.PurchaseOrder); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.PurchaseOrder)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.ListParams) int); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, model.ListParams) error); ok {
+		r2 = rf(ctx, params)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *MockIPurchaseOrdersRepo) GetByID(ctx context.Context, id int) (model.PurchaseOrder, error) {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetByID")
@@ -22,17 +65,17 @@ func (_m *MockIPurchaseOrdersRepo) GetByID(id int) (model.PurchaseOrder, error)
 
 	var r0 model.PurchaseOrder
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) (model.PurchaseOrder, error)); ok {
-		return rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) (model.PurchaseOrder, error)); ok {
+		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(int) model.PurchaseOrder); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) model.PurchaseOrder); ok {
+		r0 = rf(ctx, id)
 	} else {
 		r0 = ret.Get(0).(model.PurchaseOrder)
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -40,9 +83,67 @@ func (_m *MockIPurchaseOrdersRepo) GetByID(id int) (model.PurchaseOrder, error)
 	return r0, r1
 }
 
-// Post provides a mock function with given fields: newPurchaseOrder
-func (_m *MockIPurchaseOrdersRepo) Post(newPurchaseOrder model.PurchaseOrder) (int64, error) {
-	ret := _m.Called(newPurchaseOrder)
+// GetByIDForUpdate provides a mock function with given fields: ctx, id
+func (_m *MockIPurchaseOrdersRepo) GetByIDForUpdate(ctx context.Context, id int) (model.PurchaseOrder, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByIDForUpdate")
+	}
+
+	var r0 model.PurchaseOrder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (model.PurchaseOrder, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) model.PurchaseOrder); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(model.PurchaseOrder)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStatusesForUpdate provides a mock function with given fields: ctx, ids
+func (_m *MockIPurchaseOrdersRepo) GetStatusesForUpdate(ctx context.Context, ids []int) (map[int]string, error) {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStatusesForUpdate")
+	}
+
+	var r0 map[int]string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int) (map[int]string, error)); ok {
+		return rf(ctx, ids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int) map[int]string); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Post provides a mock function with given fields: ctx, newPurchaseOrder
+func (_m *MockIPurchaseOrdersRepo) Post(ctx context.Context, newPurchaseOrder model.PurchaseOrder) (int64, error) {
+	ret := _m.Called(ctx, newPurchaseOrder)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Post")
@@ -50,17 +151,47 @@ func (_m *MockIPurchaseOrdersRepo) Post(newPurchaseOrder model.PurchaseOrder) (i
 
 	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(model.PurchaseOrder) (int64, error)); ok {
-		return rf(newPurchaseOrder)
+	if rf, ok := ret.Get(0).(func(context.Context, model.PurchaseOrder) (int64, error)); ok {
+		return rf(ctx, newPurchaseOrder)
 	}
-	if rf, ok := ret.Get(0).(func(model.PurchaseOrder) int64); ok {
-		r0 = rf(newPurchaseOrder)
+	if rf, ok := ret.Get(0).(func(context.Context, model.PurchaseOrder) int64); ok {
+		r0 = rf(ctx, newPurchaseOrder)
 	} else {
 		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(model.PurchaseOrder) error); ok {
-		r1 = rf(newPurchaseOrder)
+	if rf, ok := ret.Get(1).(func(context.Context, model.PurchaseOrder) error); ok {
+		r1 = rf(ctx, newPurchaseOrder)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RestoreBatches provides a mock function with given fields: ctx, purchaseOrderID
+func (_m *MockIPurchaseOrdersRepo) RestoreBatches(ctx context.Context, purchaseOrderID int) ([]model.PurchaseOrderBatch, error) {
+	ret := _m.Called(ctx, purchaseOrderID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreBatches")
+	}
+
+	var r0 []model.PurchaseOrderBatch
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]model.PurchaseOrderBatch, error)); ok {
+		return rf(ctx, purchaseOrderID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []model.PurchaseOrderBatch); ok {
+		r0 = rf(ctx, purchaseOrderID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.PurchaseOrderBatch)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, purchaseOrderID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -68,6 +199,44 @@ func (_m *MockIPurchaseOrdersRepo) Post(newPurchaseOrder model.PurchaseOrder) (i
 	return r0, r1
 }
 
+// UpdateStatus provides a mock function with given fields: ctx, id, from, to
+func (_m *MockIPurchaseOrdersRepo) UpdateStatus(ctx context.Context, id int, from string, to string) error {
+	ret := _m.Called(ctx, id, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateStatus")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string, string) error); ok {
+		r0 = rf(ctx, id, from, to)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WithTx provides a mock function with given fields: tx
+func (_m *MockIPurchaseOrdersRepo) WithTx(tx *sql.Tx) interfaces.IPurchaseOrdersRepo {
+	ret := _m.Called(tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithTx")
+	}
+
+	var r0 interfaces.IPurchaseOrdersRepo
+	if rf, ok := ret.Get(0).(func(*sql.Tx) interfaces.IPurchaseOrdersRepo); ok {
+		r0 = rf(tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interfaces.IPurchaseOrdersRepo)
+		}
+	}
+
+	return r0
+}
+
 // NewMockIPurchaseOrdersRepo creates a new instance of MockIPurchaseOrdersRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockIPurchaseOrdersRepo(t interface {
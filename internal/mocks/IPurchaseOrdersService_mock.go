@@ -3,8 +3,11 @@
 package mocks
 
 import (
-	model "github.com/maxwelbm/alkemy-g7.git/internal/model"
+	context "context"
+
 	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/maxwelbm/alkemy-g7.git/internal/model"
 )
 
 // MockIPurchaseOrdersService is an autogenerated mock type for the IPurchaseOrdersService type
@@ -12,9 +15,37 @@ type MockIPurchaseOrdersService struct {
 	mock.Mock
 }
 
-// CreatePurchaseOrder provides a mock function with given fields: newPurchaseOrder
-func (_m *MockIPurchaseOrdersService) CreatePurchaseOrder(newPurchaseOrder model.PurchaseOrder) (model.PurchaseOrder, error) {
-	ret := _m.Called(newPurchaseOrder)
+// CancelPurchaseOrder provides a mock function with given fields: ctx, id
+func (_m *MockIPurchaseOrdersService) CancelPurchaseOrder(ctx context.Context, id int) (model.PurchaseOrder, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelPurchaseOrder")
+	}
+
+	var r0 model.PurchaseOrder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (model.PurchaseOrder, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) model.PurchaseOrder); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(model.PurchaseOrder)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreatePurchaseOrder provides a mock function with given fields: ctx, newPurchaseOrder
+func (_m *MockIPurchaseOrdersService) CreatePurchaseOrder(ctx context.Context, newPurchaseOrder model.PurchaseOrder) (model.PurchaseOrder, error) {
+	ret := _m.Called(ctx, newPurchaseOrder)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreatePurchaseOrder")
@@ -22,17 +53,17 @@ func (_m *MockIPurchaseOrdersService) CreatePurchaseOrder(newPurchaseOrder model
 
 	var r0 model.PurchaseOrder
 	var r1 error
-	if rf, ok := ret.Get(0).(func(model.PurchaseOrder) (model.PurchaseOrder, error)); ok {
-		return rf(newPurchaseOrder)
+	if rf, ok := ret.Get(0).(func(context.Context, model.PurchaseOrder) (model.PurchaseOrder, error)); ok {
+		return rf(ctx, newPurchaseOrder)
 	}
-	if rf, ok := ret.Get(0).(func(model.PurchaseOrder) model.PurchaseOrder); ok {
-		r0 = rf(newPurchaseOrder)
+	if rf, ok := ret.Get(0).(func(context.Context, model.PurchaseOrder) model.PurchaseOrder); ok {
+		r0 = rf(ctx, newPurchaseOrder)
 	} else {
 		r0 = ret.Get(0).(model.PurchaseOrder)
 	}
 
-	if rf, ok := ret.Get(1).(func(model.PurchaseOrder) error); ok {
-		r1 = rf(newPurchaseOrder)
+	if rf, ok := ret.Get(1).(func(context.Context, model.PurchaseOrder) error); ok {
+		r1 = rf(ctx, newPurchaseOrder)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -40,9 +71,9 @@ func (_m *MockIPurchaseOrdersService) CreatePurchaseOrder(newPurchaseOrder model
 	return r0, r1
 }
 
-// GetPurchaseOrderByID provides a mock function with given fields: id
-func (_m *MockIPurchaseOrdersService) GetPurchaseOrderByID(id int) (model.PurchaseOrder, error) {
-	ret := _m.Called(id)
+// GetPurchaseOrderByID provides a mock function with given fields: ctx, id
+func (_m *MockIPurchaseOrdersService) GetPurchaseOrderByID(ctx context.Context, id int) (model.PurchaseOrder, error) {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetPurchaseOrderByID")
@@ -50,17 +81,82 @@ func (_m *MockIPurchaseOrdersService) GetPurchaseOrderByID(id int) (model.Purcha
 
 	var r0 model.PurchaseOrder
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) (model.PurchaseOrder, error)); ok {
-		return rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) (model.PurchaseOrder, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) model.PurchaseOrder); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(model.PurchaseOrder)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPurchaseOrders provides a mock function with given fields: ctx, params
+func (_m *MockIPurchaseOrdersService) GetPurchaseOrders(ctx context.Context, params model.ListParams) ([]model.PurchaseOrder, int, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPurchaseOrders")
+	}
+
+	var r0 []model.PurchaseOrder
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.ListParams) ([]model.PurchaseOrder, int, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.ListParams) []model.PurchaseOrder); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.PurchaseOrder)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.ListParams) int); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, model.ListParams) error); ok {
+		r2 = rf(ctx, params)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// UpdatePurchaseOrderStatus provides a mock function with given fields: ctx, id, update
+func (_m *MockIPurchaseOrdersService) UpdatePurchaseOrderStatus(ctx context.Context, id int, update model.PurchaseOrderStatusUpdate) (model.PurchaseOrder, error) {
+	ret := _m.Called(ctx, id, update)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePurchaseOrderStatus")
+	}
+
+	var r0 model.PurchaseOrder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, model.PurchaseOrderStatusUpdate) (model.PurchaseOrder, error)); ok {
+		return rf(ctx, id, update)
 	}
-	if rf, ok := ret.Get(0).(func(int) model.PurchaseOrder); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int, model.PurchaseOrderStatusUpdate) model.PurchaseOrder); ok {
+		r0 = rf(ctx, id, update)
 	} else {
 		r0 = ret.Get(0).(model.PurchaseOrder)
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(context.Context, int, model.PurchaseOrderStatusUpdate) error); ok {
+		r1 = rf(ctx, id, update)
 	} else {
 		r1 = ret.Error(1)
 	}
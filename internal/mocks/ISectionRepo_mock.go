@@ -3,8 +3,14 @@
 package mocks
 
 import (
-	model "github.com/maxwelbm/alkemy-g7.git/internal/model"
+	context "context"
+
+	interfaces "github.com/maxwelbm/alkemy-g7.git/internal/repository/interfaces"
 	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/maxwelbm/alkemy-g7.git/internal/model"
+
+	sql "database/sql"
 )
 
 // MockISectionRepo is an autogenerated mock type for the ISectionRepo type
@@ -12,27 +18,29 @@ type MockISectionRepo struct {
 	mock.Mock
 }
 
-// CountProductBatchesBySectionID provides a mock function with given fields: id
-func (_m *MockISectionRepo) CountProductBatchesBySectionID(id int) (model.SectionProductBatches, error) {
-	ret := _m.Called(id)
+// CountProductBatchesSections provides a mock function with given fields: ctx, id
+func (_m *MockISectionRepo) CountProductBatchesSections(ctx context.Context, id int) ([]model.SectionProductBatches, error) {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CountProductBatchesBySectionID")
+		panic("no return value specified for CountProductBatchesSections")
 	}
 
-	var r0 model.SectionProductBatches
+	var r0 []model.SectionProductBatches
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) (model.SectionProductBatches, error)); ok {
-		return rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]model.SectionProductBatches, error)); ok {
+		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(int) model.SectionProductBatches); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) []model.SectionProductBatches); ok {
+		r0 = rf(ctx, id)
 	} else {
-		r0 = ret.Get(0).(model.SectionProductBatches)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.SectionProductBatches)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -40,47 +48,35 @@ func (_m *MockISectionRepo) CountProductBatchesBySectionID(id int) (model.Sectio
 	return r0, r1
 }
 
-// CountProductBatchesSections provides a mock function with no fields
-func (_m *MockISectionRepo) CountProductBatchesSections() ([]model.SectionProductBatches, error) {
-	ret := _m.Called()
+// DecreaseCurrentCapacity provides a mock function with given fields: ctx, id, quantity
+func (_m *MockISectionRepo) DecreaseCurrentCapacity(ctx context.Context, id int, quantity int) error {
+	ret := _m.Called(ctx, id, quantity)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CountProductBatchesSections")
+		panic("no return value specified for DecreaseCurrentCapacity")
 	}
 
-	var r0 []model.SectionProductBatches
-	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]model.SectionProductBatches, error)); ok {
-		return rf()
-	}
-	if rf, ok := ret.Get(0).(func() []model.SectionProductBatches); ok {
-		r0 = rf()
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]model.SectionProductBatches)
-		}
-	}
-
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) error); ok {
+		r0 = rf(ctx, id, quantity)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// Delete provides a mock function with given fields: id
-func (_m *MockISectionRepo) Delete(id int) error {
-	ret := _m.Called(id)
+// Delete provides a mock function with given fields: ctx, id
+func (_m *MockISectionRepo) Delete(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Delete")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(int) error); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -88,39 +84,46 @@ func (_m *MockISectionRepo) Delete(id int) error {
 	return r0
 }
 
-// Get provides a mock function with no fields
-func (_m *MockISectionRepo) Get() ([]model.Section, error) {
-	ret := _m.Called()
+// Get provides a mock function with given fields: ctx, params
+func (_m *MockISectionRepo) Get(ctx context.Context, params model.ListParams) ([]model.Section, int, error) {
+	ret := _m.Called(ctx, params)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Get")
 	}
 
 	var r0 []model.Section
-	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]model.Section, error)); ok {
-		return rf()
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.ListParams) ([]model.Section, int, error)); ok {
+		return rf(ctx, params)
 	}
-	if rf, ok := ret.Get(0).(func() []model.Section); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context, model.ListParams) []model.Section); ok {
+		r0 = rf(ctx, params)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.Section)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context, model.ListParams) int); ok {
+		r1 = rf(ctx, params)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(int)
 	}
 
-	return r0, r1
+	if rf, ok := ret.Get(2).(func(context.Context, model.ListParams) error); ok {
+		r2 = rf(ctx, params)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
-// GetByID provides a mock function with given fields: id
-func (_m *MockISectionRepo) GetByID(id int) (model.Section, error) {
-	ret := _m.Called(id)
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *MockISectionRepo) GetByID(ctx context.Context, id int) (model.Section, error) {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetByID")
@@ -128,17 +131,17 @@ func (_m *MockISectionRepo) GetByID(id int) (model.Section, error) {
 
 	var r0 model.Section
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) (model.Section, error)); ok {
-		return rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) (model.Section, error)); ok {
+		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(int) model.Section); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) model.Section); ok {
+		r0 = rf(ctx, id)
 	} else {
 		r0 = ret.Get(0).(model.Section)
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -146,9 +149,57 @@ func (_m *MockISectionRepo) GetByID(id int) (model.Section, error) {
 	return r0, r1
 }
 
-// Post provides a mock function with given fields: section
-func (_m *MockISectionRepo) Post(section *model.Section) (model.Section, error) {
-	ret := _m.Called(section)
+// GetCapacityReport provides a mock function with given fields: ctx, nearFullRatio
+func (_m *MockISectionRepo) GetCapacityReport(ctx context.Context, nearFullRatio float64) ([]model.SectionCapacity, error) {
+	ret := _m.Called(ctx, nearFullRatio)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCapacityReport")
+	}
+
+	var r0 []model.SectionCapacity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, float64) ([]model.SectionCapacity, error)); ok {
+		return rf(ctx, nearFullRatio)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, float64) []model.SectionCapacity); ok {
+		r0 = rf(ctx, nearFullRatio)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.SectionCapacity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, float64) error); ok {
+		r1 = rf(ctx, nearFullRatio)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IncreaseCurrentCapacity provides a mock function with given fields: ctx, id, quantity
+func (_m *MockISectionRepo) IncreaseCurrentCapacity(ctx context.Context, id int, quantity int) error {
+	ret := _m.Called(ctx, id, quantity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IncreaseCurrentCapacity")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) error); ok {
+		r0 = rf(ctx, id, quantity)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Post provides a mock function with given fields: ctx, section
+func (_m *MockISectionRepo) Post(ctx context.Context, section *model.Section) (model.Section, error) {
+	ret := _m.Called(ctx, section)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Post")
@@ -156,17 +207,17 @@ func (_m *MockISectionRepo) Post(section *model.Section) (model.Section, error)
 
 	var r0 model.Section
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*model.Section) (model.Section, error)); ok {
-		return rf(section)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Section) (model.Section, error)); ok {
+		return rf(ctx, section)
 	}
-	if rf, ok := ret.Get(0).(func(*model.Section) model.Section); ok {
-		r0 = rf(section)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Section) model.Section); ok {
+		r0 = rf(ctx, section)
 	} else {
 		r0 = ret.Get(0).(model.Section)
 	}
 
-	if rf, ok := ret.Get(1).(func(*model.Section) error); ok {
-		r1 = rf(section)
+	if rf, ok := ret.Get(1).(func(context.Context, *model.Section) error); ok {
+		r1 = rf(ctx, section)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -174,9 +225,27 @@ func (_m *MockISectionRepo) Post(section *model.Section) (model.Section, error)
 	return r0, r1
 }
 
-// Update provides a mock function with given fields: id, section
-func (_m *MockISectionRepo) Update(id int, section *model.Section) (model.Section, error) {
-	ret := _m.Called(id, section)
+// SyncCurrentTemperature provides a mock function with given fields: ctx, id
+func (_m *MockISectionRepo) SyncCurrentTemperature(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SyncCurrentTemperature")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Update provides a mock function with given fields: ctx, id, section
+func (_m *MockISectionRepo) Update(ctx context.Context, id int, section *model.Section) (model.Section, error) {
+	ret := _m.Called(ctx, id, section)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Update")
@@ -184,17 +253,17 @@ func (_m *MockISectionRepo) Update(id int, section *model.Section) (model.Sectio
 
 	var r0 model.Section
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, *model.Section) (model.Section, error)); ok {
-		return rf(id, section)
+	if rf, ok := ret.Get(0).(func(context.Context, int, *model.Section) (model.Section, error)); ok {
+		return rf(ctx, id, section)
 	}
-	if rf, ok := ret.Get(0).(func(int, *model.Section) model.Section); ok {
-		r0 = rf(id, section)
+	if rf, ok := ret.Get(0).(func(context.Context, int, *model.Section) model.Section); ok {
+		r0 = rf(ctx, id, section)
 	} else {
 		r0 = ret.Get(0).(model.Section)
 	}
 
-	if rf, ok := ret.Get(1).(func(int, *model.Section) error); ok {
-		r1 = rf(id, section)
+	if rf, ok := ret.Get(1).(func(context.Context, int, *model.Section) error); ok {
+		r1 = rf(ctx, id, section)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -202,6 +271,26 @@ func (_m *MockISectionRepo) Update(id int, section *model.Section) (model.Sectio
 	return r0, r1
 }
 
+// WithTx provides a mock function with given fields: tx
+func (_m *MockISectionRepo) WithTx(tx *sql.Tx) interfaces.ISectionRepo {
+	ret := _m.Called(tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithTx")
+	}
+
+	var r0 interfaces.ISectionRepo
+	if rf, ok := ret.Get(0).(func(*sql.Tx) interfaces.ISectionRepo); ok {
+		r0 = rf(tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interfaces.ISectionRepo)
+		}
+	}
+
+	return r0
+}
+
 // NewMockISectionRepo creates a new instance of MockISectionRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockISectionRepo(t interface {
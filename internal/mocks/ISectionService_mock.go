@@ -3,8 +3,11 @@
 package mocks
 
 import (
-	model "github.com/maxwelbm/alkemy-g7.git/internal/model"
+	context "context"
+
 	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/maxwelbm/alkemy-g7.git/internal/model"
 )
 
 // MockISectionService is an autogenerated mock type for the ISectionService type
@@ -12,9 +15,9 @@ type MockISectionService struct {
 	mock.Mock
 }
 
-// CountProductBatchesBySectionID provides a mock function with given fields: id
-func (_m *MockISectionService) CountProductBatchesBySectionID(id int) (model.SectionProductBatches, error) {
-	ret := _m.Called(id)
+// CountProductBatchesBySectionID provides a mock function with given fields: ctx, id
+func (_m *MockISectionService) CountProductBatchesBySectionID(ctx context.Context, id int) (model.SectionProductBatches, error) {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CountProductBatchesBySectionID")
@@ -22,17 +25,17 @@ func (_m *MockISectionService) CountProductBatchesBySectionID(id int) (model.Sec
 
 	var r0 model.SectionProductBatches
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) (model.SectionProductBatches, error)); ok {
-		return rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) (model.SectionProductBatches, error)); ok {
+		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(int) model.SectionProductBatches); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) model.SectionProductBatches); ok {
+		r0 = rf(ctx, id)
 	} else {
 		r0 = ret.Get(0).(model.SectionProductBatches)
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -40,9 +43,9 @@ func (_m *MockISectionService) CountProductBatchesBySectionID(id int) (model.Sec
 	return r0, r1
 }
 
-// CountProductBatchesSections provides a mock function with no fields
-func (_m *MockISectionService) CountProductBatchesSections() ([]model.SectionProductBatches, error) {
-	ret := _m.Called()
+// CountProductBatchesSections provides a mock function with given fields: ctx
+func (_m *MockISectionService) CountProductBatchesSections(ctx context.Context) ([]model.SectionProductBatches, error) {
+	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CountProductBatchesSections")
@@ -50,19 +53,19 @@ func (_m *MockISectionService) CountProductBatchesSections() ([]model.SectionPro
 
 	var r0 []model.SectionProductBatches
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]model.SectionProductBatches, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]model.SectionProductBatches, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []model.SectionProductBatches); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []model.SectionProductBatches); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.SectionProductBatches)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -70,17 +73,17 @@ func (_m *MockISectionService) CountProductBatchesSections() ([]model.SectionPro
 	return r0, r1
 }
 
-// Delete provides a mock function with given fields: id
-func (_m *MockISectionService) Delete(id int) error {
-	ret := _m.Called(id)
+// Delete provides a mock function with given fields: ctx, id
+func (_m *MockISectionService) Delete(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Delete")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(int) error); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -88,39 +91,46 @@ func (_m *MockISectionService) Delete(id int) error {
 	return r0
 }
 
-// Get provides a mock function with no fields
-func (_m *MockISectionService) Get() ([]model.Section, error) {
-	ret := _m.Called()
+// Get provides a mock function with given fields: ctx, params
+func (_m *MockISectionService) Get(ctx context.Context, params model.ListParams) ([]model.Section, int, error) {
+	ret := _m.Called(ctx, params)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Get")
 	}
 
 	var r0 []model.Section
-	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]model.Section, error)); ok {
-		return rf()
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.ListParams) ([]model.Section, int, error)); ok {
+		return rf(ctx, params)
 	}
-	if rf, ok := ret.Get(0).(func() []model.Section); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context, model.ListParams) []model.Section); ok {
+		r0 = rf(ctx, params)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.Section)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context, model.ListParams) int); ok {
+		r1 = rf(ctx, params)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(int)
 	}
 
-	return r0, r1
+	if rf, ok := ret.Get(2).(func(context.Context, model.ListParams) error); ok {
+		r2 = rf(ctx, params)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
-// GetByID provides a mock function with given fields: id
-func (_m *MockISectionService) GetByID(id int) (model.Section, error) {
-	ret := _m.Called(id)
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *MockISectionService) GetByID(ctx context.Context, id int) (model.Section, error) {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetByID")
@@ -128,17 +138,47 @@ func (_m *MockISectionService) GetByID(id int) (model.Section, error) {
 
 	var r0 model.Section
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) (model.Section, error)); ok {
-		return rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) (model.Section, error)); ok {
+		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(int) model.Section); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) model.Section); ok {
+		r0 = rf(ctx, id)
 	} else {
 		r0 = ret.Get(0).(model.Section)
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCapacityReport provides a mock function with given fields: ctx, nearFullRatio
+func (_m *MockISectionService) GetCapacityReport(ctx context.Context, nearFullRatio float64) ([]model.SectionCapacity, error) {
+	ret := _m.Called(ctx, nearFullRatio)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCapacityReport")
+	}
+
+	var r0 []model.SectionCapacity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, float64) ([]model.SectionCapacity, error)); ok {
+		return rf(ctx, nearFullRatio)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, float64) []model.SectionCapacity); ok {
+		r0 = rf(ctx, nearFullRatio)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.SectionCapacity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, float64) error); ok {
+		r1 = rf(ctx, nearFullRatio)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -146,9 +186,9 @@ func (_m *MockISectionService) GetByID(id int) (model.Section, error) {
 	return r0, r1
 }
 
-// Post provides a mock function with given fields: section
-func (_m *MockISectionService) Post(section *model.Section) (model.Section, error) {
-	ret := _m.Called(section)
+// Post provides a mock function with given fields: ctx, section
+func (_m *MockISectionService) Post(ctx context.Context, section *model.Section) (model.Section, error) {
+	ret := _m.Called(ctx, section)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Post")
@@ -156,17 +196,17 @@ func (_m *MockISectionService) Post(section *model.Section) (model.Section, erro
 
 	var r0 model.Section
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*model.Section) (model.Section, error)); ok {
-		return rf(section)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Section) (model.Section, error)); ok {
+		return rf(ctx, section)
 	}
-	if rf, ok := ret.Get(0).(func(*model.Section) model.Section); ok {
-		r0 = rf(section)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Section) model.Section); ok {
+		r0 = rf(ctx, section)
 	} else {
 		r0 = ret.Get(0).(model.Section)
 	}
 
-	if rf, ok := ret.Get(1).(func(*model.Section) error); ok {
-		r1 = rf(section)
+	if rf, ok := ret.Get(1).(func(context.Context, *model.Section) error); ok {
+		r1 = rf(ctx, section)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -174,9 +214,9 @@ func (_m *MockISectionService) Post(section *model.Section) (model.Section, erro
 	return r0, r1
 }
 
-// Update provides a mock function with given fields: id, section
-func (_m *MockISectionService) Update(id int, section *model.Section) (model.Section, error) {
-	ret := _m.Called(id, section)
+// Update provides a mock function with given fields: ctx, id, section
+func (_m *MockISectionService) Update(ctx context.Context, id int, section *model.Section) (model.Section, error) {
+	ret := _m.Called(ctx, id, section)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Update")
@@ -184,17 +224,17 @@ func (_m *MockISectionService) Update(id int, section *model.Section) (model.Sec
 
 	var r0 model.Section
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, *model.Section) (model.Section, error)); ok {
-		return rf(id, section)
+	if rf, ok := ret.Get(0).(func(context.Context, int, *model.Section) (model.Section, error)); ok {
+		return rf(ctx, id, section)
 	}
-	if rf, ok := ret.Get(0).(func(int, *model.Section) model.Section); ok {
-		r0 = rf(id, section)
+	if rf, ok := ret.Get(0).(func(context.Context, int, *model.Section) model.Section); ok {
+		r0 = rf(ctx, id, section)
 	} else {
 		r0 = ret.Get(0).(model.Section)
 	}
 
-	if rf, ok := ret.Get(1).(func(int, *model.Section) error); ok {
-		r1 = rf(id, section)
+	if rf, ok := ret.Get(1).(func(context.Context, int, *model.Section) error); ok {
+		r1 = rf(ctx, id, section)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3,8 +3,14 @@
 package mocks
 
 import (
-	model "github.com/maxwelbm/alkemy-g7.git/internal/model"
+	context "context"
+
+	interfaces "github.com/maxwelbm/alkemy-g7.git/internal/repository/interfaces"
 	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/maxwelbm/alkemy-g7.git/internal/model"
+
+	sql "database/sql"
 )
 
 // MockISellerRepo is an autogenerated mock type for the ISellerRepo type
@@ -12,17 +18,17 @@ type MockISellerRepo struct {
 	mock.Mock
 }
 
-// Delete provides a mock function with given fields: id
-func (_m *MockISellerRepo) Delete(id int) error {
-	ret := _m.Called(id)
+// Delete provides a mock function with given fields: ctx, id
+func (_m *MockISellerRepo) Delete(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Delete")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(int) error); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -30,39 +36,46 @@ func (_m *MockISellerRepo) Delete(id int) error {
 	return r0
 }
 
-// Get provides a mock function with no fields
-func (_m *MockISellerRepo) Get() ([]model.Seller, error) {
-	ret := _m.Called()
+// Get provides a mock function with given fields: ctx, params
+func (_m *MockISellerRepo) Get(ctx context.Context, params model.ListParams) ([]model.Seller, int, error) {
+	ret := _m.Called(ctx, params)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Get")
 	}
 
 	var r0 []model.Seller
-	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]model.Seller, error)); ok {
-		return rf()
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.ListParams) ([]model.Seller, int, error)); ok {
+		return rf(ctx, params)
 	}
-	if rf, ok := ret.Get(0).(func() []model.Seller); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context, model.ListParams) []model.Seller); ok {
+		r0 = rf(ctx, params)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.Seller)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context, model.ListParams) int); ok {
+		r1 = rf(ctx, params)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(int)
 	}
 
-	return r0, r1
+	if rf, ok := ret.Get(2).(func(context.Context, model.ListParams) error); ok {
+		r2 = rf(ctx, params)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
-// GetByID provides a mock function with given fields: id
-func (_m *MockISellerRepo) GetByID(id int) (model.Seller, error) {
-	ret := _m.Called(id)
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *MockISellerRepo) GetByID(ctx context.Context, id int) (model.Seller, error) {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetByID")
@@ -70,17 +83,17 @@ func (_m *MockISellerRepo) GetByID(id int) (model.Seller, error) {
 
 	var r0 model.Seller
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) (model.Seller, error)); ok {
-		return rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) (model.Seller, error)); ok {
+		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(int) model.Seller); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) model.Seller); ok {
+		r0 = rf(ctx, id)
 	} else {
 		r0 = ret.Get(0).(model.Seller)
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -88,9 +101,9 @@ func (_m *MockISellerRepo) GetByID(id int) (model.Seller, error) {
 	return r0, r1
 }
 
-// Patch provides a mock function with given fields: id, seller
-func (_m *MockISellerRepo) Patch(id int, seller *model.Seller) (model.Seller, error) {
-	ret := _m.Called(id, seller)
+// Patch provides a mock function with given fields: ctx, id, seller
+func (_m *MockISellerRepo) Patch(ctx context.Context, id int, seller *model.Seller) (model.Seller, error) {
+	ret := _m.Called(ctx, id, seller)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Patch")
@@ -98,17 +111,17 @@ func (_m *MockISellerRepo) Patch(id int, seller *model.Seller) (model.Seller, er
 
 	var r0 model.Seller
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, *model.Seller) (model.Seller, error)); ok {
-		return rf(id, seller)
+	if rf, ok := ret.Get(0).(func(context.Context, int, *model.Seller) (model.Seller, error)); ok {
+		return rf(ctx, id, seller)
 	}
-	if rf, ok := ret.Get(0).(func(int, *model.Seller) model.Seller); ok {
-		r0 = rf(id, seller)
+	if rf, ok := ret.Get(0).(func(context.Context, int, *model.Seller) model.Seller); ok {
+		r0 = rf(ctx, id, seller)
 	} else {
 		r0 = ret.Get(0).(model.Seller)
 	}
 
-	if rf, ok := ret.Get(1).(func(int, *model.Seller) error); ok {
-		r1 = rf(id, seller)
+	if rf, ok := ret.Get(1).(func(context.Context, int, *model.Seller) error); ok {
+		r1 = rf(ctx, id, seller)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -116,9 +129,9 @@ func (_m *MockISellerRepo) Patch(id int, seller *model.Seller) (model.Seller, er
 	return r0, r1
 }
 
-// Post provides a mock function with given fields: seller
-func (_m *MockISellerRepo) Post(seller *model.Seller) (model.Seller, error) {
-	ret := _m.Called(seller)
+// Post provides a mock function with given fields: ctx, seller
+func (_m *MockISellerRepo) Post(ctx context.Context, seller *model.Seller) (model.Seller, error) {
+	ret := _m.Called(ctx, seller)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Post")
@@ -126,17 +139,17 @@ func (_m *MockISellerRepo) Post(seller *model.Seller) (model.Seller, error) {
 
 	var r0 model.Seller
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*model.Seller) (model.Seller, error)); ok {
-		return rf(seller)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Seller) (model.Seller, error)); ok {
+		return rf(ctx, seller)
 	}
-	if rf, ok := ret.Get(0).(func(*model.Seller) model.Seller); ok {
-		r0 = rf(seller)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Seller) model.Seller); ok {
+		r0 = rf(ctx, seller)
 	} else {
 		r0 = ret.Get(0).(model.Seller)
 	}
 
-	if rf, ok := ret.Get(1).(func(*model.Seller) error); ok {
-		r1 = rf(seller)
+	if rf, ok := ret.Get(1).(func(context.Context, *model.Seller) error); ok {
+		r1 = rf(ctx, seller)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -144,6 +157,26 @@ func (_m *MockISellerRepo) Post(seller *model.Seller) (model.Seller, error) {
 	return r0, r1
 }
 
+// WithTx provides a mock function with given fields: tx
+func (_m *MockISellerRepo) WithTx(tx *sql.Tx) interfaces.ISellerRepo {
+	ret := _m.Called(tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithTx")
+	}
+
+	var r0 interfaces.ISellerRepo
+	if rf, ok := ret.Get(0).(func(*sql.Tx) interfaces.ISellerRepo); ok {
+		r0 = rf(tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interfaces.ISellerRepo)
+		}
+	}
+
+	return r0
+}
+
 // NewMockISellerRepo creates a new instance of MockISellerRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockISellerRepo(t interface {
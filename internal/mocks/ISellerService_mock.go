@@ -3,8 +3,11 @@
 package mocks
 
 import (
-	model "github.com/maxwelbm/alkemy-g7.git/internal/model"
+	context "context"
+
 	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/maxwelbm/alkemy-g7.git/internal/model"
 )
 
 // MockISellerService is an autogenerated mock type for the ISellerService type
@@ -12,9 +15,9 @@ type MockISellerService struct {
 	mock.Mock
 }
 
-// CreateSeller provides a mock function with given fields: seller
-func (_m *MockISellerService) CreateSeller(seller *model.Seller) (model.Seller, error) {
-	ret := _m.Called(seller)
+// CreateSeller provides a mock function with given fields: ctx, seller
+func (_m *MockISellerService) CreateSeller(ctx context.Context, seller *model.Seller) (model.Seller, error) {
+	ret := _m.Called(ctx, seller)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateSeller")
@@ -22,17 +25,17 @@ func (_m *MockISellerService) CreateSeller(seller *model.Seller) (model.Seller,
 
 	var r0 model.Seller
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*model.Seller) (model.Seller, error)); ok {
-		return rf(seller)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Seller) (model.Seller, error)); ok {
+		return rf(ctx, seller)
 	}
-	if rf, ok := ret.Get(0).(func(*model.Seller) model.Seller); ok {
-		r0 = rf(seller)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Seller) model.Seller); ok {
+		r0 = rf(ctx, seller)
 	} else {
 		r0 = ret.Get(0).(model.Seller)
 	}
 
-	if rf, ok := ret.Get(1).(func(*model.Seller) error); ok {
-		r1 = rf(seller)
+	if rf, ok := ret.Get(1).(func(context.Context, *model.Seller) error); ok {
+		r1 = rf(ctx, seller)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -40,17 +43,17 @@ func (_m *MockISellerService) CreateSeller(seller *model.Seller) (model.Seller,
 	return r0, r1
 }
 
-// DeleteSeller provides a mock function with given fields: id
-func (_m *MockISellerService) DeleteSeller(id int) error {
-	ret := _m.Called(id)
+// DeleteSeller provides a mock function with given fields: ctx, id
+func (_m *MockISellerService) DeleteSeller(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeleteSeller")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(int) error); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -58,39 +61,46 @@ func (_m *MockISellerService) DeleteSeller(id int) error {
 	return r0
 }
 
-// GetAll provides a mock function with no fields
-func (_m *MockISellerService) GetAll() ([]model.Seller, error) {
-	ret := _m.Called()
+// GetAll provides a mock function with given fields: ctx, params
+func (_m *MockISellerService) GetAll(ctx context.Context, params model.ListParams) ([]model.Seller, int, error) {
+	ret := _m.Called(ctx, params)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetAll")
 	}
 
 	var r0 []model.Seller
-	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]model.Seller, error)); ok {
-		return rf()
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.ListParams) ([]model.Seller, int, error)); ok {
+		return rf(ctx, params)
 	}
-	if rf, ok := ret.Get(0).(func() []model.Seller); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context, model.ListParams) []model.Seller); ok {
+		r0 = rf(ctx, params)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.Seller)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context, model.ListParams) int); ok {
+		r1 = rf(ctx, params)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(int)
 	}
 
-	return r0, r1
+	if rf, ok := ret.Get(2).(func(context.Context, model.ListParams) error); ok {
+		r2 = rf(ctx, params)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
-// GetByID provides a mock function with given fields: id
-func (_m *MockISellerService) GetByID(id int) (model.Seller, error) {
-	ret := _m.Called(id)
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *MockISellerService) GetByID(ctx context.Context, id int) (model.Seller, error) {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetByID")
@@ -98,17 +108,17 @@ func (_m *MockISellerService) GetByID(id int) (model.Seller, error) {
 
 	var r0 model.Seller
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) (model.Seller, error)); ok {
-		return rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) (model.Seller, error)); ok {
+		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(int) model.Seller); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) model.Seller); ok {
+		r0 = rf(ctx, id)
 	} else {
 		r0 = ret.Get(0).(model.Seller)
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -116,9 +126,9 @@ func (_m *MockISellerService) GetByID(id int) (model.Seller, error) {
 	return r0, r1
 }
 
-// UpdateSeller provides a mock function with given fields: id, seller
-func (_m *MockISellerService) UpdateSeller(id int, seller *model.Seller) (model.Seller, error) {
-	ret := _m.Called(id, seller)
+// UpdateSeller provides a mock function with given fields: ctx, id, seller
+func (_m *MockISellerService) UpdateSeller(ctx context.Context, id int, seller *model.Seller) (model.Seller, error) {
+	ret := _m.Called(ctx, id, seller)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateSeller")
@@ -126,17 +136,17 @@ func (_m *MockISellerService) UpdateSeller(id int, seller *model.Seller) (model.
 
 	var r0 model.Seller
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, *model.Seller) (model.Seller, error)); ok {
-		return rf(id, seller)
+	if rf, ok := ret.Get(0).(func(context.Context, int, *model.Seller) (model.Seller, error)); ok {
+		return rf(ctx, id, seller)
 	}
-	if rf, ok := ret.Get(0).(func(int, *model.Seller) model.Seller); ok {
-		r0 = rf(id, seller)
+	if rf, ok := ret.Get(0).(func(context.Context, int, *model.Seller) model.Seller); ok {
+		r0 = rf(ctx, id, seller)
 	} else {
 		r0 = ret.Get(0).(model.Seller)
 	}
 
-	if rf, ok := ret.Get(1).(func(int, *model.Seller) error); ok {
-		r1 = rf(id, seller)
+	if rf, ok := ret.Get(1).(func(context.Context, int, *model.Seller) error); ok {
+		r1 = rf(ctx, id, seller)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -0,0 +1,142 @@
+// Code generated by mockery v2.52.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/maxwelbm/alkemy-g7.git/internal/model"
+)
+
+// MockIShipmentService is an autogenerated mock type for the IShipmentService type
+type MockIShipmentService struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, shipment
+func (_m *MockIShipmentService) Create(ctx context.Context, shipment model.ShipmentCreate) (model.Shipment, error) {
+	ret := _m.Called(ctx, shipment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 model.Shipment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.ShipmentCreate) (model.Shipment, error)); ok {
+		return rf(ctx, shipment)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.ShipmentCreate) model.Shipment); ok {
+		r0 = rf(ctx, shipment)
+	} else {
+		r0 = ret.Get(0).(model.Shipment)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.ShipmentCreate) error); ok {
+		r1 = rf(ctx, shipment)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *MockIShipmentService) GetByID(ctx context.Context, id int) (model.Shipment, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 model.Shipment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (model.Shipment, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) model.Shipment); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(model.Shipment)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTracking provides a mock function with given fields: ctx, purchaseOrderID
+func (_m *MockIShipmentService) GetTracking(ctx context.Context, purchaseOrderID int) (model.PurchaseOrderTracking, error) {
+	ret := _m.Called(ctx, purchaseOrderID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTracking")
+	}
+
+	var r0 model.PurchaseOrderTracking
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (model.PurchaseOrderTracking, error)); ok {
+		return rf(ctx, purchaseOrderID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) model.PurchaseOrderTracking); ok {
+		r0 = rf(ctx, purchaseOrderID)
+	} else {
+		r0 = ret.Get(0).(model.PurchaseOrderTracking)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, purchaseOrderID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateStatus provides a mock function with given fields: ctx, id, update
+func (_m *MockIShipmentService) UpdateStatus(ctx context.Context, id int, update model.ShipmentStatusUpdate) (model.Shipment, error) {
+	ret := _m.Called(ctx, id, update)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateStatus")
+	}
+
+	var r0 model.Shipment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, model.ShipmentStatusUpdate) (model.Shipment, error)); ok {
+		return rf(ctx, id, update)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, model.ShipmentStatusUpdate) model.Shipment); ok {
+		r0 = rf(ctx, id, update)
+	} else {
+		r0 = ret.Get(0).(model.Shipment)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, model.ShipmentStatusUpdate) error); ok {
+		r1 = rf(ctx, id, update)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewMockIShipmentService creates a new instance of MockIShipmentService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIShipmentService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIShipmentService {
+	mock := &MockIShipmentService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
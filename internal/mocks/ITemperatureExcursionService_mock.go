@@ -0,0 +1,128 @@
+// Code generated by mockery v2.52.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/maxwelbm/alkemy-g7.git/internal/model"
+
+	time "time"
+)
+
+// MockITemperatureExcursionService is an autogenerated mock type for the ITemperatureExcursionService type
+type MockITemperatureExcursionService struct {
+	mock.Mock
+}
+
+// EvaluateBatch provides a mock function with given fields: ctx, batchID, temperature, at
+func (_m *MockITemperatureExcursionService) EvaluateBatch(ctx context.Context, batchID int, temperature float64, at time.Time) error {
+	ret := _m.Called(ctx, batchID, temperature, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EvaluateBatch")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, float64, time.Time) error); ok {
+		r0 = rf(ctx, batchID, temperature, at)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EvaluateSection provides a mock function with given fields: ctx, sectionID, readings
+func (_m *MockITemperatureExcursionService) EvaluateSection(ctx context.Context, sectionID int, readings []model.TemperatureReading) error {
+	ret := _m.Called(ctx, sectionID, readings)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EvaluateSection")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, []model.TemperatureReading) error); ok {
+		r0 = rf(ctx, sectionID, readings)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetBySection provides a mock function with given fields: ctx, sectionID, status
+func (_m *MockITemperatureExcursionService) GetBySection(ctx context.Context, sectionID int, status string) ([]model.TemperatureExcursion, error) {
+	ret := _m.Called(ctx, sectionID, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBySection")
+	}
+
+	var r0 []model.TemperatureExcursion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) ([]model.TemperatureExcursion, error)); ok {
+		return rf(ctx, sectionID, status)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) []model.TemperatureExcursion); ok {
+		r0 = rf(ctx, sectionID, status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.TemperatureExcursion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, string) error); ok {
+		r1 = rf(ctx, sectionID, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetReport provides a mock function with given fields: ctx, filter
+func (_m *MockITemperatureExcursionService) GetReport(ctx context.Context, filter model.ExcursionReportFilter) ([]model.SectionExcursionReport, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReport")
+	}
+
+	var r0 []model.SectionExcursionReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.ExcursionReportFilter) ([]model.SectionExcursionReport, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.ExcursionReportFilter) []model.SectionExcursionReport); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.SectionExcursionReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.ExcursionReportFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewMockITemperatureExcursionService creates a new instance of MockITemperatureExcursionService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockITemperatureExcursionService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockITemperatureExcursionService {
+	mock := &MockITemperatureExcursionService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
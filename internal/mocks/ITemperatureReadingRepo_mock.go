@@ -0,0 +1,141 @@
+// Code generated by mockery v2.52.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	interfaces "github.com/maxwelbm/alkemy-g7.git/internal/repository/interfaces"
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/maxwelbm/alkemy-g7.git/internal/model"
+
+	sql "database/sql"
+
+	time "time"
+)
+
+// MockITemperatureReadingRepo is an autogenerated mock type for the ITemperatureReadingRepo type
+type MockITemperatureReadingRepo struct {
+	mock.Mock
+}
+
+// BulkInsert provides a mock function with given fields: ctx, sectionID, readings
+func (_m *MockITemperatureReadingRepo) BulkInsert(ctx context.Context, sectionID int, readings []model.TemperatureReading) (int, error) {
+	ret := _m.Called(ctx, sectionID, readings)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BulkInsert")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, []model.TemperatureReading) (int, error)); ok {
+		return rf(ctx, sectionID, readings)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, []model.TemperatureReading) int); ok {
+		r0 = rf(ctx, sectionID, readings)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, []model.TemperatureReading) error); ok {
+		r1 = rf(ctx, sectionID, readings)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAggregates provides a mock function with given fields: ctx, query
+func (_m *MockITemperatureReadingRepo) GetAggregates(ctx context.Context, query model.TemperatureQuery) ([]model.TemperatureAggregate, error) {
+	ret := _m.Called(ctx, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAggregates")
+	}
+
+	var r0 []model.TemperatureAggregate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.TemperatureQuery) ([]model.TemperatureAggregate, error)); ok {
+		return rf(ctx, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.TemperatureQuery) []model.TemperatureAggregate); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.TemperatureAggregate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.TemperatureQuery) error); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLatestRecordedAt provides a mock function with given fields: ctx, sectionID
+func (_m *MockITemperatureReadingRepo) GetLatestRecordedAt(ctx context.Context, sectionID int) (time.Time, error) {
+	ret := _m.Called(ctx, sectionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestRecordedAt")
+	}
+
+	var r0 time.Time
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (time.Time, error)); ok {
+		return rf(ctx, sectionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) time.Time); ok {
+		r0 = rf(ctx, sectionID)
+	} else {
+		r0 = ret.Get(0).(time.Time)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, sectionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WithTx provides a mock function with given fields: tx
+func (_m *MockITemperatureReadingRepo) WithTx(tx *sql.Tx) interfaces.ITemperatureReadingRepo {
+	ret := _m.Called(tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithTx")
+	}
+
+	var r0 interfaces.ITemperatureReadingRepo
+	if rf, ok := ret.Get(0).(func(*sql.Tx) interfaces.ITemperatureReadingRepo); ok {
+		r0 = rf(tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interfaces.ITemperatureReadingRepo)
+		}
+	}
+
+	return r0
+}
+
+// NewMockITemperatureReadingRepo creates a new instance of MockITemperatureReadingRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockITemperatureReadingRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockITemperatureReadingRepo {
+	mock := &MockITemperatureReadingRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
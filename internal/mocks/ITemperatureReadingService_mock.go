@@ -0,0 +1,88 @@
+// Code generated by mockery v2.52.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/maxwelbm/alkemy-g7.git/internal/model"
+)
+
+// MockITemperatureReadingService is an autogenerated mock type for the ITemperatureReadingService type
+type MockITemperatureReadingService struct {
+	mock.Mock
+}
+
+// GetAggregates provides a mock function with given fields: ctx, query
+func (_m *MockITemperatureReadingService) GetAggregates(ctx context.Context, query model.TemperatureQuery) ([]model.TemperatureAggregate, error) {
+	ret := _m.Called(ctx, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAggregates")
+	}
+
+	var r0 []model.TemperatureAggregate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.TemperatureQuery) ([]model.TemperatureAggregate, error)); ok {
+		return rf(ctx, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.TemperatureQuery) []model.TemperatureAggregate); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.TemperatureAggregate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.TemperatureQuery) error); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Ingest provides a mock function with given fields: ctx, sectionID, readings
+func (_m *MockITemperatureReadingService) Ingest(ctx context.Context, sectionID int, readings []model.TemperatureReading) (model.TemperatureIngestResult, error) {
+	ret := _m.Called(ctx, sectionID, readings)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Ingest")
+	}
+
+	var r0 model.TemperatureIngestResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, []model.TemperatureReading) (model.TemperatureIngestResult, error)); ok {
+		return rf(ctx, sectionID, readings)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, []model.TemperatureReading) model.TemperatureIngestResult); ok {
+		r0 = rf(ctx, sectionID, readings)
+	} else {
+		r0 = ret.Get(0).(model.TemperatureIngestResult)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, []model.TemperatureReading) error); ok {
+		r1 = rf(ctx, sectionID, readings)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewMockITemperatureReadingService creates a new instance of MockITemperatureReadingService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockITemperatureReadingService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockITemperatureReadingService {
+	mock := &MockITemperatureReadingService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
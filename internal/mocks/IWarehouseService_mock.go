@@ -3,8 +3,11 @@
 package mocks
 
 import (
-	model "github.com/maxwelbm/alkemy-g7.git/internal/model"
+	context "context"
+
 	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/maxwelbm/alkemy-g7.git/internal/model"
 )
 
 // MockIWarehouseService is an autogenerated mock type for the IWarehouseService type
@@ -12,17 +15,17 @@ type MockIWarehouseService struct {
 	mock.Mock
 }
 
-// DeleteByIDWareHouse provides a mock function with given fields: id
-func (_m *MockIWarehouseService) DeleteByIDWareHouse(id int) error {
-	ret := _m.Called(id)
+// DeleteByIDWareHouse provides a mock function with given fields: ctx, id
+func (_m *MockIWarehouseService) DeleteByIDWareHouse(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeleteByIDWareHouse")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(int) error); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -30,39 +33,46 @@ func (_m *MockIWarehouseService) DeleteByIDWareHouse(id int) error {
 	return r0
 }
 
-// GetAllWareHouse provides a mock function with no fields
-func (_m *MockIWarehouseService) GetAllWareHouse() ([]model.WareHouse, error) {
-	ret := _m.Called()
+// GetAllWareHouse provides a mock function with given fields: ctx, params
+func (_m *MockIWarehouseService) GetAllWareHouse(ctx context.Context, params model.ListParams) ([]model.WareHouse, int, error) {
+	ret := _m.Called(ctx, params)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetAllWareHouse")
 	}
 
 	var r0 []model.WareHouse
-	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]model.WareHouse, error)); ok {
-		return rf()
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.ListParams) ([]model.WareHouse, int, error)); ok {
+		return rf(ctx, params)
 	}
-	if rf, ok := ret.Get(0).(func() []model.WareHouse); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context, model.ListParams) []model.WareHouse); ok {
+		r0 = rf(ctx, params)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.WareHouse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context, model.ListParams) int); ok {
+		r1 = rf(ctx, params)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(int)
 	}
 
-	return r0, r1
+	if rf, ok := ret.Get(2).(func(context.Context, model.ListParams) error); ok {
+		r2 = rf(ctx, params)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
-// GetByIDWareHouse provides a mock function with given fields: id
-func (_m *MockIWarehouseService) GetByIDWareHouse(id int) (model.WareHouse, error) {
-	ret := _m.Called(id)
+// GetByIDWareHouse provides a mock function with given fields: ctx, id
+func (_m *MockIWarehouseService) GetByIDWareHouse(ctx context.Context, id int) (model.WareHouse, error) {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetByIDWareHouse")
@@ -70,17 +80,105 @@ func (_m *MockIWarehouseService) GetByIDWareHouse(id int) (model.WareHouse, erro
 
 	var r0 model.WareHouse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int) (model.WareHouse, error)); ok {
-		return rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) (model.WareHouse, error)); ok {
+		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(int) model.WareHouse); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(context.Context, int) model.WareHouse); ok {
+		r0 = rf(ctx, id)
 	} else {
 		r0 = ret.Get(0).(model.WareHouse)
 	}
 
-	if rf, ok := ret.Get(1).(func(int) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCapacityReportWareHouse provides a mock function with given fields: ctx, id
+func (_m *MockIWarehouseService) GetCapacityReportWareHouse(ctx context.Context, id int) ([]model.WareHouseCapacity, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCapacityReportWareHouse")
+	}
+
+	var r0 []model.WareHouseCapacity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]model.WareHouseCapacity, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []model.WareHouseCapacity); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.WareHouseCapacity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetInventoryWareHouse provides a mock function with given fields: ctx, id
+func (_m *MockIWarehouseService) GetInventoryWareHouse(ctx context.Context, id int) (model.WareHouseInventory, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInventoryWareHouse")
+	}
+
+	var r0 model.WareHouseInventory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (model.WareHouseInventory, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) model.WareHouseInventory); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(model.WareHouseInventory)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetNearestWareHouses provides a mock function with given fields: ctx, params
+func (_m *MockIWarehouseService) GetNearestWareHouses(ctx context.Context, params model.NearestWareHouseParams) ([]model.NearestWareHouse, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNearestWareHouses")
+	}
+
+	var r0 []model.NearestWareHouse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.NearestWareHouseParams) ([]model.NearestWareHouse, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.NearestWareHouseParams) []model.NearestWareHouse); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.NearestWareHouse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.NearestWareHouseParams) error); ok {
+		r1 = rf(ctx, params)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -88,9 +186,9 @@ func (_m *MockIWarehouseService) GetByIDWareHouse(id int) (model.WareHouse, erro
 	return r0, r1
 }
 
-// PostWareHouse provides a mock function with given fields: warehouse
-func (_m *MockIWarehouseService) PostWareHouse(warehouse model.WareHouse) (model.WareHouse, error) {
-	ret := _m.Called(warehouse)
+// PostWareHouse provides a mock function with given fields: ctx, warehouse
+func (_m *MockIWarehouseService) PostWareHouse(ctx context.Context, warehouse model.WareHouse) (model.WareHouse, error) {
+	ret := _m.Called(ctx, warehouse)
 
 	if len(ret) == 0 {
 		panic("no return value specified for PostWareHouse")
@@ -98,17 +196,17 @@ func (_m *MockIWarehouseService) PostWareHouse(warehouse model.WareHouse) (model
 
 	var r0 model.WareHouse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(model.WareHouse) (model.WareHouse, error)); ok {
-		return rf(warehouse)
+	if rf, ok := ret.Get(0).(func(context.Context, model.WareHouse) (model.WareHouse, error)); ok {
+		return rf(ctx, warehouse)
 	}
-	if rf, ok := ret.Get(0).(func(model.WareHouse) model.WareHouse); ok {
-		r0 = rf(warehouse)
+	if rf, ok := ret.Get(0).(func(context.Context, model.WareHouse) model.WareHouse); ok {
+		r0 = rf(ctx, warehouse)
 	} else {
 		r0 = ret.Get(0).(model.WareHouse)
 	}
 
-	if rf, ok := ret.Get(1).(func(model.WareHouse) error); ok {
-		r1 = rf(warehouse)
+	if rf, ok := ret.Get(1).(func(context.Context, model.WareHouse) error); ok {
+		r1 = rf(ctx, warehouse)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -116,9 +214,9 @@ func (_m *MockIWarehouseService) PostWareHouse(warehouse model.WareHouse) (model
 	return r0, r1
 }
 
-// UpdateWareHouse provides a mock function with given fields: id, warehouse
-func (_m *MockIWarehouseService) UpdateWareHouse(id int, warehouse model.WareHouse) (model.WareHouse, error) {
-	ret := _m.Called(id, warehouse)
+// UpdateWareHouse provides a mock function with given fields: ctx, id, warehouse
+func (_m *MockIWarehouseService) UpdateWareHouse(ctx context.Context, id int, warehouse model.WareHouse) (model.WareHouse, error) {
+	ret := _m.Called(ctx, id, warehouse)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateWareHouse")
@@ -126,17 +224,17 @@ func (_m *MockIWarehouseService) UpdateWareHouse(id int, warehouse model.WareHou
 
 	var r0 model.WareHouse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, model.WareHouse) (model.WareHouse, error)); ok {
-		return rf(id, warehouse)
+	if rf, ok := ret.Get(0).(func(context.Context, int, model.WareHouse) (model.WareHouse, error)); ok {
+		return rf(ctx, id, warehouse)
 	}
-	if rf, ok := ret.Get(0).(func(int, model.WareHouse) model.WareHouse); ok {
-		r0 = rf(id, warehouse)
+	if rf, ok := ret.Get(0).(func(context.Context, int, model.WareHouse) model.WareHouse); ok {
+		r0 = rf(ctx, id, warehouse)
 	} else {
 		r0 = ret.Get(0).(model.WareHouse)
 	}
 
-	if rf, ok := ret.Get(1).(func(int, model.WareHouse) error); ok {
-		r1 = rf(id, warehouse)
+	if rf, ok := ret.Get(1).(func(context.Context, int, model.WareHouse) error); ok {
+		r1 = rf(ctx, id, warehouse)
 	} else {
 		r1 = ret.Error(1)
 	}
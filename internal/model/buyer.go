@@ -12,6 +12,22 @@ type Buyer struct {
 	LastName     string `json:"last_name" example:"Doe"`
 }
 
+// BuyerListOptions are the filters and sort keys accepted by the buyers list endpoint.
+var BuyerListOptions = ListOptions{
+	Filters: map[string]string{
+		"card_number_id": "card_number_id",
+		"first_name":     "first_name",
+		"last_name":      "last_name",
+	},
+	Sorts: map[string]string{
+		"id":             "id",
+		"card_number_id": "card_number_id",
+		"first_name":     "first_name",
+		"last_name":      "last_name",
+	},
+	DefaultSort: "id",
+}
+
 type BuyerPurchaseOrder struct {
 	ID                  int    `json:"id"`
 	CardNumberID        string `json:"card_number_id"`
@@ -14,6 +14,20 @@ type Carries struct {
 	LocalityID  int    `json:"locality_id"`
 }
 
+// CarrierListOptions are the filters and sort keys accepted by the carriers list endpoint.
+var CarrierListOptions = ListOptions{
+	Filters: map[string]string{
+		"locality_id": "`locality_id`",
+	},
+	Sorts: map[string]string{
+		"id":           "`id`",
+		"cid":          "`cid`",
+		"company_name": "`company_name`",
+		"locality_id":  "`locality_id`",
+	},
+	DefaultSort: "id",
+}
+
 func (c *Carries) ValidateEmptyFields(isPatch bool) error {
 	var fieldsEmpty []string
 
@@ -0,0 +1,58 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+)
+
+type Country struct {
+	ID   int    `json:"id"`
+	Name string `json:"country_name"`
+}
+
+// CountryListOptions are the sort keys accepted by the countries list endpoint.
+var CountryListOptions = ListOptions{
+	Sorts: map[string]string{
+		"id":           "`id`",
+		"country_name": "`country_name`",
+	},
+	DefaultSort: "id",
+}
+
+// CountryReport rolls the localities of a country and their sellers and carriers up to the country.
+type CountryReport struct {
+	ID              int    `json:"country_id"`
+	Name            string `json:"country_name"`
+	ProvincesCount  int    `json:"provinces_count"`
+	LocalitiesCount int    `json:"localities_count"`
+	SellersCount    int    `json:"sellers_count"`
+	CarriersCount   int    `json:"carriers_count"`
+}
+
+func (c *Country) Validate() error {
+	var errors []string
+
+	c.Name = strings.TrimSpace(c.Name)
+
+	if c.Name == "" {
+		errors = append(errors, "country_name is required")
+	}
+
+	if len(c.Name) > 255 {
+		errors = append(errors, "country_name cannot be longer than 255 characters")
+	}
+
+	if len(errors) > 0 {
+		return fmt.Errorf("validation errors: %s", strings.Join(errors, "; "))
+	}
+
+	return nil
+}
+
+type CountryResponseSwagger struct {
+	Data []Country `json:"data"`
+}
+
+type CountryReportResponseSwagger struct {
+	Data []CountryReport `json:"data"`
+}
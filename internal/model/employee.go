@@ -8,6 +8,21 @@ type Employee struct {
 	WarehouseID  int
 }
 
+// EmployeeListOptions are the filters and sort keys accepted by the employees list endpoint.
+var EmployeeListOptions = ListOptions{
+	Filters: map[string]string{
+		"warehouse_id": "warehouse_id",
+	},
+	Sorts: map[string]string{
+		"id":             "id",
+		"card_number_id": "card_number_id",
+		"first_name":     "first_name",
+		"last_name":      "last_name",
+		"warehouse_id":   "warehouse_id",
+	},
+	DefaultSort: "id",
+}
+
 type InboundOrdersReportByEmployee struct {
 	ID                 int    `json:"id"`
 	CardNumberID       string `json:"card_number_id"`
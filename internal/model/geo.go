@@ -0,0 +1,30 @@
+package model
+
+import "errors"
+
+var (
+	ErrCoordinatesIncomplete = errors.New("latitude and longitude must be given together")
+	ErrLatitudeOutOfRange    = errors.New("latitude must be between -90 and 90")
+	ErrLongitudeOutOfRange   = errors.New("longitude must be between -180 and 180")
+)
+
+// ValidateCoordinates accepts either no coordinates or a latitude and longitude within range.
+func ValidateCoordinates(latitude, longitude *float64) error {
+	if latitude == nil && longitude == nil {
+		return nil
+	}
+
+	if latitude == nil || longitude == nil {
+		return ErrCoordinatesIncomplete
+	}
+
+	if *latitude < -90 || *latitude > 90 {
+		return ErrLatitudeOutOfRange
+	}
+
+	if *longitude < -180 || *longitude > 180 {
+		return ErrLongitudeOutOfRange
+	}
+
+	return nil
+}
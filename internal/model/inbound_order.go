@@ -4,6 +4,8 @@ import (
 	"time"
 )
 
+const MaxInboundOrderBatches = 100
+
 type InboundOrder struct {
 	ID             int
 	OrderDate      time.Time
@@ -11,10 +13,44 @@ type InboundOrder struct {
 	EmployeeID     int
 	ProductBatchID int
 	WareHouseID    int
+	// ProductBatchIDs lists every batch received with the order. Orders received
+	// with a single existing batch only have ProductBatchID.
+	ProductBatchIDs []int
+	// ProductBatches are the batches created together with the order.
+	ProductBatches []ProductBatches
+}
+
+// InboundOrderListOptions are the filters and sort keys accepted by the inbound orders list endpoint.
+// The order date bounds are inclusive and compared by day.
+var InboundOrderListOptions = ListOptions{
+	Filters: map[string]string{
+		"warehouse_id":    "`warehouse_id`",
+		"employee_id":     "`employee_id`",
+		"order_date_from": "`order_date` >= ?",
+		"order_date_to":   "`order_date` < ? + INTERVAL 1 DAY",
+	},
+	Sorts: map[string]string{
+		"id":           "`id`",
+		"order_number": "`order_number`",
+		"order_date":   "`order_date`",
+		"employee_id":  "`employee_id`",
+		"warehouse_id": "`warehouse_id`",
+	},
+	DefaultSort: "id",
 }
 
+// IsValid checks the order fields. An order either refers to an existing batch
+// or brings the batches to create, never both.
 func (i *InboundOrder) IsValid() bool {
-	if i.EmployeeID <= 0 || i.ProductBatchID <= 0 || i.WareHouseID <= 0 {
+	if i.EmployeeID <= 0 || i.WareHouseID <= 0 {
+		return false
+	}
+
+	if len(i.ProductBatches) == 0 && i.ProductBatchID <= 0 {
+		return false
+	}
+
+	if len(i.ProductBatches) > 0 && (i.ProductBatchID != 0 || len(i.ProductBatches) > MaxInboundOrderBatches) {
 		return false
 	}
 
@@ -1,21 +1,55 @@
 package model
 
 import (
+	"net/http"
+
 	er "github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
 )
 
+// Locality belongs to a province through ProvinceID; Province and Country carry the names
+// read from the provinces and countries tables. Latitude and Longitude are optional.
 type Locality struct {
-	ID       int    `json:"id"`
-	Locality string `json:"locality_name"`
-	Province string `json:"province_name"`
-	Country  string `json:"country_name"`
+	ID         int      `json:"id"`
+	Locality   string   `json:"locality_name"`
+	ProvinceID int      `json:"province_id"`
+	Province   string   `json:"province_name"`
+	Country    string   `json:"country_name"`
+	Latitude   *float64 `json:"latitude,omitempty"`
+	Longitude  *float64 `json:"longitude,omitempty"`
+}
+
+// LocalityListOptions are the filters and sort keys accepted by the localities list endpoint.
+// The name filters search for the given text anywhere in the locality, province or country name.
+var LocalityListOptions = ListOptions{
+	Filters: map[string]string{
+		"locality_name": "l.locality_name LIKE CONCAT('%', ?, '%')",
+		"province_name": "p.province_name LIKE CONCAT('%', ?, '%')",
+		"country_name":  "c.country_name LIKE CONCAT('%', ?, '%')",
+		"province_id":   "l.province_id",
+		"country_id":    "p.id_country_fk",
+	},
+	Sorts: map[string]string{
+		"id":            "l.id",
+		"locality_name": "l.locality_name",
+		"province_name": "p.province_name",
+		"country_name":  "c.country_name",
+	},
+	DefaultSort: "id",
+}
+
+// LocalityDependencies counts the sellers and carriers that keep a locality from being deleted.
+type LocalityDependencies struct {
+	ID       int `json:"locality_id"`
+	Sellers  int `json:"sellers_count"`
+	Carriers int `json:"carriers_count"`
 }
 
 type LocalityJSON struct {
-	ID       *int    `json:"id"`
-	Locality *string `json:"locality_name"`
-	Province *string `json:"province_name"`
-	Country  *string `json:"country_name"`
+	ID         *int    `json:"id"`
+	Locality   *string `json:"locality_name"`
+	ProvinceID *int    `json:"province_id"`
+	Province   *string `json:"province_name"`
+	Country    *string `json:"country_name"`
 }
 
 type LocalitiesJSONSellers struct {
@@ -32,17 +66,23 @@ type LocalitiesJSONCarriers struct {
 
 func (s *Locality) ValidateEmptyFields(l *Locality) error {
 	localityJSON := LocalityJSON{
-		Locality: &l.Locality,
-		Province: &l.Province,
-		Country:  &l.Country,
+		Locality:   &l.Locality,
+		ProvinceID: &l.ProvinceID,
+		Province:   &l.Province,
+		Country:    &l.Country,
 	}
 
-	if *localityJSON.Locality == "" || *localityJSON.Province == "" || *localityJSON.Country == "" {
+	if localityJSON.Locality == nil || localityJSON.ProvinceID == nil || localityJSON.Province == nil || localityJSON.Country == nil {
+		return er.ErrInvalidLocalityJSONFormat
+	}
+
+	// the province is given by id or, as before the hierarchy existed, by province and country names
+	if *localityJSON.Locality == "" || (*localityJSON.ProvinceID == 0 && (*localityJSON.Province == "" || *localityJSON.Country == "")) {
 		return er.ErrNullLocalityAttribute
 	}
 
-	if localityJSON.Locality == nil || localityJSON.Province == nil || localityJSON.Country == nil {
-		return er.ErrInvalidLocalityJSONFormat
+	if err := ValidateCoordinates(l.Latitude, l.Longitude); err != nil {
+		return er.NewLocalityErr(err.Error(), http.StatusUnprocessableEntity)
 	}
 
 	return nil
@@ -52,6 +92,11 @@ type LocalityResponseSwagger struct {
 	Data []Locality `json:"data"`
 }
 
+type LocalityDependenciesResponseSwagger struct {
+	Message string               `json:"message"`
+	Data    LocalityDependencies `json:"data"`
+}
+
 type LocalitySellersResponseSwagger struct {
 	Data []LocalitiesJSONSellers `json:"data"`
 }
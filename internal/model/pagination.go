@@ -0,0 +1,99 @@
+package model
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	DefaultPage     = 1
+	DefaultPageSize = 20
+	MaxPageSize     = 100
+)
+
+// ListParams carries the pagination, sorting and filtering options of a list request.
+// A zero PageSize means no limit, which is what internal callers use to read every row.
+type ListParams struct {
+	Page     int
+	PageSize int
+	Sort     string
+	Desc     bool
+	Filters  map[string]string
+}
+
+// ListOptions whitelists, per entity, the query parameters accepted as filters and sort keys
+// and maps each of them to its SQL column. A filter mapped to a full condition with its own
+// placeholder (e.g. "`due_date` >= ?") is used as is instead of an equality.
+type ListOptions struct {
+	Filters     map[string]string
+	Sorts       map[string]string
+	DefaultSort string
+}
+
+func (p ListParams) Offset() int {
+	if p.Page <= 1 {
+		return 0
+	}
+
+	return (p.Page - 1) * p.PageSize
+}
+
+// ParseListParams reads page, page_size, sort and the filters allowed by opts from the query string.
+// The sort key may be prefixed with "-" to order descending.
+func ParseListParams(query map[string][]string, opts ListOptions) (params ListParams, err error) {
+	params = ListParams{Page: DefaultPage, PageSize: DefaultPageSize, Sort: opts.DefaultSort, Filters: map[string]string{}}
+
+	get := func(key string) string {
+		if values, ok := query[key]; ok && len(values) > 0 {
+			return values[0]
+		}
+
+		return ""
+	}
+
+	if page := get("page"); page != "" {
+		params.Page, err = strconv.Atoi(page)
+
+		if err != nil || params.Page < 1 {
+			return ListParams{}, fmt.Errorf("invalid page: %s", page)
+		}
+	}
+
+	if size := get("page_size"); size != "" {
+		params.PageSize, err = strconv.Atoi(size)
+
+		if err != nil || params.PageSize < 1 || params.PageSize > MaxPageSize {
+			return ListParams{}, fmt.Errorf("invalid page_size: %s, must be between 1 and %d", size, MaxPageSize)
+		}
+	}
+
+	if sortKey := get("sort"); sortKey != "" {
+		params.Desc = strings.HasPrefix(sortKey, "-")
+		params.Sort = strings.TrimPrefix(sortKey, "-")
+
+		if _, ok := opts.Sorts[params.Sort]; !ok {
+			return ListParams{}, fmt.Errorf("invalid sort: %s, allowed values are %s", params.Sort, strings.Join(sortedKeys(opts.Sorts), ","))
+		}
+	}
+
+	for key := range opts.Filters {
+		if value := get(key); value != "" {
+			params.Filters[key] = value
+		}
+	}
+
+	return params, nil
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -14,6 +14,25 @@ type ProductRecords struct {
 	ProductID      int       `json:"product_id"`
 }
 
+// ProductRecordsListOptions are the filters and sort keys accepted by the product records list endpoint.
+// The update date bounds are inclusive and compared by day, as a half-open range so the index on
+// last_update_date can be used.
+var ProductRecordsListOptions = ListOptions{
+	Filters: map[string]string{
+		"product_id":            "product_id",
+		"last_update_date_from": "last_update_date >= ?",
+		"last_update_date_to":   "last_update_date < ? + INTERVAL 1 DAY",
+	},
+	Sorts: map[string]string{
+		"id":               "id",
+		"last_update_date": "last_update_date",
+		"product_id":       "product_id",
+		"purchase_price":   "purchase_price",
+		"sale_price":       "sale_price",
+	},
+	DefaultSort: "id",
+}
+
 type ProductRecordsReport struct {
 	ProductID    int    `json:"product_id"`
 	Description  string `json:"description"`
@@ -40,6 +59,102 @@ func (p *ProductRecords) Validate() error {
 	return nil
 }
 
+// ProductPricePoint is a price snapshot of the product with the margin it gave.
+type ProductPricePoint struct {
+	ID             int       `json:"id"`
+	LastUpdateDate time.Time `json:"last_update_date"`
+	PurchasePrice  float64   `json:"purchase_price"`
+	SalePrice      float64   `json:"sale_price"`
+	Margin         float64   `json:"margin"`
+}
+
+// ProductPriceHistory is the price time series of a product, oldest first, together with the
+// record holding its current prices.
+type ProductPriceHistory struct {
+	ProductID int                 `json:"product_id"`
+	Current   *ProductPricePoint  `json:"current"`
+	Records   []ProductPricePoint `json:"records"`
+}
+
+// ProductMarginFilter narrows the margin report to a seller, a product and a period. Zero values
+// leave the report unfiltered; the period bounds are inclusive and compared by day.
+type ProductMarginFilter struct {
+	SellerID  int
+	ProductID int
+	From      time.Time
+	To        time.Time
+}
+
+// ProductMarginReport sums up the margins of a product over a period. Margins are percentages of
+// the sale price and the price change compares the last sale price with the first one.
+type ProductMarginReport struct {
+	ProductID             int     `json:"product_id"`
+	Description           string  `json:"description"`
+	SellerID              int     `json:"seller_id"`
+	RecordsCount          int     `json:"records_count"`
+	AverageMargin         float64 `json:"average_margin"`
+	MinMargin             float64 `json:"min_margin"`
+	MaxMargin             float64 `json:"max_margin"`
+	PriceChangePercentage float64 `json:"price_change_percentage"`
+}
+
+// SellerMarginReport sums up the margins of every product of a seller over a period. The price
+// change is the average of the price changes of its products.
+type SellerMarginReport struct {
+	SellerID              int     `json:"seller_id"`
+	ProductsCount         int     `json:"products_count"`
+	RecordsCount          int     `json:"records_count"`
+	AverageMargin         float64 `json:"average_margin"`
+	MinMargin             float64 `json:"min_margin"`
+	MaxMargin             float64 `json:"max_margin"`
+	PriceChangePercentage float64 `json:"price_change_percentage"`
+}
+
+// Validate checks that the period is not reversed.
+func (f ProductMarginFilter) Validate() error {
+	if !f.From.IsZero() && !f.To.IsZero() && f.From.After(f.To) {
+		return fmt.Errorf("from cannot be after to")
+	}
+
+	return nil
+}
+
+// Margin returns the share of the sale price left after the purchase price, as a percentage.
+func Margin(purchasePrice, salePrice float64) float64 {
+	if salePrice == 0 {
+		return 0
+	}
+
+	return roundPrice((salePrice - purchasePrice) / salePrice * 100)
+}
+
+// ToPricePoint returns the record as a point of the price history.
+func (p ProductRecords) ToPricePoint() ProductPricePoint {
+	return ProductPricePoint{
+		ID:             p.ID,
+		LastUpdateDate: p.LastUpdateDate,
+		PurchasePrice:  p.PurchasePrice,
+		SalePrice:      p.SalePrice,
+		Margin:         Margin(p.PurchasePrice, p.SalePrice),
+	}
+}
+
+// Round rounds the margins and the price change aggregated by the repository to cents.
+func (r *ProductMarginReport) Round() {
+	r.AverageMargin = roundPrice(r.AverageMargin)
+	r.MinMargin = roundPrice(r.MinMargin)
+	r.MaxMargin = roundPrice(r.MaxMargin)
+	r.PriceChangePercentage = roundPrice(r.PriceChangePercentage)
+}
+
+// Round rounds the margins and the price change aggregated by the repository to cents.
+func (r *SellerMarginReport) Round() {
+	r.AverageMargin = roundPrice(r.AverageMargin)
+	r.MinMargin = roundPrice(r.MinMargin)
+	r.MaxMargin = roundPrice(r.MaxMargin)
+	r.PriceChangePercentage = roundPrice(r.PriceChangePercentage)
+}
+
 type ProductRecordResponseSwagger struct {
 	Data []ProductRecords `json:"data"`
 }
\ No newline at end of file
@@ -2,6 +2,7 @@ package model
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 	"time"
 )
@@ -20,6 +21,60 @@ type ProductBatches struct {
 	SectionID          int
 }
 
+// ProductBatchesListOptions are the filters and sort keys accepted by the product batches list endpoint.
+// The due date bounds are inclusive and compared by day.
+var ProductBatchesListOptions = ListOptions{
+	Filters: map[string]string{
+		"section_id":    "`section_id`",
+		"product_id":    "`product_id`",
+		"due_date_from": "`due_date` >= ?",
+		"due_date_to":   "`due_date` < ? + INTERVAL 1 DAY",
+	},
+	Sorts: map[string]string{
+		"id":               "`id`",
+		"batch_number":     "`batch_number`",
+		"current_quantity": "`current_quantity`",
+		"due_date":         "`due_date`",
+		"product_id":       "`product_id`",
+		"section_id":       "`section_id`",
+	},
+	DefaultSort: "id",
+}
+
+// ProductBatchesUpdate holds the fields of a batch that can be corrected after it was stored.
+// A nil field is left unchanged.
+type ProductBatchesUpdate struct {
+	CurrentQuantity    *int
+	CurrentTemperature *float64
+	MinimumTemperature *float64
+}
+
+// Apply validates the fields set in the update and copies them over the batch. Only the patched fields
+// are checked, and a quantity of zero is accepted to write off what is left of the batch.
+func (u ProductBatchesUpdate) Apply(pb *ProductBatches) error {
+	if u.CurrentQuantity == nil && u.CurrentTemperature == nil && u.MinimumTemperature == nil {
+		return errors.New("at least one of CurrentQuantity, CurrentTemperature or MinimumTemperature must be informed")
+	}
+
+	if u.CurrentQuantity != nil && *u.CurrentQuantity < 0 {
+		return errors.New("CurrentQuantity cannot be negative")
+	}
+
+	if u.CurrentQuantity != nil {
+		pb.CurrentQuantity = *u.CurrentQuantity
+	}
+
+	if u.CurrentTemperature != nil {
+		pb.CurrentTemperature = *u.CurrentTemperature
+	}
+
+	if u.MinimumTemperature != nil {
+		pb.MinimumTemperature = *u.MinimumTemperature
+	}
+
+	return nil
+}
+
 func (pb *ProductBatches) Validate() error {
 	var errorMessages []string
 
@@ -61,3 +116,119 @@ func (pb *ProductBatches) Validate() error {
 	
 	return nil
 }
+
+// ValidatePlacement checks that the batch can be stored in the section: the product must be of the
+// section's product type, the section must not be colder than the batch tolerates and it must be able
+// to reach the product's recommended freezing temperature.
+func (pb *ProductBatches) ValidatePlacement(product Product, section Section) error {
+	var errorMessages []string
+
+	if product.ProductTypeID != section.ProductTypeID {
+		errorMessages = append(errorMessages, fmt.Sprintf("product type %d does not match section product type %d", product.ProductTypeID, section.ProductTypeID))
+	}
+
+	if section.CurrentTemperature < pb.MinimumTemperature {
+		errorMessages = append(errorMessages, fmt.Sprintf("section current temperature %.2f is below the batch minimum temperature %.2f", section.CurrentTemperature, pb.MinimumTemperature))
+	}
+
+	if section.MinimumTemperature > product.RecommendedFreezingTemperature {
+		errorMessages = append(errorMessages, fmt.Sprintf("section minimum temperature %.2f cannot reach the product recommended freezing temperature %.2f", section.MinimumTemperature, product.RecommendedFreezingTemperature))
+	}
+
+	if len(errorMessages) > 0 {
+		return errors.New(strings.Join(errorMessages, "; "))
+	}
+
+	return nil
+}
+
+// ProductBatchesReferences counts the rows of other tables that still point to a batch.
+type ProductBatchesReferences struct {
+	InboundOrders         int
+	PurchaseOrders        int
+	TemperatureExcursions int
+}
+
+// Names lists what still references the batch, so a blocked deletion can say why.
+func (r ProductBatchesReferences) Names() (names []string) {
+	if r.InboundOrders > 0 {
+		names = append(names, "inbound orders")
+	}
+
+	if r.PurchaseOrders > 0 {
+		names = append(names, "purchase orders")
+	}
+
+	if r.TemperatureExcursions > 0 {
+		names = append(names, "temperature excursions")
+	}
+
+	return
+}
+
+const (
+	DefaultExpiryWindowDays = 7
+	MaxExpiryWindowDays     = 365
+
+	ExpiryStatusExpiring = "expiring"
+	ExpiryStatusExpired  = "expired"
+)
+
+// ExpiringBatch is a batch with stock left whose due date falls inside an expiry window.
+// The section and warehouse fields are only used to group the report.
+type ExpiringBatch struct {
+	ID              int       `json:"id"`
+	BatchNumber     string    `json:"batch_number"`
+	CurrentQuantity int       `json:"current_quantity"`
+	DueDate         time.Time `json:"due_date"`
+	ProductID       int       `json:"product_id"`
+	Status          string    `json:"status"`
+	SectionID       int       `json:"-"`
+	SectionNumber   string    `json:"-"`
+	WarehouseID     int       `json:"-"`
+	WarehouseCode   string    `json:"-"`
+}
+
+// ExpiryStatus tells whether the batch is already expired at now or still about to expire.
+func (b ExpiringBatch) ExpiryStatus(now time.Time) string {
+	if b.DueDate.Before(now) {
+		return ExpiryStatusExpired
+	}
+
+	return ExpiryStatusExpiring
+}
+
+type ExpiringBatchesSection struct {
+	SectionID     int             `json:"section_id"`
+	SectionNumber string          `json:"section_number"`
+	Batches       []ExpiringBatch `json:"batches"`
+}
+
+type ExpiringBatchesWarehouse struct {
+	WarehouseID   int                      `json:"warehouse_id"`
+	WarehouseCode string                   `json:"warehouse_code"`
+	Sections      []ExpiringBatchesSection `json:"sections"`
+}
+
+// GroupExpiringBatches nests the batches by warehouse and section. The batches must be ordered by
+// warehouse and section, as returned by the repository.
+func GroupExpiringBatches(batches []ExpiringBatch) []ExpiringBatchesWarehouse {
+	report := []ExpiringBatchesWarehouse{}
+
+	for _, b := range batches {
+		if len(report) == 0 || report[len(report)-1].WarehouseID != b.WarehouseID {
+			report = append(report, ExpiringBatchesWarehouse{WarehouseID: b.WarehouseID, WarehouseCode: b.WarehouseCode})
+		}
+
+		warehouse := &report[len(report)-1]
+
+		if len(warehouse.Sections) == 0 || warehouse.Sections[len(warehouse.Sections)-1].SectionID != b.SectionID {
+			warehouse.Sections = append(warehouse.Sections, ExpiringBatchesSection{SectionID: b.SectionID, SectionNumber: b.SectionNumber})
+		}
+
+		section := &warehouse.Sections[len(warehouse.Sections)-1]
+		section.Batches = append(section.Batches, b)
+	}
+
+	return report
+}
@@ -0,0 +1,44 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+)
+
+type ProductType struct {
+	ID       int    `json:"id"`
+	TypeName string `json:"type_name"`
+}
+
+type ProductTypeUsage struct {
+	ID            int    `json:"id"`
+	TypeName      string `json:"type_name"`
+	ProductsCount int    `json:"products_count"`
+	SectionsCount int    `json:"sections_count"`
+}
+
+func (pt *ProductType) Validate() error {
+	var errors []string
+
+	if strings.TrimSpace(pt.TypeName) == "" {
+		errors = append(errors, "TypeName is required")
+	}
+
+	if len(pt.TypeName) > 100 {
+		errors = append(errors, "TypeName cannot be longer than 100 characters")
+	}
+
+	if len(errors) > 0 {
+		return fmt.Errorf("validation errors: %s", strings.Join(errors, "; "))
+	}
+
+	return nil
+}
+
+type ProductTypeResponseSwagger struct {
+	Data []ProductType `json:"data"`
+}
+
+type ProductTypeUsageResponseSwagger struct {
+	Data []ProductTypeUsage `json:"data"`
+}
@@ -20,6 +20,23 @@ type Product struct {
 	SellerID                       int     `json:"seller_id"`
 }
 
+// ProductListOptions are the filters and sort keys accepted by the products list endpoint.
+var ProductListOptions = ListOptions{
+	Filters: map[string]string{
+		"seller_id":       "seller_id",
+		"product_type_id": "product_type_id",
+	},
+	Sorts: map[string]string{
+		"id":              "id",
+		"product_code":    "product_code",
+		"description":     "description",
+		"expiration_rate": "expiration_rate",
+		"seller_id":       "seller_id",
+		"product_type_id": "product_type_id",
+	},
+	DefaultSort: "id",
+}
+
 func (p *Product) Validate() error {
 	var errors []string
 
@@ -0,0 +1,67 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+)
+
+type Province struct {
+	ID        int    `json:"id"`
+	Name      string `json:"province_name"`
+	CountryID int    `json:"country_id"`
+}
+
+// ProvinceListOptions are the filters and sort keys accepted by the provinces list endpoint.
+var ProvinceListOptions = ListOptions{
+	Filters: map[string]string{
+		"country_id": "`id_country_fk`",
+	},
+	Sorts: map[string]string{
+		"id":            "`id`",
+		"province_name": "`province_name`",
+		"country_id":    "`id_country_fk`",
+	},
+	DefaultSort: "id",
+}
+
+// ProvinceReport rolls the localities of a province and their sellers and carriers up to the province.
+type ProvinceReport struct {
+	ID              int    `json:"province_id"`
+	Name            string `json:"province_name"`
+	CountryID       int    `json:"country_id"`
+	LocalitiesCount int    `json:"localities_count"`
+	SellersCount    int    `json:"sellers_count"`
+	CarriersCount   int    `json:"carriers_count"`
+}
+
+func (p *Province) Validate() error {
+	var errors []string
+
+	p.Name = strings.TrimSpace(p.Name)
+
+	if p.Name == "" {
+		errors = append(errors, "province_name is required")
+	}
+
+	if len(p.Name) > 255 {
+		errors = append(errors, "province_name cannot be longer than 255 characters")
+	}
+
+	if p.CountryID <= 0 {
+		errors = append(errors, "country_id is required")
+	}
+
+	if len(errors) > 0 {
+		return fmt.Errorf("validation errors: %s", strings.Join(errors, "; "))
+	}
+
+	return nil
+}
+
+type ProvinceResponseSwagger struct {
+	Data []Province `json:"data"`
+}
+
+type ProvinceReportResponseSwagger struct {
+	Data []ProvinceReport `json:"data"`
+}
@@ -2,6 +2,7 @@ package model
 
 import (
 	"fmt"
+	"math"
 	"strings"
 	"time"
 )
@@ -13,6 +14,61 @@ type PurchaseOrder struct {
 	TrackingCode    string    `json:"tracking_code" example:"TC001"`
 	BuyerID         int       `json:"buyer_id" example:"1"`
 	ProductRecordID int       `json:"product_record_id" example:"1"`
+	Quantity        int       `json:"quantity" example:"1"`
+	Status          string    `json:"status" example:"pending"`
+	Total           float64   `json:"total" example:"10.5"`
+	// Lines is only loaded when a single purchase order is read.
+	Lines []PurchaseOrderLine `json:"lines,omitempty"`
+}
+
+// PurchaseOrderLine is a product ordered within a purchase order. The unit price is the sale price
+// of the product's latest record at the time the order was placed and never changes afterwards.
+type PurchaseOrderLine struct {
+	ID              int     `json:"id" example:"1"`
+	LineNumber      int     `json:"line_number" example:"1"`
+	ProductID       int     `json:"product_id" example:"1"`
+	ProductRecordID int     `json:"product_record_id" example:"1"`
+	Quantity        int     `json:"quantity" example:"3"`
+	UnitPrice       float64 `json:"unit_price" example:"3.5"`
+	Subtotal        float64 `json:"subtotal" example:"10.5"`
+}
+
+const MaxPurchaseOrderLines = 100
+
+const (
+	PurchaseOrderStatusPending   = "pending"
+	PurchaseOrderStatusConfirmed = "confirmed"
+	PurchaseOrderStatusCancelled = "cancelled"
+	PurchaseOrderStatusFulfilled = "fulfilled"
+)
+
+// purchaseOrderTransitions lists, per status, the statuses a purchase order may move to. Cancelled and fulfilled are final.
+var purchaseOrderTransitions = map[string][]string{
+	PurchaseOrderStatusPending:   {PurchaseOrderStatusConfirmed, PurchaseOrderStatusCancelled},
+	PurchaseOrderStatusConfirmed: {PurchaseOrderStatusFulfilled, PurchaseOrderStatusCancelled},
+}
+
+// PurchaseOrderListOptions are the filters and sort keys accepted by the purchase orders list endpoint.
+// The order date bounds are inclusive and compared by day.
+var PurchaseOrderListOptions = ListOptions{
+	Filters: map[string]string{
+		"buyer_id":        "`buyer_id`",
+		"status":          "`status`",
+		"order_date_from": "`order_date` >= ?",
+		"order_date_to":   "`order_date` < ? + INTERVAL 1 DAY",
+	},
+	Sorts: map[string]string{
+		"id":           "`id`",
+		"order_number": "`order_number`",
+		"order_date":   "`order_date`",
+		"buyer_id":     "`buyer_id`",
+		"status":       "`status`",
+	},
+	DefaultSort: "id",
+}
+
+type PurchaseOrderStatusUpdate struct {
+	Status string `json:"status" example:"confirmed"`
 }
 
 func (p *PurchaseOrder) ValidateEmptyFields() error {
@@ -34,17 +90,120 @@ func (p *PurchaseOrder) ValidateEmptyFields() error {
 		fieldsEmpty = append(fieldsEmpty, "buyer_id")
 	}
 
-	if p.ProductRecordID == 0 {
-		fieldsEmpty = append(fieldsEmpty, "product_record_id")
+	if len(p.Lines) == 0 {
+		if p.ProductRecordID == 0 {
+			fieldsEmpty = append(fieldsEmpty, "product_record_id")
+		}
+
+		if p.Quantity <= 0 {
+			fieldsEmpty = append(fieldsEmpty, "quantity")
+		}
+	}
+
+	for i, line := range p.Lines {
+		if line.ProductID <= 0 {
+			fieldsEmpty = append(fieldsEmpty, fmt.Sprintf("lines[%d].product_id", i))
+		}
+
+		if line.Quantity <= 0 {
+			fieldsEmpty = append(fieldsEmpty, fmt.Sprintf("lines[%d].quantity", i))
+		}
 	}
 
 	if len(fieldsEmpty) > 0 {
 		return fmt.Errorf("Field(s) %s cannot be empty", strings.Join(fieldsEmpty, ","))
 	}
 
+	return p.validateLines()
+}
+
+// validateLines checks that an order is placed either for a single product record or as line items,
+// with each product ordered in a single line.
+func (p *PurchaseOrder) validateLines() error {
+	if len(p.Lines) == 0 {
+		return nil
+	}
+
+	if p.ProductRecordID != 0 || p.Quantity != 0 {
+		return fmt.Errorf("product_record_id and quantity cannot be combined with lines")
+	}
+
+	if len(p.Lines) > MaxPurchaseOrderLines {
+		return fmt.Errorf("an order cannot have more than %d lines", MaxPurchaseOrderLines)
+	}
+
+	seen := make(map[int]bool, len(p.Lines))
+
+	for _, line := range p.Lines {
+		if seen[line.ProductID] {
+			return fmt.Errorf("product %d appears in more than one line", line.ProductID)
+		}
+
+		seen[line.ProductID] = true
+	}
+
 	return nil
 }
 
+// ComputeTotals numbers the lines and derives their subtotals, the total quantity and the total of the order.
+func (p *PurchaseOrder) ComputeTotals() {
+	p.Quantity = 0
+	p.Total = 0
+
+	for i := range p.Lines {
+		p.Lines[i].LineNumber = i + 1
+		p.Lines[i].ComputeSubtotal()
+		p.Quantity += p.Lines[i].Quantity
+		p.Total += p.Lines[i].Subtotal
+	}
+
+	p.Total = roundPrice(p.Total)
+}
+
+func (l *PurchaseOrderLine) ComputeSubtotal() {
+	l.Subtotal = roundPrice(float64(l.Quantity) * l.UnitPrice)
+}
+
+func roundPrice(value float64) float64 {
+	return math.Round(value*100) / 100
+}
+
+func (u PurchaseOrderStatusUpdate) Validate() error {
+	if !IsPurchaseOrderStatus(u.Status) {
+		return fmt.Errorf("status must be one of pending, confirmed, cancelled or fulfilled")
+	}
+
+	return nil
+}
+
+func IsPurchaseOrderStatus(status string) bool {
+	switch status {
+	case PurchaseOrderStatusPending, PurchaseOrderStatusConfirmed, PurchaseOrderStatusCancelled, PurchaseOrderStatusFulfilled:
+		return true
+	}
+
+	return false
+}
+
+// CanPurchaseOrderTransition reports whether a purchase order in status from may move to status to.
+func CanPurchaseOrderTransition(from, to string) bool {
+	for _, next := range purchaseOrderTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+type PurchaseOrderBatch struct {
+	ID              int `json:"id"`
+	PurchaseOrderID int `json:"purchase_order_id"`
+	ProductBatchID  int `json:"product_batch_id"`
+	Quantity        int `json:"quantity"`
+	SectionID       int `json:"-"`
+}
+
 type PurchaseOrderResponseSwagger struct {
 	Data []PurchaseOrder `json:"data"`
 }
@@ -17,6 +17,41 @@ type Section struct {
 	ProductTypeID      int     `json:"product_type_id"`
 }
 
+// SectionListOptions are the filters and sort keys accepted by the sections list endpoint.
+var SectionListOptions = ListOptions{
+	Filters: map[string]string{
+		"warehouse_id":    "`warehouse_id`",
+		"product_type_id": "`product_type_id`",
+	},
+	Sorts: map[string]string{
+		"id":                  "`id`",
+		"section_number":      "`section_number`",
+		"current_temperature": "`current_temperature`",
+		"current_capacity":    "`current_capacity`",
+		"warehouse_id":        "`warehouse_id`",
+		"product_type_id":     "`product_type_id`",
+	},
+	DefaultSort: "id",
+}
+
+const (
+	SectionCapacityBelowMinimum = "below_minimum"
+	SectionCapacityNearFull     = "near_full"
+
+	// DefaultNearFullRatio is the share of MaximumCapacity from which a section is reported as near full.
+	DefaultNearFullRatio = 0.9
+)
+
+type SectionCapacity struct {
+	ID              int    `json:"id"`
+	SectionNumber   string `json:"section_number"`
+	WarehouseID     int    `json:"warehouse_id"`
+	CurrentCapacity int    `json:"current_capacity"`
+	MinimumCapacity int    `json:"minimum_capacity"`
+	MaximumCapacity int    `json:"maximum_capacity"`
+	Status          string `json:"status"`
+}
+
 type SectionProductBatches struct {
 	ID            int    `json:"id"`
 	SectionNumber string `json:"section_number"`
@@ -38,10 +73,6 @@ func (s *Section) Validate() error {
 		errorMessages = append(errorMessages, "MinimumTemperature não pode ser vazio")
 	}
 
-	if s.CurrentCapacity == 0 {
-		errorMessages = append(errorMessages, "CurrentCapacity não pode ser vazio")
-	}
-
 	if s.MinimumCapacity == 0 {
 		errorMessages = append(errorMessages, "MinimumCapacity não pode ser vazio")
 	}
@@ -50,6 +81,10 @@ func (s *Section) Validate() error {
 		errorMessages = append(errorMessages, "MaximumCapacity não pode ser vazio")
 	}
 
+	if s.MaximumCapacity < s.MinimumCapacity {
+		errorMessages = append(errorMessages, "MaximumCapacity não pode ser menor que MinimumCapacity")
+	}
+
 	if s.WarehouseID == 0 {
 		errorMessages = append(errorMessages, "WarehouseID não pode ser vazio")
 	}
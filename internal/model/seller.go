@@ -13,6 +13,20 @@ type Seller struct {
 	Locality    int    `json:"locality_id"`
 }
 
+// SellerListOptions are the filters and sort keys accepted by the sellers list endpoint.
+var SellerListOptions = ListOptions{
+	Filters: map[string]string{
+		"locality_id": "`locality_id`",
+	},
+	Sorts: map[string]string{
+		"id":           "`id`",
+		"cid":          "`cid`",
+		"company_name": "`company_name`",
+		"locality_id":  "`locality_id`",
+	},
+	DefaultSort: "id",
+}
+
 type SellerJSON struct {
 	ID          *int    `json:"id"`
 	CID         *int    `json:"cid"`
@@ -0,0 +1,156 @@
+package model
+
+import (
+	"crypto/rand"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	ShipmentStatusCreated   = "created"
+	ShipmentStatusPicked    = "picked"
+	ShipmentStatusInTransit = "in_transit"
+	ShipmentStatusDelivered = "delivered"
+	ShipmentStatusFailed    = "failed"
+
+	MaxOrdersPerShipment = 100
+	TrackingCodeLength   = 12
+)
+
+// shipmentTransitions lists, per status, the statuses a shipment may move to. Delivered and failed are final.
+var shipmentTransitions = map[string][]string{
+	ShipmentStatusCreated:   {ShipmentStatusPicked, ShipmentStatusFailed},
+	ShipmentStatusPicked:    {ShipmentStatusInTransit, ShipmentStatusFailed},
+	ShipmentStatusInTransit: {ShipmentStatusDelivered, ShipmentStatusFailed},
+}
+
+// trackingCodeAlphabet leaves out characters easily mistaken for one another (0/O, 1/I).
+const trackingCodeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+// Shipment groups purchase orders handed to a carrier under a single tracking code.
+type Shipment struct {
+	ID               int             `json:"id"`
+	TrackingCode     string          `json:"tracking_code"`
+	CarrierID        int             `json:"carrier_id"`
+	Status           string          `json:"status"`
+	PurchaseOrderIDs []int           `json:"purchase_order_ids"`
+	CreatedAt        time.Time       `json:"created_at"`
+	UpdatedAt        time.Time       `json:"updated_at"`
+	Events           []ShipmentEvent `json:"events"`
+}
+
+// ShipmentEvent records a status reached by a shipment.
+type ShipmentEvent struct {
+	ID          int       `json:"id"`
+	ShipmentID  int       `json:"-"`
+	Status      string    `json:"status"`
+	Description string    `json:"description"`
+	OccurredAt  time.Time `json:"occurred_at"`
+}
+
+type ShipmentCreate struct {
+	CarrierID        int   `json:"carrier_id"`
+	PurchaseOrderIDs []int `json:"purchase_order_ids"`
+}
+
+type ShipmentStatusUpdate struct {
+	Status      string `json:"status"`
+	Description string `json:"description"`
+}
+
+// PurchaseOrderTracking is the tracking information of the latest shipment of a purchase order.
+type PurchaseOrderTracking struct {
+	PurchaseOrderID int             `json:"purchase_order_id"`
+	ShipmentID      int             `json:"shipment_id"`
+	TrackingCode    string          `json:"tracking_code"`
+	CarrierID       int             `json:"carrier_id"`
+	Status          string          `json:"status"`
+	Events          []ShipmentEvent `json:"events"`
+}
+
+func (s ShipmentCreate) Validate() error {
+	var errors []string
+
+	if s.CarrierID <= 0 {
+		errors = append(errors, "carrier_id is required")
+	}
+
+	if len(s.PurchaseOrderIDs) == 0 {
+		errors = append(errors, "at least one purchase order is required")
+	} else if len(s.PurchaseOrderIDs) > MaxOrdersPerShipment {
+		errors = append(errors, fmt.Sprintf("at most %d purchase orders can be shipped together", MaxOrdersPerShipment))
+	}
+
+	seen := make(map[int]bool, len(s.PurchaseOrderIDs))
+	for _, id := range s.PurchaseOrderIDs {
+		if id <= 0 {
+			errors = append(errors, fmt.Sprintf("invalid purchase order id %d", id))
+		} else if seen[id] {
+			errors = append(errors, fmt.Sprintf("purchase order %d is repeated", id))
+		}
+
+		seen[id] = true
+	}
+
+	if len(errors) > 0 {
+		return fmt.Errorf("validation errors: %s", strings.Join(errors, "; "))
+	}
+
+	return nil
+}
+
+func (u ShipmentStatusUpdate) Validate() error {
+	var errors []string
+
+	switch u.Status {
+	case ShipmentStatusPicked, ShipmentStatusInTransit, ShipmentStatusDelivered, ShipmentStatusFailed:
+	case "":
+		errors = append(errors, "status is required")
+	default:
+		errors = append(errors, fmt.Sprintf("status must be one of %s, %s, %s or %s", ShipmentStatusPicked, ShipmentStatusInTransit, ShipmentStatusDelivered, ShipmentStatusFailed))
+	}
+
+	if len(u.Description) > 255 {
+		errors = append(errors, "description cannot be longer than 255 characters")
+	}
+
+	if len(errors) > 0 {
+		return fmt.Errorf("validation errors: %s", strings.Join(errors, "; "))
+	}
+
+	return nil
+}
+
+// CanShipmentTransition tells whether a shipment in status from may move to status to.
+func CanShipmentTransition(from, to string) bool {
+	for _, next := range shipmentTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NewTrackingCode returns a random tracking code with TrackingCodeLength characters.
+func NewTrackingCode() (string, error) {
+	buf := make([]byte, TrackingCodeLength)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+
+	for i, b := range buf {
+		buf[i] = trackingCodeAlphabet[int(b)%len(trackingCodeAlphabet)]
+	}
+
+	return string(buf), nil
+}
+
+type ShipmentResponseSwagger struct {
+	Data Shipment `json:"data"`
+}
+
+type PurchaseOrderTrackingResponseSwagger struct {
+	Data PurchaseOrderTracking `json:"data"`
+}
@@ -0,0 +1,81 @@
+package model
+
+import "time"
+
+const (
+	ExcursionAboveMaximum = "above_maximum"
+	ExcursionBelowMinimum = "below_minimum"
+
+	ExcursionStatusOpen   = "open"
+	ExcursionStatusClosed = "closed"
+)
+
+// BatchTemperatureLimits is the range a stored batch tolerates: no colder than the batch minimum temperature
+// and no warmer than its product's recommended freezing temperature.
+type BatchTemperatureLimits struct {
+	BatchID            int
+	SectionID          int
+	MinimumTemperature float64
+	MaximumTemperature float64
+}
+
+// Excursion returns the kind of excursion the temperature represents for the batch and the limit it broke,
+// or an empty kind when the temperature is within the limits.
+func (l BatchTemperatureLimits) Excursion(temperature float64) (kind string, limit float64) {
+	switch {
+	case temperature > l.MaximumTemperature:
+		return ExcursionAboveMaximum, l.MaximumTemperature
+	case temperature < l.MinimumTemperature:
+		return ExcursionBelowMinimum, l.MinimumTemperature
+	}
+
+	return "", 0
+}
+
+// TemperatureExcursion is an incident where a batch was kept outside its temperature limits.
+// EndedAt is nil while the excursion is still going on.
+type TemperatureExcursion struct {
+	ID               int        `json:"id"`
+	SectionID        int        `json:"section_id"`
+	ProductBatchID   int        `json:"product_batch_id"`
+	Kind             string     `json:"kind"`
+	LimitTemperature float64    `json:"limit_temperature"`
+	PeakTemperature  float64    `json:"peak_temperature"`
+	StartedAt        time.Time  `json:"started_at"`
+	EndedAt          *time.Time `json:"ended_at"`
+}
+
+// IsWorse tells whether the temperature deviates further from the limit than the recorded peak.
+func (e TemperatureExcursion) IsWorse(temperature float64) bool {
+	if e.Kind == ExcursionAboveMaximum {
+		return temperature > e.PeakTemperature
+	}
+
+	return temperature < e.PeakTemperature
+}
+
+// ExcursionReportFilter limits the incidents report to the excursions started in [From, To).
+// A zero bound is not applied.
+type ExcursionReportFilter struct {
+	From time.Time
+	To   time.Time
+}
+
+// SectionExcursionReport summarizes the excursions recorded in a section.
+type SectionExcursionReport struct {
+	SectionID            int       `json:"section_id"`
+	SectionNumber        string    `json:"section_number"`
+	WarehouseID          int       `json:"warehouse_id"`
+	IncidentsCount       int       `json:"incidents_count"`
+	OpenCount            int       `json:"open_count"`
+	TotalDurationMinutes int       `json:"total_duration_minutes"`
+	LastStartedAt        time.Time `json:"last_started_at"`
+}
+
+type TemperatureExcursionResponseSwagger struct {
+	Data []TemperatureExcursion `json:"data"`
+}
+
+type SectionExcursionReportResponseSwagger struct {
+	Data []SectionExcursionReport `json:"data"`
+}
@@ -0,0 +1,113 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	MaxReadingsPerIngest      = 1000
+	MinTemperatureReading     = -100.0
+	MaxTemperatureReading     = 100.0
+	MaxReadingClockSkew       = 5 * time.Minute
+	DefaultTemperatureRange   = 24 * time.Hour
+	DefaultTemperatureBucket  = time.Hour
+	MinTemperatureBucket      = time.Minute
+	MaxTemperatureQueryPeriod = 366 * 24 * time.Hour
+)
+
+// TemperatureReading is a single sensor measurement taken inside a section.
+type TemperatureReading struct {
+	ID          int       `json:"id"`
+	SectionID   int       `json:"section_id"`
+	SensorID    string    `json:"sensor_id"`
+	Temperature float64   `json:"temperature"`
+	RecordedAt  time.Time `json:"recorded_at"`
+}
+
+// TemperatureIngestResult summarizes a bulk ingestion of readings.
+type TemperatureIngestResult struct {
+	SectionID int `json:"section_id"`
+	Inserted  int `json:"inserted"`
+}
+
+// TemperatureQuery selects the readings of a section recorded in [From, To) and the size of the aggregation buckets.
+type TemperatureQuery struct {
+	SectionID int
+	From      time.Time
+	To        time.Time
+	Interval  time.Duration
+}
+
+// TemperatureAggregate holds the statistics of the readings recorded in one interval starting at PeriodStart.
+type TemperatureAggregate struct {
+	PeriodStart    time.Time `json:"period_start"`
+	MinTemperature float64   `json:"min_temperature"`
+	MaxTemperature float64   `json:"max_temperature"`
+	AvgTemperature float64   `json:"avg_temperature"`
+	ReadingsCount  int       `json:"readings_count"`
+}
+
+// ValidateReadings checks a batch of readings sent for ingestion, reporting the position of each invalid one.
+func ValidateReadings(readings []TemperatureReading, now time.Time) error {
+	if len(readings) == 0 {
+		return fmt.Errorf("validation errors: at least one reading is required")
+	}
+
+	if len(readings) > MaxReadingsPerIngest {
+		return fmt.Errorf("validation errors: at most %d readings can be sent at once", MaxReadingsPerIngest)
+	}
+
+	var errors []string
+
+	for i, reading := range readings {
+		if reading.RecordedAt.IsZero() {
+			errors = append(errors, fmt.Sprintf("readings[%d]: RecordedAt is required", i))
+		} else if reading.RecordedAt.After(now.Add(MaxReadingClockSkew)) {
+			errors = append(errors, fmt.Sprintf("readings[%d]: RecordedAt cannot be in the future", i))
+		}
+
+		if reading.Temperature < MinTemperatureReading || reading.Temperature > MaxTemperatureReading {
+			errors = append(errors, fmt.Sprintf("readings[%d]: Temperature must be between %.0f and %.0f", i, MinTemperatureReading, MaxTemperatureReading))
+		}
+
+		if len(reading.SensorID) > 100 {
+			errors = append(errors, fmt.Sprintf("readings[%d]: SensorID cannot be longer than 100 characters", i))
+		}
+	}
+
+	if len(errors) > 0 {
+		return fmt.Errorf("validation errors: %s", strings.Join(errors, "; "))
+	}
+
+	return nil
+}
+
+func (q TemperatureQuery) Validate() error {
+	var errors []string
+
+	if !q.From.Before(q.To) {
+		errors = append(errors, "from must be before to")
+	} else if q.To.Sub(q.From) > MaxTemperatureQueryPeriod {
+		errors = append(errors, fmt.Sprintf("the period cannot be longer than %s", MaxTemperatureQueryPeriod))
+	}
+
+	if q.Interval < MinTemperatureBucket {
+		errors = append(errors, fmt.Sprintf("interval cannot be shorter than %s", MinTemperatureBucket))
+	}
+
+	if q.Interval%time.Second != 0 {
+		errors = append(errors, "interval must be a whole number of seconds")
+	}
+
+	if len(errors) > 0 {
+		return fmt.Errorf("validation errors: %s", strings.Join(errors, "; "))
+	}
+
+	return nil
+}
+
+type TemperatureAggregateResponseSwagger struct {
+	Data []TemperatureAggregate `json:"data"`
+}
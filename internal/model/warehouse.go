@@ -3,6 +3,7 @@ package model
 import (
 	"fmt"
 	"strings"
+	"time"
 )
 
 type WareHouse struct {
@@ -12,6 +13,43 @@ type WareHouse struct {
 	WareHouseCode      string `json:"warehouse_code"`
 	MinimunCapacity    int    `json:"minimun_capacity"`
 	MinimunTemperature int    `json:"minimun_temperature"`
+	// Latitude and Longitude are optional; warehouses without them are never ranked by distance.
+	Latitude  *float64 `json:"latitude,omitempty"`
+	Longitude *float64 `json:"longitude,omitempty"`
+}
+
+// NearestWareHouseParams locates the origin of a nearest-warehouse search, either by the
+// coordinates of a locality or by Latitude and Longitude, and optionally requires a section
+// of ProductTypeID with room for Quantity more units.
+type NearestWareHouseParams struct {
+	LocalityID    int
+	Latitude      *float64
+	Longitude     *float64
+	ProductTypeID int
+	Quantity      int
+	Limit         int
+}
+
+// NearestWareHouse is a warehouse with its great-circle distance to the search origin and the
+// largest free capacity among its sections (of the requested product type, when one is given).
+type NearestWareHouse struct {
+	WareHouse
+	DistanceKm        float64 `json:"distance_km"`
+	AvailableCapacity int     `json:"available_capacity"`
+}
+
+// WareHouseListOptions are the filters and sort keys accepted by the warehouses list endpoint.
+var WareHouseListOptions = ListOptions{
+	Filters: map[string]string{
+		"warehouse_code": "w.warehouse_code",
+	},
+	Sorts: map[string]string{
+		"id":                  "w.id",
+		"warehouse_code":      "w.warehouse_code",
+		"minimum_capacity":    "w.minimum_capacity",
+		"minimum_temperature": "w.minimum_temperature",
+	},
+	DefaultSort: "id",
 }
 
 func (w *WareHouse) ValidateEmptyFields(isPatch bool) error {
@@ -42,12 +80,119 @@ func (w *WareHouse) ValidateEmptyFields(isPatch bool) error {
 			return fmt.Errorf("Field(s) %s cannot be empty or invalid", strings.Join(fieldsEmpty, ", "))
 		}
 	} else {
-		if len(fieldsEmpty) == 5 {
+		if len(fieldsEmpty) == 5 && w.Latitude == nil && w.Longitude == nil {
 			return fmt.Errorf("at least one field must be filled in")
 		}
 	}
 
-	return nil
+	return ValidateCoordinates(w.Latitude, w.Longitude)
+}
+
+// WareHouseInventoryRow is the stock of a product kept in one section of a warehouse, as aggregated
+// by the repository. NearestDueDate is nil when none of the batches has a due date.
+type WareHouseInventoryRow struct {
+	ProductID      int
+	ProductCode    string
+	Description    string
+	SectionID      int
+	SectionNumber  string
+	Quantity       int
+	BatchesCount   int
+	NearestDueDate *time.Time
+}
+
+type WareHouseInventorySection struct {
+	SectionID      int        `json:"section_id"`
+	SectionNumber  string     `json:"section_number"`
+	Quantity       int        `json:"quantity"`
+	BatchesCount   int        `json:"batches_count"`
+	NearestDueDate *time.Time `json:"nearest_due_date"`
+}
+
+type WareHouseInventoryProduct struct {
+	ProductID      int                         `json:"product_id"`
+	ProductCode    string                      `json:"product_code"`
+	Description    string                      `json:"description"`
+	TotalQuantity  int                         `json:"total_quantity"`
+	BatchesCount   int                         `json:"batches_count"`
+	NearestDueDate *time.Time                  `json:"nearest_due_date"`
+	Sections       []WareHouseInventorySection `json:"sections"`
+}
+
+type WareHouseInventory struct {
+	WarehouseID   int                         `json:"warehouse_id"`
+	WarehouseCode string                      `json:"warehouse_code"`
+	TotalQuantity int                         `json:"total_quantity"`
+	Products      []WareHouseInventoryProduct `json:"products"`
+}
+
+// GroupWareHouseInventory totals the rows per product, keeping the section breakdown. The rows must be
+// ordered by product, as returned by the repository.
+func GroupWareHouseInventory(warehouse WareHouse, rows []WareHouseInventoryRow) WareHouseInventory {
+	inventory := WareHouseInventory{WarehouseID: warehouse.ID, WarehouseCode: warehouse.WareHouseCode, Products: []WareHouseInventoryProduct{}}
+
+	for _, r := range rows {
+		if len(inventory.Products) == 0 || inventory.Products[len(inventory.Products)-1].ProductID != r.ProductID {
+			inventory.Products = append(inventory.Products, WareHouseInventoryProduct{ProductID: r.ProductID, ProductCode: r.ProductCode, Description: r.Description})
+		}
+
+		product := &inventory.Products[len(inventory.Products)-1]
+		product.TotalQuantity += r.Quantity
+		product.BatchesCount += r.BatchesCount
+
+		if r.NearestDueDate != nil && (product.NearestDueDate == nil || r.NearestDueDate.Before(*product.NearestDueDate)) {
+			product.NearestDueDate = r.NearestDueDate
+		}
+
+		product.Sections = append(product.Sections, WareHouseInventorySection{
+			SectionID:      r.SectionID,
+			SectionNumber:  r.SectionNumber,
+			Quantity:       r.Quantity,
+			BatchesCount:   r.BatchesCount,
+			NearestDueDate: r.NearestDueDate,
+		})
+
+		inventory.TotalQuantity += r.Quantity
+	}
+
+	return inventory
+}
+
+type WareHouseInventoryResponseSwagger struct {
+	Data WareHouseInventory `json:"data"`
+}
+
+// WareHouseCapacity adds up the capacity of the sections of a warehouse. Utilization is the share of
+// TotalCapacity in use and BelowMinimumCapacity tells whether the sections offer less than the
+// minimum capacity required of the warehouse.
+type WareHouseCapacity struct {
+	WarehouseID          int     `json:"warehouse_id"`
+	WarehouseCode        string  `json:"warehouse_code"`
+	MinimumCapacity      int     `json:"minimum_capacity"`
+	SectionsCount        int     `json:"sections_count"`
+	TotalCapacity        int     `json:"total_capacity"`
+	UsedCapacity         int     `json:"used_capacity"`
+	AvailableCapacity    int     `json:"available_capacity"`
+	Utilization          float64 `json:"utilization"`
+	BelowMinimumCapacity bool    `json:"below_minimum_capacity"`
+}
+
+// SetUtilization derives the available capacity, utilization and minimum capacity check from the totals.
+func (c *WareHouseCapacity) SetUtilization() {
+	c.AvailableCapacity = c.TotalCapacity - c.UsedCapacity
+	c.BelowMinimumCapacity = c.TotalCapacity < c.MinimumCapacity
+
+	if c.TotalCapacity > 0 {
+		c.Utilization = float64(c.UsedCapacity) / float64(c.TotalCapacity)
+	}
+}
+
+type WareHouseCapacityResponseSwagger struct {
+	Data []WareHouseCapacity `json:"data"`
+}
+
+type NearestWareHousesResponseSwagger struct {
+	Data []NearestWareHouse `json:"data"`
 }
 
 type WareHousesResponseSwagger struct {
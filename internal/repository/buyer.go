@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"github.com/maxwelbm/alkemy-g7.git/pkg/logger"
@@ -8,17 +9,18 @@ import (
 
 	"github.com/go-sql-driver/mysql"
 	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+	"github.com/maxwelbm/alkemy-g7.git/internal/repository/interfaces"
 	"github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
 )
 
 type BuyerRepository struct {
-	db  *sql.DB
+	db  DBTX
 	log logger.Logger
 }
 
-func (r *BuyerRepository) Delete(id int) (err error) {
+func (r *BuyerRepository) Delete(ctx context.Context, id int) (err error) {
 	r.log.Log("BuyerRepository", "INFO", fmt.Sprintf("initializing Delete function with parameter %d", id))
-	_, err = r.db.Exec("DELETE FROM buyers WHERE id = ?", id)
+	_, err = r.db.ExecContext(ctx, "DELETE FROM buyers WHERE id = ?", id)
 
 	if err != nil {
 		if err.(*mysql.MySQLError).Number == 1451 {
@@ -35,9 +37,12 @@ func (r *BuyerRepository) Delete(id int) (err error) {
 	return
 }
 
-func (r *BuyerRepository) Get() (buyers []model.Buyer, err error) {
+func (r *BuyerRepository) Get(ctx context.Context, params model.ListParams) (buyers []model.Buyer, total int, err error) {
 	r.log.Log("BuyerRepository", "INFO", "initializing Get function")
-	rows, err := r.db.Query("SELECT id, card_number_id,first_name,last_name FROM buyers")
+
+	list := newListQuery(params, model.BuyerListOptions)
+	query, args := list.selectQuery("SELECT id, card_number_id,first_name,last_name FROM buyers")
+	rows, err := r.db.QueryContext(ctx, query, args...)
 
 	if err != nil {
 		r.log.Log("BuyerRepository", "ERROR", fmt.Sprintf("Error: %v", err))
@@ -58,14 +63,25 @@ func (r *BuyerRepository) Get() (buyers []model.Buyer, err error) {
 		buyers = append(buyers, buyer)
 	}
 
+	total = len(buyers)
+
+	if params.PageSize > 0 {
+		total, err = countRows(ctx, r.db, "SELECT COUNT(*) FROM buyers", list)
+
+		if err != nil {
+			r.log.Log("BuyerRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+			return nil, 0, err
+		}
+	}
+
 	r.log.Log("BuyerRepository", "INFO", fmt.Sprintf("retornando %v", buyers))
 
-	return buyers, nil
+	return buyers, total, nil
 }
 
-func (r *BuyerRepository) GetByID(id int) (buyer model.Buyer, err error) {
+func (r *BuyerRepository) GetByID(ctx context.Context, id int) (buyer model.Buyer, err error) {
 	r.log.Log("BuyerRepository", "INFO", fmt.Sprintf("initializing GetByID function with parameter %d", id))
-	row := r.db.QueryRow("SELECT id,card_number_id,first_name,last_name FROM buyers WHERE id=?", id)
+	row := r.db.QueryRowContext(ctx, "SELECT id,card_number_id,first_name,last_name FROM buyers WHERE id=?", id)
 	err = row.Scan(&buyer.ID, &buyer.CardNumberID, &buyer.FirstName, &buyer.LastName)
 
 	if err != nil {
@@ -83,16 +99,16 @@ func (r *BuyerRepository) GetByID(id int) (buyer model.Buyer, err error) {
 	return
 }
 
-func (r *BuyerRepository) Post(newBuyer model.Buyer) (id int64, err error) {
+func (r *BuyerRepository) Post(ctx context.Context, newBuyer model.Buyer) (id int64, err error) {
 	r.log.Log("BuyerRepository", "INFO", fmt.Sprintf("initializing Post function with parameter %v", newBuyer))
-	prepare, err := r.db.Prepare("INSERT INTO buyers (card_number_id, first_name, last_name) VALUES (?,?,?)")
+	prepare, err := r.db.PrepareContext(ctx, "INSERT INTO buyers (card_number_id, first_name, last_name) VALUES (?,?,?)")
 
 	if err != nil {
 		r.log.Log("BuyerRepository", "ERROR", fmt.Sprintf("Error: %v", err))
 		return
 	}
 
-	result, err := prepare.Exec(newBuyer.CardNumberID, newBuyer.FirstName, newBuyer.LastName)
+	result, err := prepare.ExecContext(ctx, newBuyer.CardNumberID, newBuyer.FirstName, newBuyer.LastName)
 
 	if err != nil {
 		if err.(*mysql.MySQLError).Number == 1062 {
@@ -110,16 +126,16 @@ func (r *BuyerRepository) Post(newBuyer model.Buyer) (id int64, err error) {
 	return
 }
 
-func (r *BuyerRepository) Update(id int, newBuyer model.Buyer) (err error) {
+func (r *BuyerRepository) Update(ctx context.Context, id int, newBuyer model.Buyer) (err error) {
 	r.log.Log("BuyerRepository", "INFO", fmt.Sprintf("initializing Update function with parameter %v and %d", newBuyer, id))
-	prepare, err := r.db.Prepare("UPDATE buyers SET card_number_id = ?, first_name = ?, last_name = ? WHERE id = ?")
+	prepare, err := r.db.PrepareContext(ctx, "UPDATE buyers SET card_number_id = ?, first_name = ?, last_name = ? WHERE id = ?")
 
 	if err != nil {
 		r.log.Log("BuyerRepository", "ERROR", fmt.Sprintf("Error: %v", err))
 		return
 	}
 
-	_, err = prepare.Exec(newBuyer.CardNumberID, newBuyer.FirstName, newBuyer.LastName, id)
+	_, err = prepare.ExecContext(ctx, newBuyer.CardNumberID, newBuyer.FirstName, newBuyer.LastName, id)
 
 	if err != nil {
 		if err.(*mysql.MySQLError).Number == 1062 {
@@ -136,9 +152,9 @@ func (r *BuyerRepository) Update(id int, newBuyer model.Buyer) (err error) {
 	return
 }
 
-func (r *BuyerRepository) CountPurchaseOrderByBuyerID(id int) (countBuyerPurchaseOrder model.BuyerPurchaseOrder, err error) {
+func (r *BuyerRepository) CountPurchaseOrderByBuyerID(ctx context.Context, id int) (countBuyerPurchaseOrder model.BuyerPurchaseOrder, err error) {
 	r.log.Log("BuyerRepository", "INFO", fmt.Sprintf("initializing CountPurchaseOrderByBuyerID function with parameter  %d", id))
-	row := r.db.QueryRow("SELECT b.id, b.card_number_id, b.first_name, b.last_name, COUNT(po.id) as purchase_orders_count FROM buyers b LEFT JOIN purchase_orders po ON po.buyer_id = b.id WHERE b.id = ? GROUP BY b.id", id)
+	row := r.db.QueryRowContext(ctx, "SELECT b.id, b.card_number_id, b.first_name, b.last_name, COUNT(po.id) as purchase_orders_count FROM buyers b LEFT JOIN purchase_orders po ON po.buyer_id = b.id WHERE b.id = ? GROUP BY b.id", id)
 	err = row.Scan(&countBuyerPurchaseOrder.ID, &countBuyerPurchaseOrder.CardNumberID, &countBuyerPurchaseOrder.FirstName, &countBuyerPurchaseOrder.LastName, &countBuyerPurchaseOrder.PurchaseOrdersCount)
 
 	if err != nil {
@@ -156,9 +172,9 @@ func (r *BuyerRepository) CountPurchaseOrderByBuyerID(id int) (countBuyerPurchas
 	return
 }
 
-func (r *BuyerRepository) CountPurchaseOrderBuyers() (countBuyerPurchaseOrder []model.BuyerPurchaseOrder, err error) {
+func (r *BuyerRepository) CountPurchaseOrderBuyers(ctx context.Context) (countBuyerPurchaseOrder []model.BuyerPurchaseOrder, err error) {
 	r.log.Log("BuyerRepository", "INFO", "initializing CountPurchaseOrderBuyers function")
-	rows, err := r.db.Query("SELECT b.id, b.card_number_id, b.first_name, b.last_name, COUNT(po.id) as purchase_orders_count FROM buyers b LEFT JOIN purchase_orders po ON po.buyer_id = b.id GROUP BY b.id")
+	rows, err := r.db.QueryContext(ctx, "SELECT b.id, b.card_number_id, b.first_name, b.last_name, COUNT(po.id) as purchase_orders_count FROM buyers b LEFT JOIN purchase_orders po ON po.buyer_id = b.id GROUP BY b.id")
 
 	if err != nil {
 		r.log.Log("BuyerRepository", "ERROR", fmt.Sprintf("Error: %v", err))
@@ -187,3 +203,8 @@ func (r *BuyerRepository) CountPurchaseOrderBuyers() (countBuyerPurchaseOrder []
 func NewBuyerRepository(db *sql.DB, log logger.Logger) *BuyerRepository {
 	return &BuyerRepository{db: db, log: log}
 }
+
+// WithTx implements interfaces.IBuyerRepo.
+func (r *BuyerRepository) WithTx(tx *sql.Tx) interfaces.IBuyerRepo {
+	return &BuyerRepository{db: tx, log: r.log}
+}
@@ -1,6 +1,7 @@
 package repository_test
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"net/http"
@@ -35,7 +36,7 @@ func TestBuyerRepository_Post(t *testing.T) {
 			WithArgs(buyer.CardNumberID, buyer.FirstName, buyer.LastName).
 			WillReturnResult(sqlmock.NewResult(1, 1))
 
-		id, err := rp.Post(buyer)
+		id, err := rp.Post(context.Background(), buyer)
 
 		expectedId := 1
 
@@ -52,7 +53,7 @@ func TestBuyerRepository_Post(t *testing.T) {
 
 		mock.ExpectPrepare("INSERT INTO buyers (card_number_id, first_name, last_name) VALUES (?,?,?)").WillReturnError(errors.New("prepare statement error"))
 
-		_, err := rp.Post(buyer)
+		_, err := rp.Post(context.Background(), buyer)
 
 		errMock := mock.ExpectationsWereMet()
 
@@ -74,7 +75,7 @@ func TestBuyerRepository_Post(t *testing.T) {
 				Message:  "Duplicate entry",
 			})
 
-		_, err := rp.Post(buyer)
+		_, err := rp.Post(context.Background(), buyer)
 
 		errMock := mock.ExpectationsWereMet()
 
@@ -110,7 +111,7 @@ func TestBuyerRepository_GetByID(t *testing.T) {
 			WithArgs(buyerID).
 			WillReturnRows(rows)
 
-		result, err := rp.GetByID(buyerID)
+		result, err := rp.GetByID(context.Background(), buyerID)
 		assert.NoError(t, err)
 
 		errMock := mock.ExpectationsWereMet()
@@ -126,7 +127,7 @@ func TestBuyerRepository_GetByID(t *testing.T) {
 			WithArgs(buyerID).
 			WillReturnError(sql.ErrNoRows)
 
-		buyer, err := rp.GetByID(buyerID)
+		buyer, err := rp.GetByID(context.Background(), buyerID)
 		errorExpected := customerror.NewBuyerError(http.StatusNotFound, customerror.ErrNotFound.Error(), "Buyer")
 		errorMock := mock.ExpectationsWereMet()
 
@@ -168,9 +169,9 @@ func TestBuyerRepository_Get(t *testing.T) {
 			rows.AddRow(buyer.ID, buyer.CardNumberID, buyer.FirstName, buyer.LastName)
 		}
 
-		mock.ExpectQuery("SELECT id, card_number_id,first_name,last_name FROM buyers").WillReturnRows(rows)
+		mock.ExpectQuery("SELECT id, card_number_id,first_name,last_name FROM buyers ORDER BY id").WillReturnRows(rows)
 
-		buyers, err := rp.Get()
+		buyers, _, err := rp.Get(context.Background(), model.ListParams{})
 		errMock := mock.ExpectationsWereMet()
 		assert.NoError(t, err)
 		assert.Equal(t, expectedBuyers, buyers)
@@ -179,9 +180,9 @@ func TestBuyerRepository_Get(t *testing.T) {
 	})
 
 	t.Run("Return errors an listing buyers", func(t *testing.T) {
-		mock.ExpectQuery("SELECT id, card_number_id,first_name,last_name FROM buyers").WillReturnError(errors.New("unmapped error"))
+		mock.ExpectQuery("SELECT id, card_number_id,first_name,last_name FROM buyers ORDER BY id").WillReturnError(errors.New("unmapped error"))
 
-		buyers, err := rp.Get()
+		buyers, _, err := rp.Get(context.Background(), model.ListParams{})
 		mockErr := mock.ExpectationsWereMet()
 		assert.Error(t, err)
 		assert.Equal(t, []model.Buyer(nil), buyers)
@@ -211,7 +212,7 @@ func TestBuyerRepository_Update(t *testing.T) {
 			WithArgs(buyer.CardNumberID, buyer.FirstName, buyer.LastName, buyerID).
 			WillReturnResult(sqlmock.NewResult(1, 1))
 
-		err := rp.Update(buyerID, buyer)
+		err := rp.Update(context.Background(), buyerID, buyer)
 		mockErr := mock.ExpectationsWereMet()
 
 		assert.NoError(t, err)
@@ -232,7 +233,7 @@ func TestBuyerRepository_Update(t *testing.T) {
 				Message:  "Duplicate entry",
 			})
 
-		err := rp.Update(buyerID, buyer)
+		err := rp.Update(context.Background(), buyerID, buyer)
 		mockErr := mock.ExpectationsWereMet()
 
 		expectedError := customerror.NewBuyerError(http.StatusNotFound, customerror.ErrConflict.Error(), "card_number_id")
@@ -248,7 +249,7 @@ func TestBuyerRepository_Update(t *testing.T) {
 		mock.ExpectPrepare("UPDATE buyers SET card_number_id = ?, first_name = ?, last_name = ? WHERE id = ?").
 			WillReturnError(errors.New("unmapped Error"))
 
-		err := rp.Update(buyerID, buyer)
+		err := rp.Update(context.Background(), buyerID, buyer)
 		mockErr := mock.ExpectationsWereMet()
 		assert.Error(t, err)
 		assert.NoError(t, mockErr)
@@ -274,7 +275,7 @@ func TestBuyerRepository_CountPurchaseOrderBuyers(t *testing.T) {
 		mock.ExpectQuery("SELECT b.id, b.card_number_id, b.first_name, b.last_name, COUNT(po.id) as purchase_orders_count FROM buyers b LEFT JOIN purchase_orders po ON po.buyer_id = b.id GROUP BY b.id").
 			WillReturnRows(rows)
 
-		count, err := rp.CountPurchaseOrderBuyers()
+		count, err := rp.CountPurchaseOrderBuyers(context.Background())
 
 		expectedCount := []model.BuyerPurchaseOrder{
 			{ID: 1, CardNumberID: "1234-5678", FirstName: "John", LastName: "Doe", PurchaseOrdersCount: 3},
@@ -292,7 +293,7 @@ func TestBuyerRepository_CountPurchaseOrderBuyers(t *testing.T) {
 		mock.ExpectQuery("SELECT b.id, b.card_number_id, b.first_name, b.last_name, COUNT(po.id) as purchase_orders_count FROM buyers b LEFT JOIN purchase_orders po ON po.buyer_id = b.id GROUP BY b.id").
 			WillReturnError(errors.New("unmapped error"))
 
-		count, err := rp.CountPurchaseOrderBuyers()
+		count, err := rp.CountPurchaseOrderBuyers(context.Background())
 
 		expectedCount := []model.BuyerPurchaseOrder(nil)
 		errMock := mock.ExpectationsWereMet()
@@ -323,7 +324,7 @@ func TestBuyerRepository_CountPurchaseOrderByBuyerID(t *testing.T) {
 			WithArgs(buyerID).
 			WillReturnRows(rows)
 
-		count, err := rp.CountPurchaseOrderByBuyerID(buyerID)
+		count, err := rp.CountPurchaseOrderByBuyerID(context.Background(), buyerID)
 
 		expectedCount := model.BuyerPurchaseOrder{ID: 1, CardNumberID: "1234-5678", FirstName: "John", LastName: "Doe", PurchaseOrdersCount: 3}
 
@@ -341,7 +342,7 @@ func TestBuyerRepository_CountPurchaseOrderByBuyerID(t *testing.T) {
 		mock.ExpectQuery("SELECT b.id, b.card_number_id, b.first_name, b.last_name, COUNT(po.id) as purchase_orders_count FROM buyers b LEFT JOIN purchase_orders po ON po.buyer_id = b.id WHERE b.id = ? GROUP BY b.id").
 			WillReturnError(sql.ErrNoRows)
 
-		count, err := rp.CountPurchaseOrderByBuyerID(buyerID)
+		count, err := rp.CountPurchaseOrderByBuyerID(context.Background(), buyerID)
 
 		expectedCount := model.BuyerPurchaseOrder{}
 		errMock := mock.ExpectationsWereMet()
@@ -369,7 +370,7 @@ func TestBuyerRepository_Delete(t *testing.T) {
 			WithArgs(buyerID).
 			WillReturnResult(sqlmock.NewResult(0, 1))
 
-		err := rp.Delete(buyerID)
+		err := rp.Delete(context.Background(), buyerID)
 
 		mockErr := mock.ExpectationsWereMet()
 
@@ -384,7 +385,7 @@ func TestBuyerRepository_Delete(t *testing.T) {
 			WithArgs(1).
 			WillReturnError(&mysql.MySQLError{Number: 1451})
 
-		err := rp.Delete(buyerID)
+		err := rp.Delete(context.Background(), buyerID)
 
 		mockErr := mock.ExpectationsWereMet()
 
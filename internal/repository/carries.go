@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
@@ -10,11 +11,12 @@ import (
 
 	"github.com/go-sql-driver/mysql"
 	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+	"github.com/maxwelbm/alkemy-g7.git/internal/repository/interfaces"
 	"github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
 )
 
 type Carriers struct {
-	db  *sql.DB
+	db  DBTX
 	log logger.Logger
 }
 
@@ -22,8 +24,8 @@ func NewCarriersRepository(db *sql.DB, log logger.Logger) *Carriers {
 	return &Carriers{db: db, log: log}
 }
 
-func (r *Carriers) GetByID(id int) (carrier model.Carries, err error) {
-	row := r.db.QueryRow("SELECT `id`, `cid`, `company_name`, `address`, `telephone`, `locality_id` FROM `carriers` WHERE `id` = ?", id)
+func (r *Carriers) GetByID(ctx context.Context, id int) (carrier model.Carries, err error) {
+	row := r.db.QueryRowContext(ctx, "SELECT `id`, `cid`, `company_name`, `address`, `telephone`, `locality_id` FROM `carriers` WHERE `id` = ?", id)
 	r.log.Log("CarriesRepository", "INFO", "initializing GetByID function")
 
 	err = row.Scan(&carrier.ID, &carrier.CID, &carrier.CompanyName, &carrier.Address, &carrier.Telephone, &carrier.LocalityID)
@@ -43,10 +45,11 @@ func (r *Carriers) GetByID(id int) (carrier model.Carries, err error) {
 	return
 }
 
-func (r *Carriers) PostCarrier(carrier model.Carries) (id int64, err error) {
+func (r *Carriers) PostCarrier(ctx context.Context, carrier model.Carries) (id int64, err error) {
 	r.log.Log("CarriesRepository", "INFO", "initializing PostCarrier function")
 
-	result, err := r.db.Exec(
+	result, err := r.db.ExecContext(
+		ctx,
 		"INSERT INTO `carriers` (`cid`, `company_name`, `address`, `telephone`, `locality_id`) VALUES (?, ?, ?, ?, ?)",
 		carrier.CID, carrier.CompanyName, carrier.Address, carrier.Telephone, carrier.LocalityID,
 	)
@@ -80,3 +83,113 @@ func (r *Carriers) PostCarrier(carrier model.Carries) (id int64, err error) {
 
 	return
 }
+
+func (r *Carriers) Get(ctx context.Context, params model.ListParams) (carriers []model.Carries, total int, err error) {
+	r.log.Log("CarriesRepository", "INFO", "initializing Get function")
+
+	list := newListQuery(params, model.CarrierListOptions)
+	query, args := list.selectQuery("SELECT `id`, `cid`, `company_name`, `address`, `telephone`, `locality_id` FROM `carriers`")
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		r.log.Log("CarriesRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var carrier model.Carries
+
+		err = rows.Scan(&carrier.ID, &carrier.CID, &carrier.CompanyName, &carrier.Address, &carrier.Telephone, &carrier.LocalityID)
+		if err != nil {
+			r.log.Log("CarriesRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+
+			return nil, 0, err
+		}
+
+		carriers = append(carriers, carrier)
+	}
+
+	if err = rows.Err(); err != nil {
+		r.log.Log("CarriesRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return nil, 0, err
+	}
+
+	total = len(carriers)
+
+	if params.PageSize > 0 {
+		total, err = countRows(ctx, r.db, "SELECT COUNT(*) FROM `carriers`", list)
+		if err != nil {
+			r.log.Log("CarriesRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+
+			return nil, 0, err
+		}
+	}
+
+	r.log.Log("CarriesRepository", "INFO", "Get completed successfully")
+
+	return
+}
+
+func (r *Carriers) PatchCarrier(ctx context.Context, id int, carrier model.Carries) (err error) {
+	r.log.Log("CarriesRepository", "INFO", "initializing PatchCarrier function")
+
+	_, err = r.db.ExecContext(
+		ctx,
+		"UPDATE `carriers` SET `cid` = ?, `company_name` = ?, `address` = ?, `telephone` = ?, `locality_id` = ? WHERE `id` = ?",
+		carrier.CID, carrier.CompanyName, carrier.Address, carrier.Telephone, carrier.LocalityID, id,
+	)
+
+	if err != nil {
+		var mysqlErr *mysql.MySQLError
+		if errors.As(err, &mysqlErr) && mysqlErr.Number == 1062 {
+			err = customerror.NewCarrierError(customerror.ErrConflict.Error(), "cid", http.StatusConflict)
+		}
+		r.log.Log("CarriesRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+	r.log.Log("CarriesRepository", "INFO", "PatchCarrier completed successfully")
+
+	return
+}
+
+func (r *Carriers) DeleteCarrier(ctx context.Context, id int) (err error) {
+	r.log.Log("CarriesRepository", "INFO", "initializing DeleteCarrier function")
+
+	result, err := r.db.ExecContext(ctx, "DELETE FROM `carriers` WHERE `id` = ?", id)
+	if err != nil {
+		var mysqlErr *mysql.MySQLError
+		if errors.As(err, &mysqlErr) && mysqlErr.Number == 1451 {
+			err = customerror.NewCarrierError(customerror.ErrDependencies.Error(), "carrier", http.StatusConflict)
+		}
+		r.log.Log("CarriesRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		r.log.Log("CarriesRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	if affected == 0 {
+		err = customerror.NewCarrierError(customerror.ErrNotFound.Error(), "carrier", http.StatusNotFound)
+		r.log.Log("CarriesRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+	r.log.Log("CarriesRepository", "INFO", "DeleteCarrier completed successfully")
+
+	return
+}
+
+// WithTx implements interfaces.ICarriersRepo.
+func (r *Carriers) WithTx(tx *sql.Tx) interfaces.ICarriersRepo {
+	return &Carriers{db: tx, log: r.log}
+}
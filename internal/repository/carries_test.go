@@ -1,8 +1,10 @@
 package repository_test
 
 import (
+	"context"
 	"database/sql"
 	"errors"
+	"net/http"
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
@@ -39,7 +41,7 @@ func TestCarriers_GetByID(t *testing.T) {
 			WithArgs(expectedCarrier.ID).
 			WillReturnRows(rows)
 
-		carrier, err := rp.GetByID(expectedCarrier.ID)
+		carrier, err := rp.GetByID(context.Background(), expectedCarrier.ID)
 
 		assert.NoError(t, err)
 		assert.Equal(t, expectedCarrier, carrier)
@@ -52,7 +54,7 @@ func TestCarriers_GetByID(t *testing.T) {
 			WithArgs(1).
 			WillReturnError(sql.ErrNoRows)
 
-		carrier, err := rp.GetByID(1)
+		carrier, err := rp.GetByID(context.Background(), 1)
 
 		assert.Error(t, err)
 		assert.IsType(t, &customerror.CarrierError{}, err)
@@ -84,7 +86,7 @@ func TestCarriers_PostCarrier(t *testing.T) {
 			WithArgs(newCarrier.CID, newCarrier.CompanyName, newCarrier.Address, newCarrier.Telephone, newCarrier.LocalityID).
 			WillReturnResult(sqlmock.NewResult(1, 1))
 
-		id, err := rp.PostCarrier(newCarrier)
+		id, err := rp.PostCarrier(context.Background(), newCarrier)
 
 		assert.NoError(t, err)
 		assert.Equal(t, int64(1), id)
@@ -103,7 +105,7 @@ func TestCarriers_PostCarrier(t *testing.T) {
 			WithArgs(newCarrier.CID, newCarrier.CompanyName, newCarrier.Address, newCarrier.Telephone, newCarrier.LocalityID).
 			WillReturnError(&mysql.MySQLError{Number: 1062})
 
-		id, err := rp.PostCarrier(newCarrier)
+		id, err := rp.PostCarrier(context.Background(), newCarrier)
 
 		assert.Error(t, err)
 		assert.Equal(t, int64(0), id)
@@ -122,9 +124,120 @@ func TestCarriers_PostCarrier(t *testing.T) {
 			WithArgs(newCarrier.CID, newCarrier.CompanyName, newCarrier.Address, newCarrier.Telephone, newCarrier.LocalityID).
 			WillReturnError(errors.New("some other error"))
 
-		id, err := rp.PostCarrier(newCarrier)
+		id, err := rp.PostCarrier(context.Background(), newCarrier)
 
 		assert.Error(t, err)
 		assert.Equal(t, int64(0), id)
 	})
 }
+
+func TestCarriers_Get(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	rp := repository.NewCarriersRepository(db, logMock)
+	columns := []string{"id", "cid", "company_name", "address", "telephone", "locality_id"}
+
+	t.Run("Success Get paginated and filtered by locality", func(t *testing.T) {
+		rows := mock.NewRows(columns).AddRow(2, "CID002", "XYZ Company", "456 Main St", "0987654321", 1)
+		mock.ExpectQuery("SELECT `id`, `cid`, `company_name`, `address`, `telephone`, `locality_id` FROM `carriers` WHERE `locality_id` = ? ORDER BY `id` LIMIT ? OFFSET ?").
+			WithArgs("1", 1, 1).
+			WillReturnRows(rows)
+		mock.ExpectQuery("SELECT COUNT(*) FROM `carriers` WHERE `locality_id` = ?").
+			WithArgs("1").
+			WillReturnRows(mock.NewRows([]string{"COUNT(*)"}).AddRow(2))
+
+		carriers, total, err := rp.Get(context.Background(), model.ListParams{Page: 2, PageSize: 1, Sort: "id", Filters: map[string]string{"locality_id": "1"}})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, total)
+		assert.Equal(t, []model.Carries{{ID: 2, CID: "CID002", CompanyName: "XYZ Company", Address: "456 Main St", Telephone: "0987654321", LocalityID: 1}}, carriers)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Error Get", func(t *testing.T) {
+		mock.ExpectQuery("SELECT `id`, `cid`, `company_name`, `address`, `telephone`, `locality_id` FROM `carriers` ORDER BY `id`").
+			WillReturnError(errors.New("unmapped error"))
+
+		carriers, total, err := rp.Get(context.Background(), model.ListParams{})
+
+		assert.Error(t, err)
+		assert.Zero(t, total)
+		assert.Nil(t, carriers)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestCarriers_PatchCarrier(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	rp := repository.NewCarriersRepository(db, logMock)
+	query := "UPDATE `carriers` SET `cid` = ?, `company_name` = ?, `address` = ?, `telephone` = ?, `locality_id` = ? WHERE `id` = ?"
+	carrier := model.Carries{CID: "CID001", CompanyName: "ABC Company", Address: "123 Main St", Telephone: "1234567890", LocalityID: 2}
+
+	t.Run("Success PatchCarrier", func(t *testing.T) {
+		mock.ExpectExec(query).
+			WithArgs("CID001", "ABC Company", "123 Main St", "1234567890", 2, 1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := rp.PatchCarrier(context.Background(), 1, carrier)
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Conflict PatchCarrier duplicated cid", func(t *testing.T) {
+		mock.ExpectExec(query).
+			WillReturnError(&mysql.MySQLError{Number: 1062})
+
+		err := rp.PatchCarrier(context.Background(), 1, carrier)
+
+		assert.Equal(t, customerror.NewCarrierError(customerror.ErrConflict.Error(), "cid", http.StatusConflict), err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestCarriers_DeleteCarrier(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	rp := repository.NewCarriersRepository(db, logMock)
+	query := "DELETE FROM `carriers` WHERE `id` = ?"
+
+	t.Run("Success DeleteCarrier", func(t *testing.T) {
+		mock.ExpectExec(query).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := rp.DeleteCarrier(context.Background(), 1)
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Not Found DeleteCarrier", func(t *testing.T) {
+		mock.ExpectExec(query).WithArgs(99).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := rp.DeleteCarrier(context.Background(), 99)
+
+		assert.Equal(t, customerror.NewCarrierError(customerror.ErrNotFound.Error(), "carrier", http.StatusNotFound), err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Conflict DeleteCarrier with dependencies", func(t *testing.T) {
+		mock.ExpectExec(query).WithArgs(1).WillReturnError(&mysql.MySQLError{Number: 1451})
+
+		err := rp.DeleteCarrier(context.Background(), 1)
+
+		assert.Equal(t, customerror.NewCarrierError(customerror.ErrDependencies.Error(), "carrier", http.StatusConflict), err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,238 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+	"github.com/maxwelbm/alkemy-g7.git/internal/repository/interfaces"
+	"github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
+	"github.com/maxwelbm/alkemy-g7.git/pkg/logger"
+)
+
+type CountryRepository struct {
+	db  DBTX
+	log logger.Logger
+}
+
+func NewCountryRepository(db *sql.DB, log logger.Logger) *CountryRepository {
+	return &CountryRepository{db: db, log: log}
+}
+
+func (r *CountryRepository) Get(ctx context.Context, params model.ListParams) (countries []model.Country, total int, err error) {
+	r.log.Log("CountryRepository", "INFO", "initializing Get function")
+
+	list := newListQuery(params, model.CountryListOptions)
+	query, args := list.selectQuery("SELECT `id`, `country_name` FROM `countries`")
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		r.log.Log("CountryRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+		return
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var country model.Country
+
+		err = rows.Scan(&country.ID, &country.Name)
+		if err != nil {
+			r.log.Log("CountryRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+			return nil, 0, err
+		}
+
+		countries = append(countries, country)
+	}
+
+	if err = rows.Err(); err != nil {
+		r.log.Log("CountryRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+		return nil, 0, err
+	}
+
+	total = len(countries)
+
+	if params.PageSize > 0 {
+		total, err = countRows(ctx, r.db, "SELECT COUNT(*) FROM `countries`", list)
+		if err != nil {
+			r.log.Log("CountryRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+			return nil, 0, err
+		}
+	}
+
+	r.log.Log("CountryRepository", "INFO", fmt.Sprintf("returning %d countries", len(countries)))
+
+	return
+}
+
+func (r *CountryRepository) GetByID(ctx context.Context, id int) (country model.Country, err error) {
+	r.log.Log("CountryRepository", "INFO", fmt.Sprintf("initializing GetByID function with id %d", id))
+
+	row := r.db.QueryRowContext(ctx, "SELECT `id`, `country_name` FROM `countries` WHERE `id` = ?", id)
+
+	err = row.Scan(&country.ID, &country.Name)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = customerror.HandleError("country", customerror.ErrorNotFound, "")
+		}
+
+		r.log.Log("CountryRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	r.log.Log("CountryRepository", "INFO", fmt.Sprintf("returning country: %v", country))
+
+	return
+}
+
+func (r *CountryRepository) Post(ctx context.Context, country model.Country) (model.Country, error) {
+	r.log.Log("CountryRepository", "INFO", "initializing Post function")
+
+	result, err := r.db.ExecContext(ctx, "INSERT INTO `countries` (`country_name`) VALUES (?)", country.Name)
+	if err != nil {
+		err = countrySQLError(err)
+		r.log.Log("CountryRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return model.Country{}, err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		r.log.Log("CountryRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+		return model.Country{}, err
+	}
+
+	country.ID = int(id)
+
+	r.log.Log("CountryRepository", "INFO", fmt.Sprintf("saved country: %v", country))
+
+	return country, nil
+}
+
+func (r *CountryRepository) Update(ctx context.Context, id int, country model.Country) (model.Country, error) {
+	r.log.Log("CountryRepository", "INFO", fmt.Sprintf("initializing Update function with id %d", id))
+
+	_, err := r.db.ExecContext(ctx, "UPDATE `countries` SET `country_name` = ? WHERE `id` = ?", country.Name, id)
+	if err != nil {
+		err = countrySQLError(err)
+		r.log.Log("CountryRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return model.Country{}, err
+	}
+
+	country.ID = id
+
+	r.log.Log("CountryRepository", "INFO", fmt.Sprintf("updated country: %v", country))
+
+	return country, nil
+}
+
+func (r *CountryRepository) Delete(ctx context.Context, id int) (err error) {
+	r.log.Log("CountryRepository", "INFO", fmt.Sprintf("initializing Delete function with id %d", id))
+
+	_, err = r.db.ExecContext(ctx, "DELETE FROM `countries` WHERE `id` = ?", id)
+	if err != nil {
+		err = countrySQLError(err)
+		r.log.Log("CountryRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	r.log.Log("CountryRepository", "INFO", fmt.Sprintf("country with id %d deleted", id))
+
+	return
+}
+
+// CountProvinces returns how many provinces belong to the country.
+func (r *CountryRepository) CountProvinces(ctx context.Context, id int) (count int, err error) {
+	r.log.Log("CountryRepository", "INFO", fmt.Sprintf("initializing CountProvinces function with id %d", id))
+
+	err = r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM `provinces` WHERE `id_country_fk` = ?", id).Scan(&count)
+	if err != nil {
+		r.log.Log("CountryRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+		return
+	}
+
+	r.log.Log("CountryRepository", "INFO", fmt.Sprintf("country with id %d has %d provinces", id, count))
+
+	return
+}
+
+// GetReport rolls the sellers and carriers of every locality up to its country, restricted to a single country when id is set.
+func (r *CountryRepository) GetReport(ctx context.Context, id int) (report []model.CountryReport, err error) {
+	r.log.Log("CountryRepository", "INFO", fmt.Sprintf("initializing GetReport function with id %d", id))
+
+	query := "SELECT c.id, c.country_name, COUNT(DISTINCT p.id) AS provinces_count, COUNT(l.id) AS localities_count," +
+		" COALESCE(SUM(s.sellers_count), 0) AS sellers_count, COALESCE(SUM(ca.carriers_count), 0) AS carriers_count" +
+		" FROM `countries` c LEFT JOIN `provinces` p ON p.id_country_fk = c.id LEFT JOIN `locality` l ON l.province_id = p.id" +
+		localityCountsJoin
+
+	var args []any
+
+	if id > 0 {
+		query += " WHERE c.id = ?"
+
+		args = append(args, id)
+	}
+
+	query += " GROUP BY c.id, c.country_name ORDER BY c.country_name"
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		r.log.Log("CountryRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+		return
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var c model.CountryReport
+
+		err = rows.Scan(&c.ID, &c.Name, &c.ProvincesCount, &c.LocalitiesCount, &c.SellersCount, &c.CarriersCount)
+		if err != nil {
+			r.log.Log("CountryRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+			return nil, err
+		}
+
+		report = append(report, c)
+	}
+
+	if err = rows.Err(); err != nil {
+		r.log.Log("CountryRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+		return nil, err
+	}
+
+	if id > 0 && len(report) == 0 {
+		err = customerror.HandleError("country", customerror.ErrorNotFound, "")
+		r.log.Log("CountryRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	r.log.Log("CountryRepository", "INFO", fmt.Sprintf("returning report of %d countries", len(report)))
+
+	return
+}
+
+// WithTx implements interfaces.ICountryRepo.
+func (r *CountryRepository) WithTx(tx *sql.Tx) interfaces.ICountryRepo {
+	return &CountryRepository{db: tx, log: r.log}
+}
+
+func countrySQLError(err error) error {
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) {
+		switch mysqlErr.Number {
+		case 1062:
+			return customerror.HandleError("country", customerror.ErrorConflict, "")
+		case 1451:
+			return customerror.HandleError("country", customerror.ErrorDep, "")
+		}
+	}
+
+	return err
+}
@@ -0,0 +1,232 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+	"github.com/maxwelbm/alkemy-g7.git/internal/repository"
+	"github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
+	"github.com/stretchr/testify/assert"
+)
+
+const countryReportQuery = "SELECT c.id, c.country_name, COUNT(DISTINCT p.id) AS provinces_count, COUNT(l.id) AS localities_count," +
+	" COALESCE(SUM(s.sellers_count), 0) AS sellers_count, COALESCE(SUM(ca.carriers_count), 0) AS carriers_count" +
+	" FROM `countries` c LEFT JOIN `provinces` p ON p.id_country_fk = c.id LEFT JOIN `locality` l ON l.province_id = p.id" +
+	" LEFT JOIN (SELECT `locality_id`, COUNT(*) AS `sellers_count` FROM `sellers` GROUP BY `locality_id`) s ON s.locality_id = l.id" +
+	" LEFT JOIN (SELECT `locality_id`, COUNT(*) AS `carriers_count` FROM `carriers` GROUP BY `locality_id`) ca ON ca.locality_id = l.id"
+
+func setupCountryRepository(t *testing.T) (*repository.CountryRepository, sqlmock.Sqlmock) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	t.Cleanup(func() { db.Close() })
+
+	return repository.NewCountryRepository(db, logMock), mock
+}
+
+func TestCountryRepository_Get(t *testing.T) {
+	t.Run("should return the requested page and the total", func(t *testing.T) {
+		rp, mock := setupCountryRepository(t)
+
+		mock.ExpectQuery("SELECT `id`, `country_name` FROM `countries` ORDER BY `country_name` DESC, `id` LIMIT ? OFFSET ?").
+			WithArgs(1, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "country_name"}).AddRow(2, "Brazil"))
+		mock.ExpectQuery("SELECT COUNT(*) FROM `countries`").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+
+		countries, total, err := rp.Get(context.Background(), model.ListParams{Page: 2, PageSize: 1, Sort: "country_name", Desc: true})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []model.Country{{ID: 2, Name: "Brazil"}}, countries)
+		assert.Equal(t, 3, total)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("should return error when the query fails", func(t *testing.T) {
+		rp, mock := setupCountryRepository(t)
+
+		mock.ExpectQuery("SELECT `id`, `country_name` FROM `countries` ORDER BY `id`").
+			WillReturnError(sql.ErrConnDone)
+
+		countries, total, err := rp.Get(context.Background(), model.ListParams{})
+
+		assert.ErrorIs(t, err, sql.ErrConnDone)
+		assert.Nil(t, countries)
+		assert.Zero(t, total)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestCountryRepository_GetByID(t *testing.T) {
+	t.Run("should return the country", func(t *testing.T) {
+		rp, mock := setupCountryRepository(t)
+
+		mock.ExpectQuery("SELECT `id`, `country_name` FROM `countries` WHERE `id` = ?").
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "country_name"}).AddRow(1, "Argentina"))
+
+		country, err := rp.GetByID(context.Background(), 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, model.Country{ID: 1, Name: "Argentina"}, country)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("should return not found when the country does not exist", func(t *testing.T) {
+		rp, mock := setupCountryRepository(t)
+
+		mock.ExpectQuery("SELECT `id`, `country_name` FROM `countries` WHERE `id` = ?").
+			WithArgs(99).
+			WillReturnError(sql.ErrNoRows)
+
+		country, err := rp.GetByID(context.Background(), 99)
+
+		assert.Equal(t, customerror.HandleError("country", customerror.ErrorNotFound, ""), err)
+		assert.Empty(t, country)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestCountryRepository_Post(t *testing.T) {
+	t.Run("should return the created country", func(t *testing.T) {
+		rp, mock := setupCountryRepository(t)
+
+		mock.ExpectExec("INSERT INTO `countries` (`country_name`) VALUES (?)").
+			WithArgs("Chile").
+			WillReturnResult(sqlmock.NewResult(4, 1))
+
+		country, err := rp.Post(context.Background(), model.Country{Name: "Chile"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, model.Country{ID: 4, Name: "Chile"}, country)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("should return conflict when the name is duplicated", func(t *testing.T) {
+		rp, mock := setupCountryRepository(t)
+
+		mock.ExpectExec("INSERT INTO `countries` (`country_name`) VALUES (?)").
+			WithArgs("Chile").
+			WillReturnError(&mysql.MySQLError{Number: 1062})
+
+		country, err := rp.Post(context.Background(), model.Country{Name: "Chile"})
+
+		assert.Equal(t, customerror.HandleError("country", customerror.ErrorConflict, ""), err)
+		assert.Empty(t, country)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestCountryRepository_Update(t *testing.T) {
+	rp, mock := setupCountryRepository(t)
+
+	mock.ExpectExec("UPDATE `countries` SET `country_name` = ? WHERE `id` = ?").
+		WithArgs("Uruguay", 2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	country, err := rp.Update(context.Background(), 2, model.Country{Name: "Uruguay"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, model.Country{ID: 2, Name: "Uruguay"}, country)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCountryRepository_Delete(t *testing.T) {
+	t.Run("should delete the country", func(t *testing.T) {
+		rp, mock := setupCountryRepository(t)
+
+		mock.ExpectExec("DELETE FROM `countries` WHERE `id` = ?").
+			WithArgs(1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := rp.Delete(context.Background(), 1)
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("should return dependencies error when provinces reference the country", func(t *testing.T) {
+		rp, mock := setupCountryRepository(t)
+
+		mock.ExpectExec("DELETE FROM `countries` WHERE `id` = ?").
+			WithArgs(1).
+			WillReturnError(&mysql.MySQLError{Number: 1451})
+
+		err := rp.Delete(context.Background(), 1)
+
+		assert.Equal(t, customerror.HandleError("country", customerror.ErrorDep, ""), err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestCountryRepository_CountProvinces(t *testing.T) {
+	rp, mock := setupCountryRepository(t)
+
+	mock.ExpectQuery("SELECT COUNT(*) FROM `provinces` WHERE `id_country_fk` = ?").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+
+	count, err := rp.CountProvinces(context.Background(), 1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCountryRepository_GetReport(t *testing.T) {
+	columns := []string{"id", "country_name", "provinces_count", "localities_count", "sellers_count", "carriers_count"}
+
+	t.Run("should return the report of every country", func(t *testing.T) {
+		rp, mock := setupCountryRepository(t)
+
+		mock.ExpectQuery(countryReportQuery + " GROUP BY c.id, c.country_name ORDER BY c.country_name").
+			WillReturnRows(sqlmock.NewRows(columns).
+				AddRow(1, "Argentina", 2, 3, 4, 1).
+				AddRow(2, "Brazil", 0, 0, 0, 0))
+
+		report, err := rp.GetReport(context.Background(), 0)
+
+		expected := []model.CountryReport{
+			{ID: 1, Name: "Argentina", ProvincesCount: 2, LocalitiesCount: 3, SellersCount: 4, CarriersCount: 1},
+			{ID: 2, Name: "Brazil"},
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, report)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("should filter the report by country", func(t *testing.T) {
+		rp, mock := setupCountryRepository(t)
+
+		mock.ExpectQuery(countryReportQuery + " WHERE c.id = ? GROUP BY c.id, c.country_name ORDER BY c.country_name").
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows(columns).AddRow(1, "Argentina", 2, 3, 4, 1))
+
+		report, err := rp.GetReport(context.Background(), 1)
+
+		assert.NoError(t, err)
+		assert.Len(t, report, 1)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("should return not found when the country does not exist", func(t *testing.T) {
+		rp, mock := setupCountryRepository(t)
+
+		mock.ExpectQuery(countryReportQuery + " WHERE c.id = ? GROUP BY c.id, c.country_name ORDER BY c.country_name").
+			WithArgs(99).
+			WillReturnRows(sqlmock.NewRows(columns))
+
+		report, err := rp.GetReport(context.Background(), 99)
+
+		assert.Equal(t, customerror.HandleError("country", customerror.ErrorNotFound, ""), err)
+		assert.Empty(t, report)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
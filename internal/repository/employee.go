@@ -1,16 +1,18 @@
 package repository
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 
 	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+	"github.com/maxwelbm/alkemy-g7.git/internal/repository/interfaces"
 	"github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
 	"github.com/maxwelbm/alkemy-g7.git/pkg/logger"
 )
 
 type EmployeeRepository struct {
-	db  *sql.DB
+	db  DBTX
 	log logger.Logger
 }
 
@@ -18,14 +20,16 @@ func CreateEmployeeRepository(db *sql.DB, log logger.Logger) *EmployeeRepository
 	return &EmployeeRepository{db: db, log: log}
 }
 
-func (e *EmployeeRepository) Get() ([]model.Employee, error) {
+func (e *EmployeeRepository) Get(ctx context.Context, params model.ListParams) ([]model.Employee, int, error) {
 	e.log.Log("EmployeeRepository", "INFO", "initializing Get function")
 
-	rows, err := e.db.Query("SELECT id, card_number_id, first_name, last_name, warehouse_id FROM employees")
+	list := newListQuery(params, model.EmployeeListOptions)
+	query, args := list.selectQuery("SELECT id, card_number_id, first_name, last_name, warehouse_id FROM employees")
+	rows, err := e.db.QueryContext(ctx, query, args...)
 
 	if err != nil {
 		e.log.Log("EmployeeRepository", "ERROR", fmt.Sprintf("failed to query employees: %v", err))
-		return nil, err
+		return nil, 0, err
 	}
 
 	defer rows.Close()
@@ -38,23 +42,34 @@ func (e *EmployeeRepository) Get() ([]model.Employee, error) {
 		err := rows.Scan(&employee.ID, &employee.CardNumberID, &employee.FirstName, &employee.LastName, &employee.WarehouseID)
 		if err != nil {
 			e.log.Log("EmployeeRepository", "ERROR", fmt.Sprintf("failed to scan employee row: %v", err))
-			return nil, err
+			return nil, 0, err
 		}
 
 		employees = append(employees, employee)
 	}
 
+	total := len(employees)
+
+	if params.PageSize > 0 {
+		total, err = countRows(ctx, e.db, "SELECT COUNT(*) FROM employees", list)
+
+		if err != nil {
+			e.log.Log("EmployeeRepository", "ERROR", fmt.Sprintf("failed to count employees: %v", err))
+			return nil, 0, err
+		}
+	}
+
 	e.log.Log("EmployeeRepository", "INFO", fmt.Sprintf("Get function finished successfully, retrieved %d employees", len(employees)))
 
-	return employees, nil
+	return employees, total, nil
 }
 
-func (e *EmployeeRepository) GetByID(id int) (model.Employee, error) {
+func (e *EmployeeRepository) GetByID(ctx context.Context, id int) (model.Employee, error) {
 	e.log.Log("EmployeeRepository", "INFO", fmt.Sprintf("initializing GetByID function for employee ID: %d", id))
 
 	var employee model.Employee
 
-	row := e.db.QueryRow("SELECT id, card_number_id, first_name, last_name, warehouse_id FROM employees WHERE id = ?", id)
+	row := e.db.QueryRowContext(ctx, "SELECT id, card_number_id, first_name, last_name, warehouse_id FROM employees WHERE id = ?", id)
 
 	err := row.Scan(&employee.ID, &employee.CardNumberID, &employee.FirstName, &employee.LastName, &employee.WarehouseID)
 	if err == sql.ErrNoRows {
@@ -70,10 +85,10 @@ func (e *EmployeeRepository) GetByID(id int) (model.Employee, error) {
 	return employee, nil
 }
 
-func (e *EmployeeRepository) Post(employee model.Employee) (model.Employee, error) {
+func (e *EmployeeRepository) Post(ctx context.Context, employee model.Employee) (model.Employee, error) {
 	e.log.Log("EmployeeRepository", "INFO", "initializing Post function")
 
-	result, err := e.db.Exec("INSERT INTO employees (card_number_id, first_name, last_name, warehouse_id) VALUES (?, ?, ?, ?)",
+	result, err := e.db.ExecContext(ctx, "INSERT INTO employees (card_number_id, first_name, last_name, warehouse_id) VALUES (?, ?, ?, ?)",
 		employee.CardNumberID, employee.FirstName, employee.LastName, employee.WarehouseID)
 
 	if err != nil {
@@ -93,10 +108,10 @@ func (e *EmployeeRepository) Post(employee model.Employee) (model.Employee, erro
 	return employee, nil
 }
 
-func (e *EmployeeRepository) Update(id int, employee model.Employee) (model.Employee, error) {
+func (e *EmployeeRepository) Update(ctx context.Context, id int, employee model.Employee) (model.Employee, error) {
 	e.log.Log("EmployeeRepository", "INFO", fmt.Sprintf("initializing Update function for employee ID: %d", id))
 
-	_, err := e.db.Exec("UPDATE employees SET card_number_id = ?, first_name = ?, last_name = ?, warehouse_id = ? WHERE id = ?",
+	_, err := e.db.ExecContext(ctx, "UPDATE employees SET card_number_id = ?, first_name = ?, last_name = ?, warehouse_id = ? WHERE id = ?",
 		employee.CardNumberID, employee.FirstName, employee.LastName, employee.WarehouseID, id)
 	if err != nil {
 		e.log.Log("EmployeeRepository", "ERROR", fmt.Sprintf("failed to update employee with ID: %d: %v", id, err))
@@ -109,10 +124,10 @@ func (e *EmployeeRepository) Update(id int, employee model.Employee) (model.Empl
 	return employee, nil
 }
 
-func (e *EmployeeRepository) Delete(id int) error {
+func (e *EmployeeRepository) Delete(ctx context.Context, id int) error {
 	e.log.Log("EmployeeRepository", "INFO", fmt.Sprintf("initializing Delete function for employee ID: %d", id))
 
-	_, err := e.db.Exec("DELETE FROM employees WHERE id = ?", id)
+	_, err := e.db.ExecContext(ctx, "DELETE FROM employees WHERE id = ?", id)
 	if err != nil {
 		e.log.Log("EmployeeRepository", "ERROR", fmt.Sprintf("failed to delete employee with ID: %d: %v", id, err))
 		return err
@@ -123,7 +138,7 @@ func (e *EmployeeRepository) Delete(id int) error {
 	return nil
 }
 
-func (e *EmployeeRepository) GetInboundOrdersReportByEmployee(employeeID int) (model.InboundOrdersReportByEmployee, error) {
+func (e *EmployeeRepository) GetInboundOrdersReportByEmployee(ctx context.Context, employeeID int) (model.InboundOrdersReportByEmployee, error) {
 	e.log.Log("EmployeeRepository", "INFO", fmt.Sprintf("initializing GetInboundOrdersReportByEmployee function for employee ID: %d", employeeID))
 
 	query := `
@@ -137,7 +152,7 @@ func (e *EmployeeRepository) GetInboundOrdersReportByEmployee(employeeID int) (m
 		WHERE e.id = ?
 		GROUP BY e.id `
 
-	rows := e.db.QueryRow(query, employeeID)
+	rows := e.db.QueryRowContext(ctx, query, employeeID)
 
 	var inboundReport model.InboundOrdersReportByEmployee
 
@@ -160,7 +175,7 @@ func (e *EmployeeRepository) GetInboundOrdersReportByEmployee(employeeID int) (m
 	return inboundReport, nil
 }
 
-func (e *EmployeeRepository) GetInboundOrdersReports() ([]model.InboundOrdersReportByEmployee, error) {
+func (e *EmployeeRepository) GetInboundOrdersReports(ctx context.Context) ([]model.InboundOrdersReportByEmployee, error) {
 	e.log.Log("EmployeeRepository", "INFO", "initializing GetInboundOrdersReports function")
 
 	query := `
@@ -173,7 +188,7 @@ func (e *EmployeeRepository) GetInboundOrdersReports() ([]model.InboundOrdersRep
 			ON i.employee_id = e.id
 		GROUP BY e.id `
 
-	rows, err := e.db.Query(query)
+	rows, err := e.db.QueryContext(ctx, query)
 	if err != nil {
 		e.log.Log("EmployeeRepository", "ERROR", fmt.Sprintf("failed to query inbound orders reports: %v", err))
 		return nil, err
@@ -199,3 +214,8 @@ func (e *EmployeeRepository) GetInboundOrdersReports() ([]model.InboundOrdersRep
 
 	return inboundReports, nil
 }
+
+// WithTx implements interfaces.IEmployeeRepo.
+func (e *EmployeeRepository) WithTx(tx *sql.Tx) interfaces.IEmployeeRepo {
+	return &EmployeeRepository{db: tx, log: e.log}
+}
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
@@ -32,12 +33,31 @@ func TestEmployeeRepository_Get(t *testing.T) {
 			rows.AddRow(emp.ID, emp.CardNumberID, emp.FirstName, emp.LastName, emp.WarehouseID)
 		}
 
-		mock.ExpectQuery("SELECT id, card_number_id, first_name, last_name, warehouse_id FROM employees").
+		mock.ExpectQuery("SELECT id, card_number_id, first_name, last_name, warehouse_id FROM employees ORDER BY id").
 			WillReturnRows(rows)
 
-		result, err := rp.Get()
+		result, total, err := rp.Get(context.Background(), model.ListParams{})
 		assert.NoError(t, err)
 		assert.Equal(t, employees, result)
+		assert.Equal(t, 2, total)
+	})
+
+	t.Run("retrieving a filtered and sorted page of employees", func(t *testing.T) {
+		employee := model.Employee{ID: 3, CardNumberID: "11111", FirstName: "Anna", LastName: "Lee", WarehouseID: 1}
+		params := model.ListParams{Page: 2, PageSize: 1, Sort: "last_name", Desc: true, Filters: map[string]string{"warehouse_id": "1"}}
+
+		mock.ExpectQuery("SELECT id, card_number_id, first_name, last_name, warehouse_id FROM employees WHERE warehouse_id = ? ORDER BY last_name DESC, id LIMIT ? OFFSET ?").
+			WithArgs("1", 1, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "card_number_id", "first_name", "last_name", "warehouse_id"}).
+				AddRow(employee.ID, employee.CardNumberID, employee.FirstName, employee.LastName, employee.WarehouseID))
+		mock.ExpectQuery("SELECT COUNT(*) FROM employees WHERE warehouse_id = ?").
+			WithArgs("1").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+
+		result, total, err := rp.Get(context.Background(), params)
+		assert.NoError(t, err)
+		assert.Equal(t, []model.Employee{employee}, result)
+		assert.Equal(t, 3, total)
 	})
 }
 
@@ -67,7 +87,7 @@ func TestEmployeeRepository_GetByID(t *testing.T) {
 			WithArgs(employeeID).
 			WillReturnRows(rows)
 
-		result, err := rp.GetByID(employeeID)
+		result, err := rp.GetByID(context.Background(), employeeID)
 		assert.NoError(t, err)
 		assert.Equal(t, employee, result)
 	})
@@ -79,7 +99,7 @@ func TestEmployeeRepository_GetByID(t *testing.T) {
 			WithArgs(employeeID).
 			WillReturnRows(sqlmock.NewRows([]string{"id"}))
 
-		_, err := rp.GetByID(employeeID)
+		_, err := rp.GetByID(context.Background(), employeeID)
 		assert.Error(t, err)
 	})
 }
@@ -105,7 +125,7 @@ func TestEmployeeRepository_Post(t *testing.T) {
 			WithArgs(employee.CardNumberID, employee.FirstName, employee.LastName, employee.WarehouseID).
 			WillReturnResult(sqlmock.NewResult(1, 1))
 
-		result, err := rp.Post(employee)
+		result, err := rp.Post(context.Background(), employee)
 		assert.NoError(t, err)
 		assert.Equal(t, 1, result.ID)
 	})
@@ -133,7 +153,7 @@ func TestEmployeeRepository_Update(t *testing.T) {
 			WithArgs(employee.CardNumberID, employee.FirstName, employee.LastName, employee.WarehouseID, employeeID).
 			WillReturnResult(sqlmock.NewResult(1, 1))
 
-		result, err := rp.Update(employeeID, employee)
+		result, err := rp.Update(context.Background(), employeeID, employee)
 		assert.NoError(t, err)
 		assert.Equal(t, employeeID, result.ID)
 	})
@@ -155,7 +175,7 @@ func TestEmployeeRepository_Delete(t *testing.T) {
 			WithArgs(employeeID).
 			WillReturnResult(sqlmock.NewResult(1, 1))
 
-		err := rp.Delete(employeeID)
+		err := rp.Delete(context.Background(), employeeID)
 		assert.NoError(t, err)
 	})
 }
@@ -187,7 +207,7 @@ func TestEmployeeRepository_GetInboundOrdersReportByEmployee(t *testing.T) {
 			WithArgs(employeeID).
 			WillReturnRows(rows)
 
-		result, err := rp.GetInboundOrdersReportByEmployee(employeeID)
+		result, err := rp.GetInboundOrdersReportByEmployee(context.Background(), employeeID)
 		assert.NoError(t, err)
 		assert.Equal(t, report, result)
 	})
@@ -199,7 +219,7 @@ func TestEmployeeRepository_GetInboundOrdersReportByEmployee(t *testing.T) {
 			WithArgs(employeeID).
 			WillReturnRows(sqlmock.NewRows([]string{"id"}))
 
-		_, err := rp.GetInboundOrdersReportByEmployee(employeeID)
+		_, err := rp.GetInboundOrdersReportByEmployee(context.Background(), employeeID)
 		assert.Error(t, err)
 	})
 }
@@ -227,7 +247,7 @@ func TestEmployeeRepository_GetInboundOrdersReports(t *testing.T) {
 		mock.ExpectQuery("SELECT e.id, e.card_number_id, e.first_name, e.last_name, e.warehouse_id, COUNT(i.id) as inbound_orders_count FROM employees e LEFT JOIN inbound_orders i ON i.employee_id = e.id GROUP BY e.id").
 			WillReturnRows(rows)
 
-		result, err := rp.GetInboundOrdersReports()
+		result, err := rp.GetInboundOrdersReports(context.Background())
 		assert.NoError(t, err)
 		assert.Equal(t, reports, result)
 	})
@@ -1,15 +1,19 @@
 package repository
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
+	"strings"
 
 	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+	"github.com/maxwelbm/alkemy-g7.git/internal/repository/interfaces"
+	"github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
 	"github.com/maxwelbm/alkemy-g7.git/pkg/logger"
 )
 
 type InboundOrderService struct {
-	db  *sql.DB
+	db  DBTX
 	log logger.Logger
 }
 
@@ -20,7 +24,7 @@ func NewInboundService(db *sql.DB, log logger.Logger) *InboundOrderService {
 	}
 }
 
-func (i *InboundOrderService) Post(inboundOrder model.InboundOrder) (model.InboundOrder, error) {
+func (i *InboundOrderService) Post(ctx context.Context, inboundOrder model.InboundOrder) (model.InboundOrder, error) {
 	i.log.Log("InboundOrderService", "INFO", "initializing Post function for inbound order")
 
 	query := `
@@ -28,7 +32,8 @@ func (i *InboundOrderService) Post(inboundOrder model.InboundOrder) (model.Inbou
 		VALUES (?, ?, ?, ?, ?)
 	`
 
-	result, err := i.db.Exec(
+	result, err := i.db.ExecContext(
+		ctx,
 		query,
 		inboundOrder.OrderDate,
 		inboundOrder.OrderNumber,
@@ -55,3 +60,137 @@ func (i *InboundOrderService) Post(inboundOrder model.InboundOrder) (model.Inbou
 
 	return inboundOrder, nil
 }
+
+// AddBatches links every batch received with the inbound order.
+func (i *InboundOrderService) AddBatches(ctx context.Context, inboundOrderID int, productBatchIDs []int) error {
+	i.log.Log("InboundOrderService", "INFO", fmt.Sprintf("initializing AddBatches function for inbound order %d", inboundOrderID))
+
+	values := make([]string, 0, len(productBatchIDs))
+	args := make([]any, 0, len(productBatchIDs)*2)
+
+	for _, productBatchID := range productBatchIDs {
+		values = append(values, "(?, ?)")
+		args = append(args, inboundOrderID, productBatchID)
+	}
+
+	query := "INSERT INTO inbound_order_batches (inbound_order_id, product_batch_id) VALUES " + strings.Join(values, ", ")
+
+	_, err := i.db.ExecContext(ctx, query, args...)
+
+	if err != nil {
+		i.log.Log("InboundOrderService", "ERROR", fmt.Sprintf("failed to link product batches: %v", err))
+		return err
+	}
+
+	return nil
+}
+
+func (i *InboundOrderService) Get(ctx context.Context, params model.ListParams) ([]model.InboundOrder, int, error) {
+	i.log.Log("InboundOrderService", "INFO", "initializing Get function for inbound orders")
+
+	list := newListQuery(params, model.InboundOrderListOptions)
+	query, args := list.selectQuery("SELECT `id`, `order_date`, `order_number`, `employee_id`, `product_batch_id`, `warehouse_id` FROM `inbound_orders`")
+
+	rows, err := i.db.QueryContext(ctx, query, args...)
+
+	if err != nil {
+		i.log.Log("InboundOrderService", "ERROR", fmt.Sprintf("failed to list inbound orders: %v", err))
+		return nil, 0, err
+	}
+
+	defer rows.Close()
+
+	var inboundOrders []model.InboundOrder
+
+	for rows.Next() {
+		var inboundOrder model.InboundOrder
+
+		err = rows.Scan(&inboundOrder.ID, &inboundOrder.OrderDate, &inboundOrder.OrderNumber, &inboundOrder.EmployeeID, &inboundOrder.ProductBatchID, &inboundOrder.WareHouseID)
+
+		if err != nil {
+			i.log.Log("InboundOrderService", "ERROR", fmt.Sprintf("failed to scan inbound order: %v", err))
+			return nil, 0, err
+		}
+
+		inboundOrders = append(inboundOrders, inboundOrder)
+	}
+
+	if err = rows.Err(); err != nil {
+		i.log.Log("InboundOrderService", "ERROR", fmt.Sprintf("failed to list inbound orders: %v", err))
+		return nil, 0, err
+	}
+
+	total := len(inboundOrders)
+
+	if params.PageSize > 0 {
+		total, err = countRows(ctx, i.db, "SELECT COUNT(*) FROM `inbound_orders`", list)
+
+		if err != nil {
+			i.log.Log("InboundOrderService", "ERROR", fmt.Sprintf("failed to count inbound orders: %v", err))
+			return nil, 0, err
+		}
+	}
+
+	i.log.Log("InboundOrderService", "INFO", fmt.Sprintf("Get function finished successfully, found %d inbound orders", len(inboundOrders)))
+
+	return inboundOrders, total, nil
+}
+
+// GetByID returns the inbound order with the ids of all the batches it received.
+func (i *InboundOrderService) GetByID(ctx context.Context, id int) (model.InboundOrder, error) {
+	i.log.Log("InboundOrderService", "INFO", fmt.Sprintf("initializing GetByID function for inbound order %d", id))
+
+	var inboundOrder model.InboundOrder
+
+	row := i.db.QueryRowContext(ctx, "SELECT id, order_date, order_number, employee_id, product_batch_id, warehouse_id FROM inbound_orders WHERE id = ?", id)
+
+	err := row.Scan(&inboundOrder.ID, &inboundOrder.OrderDate, &inboundOrder.OrderNumber, &inboundOrder.EmployeeID, &inboundOrder.ProductBatchID, &inboundOrder.WareHouseID)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			err = customerror.InboundErrNotFound
+		}
+
+		i.log.Log("InboundOrderService", "ERROR", fmt.Sprintf("failed to get inbound order: %v", err))
+
+		return model.InboundOrder{}, err
+	}
+
+	rows, err := i.db.QueryContext(ctx, "SELECT product_batch_id FROM inbound_order_batches WHERE inbound_order_id = ? ORDER BY id", id)
+
+	if err != nil {
+		i.log.Log("InboundOrderService", "ERROR", fmt.Sprintf("failed to get inbound order batches: %v", err))
+		return model.InboundOrder{}, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var productBatchID int
+
+		if err = rows.Scan(&productBatchID); err != nil {
+			i.log.Log("InboundOrderService", "ERROR", fmt.Sprintf("failed to scan inbound order batch: %v", err))
+			return model.InboundOrder{}, err
+		}
+
+		inboundOrder.ProductBatchIDs = append(inboundOrder.ProductBatchIDs, productBatchID)
+	}
+
+	if err = rows.Err(); err != nil {
+		i.log.Log("InboundOrderService", "ERROR", fmt.Sprintf("failed to get inbound order batches: %v", err))
+		return model.InboundOrder{}, err
+	}
+
+	if len(inboundOrder.ProductBatchIDs) == 0 {
+		inboundOrder.ProductBatchIDs = []int{inboundOrder.ProductBatchID}
+	}
+
+	i.log.Log("InboundOrderService", "INFO", fmt.Sprintf("GetByID function finished successfully, found inbound order %d", id))
+
+	return inboundOrder, nil
+}
+
+// WithTx implements interfaces.IInboundOrderRepository.
+func (i *InboundOrderService) WithTx(tx *sql.Tx) interfaces.IInboundOrderRepository {
+	return &InboundOrderService{db: tx, log: i.log}
+}
@@ -1,12 +1,15 @@
 package repository
 
 import (
+	"context"
+	"database/sql"
 	"testing"
 	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/maxwelbm/alkemy-g7.git/internal/mocks"
 	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+	"github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -32,7 +35,7 @@ func TestPostInboundOrder(t *testing.T) {
 			WithArgs(inboundOrder.OrderDate, inboundOrder.OrderNumber, inboundOrder.EmployeeID, inboundOrder.ProductBatchID, inboundOrder.WareHouseID).
 			WillReturnResult(sqlmock.NewResult(1, 1))
 
-		result, err := repo.Post(inboundOrder)
+		result, err := repo.Post(context.Background(), inboundOrder)
 
 		assert.NoError(t, err)
 		assert.Equal(t, 1, result.ID)
@@ -46,3 +49,130 @@ func TestPostInboundOrder(t *testing.T) {
 	})
 
 }
+
+func TestGetInboundOrders(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	repo := NewInboundService(db, mocks.MockLog{})
+	columns := []string{"id", "order_date", "order_number", "employee_id", "product_batch_id", "warehouse_id"}
+	query := "SELECT `id`, `order_date`, `order_number`, `employee_id`, `product_batch_id`, `warehouse_id` FROM `inbound_orders`"
+
+	t.Run("should list the inbound orders filtered by warehouse, employee and date range", func(t *testing.T) {
+		params := model.ListParams{Page: 1, PageSize: 10, Sort: "order_date", Filters: map[string]string{
+			"warehouse_id":    "1",
+			"employee_id":     "2",
+			"order_date_from": "2023-10-01",
+			"order_date_to":   "2023-10-31",
+		}}
+		expected := model.InboundOrder{ID: 1, OrderDate: time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC), OrderNumber: "ORD123", EmployeeID: 2, ProductBatchID: 1, WareHouseID: 1}
+		where := " WHERE `employee_id` = ? AND `order_date` >= ? AND `order_date` < ? + INTERVAL 1 DAY AND `warehouse_id` = ?"
+
+		mock.ExpectQuery(query+where+" ORDER BY `order_date`, `id` LIMIT ? OFFSET ?").
+			WithArgs("2", "2023-10-01", "2023-10-31", "1", 10, 0).
+			WillReturnRows(sqlmock.NewRows(columns).AddRow(expected.ID, expected.OrderDate, expected.OrderNumber, expected.EmployeeID, expected.ProductBatchID, expected.WareHouseID))
+		mock.ExpectQuery("SELECT COUNT(*) FROM `inbound_orders`"+where).
+			WithArgs("2", "2023-10-01", "2023-10-31", "1").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+		result, total, err := repo.Get(context.Background(), params)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []model.InboundOrder{expected}, result)
+		assert.Equal(t, 1, total)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("should return error when the query fails", func(t *testing.T) {
+		mock.ExpectQuery(query + " ORDER BY `id`").WillReturnError(sql.ErrConnDone)
+
+		result, total, err := repo.Get(context.Background(), model.ListParams{})
+
+		assert.ErrorIs(t, err, sql.ErrConnDone)
+		assert.Nil(t, result)
+		assert.Zero(t, total)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestGetInboundOrderByID(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	repo := NewInboundService(db, mocks.MockLog{})
+	columns := []string{"id", "order_date", "order_number", "employee_id", "product_batch_id", "warehouse_id"}
+	query := "SELECT id, order_date, order_number, employee_id, product_batch_id, warehouse_id FROM inbound_orders WHERE id = ?"
+	batchesQuery := "SELECT product_batch_id FROM inbound_order_batches WHERE inbound_order_id = ? ORDER BY id"
+	orderDate := time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("should return the inbound order with all its batches", func(t *testing.T) {
+		mock.ExpectQuery(query).WithArgs(1).
+			WillReturnRows(sqlmock.NewRows(columns).AddRow(1, orderDate, "ORD123", 1, 4, 1))
+		mock.ExpectQuery(batchesQuery).WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"product_batch_id"}).AddRow(4).AddRow(5))
+
+		result, err := repo.GetByID(context.Background(), 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, model.InboundOrder{ID: 1, OrderDate: orderDate, OrderNumber: "ORD123", EmployeeID: 1, ProductBatchID: 4, WareHouseID: 1, ProductBatchIDs: []int{4, 5}}, result)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("should fall back to the product batch of the order when no batches are linked", func(t *testing.T) {
+		mock.ExpectQuery(query).WithArgs(2).
+			WillReturnRows(sqlmock.NewRows(columns).AddRow(2, orderDate, "ORD124", 1, 3, 1))
+		mock.ExpectQuery(batchesQuery).WithArgs(2).
+			WillReturnRows(sqlmock.NewRows([]string{"product_batch_id"}))
+
+		result, err := repo.GetByID(context.Background(), 2)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{3}, result.ProductBatchIDs)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("should return not found when the inbound order does not exist", func(t *testing.T) {
+		mock.ExpectQuery(query).WithArgs(99).WillReturnError(sql.ErrNoRows)
+
+		result, err := repo.GetByID(context.Background(), 99)
+
+		assert.Equal(t, customerror.InboundErrNotFound, err)
+		assert.Empty(t, result)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestAddInboundOrderBatches(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	repo := NewInboundService(db, mocks.MockLog{})
+	query := "INSERT INTO inbound_order_batches (inbound_order_id, product_batch_id) VALUES (?, ?), (?, ?)"
+
+	t.Run("should link every batch with the inbound order", func(t *testing.T) {
+		mock.ExpectExec(query).WithArgs(1, 4, 1, 5).WillReturnResult(sqlmock.NewResult(2, 2))
+
+		err := repo.AddBatches(context.Background(), 1, []int{4, 5})
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("should return error when the insert fails", func(t *testing.T) {
+		mock.ExpectExec(query).WithArgs(1, 4, 1, 5).WillReturnError(sql.ErrConnDone)
+
+		err := repo.AddBatches(context.Background(), 1, []int{4, 5})
+
+		assert.ErrorIs(t, err, sql.ErrConnDone)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -1,13 +1,19 @@
 package interfaces
 
-import "github.com/maxwelbm/alkemy-g7.git/internal/model"
+import (
+	"context"
+	"database/sql"
+
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+)
 
 type IBuyerRepo interface {
-	Get() (buyers []model.Buyer, err error)
-	GetByID(id int) (buyer model.Buyer, err error)
-	Post(newBuyer model.Buyer) (id int64, err error)
-	Update(id int, newBuyer model.Buyer) (err error)
-	Delete(id int) (err error)
-	CountPurchaseOrderByBuyerID(id int) (countBuyerPurchaseOrder model.BuyerPurchaseOrder, err error)
-	CountPurchaseOrderBuyers() (countBuyerPurchaseOrder []model.BuyerPurchaseOrder, err error)
+	Get(ctx context.Context, params model.ListParams) (buyers []model.Buyer, total int, err error)
+	GetByID(ctx context.Context, id int) (buyer model.Buyer, err error)
+	Post(ctx context.Context, newBuyer model.Buyer) (id int64, err error)
+	Update(ctx context.Context, id int, newBuyer model.Buyer) (err error)
+	Delete(ctx context.Context, id int) (err error)
+	CountPurchaseOrderByBuyerID(ctx context.Context, id int) (countBuyerPurchaseOrder model.BuyerPurchaseOrder, err error)
+	CountPurchaseOrderBuyers(ctx context.Context) (countBuyerPurchaseOrder []model.BuyerPurchaseOrder, err error)
+	WithTx(tx *sql.Tx) IBuyerRepo
 }
@@ -1,8 +1,17 @@
 package interfaces
 
-import "github.com/maxwelbm/alkemy-g7.git/internal/model"
+import (
+	"context"
+	"database/sql"
+
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+)
 
 type ICarriersRepo interface {
-	PostCarrier(newCarrier model.Carries) (id int64, err error)
-	GetByID(id int) (carrier model.Carries, err error)
+	PostCarrier(ctx context.Context, newCarrier model.Carries) (id int64, err error)
+	GetByID(ctx context.Context, id int) (carrier model.Carries, err error)
+	Get(ctx context.Context, params model.ListParams) (carriers []model.Carries, total int, err error)
+	PatchCarrier(ctx context.Context, id int, carrier model.Carries) (err error)
+	DeleteCarrier(ctx context.Context, id int) (err error)
+	WithTx(tx *sql.Tx) ICarriersRepo
 }
@@ -0,0 +1,19 @@
+package interfaces
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+)
+
+type ICountryRepo interface {
+	Get(ctx context.Context, params model.ListParams) ([]model.Country, int, error)
+	GetByID(ctx context.Context, id int) (model.Country, error)
+	Post(ctx context.Context, country model.Country) (model.Country, error)
+	Update(ctx context.Context, id int, country model.Country) (model.Country, error)
+	Delete(ctx context.Context, id int) error
+	CountProvinces(ctx context.Context, id int) (int, error)
+	GetReport(ctx context.Context, id int) ([]model.CountryReport, error)
+	WithTx(tx *sql.Tx) ICountryRepo
+}
@@ -1,13 +1,19 @@
 package interfaces
 
-import "github.com/maxwelbm/alkemy-g7.git/internal/model"
+import (
+	"context"
+	"database/sql"
+
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+)
 
 type IEmployeeRepo interface {
-	Get() ([]model.Employee, error)
-	GetByID(id int) (model.Employee, error)
-	Update(id int, employee model.Employee) (model.Employee, error)
-	Post(employee model.Employee) (model.Employee, error)
-	Delete(id int) error
-	GetInboundOrdersReportByEmployee(employeeID int) (model.InboundOrdersReportByEmployee, error)
-	GetInboundOrdersReports() ([]model.InboundOrdersReportByEmployee, error)
+	Get(ctx context.Context, params model.ListParams) ([]model.Employee, int, error)
+	GetByID(ctx context.Context, id int) (model.Employee, error)
+	Update(ctx context.Context, id int, employee model.Employee) (model.Employee, error)
+	Post(ctx context.Context, employee model.Employee) (model.Employee, error)
+	Delete(ctx context.Context, id int) error
+	GetInboundOrdersReportByEmployee(ctx context.Context, employeeID int) (model.InboundOrdersReportByEmployee, error)
+	GetInboundOrdersReports(ctx context.Context) ([]model.InboundOrdersReportByEmployee, error)
+	WithTx(tx *sql.Tx) IEmployeeRepo
 }
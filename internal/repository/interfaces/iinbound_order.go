@@ -1,7 +1,16 @@
 package interfaces
 
-import "github.com/maxwelbm/alkemy-g7.git/internal/model"
+import (
+	"context"
+	"database/sql"
+
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+)
 
 type IInboundOrderRepository interface {
-	Post(inboundOrder model.InboundOrder) (model.InboundOrder, error)
+	Get(ctx context.Context, params model.ListParams) ([]model.InboundOrder, int, error)
+	GetByID(ctx context.Context, id int) (model.InboundOrder, error)
+	Post(ctx context.Context, inboundOrder model.InboundOrder) (model.InboundOrder, error)
+	AddBatches(ctx context.Context, inboundOrderID int, productBatchIDs []int) error
+	WithTx(tx *sql.Tx) IInboundOrderRepository
 }
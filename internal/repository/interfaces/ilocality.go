@@ -1,14 +1,20 @@
 package interfaces
 
 import (
+	"context"
+	"database/sql"
+
 	"github.com/maxwelbm/alkemy-g7.git/internal/model"
 )
 
 type ILocalityRepo interface {
-	GetReportSellersWithID(id int) (locality []model.LocalitiesJSONSellers, err error)
-	GetSellers(id int) (report []model.LocalitiesJSONSellers, err error)
-	GetReportCarriersWithID(id int) (locality []model.LocalitiesJSONCarriers, err error)
-	GetCarriers(id int) (report []model.LocalitiesJSONCarriers, err error)
-	GetByID(id int) (model.Locality, error)
-	CreateLocality(l *model.Locality) (model.Locality, error)
+	GetSellers(ctx context.Context, id int) (report []model.LocalitiesJSONSellers, err error)
+	GetCarriers(ctx context.Context, id int) (report []model.LocalitiesJSONCarriers, err error)
+	GetByID(ctx context.Context, id int) (model.Locality, error)
+	Get(ctx context.Context, params model.ListParams) ([]model.Locality, int, error)
+	CreateLocality(ctx context.Context, l *model.Locality) (model.Locality, error)
+	UpdateLocality(ctx context.Context, id int, l *model.Locality) (model.Locality, error)
+	DeleteLocality(ctx context.Context, id int) error
+	CountDependencies(ctx context.Context, id int) (model.LocalityDependencies, error)
+	WithTx(tx *sql.Tx) ILocalityRepo
 }
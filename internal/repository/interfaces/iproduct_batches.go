@@ -1,8 +1,21 @@
 package interfaces
 
-import "github.com/maxwelbm/alkemy-g7.git/internal/model"
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+)
 
 type IProductBatchesRepo interface {
-	GetByID(id int) (model.ProductBatches, error)
-	Post(prodBatches *model.ProductBatches) (model.ProductBatches, error)
+	Get(ctx context.Context, params model.ListParams) ([]model.ProductBatches, int, error)
+	GetByID(ctx context.Context, id int) (model.ProductBatches, error)
+	GetByIDForUpdate(ctx context.Context, id int) (model.ProductBatches, error)
+	Post(ctx context.Context, prodBatches *model.ProductBatches) (model.ProductBatches, error)
+	Update(ctx context.Context, id int, prodBatches *model.ProductBatches) (model.ProductBatches, error)
+	Delete(ctx context.Context, id int) error
+	CountReferences(ctx context.Context, id int) (model.ProductBatchesReferences, error)
+	GetExpiring(ctx context.Context, until time.Time) ([]model.ExpiringBatch, error)
+	WithTx(tx *sql.Tx) IProductBatchesRepo
 }
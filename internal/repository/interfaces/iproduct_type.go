@@ -0,0 +1,19 @@
+package interfaces
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+)
+
+type IProductTypeRepo interface {
+	Get(ctx context.Context) ([]model.ProductType, error)
+	GetByID(ctx context.Context, id int) (model.ProductType, error)
+	Post(ctx context.Context, productType model.ProductType) (model.ProductType, error)
+	Update(ctx context.Context, id int, productType model.ProductType) (model.ProductType, error)
+	Delete(ctx context.Context, id int) error
+	CountUsage(ctx context.Context) ([]model.ProductTypeUsage, error)
+	CountUsageByID(ctx context.Context, id int) (model.ProductTypeUsage, error)
+	WithTx(tx *sql.Tx) IProductTypeRepo
+}
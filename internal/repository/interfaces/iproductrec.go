@@ -1,11 +1,24 @@
 package interfaces
 
-import "github.com/maxwelbm/alkemy-g7.git/internal/model"
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+)
 
 type IProductRecRepository interface {
-	Create(pr model.ProductRecords) (model.ProductRecords, error)
-	GetAll() ([]model.ProductRecords, error)
-	GetByID(id int) (model.ProductRecords, error)
-	GetByIDProduct(idProduct int) ([]model.ProductRecords, error)
-	GetAllReport() ([]model.ProductRecordsReport, error)
-}
\ No newline at end of file
+	Create(ctx context.Context, pr model.ProductRecords) (model.ProductRecords, error)
+	GetAll(ctx context.Context, params model.ListParams) ([]model.ProductRecords, int, error)
+	GetByID(ctx context.Context, id int) (model.ProductRecords, error)
+	GetByIDProduct(ctx context.Context, idProduct int) ([]model.ProductRecords, error)
+	GetLatestByIDProduct(ctx context.Context, idProduct int) (model.ProductRecords, error)
+	GetAllReport(ctx context.Context, idProduct int) ([]model.ProductRecordsReport, error)
+	CountPurchaseOrders(ctx context.Context, id int) (int, error)
+	Delete(ctx context.Context, id int) error
+	GetPriceHistory(ctx context.Context, idProduct int, from, to time.Time) ([]model.ProductRecords, error)
+	GetProductMargins(ctx context.Context, filter model.ProductMarginFilter) ([]model.ProductMarginReport, error)
+	GetSellerMargins(ctx context.Context, filter model.ProductMarginFilter) ([]model.SellerMarginReport, error)
+	WithTx(tx *sql.Tx) IProductRecRepository
+}
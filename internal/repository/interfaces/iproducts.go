@@ -1,11 +1,17 @@
 package interfaces
 
-import "github.com/maxwelbm/alkemy-g7.git/internal/model"
+import (
+	"context"
+	"database/sql"
+
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+)
 
 type IProductsRepo interface {
-	GetAll() (map[int]model.Product, error)
-	GetByID(id int) (model.Product, error)
-	Create(product model.Product) (model.Product, error)
-	Update(id int, product model.Product) (model.Product, error)
-	Delete(id int) error
+	GetAll(ctx context.Context, params model.ListParams) ([]model.Product, int, error)
+	GetByID(ctx context.Context, id int) (model.Product, error)
+	Create(ctx context.Context, product model.Product) (model.Product, error)
+	Update(ctx context.Context, id int, product model.Product) (model.Product, error)
+	Delete(ctx context.Context, id int) error
+	WithTx(tx *sql.Tx) IProductsRepo
 }
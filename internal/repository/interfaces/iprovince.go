@@ -0,0 +1,20 @@
+package interfaces
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+)
+
+type IProvinceRepo interface {
+	Get(ctx context.Context, params model.ListParams) ([]model.Province, int, error)
+	GetByID(ctx context.Context, id int) (model.Province, error)
+	GetByName(ctx context.Context, provinceName, countryName string) (model.Province, error)
+	Post(ctx context.Context, province model.Province) (model.Province, error)
+	Update(ctx context.Context, id int, province model.Province) (model.Province, error)
+	Delete(ctx context.Context, id int) error
+	CountLocalities(ctx context.Context, id int) (int, error)
+	GetReport(ctx context.Context, id int) ([]model.ProvinceReport, error)
+	WithTx(tx *sql.Tx) IProvinceRepo
+}
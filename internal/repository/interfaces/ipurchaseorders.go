@@ -1,8 +1,19 @@
 package interfaces
 
-import "github.com/maxwelbm/alkemy-g7.git/internal/model"
+import (
+	"context"
+	"database/sql"
+
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+)
 
 type IPurchaseOrdersRepo interface {
-	GetByID(id int) (purchaseOrder model.PurchaseOrder, err error)
-	Post(newPurchaseOrder model.PurchaseOrder) (id int64, err error)
+	GetByID(ctx context.Context, id int) (purchaseOrder model.PurchaseOrder, err error)
+	GetByIDForUpdate(ctx context.Context, id int) (purchaseOrder model.PurchaseOrder, err error)
+	GetStatusesForUpdate(ctx context.Context, ids []int) (statuses map[int]string, err error)
+	Get(ctx context.Context, params model.ListParams) (purchaseOrders []model.PurchaseOrder, total int, err error)
+	Post(ctx context.Context, newPurchaseOrder model.PurchaseOrder) (id int64, err error)
+	UpdateStatus(ctx context.Context, id int, from string, to string) (err error)
+	RestoreBatches(ctx context.Context, purchaseOrderID int) (allocations []model.PurchaseOrderBatch, err error)
+	WithTx(tx *sql.Tx) IPurchaseOrdersRepo
 }
@@ -1,13 +1,22 @@
 package interfaces
 
-import "github.com/maxwelbm/alkemy-g7.git/internal/model"
+import (
+	"context"
+	"database/sql"
+
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+)
 
 type ISectionRepo interface {
-	Get() ([]model.Section, error)
-	GetByID(id int) (model.Section, error)
-	Post(section *model.Section) (model.Section, error)
-	Update(id int, section *model.Section) (model.Section, error)
-	Delete(id int) error
-	CountProductBatchesBySectionID(id int) (countProdBatches model.SectionProductBatches, err error)
-	CountProductBatchesSections() (countProductBatches []model.SectionProductBatches, err error)
+	Get(ctx context.Context, params model.ListParams) ([]model.Section, int, error)
+	GetByID(ctx context.Context, id int) (model.Section, error)
+	Post(ctx context.Context, section *model.Section) (model.Section, error)
+	Update(ctx context.Context, id int, section *model.Section) (model.Section, error)
+	Delete(ctx context.Context, id int) error
+	CountProductBatchesSections(ctx context.Context, id int) (countProductBatches []model.SectionProductBatches, err error)
+	IncreaseCurrentCapacity(ctx context.Context, id int, quantity int) error
+	DecreaseCurrentCapacity(ctx context.Context, id int, quantity int) error
+	SyncCurrentTemperature(ctx context.Context, id int) error
+	GetCapacityReport(ctx context.Context, nearFullRatio float64) ([]model.SectionCapacity, error)
+	WithTx(tx *sql.Tx) ISectionRepo
 }
@@ -1,11 +1,17 @@
 package interfaces
 
-import "github.com/maxwelbm/alkemy-g7.git/internal/model"
+import (
+	"context"
+	"database/sql"
+
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+)
 
 type ISellerRepo interface {
-	Get() ([]model.Seller, error)
-	GetByID(id int) (model.Seller, error)
-	Post(seller *model.Seller) (model.Seller, error)
-	Patch(id int, seller *model.Seller) (model.Seller, error)
-	Delete(id int) error
+	Get(ctx context.Context, params model.ListParams) ([]model.Seller, int, error)
+	GetByID(ctx context.Context, id int) (model.Seller, error)
+	Post(ctx context.Context, seller *model.Seller) (model.Seller, error)
+	Patch(ctx context.Context, id int, seller *model.Seller) (model.Seller, error)
+	Delete(ctx context.Context, id int) error
+	WithTx(tx *sql.Tx) ISellerRepo
 }
@@ -0,0 +1,21 @@
+package interfaces
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+)
+
+type IShipmentRepo interface {
+	Create(ctx context.Context, shipment model.Shipment) (int, error)
+	AddPurchaseOrders(ctx context.Context, shipmentID int, purchaseOrderIDs []int, trackingCode string) error
+	CountActiveByPurchaseOrders(ctx context.Context, purchaseOrderIDs []int) (int, error)
+	UpdateStatus(ctx context.Context, id int, status string, at time.Time) error
+	AddEvent(ctx context.Context, event model.ShipmentEvent) error
+	GetByID(ctx context.Context, id int) (model.Shipment, error)
+	GetByIDForUpdate(ctx context.Context, id int) (model.Shipment, error)
+	GetLatestByPurchaseOrder(ctx context.Context, purchaseOrderID int) (model.Shipment, error)
+	WithTx(tx *sql.Tx) IShipmentRepo
+}
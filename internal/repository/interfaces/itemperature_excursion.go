@@ -0,0 +1,21 @@
+package interfaces
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+)
+
+type ITemperatureExcursionRepo interface {
+	GetBatchLimits(ctx context.Context, sectionID int) ([]model.BatchTemperatureLimits, error)
+	GetBatchLimitsByID(ctx context.Context, batchID int) (model.BatchTemperatureLimits, error)
+	GetOpen(ctx context.Context, sectionID int) ([]model.TemperatureExcursion, error)
+	Open(ctx context.Context, excursion model.TemperatureExcursion) (int, error)
+	UpdatePeak(ctx context.Context, id int, peak float64) error
+	Close(ctx context.Context, id int, endedAt time.Time) error
+	GetBySection(ctx context.Context, sectionID int, status string) ([]model.TemperatureExcursion, error)
+	GetReport(ctx context.Context, filter model.ExcursionReportFilter) ([]model.SectionExcursionReport, error)
+	WithTx(tx *sql.Tx) ITemperatureExcursionRepo
+}
@@ -0,0 +1,16 @@
+package interfaces
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+)
+
+type ITemperatureReadingRepo interface {
+	BulkInsert(ctx context.Context, sectionID int, readings []model.TemperatureReading) (int, error)
+	GetLatestRecordedAt(ctx context.Context, sectionID int) (time.Time, error)
+	GetAggregates(ctx context.Context, query model.TemperatureQuery) ([]model.TemperatureAggregate, error)
+	WithTx(tx *sql.Tx) ITemperatureReadingRepo
+}
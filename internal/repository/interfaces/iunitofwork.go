@@ -0,0 +1,10 @@
+package interfaces
+
+import (
+	"context"
+	"database/sql"
+)
+
+type IUnitOfWork interface {
+	Do(ctx context.Context, fn func(tx *sql.Tx) error) error
+}
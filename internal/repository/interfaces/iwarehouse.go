@@ -1,11 +1,20 @@
 package interfaces
 
-import "github.com/maxwelbm/alkemy-g7.git/internal/model"
+import (
+	"context"
+	"database/sql"
+
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+)
 
 type IWarehouseRepo interface {
-	GetAllWareHouse() (w []model.WareHouse, err error)
-	GetByIDWareHouse(id int) (w model.WareHouse, err error)
-	PostWareHouse(warehouse model.WareHouse) (id int64, err error)
-	UpdateWareHouse(id int, warehouse model.WareHouse) (err error)
-	DeleteByIDWareHouse(id int) error
+	GetAllWareHouse(ctx context.Context, params model.ListParams) (w []model.WareHouse, total int, err error)
+	GetByIDWareHouse(ctx context.Context, id int) (w model.WareHouse, err error)
+	PostWareHouse(ctx context.Context, warehouse model.WareHouse) (id int64, err error)
+	UpdateWareHouse(ctx context.Context, id int, warehouse model.WareHouse) (err error)
+	DeleteByIDWareHouse(ctx context.Context, id int) error
+	GetNearestWareHouses(ctx context.Context, params model.NearestWareHouseParams) (w []model.NearestWareHouse, err error)
+	GetCapacityReportWareHouse(ctx context.Context, id int) (report []model.WareHouseCapacity, err error)
+	GetInventoryWareHouse(ctx context.Context, id int) (inventory []model.WareHouseInventoryRow, err error)
+	WithTx(tx *sql.Tx) IWarehouseRepo
 }
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/go-sql-driver/mysql"
 	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+	"github.com/maxwelbm/alkemy-g7.git/internal/repository/interfaces"
 	er "github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
 )
 
@@ -17,15 +19,15 @@ func CreateRepositoryLocalities(db *sql.DB, log logger.Logger) *LocalitiesReposi
 }
 
 type LocalitiesRepository struct {
-	db  *sql.DB
+	db  DBTX
 	log logger.Logger
 }
 
-func (rp *LocalitiesRepository) GetCarriers(id int) (report []model.LocalitiesJSONCarriers, err error) {
+func (rp *LocalitiesRepository) GetCarriers(ctx context.Context, id int) (report []model.LocalitiesJSONCarriers, err error) {
 	rp.log.Log("LocalitiesRepository", "INFO", "Get report Carriers function initializing")
 
-	query := "SELECT l.id, l.locality_name, COUNT(c.locality_id) AS `carriers_count` FROM `carriers` c RIGHT JOIN `locality` l ON c.locality_id = l.id GROUP BY l.id, l.locality_name ORDER BY l.locality_name"
-	rows, err := rp.db.Query(query)
+	query, args := localityReportQuery("carriers", "carriers_count", id)
+	rows, err := rp.db.QueryContext(ctx, query, args...)
 
 	if err != nil {
 		rp.log.Log("LocalitiesRepository", "ERROR", fmt.Sprintf("Error: %v", err))
@@ -48,46 +50,23 @@ func (rp *LocalitiesRepository) GetCarriers(id int) (report []model.LocalitiesJS
 		report = append(report, c)
 	}
 
-	rp.log.Log("LocalitiesRepository", "INFO", fmt.Sprintf("Retrieved report carriers: %+v", report))
-	rp.log.Log("LocalitiesRepository", "INFO", "Get report Carriers function completed")
-
-	return
-}
-
-func (rp *LocalitiesRepository) GetReportCarriersWithID(id int) (locality []model.LocalitiesJSONCarriers, err error) {
-	rp.log.Log("LocalitiesRepository", "INFO", "Get report Carrier by ID function initializing")
-
-	if _, err := rp.GetByID(id); err != nil {
-		rp.log.Log("LocalitiesRepository", "ERROR", fmt.Sprintf("Error: %v", err))
-
-		return locality, err
-	}
-
-	query := "SELECT l.id, l.locality_name, COUNT(c.locality_id) AS `carriers_count` FROM `carriers` c RIGHT JOIN `locality` l ON c.locality_id = l.id WHERE l.id = ? GROUP BY l.id, l.locality_name"
-	row := rp.db.QueryRow(query, id)
+	if id > 0 && len(report) == 0 {
+		rp.log.Log("LocalitiesRepository", "ERROR", fmt.Sprintf("Error: locality %d not found", id))
 
-	var c model.LocalitiesJSONCarriers
-	err = row.Scan(&c.ID, &c.Locality, &c.Carriers)
-
-	if err != nil {
-		rp.log.Log("LocalitiesRepository", "ERROR", fmt.Sprintf("Error: %v", err))
-
-		return
+		return report, er.ErrLocalityNotFound
 	}
 
-	locality = append(locality, c)
-
-	rp.log.Log("LocalitiesRepository", "INFO", fmt.Sprintf("Retrieved report carrier: %+v", locality))
-	rp.log.Log("LocalitiesRepository", "INFO", "Get report Carrier by ID function completed")
+	rp.log.Log("LocalitiesRepository", "INFO", fmt.Sprintf("Retrieved report carriers: %+v", report))
+	rp.log.Log("LocalitiesRepository", "INFO", "Get report Carriers function completed")
 
 	return
 }
 
-func (rp *LocalitiesRepository) GetSellers(id int) (report []model.LocalitiesJSONSellers, err error) {
+func (rp *LocalitiesRepository) GetSellers(ctx context.Context, id int) (report []model.LocalitiesJSONSellers, err error) {
 	rp.log.Log("LocalitiesRepository", "INFO", "Get report Sellers function initializing")
 
-	query := "SELECT l.id, l.locality_name, COUNT(s.locality_id) AS `sellers_count` FROM `sellers` s RIGHT JOIN `locality` l ON s.locality_id = l.id GROUP BY l.id, l.locality_name ORDER BY l.locality_name"
-	rows, err := rp.db.Query(query)
+	query, args := localityReportQuery("sellers", "sellers_count", id)
+	rows, err := rp.db.QueryContext(ctx, query, args...)
 
 	if err != nil {
 		rp.log.Log("LocalitiesRepository", "ERROR", fmt.Sprintf("Error: %v", err))
@@ -110,46 +89,49 @@ func (rp *LocalitiesRepository) GetSellers(id int) (report []model.LocalitiesJSO
 		report = append(report, l)
 	}
 
+	if id > 0 && len(report) == 0 {
+		rp.log.Log("LocalitiesRepository", "ERROR", fmt.Sprintf("Error: locality %d not found", id))
+
+		return report, er.ErrLocalityNotFound
+	}
+
 	rp.log.Log("LocalitiesRepository", "INFO", fmt.Sprintf("Retrieved report sellers: %+v", report))
 	rp.log.Log("LocalitiesRepository", "INFO", "Get report Sellers function completed")
 
 	return
 }
 
-func (rp *LocalitiesRepository) GetReportSellersWithID(id int) (locality []model.LocalitiesJSONSellers, err error) {
-	rp.log.Log("LocalitiesRepository", "INFO", "Get report Seller by ID function initializing")
+// localityReportQuery builds the per-locality count over table, restricted to a single locality when id is set.
+func localityReportQuery(table, alias string, id int) (query string, args []any) {
+	query = fmt.Sprintf("SELECT l.id, l.locality_name, COUNT(t.locality_id) AS `%s` FROM `locality` l LEFT JOIN `%s` t ON t.locality_id = l.id", alias, table)
 
-	if _, err := rp.GetByID(id); err != nil {
-		rp.log.Log("LocalitiesRepository", "ERROR", fmt.Sprintf("Error: %v", err))
-
-		return locality, err
+	if id > 0 {
+		query += " WHERE l.id = ?"
+		args = append(args, id)
 	}
 
-	query := "SELECT l.id, l.locality_name, COUNT(s.locality_id) AS `sellers_count` FROM `sellers` s RIGHT JOIN `locality` l ON s.locality_id = l.id WHERE l.id = ? GROUP BY l.id, l.locality_name"
-	row := rp.db.QueryRow(query, id)
-
-	var s model.LocalitiesJSONSellers
-	err = row.Scan(&s.ID, &s.Locality, &s.Sellers)
-
-	if err != nil {
-		rp.log.Log("LocalitiesRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+	query += " GROUP BY l.id, l.locality_name ORDER BY l.locality_name"
 
-		return
-	}
+	return
+}
 
-	locality = append(locality, s)
+// localityCountsJoin attaches to each locality l of a report the number of its sellers and carriers,
+// counted per locality first so the joins never multiply rows.
+const localityCountsJoin = " LEFT JOIN (SELECT `locality_id`, COUNT(*) AS `sellers_count` FROM `sellers` GROUP BY `locality_id`) s ON s.locality_id = l.id" +
+	" LEFT JOIN (SELECT `locality_id`, COUNT(*) AS `carriers_count` FROM `carriers` GROUP BY `locality_id`) ca ON ca.locality_id = l.id"
 
-	rp.log.Log("LocalitiesRepository", "INFO", fmt.Sprintf("Retrieved report seller: %+v", locality))
-	rp.log.Log("LocalitiesRepository", "INFO", "Get report Seller by ID function completed")
+// localityFrom joins each locality l to its province p and country c.
+const localityFrom = " FROM `locality` l INNER JOIN `provinces` p ON p.id = l.province_id INNER JOIN `countries` c ON c.id = p.id_country_fk"
 
-	return
-}
+// localitySelect reads localities with the names of their province and country.
+const localitySelect = "SELECT l.id, l.locality_name, l.province_id, p.province_name, c.country_name, l.latitude, l.longitude" + localityFrom
 
-func (rp *LocalitiesRepository) Get() (localities []model.Locality, err error) {
+func (rp *LocalitiesRepository) Get(ctx context.Context, params model.ListParams) (localities []model.Locality, total int, err error) {
 	rp.log.Log("LocalitiesRepository", "INFO", "Get localities function initializing")
 
-	query := "SELECT `id`, `locality_name`, `province_name`, `country_name` FROM `locality`"
-	rows, err := rp.db.Query(query)
+	list := newListQuery(params, model.LocalityListOptions)
+	query, args := list.selectQuery(localitySelect)
+	rows, err := rp.db.QueryContext(ctx, query, args...)
 
 	if err != nil {
 		rp.log.Log("LocalitiesRepository", "ERROR", fmt.Sprintf("Error: %v", err))
@@ -161,30 +143,47 @@ func (rp *LocalitiesRepository) Get() (localities []model.Locality, err error) {
 
 	for rows.Next() {
 		var locality model.Locality
-		err = rows.Scan(&locality.ID, &locality.Locality, &locality.Province, &locality.Country)
+		err = rows.Scan(&locality.ID, &locality.Locality, &locality.ProvinceID, &locality.Province, &locality.Country, &locality.Latitude, &locality.Longitude)
 
 		if err != nil {
 			rp.log.Log("LocalitiesRepository", "ERROR", fmt.Sprintf("Error: %v", err))
 
-			return
+			return nil, 0, err
 		}
 
 		localities = append(localities, locality)
 	}
 
+	if err = rows.Err(); err != nil {
+		rp.log.Log("LocalitiesRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return nil, 0, err
+	}
+
+	total = len(localities)
+
+	if params.PageSize > 0 {
+		total, err = countRows(ctx, rp.db, "SELECT COUNT(*)"+localityFrom, list)
+		if err != nil {
+			rp.log.Log("LocalitiesRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+
+			return nil, 0, err
+		}
+	}
+
 	rp.log.Log("LocalitiesRepository", "INFO", fmt.Sprintf("Retrieved localities: %+v", localities))
 	rp.log.Log("LocalitiesRepository", "INFO", "Get localities function completed")
 
 	return
 }
 
-func (rp *LocalitiesRepository) GetByID(id int) (l model.Locality, err error) {
+func (rp *LocalitiesRepository) GetByID(ctx context.Context, id int) (l model.Locality, err error) {
 	rp.log.Log("LocalitiesRepository", "INFO", "Get locality by ID function initializing")
 
-	query := "SELECT `id`, `locality_name`, `province_name`, `country_name` FROM `locality` WHERE `id` = ?"
-	row := rp.db.QueryRow(query, id)
+	query := localitySelect + " WHERE l.id = ?"
+	row := rp.db.QueryRowContext(ctx, query, id)
 
-	err = row.Scan(&l.ID, &l.Locality, &l.Province, &l.Country)
+	err = row.Scan(&l.ID, &l.Locality, &l.ProvinceID, &l.Province, &l.Country, &l.Latitude, &l.Longitude)
 
 	if errors.Is(err, sql.ErrNoRows) {
 		rp.log.Log("LocalitiesRepository", "ERROR", fmt.Sprintf("Error: %v", err))
@@ -200,11 +199,11 @@ func (rp *LocalitiesRepository) GetByID(id int) (l model.Locality, err error) {
 	return
 }
 
-func (rp *LocalitiesRepository) CreateLocality(locality *model.Locality) (l model.Locality, err error) {
+func (rp *LocalitiesRepository) CreateLocality(ctx context.Context, locality *model.Locality) (l model.Locality, err error) {
 	rp.log.Log("LocalitiesRepository", "INFO", "Create locality function initializing")
 
-	query := "INSERT INTO `locality` (`locality_name`, `province_name`, `country_name`) VALUES (?, ?, ?)"
-	result, err := rp.db.Exec(query, (*locality).Locality, (*locality).Province, (*locality).Country)
+	query := "INSERT INTO `locality` (`locality_name`, `province_id`, `latitude`, `longitude`) VALUES (?, ?, ?, ?)"
+	result, err := rp.db.ExecContext(ctx, query, (*locality).Locality, (*locality).ProvinceID, (*locality).Latitude, (*locality).Longitude)
 	err = rp.validateSQLError(err)
 
 	if err != nil {
@@ -220,7 +219,7 @@ func (rp *LocalitiesRepository) CreateLocality(locality *model.Locality) (l mode
 		return
 	}
 
-	l, _ = rp.GetByID(int(id))
+	l, _ = rp.GetByID(ctx, int(id))
 
 	rp.log.Log("LocalitiesRepository", "INFO", fmt.Sprintf("Created locality: %+v", l))
 	rp.log.Log("LocalitiesRepository", "INFO", "Create locality function completed")
@@ -228,6 +227,66 @@ func (rp *LocalitiesRepository) CreateLocality(locality *model.Locality) (l mode
 	return
 }
 
+func (rp *LocalitiesRepository) UpdateLocality(ctx context.Context, id int, locality *model.Locality) (l model.Locality, err error) {
+	rp.log.Log("LocalitiesRepository", "INFO", "Update locality function initializing")
+
+	query := "UPDATE `locality` SET `locality_name` = ?, `province_id` = ?, `latitude` = ?, `longitude` = ? WHERE `id` = ?"
+	_, err = rp.db.ExecContext(ctx, query, locality.Locality, locality.ProvinceID, locality.Latitude, locality.Longitude, id)
+	err = rp.validateSQLError(err)
+
+	if err != nil {
+		rp.log.Log("LocalitiesRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	l, err = rp.GetByID(ctx, id)
+
+	rp.log.Log("LocalitiesRepository", "INFO", fmt.Sprintf("Updated locality: %+v", l))
+	rp.log.Log("LocalitiesRepository", "INFO", "Update locality function completed")
+
+	return
+}
+
+func (rp *LocalitiesRepository) DeleteLocality(ctx context.Context, id int) (err error) {
+	rp.log.Log("LocalitiesRepository", "INFO", "Delete locality function initializing")
+
+	query := "DELETE FROM `locality` WHERE `id` = ?"
+	_, err = rp.db.ExecContext(ctx, query, id)
+	err = rp.validateSQLError(err)
+
+	if err != nil {
+		rp.log.Log("LocalitiesRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	rp.log.Log("LocalitiesRepository", "INFO", "Delete locality function completed")
+
+	return
+}
+
+// CountDependencies counts the sellers and carriers that reference the locality.
+func (rp *LocalitiesRepository) CountDependencies(ctx context.Context, id int) (d model.LocalityDependencies, err error) {
+	rp.log.Log("LocalitiesRepository", "INFO", "Count locality dependencies function initializing")
+
+	query := "SELECT (SELECT COUNT(*) FROM `sellers` WHERE `locality_id` = ?), (SELECT COUNT(*) FROM `carriers` WHERE `locality_id` = ?)"
+	err = rp.db.QueryRowContext(ctx, query, id, id).Scan(&d.Sellers, &d.Carriers)
+
+	if err != nil {
+		rp.log.Log("LocalitiesRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	d.ID = id
+
+	rp.log.Log("LocalitiesRepository", "INFO", fmt.Sprintf("Locality dependencies: %+v", d))
+	rp.log.Log("LocalitiesRepository", "INFO", "Count locality dependencies function completed")
+
+	return
+}
+
 func (rp *LocalitiesRepository) validateSQLError(err error) (e error) {
 	if err != nil {
 		var mysqlErr *mysql.MySQLError
@@ -237,6 +296,10 @@ func (rp *LocalitiesRepository) validateSQLError(err error) (e error) {
 				e = er.ErrInvalidLocalityJSONFormat
 			case 1048:
 				e = er.ErrNullLocalityAttribute
+			case 1451:
+				e = er.ErrLocalityDependencies
+			case 1452:
+				e = er.ErrLocalityProvinceNotFound
 			default:
 				e = er.ErrDefaultLocalitySQL
 			}
@@ -245,3 +308,8 @@ func (rp *LocalitiesRepository) validateSQLError(err error) (e error) {
 
 	return e
 }
+
+// WithTx implements interfaces.ILocalityRepo.
+func (rp *LocalitiesRepository) WithTx(tx *sql.Tx) interfaces.ILocalityRepo {
+	return &LocalitiesRepository{db: tx, log: rp.log}
+}
@@ -1,6 +1,7 @@
 package repository_test
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"net/http"
@@ -24,18 +25,18 @@ func TestLocalitiesRepository_CreateLocality(t *testing.T) {
 	rp := repository.CreateRepositoryLocalities(db, logMock)
 
 	t.Run("test repository method for create locality with success", func(t *testing.T) {
-		l := model.Locality{ID: 1, Locality: "Denver", Province: "Colorado", Country: "EUA"}
+		l := model.Locality{ID: 1, Locality: "Denver", ProvinceID: 1, Province: "Colorado", Country: "EUA"}
 
-		mock.ExpectExec("INSERT INTO `locality` (`locality_name`, `province_name`, `country_name`) VALUES (?, ?, ?)").
-			WithArgs(l.Locality, l.Province, l.Country).
+		mock.ExpectExec("INSERT INTO `locality` (`locality_name`, `province_id`, `latitude`, `longitude`) VALUES (?, ?, ?, ?)").
+			WithArgs(l.Locality, l.ProvinceID, l.Latitude, l.Longitude).
 			WillReturnResult(sqlmock.NewResult(1, 1))
 
-		mock.ExpectQuery("SELECT `id`, `locality_name`, `province_name`, `country_name` FROM `locality` WHERE `id` = ?").
+		mock.ExpectQuery("SELECT l.id, l.locality_name, l.province_id, p.province_name, c.country_name, l.latitude, l.longitude FROM `locality` l INNER JOIN `provinces` p ON p.id = l.province_id INNER JOIN `countries` c ON c.id = p.id_country_fk WHERE l.id = ?").
 			WithArgs(1).
-			WillReturnRows(sqlmock.NewRows([]string{"id", "locality_name", "province_name", "country_name"}).
-				AddRow(l.ID, l.Locality, l.Province, l.Country))
+			WillReturnRows(sqlmock.NewRows([]string{"id", "locality_name", "province_id", "province_name", "country_name", "latitude", "longitude"}).
+				AddRow(l.ID, l.Locality, l.ProvinceID, l.Province, l.Country, l.Latitude, l.Longitude))
 
-		locality, err := rp.CreateLocality(&l)
+		locality, err := rp.CreateLocality(context.Background(), &l)
 
 		errMock := mock.ExpectationsWereMet()
 
@@ -46,13 +47,13 @@ func TestLocalitiesRepository_CreateLocality(t *testing.T) {
 	})
 
 	t.Run("test repository method for create locality with insert error", func(t *testing.T) {
-		l := model.Locality{ID: 7, Locality: "Manhattan", Province: "New York", Country: "EUA"}
+		l := model.Locality{ID: 7, Locality: "Manhattan", ProvinceID: 1, Province: "New York", Country: "EUA"}
 
-		mock.ExpectExec("INSERT INTO `locality` (`locality_name`, `province_name`, `country_name`) VALUES (?, ?, ?)").
-			WithArgs(l.Locality, l.Province, l.Country).
+		mock.ExpectExec("INSERT INTO `locality` (`locality_name`, `province_id`, `latitude`, `longitude`) VALUES (?, ?, ?, ?)").
+			WithArgs(l.Locality, l.ProvinceID, l.Latitude, l.Longitude).
 			WillReturnResult(sqlmock.NewErrorResult(errors.New("error")))
 
-		locality, err := rp.CreateLocality(&l)
+		locality, err := rp.CreateLocality(context.Background(), &l)
 
 		errMock := mock.ExpectationsWereMet()
 
@@ -63,13 +64,13 @@ func TestLocalitiesRepository_CreateLocality(t *testing.T) {
 	})
 
 	t.Run("test repository method for create locality with sql null attribute error", func(t *testing.T) {
-		l := model.Locality{ID: 10, Locality: "Los Angeles", Province: "California", Country: "EUA"}
+		l := model.Locality{ID: 10, Locality: "Los Angeles", ProvinceID: 1, Province: "California", Country: "EUA"}
 
-		mock.ExpectExec("INSERT INTO `locality` (`locality_name`, `province_name`, `country_name`) VALUES (?, ?, ?)").
-			WithArgs(l.Locality, l.Province, l.Country).
+		mock.ExpectExec("INSERT INTO `locality` (`locality_name`, `province_id`, `latitude`, `longitude`) VALUES (?, ?, ?, ?)").
+			WithArgs(l.Locality, l.ProvinceID, l.Latitude, l.Longitude).
 			WillReturnError(&mysql.MySQLError{Number: 1048})
 
-		locality, err := rp.CreateLocality(&l)
+		locality, err := rp.CreateLocality(context.Background(), &l)
 		errMock := mock.ExpectationsWereMet()
 		expectedErr := customerror.ErrNullLocalityAttribute
 
@@ -80,13 +81,13 @@ func TestLocalitiesRepository_CreateLocality(t *testing.T) {
 	})
 
 	t.Run("test repository method for create locality with sql invalid json error", func(t *testing.T) {
-		l := model.Locality{ID: 9, Locality: "Little Rock", Province: "Arkansas", Country: "EUA"}
+		l := model.Locality{ID: 9, Locality: "Little Rock", ProvinceID: 1, Province: "Arkansas", Country: "EUA"}
 
-		mock.ExpectExec("INSERT INTO `locality` (`locality_name`, `province_name`, `country_name`) VALUES (?, ?, ?)").
-			WithArgs(l.Locality, l.Province, l.Country).
+		mock.ExpectExec("INSERT INTO `locality` (`locality_name`, `province_id`, `latitude`, `longitude`) VALUES (?, ?, ?, ?)").
+			WithArgs(l.Locality, l.ProvinceID, l.Latitude, l.Longitude).
 			WillReturnError(&mysql.MySQLError{Number: 1064})
 
-		locality, err := rp.CreateLocality(&l)
+		locality, err := rp.CreateLocality(context.Background(), &l)
 		errMock := mock.ExpectationsWereMet()
 		expectedErr := customerror.ErrInvalidLocalityJSONFormat
 
@@ -96,14 +97,29 @@ func TestLocalitiesRepository_CreateLocality(t *testing.T) {
 		assert.Empty(t, locality)
 	})
 
+	t.Run("test repository method for create locality with unknown province", func(t *testing.T) {
+		l := model.Locality{Locality: "Springfield", ProvinceID: 99}
+
+		mock.ExpectExec("INSERT INTO `locality` (`locality_name`, `province_id`, `latitude`, `longitude`) VALUES (?, ?, ?, ?)").
+			WithArgs(l.Locality, l.ProvinceID, l.Latitude, l.Longitude).
+			WillReturnError(&mysql.MySQLError{Number: 1452})
+
+		locality, err := rp.CreateLocality(context.Background(), &l)
+		errMock := mock.ExpectationsWereMet()
+
+		assert.NoError(t, errMock)
+		assert.ErrorIs(t, err, customerror.ErrLocalityProvinceNotFound)
+		assert.Empty(t, locality)
+	})
+
 	t.Run("test repository method for create seller with sql default error", func(t *testing.T) {
-		l := model.Locality{ID: 17, Locality: "Phoenix", Province: "Arizona", Country: "EUA"}
+		l := model.Locality{ID: 17, Locality: "Phoenix", ProvinceID: 1, Province: "Arizona", Country: "EUA"}
 
-		mock.ExpectExec("INSERT INTO `locality` (`locality_name`, `province_name`, `country_name`) VALUES (?, ?, ?)").
-			WithArgs(l.Locality, l.Province, l.Country).
+		mock.ExpectExec("INSERT INTO `locality` (`locality_name`, `province_id`, `latitude`, `longitude`) VALUES (?, ?, ?, ?)").
+			WithArgs(l.Locality, l.ProvinceID, l.Latitude, l.Longitude).
 			WillReturnError(&mysql.MySQLError{Number: 1205})
 
-		locality, err := rp.CreateLocality(&l)
+		locality, err := rp.CreateLocality(context.Background(), &l)
 		errMock := mock.ExpectationsWereMet()
 		expectedErr := customerror.ErrDefaultLocalitySQL
 
@@ -125,16 +141,16 @@ func TestLocalitiesRepository_GetByID(t *testing.T) {
 
 	t.Run("test repository method for get locality by id successfully", func(t *testing.T) {
 		ID := 1
-		l := model.Locality{ID: 17, Locality: "Phoenix", Province: "Arizona", Country: "EUA"}
+		l := model.Locality{ID: 17, Locality: "Phoenix", ProvinceID: 1, Province: "Arizona", Country: "EUA"}
 
-		rows := sqlmock.NewRows([]string{"id", "locality_name", "province_name", "country_name"}).
-			AddRow(l.ID, l.Locality, l.Province, l.Country)
+		rows := sqlmock.NewRows([]string{"id", "locality_name", "province_id", "province_name", "country_name", "latitude", "longitude"}).
+			AddRow(l.ID, l.Locality, l.ProvinceID, l.Province, l.Country, l.Latitude, l.Longitude)
 
-		mock.ExpectQuery("SELECT `id`, `locality_name`, `province_name`, `country_name` FROM `locality` WHERE `id` = ?").
+		mock.ExpectQuery("SELECT l.id, l.locality_name, l.province_id, p.province_name, c.country_name, l.latitude, l.longitude FROM `locality` l INNER JOIN `provinces` p ON p.id = l.province_id INNER JOIN `countries` c ON c.id = p.id_country_fk WHERE l.id = ?").
 			WithArgs(ID).
 			WillReturnRows(rows)
 
-		result, err := rp.GetByID(ID)
+		result, err := rp.GetByID(context.Background(), ID)
 
 		errMock := mock.ExpectationsWereMet()
 
@@ -146,11 +162,11 @@ func TestLocalitiesRepository_GetByID(t *testing.T) {
 	t.Run("test repository method for get locality by id with error not found", func(t *testing.T) {
 		ID := 99
 
-		mock.ExpectQuery("SELECT `id`, `locality_name`, `province_name`, `country_name` FROM `locality` WHERE `id` = ?").
+		mock.ExpectQuery("SELECT l.id, l.locality_name, l.province_id, p.province_name, c.country_name, l.latitude, l.longitude FROM `locality` l INNER JOIN `provinces` p ON p.id = l.province_id INNER JOIN `countries` c ON c.id = p.id_country_fk WHERE l.id = ?").
 			WithArgs(ID).
 			WillReturnError(sql.ErrNoRows)
 
-		seller, err := rp.GetByID(ID)
+		seller, err := rp.GetByID(context.Background(), ID)
 		errorExpected := customerror.NewSellerErr(customerror.ErrNotFound.Error(), http.StatusNotFound)
 		errorMock := mock.ExpectationsWereMet()
 
@@ -171,31 +187,57 @@ func TestLocalitiesRepository_Get(t *testing.T) {
 
 	t.Run("test repository method for get all localities successfully", func(t *testing.T) {
 		expectedLocalities := []model.Locality{
-			{ID: 1, Locality: "Denver", Province: "Colorado", Country: "EUA"},
-			{ID: 2, Locality: "Phoenix", Province: "Arizona", Country: "EUA"},
+			{ID: 1, Locality: "Denver", ProvinceID: 1, Province: "Colorado", Country: "EUA"},
+			{ID: 2, Locality: "Phoenix", ProvinceID: 1, Province: "Arizona", Country: "EUA"},
 		}
 
-		rows := sqlmock.NewRows([]string{"id", "locality_name", "province_name", "country_name"})
+		rows := sqlmock.NewRows([]string{"id", "locality_name", "province_id", "province_name", "country_name", "latitude", "longitude"})
 		for _, locality := range expectedLocalities {
-			rows.AddRow(locality.ID, locality.Locality, locality.Province, locality.Country)
+			rows.AddRow(locality.ID, locality.Locality, locality.ProvinceID, locality.Province, locality.Country, locality.Latitude, locality.Longitude)
 		}
 
-		mock.ExpectQuery("SELECT `id`, `locality_name`, `province_name`, `country_name` FROM `locality`").
+		mock.ExpectQuery("SELECT l.id, l.locality_name, l.province_id, p.province_name, c.country_name, l.latitude, l.longitude FROM `locality` l INNER JOIN `provinces` p ON p.id = l.province_id INNER JOIN `countries` c ON c.id = p.id_country_fk ORDER BY l.id").
 			WillReturnRows(rows)
 
-		localities, err := rp.Get()
+		localities, total, err := rp.Get(context.Background(), model.ListParams{})
 		errMock := mock.ExpectationsWereMet()
 
 		assert.NoError(t, err)
 		assert.Equal(t, expectedLocalities, localities)
+		assert.Equal(t, 2, total)
+		assert.NoError(t, errMock)
+	})
+
+	t.Run("test repository method for search localities by name, province and country with pagination", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"id", "locality_name", "province_id", "province_name", "country_name", "latitude", "longitude"}).
+			AddRow(3, "Santa Ana", 2, "California", "EUA", nil, nil)
+
+		mock.ExpectQuery("SELECT l.id, l.locality_name, l.province_id, p.province_name, c.country_name, l.latitude, l.longitude FROM `locality` l INNER JOIN `provinces` p ON p.id = l.province_id INNER JOIN `countries` c ON c.id = p.id_country_fk WHERE c.country_name LIKE CONCAT('%', ?, '%') AND l.locality_name LIKE CONCAT('%', ?, '%') AND p.province_name LIKE CONCAT('%', ?, '%') ORDER BY l.locality_name DESC, l.id LIMIT ? OFFSET ?").
+			WithArgs("EU", "Santa", "Cali", 1, 1).
+			WillReturnRows(rows)
+		mock.ExpectQuery("SELECT COUNT(*) FROM `locality` l INNER JOIN `provinces` p ON p.id = l.province_id INNER JOIN `countries` c ON c.id = p.id_country_fk WHERE c.country_name LIKE CONCAT('%', ?, '%') AND l.locality_name LIKE CONCAT('%', ?, '%') AND p.province_name LIKE CONCAT('%', ?, '%')").
+			WithArgs("EU", "Santa", "Cali").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+
+		params := model.ListParams{Page: 2, PageSize: 1, Sort: "locality_name", Desc: true, Filters: map[string]string{
+			"locality_name": "Santa",
+			"province_name": "Cali",
+			"country_name":  "EU",
+		}}
+		localities, total, err := rp.Get(context.Background(), params)
+		errMock := mock.ExpectationsWereMet()
+
+		assert.NoError(t, err)
+		assert.Equal(t, []model.Locality{{ID: 3, Locality: "Santa Ana", ProvinceID: 2, Province: "California", Country: "EUA"}}, localities)
+		assert.Equal(t, 2, total)
 		assert.NoError(t, errMock)
 	})
 
 	t.Run("test repository method for get all localities with query error", func(t *testing.T) {
-		mock.ExpectQuery("SELECT `id`, `locality_name`, `province_name`, `country_name` FROM `locality`").
+		mock.ExpectQuery("SELECT l.id, l.locality_name, l.province_id, p.province_name, c.country_name, l.latitude, l.longitude FROM `locality` l INNER JOIN `provinces` p ON p.id = l.province_id INNER JOIN `countries` c ON c.id = p.id_country_fk ORDER BY l.id").
 			WillReturnError(sql.ErrNoRows)
 
-		localities, err := rp.Get()
+		localities, _, err := rp.Get(context.Background(), model.ListParams{})
 		mockErr := mock.ExpectationsWereMet()
 
 		assert.Error(t, err)
@@ -204,6 +246,105 @@ func TestLocalitiesRepository_Get(t *testing.T) {
 	})
 }
 
+func TestLocalitiesRepository_UpdateLocality(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	rp := repository.CreateRepositoryLocalities(db, logMock)
+
+	t.Run("test repository method for update locality with success", func(t *testing.T) {
+		l := model.Locality{ID: 2, Locality: "Boulder", ProvinceID: 1, Province: "Colorado", Country: "EUA"}
+
+		mock.ExpectExec("UPDATE `locality` SET `locality_name` = ?, `province_id` = ?, `latitude` = ?, `longitude` = ? WHERE `id` = ?").
+			WithArgs(l.Locality, l.ProvinceID, l.Latitude, l.Longitude, l.ID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery("SELECT l.id, l.locality_name, l.province_id, p.province_name, c.country_name, l.latitude, l.longitude FROM `locality` l INNER JOIN `provinces` p ON p.id = l.province_id INNER JOIN `countries` c ON c.id = p.id_country_fk WHERE l.id = ?").
+			WithArgs(l.ID).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "locality_name", "province_id", "province_name", "country_name", "latitude", "longitude"}).
+				AddRow(l.ID, l.Locality, l.ProvinceID, l.Province, l.Country, l.Latitude, l.Longitude))
+
+		locality, err := rp.UpdateLocality(context.Background(), l.ID, &l)
+		errMock := mock.ExpectationsWereMet()
+
+		assert.NoError(t, errMock)
+		assert.NoError(t, err)
+		assert.Equal(t, l, locality)
+	})
+
+	t.Run("test repository method for update locality with unknown province", func(t *testing.T) {
+		l := model.Locality{ID: 2, Locality: "Boulder", ProvinceID: 99}
+
+		mock.ExpectExec("UPDATE `locality` SET `locality_name` = ?, `province_id` = ?, `latitude` = ?, `longitude` = ? WHERE `id` = ?").
+			WithArgs(l.Locality, l.ProvinceID, l.Latitude, l.Longitude, l.ID).
+			WillReturnError(&mysql.MySQLError{Number: 1452})
+
+		locality, err := rp.UpdateLocality(context.Background(), l.ID, &l)
+		errMock := mock.ExpectationsWereMet()
+
+		assert.NoError(t, errMock)
+		assert.ErrorIs(t, err, customerror.ErrLocalityProvinceNotFound)
+		assert.Empty(t, locality)
+	})
+}
+
+func TestLocalitiesRepository_DeleteLocality(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	rp := repository.CreateRepositoryLocalities(db, logMock)
+
+	t.Run("test repository method for delete locality with success", func(t *testing.T) {
+		mock.ExpectExec("DELETE FROM `locality` WHERE `id` = ?").
+			WithArgs(3).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := rp.DeleteLocality(context.Background(), 3)
+		errMock := mock.ExpectationsWereMet()
+
+		assert.NoError(t, errMock)
+		assert.NoError(t, err)
+	})
+
+	t.Run("test repository method for delete locality still referenced", func(t *testing.T) {
+		mock.ExpectExec("DELETE FROM `locality` WHERE `id` = ?").
+			WithArgs(1).
+			WillReturnError(&mysql.MySQLError{Number: 1451})
+
+		err := rp.DeleteLocality(context.Background(), 1)
+		errMock := mock.ExpectationsWereMet()
+
+		assert.NoError(t, errMock)
+		assert.ErrorIs(t, err, customerror.ErrLocalityDependencies)
+	})
+}
+
+func TestLocalitiesRepository_CountDependencies(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	rp := repository.CreateRepositoryLocalities(db, logMock)
+
+	mock.ExpectQuery("SELECT (SELECT COUNT(*) FROM `sellers` WHERE `locality_id` = ?), (SELECT COUNT(*) FROM `carriers` WHERE `locality_id` = ?)").
+		WithArgs(1, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"sellers", "carriers"}).AddRow(2, 1))
+
+	dependencies, err := rp.CountDependencies(context.Background(), 1)
+	errMock := mock.ExpectationsWereMet()
+
+	assert.NoError(t, errMock)
+	assert.NoError(t, err)
+	assert.Equal(t, model.LocalityDependencies{ID: 1, Sellers: 2, Carriers: 1}, dependencies)
+}
+
 func TestLocalitiesRepository_GetSellers(t *testing.T) {
 	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
 	if err != nil {
@@ -226,10 +367,10 @@ func TestLocalitiesRepository_GetSellers(t *testing.T) {
 			rows.AddRow(locality.ID, locality.Locality, locality.Sellers)
 		}
 
-		mock.ExpectQuery("SELECT l.id, l.locality_name, COUNT(s.locality_id) AS `sellers_count` FROM `sellers` s RIGHT JOIN `locality` l ON s.locality_id = l.id GROUP BY l.id, l.locality_name ORDER BY l.locality_name").
+		mock.ExpectQuery("SELECT l.id, l.locality_name, COUNT(t.locality_id) AS `sellers_count` FROM `locality` l LEFT JOIN `sellers` t ON t.locality_id = l.id GROUP BY l.id, l.locality_name ORDER BY l.locality_name").
 			WillReturnRows(rows)
 
-		report, err := rp.GetSellers(0)
+		report, err := rp.GetSellers(context.Background(), 0)
 		errMock := mock.ExpectationsWereMet()
 
 		assert.NoError(t, err)
@@ -238,10 +379,10 @@ func TestLocalitiesRepository_GetSellers(t *testing.T) {
 	})
 
 	t.Run("test repository method for get report all sellers with sql no rows", func(t *testing.T) {
-		mock.ExpectQuery("SELECT l.id, l.locality_name, COUNT(s.locality_id) AS `sellers_count` FROM `sellers` s RIGHT JOIN `locality` l ON s.locality_id = l.id GROUP BY l.id, l.locality_name ORDER BY l.locality_name").
+		mock.ExpectQuery("SELECT l.id, l.locality_name, COUNT(t.locality_id) AS `sellers_count` FROM `locality` l LEFT JOIN `sellers` t ON t.locality_id = l.id GROUP BY l.id, l.locality_name ORDER BY l.locality_name").
 			WillReturnError(sql.ErrNoRows)
 
-		report, err := rp.GetSellers(0)
+		report, err := rp.GetSellers(context.Background(), 0)
 		mockErr := mock.ExpectationsWereMet()
 
 		assert.Error(t, err)
@@ -272,10 +413,10 @@ func TestLocalitiesRepository_GetCarriers(t *testing.T) {
 			rows.AddRow(locality.ID, locality.Locality, locality.Carriers)
 		}
 
-		mock.ExpectQuery("SELECT l.id, l.locality_name, COUNT(c.locality_id) AS `carriers_count` FROM `carriers` c RIGHT JOIN `locality` l ON c.locality_id = l.id GROUP BY l.id, l.locality_name ORDER BY l.locality_name").
+		mock.ExpectQuery("SELECT l.id, l.locality_name, COUNT(t.locality_id) AS `carriers_count` FROM `locality` l LEFT JOIN `carriers` t ON t.locality_id = l.id GROUP BY l.id, l.locality_name ORDER BY l.locality_name").
 			WillReturnRows(rows)
 
-		report, err := rp.GetCarriers(0)
+		report, err := rp.GetCarriers(context.Background(), 0)
 		errMock := mock.ExpectationsWereMet()
 
 		assert.NoError(t, err)
@@ -284,10 +425,10 @@ func TestLocalitiesRepository_GetCarriers(t *testing.T) {
 	})
 
 	t.Run("test repository method for get report all carriers with sql no rows", func(t *testing.T) {
-		mock.ExpectQuery("SELECT l.id, l.locality_name, COUNT(c.locality_id) AS `carriers_count` FROM `carriers` c RIGHT JOIN `locality` l ON c.locality_id = l.id GROUP BY l.id, l.locality_name ORDER BY l.locality_name").
+		mock.ExpectQuery("SELECT l.id, l.locality_name, COUNT(t.locality_id) AS `carriers_count` FROM `locality` l LEFT JOIN `carriers` t ON t.locality_id = l.id GROUP BY l.id, l.locality_name ORDER BY l.locality_name").
 			WillReturnError(sql.ErrNoRows)
 
-		report, err := rp.GetCarriers(0)
+		report, err := rp.GetCarriers(context.Background(), 0)
 		mockErr := mock.ExpectationsWereMet()
 
 		assert.Error(t, err)
@@ -296,7 +437,7 @@ func TestLocalitiesRepository_GetCarriers(t *testing.T) {
 	})
 }
 
-func TestLocalitiesRepository_GetReportSellersWithID(t *testing.T) {
+func TestLocalitiesRepository_GetSellersByID(t *testing.T) {
 	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
 	if err != nil {
 		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
@@ -310,23 +451,15 @@ func TestLocalitiesRepository_GetReportSellersWithID(t *testing.T) {
 			{ID: "4", Locality: "Phoenix", Sellers: 5},
 		}
 		ID := 4
-		l := model.Locality{ID: 4, Locality: "Phoenix", Province: "Arizona", Country: "EUA"}
-
-		rows := sqlmock.NewRows([]string{"id", "locality_name", "province_name", "country_name"}).
-			AddRow(l.ID, l.Locality, l.Province, l.Country)
-
-		mock.ExpectQuery("SELECT `id`, `locality_name`, `province_name`, `country_name` FROM `locality` WHERE `id` = ?").
-			WithArgs(ID).
-			WillReturnRows(rows)
 
 		row := sqlmock.NewRows([]string{"id", "locality_name", "sellers_count"}).
 			AddRow(expectedReport[0].ID, expectedReport[0].Locality, expectedReport[0].Sellers)
 
-		mock.ExpectQuery("SELECT l.id, l.locality_name, COUNT(s.locality_id) AS `sellers_count` FROM `sellers` s RIGHT JOIN `locality` l ON s.locality_id = l.id WHERE l.id = ? GROUP BY l.id, l.locality_name").
+		mock.ExpectQuery("SELECT l.id, l.locality_name, COUNT(t.locality_id) AS `sellers_count` FROM `locality` l LEFT JOIN `sellers` t ON t.locality_id = l.id WHERE l.id = ? GROUP BY l.id, l.locality_name ORDER BY l.locality_name").
 			WithArgs(ID).
 			WillReturnRows(row)
 
-		report, err := rp.GetReportSellersWithID(ID)
+		report, err := rp.GetSellers(context.Background(), ID)
 		errMock := mock.ExpectationsWereMet()
 
 		assert.NoError(t, err)
@@ -338,11 +471,11 @@ func TestLocalitiesRepository_GetReportSellersWithID(t *testing.T) {
 		ID := 999
 		expectedErr := customerror.ErrLocalityNotFound
 
-		mock.ExpectQuery("SELECT `id`, `locality_name`, `province_name`, `country_name` FROM `locality` WHERE `id` = ?").
+		mock.ExpectQuery("SELECT l.id, l.locality_name, COUNT(t.locality_id) AS `sellers_count` FROM `locality` l LEFT JOIN `sellers` t ON t.locality_id = l.id WHERE l.id = ? GROUP BY l.id, l.locality_name ORDER BY l.locality_name").
 			WithArgs(ID).
-			WillReturnError(sql.ErrNoRows)
+			WillReturnRows(sqlmock.NewRows([]string{"id", "locality_name", "sellers_count"}))
 
-		report, err := rp.GetReportSellersWithID(ID)
+		report, err := rp.GetSellers(context.Background(), ID)
 		errMock := mock.ExpectationsWereMet()
 
 		assert.ErrorIs(t, expectedErr, err)
@@ -351,7 +484,7 @@ func TestLocalitiesRepository_GetReportSellersWithID(t *testing.T) {
 	})
 }
 
-func TestLocalitiesRepository_GetReportCarriersWithID(t *testing.T) {
+func TestLocalitiesRepository_GetCarriersByID(t *testing.T) {
 	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
 	if err != nil {
 		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
@@ -365,23 +498,15 @@ func TestLocalitiesRepository_GetReportCarriersWithID(t *testing.T) {
 			{ID: "4", Locality: "Phoenix", Carriers: 5},
 		}
 		ID := 4
-		l := model.Locality{ID: 4, Locality: "Phoenix", Province: "Arizona", Country: "EUA"}
-
-		rows := sqlmock.NewRows([]string{"id", "locality_name", "province_name", "country_name"}).
-			AddRow(l.ID, l.Locality, l.Province, l.Country)
-
-		mock.ExpectQuery("SELECT `id`, `locality_name`, `province_name`, `country_name` FROM `locality` WHERE `id` = ?").
-			WithArgs(ID).
-			WillReturnRows(rows)
 
 		row := sqlmock.NewRows([]string{"id", "locality_name", "carriers_count"}).
 			AddRow(expectedReport[0].ID, expectedReport[0].Locality, expectedReport[0].Carriers)
 
-		mock.ExpectQuery("SELECT l.id, l.locality_name, COUNT(c.locality_id) AS `carriers_count` FROM `carriers` c RIGHT JOIN `locality` l ON c.locality_id = l.id WHERE l.id = ? GROUP BY l.id, l.locality_name").
+		mock.ExpectQuery("SELECT l.id, l.locality_name, COUNT(t.locality_id) AS `carriers_count` FROM `locality` l LEFT JOIN `carriers` t ON t.locality_id = l.id WHERE l.id = ? GROUP BY l.id, l.locality_name ORDER BY l.locality_name").
 			WithArgs(ID).
 			WillReturnRows(row)
 
-		report, err := rp.GetReportCarriersWithID(ID)
+		report, err := rp.GetCarriers(context.Background(), ID)
 		errMock := mock.ExpectationsWereMet()
 
 		assert.NoError(t, err)
@@ -393,11 +518,11 @@ func TestLocalitiesRepository_GetReportCarriersWithID(t *testing.T) {
 		ID := 999
 		expectedErr := customerror.ErrLocalityNotFound
 
-		mock.ExpectQuery("SELECT `id`, `locality_name`, `province_name`, `country_name` FROM `locality` WHERE `id` = ?").
+		mock.ExpectQuery("SELECT l.id, l.locality_name, COUNT(t.locality_id) AS `carriers_count` FROM `locality` l LEFT JOIN `carriers` t ON t.locality_id = l.id WHERE l.id = ? GROUP BY l.id, l.locality_name ORDER BY l.locality_name").
 			WithArgs(ID).
-			WillReturnError(sql.ErrNoRows)
+			WillReturnRows(sqlmock.NewRows([]string{"id", "locality_name", "carriers_count"}))
 
-		report, err := rp.GetReportCarriersWithID(ID)
+		report, err := rp.GetCarriers(context.Background(), ID)
 		errMock := mock.ExpectationsWereMet()
 
 		assert.ErrorIs(t, expectedErr, err)
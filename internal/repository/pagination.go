@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+)
+
+// listQuery holds the clauses shared by the select and count statements of a paginated list.
+// Only columns whitelisted in model.ListOptions ever reach the SQL text; values are bound as arguments.
+type listQuery struct {
+	where     string
+	whereArgs []any
+	orderBy   string
+	limit     string
+	limitArgs []any
+}
+
+func newListQuery(params model.ListParams, opts model.ListOptions) (q listQuery) {
+	keys := make([]string, 0, len(params.Filters))
+	for key := range params.Filters {
+		if _, ok := opts.Filters[key]; ok {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Strings(keys)
+
+	var conditions []string
+	for _, key := range keys {
+		condition := opts.Filters[key]
+		if !strings.Contains(condition, "?") {
+			condition += " = ?"
+		}
+
+		conditions = append(conditions, condition)
+		q.whereArgs = append(q.whereArgs, params.Filters[key])
+	}
+
+	if len(conditions) > 0 {
+		q.where = " WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	sortKey := params.Sort
+	if _, ok := opts.Sorts[sortKey]; !ok {
+		sortKey = opts.DefaultSort
+	}
+
+	q.orderBy = " ORDER BY " + opts.Sorts[sortKey]
+	if params.Desc {
+		q.orderBy += " DESC"
+	}
+
+	// the primary key breaks ties so pages never overlap or skip rows
+	if sortKey != opts.DefaultSort {
+		q.orderBy += ", " + opts.Sorts[opts.DefaultSort]
+	}
+
+	if params.PageSize > 0 {
+		q.limit = " LIMIT ? OFFSET ?"
+		q.limitArgs = []any{params.PageSize, params.Offset()}
+	}
+
+	return
+}
+
+func (q listQuery) selectQuery(base string) (string, []any) {
+	return base + q.where + q.orderBy + q.limit, append(append([]any{}, q.whereArgs...), q.limitArgs...)
+}
+
+func (q listQuery) countQuery(base string) (string, []any) {
+	return base + q.where, q.whereArgs
+}
+
+// countRows returns the number of rows matching the list filters, ignoring the page window.
+func countRows(ctx context.Context, db DBTX, base string, q listQuery) (total int, err error) {
+	query, args := q.countQuery(base)
+	err = db.QueryRowContext(ctx, query, args...).Scan(&total)
+
+	return
+}
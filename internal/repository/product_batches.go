@@ -1,18 +1,21 @@
 package repository
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
+	"time"
 
 	"github.com/maxwelbm/alkemy-g7.git/pkg/logger"
 
 	"github.com/go-sql-driver/mysql"
 	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+	"github.com/maxwelbm/alkemy-g7.git/internal/repository/interfaces"
 	"github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
 )
 
 type ProductBatchesRepository struct {
-	db  *sql.DB
+	db  DBTX
 	log logger.Logger
 }
 
@@ -20,11 +23,73 @@ func CreateProductBatchesRepository(db *sql.DB, log logger.Logger) *ProductBatch
 	return &ProductBatchesRepository{db: db, log: log}
 }
 
-func (r *ProductBatchesRepository) GetByID(id int) (prodBatches model.ProductBatches, err error) {
+func (r *ProductBatchesRepository) Get(ctx context.Context, params model.ListParams) (batches []model.ProductBatches, total int, err error) {
+	r.log.Log("ProductBatchesRepository", "INFO", "initializing Get function")
+
+	list := newListQuery(params, model.ProductBatchesListOptions)
+	query, args := list.selectQuery("SELECT `id`, `batch_number`, `current_quantity`, `current_temperature`, `minimum_temperature`, `due_date`, `initial_quantity`, `manufacturing_date`, `manufacturing_hour`, `product_id`, `section_id` FROM `product_batches`")
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		r.log.Log("ProductBatchesRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var pb model.ProductBatches
+
+		err = rows.Scan(&pb.ID, &pb.BatchNumber, &pb.CurrentQuantity, &pb.CurrentTemperature, &pb.MinimumTemperature, &pb.DueDate, &pb.InitialQuantity, &pb.ManufacturingDate, &pb.ManufacturingHour, &pb.ProductID, &pb.SectionID)
+		if err != nil {
+			r.log.Log("ProductBatchesRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+
+			return nil, 0, err
+		}
+
+		batches = append(batches, pb)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		r.log.Log("ProductBatchesRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return nil, 0, err
+	}
+
+	total = len(batches)
+
+	if params.PageSize > 0 {
+		total, err = countRows(ctx, r.db, "SELECT COUNT(*) FROM `product_batches`", list)
+		if err != nil {
+			r.log.Log("ProductBatchesRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+
+			return nil, 0, err
+		}
+	}
+
+	r.log.Log("ProductBatchesRepository", "INFO", "returning a slice with product batches")
+
+	return
+}
+
+func (r *ProductBatchesRepository) GetByID(ctx context.Context, id int) (prodBatches model.ProductBatches, err error) {
 	r.log.Log("ProductBatchesRepository", "INFO", "initializing GetByID function")
 
-	getByIDQuery := "SELECT `id`, `batch_number`, `current_quantity`, `current_temperature`, `minimum_temperature`, `due_date`, `initial_quantity`, `manufacturing_date`, `manufacturing_hour`, `product_id`, `section_id` FROM `product_batches` WHERE `id` = ?"
-	row := r.db.QueryRow(getByIDQuery, id)
+	return r.getByID(ctx, "SELECT `id`, `batch_number`, `current_quantity`, `current_temperature`, `minimum_temperature`, `due_date`, `initial_quantity`, `manufacturing_date`, `manufacturing_hour`, `product_id`, `section_id` FROM `product_batches` WHERE `id` = ?", id)
+}
+
+// GetByIDForUpdate reads the batch and locks its row until the transaction ends, so concurrent
+// corrections are applied one after the other. It must run inside a transaction.
+func (r *ProductBatchesRepository) GetByIDForUpdate(ctx context.Context, id int) (prodBatches model.ProductBatches, err error) {
+	r.log.Log("ProductBatchesRepository", "INFO", "initializing GetByIDForUpdate function")
+
+	return r.getByID(ctx, "SELECT `id`, `batch_number`, `current_quantity`, `current_temperature`, `minimum_temperature`, `due_date`, `initial_quantity`, `manufacturing_date`, `manufacturing_hour`, `product_id`, `section_id` FROM `product_batches` WHERE `id` = ? FOR UPDATE", id)
+}
+
+func (r *ProductBatchesRepository) getByID(ctx context.Context, query string, id int) (prodBatches model.ProductBatches, err error) {
+	row := r.db.QueryRowContext(ctx, query, id)
 
 	err = row.Scan(&prodBatches.ID, &prodBatches.BatchNumber, &prodBatches.CurrentQuantity, &prodBatches.CurrentTemperature, &prodBatches.MinimumTemperature, &prodBatches.DueDate, &prodBatches.InitialQuantity, &prodBatches.ManufacturingDate, &prodBatches.ManufacturingHour, &prodBatches.ProductID, &prodBatches.SectionID)
 	if err != nil {
@@ -42,12 +107,12 @@ func (r *ProductBatchesRepository) GetByID(id int) (prodBatches model.ProductBat
 	return
 }
 
-func (r *ProductBatchesRepository) Post(prodBatches *model.ProductBatches) (newProdBatches model.ProductBatches, err error) {
+func (r *ProductBatchesRepository) Post(ctx context.Context, prodBatches *model.ProductBatches) (newProdBatches model.ProductBatches, err error) {
 	r.log.Log("ProductBatchesRepository", "INFO", "initializing Post function")
 
 	postQuery := "INSERT INTO product_batches (batch_number, current_quantity, current_temperature, minimum_temperature, due_date, initial_quantity, manufacturing_date, manufacturing_hour, product_id, section_id) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)"
 
-	result, err := r.db.Exec(postQuery, (*prodBatches).BatchNumber, (*prodBatches).CurrentQuantity, (*prodBatches).CurrentTemperature, (*prodBatches).MinimumTemperature, (*prodBatches).DueDate, (*prodBatches).InitialQuantity, (*prodBatches).ManufacturingDate, (*prodBatches).ManufacturingHour, (*prodBatches).ProductID, (*prodBatches).SectionID)
+	result, err := r.db.ExecContext(ctx, postQuery, (*prodBatches).BatchNumber, (*prodBatches).CurrentQuantity, (*prodBatches).CurrentTemperature, (*prodBatches).MinimumTemperature, (*prodBatches).DueDate, (*prodBatches).InitialQuantity, (*prodBatches).ManufacturingDate, (*prodBatches).ManufacturingHour, (*prodBatches).ProductID, (*prodBatches).SectionID)
 
 	if err != nil {
 		if err.(*mysql.MySQLError).Number == 1062 {
@@ -68,9 +133,133 @@ func (r *ProductBatchesRepository) Post(prodBatches *model.ProductBatches) (newP
 
 	(*prodBatches).ID = int(id)
 
-	newProdBatches, _ = r.GetByID(int(id))
+	newProdBatches, _ = r.GetByID(ctx, int(id))
 
 	r.log.Log("ProductBatchesRepository", "INFO", fmt.Sprintf("saving a product batches to the database: %v", prodBatches))
 
 	return
 }
+
+func (r *ProductBatchesRepository) Update(ctx context.Context, id int, prodBatches *model.ProductBatches) (updated model.ProductBatches, err error) {
+	r.log.Log("ProductBatchesRepository", "INFO", "initializing Update function")
+
+	query := "UPDATE `product_batches` SET `current_quantity` = ?, `current_temperature` = ?, `minimum_temperature` = ? WHERE `id` = ?"
+
+	_, err = r.db.ExecContext(ctx, query, prodBatches.CurrentQuantity, prodBatches.CurrentTemperature, prodBatches.MinimumTemperature, id)
+	if err != nil {
+		r.log.Log("ProductBatchesRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	updated, err = r.GetByID(ctx, id)
+	if err != nil {
+		return
+	}
+
+	r.log.Log("ProductBatchesRepository", "INFO", fmt.Sprintf("product batches updated: %v", updated))
+
+	return
+}
+
+func (r *ProductBatchesRepository) Delete(ctx context.Context, id int) (err error) {
+	r.log.Log("ProductBatchesRepository", "INFO", "initializing Delete function")
+
+	result, err := r.db.ExecContext(ctx, "DELETE FROM `product_batches` WHERE `id` = ?", id)
+	if err != nil {
+		if mysqlErr, ok := err.(*mysql.MySQLError); ok && mysqlErr.Number == 1451 {
+			err = customerror.HandleError("product batches", customerror.ErrorDep, "")
+		}
+
+		r.log.Log("ProductBatchesRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.log.Log("ProductBatchesRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	if rowsAffected == 0 {
+		err = customerror.HandleError("product batches", customerror.ErrorNotFound, "")
+		r.log.Log("ProductBatchesRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	r.log.Log("ProductBatchesRepository", "INFO", fmt.Sprintf("product batches with id %d deleted", id))
+
+	return
+}
+
+// CountReferences counts the inbound orders, purchase orders and temperature excursions that reference
+// the batch. Inbound orders reference it either directly or as one of the batches received with the order.
+func (r *ProductBatchesRepository) CountReferences(ctx context.Context, id int) (refs model.ProductBatchesReferences, err error) {
+	r.log.Log("ProductBatchesRepository", "INFO", "initializing CountReferences function")
+
+	query := "SELECT " +
+		"(SELECT COUNT(*) FROM `inbound_orders` WHERE `product_batch_id` = ? OR `id` IN (SELECT `inbound_order_id` FROM `inbound_order_batches` WHERE `product_batch_id` = ?)), " +
+		"(SELECT COUNT(DISTINCT `purchase_order_id`) FROM `purchase_order_batches` WHERE `product_batch_id` = ?), " +
+		"(SELECT COUNT(*) FROM `temperature_excursions` WHERE `product_batch_id` = ?)"
+
+	err = r.db.QueryRowContext(ctx, query, id, id, id, id).Scan(&refs.InboundOrders, &refs.PurchaseOrders, &refs.TemperatureExcursions)
+	if err != nil {
+		r.log.Log("ProductBatchesRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+	}
+
+	return
+}
+
+// GetExpiring returns the batches with stock left that are due until the given time, already expired ones included,
+// ordered by warehouse, section and due date.
+func (r *ProductBatchesRepository) GetExpiring(ctx context.Context, until time.Time) (batches []model.ExpiringBatch, err error) {
+	r.log.Log("ProductBatchesRepository", "INFO", fmt.Sprintf("initializing GetExpiring function until %s", until.Format(time.RFC3339)))
+
+	query := "SELECT pb.id, pb.batch_number, pb.current_quantity, pb.due_date, pb.product_id, s.id, s.section_number, w.id, w.warehouse_code " +
+		"FROM product_batches pb " +
+		"INNER JOIN sections s ON s.id = pb.section_id " +
+		"INNER JOIN warehouses w ON w.id = s.warehouse_id " +
+		"WHERE pb.current_quantity > 0 AND pb.due_date <= ? " +
+		"ORDER BY w.id, s.id, pb.due_date, pb.id"
+
+	rows, err := r.db.QueryContext(ctx, query, until)
+	if err != nil {
+		r.log.Log("ProductBatchesRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var b model.ExpiringBatch
+
+		err = rows.Scan(&b.ID, &b.BatchNumber, &b.CurrentQuantity, &b.DueDate, &b.ProductID, &b.SectionID, &b.SectionNumber, &b.WarehouseID, &b.WarehouseCode)
+		if err != nil {
+			r.log.Log("ProductBatchesRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+
+			return nil, err
+		}
+
+		batches = append(batches, b)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		r.log.Log("ProductBatchesRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return nil, err
+	}
+
+	r.log.Log("ProductBatchesRepository", "INFO", fmt.Sprintf("returning %d expiring product batches", len(batches)))
+
+	return
+}
+
+// WithTx implements interfaces.IProductBatchesRepo.
+func (r *ProductBatchesRepository) WithTx(tx *sql.Tx) interfaces.IProductBatchesRepo {
+	return &ProductBatchesRepository{db: tx, log: r.log}
+}
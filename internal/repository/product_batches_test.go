@@ -1,6 +1,7 @@
 package repository_test
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 	"time"
@@ -41,7 +42,7 @@ func TestProductBatchesRepository_Post(t *testing.T) {
 
 		mock.ExpectQuery("SELECT `id`, `batch_number`, `current_quantity`, `current_temperature`, `minimum_temperature`, `due_date`, `initial_quantity`, `manufacturing_date`, `manufacturing_hour`, `product_id`, `section_id` FROM `product_batches` WHERE `id` = ?").WithArgs(&createdPB.ID).WillReturnRows(sqlmock.NewRows([]string{"id", "batch_number", "current_quantity", "current_temperature", "minimum_temperature", "due_date", "initial_quantity", "manufacturing_date", "manufacturing_hour", "product_id", "section_id"}).AddRow(&createdPB.ID, &createdPB.BatchNumber, &createdPB.CurrentQuantity, &createdPB.CurrentTemperature, &createdPB.MinimumTemperature, &createdPB.DueDate, &createdPB.InitialQuantity, &createdPB.ManufacturingDate, &createdPB.ManufacturingHour, &createdPB.ProductID, &createdPB.SectionID))
 
-		pb, err := rp.Post(&createdPB)
+		pb, err := rp.Post(context.Background(), &createdPB)
 		mockErr := mock.ExpectationsWereMet()
 
 		assert.Equal(t, createdPB, pb)
@@ -67,7 +68,7 @@ func TestProductBatchesRepository_Post(t *testing.T) {
 
 		mock.ExpectExec("INSERT INTO product_batches (batch_number, current_quantity, current_temperature, minimum_temperature, due_date, initial_quantity, manufacturing_date, manufacturing_hour, product_id, section_id) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)").WithArgs(&createdPB.BatchNumber, &createdPB.CurrentQuantity, &createdPB.CurrentTemperature, &createdPB.MinimumTemperature, &createdPB.DueDate, &createdPB.InitialQuantity, &createdPB.ManufacturingDate, &createdPB.ManufacturingHour, &createdPB.ProductID, &createdPB.SectionID).WillReturnError(&mysql.MySQLError{Number: 1062})
 
-		_, err := rp.Post(&createdPB)
+		_, err := rp.Post(context.Background(), &createdPB)
 		mockErr := mock.ExpectationsWereMet()
 
 		assert.Error(t, err)
@@ -105,7 +106,7 @@ func TestProductBatchesRepository_GetByID(t *testing.T) {
 
 		mock.ExpectQuery("SELECT `id`, `batch_number`, `current_quantity`, `current_temperature`, `minimum_temperature`, `due_date`, `initial_quantity`, `manufacturing_date`, `manufacturing_hour`, `product_id`, `section_id` FROM `product_batches` WHERE `id` = ?").WithArgs(&createdPB.ID).WillReturnRows(rows)
 
-		pbItem, err := rp.GetByID(pbID)
+		pbItem, err := rp.GetByID(context.Background(), pbID)
 		mockErr := mock.ExpectationsWereMet()
 
 		assert.NoError(t, mockErr)
@@ -121,7 +122,7 @@ func TestProductBatchesRepository_GetByID(t *testing.T) {
 
 		mock.ExpectQuery("SELECT `id`, `batch_number`, `current_quantity`, `current_temperature`, `minimum_temperature`, `due_date`, `initial_quantity`, `manufacturing_date`, `manufacturing_hour`, `product_id`, `section_id` FROM `product_batches` WHERE `id` = ?").WithArgs(pbID).WillReturnError(sql.ErrNoRows)
 
-		pbItem, err := rp.GetByID(pbID)
+		pbItem, err := rp.GetByID(context.Background(), pbID)
 		mockErr := mock.ExpectationsWereMet()
 
 		assert.NoError(t, mockErr)
@@ -130,3 +131,232 @@ func TestProductBatchesRepository_GetByID(t *testing.T) {
 
 	})
 }
+
+func TestProductBatchesRepository_GetByIDForUpdate(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	defer db.Close()
+
+	rp := repository.CreateProductBatchesRepository(db, logMock)
+
+	t.Run("given a valid id then lock and return the product batch", func(t *testing.T) {
+		mock.ExpectQuery("SELECT `id`, `batch_number`, `current_quantity`, `current_temperature`, `minimum_temperature`, `due_date`, `initial_quantity`, `manufacturing_date`, `manufacturing_hour`, `product_id`, `section_id` FROM `product_batches` WHERE `id` = ? FOR UPDATE").
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "batch_number", "current_quantity", "current_temperature", "minimum_temperature", "due_date", "initial_quantity", "manufacturing_date", "manufacturing_hour", "product_id", "section_id"}).
+				AddRow(1, "B01", 8, 4.5, 2.0, time.Time{}, 10, time.Time{}, 10, 1, 1))
+
+		pb, err := rp.GetByIDForUpdate(context.Background(), 1)
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+		assert.NoError(t, err)
+		assert.Equal(t, model.ProductBatches{ID: 1, BatchNumber: "B01", CurrentQuantity: 8, CurrentTemperature: 4.5, MinimumTemperature: 2.0, InitialQuantity: 10, ManufacturingHour: 10, ProductID: 1, SectionID: 1}, pb)
+	})
+
+	t.Run("given an unknown id then return not found", func(t *testing.T) {
+		mock.ExpectQuery("SELECT `id`, `batch_number`, `current_quantity`, `current_temperature`, `minimum_temperature`, `due_date`, `initial_quantity`, `manufacturing_date`, `manufacturing_hour`, `product_id`, `section_id` FROM `product_batches` WHERE `id` = ? FOR UPDATE").
+			WithArgs(99).
+			WillReturnError(sql.ErrNoRows)
+
+		_, err := rp.GetByIDForUpdate(context.Background(), 99)
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+		assert.Equal(t, customerror.HandleError("product batches", customerror.ErrorNotFound, ""), err)
+	})
+}
+
+func TestProductBatchesRepository_Get(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	defer db.Close()
+
+	rp := repository.CreateProductBatchesRepository(db, logMock)
+
+	columns := []string{"id", "batch_number", "current_quantity", "current_temperature", "minimum_temperature", "due_date", "initial_quantity", "manufacturing_date", "manufacturing_hour", "product_id", "section_id"}
+	selectQuery := "SELECT `id`, `batch_number`, `current_quantity`, `current_temperature`, `minimum_temperature`, `due_date`, `initial_quantity`, `manufacturing_date`, `manufacturing_hour`, `product_id`, `section_id` FROM `product_batches`"
+
+	t.Run("given filters by section and due date range then return the matching page", func(t *testing.T) {
+		dueDate := time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC)
+		params := model.ListParams{Page: 1, PageSize: 10, Sort: "due_date", Filters: map[string]string{"section_id": "1", "due_date_from": "2025-01-01", "due_date_to": "2025-01-31"}}
+
+		rows := sqlmock.NewRows(columns).AddRow(1, "B01", 10, 10.0, 5.0, dueDate, 10, dueDate, 10, 1, 1)
+
+		mock.ExpectQuery(selectQuery+" WHERE `due_date` >= ? AND `due_date` < ? + INTERVAL 1 DAY AND `section_id` = ? ORDER BY `due_date`, `id` LIMIT ? OFFSET ?").
+			WithArgs("2025-01-01", "2025-01-31", "1", 10, 0).
+			WillReturnRows(rows)
+		mock.ExpectQuery("SELECT COUNT(*) FROM `product_batches` WHERE `due_date` >= ? AND `due_date` < ? + INTERVAL 1 DAY AND `section_id` = ?").
+			WithArgs("2025-01-01", "2025-01-31", "1").
+			WillReturnRows(sqlmock.NewRows([]string{"COUNT(*)"}).AddRow(1))
+
+		batches, total, err := rp.Get(context.Background(), params)
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+		assert.NoError(t, err)
+		assert.Equal(t, 1, total)
+		assert.Equal(t, []model.ProductBatches{{ID: 1, BatchNumber: "B01", CurrentQuantity: 10, CurrentTemperature: 10.0, MinimumTemperature: 5.0, DueDate: dueDate, InitialQuantity: 10, ManufacturingDate: dueDate, ManufacturingHour: 10, ProductID: 1, SectionID: 1}}, batches)
+	})
+
+	t.Run("given a query error then return it", func(t *testing.T) {
+		mock.ExpectQuery(selectQuery + " ORDER BY `id`").WillReturnError(sql.ErrConnDone)
+
+		batches, total, err := rp.Get(context.Background(), model.ListParams{})
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+		assert.ErrorIs(t, err, sql.ErrConnDone)
+		assert.Zero(t, total)
+		assert.Nil(t, batches)
+	})
+}
+
+func TestProductBatchesRepository_Update(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	defer db.Close()
+
+	rp := repository.CreateProductBatchesRepository(db, logMock)
+
+	t.Run("given a corrected batch then update it and return the stored values", func(t *testing.T) {
+		pb := model.ProductBatches{ID: 1, BatchNumber: "B01", CurrentQuantity: 8, CurrentTemperature: 4.5, MinimumTemperature: 2.0, ProductID: 1, SectionID: 1}
+
+		mock.ExpectExec("UPDATE `product_batches` SET `current_quantity` = ?, `current_temperature` = ?, `minimum_temperature` = ? WHERE `id` = ?").
+			WithArgs(8, 4.5, 2.0, 1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery("SELECT `id`, `batch_number`, `current_quantity`, `current_temperature`, `minimum_temperature`, `due_date`, `initial_quantity`, `manufacturing_date`, `manufacturing_hour`, `product_id`, `section_id` FROM `product_batches` WHERE `id` = ?").
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "batch_number", "current_quantity", "current_temperature", "minimum_temperature", "due_date", "initial_quantity", "manufacturing_date", "manufacturing_hour", "product_id", "section_id"}).
+				AddRow(1, "B01", 8, 4.5, 2.0, time.Time{}, 0, time.Time{}, 0, 1, 1))
+
+		updated, err := rp.Update(context.Background(), 1, &pb)
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+		assert.NoError(t, err)
+		assert.Equal(t, pb, updated)
+	})
+}
+
+func TestProductBatchesRepository_Delete(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	defer db.Close()
+
+	rp := repository.CreateProductBatchesRepository(db, logMock)
+
+	t.Run("given an existing batch then delete it", func(t *testing.T) {
+		mock.ExpectExec("DELETE FROM `product_batches` WHERE `id` = ?").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := rp.Delete(context.Background(), 1)
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+		assert.NoError(t, err)
+	})
+
+	t.Run("given an unknown batch then return not found", func(t *testing.T) {
+		mock.ExpectExec("DELETE FROM `product_batches` WHERE `id` = ?").WithArgs(99).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := rp.Delete(context.Background(), 99)
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+		assert.Equal(t, customerror.HandleError("product batches", customerror.ErrorNotFound, ""), err)
+	})
+
+	t.Run("given a batch referenced by another table then return a dependency error", func(t *testing.T) {
+		mock.ExpectExec("DELETE FROM `product_batches` WHERE `id` = ?").WithArgs(1).WillReturnError(&mysql.MySQLError{Number: 1451})
+
+		err := rp.Delete(context.Background(), 1)
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+		assert.Equal(t, customerror.HandleError("product batches", customerror.ErrorDep, ""), err)
+	})
+}
+
+func TestProductBatchesRepository_CountReferences(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	defer db.Close()
+
+	rp := repository.CreateProductBatchesRepository(db, logMock)
+
+	query := "SELECT " +
+		"(SELECT COUNT(*) FROM `inbound_orders` WHERE `product_batch_id` = ? OR `id` IN (SELECT `inbound_order_id` FROM `inbound_order_batches` WHERE `product_batch_id` = ?)), " +
+		"(SELECT COUNT(DISTINCT `purchase_order_id`) FROM `purchase_order_batches` WHERE `product_batch_id` = ?), " +
+		"(SELECT COUNT(*) FROM `temperature_excursions` WHERE `product_batch_id` = ?)"
+
+	t.Run("return the inbound orders, purchase orders and excursions referencing the batch", func(t *testing.T) {
+		mock.ExpectQuery(query).
+			WithArgs(1, 1, 1, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"inbound_orders", "purchase_orders", "temperature_excursions"}).AddRow(2, 1, 3))
+
+		refs, err := rp.CountReferences(context.Background(), 1)
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+		assert.NoError(t, err)
+		assert.Equal(t, model.ProductBatchesReferences{InboundOrders: 2, PurchaseOrders: 1, TemperatureExcursions: 3}, refs)
+	})
+
+	t.Run("return error when the query fails", func(t *testing.T) {
+		mock.ExpectQuery(query).
+			WithArgs(1, 1, 1, 1).
+			WillReturnError(sql.ErrConnDone)
+
+		_, err := rp.CountReferences(context.Background(), 1)
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+		assert.ErrorIs(t, err, sql.ErrConnDone)
+	})
+}
+
+func TestProductBatchesRepository_GetExpiring(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	defer db.Close()
+
+	rp := repository.CreateProductBatchesRepository(db, logMock)
+
+	query := "SELECT pb.id, pb.batch_number, pb.current_quantity, pb.due_date, pb.product_id, s.id, s.section_number, w.id, w.warehouse_code " +
+		"FROM product_batches pb " +
+		"INNER JOIN sections s ON s.id = pb.section_id " +
+		"INNER JOIN warehouses w ON w.id = s.warehouse_id " +
+		"WHERE pb.current_quantity > 0 AND pb.due_date <= ? " +
+		"ORDER BY w.id, s.id, pb.due_date, pb.id"
+	until := time.Date(2025, 1, 8, 0, 0, 0, 0, time.UTC)
+	dueDate := time.Date(2025, 1, 5, 0, 0, 0, 0, time.UTC)
+
+	t.Run("return the batches due until the given time", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"id", "batch_number", "current_quantity", "due_date", "product_id", "id", "section_number", "id", "warehouse_code"}).
+			AddRow(1, "B01", 10, dueDate, 1, 2, "S02", 3, "W03")
+		mock.ExpectQuery(query).WithArgs(until).WillReturnRows(rows)
+
+		batches, err := rp.GetExpiring(context.Background(), until)
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+		assert.NoError(t, err)
+		assert.Equal(t, []model.ExpiringBatch{{ID: 1, BatchNumber: "B01", CurrentQuantity: 10, DueDate: dueDate, ProductID: 1, SectionID: 2, SectionNumber: "S02", WarehouseID: 3, WarehouseCode: "W03"}}, batches)
+	})
+
+	t.Run("return error when the query fails", func(t *testing.T) {
+		mock.ExpectQuery(query).WithArgs(until).WillReturnError(sql.ErrConnDone)
+
+		batches, err := rp.GetExpiring(context.Background(), until)
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+		assert.ErrorIs(t, err, sql.ErrConnDone)
+		assert.Nil(t, batches)
+	})
+}
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
@@ -22,9 +23,9 @@ func TestProductRepository_GetAll(t *testing.T) {
 	rp := NewProductRepository(db, logMock)
 
 	t.Run("retrieving all products", func(t *testing.T) {
-		products := map[int]model.Product{
-			1: {ID: 1, ProductCode: "CODE1", Description: "Product 1", Width: 10.5, Height: 20.5, Length: 30.5, NetWeight: 100, ExpirationRate: 0.5, RecommendedFreezingTemperature: -18, FreezingRate: 0.3, ProductTypeID: 1, SellerID: 1},
-			2: {ID: 2, ProductCode: "CODE2", Description: "Product 2", Width: 20.5, Height: 30.5, Length: 40.5, NetWeight: 200, ExpirationRate: 0.6, RecommendedFreezingTemperature: -16, FreezingRate: 0.4, ProductTypeID: 2, SellerID: 2},
+		products := []model.Product{
+			{ID: 1, ProductCode: "CODE1", Description: "Product 1", Width: 10.5, Height: 20.5, Length: 30.5, NetWeight: 100, ExpirationRate: 0.5, RecommendedFreezingTemperature: -18, FreezingRate: 0.3, ProductTypeID: 1, SellerID: 1},
+			{ID: 2, ProductCode: "CODE2", Description: "Product 2", Width: 20.5, Height: 30.5, Length: 40.5, NetWeight: 200, ExpirationRate: 0.6, RecommendedFreezingTemperature: -16, FreezingRate: 0.4, ProductTypeID: 2, SellerID: 2},
 		}
 
 		rows := sqlmock.NewRows([]string{"id", "product_code", "description", "width", "height", "length", "net_weight", "expiration_rate", "recommended_freezing_temperature", "freezing_rate", "product_type_id", "seller_id"})
@@ -32,20 +33,21 @@ func TestProductRepository_GetAll(t *testing.T) {
 			rows.AddRow(p.ID, p.ProductCode, p.Description, p.Width, p.Height, p.Length, p.NetWeight, p.ExpirationRate, p.RecommendedFreezingTemperature, p.FreezingRate, p.ProductTypeID, p.SellerID)
 		}
 
-		mock.ExpectQuery("SELECT id, product_code, description, width, height, length, net_weight, expiration_rate, recommended_freezing_temperature, freezing_rate, product_type_id, seller_id FROM products").
+		mock.ExpectQuery("SELECT id, product_code, description, width, height, length, net_weight, expiration_rate, recommended_freezing_temperature, freezing_rate, product_type_id, seller_id FROM products ORDER BY id").
 			WillReturnRows(rows)
 
-		result, err := rp.GetAll()
+		result, total, err := rp.GetAll(context.Background(), model.ListParams{})
 		assert.NoError(t, err)
 		assert.Equal(t, products, result)
+		assert.Equal(t, 2, total)
 	})
 
 	t.Run("error executing query", func(t *testing.T) {
-		mock.ExpectQuery("SELECT id, product_code, description, width, height, length, net_weight, expiration_rate, recommended_freezing_temperature, freezing_rate, product_type_id, seller_id FROM products").
+		mock.ExpectQuery("SELECT id, product_code, description, width, height, length, net_weight, expiration_rate, recommended_freezing_temperature, freezing_rate, product_type_id, seller_id FROM products ORDER BY id").
 			WillReturnRows(sqlmock.NewRows([]string{"id", "product_code", "description", "width", "height", "length", "net_weight", "expiration_rate", "recommended_freezing_temperature", "freezing_rate", "product_type_id", "seller_id"}).
 				AddRow(1, "CODE1", "Product 1", 10.5, 20.5, 30.5, 100, 0.5, -18, 0.3, 1, 1).RowError(0, fmt.Errorf("Row error")))
 
-		_, err := rp.GetAll()
+		_, _, err := rp.GetAll(context.Background(), model.ListParams{})
 
 		fmt.Println(err)
 
@@ -55,31 +57,31 @@ func TestProductRepository_GetAll(t *testing.T) {
 
 	t.Run("error on scanning product", func(t *testing.T) {
 		expected := errors.New("Error executing query")
-		mock.ExpectQuery("SELECT id, product_code, description, width, height, length, net_weight, expiration_rate, recommended_freezing_temperature, freezing_rate, product_type_id, seller_id FROM products").
+		mock.ExpectQuery("SELECT id, product_code, description, width, height, length, net_weight, expiration_rate, recommended_freezing_temperature, freezing_rate, product_type_id, seller_id FROM products ORDER BY id").
 			WillReturnError(expected)
 
-		_, err := rp.GetAll()
+		_, _, err := rp.GetAll(context.Background(), model.ListParams{})
 
 		assert.EqualError(t, expected, err.Error())
 	})
 
 	t.Run("error on convert type of product", func(t *testing.T) {
 		expected := errors.New("sql: Scan error on column index 3, name \"width\": converting driver.Value type string (\"Invalid Field\") to a float64: invalid syntax")
-		mock.ExpectQuery("SELECT id, product_code, description, width, height, length, net_weight, expiration_rate, recommended_freezing_temperature, freezing_rate, product_type_id, seller_id FROM products").
+		mock.ExpectQuery("SELECT id, product_code, description, width, height, length, net_weight, expiration_rate, recommended_freezing_temperature, freezing_rate, product_type_id, seller_id FROM products ORDER BY id").
 			WillReturnRows(sqlmock.NewRows([]string{"id", "product_code", "description", "width", "height", "length", "net_weight", "expiration_rate", "recommended_freezing_temperature", "freezing_rate", "product_type_id", "seller_id"}).
 				AddRow(1, "CODE1", "Product 1", "Invalid Field", 20.5, 30.5, 100, 0.5, -18, 0.3, 1, 1))
 
-		_, err := rp.GetAll()
+		_, _, err := rp.GetAll(context.Background(), model.ListParams{})
 
 		assert.EqualError(t, expected, err.Error())
 	})
 
 	t.Run("error on rows.Err()", func(t *testing.T) {
-		mock.ExpectQuery("SELECT id, product_code, description, width, height, length, net_weight, expiration_rate, recommended_freezing_temperature, freezing_rate, product_type_id, seller_id FROM products").
+		mock.ExpectQuery("SELECT id, product_code, description, width, height, length, net_weight, expiration_rate, recommended_freezing_temperature, freezing_rate, product_type_id, seller_id FROM products ORDER BY id").
 			WillReturnRows(sqlmock.NewRows([]string{"id", "product_code", "description", "width", "height", "length", "net_weight", "expiration_rate", "recommended_freezing_temperature", "freezing_rate", "product_type_id", "seller_id"}).
 				AddRow(1, "CODE1", "Product 1", 10.5, 20.5, 30.5, 100, 0.5, -18, 0.3, 1, 1).RowError(0, fmt.Errorf("Row error")))
 
-		_, err := rp.GetAll()
+		_, _, err := rp.GetAll(context.Background(), model.ListParams{})
 
 		fmt.Println(err)
 
@@ -122,7 +124,7 @@ func TestProductRepository_GetByID(t *testing.T) {
 			WithArgs(productID).
 			WillReturnRows(rows)
 
-		result, err := repo.GetByID(productID)
+		result, err := repo.GetByID(context.Background(), productID)
 		assert.NoError(t, err)
 		assert.Equal(t, expectedProduct, result)
 	})
@@ -134,7 +136,7 @@ func TestProductRepository_GetByID(t *testing.T) {
 			WithArgs(productID).
 			WillReturnError(sql.ErrNoRows)
 
-		_, err := repo.GetByID(productID)
+		_, err := repo.GetByID(context.Background(), productID)
 		fmt.Println(err)
 
 		assert.Error(t, err)
@@ -150,7 +152,7 @@ func TestProductRepository_GetByID(t *testing.T) {
 			WillReturnRows(sqlmock.NewRows([]string{"id", "product_code", "description", "width", "height", "length", "net_weight", "expiration_rate", "recommended_freezing_temperature", "freezing_rate", "product_type_id", "seller_id"}).
 				AddRow(productID, "CODE1", "Product 1", 10.5, 20.5, 30.5, 100, 0.5, -18, 0.3, 1, "INVALID_TYPE"))
 
-		_, err := repo.GetByID(productID)
+		_, err := repo.GetByID(context.Background(), productID)
 		assert.Error(t, err)
 	})
 }
@@ -183,7 +185,7 @@ func TestProductRepository_Create(t *testing.T) {
 			WithArgs(product.ProductCode, product.Description, product.Width, product.Height, product.Length, product.NetWeight, product.ExpirationRate, product.RecommendedFreezingTemperature, product.FreezingRate, product.ProductTypeID, product.SellerID).
 			WillReturnResult(sqlmock.NewResult(1, 1))
 
-		newProduct, err := repo.Create(product)
+		newProduct, err := repo.Create(context.Background(), product)
 		assert.NoError(t, err)
 		assert.Equal(t, 1, newProduct.ID)
 	})
@@ -193,7 +195,7 @@ func TestProductRepository_Create(t *testing.T) {
 			WithArgs(product.ProductCode, product.Description, product.Width, product.Height, product.Length, product.NetWeight, product.ExpirationRate, product.RecommendedFreezingTemperature, product.FreezingRate, product.ProductTypeID, product.SellerID).
 			WillReturnError(errors.New("simulated database error"))
 
-		_, err := repo.Create(product)
+		_, err := repo.Create(context.Background(), product)
 		fmt.Println(err)
 		assert.Error(t, err)
 	})
@@ -203,7 +205,7 @@ func TestProductRepository_Create(t *testing.T) {
 			WithArgs(product.ProductCode, product.Description, product.Width, product.Height, product.Length, product.NetWeight, product.ExpirationRate, product.RecommendedFreezingTemperature, product.FreezingRate, product.ProductTypeID, product.SellerID).
 			WillReturnResult(sqlmock.NewErrorResult(errors.New("Error to get id")))
 
-		_, err := repo.Create(product)
+		_, err := repo.Create(context.Background(), product)
 		assert.EqualError(t, errors.New("Error to get id"), err.Error())
 	})
 
@@ -239,7 +241,7 @@ func TestProductRepository_Update(t *testing.T) {
 			WithArgs(product.ProductCode, product.Description, product.Width, product.Height, product.Length, product.NetWeight, product.ExpirationRate, product.RecommendedFreezingTemperature, product.FreezingRate, product.ProductTypeID, product.SellerID, productID).
 			WillReturnResult(sqlmock.NewResult(1, 1)) // Simula a atualização bem-sucedida
 
-		updatedProduct, err := repo.Update(productID, product)
+		updatedProduct, err := repo.Update(context.Background(), productID, product)
 		assert.NoError(t, err)
 		assert.Equal(t, productID, updatedProduct.ID) // Verifica se o ID retornado é o esperado
 	})
@@ -251,7 +253,7 @@ func TestProductRepository_Update(t *testing.T) {
 			WithArgs(product.ProductCode, product.Description, product.Width, product.Height, product.Length, product.NetWeight, product.ExpirationRate, product.RecommendedFreezingTemperature, product.FreezingRate, product.ProductTypeID, product.SellerID, productID).
 			WillReturnError(errors.New("simulated update error"))
 
-		_, err := repo.Update(productID, product)
+		_, err := repo.Update(context.Background(), productID, product)
 
 		assert.Error(t, err)
 	})
@@ -273,7 +275,7 @@ func TestProductRepository_Delete(t *testing.T) {
 			WithArgs(productID).
 			WillReturnResult(sqlmock.NewResult(1, 1)) // Simula a deleção bem-sucedida
 
-		err := repo.Delete(productID)
+		err := repo.Delete(context.Background(), productID)
 		assert.NoError(t, err)
 	})
 
@@ -284,7 +286,7 @@ func TestProductRepository_Delete(t *testing.T) {
 			WithArgs(productID).
 			WillReturnError(errors.New("simulated delete error"))
 
-		err := repo.Delete(productID)
+		err := repo.Delete(context.Background(), productID)
 		assert.Error(t, err)
 		assert.Equal(t, appErr.HandleError("product", appErr.ErrorDep, "product record"), err)
 	})
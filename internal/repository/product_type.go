@@ -0,0 +1,201 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+	"github.com/maxwelbm/alkemy-g7.git/internal/repository/interfaces"
+	"github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
+	"github.com/maxwelbm/alkemy-g7.git/pkg/logger"
+)
+
+type ProductTypeRepository struct {
+	db  DBTX
+	log logger.Logger
+}
+
+func NewProductTypeRepository(db *sql.DB, log logger.Logger) *ProductTypeRepository {
+	return &ProductTypeRepository{db: db, log: log}
+}
+
+func (r *ProductTypeRepository) Get(ctx context.Context) (productTypes []model.ProductType, err error) {
+	r.log.Log("ProductTypeRepository", "INFO", "initializing Get function")
+
+	rows, err := r.db.QueryContext(ctx, "SELECT `id`, `type_name` FROM `product_type` ORDER BY `id`")
+	if err != nil {
+		r.log.Log("ProductTypeRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+		return
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var productType model.ProductType
+
+		err = rows.Scan(&productType.ID, &productType.TypeName)
+		if err != nil {
+			r.log.Log("ProductTypeRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+			return nil, err
+		}
+
+		productTypes = append(productTypes, productType)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		r.log.Log("ProductTypeRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+		return nil, err
+	}
+
+	r.log.Log("ProductTypeRepository", "INFO", fmt.Sprintf("returning %d product types", len(productTypes)))
+
+	return
+}
+
+func (r *ProductTypeRepository) GetByID(ctx context.Context, id int) (productType model.ProductType, err error) {
+	r.log.Log("ProductTypeRepository", "INFO", fmt.Sprintf("initializing GetByID function with id %d", id))
+
+	row := r.db.QueryRowContext(ctx, "SELECT `id`, `type_name` FROM `product_type` WHERE `id` = ?", id)
+
+	err = row.Scan(&productType.ID, &productType.TypeName)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = customerror.HandleError("product type", customerror.ErrorNotFound, "")
+		}
+
+		r.log.Log("ProductTypeRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	r.log.Log("ProductTypeRepository", "INFO", fmt.Sprintf("returning product type: %v", productType))
+
+	return
+}
+
+func (r *ProductTypeRepository) Post(ctx context.Context, productType model.ProductType) (model.ProductType, error) {
+	r.log.Log("ProductTypeRepository", "INFO", "initializing Post function")
+
+	result, err := r.db.ExecContext(ctx, "INSERT INTO `product_type` (`type_name`) VALUES (?)", productType.TypeName)
+	if err != nil {
+		r.log.Log("ProductTypeRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+		return model.ProductType{}, err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		r.log.Log("ProductTypeRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+		return model.ProductType{}, err
+	}
+
+	productType.ID = int(id)
+
+	r.log.Log("ProductTypeRepository", "INFO", fmt.Sprintf("saved product type: %v", productType))
+
+	return productType, nil
+}
+
+func (r *ProductTypeRepository) Update(ctx context.Context, id int, productType model.ProductType) (model.ProductType, error) {
+	r.log.Log("ProductTypeRepository", "INFO", fmt.Sprintf("initializing Update function with id %d", id))
+
+	_, err := r.db.ExecContext(ctx, "UPDATE `product_type` SET `type_name` = ? WHERE `id` = ?", productType.TypeName, id)
+	if err != nil {
+		r.log.Log("ProductTypeRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+		return model.ProductType{}, err
+	}
+
+	productType.ID = id
+
+	r.log.Log("ProductTypeRepository", "INFO", fmt.Sprintf("updated product type: %v", productType))
+
+	return productType, nil
+}
+
+func (r *ProductTypeRepository) Delete(ctx context.Context, id int) (err error) {
+	r.log.Log("ProductTypeRepository", "INFO", fmt.Sprintf("initializing Delete function with id %d", id))
+
+	_, err = r.db.ExecContext(ctx, "DELETE FROM `product_type` WHERE `id` = ?", id)
+	if err != nil {
+		var mysqlErr *mysql.MySQLError
+		if errors.As(err, &mysqlErr) && mysqlErr.Number == 1451 {
+			err = customerror.HandleError("product type", customerror.ErrorDep, "")
+		}
+
+		r.log.Log("ProductTypeRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	r.log.Log("ProductTypeRepository", "INFO", fmt.Sprintf("product type with id %d deleted", id))
+
+	return
+}
+
+const productTypeUsageQuery = "SELECT pt.id, pt.type_name, " +
+	"(SELECT COUNT(*) FROM products p WHERE p.product_type_id = pt.id) AS products_count, " +
+	"(SELECT COUNT(*) FROM sections s WHERE s.product_type_id = pt.id) AS sections_count " +
+	"FROM product_type pt"
+
+func (r *ProductTypeRepository) CountUsage(ctx context.Context) (usage []model.ProductTypeUsage, err error) {
+	r.log.Log("ProductTypeRepository", "INFO", "initializing CountUsage function")
+
+	rows, err := r.db.QueryContext(ctx, productTypeUsageQuery+" ORDER BY pt.id")
+	if err != nil {
+		r.log.Log("ProductTypeRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+		return
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var u model.ProductTypeUsage
+
+		err = rows.Scan(&u.ID, &u.TypeName, &u.ProductsCount, &u.SectionsCount)
+		if err != nil {
+			r.log.Log("ProductTypeRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+			return nil, err
+		}
+
+		usage = append(usage, u)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		r.log.Log("ProductTypeRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+		return nil, err
+	}
+
+	r.log.Log("ProductTypeRepository", "INFO", fmt.Sprintf("returning usage of %d product types", len(usage)))
+
+	return
+}
+
+func (r *ProductTypeRepository) CountUsageByID(ctx context.Context, id int) (usage model.ProductTypeUsage, err error) {
+	r.log.Log("ProductTypeRepository", "INFO", fmt.Sprintf("initializing CountUsageByID function with id %d", id))
+
+	row := r.db.QueryRowContext(ctx, productTypeUsageQuery+" WHERE pt.id = ?", id)
+
+	err = row.Scan(&usage.ID, &usage.TypeName, &usage.ProductsCount, &usage.SectionsCount)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = customerror.HandleError("product type", customerror.ErrorNotFound, "")
+		}
+
+		r.log.Log("ProductTypeRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	r.log.Log("ProductTypeRepository", "INFO", fmt.Sprintf("returning usage of product type: %v", usage))
+
+	return
+}
+
+// WithTx implements interfaces.IProductTypeRepo.
+func (r *ProductTypeRepository) WithTx(tx *sql.Tx) interfaces.IProductTypeRepo {
+	return &ProductTypeRepository{db: tx, log: r.log}
+}
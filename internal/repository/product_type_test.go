@@ -0,0 +1,217 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+	"github.com/maxwelbm/alkemy-g7.git/internal/repository"
+	"github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProductTypeRepository_Get(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	defer db.Close()
+
+	rp := repository.NewProductTypeRepository(db, logMock)
+
+	t.Run("return all the product types", func(t *testing.T) {
+		expected := []model.ProductType{{ID: 1, TypeName: "Frozen"}, {ID: 2, TypeName: "Dry goods"}}
+
+		rows := mock.NewRows([]string{"id", "type_name"}).AddRow(1, "Frozen").AddRow(2, "Dry goods")
+		mock.ExpectQuery("SELECT `id`, `type_name` FROM `product_type` ORDER BY `id`").WillReturnRows(rows)
+
+		productTypes, err := rp.Get(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, productTypes)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("return error when the query fails", func(t *testing.T) {
+		mock.ExpectQuery("SELECT `id`, `type_name` FROM `product_type` ORDER BY `id`").WillReturnError(errors.New("unmapped error"))
+
+		productTypes, err := rp.Get(context.Background())
+
+		assert.Error(t, err)
+		assert.Nil(t, productTypes)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestProductTypeRepository_GetByID(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	defer db.Close()
+
+	rp := repository.NewProductTypeRepository(db, logMock)
+
+	t.Run("return the product type", func(t *testing.T) {
+		mock.ExpectQuery("SELECT `id`, `type_name` FROM `product_type` WHERE `id` = ?").
+			WithArgs(1).
+			WillReturnRows(mock.NewRows([]string{"id", "type_name"}).AddRow(1, "Frozen"))
+
+		productType, err := rp.GetByID(context.Background(), 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, model.ProductType{ID: 1, TypeName: "Frozen"}, productType)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("return not found when the product type does not exist", func(t *testing.T) {
+		mock.ExpectQuery("SELECT `id`, `type_name` FROM `product_type` WHERE `id` = ?").
+			WithArgs(99).
+			WillReturnError(sql.ErrNoRows)
+
+		_, err := rp.GetByID(context.Background(), 99)
+
+		assert.Equal(t, customerror.HandleError("product type", customerror.ErrorNotFound, ""), err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestProductTypeRepository_Post(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	defer db.Close()
+
+	rp := repository.NewProductTypeRepository(db, logMock)
+
+	t.Run("create the product type", func(t *testing.T) {
+		mock.ExpectExec("INSERT INTO `product_type` (`type_name`) VALUES (?)").
+			WithArgs("Frozen").
+			WillReturnResult(sqlmock.NewResult(3, 1))
+
+		productType, err := rp.Post(context.Background(), model.ProductType{TypeName: "Frozen"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, model.ProductType{ID: 3, TypeName: "Frozen"}, productType)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestProductTypeRepository_Update(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	defer db.Close()
+
+	rp := repository.NewProductTypeRepository(db, logMock)
+
+	t.Run("update the product type", func(t *testing.T) {
+		mock.ExpectExec("UPDATE `product_type` SET `type_name` = ? WHERE `id` = ?").
+			WithArgs("Chilled", 1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		productType, err := rp.Update(context.Background(), 1, model.ProductType{TypeName: "Chilled"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, model.ProductType{ID: 1, TypeName: "Chilled"}, productType)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestProductTypeRepository_Delete(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	defer db.Close()
+
+	rp := repository.NewProductTypeRepository(db, logMock)
+
+	t.Run("delete the product type", func(t *testing.T) {
+		mock.ExpectExec("DELETE FROM `product_type` WHERE `id` = ?").
+			WithArgs(1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := rp.Delete(context.Background(), 1)
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("return conflict when a foreign key references the product type", func(t *testing.T) {
+		mock.ExpectExec("DELETE FROM `product_type` WHERE `id` = ?").
+			WithArgs(1).
+			WillReturnError(&mysql.MySQLError{Number: 1451})
+
+		err := rp.Delete(context.Background(), 1)
+
+		assert.Equal(t, customerror.HandleError("product type", customerror.ErrorDep, ""), err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestProductTypeRepository_CountUsage(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	defer db.Close()
+
+	rp := repository.NewProductTypeRepository(db, logMock)
+
+	query := "SELECT pt.id, pt.type_name, " +
+		"(SELECT COUNT(*) FROM products p WHERE p.product_type_id = pt.id) AS products_count, " +
+		"(SELECT COUNT(*) FROM sections s WHERE s.product_type_id = pt.id) AS sections_count " +
+		"FROM product_type pt"
+
+	t.Run("return the usage of every product type", func(t *testing.T) {
+		rows := mock.NewRows([]string{"id", "type_name", "products_count", "sections_count"}).
+			AddRow(1, "Frozen", 4, 2).
+			AddRow(2, "Dry goods", 0, 0)
+		mock.ExpectQuery(query + " ORDER BY pt.id").WillReturnRows(rows)
+
+		usage, err := rp.CountUsage(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, []model.ProductTypeUsage{
+			{ID: 1, TypeName: "Frozen", ProductsCount: 4, SectionsCount: 2},
+			{ID: 2, TypeName: "Dry goods"},
+		}, usage)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("return the usage of a single product type", func(t *testing.T) {
+		mock.ExpectQuery(query + " WHERE pt.id = ?").
+			WithArgs(1).
+			WillReturnRows(mock.NewRows([]string{"id", "type_name", "products_count", "sections_count"}).AddRow(1, "Frozen", 4, 2))
+
+		usage, err := rp.CountUsageByID(context.Background(), 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, model.ProductTypeUsage{ID: 1, TypeName: "Frozen", ProductsCount: 4, SectionsCount: 2}, usage)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("return not found for an unknown product type", func(t *testing.T) {
+		mock.ExpectQuery(query + " WHERE pt.id = ?").
+			WithArgs(99).
+			WillReturnError(sql.ErrNoRows)
+
+		_, err := rp.CountUsageByID(context.Background(), 99)
+
+		assert.Equal(t, customerror.HandleError("product type", customerror.ErrorNotFound, ""), err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -1,18 +1,22 @@
 package repository
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/maxwelbm/alkemy-g7.git/pkg/logger"
 
 	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+	"github.com/maxwelbm/alkemy-g7.git/internal/repository/interfaces"
 	appErr "github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
 )
 
 type ProductRecRepository struct {
-	DB  *sql.DB
+	DB  DBTX
 	log logger.Logger
 }
 
@@ -20,7 +24,7 @@ func NewProductRecRepository(db *sql.DB, log logger.Logger) *ProductRecRepositor
 	return &ProductRecRepository{DB: db, log: log}
 }
 
-func (pr *ProductRecRepository) Create(productRec model.ProductRecords) (model.ProductRecords, error) {
+func (pr *ProductRecRepository) Create(ctx context.Context, productRec model.ProductRecords) (model.ProductRecords, error) {
 	pr.log.Log("ProductRecRepository", "INFO", "Create function initializing")
 
 	query := `
@@ -29,7 +33,7 @@ func (pr *ProductRecRepository) Create(productRec model.ProductRecords) (model.P
 	VALUES (?, ?, ?, ?)
 	`
 
-	result, err := pr.DB.Exec(query, productRec.LastUpdateDate,
+	result, err := pr.DB.ExecContext(ctx, query, productRec.LastUpdateDate,
 		productRec.PurchasePrice, productRec.SalePrice, productRec.ProductID)
 
 	if err != nil {
@@ -49,7 +53,7 @@ func (pr *ProductRecRepository) Create(productRec model.ProductRecords) (model.P
 	return productRec, nil
 }
 
-func (pr *ProductRecRepository) GetByID(id int) (model.ProductRecords, error) {
+func (pr *ProductRecRepository) GetByID(ctx context.Context, id int) (model.ProductRecords, error) {
 	pr.log.Log("ProductRecRepository", "INFO", fmt.Sprintf("GetByID function initializing for ID: %d", id))
 	var productRecord model.ProductRecords
 
@@ -63,7 +67,7 @@ func (pr *ProductRecRepository) GetByID(id int) (model.ProductRecords, error) {
 	FROM product_records 
 	WHERE id = ?
 	`
-	row := pr.DB.QueryRow(query, id)
+	row := pr.DB.QueryRowContext(ctx, query, id)
 
 	err := row.Scan(&productRecord.ID, &productRecord.LastUpdateDate,
 		&productRecord.ProductID, &productRecord.PurchasePrice, &productRecord.SalePrice)
@@ -81,24 +85,24 @@ func (pr *ProductRecRepository) GetByID(id int) (model.ProductRecords, error) {
 	return productRecord, nil
 }
 
-func (pr *ProductRecRepository) GetAll() ([]model.ProductRecords, error) {
+func (pr *ProductRecRepository) GetAll(ctx context.Context, params model.ListParams) ([]model.ProductRecords, int, error) {
 	pr.log.Log("ProductRecRepository", "INFO", "GetAll function initializing")
 	var productRecordList []model.ProductRecords
 
-	query := `
+	list := newListQuery(params, model.ProductRecordsListOptions)
+	query, args := list.selectQuery(`
 	SELECT
 	id,
 	last_update_date, 
 	product_id, 
 	purchase_price, 
 	sale_price
-	FROM product_records
-	`
+	FROM product_records`)
 
-	rows, err := pr.DB.Query(query)
+	rows, err := pr.DB.QueryContext(ctx, query, args...)
 	if err != nil {
 		pr.log.Log("ProductRecRepository", "ERROR", fmt.Sprintf("Error executing query: %v", err))
-		return nil, err
+		return nil, 0, err
 	}
 	defer rows.Close()
 
@@ -110,7 +114,7 @@ func (pr *ProductRecRepository) GetAll() ([]model.ProductRecords, error) {
 
 		if err != nil {
 			pr.log.Log("ProductRecRepository", "ERROR", "Error trying to convert row to struct")
-			return nil, errors.New("Error trying to convert row to struct")
+			return nil, 0, errors.New("Error trying to convert row to struct")
 		}
 
 		productRecordList = append(productRecordList, productRecord)
@@ -118,14 +122,24 @@ func (pr *ProductRecRepository) GetAll() ([]model.ProductRecords, error) {
 
 	if err = rows.Err(); err != nil {
 		pr.log.Log("ProductRecRepository", "ERROR", fmt.Sprintf("Error during row iteration: %v", err))
-		return nil, err
+		return nil, 0, err
+	}
+
+	total := len(productRecordList)
+
+	if params.PageSize > 0 {
+		total, err = countRows(ctx, pr.DB, "SELECT COUNT(*) FROM product_records", list)
+		if err != nil {
+			pr.log.Log("ProductRecRepository", "ERROR", fmt.Sprintf("Error counting product records: %v", err))
+			return nil, 0, err
+		}
 	}
 
 	pr.log.Log("ProductRecRepository", "INFO", fmt.Sprintf("Retrieved all product records: %+v", productRecordList))
-	return productRecordList, nil
+	return productRecordList, total, nil
 }
 
-func (pr *ProductRecRepository) GetByIDProduct(idProduct int) ([]model.ProductRecords, error) {
+func (pr *ProductRecRepository) GetByIDProduct(ctx context.Context, idProduct int) ([]model.ProductRecords, error) {
 	pr.log.Log("ProductRecRepository", "INFO", fmt.Sprintf("GetByIDProduct function initializing for Product ID: %d", idProduct))
 	var productRecordList []model.ProductRecords
 
@@ -140,7 +154,7 @@ func (pr *ProductRecRepository) GetByIDProduct(idProduct int) ([]model.ProductRe
 	where product_id = ?
 	`
 
-	rows, err := pr.DB.Query(query, idProduct)
+	rows, err := pr.DB.QueryContext(ctx, query, idProduct)
 	if err != nil {
 		pr.log.Log("ProductRecRepository", "ERROR", fmt.Sprintf("Error executing query for product ID %d: %v", idProduct, err))
 		return productRecordList, err
@@ -170,8 +184,44 @@ func (pr *ProductRecRepository) GetByIDProduct(idProduct int) ([]model.ProductRe
 	return productRecordList, nil
 }
 
-func (pr *ProductRecRepository) GetAllReport() ([]model.ProductRecordsReport, error) {
-	pr.log.Log("ProductRecRepository", "INFO", "GetAllReport function initializing")
+// GetLatestByIDProduct returns the most recently updated record of the product, which holds its current prices.
+func (pr *ProductRecRepository) GetLatestByIDProduct(ctx context.Context, idProduct int) (model.ProductRecords, error) {
+	pr.log.Log("ProductRecRepository", "INFO", fmt.Sprintf("GetLatestByIDProduct function initializing for Product ID: %d", idProduct))
+	var productRecord model.ProductRecords
+
+	query := `
+	SELECT
+	id,
+	last_update_date, 
+	product_id, 
+	purchase_price, 
+	sale_price
+	FROM product_records
+	WHERE product_id = ?
+	ORDER BY last_update_date DESC, id DESC
+	LIMIT 1
+	`
+	row := pr.DB.QueryRowContext(ctx, query, idProduct)
+
+	err := row.Scan(&productRecord.ID, &productRecord.LastUpdateDate,
+		&productRecord.ProductID, &productRecord.PurchasePrice, &productRecord.SalePrice)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			pr.log.Log("ProductRecRepository", "INFO", fmt.Sprintf("No product record found for Product ID: %d", idProduct))
+			return model.ProductRecords{}, appErr.HandleError("product record", appErr.ErrorNotFound, "")
+		}
+		pr.log.Log("ProductRecRepository", "ERROR", fmt.Sprintf("Error scanning latest product record for Product ID %d: %v", idProduct, err))
+		return model.ProductRecords{}, err
+	}
+
+	pr.log.Log("ProductRecRepository", "INFO", fmt.Sprintf("Retrieved latest product record: %+v", productRecord))
+
+	return productRecord, nil
+}
+
+// GetAllReport counts the records of every product, or of a single product when idProduct is set.
+func (pr *ProductRecRepository) GetAllReport(ctx context.Context, idProduct int) ([]model.ProductRecordsReport, error) {
+	pr.log.Log("ProductRecRepository", "INFO", fmt.Sprintf("GetAllReport function initializing for ProductID: %d", idProduct))
 	var productRecordReport []model.ProductRecordsReport
 
 	query := `
@@ -180,10 +230,18 @@ func (pr *ProductRecRepository) GetAllReport() ([]model.ProductRecordsReport, er
 	p.description, 
 	count(p.id) as record_count 
 	FROM products p
-	inner join product_records pr on pr.product_id = p.id
-	GROUP by p.id, p.description
-	`
-	rows, err := pr.DB.Query(query)
+	inner join product_records pr on pr.product_id = p.id`
+
+	var args []any
+
+	if idProduct > 0 {
+		query += "\n\tWHERE p.id = ?"
+		args = append(args, idProduct)
+	}
+
+	query += "\n\tGROUP by p.id, p.description"
+
+	rows, err := pr.DB.QueryContext(ctx, query, args...)
 	if err != nil {
 		pr.log.Log("ProductRecRepository", "ERROR", fmt.Sprintf("Error executing report query: %v", err))
 		return productRecordReport, err
@@ -209,4 +267,254 @@ func (pr *ProductRecRepository) GetAllReport() ([]model.ProductRecordsReport, er
 
 	pr.log.Log("ProductRecRepository", "INFO", fmt.Sprintf("Retrieved all product record reports: %+v", productRecordReport))
 	return productRecordReport, nil
-}
\ No newline at end of file
+}
+
+// CountPurchaseOrders returns how many purchase orders and purchase order lines are priced with the record.
+func (pr *ProductRecRepository) CountPurchaseOrders(ctx context.Context, id int) (int, error) {
+	pr.log.Log("ProductRecRepository", "INFO", fmt.Sprintf("CountPurchaseOrders function initializing for ID: %d", id))
+
+	query := `
+	SELECT
+	(SELECT COUNT(*) FROM purchase_orders WHERE product_record_id = ?) +
+	(SELECT COUNT(*) FROM purchase_order_lines WHERE product_record_id = ?)
+	`
+
+	var count int
+
+	err := pr.DB.QueryRowContext(ctx, query, id, id).Scan(&count)
+	if err != nil {
+		pr.log.Log("ProductRecRepository", "ERROR", fmt.Sprintf("Error counting purchase orders for product record %d: %v", id, err))
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (pr *ProductRecRepository) Delete(ctx context.Context, id int) error {
+	pr.log.Log("ProductRecRepository", "INFO", fmt.Sprintf("Delete function initializing for ID: %d", id))
+
+	_, err := pr.DB.ExecContext(ctx, "DELETE FROM product_records WHERE id = ?", id)
+	if err != nil {
+		pr.log.Log("ProductRecRepository", "ERROR", fmt.Sprintf("Error deleting product record with ID %d: %v", id, err))
+		return err
+	}
+
+	pr.log.Log("ProductRecRepository", "INFO", fmt.Sprintf("Product record with ID %d deleted successfully", id))
+	return nil
+}
+
+// GetPriceHistory returns the records of the product updated within the period, oldest first.
+// A zero bound leaves that side of the period open.
+func (pr *ProductRecRepository) GetPriceHistory(ctx context.Context, idProduct int, from, to time.Time) ([]model.ProductRecords, error) {
+	pr.log.Log("ProductRecRepository", "INFO", fmt.Sprintf("GetPriceHistory function initializing for Product ID: %d", idProduct))
+	var productRecordList []model.ProductRecords
+
+	conditions := []string{"product_id = ?"}
+	args := []any{idProduct}
+	conditions, args = appendPeriod(conditions, args, "last_update_date", from, to)
+
+	query := `
+	SELECT
+	id,
+	last_update_date, 
+	product_id, 
+	purchase_price, 
+	sale_price
+	FROM product_records
+	WHERE ` + strings.Join(conditions, " AND ") + `
+	ORDER BY last_update_date, id
+	`
+
+	rows, err := pr.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		pr.log.Log("ProductRecRepository", "ERROR", fmt.Sprintf("Error executing price history query for product ID %d: %v", idProduct, err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var productRecord model.ProductRecords
+		err := rows.Scan(&productRecord.ID, &productRecord.LastUpdateDate,
+			&productRecord.ProductID, &productRecord.PurchasePrice,
+			&productRecord.SalePrice)
+
+		if err != nil {
+			pr.log.Log("ProductRecRepository", "ERROR", "Error trying to convert row to struct")
+			return nil, errors.New("Error trying to convert row to struct")
+		}
+
+		productRecordList = append(productRecordList, productRecord)
+	}
+
+	if err = rows.Err(); err != nil {
+		pr.log.Log("ProductRecRepository", "ERROR", fmt.Sprintf("Error during row iteration: %v", err))
+		return nil, err
+	}
+
+	pr.log.Log("ProductRecRepository", "INFO", fmt.Sprintf("Retrieved %d price history records for Product ID %d", len(productRecordList), idProduct))
+	return productRecordList, nil
+}
+
+// GetProductMargins aggregates, per product, the margins of the records matching the filter, ordered
+// by product. The price change compares the last sale price of the period with the first one.
+// The values are returned unrounded.
+func (pr *ProductRecRepository) GetProductMargins(ctx context.Context, filter model.ProductMarginFilter) ([]model.ProductMarginReport, error) {
+	pr.log.Log("ProductRecRepository", "INFO", "GetProductMargins function initializing")
+	var reports []model.ProductMarginReport
+
+	query, args := productMarginsQuery(filter)
+
+	rows, err := pr.DB.QueryContext(ctx, query+`
+	ORDER BY m.product_id
+	`, args...)
+	if err != nil {
+		pr.log.Log("ProductRecRepository", "ERROR", fmt.Sprintf("Error executing product margin query: %v", err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var report model.ProductMarginReport
+		err := rows.Scan(&report.ProductID, &report.Description, &report.SellerID, &report.RecordsCount,
+			&report.AverageMargin, &report.MinMargin, &report.MaxMargin, &report.PriceChangePercentage)
+
+		if err != nil {
+			pr.log.Log("ProductRecRepository", "ERROR", "Error trying to convert row to struct")
+			return nil, errors.New("Error trying to convert row to struct")
+		}
+
+		reports = append(reports, report)
+	}
+
+	if err = rows.Err(); err != nil {
+		pr.log.Log("ProductRecRepository", "ERROR", fmt.Sprintf("Error during row iteration: %v", err))
+		return nil, err
+	}
+
+	pr.log.Log("ProductRecRepository", "INFO", fmt.Sprintf("Retrieved margins of %d products", len(reports)))
+	return reports, nil
+}
+
+// GetSellerMargins rolls the product margins up per seller, ordered by seller. The average margin is
+// weighted by the records of each product and the price change is the average of its products.
+// The values are returned unrounded.
+func (pr *ProductRecRepository) GetSellerMargins(ctx context.Context, filter model.ProductMarginFilter) ([]model.SellerMarginReport, error) {
+	pr.log.Log("ProductRecRepository", "INFO", "GetSellerMargins function initializing")
+	reports := []model.SellerMarginReport{}
+
+	productQuery, args := productMarginsQuery(filter)
+
+	query := `
+	SELECT
+	s.seller_id,
+	COUNT(*),
+	SUM(s.records_count),
+	SUM(s.average_margin * s.records_count) / SUM(s.records_count),
+	MIN(s.min_margin),
+	MAX(s.max_margin),
+	AVG(s.price_change_percentage)
+	FROM (` + productQuery + `) s
+	GROUP BY s.seller_id
+	ORDER BY s.seller_id
+	`
+
+	rows, err := pr.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		pr.log.Log("ProductRecRepository", "ERROR", fmt.Sprintf("Error executing seller margin query: %v", err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var report model.SellerMarginReport
+		err := rows.Scan(&report.SellerID, &report.ProductsCount, &report.RecordsCount,
+			&report.AverageMargin, &report.MinMargin, &report.MaxMargin, &report.PriceChangePercentage)
+
+		if err != nil {
+			pr.log.Log("ProductRecRepository", "ERROR", "Error trying to convert row to struct")
+			return nil, errors.New("Error trying to convert row to struct")
+		}
+
+		reports = append(reports, report)
+	}
+
+	if err = rows.Err(); err != nil {
+		pr.log.Log("ProductRecRepository", "ERROR", fmt.Sprintf("Error during row iteration: %v", err))
+		return nil, err
+	}
+
+	pr.log.Log("ProductRecRepository", "INFO", fmt.Sprintf("Retrieved margins of %d sellers", len(reports)))
+	return reports, nil
+}
+
+// productMarginsQuery builds the per product aggregation of the margins within the filter. The first
+// and last sale prices of each product are taken with window functions over the filtered records.
+func productMarginsQuery(filter model.ProductMarginFilter) (string, []any) {
+	var conditions []string
+	var args []any
+
+	if filter.SellerID > 0 {
+		conditions = append(conditions, "p.seller_id = ?")
+		args = append(args, filter.SellerID)
+	}
+
+	if filter.ProductID > 0 {
+		conditions = append(conditions, "p.id = ?")
+		args = append(args, filter.ProductID)
+	}
+
+	conditions, args = appendPeriod(conditions, args, "pr.last_update_date", filter.From, filter.To)
+
+	where := ""
+	if len(conditions) > 0 {
+		where = "WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	query := `
+	SELECT
+	m.product_id,
+	m.description,
+	m.seller_id,
+	COUNT(*) AS records_count,
+	AVG(m.margin) AS average_margin,
+	MIN(m.margin) AS min_margin,
+	MAX(m.margin) AS max_margin,
+	CASE WHEN MAX(m.first_sale_price) = 0 THEN 0
+	ELSE (MAX(m.last_sale_price) - MAX(m.first_sale_price)) / MAX(m.first_sale_price) * 100 END AS price_change_percentage
+	FROM (
+	SELECT
+	p.id AS product_id,
+	p.description,
+	p.seller_id,
+	CASE WHEN pr.sale_price = 0 THEN 0 ELSE (pr.sale_price - pr.purchase_price) / pr.sale_price * 100 END AS margin,
+	FIRST_VALUE(pr.sale_price) OVER (PARTITION BY p.id ORDER BY pr.last_update_date, pr.id) AS first_sale_price,
+	FIRST_VALUE(pr.sale_price) OVER (PARTITION BY p.id ORDER BY pr.last_update_date DESC, pr.id DESC) AS last_sale_price
+	FROM products p
+	INNER JOIN product_records pr ON pr.product_id = p.id
+	` + where + `
+	) m
+	GROUP BY m.product_id, m.description, m.seller_id`
+
+	return query, args
+}
+
+// appendPeriod adds the inclusive day bounds of a period on the column to the conditions. The bounds
+// are compared as a half-open range on the bare column, so an index on it can still be used.
+func appendPeriod(conditions []string, args []any, column string, from, to time.Time) ([]string, []any) {
+	if !from.IsZero() {
+		conditions = append(conditions, column+" >= ?")
+		args = append(args, from.Format(time.DateOnly))
+	}
+
+	if !to.IsZero() {
+		conditions = append(conditions, column+" < ? + INTERVAL 1 DAY")
+		args = append(args, to.Format(time.DateOnly))
+	}
+
+	return conditions, args
+}
+
+// WithTx implements interfaces.IProductRecRepository.
+func (pr *ProductRecRepository) WithTx(tx *sql.Tx) interfaces.IProductRecRepository {
+	return &ProductRecRepository{DB: tx, log: pr.log}
+}
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"testing"
@@ -35,7 +36,7 @@ func TestProductRecRepository_Create(t *testing.T) {
 		mock.ExpectExec("INSERT INTO product_records (last_update_date, purchase_price, sale_price, product_id) VALUES (?, ?, ?, ?)").WithArgs(&productRec.LastUpdateDate, &productRec.PurchasePrice, &productRec.SalePrice, &productRec.ProductID).
 			WillReturnResult(sqlmock.NewResult(1, 1))
 
-		res, err := repo.Create(productRec)
+		res, err := repo.Create(context.Background(), productRec)
 
 		assert.Equal(t, productRec, res)
 
@@ -46,7 +47,7 @@ func TestProductRecRepository_Create(t *testing.T) {
 
 		mock.ExpectExec("INSERT INTO product_records (last_update_date, purchase_price, sale_price, product_id) VALUES (?, ?, ?, ?)").WithArgs(productRec.LastUpdateDate, productRec.PurchasePrice, productRec.SalePrice, productRec.ProductID).WillReturnError(expectedError)
 
-		_, err := repo.Create(productRec)
+		_, err := repo.Create(context.Background(), productRec)
 
 		assert.EqualError(t, expectedError, err.Error())
 
@@ -56,7 +57,7 @@ func TestProductRecRepository_Create(t *testing.T) {
 
 		mock.ExpectExec("INSERT INTO product_records (last_update_date, purchase_price, sale_price, product_id) VALUES (?, ?, ?, ?)").WithArgs(productRec.LastUpdateDate, productRec.PurchasePrice, productRec.SalePrice, productRec.ProductID).WillReturnResult(sqlmock.NewErrorResult(expectedError))
 
-		_, err := repo.Create(productRec)
+		_, err := repo.Create(context.Background(), productRec)
 
 		assert.EqualError(t, expectedError, err.Error())
 
@@ -99,7 +100,7 @@ func TestProductRecRepository_GetByID(t *testing.T) {
 
 		mock.ExpectQuery(query).WithArgs(productRec.ID).WillReturnRows(rows)
 
-		res, err := repo.GetByID(productRec.ID)
+		res, err := repo.GetByID(context.Background(), productRec.ID)
 
 		assert.NoError(t, err)
 
@@ -112,7 +113,7 @@ func TestProductRecRepository_GetByID(t *testing.T) {
 
 		mock.ExpectQuery(query).WithArgs(productRec.ID).WillReturnError(sql.ErrNoRows)
 
-		_, err := repo.GetByID(productRec.ID)
+		_, err := repo.GetByID(context.Background(), productRec.ID)
 
 		assert.EqualError(t, expectedErr, err.Error())
 
@@ -123,13 +124,69 @@ func TestProductRecRepository_GetByID(t *testing.T) {
 
 		mock.ExpectQuery(query).WithArgs(productRec.ID).WillReturnError(expectedErr)
 
-		_, err := repo.GetByID(productRec.ID)
+		_, err := repo.GetByID(context.Background(), productRec.ID)
 
 		assert.EqualError(t, expectedErr, err.Error())
 
 	})
 
 }
+func TestProductRecRepository_GetLatestByIDProduct(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	defer db.Close()
+
+	productRec := model.ProductRecords{
+		ID:             3,
+		LastUpdateDate: time.Time{},
+		PurchasePrice:  100.50,
+		SalePrice:      150.75,
+		ProductID:      101,
+	}
+
+	repo := NewProductRecRepository(db, logMock)
+
+	query := `
+	SELECT
+	id,
+	last_update_date, 
+	product_id, 
+	purchase_price, 
+	sale_price
+	FROM product_records
+	WHERE product_id = ?
+	ORDER BY last_update_date DESC, id DESC
+	LIMIT 1
+	`
+
+	t.Run("Getting the latest product record successfully", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"id", "last_update_date", "product_id", "purchase_price", "sale_price"}).
+			AddRow(productRec.ID, productRec.LastUpdateDate, productRec.ProductID, productRec.PurchasePrice, productRec.SalePrice)
+
+		mock.ExpectQuery(query).WithArgs(productRec.ProductID).WillReturnRows(rows)
+
+		res, err := repo.GetLatestByIDProduct(context.Background(), productRec.ProductID)
+
+		assert.NoError(t, err)
+
+		assert.Equal(t, productRec, res)
+	})
+
+	t.Run("Error product without records", func(t *testing.T) {
+		expectedErr := appErr.HandleError("product record", appErr.ErrorNotFound, "")
+
+		mock.ExpectQuery(query).WithArgs(productRec.ProductID).WillReturnError(sql.ErrNoRows)
+
+		_, err := repo.GetLatestByIDProduct(context.Background(), productRec.ProductID)
+
+		assert.EqualError(t, expectedErr, err.Error())
+	})
+}
+
 func TestProductRecRepository_GetByIDProduct(t *testing.T) {
 	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
 
@@ -170,7 +227,7 @@ func TestProductRecRepository_GetByIDProduct(t *testing.T) {
 
 		mock.ExpectQuery(query).WithArgs(productRec.ProductID).WillReturnRows(rows)
 
-		res, err := repo.GetByIDProduct(productRec.ProductID)
+		res, err := repo.GetByIDProduct(context.Background(), productRec.ProductID)
 
 		assert.NoError(t, err)
 
@@ -183,7 +240,7 @@ func TestProductRecRepository_GetByIDProduct(t *testing.T) {
 
 		mock.ExpectQuery(query).WithArgs(productRec.ID).WillReturnError(expectedErr)
 
-		_, err := repo.GetByIDProduct(productRec.ID)
+		_, err := repo.GetByIDProduct(context.Background(), productRec.ID)
 
 		assert.EqualError(t, expectedErr, err.Error())
 
@@ -196,7 +253,7 @@ func TestProductRecRepository_GetByIDProduct(t *testing.T) {
 
 		mock.ExpectQuery(query).WithArgs(productRec.ID).WillReturnRows(rows)
 
-		_, err := repo.GetByIDProduct(productRec.ID)
+		_, err := repo.GetByIDProduct(context.Background(), productRec.ID)
 
 		assert.EqualError(t, expectedErr, err.Error())
 
@@ -209,7 +266,7 @@ func TestProductRecRepository_GetByIDProduct(t *testing.T) {
 
 		mock.ExpectQuery(query).WithArgs(productRec.ID).WillReturnRows(rows)
 
-		_, err := repo.GetByIDProduct(productRec.ID)
+		_, err := repo.GetByIDProduct(context.Background(), productRec.ID)
 
 		assert.EqualError(t, expectedErr, err.Error())
 
@@ -240,7 +297,7 @@ func TestProductRecRepository_GetAll(t *testing.T) {
 	product_id, 
 	purchase_price, 
 	sale_price
-	FROM product_records`
+	FROM product_records ORDER BY id`
 
 	repo := NewProductRecRepository(db, logMock)
 
@@ -254,11 +311,12 @@ func TestProductRecRepository_GetAll(t *testing.T) {
 
 		mock.ExpectQuery(query).WillReturnRows(rows)
 
-		res, err := repo.GetAll()
+		res, total, err := repo.GetAll(context.Background(), model.ListParams{})
 
 		assert.NoError(t, err)
 
 		assert.Equal(t, expected, res)
+		assert.Equal(t, 1, total)
 
 	})
 
@@ -267,7 +325,7 @@ func TestProductRecRepository_GetAll(t *testing.T) {
 
 		mock.ExpectQuery(query).WillReturnError(expectedErr)
 
-		_, err := repo.GetAll()
+		_, _, err := repo.GetAll(context.Background(), model.ListParams{})
 
 		assert.EqualError(t, expectedErr, err.Error())
 
@@ -279,7 +337,7 @@ func TestProductRecRepository_GetAll(t *testing.T) {
 
 		mock.ExpectQuery(query).WillReturnRows(rows)
 
-		_, err := repo.GetAll()
+		_, _, err := repo.GetAll(context.Background(), model.ListParams{})
 
 		assert.EqualError(t, expectedErr, err.Error())
 
@@ -292,13 +350,121 @@ func TestProductRecRepository_GetAll(t *testing.T) {
 
 		mock.ExpectQuery(query).WillReturnRows(rows)
 
-		_, err := repo.GetAll()
+		_, _, err := repo.GetAll(context.Background(), model.ListParams{})
 
 		assert.EqualError(t, expectedErr, err.Error())
 
 	})
 }
 
+func TestProductRecRepository_GetAllFiltered(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	defer db.Close()
+
+	repo := NewProductRecRepository(db, logMock)
+
+	productRec := model.ProductRecords{ID: 2, LastUpdateDate: time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC), PurchasePrice: 10, SalePrice: 20, ProductID: 1}
+
+	t.Run("Getting a page of product records filtered by product and date range", func(t *testing.T) {
+		params := model.ListParams{Page: 1, PageSize: 1, Sort: "last_update_date", Desc: true, Filters: map[string]string{
+			"product_id":            "1",
+			"last_update_date_from": "2025-01-01",
+			"last_update_date_to":   "2025-01-31",
+		}}
+		where := " WHERE last_update_date >= ? AND last_update_date < ? + INTERVAL 1 DAY AND product_id = ?"
+
+		mock.ExpectQuery(`
+	SELECT
+	id,
+	last_update_date, 
+	product_id, 
+	purchase_price, 
+	sale_price
+	FROM product_records`+where+" ORDER BY last_update_date DESC, id LIMIT ? OFFSET ?").
+			WithArgs("2025-01-01", "2025-01-31", "1", 1, 0).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "last_update_date", "product_id", "purchase_price", "sale_price"}).
+				AddRow(productRec.ID, productRec.LastUpdateDate, productRec.ProductID, productRec.PurchasePrice, productRec.SalePrice))
+		mock.ExpectQuery("SELECT COUNT(*) FROM product_records"+where).
+			WithArgs("2025-01-01", "2025-01-31", "1").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+
+		res, total, err := repo.GetAll(context.Background(), params)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []model.ProductRecords{productRec}, res)
+		assert.Equal(t, 3, total)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestProductRecRepository_CountPurchaseOrders(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	defer db.Close()
+
+	repo := NewProductRecRepository(db, logMock)
+
+	query := `
+	SELECT
+	(SELECT COUNT(*) FROM purchase_orders WHERE product_record_id = ?) +
+	(SELECT COUNT(*) FROM purchase_order_lines WHERE product_record_id = ?)
+	`
+
+	t.Run("Counting the purchase orders priced with the record", func(t *testing.T) {
+		mock.ExpectQuery(query).WithArgs(1, 1).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+
+		count, err := repo.CountPurchaseOrders(context.Background(), 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, count)
+	})
+
+	t.Run("Error executing query", func(t *testing.T) {
+		mock.ExpectQuery(query).WithArgs(1, 1).WillReturnError(sql.ErrConnDone)
+
+		_, err := repo.CountPurchaseOrders(context.Background(), 1)
+
+		assert.ErrorIs(t, err, sql.ErrConnDone)
+	})
+}
+
+func TestProductRecRepository_Delete(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	defer db.Close()
+
+	repo := NewProductRecRepository(db, logMock)
+
+	t.Run("Deleting a product record successfully", func(t *testing.T) {
+		mock.ExpectExec("DELETE FROM product_records WHERE id = ?").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repo.Delete(context.Background(), 1)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Error executing delete", func(t *testing.T) {
+		mock.ExpectExec("DELETE FROM product_records WHERE id = ?").WithArgs(1).WillReturnError(sql.ErrConnDone)
+
+		err := repo.Delete(context.Background(), 1)
+
+		assert.ErrorIs(t, err, sql.ErrConnDone)
+	})
+}
+
 func TestProductRecRepository_GetAllReport(t *testing.T) {
 	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
 
@@ -335,7 +501,7 @@ func TestProductRecRepository_GetAllReport(t *testing.T) {
 
 		mock.ExpectQuery(query).WillReturnRows(rows)
 
-		res, err := repo.GetAllReport()
+		res, err := repo.GetAllReport(context.Background(), 0)
 
 		assert.NoError(t, err)
 
@@ -348,7 +514,7 @@ func TestProductRecRepository_GetAllReport(t *testing.T) {
 
 		mock.ExpectQuery(query).WillReturnError(expectedErr)
 
-		_, err := repo.GetAllReport()
+		_, err := repo.GetAllReport(context.Background(), 0)
 
 		assert.EqualError(t, expectedErr, err.Error())
 
@@ -360,7 +526,7 @@ func TestProductRecRepository_GetAllReport(t *testing.T) {
 
 		mock.ExpectQuery(query).WillReturnRows(rows)
 
-		_, err := repo.GetAllReport()
+		_, err := repo.GetAllReport(context.Background(), 0)
 
 		assert.EqualError(t, expectedErr, err.Error())
 
@@ -374,9 +540,188 @@ func TestProductRecRepository_GetAllReport(t *testing.T) {
 
 		mock.ExpectQuery(query).WillReturnRows(rows)
 
-		_, err := repo.GetAllReport()
+		_, err := repo.GetAllReport(context.Background(), 0)
 
 		assert.EqualError(t, expectedErr, err.Error())
 
 	})
+
+	t.Run("Filtering the report by product in the query", func(t *testing.T) {
+		filtered := `
+	SELECT  
+	p.id, 
+	p.description, 
+	count(p.id) as record_count 
+	FROM products p
+	inner join product_records pr on pr.product_id = p.id
+	WHERE p.id = ?
+	GROUP by p.id, p.description`
+
+		rows := sqlmock.NewRows([]string{"id", "description", "record_count"}).
+			AddRow(productReport.ProductID, productReport.Description, productReport.RecordsCount)
+
+		mock.ExpectQuery(filtered).WithArgs(productReport.ProductID).WillReturnRows(rows)
+
+		res, err := repo.GetAllReport(context.Background(), productReport.ProductID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []model.ProductRecordsReport{productReport}, res)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestProductRecRepository_GetPriceHistory(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	defer db.Close()
+
+	repo := NewProductRecRepository(db, logMock)
+
+	productRec := model.ProductRecords{
+		ID:             1,
+		LastUpdateDate: time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC),
+		ProductID:      1,
+		PurchasePrice:  10,
+		SalePrice:      20,
+	}
+
+	t.Run("Getting the price history within a period", func(t *testing.T) {
+		query := `
+	SELECT
+	id,
+	last_update_date, 
+	product_id, 
+	purchase_price, 
+	sale_price
+	FROM product_records
+	WHERE product_id = ? AND last_update_date >= ? AND last_update_date < ? + INTERVAL 1 DAY
+	ORDER BY last_update_date, id
+	`
+		rows := sqlmock.NewRows([]string{"id", "last_update_date", "product_id", "purchase_price", "sale_price"}).
+			AddRow(productRec.ID, productRec.LastUpdateDate, productRec.ProductID, productRec.PurchasePrice, productRec.SalePrice)
+
+		mock.ExpectQuery(query).WithArgs(1, "2025-01-01", "2025-01-31").WillReturnRows(rows)
+
+		res, err := repo.GetPriceHistory(context.Background(), 1, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC))
+
+		assert.NoError(t, err)
+		assert.Equal(t, []model.ProductRecords{productRec}, res)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Error executing query", func(t *testing.T) {
+		query := `
+	SELECT
+	id,
+	last_update_date, 
+	product_id, 
+	purchase_price, 
+	sale_price
+	FROM product_records
+	WHERE product_id = ?
+	ORDER BY last_update_date, id
+	`
+		mock.ExpectQuery(query).WithArgs(1).WillReturnError(sql.ErrConnDone)
+
+		res, err := repo.GetPriceHistory(context.Background(), 1, time.Time{}, time.Time{})
+
+		assert.ErrorIs(t, err, sql.ErrConnDone)
+		assert.Nil(t, res)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestProductRecRepository_GetMargins(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	defer db.Close()
+
+	repo := NewProductRecRepository(db, logMock)
+	productQuery := `
+	SELECT
+	m.product_id,
+	m.description,
+	m.seller_id,
+	COUNT(*) AS records_count,
+	AVG(m.margin) AS average_margin,
+	MIN(m.margin) AS min_margin,
+	MAX(m.margin) AS max_margin,
+	CASE WHEN MAX(m.first_sale_price) = 0 THEN 0
+	ELSE (MAX(m.last_sale_price) - MAX(m.first_sale_price)) / MAX(m.first_sale_price) * 100 END AS price_change_percentage
+	FROM (
+	SELECT
+	p.id AS product_id,
+	p.description,
+	p.seller_id,
+	CASE WHEN pr.sale_price = 0 THEN 0 ELSE (pr.sale_price - pr.purchase_price) / pr.sale_price * 100 END AS margin,
+	FIRST_VALUE(pr.sale_price) OVER (PARTITION BY p.id ORDER BY pr.last_update_date, pr.id) AS first_sale_price,
+	FIRST_VALUE(pr.sale_price) OVER (PARTITION BY p.id ORDER BY pr.last_update_date DESC, pr.id DESC) AS last_sale_price
+	FROM products p
+	INNER JOIN product_records pr ON pr.product_id = p.id
+	WHERE p.seller_id = ? AND pr.last_update_date >= ?
+	) m
+	GROUP BY m.product_id, m.description, m.seller_id`
+	filter := model.ProductMarginFilter{SellerID: 2, From: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	t.Run("Getting the margins of the products of a seller within a period", func(t *testing.T) {
+		expected := model.ProductMarginReport{ProductID: 1, Description: "Product A", SellerID: 2, RecordsCount: 2, AverageMargin: 45, MinMargin: 40, MaxMargin: 50, PriceChangePercentage: 25}
+
+		mock.ExpectQuery(productQuery+`
+	ORDER BY m.product_id
+	`).WithArgs(2, "2025-01-01").
+			WillReturnRows(sqlmock.NewRows([]string{"product_id", "description", "seller_id", "records_count", "average_margin", "min_margin", "max_margin", "price_change_percentage"}).
+				AddRow(expected.ProductID, expected.Description, expected.SellerID, expected.RecordsCount, expected.AverageMargin, expected.MinMargin, expected.MaxMargin, expected.PriceChangePercentage))
+
+		res, err := repo.GetProductMargins(context.Background(), filter)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []model.ProductMarginReport{expected}, res)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Getting the margins of a seller within a period", func(t *testing.T) {
+		expected := model.SellerMarginReport{SellerID: 2, ProductsCount: 2, RecordsCount: 3, AverageMargin: 38.333333, MinMargin: 25, MaxMargin: 50, PriceChangePercentage: 12.5}
+
+		mock.ExpectQuery(`
+	SELECT
+	s.seller_id,
+	COUNT(*),
+	SUM(s.records_count),
+	SUM(s.average_margin * s.records_count) / SUM(s.records_count),
+	MIN(s.min_margin),
+	MAX(s.max_margin),
+	AVG(s.price_change_percentage)
+	FROM (`+productQuery+`) s
+	GROUP BY s.seller_id
+	ORDER BY s.seller_id
+	`).WithArgs(2, "2025-01-01").
+			WillReturnRows(sqlmock.NewRows([]string{"seller_id", "products_count", "records_count", "average_margin", "min_margin", "max_margin", "price_change_percentage"}).
+				AddRow(expected.SellerID, expected.ProductsCount, expected.RecordsCount, expected.AverageMargin, expected.MinMargin, expected.MaxMargin, expected.PriceChangePercentage))
+
+		res, err := repo.GetSellerMargins(context.Background(), filter)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []model.SellerMarginReport{expected}, res)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Error executing query", func(t *testing.T) {
+		mock.ExpectQuery(productQuery+`
+	ORDER BY m.product_id
+	`).WithArgs(2, "2025-01-01").WillReturnError(sql.ErrConnDone)
+
+		res, err := repo.GetProductMargins(context.Background(), filter)
+
+		assert.ErrorIs(t, err, sql.ErrConnDone)
+		assert.Nil(t, res)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
 }
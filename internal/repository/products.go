@@ -1,17 +1,19 @@
 package repository
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 
 	"github.com/maxwelbm/alkemy-g7.git/pkg/logger"
 
 	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+	"github.com/maxwelbm/alkemy-g7.git/internal/repository/interfaces"
 	appErr "github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
 )
 
 type ProductRepository struct {
-	DB  *sql.DB
+	DB  DBTX
 	log logger.Logger
 }
 
@@ -19,18 +21,19 @@ func NewProductRepository(db *sql.DB, log logger.Logger) *ProductRepository {
 	return &ProductRepository{DB: db, log: log}
 }
 
-func (pr *ProductRepository) GetAll() (map[int]model.Product, error) {
+func (pr *ProductRepository) GetAll(ctx context.Context, params model.ListParams) ([]model.Product, int, error) {
 	pr.log.Log("ProductRepository", "INFO", "GetAll function initializing")
 
-	query := "SELECT id, product_code, description, width, height, length, net_weight, expiration_rate, recommended_freezing_temperature, freezing_rate, product_type_id, seller_id FROM products"
+	list := newListQuery(params, model.ProductListOptions)
+	query, args := list.selectQuery("SELECT id, product_code, description, width, height, length, net_weight, expiration_rate, recommended_freezing_temperature, freezing_rate, product_type_id, seller_id FROM products")
 
-	var products = make(map[int]model.Product)
+	var products []model.Product
 
-	rows, err := pr.DB.Query(query)
+	rows, err := pr.DB.QueryContext(ctx, query, args...)
 
 	if err != nil {
 		pr.log.Log("ProductRepository", "ERROR", fmt.Sprintf("Error executing query: %v", err))
-		return nil, err
+		return nil, 0, err
 	}
 	defer rows.Close()
 
@@ -43,29 +46,40 @@ func (pr *ProductRepository) GetAll() (map[int]model.Product, error) {
 
 		if err != nil {
 			pr.log.Log("ProductRepository", "ERROR", fmt.Sprintf("Error scanning row: %v", err))
-			return nil, err
+			return nil, 0, err
 		}
 
-		products[product.ID] = product
+		products = append(products, product)
 	}
 
 	if err = rows.Err(); err != nil {
 		pr.log.Log("ProductRepository", "ERROR", fmt.Sprintf("Error during row iteration: %v", err))
-		return nil, err
+		return nil, 0, err
+	}
+
+	total := len(products)
+
+	if params.PageSize > 0 {
+		total, err = countRows(ctx, pr.DB, "SELECT COUNT(*) FROM products", list)
+
+		if err != nil {
+			pr.log.Log("ProductRepository", "ERROR", fmt.Sprintf("Error counting products: %v", err))
+			return nil, 0, err
+		}
 	}
 
 	pr.log.Log("ProductRepository", "INFO", fmt.Sprintf("Retrieved products: %+v", products))
 	pr.log.Log("ProductRepository", "INFO", "GetAll function completed")
 
-	return products, nil
+	return products, total, nil
 }
 
-func (pr *ProductRepository) GetByID(id int) (model.Product, error) {
+func (pr *ProductRepository) GetByID(ctx context.Context, id int) (model.Product, error) {
 	pr.log.Log("ProductRepository", "INFO", fmt.Sprintf("GetByID function initializing for ID: %d", id))
 
 	var product model.Product
 
-	row := pr.DB.QueryRow("SELECT id, product_code, description, width, height, length, net_weight, expiration_rate, recommended_freezing_temperature, freezing_rate, product_type_id, seller_id FROM products WHERE id = ?", id)
+	row := pr.DB.QueryRowContext(ctx, "SELECT id, product_code, description, width, height, length, net_weight, expiration_rate, recommended_freezing_temperature, freezing_rate, product_type_id, seller_id FROM products WHERE id = ?", id)
 
 	err := row.Scan(&product.ID, &product.ProductCode, &product.Description, &product.Width, &product.Height, &product.Length, &product.NetWeight, &product.ExpirationRate, &product.RecommendedFreezingTemperature, &product.FreezingRate, &product.ProductTypeID, &product.SellerID)
 
@@ -83,12 +97,12 @@ func (pr *ProductRepository) GetByID(id int) (model.Product, error) {
 	return product, nil
 }
 
-func (pr *ProductRepository) Create(product model.Product) (model.Product, error) {
+func (pr *ProductRepository) Create(ctx context.Context, product model.Product) (model.Product, error) {
 	pr.log.Log("ProductRepository", "INFO", "Create function initializing")
 
-	result, err := pr.DB.Exec("INSERT INTO products (product_code, description, width, height, length, net_weight, expiration_rate, recommended_freezing_temperature, freezing_rate, product_type_id, seller_id) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+	result, err := pr.DB.ExecContext(ctx, "INSERT INTO products (product_code, description, width, height, length, net_weight, expiration_rate, recommended_freezing_temperature, freezing_rate, product_type_id, seller_id) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
 		product.ProductCode, product.Description, product.Width, product.Height, product.Length, product.NetWeight, product.ExpirationRate, product.RecommendedFreezingTemperature, product.FreezingRate, product.ProductTypeID, product.SellerID)
-	
+
 	if err != nil {
 		pr.log.Log("ProductRepository", "ERROR", fmt.Sprintf("Error inserting product: %v", err))
 		return product, err
@@ -106,12 +120,12 @@ func (pr *ProductRepository) Create(product model.Product) (model.Product, error
 	return product, nil
 }
 
-func (pr *ProductRepository) Update(id int, product model.Product) (model.Product, error) {
+func (pr *ProductRepository) Update(ctx context.Context, id int, product model.Product) (model.Product, error) {
 	pr.log.Log("ProductRepository", "INFO", fmt.Sprintf("Update function initializing for ID: %d", id))
 
-	_, err := pr.DB.Exec("UPDATE products SET product_code = ?, description = ?, width = ?, height = ?, length = ?, net_weight = ?, expiration_rate = ?, recommended_freezing_temperature = ?, freezing_rate = ?, product_type_id = ?, seller_id = ? WHERE id = ?",
+	_, err := pr.DB.ExecContext(ctx, "UPDATE products SET product_code = ?, description = ?, width = ?, height = ?, length = ?, net_weight = ?, expiration_rate = ?, recommended_freezing_temperature = ?, freezing_rate = ?, product_type_id = ?, seller_id = ? WHERE id = ?",
 		product.ProductCode, product.Description, product.Width, product.Height, product.Length, product.NetWeight, product.ExpirationRate, product.RecommendedFreezingTemperature, product.FreezingRate, product.ProductTypeID, product.SellerID, id)
-	
+
 	if err != nil {
 		pr.log.Log("ProductRepository", "ERROR", fmt.Sprintf("Error updating product with ID %d: %v", id, err))
 		return product, err
@@ -123,10 +137,10 @@ func (pr *ProductRepository) Update(id int, product model.Product) (model.Produc
 	return product, nil
 }
 
-func (pr *ProductRepository) Delete(id int) error {
+func (pr *ProductRepository) Delete(ctx context.Context, id int) error {
 	pr.log.Log("ProductRepository", "INFO", fmt.Sprintf("Delete function initializing for ID: %d", id))
 
-	_, err := pr.DB.Exec("DELETE FROM products WHERE id = ?", id)
+	_, err := pr.DB.ExecContext(ctx, "DELETE FROM products WHERE id = ?", id)
 	if err != nil {
 		pr.log.Log("ProductRepository", "ERROR", fmt.Sprintf("Error deleting product with ID %d: %v", id, err))
 		return appErr.HandleError("product", appErr.ErrorDep, "product record")
@@ -135,3 +149,8 @@ func (pr *ProductRepository) Delete(id int) error {
 	pr.log.Log("ProductRepository", "INFO", fmt.Sprintf("Product with ID %d deleted successfully", id))
 	return nil
 }
+
+// WithTx implements interfaces.IProductsRepo.
+func (pr *ProductRepository) WithTx(tx *sql.Tx) interfaces.IProductsRepo {
+	return &ProductRepository{DB: tx, log: pr.log}
+}
@@ -0,0 +1,263 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+	"github.com/maxwelbm/alkemy-g7.git/internal/repository/interfaces"
+	"github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
+	"github.com/maxwelbm/alkemy-g7.git/pkg/logger"
+)
+
+type ProvinceRepository struct {
+	db  DBTX
+	log logger.Logger
+}
+
+func NewProvinceRepository(db *sql.DB, log logger.Logger) *ProvinceRepository {
+	return &ProvinceRepository{db: db, log: log}
+}
+
+func (r *ProvinceRepository) Get(ctx context.Context, params model.ListParams) (provinces []model.Province, total int, err error) {
+	r.log.Log("ProvinceRepository", "INFO", "initializing Get function")
+
+	list := newListQuery(params, model.ProvinceListOptions)
+	query, args := list.selectQuery("SELECT `id`, `province_name`, `id_country_fk` FROM `provinces`")
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		r.log.Log("ProvinceRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+		return
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var province model.Province
+
+		err = rows.Scan(&province.ID, &province.Name, &province.CountryID)
+		if err != nil {
+			r.log.Log("ProvinceRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+			return nil, 0, err
+		}
+
+		provinces = append(provinces, province)
+	}
+
+	if err = rows.Err(); err != nil {
+		r.log.Log("ProvinceRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+		return nil, 0, err
+	}
+
+	total = len(provinces)
+
+	if params.PageSize > 0 {
+		total, err = countRows(ctx, r.db, "SELECT COUNT(*) FROM `provinces`", list)
+		if err != nil {
+			r.log.Log("ProvinceRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+			return nil, 0, err
+		}
+	}
+
+	r.log.Log("ProvinceRepository", "INFO", fmt.Sprintf("returning %d provinces", len(provinces)))
+
+	return
+}
+
+func (r *ProvinceRepository) GetByID(ctx context.Context, id int) (province model.Province, err error) {
+	r.log.Log("ProvinceRepository", "INFO", fmt.Sprintf("initializing GetByID function with id %d", id))
+
+	row := r.db.QueryRowContext(ctx, "SELECT `id`, `province_name`, `id_country_fk` FROM `provinces` WHERE `id` = ?", id)
+
+	err = row.Scan(&province.ID, &province.Name, &province.CountryID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = customerror.HandleError("province", customerror.ErrorNotFound, "")
+		}
+
+		r.log.Log("ProvinceRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	r.log.Log("ProvinceRepository", "INFO", fmt.Sprintf("returning province: %v", province))
+
+	return
+}
+
+// GetByName finds the province by its name and the name of its country.
+func (r *ProvinceRepository) GetByName(ctx context.Context, provinceName, countryName string) (province model.Province, err error) {
+	r.log.Log("ProvinceRepository", "INFO", fmt.Sprintf("initializing GetByName function with province %s and country %s", provinceName, countryName))
+
+	query := "SELECT p.id, p.province_name, p.id_country_fk FROM `provinces` p INNER JOIN `countries` c ON c.id = p.id_country_fk WHERE p.province_name = ? AND c.country_name = ?"
+	row := r.db.QueryRowContext(ctx, query, provinceName, countryName)
+
+	err = row.Scan(&province.ID, &province.Name, &province.CountryID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = customerror.HandleError("province", customerror.ErrorNotFound, "")
+		}
+
+		r.log.Log("ProvinceRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	r.log.Log("ProvinceRepository", "INFO", fmt.Sprintf("returning province: %v", province))
+
+	return
+}
+
+func (r *ProvinceRepository) Post(ctx context.Context, province model.Province) (model.Province, error) {
+	r.log.Log("ProvinceRepository", "INFO", "initializing Post function")
+
+	result, err := r.db.ExecContext(ctx, "INSERT INTO `provinces` (`province_name`, `id_country_fk`) VALUES (?, ?)", province.Name, province.CountryID)
+	if err != nil {
+		err = provinceSQLError(err)
+		r.log.Log("ProvinceRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return model.Province{}, err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		r.log.Log("ProvinceRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+		return model.Province{}, err
+	}
+
+	province.ID = int(id)
+
+	r.log.Log("ProvinceRepository", "INFO", fmt.Sprintf("saved province: %v", province))
+
+	return province, nil
+}
+
+func (r *ProvinceRepository) Update(ctx context.Context, id int, province model.Province) (model.Province, error) {
+	r.log.Log("ProvinceRepository", "INFO", fmt.Sprintf("initializing Update function with id %d", id))
+
+	_, err := r.db.ExecContext(ctx, "UPDATE `provinces` SET `province_name` = ?, `id_country_fk` = ? WHERE `id` = ?", province.Name, province.CountryID, id)
+	if err != nil {
+		err = provinceSQLError(err)
+		r.log.Log("ProvinceRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return model.Province{}, err
+	}
+
+	province.ID = id
+
+	r.log.Log("ProvinceRepository", "INFO", fmt.Sprintf("updated province: %v", province))
+
+	return province, nil
+}
+
+func (r *ProvinceRepository) Delete(ctx context.Context, id int) (err error) {
+	r.log.Log("ProvinceRepository", "INFO", fmt.Sprintf("initializing Delete function with id %d", id))
+
+	_, err = r.db.ExecContext(ctx, "DELETE FROM `provinces` WHERE `id` = ?", id)
+	if err != nil {
+		err = provinceSQLError(err)
+		r.log.Log("ProvinceRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	r.log.Log("ProvinceRepository", "INFO", fmt.Sprintf("province with id %d deleted", id))
+
+	return
+}
+
+// CountLocalities returns how many localities belong to the province.
+func (r *ProvinceRepository) CountLocalities(ctx context.Context, id int) (count int, err error) {
+	r.log.Log("ProvinceRepository", "INFO", fmt.Sprintf("initializing CountLocalities function with id %d", id))
+
+	err = r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM `locality` WHERE `province_id` = ?", id).Scan(&count)
+	if err != nil {
+		r.log.Log("ProvinceRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+		return
+	}
+
+	r.log.Log("ProvinceRepository", "INFO", fmt.Sprintf("province with id %d has %d localities", id, count))
+
+	return
+}
+
+// GetReport rolls the sellers and carriers of every locality up to its province, restricted to a single province when id is set.
+func (r *ProvinceRepository) GetReport(ctx context.Context, id int) (report []model.ProvinceReport, err error) {
+	r.log.Log("ProvinceRepository", "INFO", fmt.Sprintf("initializing GetReport function with id %d", id))
+
+	query := "SELECT p.id, p.province_name, p.id_country_fk, COUNT(l.id) AS localities_count," +
+		" COALESCE(SUM(s.sellers_count), 0) AS sellers_count, COALESCE(SUM(ca.carriers_count), 0) AS carriers_count" +
+		" FROM `provinces` p LEFT JOIN `locality` l ON l.province_id = p.id" +
+		localityCountsJoin
+
+	var args []any
+
+	if id > 0 {
+		query += " WHERE p.id = ?"
+
+		args = append(args, id)
+	}
+
+	query += " GROUP BY p.id, p.province_name, p.id_country_fk ORDER BY p.province_name"
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		r.log.Log("ProvinceRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+		return
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var p model.ProvinceReport
+
+		err = rows.Scan(&p.ID, &p.Name, &p.CountryID, &p.LocalitiesCount, &p.SellersCount, &p.CarriersCount)
+		if err != nil {
+			r.log.Log("ProvinceRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+			return nil, err
+		}
+
+		report = append(report, p)
+	}
+
+	if err = rows.Err(); err != nil {
+		r.log.Log("ProvinceRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+		return nil, err
+	}
+
+	if id > 0 && len(report) == 0 {
+		err = customerror.HandleError("province", customerror.ErrorNotFound, "")
+		r.log.Log("ProvinceRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	r.log.Log("ProvinceRepository", "INFO", fmt.Sprintf("returning report of %d provinces", len(report)))
+
+	return
+}
+
+// WithTx implements interfaces.IProvinceRepo.
+func (r *ProvinceRepository) WithTx(tx *sql.Tx) interfaces.IProvinceRepo {
+	return &ProvinceRepository{db: tx, log: r.log}
+}
+
+func provinceSQLError(err error) error {
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) {
+		switch mysqlErr.Number {
+		case 1062:
+			return customerror.HandleError("province", customerror.ErrorConflict, "")
+		case 1451:
+			return customerror.HandleError("province", customerror.ErrorDep, "")
+		case 1452:
+			return customerror.HandleError("country", customerror.ErrorNotFound, "")
+		}
+	}
+
+	return err
+}
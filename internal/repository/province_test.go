@@ -0,0 +1,273 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+	"github.com/maxwelbm/alkemy-g7.git/internal/repository"
+	"github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
+	"github.com/stretchr/testify/assert"
+)
+
+const provinceReportQuery = "SELECT p.id, p.province_name, p.id_country_fk, COUNT(l.id) AS localities_count," +
+	" COALESCE(SUM(s.sellers_count), 0) AS sellers_count, COALESCE(SUM(ca.carriers_count), 0) AS carriers_count" +
+	" FROM `provinces` p LEFT JOIN `locality` l ON l.province_id = p.id" +
+	" LEFT JOIN (SELECT `locality_id`, COUNT(*) AS `sellers_count` FROM `sellers` GROUP BY `locality_id`) s ON s.locality_id = l.id" +
+	" LEFT JOIN (SELECT `locality_id`, COUNT(*) AS `carriers_count` FROM `carriers` GROUP BY `locality_id`) ca ON ca.locality_id = l.id"
+
+func setupProvinceRepository(t *testing.T) (*repository.ProvinceRepository, sqlmock.Sqlmock) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	t.Cleanup(func() { db.Close() })
+
+	return repository.NewProvinceRepository(db, logMock), mock
+}
+
+func TestProvinceRepository_Get(t *testing.T) {
+	t.Run("should filter the provinces by country", func(t *testing.T) {
+		rp, mock := setupProvinceRepository(t)
+
+		mock.ExpectQuery("SELECT `id`, `province_name`, `id_country_fk` FROM `provinces` WHERE `id_country_fk` = ? ORDER BY `province_name`, `id` LIMIT ? OFFSET ?").
+			WithArgs("1", 10, 0).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "province_name", "id_country_fk"}).
+				AddRow(2, "Buenos Aires", 1).
+				AddRow(1, "Cordoba", 1))
+		mock.ExpectQuery("SELECT COUNT(*) FROM `provinces` WHERE `id_country_fk` = ?").
+			WithArgs("1").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+
+		params := model.ListParams{Page: 1, PageSize: 10, Sort: "province_name", Filters: map[string]string{"country_id": "1"}}
+		provinces, total, err := rp.Get(context.Background(), params)
+
+		expected := []model.Province{
+			{ID: 2, Name: "Buenos Aires", CountryID: 1},
+			{ID: 1, Name: "Cordoba", CountryID: 1},
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, provinces)
+		assert.Equal(t, 2, total)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("should return error when the query fails", func(t *testing.T) {
+		rp, mock := setupProvinceRepository(t)
+
+		mock.ExpectQuery("SELECT `id`, `province_name`, `id_country_fk` FROM `provinces` ORDER BY `id`").
+			WillReturnError(sql.ErrConnDone)
+
+		provinces, total, err := rp.Get(context.Background(), model.ListParams{})
+
+		assert.ErrorIs(t, err, sql.ErrConnDone)
+		assert.Nil(t, provinces)
+		assert.Zero(t, total)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestProvinceRepository_GetByID(t *testing.T) {
+	t.Run("should return the province", func(t *testing.T) {
+		rp, mock := setupProvinceRepository(t)
+
+		mock.ExpectQuery("SELECT `id`, `province_name`, `id_country_fk` FROM `provinces` WHERE `id` = ?").
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "province_name", "id_country_fk"}).AddRow(1, "Cordoba", 1))
+
+		province, err := rp.GetByID(context.Background(), 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, model.Province{ID: 1, Name: "Cordoba", CountryID: 1}, province)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("should return not found when the province does not exist", func(t *testing.T) {
+		rp, mock := setupProvinceRepository(t)
+
+		mock.ExpectQuery("SELECT `id`, `province_name`, `id_country_fk` FROM `provinces` WHERE `id` = ?").
+			WithArgs(99).
+			WillReturnError(sql.ErrNoRows)
+
+		province, err := rp.GetByID(context.Background(), 99)
+
+		assert.Equal(t, customerror.HandleError("province", customerror.ErrorNotFound, ""), err)
+		assert.Empty(t, province)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestProvinceRepository_GetByName(t *testing.T) {
+	query := "SELECT p.id, p.province_name, p.id_country_fk FROM `provinces` p INNER JOIN `countries` c ON c.id = p.id_country_fk WHERE p.province_name = ? AND c.country_name = ?"
+
+	t.Run("should return the province of the country", func(t *testing.T) {
+		rp, mock := setupProvinceRepository(t)
+
+		mock.ExpectQuery(query).
+			WithArgs("Cordoba", "Argentina").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "province_name", "id_country_fk"}).AddRow(1, "Cordoba", 1))
+
+		province, err := rp.GetByName(context.Background(), "Cordoba", "Argentina")
+
+		assert.NoError(t, err)
+		assert.Equal(t, model.Province{ID: 1, Name: "Cordoba", CountryID: 1}, province)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("should return not found when the names do not match", func(t *testing.T) {
+		rp, mock := setupProvinceRepository(t)
+
+		mock.ExpectQuery(query).
+			WithArgs("Cordoba", "Spain").
+			WillReturnError(sql.ErrNoRows)
+
+		province, err := rp.GetByName(context.Background(), "Cordoba", "Spain")
+
+		assert.Equal(t, customerror.HandleError("province", customerror.ErrorNotFound, ""), err)
+		assert.Empty(t, province)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestProvinceRepository_Post(t *testing.T) {
+	t.Run("should return the created province", func(t *testing.T) {
+		rp, mock := setupProvinceRepository(t)
+
+		mock.ExpectExec("INSERT INTO `provinces` (`province_name`, `id_country_fk`) VALUES (?, ?)").
+			WithArgs("Mendoza", 1).
+			WillReturnResult(sqlmock.NewResult(3, 1))
+
+		province, err := rp.Post(context.Background(), model.Province{Name: "Mendoza", CountryID: 1})
+
+		assert.NoError(t, err)
+		assert.Equal(t, model.Province{ID: 3, Name: "Mendoza", CountryID: 1}, province)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("should return conflict when the province already exists in the country", func(t *testing.T) {
+		rp, mock := setupProvinceRepository(t)
+
+		mock.ExpectExec("INSERT INTO `provinces` (`province_name`, `id_country_fk`) VALUES (?, ?)").
+			WithArgs("Mendoza", 1).
+			WillReturnError(&mysql.MySQLError{Number: 1062})
+
+		province, err := rp.Post(context.Background(), model.Province{Name: "Mendoza", CountryID: 1})
+
+		assert.Equal(t, customerror.HandleError("province", customerror.ErrorConflict, ""), err)
+		assert.Empty(t, province)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("should return country not found when the foreign key fails", func(t *testing.T) {
+		rp, mock := setupProvinceRepository(t)
+
+		mock.ExpectExec("INSERT INTO `provinces` (`province_name`, `id_country_fk`) VALUES (?, ?)").
+			WithArgs("Mendoza", 99).
+			WillReturnError(&mysql.MySQLError{Number: 1452})
+
+		province, err := rp.Post(context.Background(), model.Province{Name: "Mendoza", CountryID: 99})
+
+		assert.Equal(t, customerror.HandleError("country", customerror.ErrorNotFound, ""), err)
+		assert.Empty(t, province)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestProvinceRepository_Update(t *testing.T) {
+	rp, mock := setupProvinceRepository(t)
+
+	mock.ExpectExec("UPDATE `provinces` SET `province_name` = ?, `id_country_fk` = ? WHERE `id` = ?").
+		WithArgs("Santa Fe", 1, 2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	province, err := rp.Update(context.Background(), 2, model.Province{Name: "Santa Fe", CountryID: 1})
+
+	assert.NoError(t, err)
+	assert.Equal(t, model.Province{ID: 2, Name: "Santa Fe", CountryID: 1}, province)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestProvinceRepository_Delete(t *testing.T) {
+	t.Run("should delete the province", func(t *testing.T) {
+		rp, mock := setupProvinceRepository(t)
+
+		mock.ExpectExec("DELETE FROM `provinces` WHERE `id` = ?").
+			WithArgs(1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := rp.Delete(context.Background(), 1)
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("should return dependencies error when localities reference the province", func(t *testing.T) {
+		rp, mock := setupProvinceRepository(t)
+
+		mock.ExpectExec("DELETE FROM `provinces` WHERE `id` = ?").
+			WithArgs(1).
+			WillReturnError(&mysql.MySQLError{Number: 1451})
+
+		err := rp.Delete(context.Background(), 1)
+
+		assert.Equal(t, customerror.HandleError("province", customerror.ErrorDep, ""), err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestProvinceRepository_CountLocalities(t *testing.T) {
+	rp, mock := setupProvinceRepository(t)
+
+	mock.ExpectQuery("SELECT COUNT(*) FROM `locality` WHERE `province_id` = ?").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+
+	count, err := rp.CountLocalities(context.Background(), 1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, count)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestProvinceRepository_GetReport(t *testing.T) {
+	columns := []string{"id", "province_name", "id_country_fk", "localities_count", "sellers_count", "carriers_count"}
+
+	t.Run("should return the report of every province", func(t *testing.T) {
+		rp, mock := setupProvinceRepository(t)
+
+		mock.ExpectQuery(provinceReportQuery + " GROUP BY p.id, p.province_name, p.id_country_fk ORDER BY p.province_name").
+			WillReturnRows(sqlmock.NewRows(columns).
+				AddRow(2, "Buenos Aires", 1, 2, 3, 1).
+				AddRow(1, "Cordoba", 1, 1, 1, 0))
+
+		report, err := rp.GetReport(context.Background(), 0)
+
+		expected := []model.ProvinceReport{
+			{ID: 2, Name: "Buenos Aires", CountryID: 1, LocalitiesCount: 2, SellersCount: 3, CarriersCount: 1},
+			{ID: 1, Name: "Cordoba", CountryID: 1, LocalitiesCount: 1, SellersCount: 1},
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, report)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("should return not found when the province does not exist", func(t *testing.T) {
+		rp, mock := setupProvinceRepository(t)
+
+		mock.ExpectQuery(provinceReportQuery + " WHERE p.id = ? GROUP BY p.id, p.province_name, p.id_country_fk ORDER BY p.province_name").
+			WithArgs(99).
+			WillReturnRows(sqlmock.NewRows(columns))
+
+		report, err := rp.GetReport(context.Background(), 99)
+
+		assert.Equal(t, customerror.HandleError("province", customerror.ErrorNotFound, ""), err)
+		assert.Empty(t, report)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
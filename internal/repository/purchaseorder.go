@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"net/http"
@@ -9,28 +10,50 @@ import (
 
 	"github.com/go-sql-driver/mysql"
 	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+	"github.com/maxwelbm/alkemy-g7.git/internal/repository/interfaces"
 	"github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
 )
 
 type PurchaseOrderRepository struct {
-	db  *sql.DB
+	db  DBTX
 	log logger.Logger
 }
 
 // Post implements interfaces.IPurchaseOrdersRepo.
-func (p *PurchaseOrderRepository) Post(newPurchaseOrder model.PurchaseOrder) (id int64, err error) {
+// The quantity of each line is allocated from the product batches, consuming the batches with the
+// earliest due date first (FEFO), and the consumed quantity is released from each batch's section.
+// The order is expected with its lines priced and its totals computed (see model.PurchaseOrder.ComputeTotals).
+// It must run inside a unit of work (see WithTx) so the batch rows stay locked until the order is committed.
+func (p *PurchaseOrderRepository) Post(ctx context.Context, newPurchaseOrder model.PurchaseOrder) (id int64, err error) {
 	p.log.Log("PurchaseOrderRepository", "INFO", fmt.Sprintf("initializing Post function with parameter %v", newPurchaseOrder))
-	prepare, err := p.db.Prepare("INSERT INTO purchase_orders (order_number, order_date, tracking_code, buyer_id, product_record_id) VALUES(?,?,?,?,?)")
+
+	allocations := make([][]model.PurchaseOrderBatch, len(newPurchaseOrder.Lines))
+
+	for i, line := range newPurchaseOrder.Lines {
+		allocations[i], err = p.allocateBatches(ctx, line.ProductRecordID, line.Quantity)
+
+		if err != nil {
+			p.log.Log("PurchaseOrderRepository", "ERROR", fmt.Sprintf("Error:  %v", err))
+			return
+		}
+	}
+
+	prepare, err := p.db.PrepareContext(ctx, "INSERT INTO purchase_orders (order_number, order_date, tracking_code, buyer_id, product_record_id, quantity, total_amount) VALUES(?,?,?,?,?,?,?)")
 
 	if err != nil {
 		p.log.Log("PurchaseOrderRepository", "ERROR", fmt.Sprintf("Error:  %v", err))
 		return
 	}
 
-	result, err := prepare.Exec(&newPurchaseOrder.OrderNumber, &newPurchaseOrder.OrderDate, &newPurchaseOrder.TrackingCode, &newPurchaseOrder.BuyerID, &newPurchaseOrder.ProductRecordID)
+	defer prepare.Close()
+
+	// only orders placed for a single product record keep it on the header
+	productRecordID := sql.NullInt64{Int64: int64(newPurchaseOrder.ProductRecordID), Valid: newPurchaseOrder.ProductRecordID != 0}
+
+	result, err := prepare.ExecContext(ctx, &newPurchaseOrder.OrderNumber, &newPurchaseOrder.OrderDate, &newPurchaseOrder.TrackingCode, &newPurchaseOrder.BuyerID, productRecordID, &newPurchaseOrder.Quantity, &newPurchaseOrder.Total)
 
 	if err != nil {
-		if err.(*mysql.MySQLError).Number == 1062 {
+		if mysqlErr, ok := err.(*mysql.MySQLError); ok && mysqlErr.Number == 1062 {
 			err = customerror.NewPurcahseOrderError(http.StatusConflict, customerror.ErrConflict.Error(), "order_number")
 		}
 
@@ -40,16 +63,96 @@ func (p *PurchaseOrderRepository) Post(newPurchaseOrder model.PurchaseOrder) (id
 	}
 
 	id, err = result.LastInsertId()
+
+	if err != nil {
+		p.log.Log("PurchaseOrderRepository", "ERROR", fmt.Sprintf("Error:  %v", err))
+		return
+	}
+
+	for i, line := range newPurchaseOrder.Lines {
+		_, err = p.db.ExecContext(ctx, "INSERT INTO purchase_order_lines (purchase_order_id, line_number, product_id, product_record_id, quantity, unit_price) VALUES(?,?,?,?,?,?)", id, line.LineNumber, line.ProductID, line.ProductRecordID, line.Quantity, line.UnitPrice)
+
+		if err != nil {
+			p.log.Log("PurchaseOrderRepository", "ERROR", fmt.Sprintf("Error:  %v", err))
+			return 0, err
+		}
+
+		for _, allocation := range allocations[i] {
+			_, err = p.db.ExecContext(ctx, "UPDATE product_batches pb INNER JOIN sections s ON s.id = pb.section_id SET pb.current_quantity = pb.current_quantity - ?, s.current_capacity = s.current_capacity - ? WHERE pb.id = ?", allocation.Quantity, allocation.Quantity, allocation.ProductBatchID)
+
+			if err != nil {
+				p.log.Log("PurchaseOrderRepository", "ERROR", fmt.Sprintf("Error:  %v", err))
+				return 0, err
+			}
+
+			_, err = p.db.ExecContext(ctx, "INSERT INTO purchase_order_batches (purchase_order_id, product_batch_id, quantity) VALUES(?,?,?)", id, allocation.ProductBatchID, allocation.Quantity)
+
+			if err != nil {
+				p.log.Log("PurchaseOrderRepository", "ERROR", fmt.Sprintf("Error:  %v", err))
+				return 0, err
+			}
+		}
+	}
+
 	p.log.Log("PurchaseOrderRepository", "INFO", fmt.Sprintf("returning inserted ID %d", id))
 
 	return
 }
 
-func (p *PurchaseOrderRepository) GetByID(id int) (purchaseOrder model.PurchaseOrder, err error) {
+// allocateBatches locks the batches of the product referenced by the product record and
+// distributes the requested quantity among them ordered by due date. Batches past their due
+// date are not sold, so their stock does not count towards the order.
+func (p *PurchaseOrderRepository) allocateBatches(ctx context.Context, productRecordID int, quantity int) (allocations []model.PurchaseOrderBatch, err error) {
+	rows, err := p.db.QueryContext(ctx, "SELECT pb.id, pb.current_quantity FROM product_batches pb INNER JOIN product_records pr ON pr.product_id = pb.product_id WHERE pr.id = ? AND pb.current_quantity > 0 AND pb.due_date >= UTC_DATE() ORDER BY pb.due_date, pb.id FOR UPDATE", productRecordID)
+
+	if err != nil {
+		return
+	}
+
+	defer rows.Close()
+
+	remaining := quantity
+
+	for rows.Next() && remaining > 0 {
+		var batchID, available int
+
+		if err = rows.Scan(&batchID, &available); err != nil {
+			return nil, err
+		}
+
+		allocated := min(available, remaining)
+		allocations = append(allocations, model.PurchaseOrderBatch{ProductBatchID: batchID, Quantity: allocated})
+		remaining -= allocated
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if remaining > 0 {
+		return nil, customerror.NewPurcahseOrderError(http.StatusConflict, customerror.ErrInsufficientStock.Error(), "product batches")
+	}
+
+	return
+}
+
+func (p *PurchaseOrderRepository) GetByID(ctx context.Context, id int) (purchaseOrder model.PurchaseOrder, err error) {
 	p.log.Log("PurchaseOrderRepository", "INFO", fmt.Sprintf("initializing GetByID function with parameter %v", id))
-	row := p.db.QueryRow("SELECT id, order_number, order_date, tracking_code, buyer_id, product_record_id FROM purchase_orders WHERE id = ?", id)
+	return p.getByID(ctx, "SELECT id, order_number, order_date, tracking_code, buyer_id, COALESCE(product_record_id, 0), quantity, status, total_amount FROM purchase_orders WHERE id = ?", id)
+}
 
-	err = row.Scan(&purchaseOrder.ID, &purchaseOrder.OrderNumber, &purchaseOrder.OrderDate, &purchaseOrder.TrackingCode, &purchaseOrder.BuyerID, &purchaseOrder.ProductRecordID)
+// GetByIDForUpdate implements interfaces.IPurchaseOrdersRepo.
+// The order row stays locked until the transaction ends, so its status cannot change, nor can it be
+// shipped, while the caller acts on it. It must run inside a unit of work (see WithTx).
+func (p *PurchaseOrderRepository) GetByIDForUpdate(ctx context.Context, id int) (purchaseOrder model.PurchaseOrder, err error) {
+	p.log.Log("PurchaseOrderRepository", "INFO", fmt.Sprintf("initializing GetByIDForUpdate function with parameter %v", id))
+	return p.getByID(ctx, "SELECT id, order_number, order_date, tracking_code, buyer_id, COALESCE(product_record_id, 0), quantity, status, total_amount FROM purchase_orders WHERE id = ? FOR UPDATE", id)
+}
+
+func (p *PurchaseOrderRepository) getByID(ctx context.Context, query string, id int) (purchaseOrder model.PurchaseOrder, err error) {
+	row := p.db.QueryRowContext(ctx, query, id)
+
+	err = row.Scan(&purchaseOrder.ID, &purchaseOrder.OrderNumber, &purchaseOrder.OrderDate, &purchaseOrder.TrackingCode, &purchaseOrder.BuyerID, &purchaseOrder.ProductRecordID, &purchaseOrder.Quantity, &purchaseOrder.Status, &purchaseOrder.Total)
 
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -61,11 +164,212 @@ func (p *PurchaseOrderRepository) GetByID(id int) (purchaseOrder model.PurchaseO
 		return
 	}
 
+	purchaseOrder.Lines, err = p.getLines(ctx, id)
+
+	if err != nil {
+		p.log.Log("PurchaseOrderRepository", "ERROR", fmt.Sprintf("Error:  %v", err))
+		return model.PurchaseOrder{}, err
+	}
+
 	p.log.Log("PurchaseOrderRepository", "INFO", fmt.Sprintf("returning PurchaseOrder:  %v", purchaseOrder))
 
 	return
 }
 
+// GetStatusesForUpdate implements interfaces.IPurchaseOrdersRepo.
+// It returns the status of each purchase order found and locks their rows, in id order, until the
+// transaction ends, so the statuses cannot change while the caller acts on them. It must run inside a
+// unit of work (see WithTx).
+func (p *PurchaseOrderRepository) GetStatusesForUpdate(ctx context.Context, ids []int) (statuses map[int]string, err error) {
+	p.log.Log("PurchaseOrderRepository", "INFO", fmt.Sprintf("initializing GetStatusesForUpdate function with parameter %v", ids))
+
+	args := make([]any, len(ids))
+	for i, id := range ids {
+		args[i] = id
+	}
+
+	rows, err := p.db.QueryContext(ctx, "SELECT id, status FROM purchase_orders WHERE id IN ("+placeholders(len(ids))+") ORDER BY id FOR UPDATE", args...)
+	if err != nil {
+		p.log.Log("PurchaseOrderRepository", "ERROR", fmt.Sprintf("Error:  %v", err))
+		return
+	}
+
+	defer rows.Close()
+
+	statuses = make(map[int]string, len(ids))
+
+	for rows.Next() {
+		var (
+			id     int
+			status string
+		)
+
+		if err = rows.Scan(&id, &status); err != nil {
+			p.log.Log("PurchaseOrderRepository", "ERROR", fmt.Sprintf("Error:  %v", err))
+			return nil, err
+		}
+
+		statuses[id] = status
+	}
+
+	if err = rows.Err(); err != nil {
+		p.log.Log("PurchaseOrderRepository", "ERROR", fmt.Sprintf("Error:  %v", err))
+		return nil, err
+	}
+
+	return
+}
+
+// getLines returns the lines of the purchase order in the order they were placed.
+func (p *PurchaseOrderRepository) getLines(ctx context.Context, purchaseOrderID int) (lines []model.PurchaseOrderLine, err error) {
+	rows, err := p.db.QueryContext(ctx, "SELECT id, line_number, product_id, product_record_id, quantity, unit_price FROM purchase_order_lines WHERE purchase_order_id = ? ORDER BY line_number", purchaseOrderID)
+	if err != nil {
+		return
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var line model.PurchaseOrderLine
+
+		if err = rows.Scan(&line.ID, &line.LineNumber, &line.ProductID, &line.ProductRecordID, &line.Quantity, &line.UnitPrice); err != nil {
+			return nil, err
+		}
+
+		line.ComputeSubtotal()
+		lines = append(lines, line)
+	}
+
+	err = rows.Err()
+
+	return
+}
+
+// Get implements interfaces.IPurchaseOrdersRepo.
+// The lines of the purchase orders are not loaded.
+func (p *PurchaseOrderRepository) Get(ctx context.Context, params model.ListParams) (purchaseOrders []model.PurchaseOrder, total int, err error) {
+	p.log.Log("PurchaseOrderRepository", "INFO", "initializing Get function")
+
+	list := newListQuery(params, model.PurchaseOrderListOptions)
+	query, args := list.selectQuery("SELECT `id`, `order_number`, `order_date`, `tracking_code`, `buyer_id`, COALESCE(`product_record_id`, 0), `quantity`, `status`, `total_amount` FROM `purchase_orders`")
+
+	rows, err := p.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		p.log.Log("PurchaseOrderRepository", "ERROR", fmt.Sprintf("Error:  %v", err))
+		return
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var purchaseOrder model.PurchaseOrder
+
+		err = rows.Scan(&purchaseOrder.ID, &purchaseOrder.OrderNumber, &purchaseOrder.OrderDate, &purchaseOrder.TrackingCode, &purchaseOrder.BuyerID, &purchaseOrder.ProductRecordID, &purchaseOrder.Quantity, &purchaseOrder.Status, &purchaseOrder.Total)
+		if err != nil {
+			p.log.Log("PurchaseOrderRepository", "ERROR", fmt.Sprintf("Error:  %v", err))
+			return nil, 0, err
+		}
+
+		purchaseOrders = append(purchaseOrders, purchaseOrder)
+	}
+
+	if err = rows.Err(); err != nil {
+		p.log.Log("PurchaseOrderRepository", "ERROR", fmt.Sprintf("Error:  %v", err))
+		return nil, 0, err
+	}
+
+	total = len(purchaseOrders)
+
+	if params.PageSize > 0 {
+		total, err = countRows(ctx, p.db, "SELECT COUNT(*) FROM `purchase_orders`", list)
+		if err != nil {
+			p.log.Log("PurchaseOrderRepository", "ERROR", fmt.Sprintf("Error:  %v", err))
+			return nil, 0, err
+		}
+	}
+
+	p.log.Log("PurchaseOrderRepository", "INFO", fmt.Sprintf("returning %d purchase orders", len(purchaseOrders)))
+
+	return
+}
+
+// UpdateStatus implements interfaces.IPurchaseOrdersRepo.
+// The row is only changed while it is still in status from, so two concurrent changes cannot both succeed.
+func (p *PurchaseOrderRepository) UpdateStatus(ctx context.Context, id int, from string, to string) (err error) {
+	p.log.Log("PurchaseOrderRepository", "INFO", fmt.Sprintf("initializing UpdateStatus function with parameters %d, %s, %s", id, from, to))
+
+	result, err := p.db.ExecContext(ctx, "UPDATE purchase_orders SET status = ? WHERE id = ? AND status = ?", to, id, from)
+	if err != nil {
+		p.log.Log("PurchaseOrderRepository", "ERROR", fmt.Sprintf("Error:  %v", err))
+		return
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		p.log.Log("PurchaseOrderRepository", "ERROR", fmt.Sprintf("Error:  %v", err))
+		return
+	}
+
+	if affected == 0 {
+		err = customerror.NewPurcahseOrderError(http.StatusConflict, fmt.Sprintf("status is no longer %s", from), "Purchase Order")
+		p.log.Log("PurchaseOrderRepository", "ERROR", fmt.Sprintf("Error:  %v", err))
+	}
+
+	return
+}
+
+// RestoreBatches implements interfaces.IPurchaseOrdersRepo.
+// It gives back to each product batch the quantity allocated to the order by Post and returns the
+// allocations with the section of each batch, so the caller can take the space back in the sections
+// within their maximum capacity. The allocations are kept as the history of the cancelled order.
+func (p *PurchaseOrderRepository) RestoreBatches(ctx context.Context, purchaseOrderID int) (allocations []model.PurchaseOrderBatch, err error) {
+	p.log.Log("PurchaseOrderRepository", "INFO", fmt.Sprintf("initializing RestoreBatches function with parameter %d", purchaseOrderID))
+
+	rows, err := p.db.QueryContext(ctx, "SELECT pob.product_batch_id, pob.quantity, pb.section_id FROM purchase_order_batches pob INNER JOIN product_batches pb ON pb.id = pob.product_batch_id WHERE pob.purchase_order_id = ? ORDER BY pob.id", purchaseOrderID)
+	if err != nil {
+		p.log.Log("PurchaseOrderRepository", "ERROR", fmt.Sprintf("Error:  %v", err))
+		return
+	}
+
+	for rows.Next() {
+		var allocation model.PurchaseOrderBatch
+
+		if err = rows.Scan(&allocation.ProductBatchID, &allocation.Quantity, &allocation.SectionID); err != nil {
+			rows.Close()
+			p.log.Log("PurchaseOrderRepository", "ERROR", fmt.Sprintf("Error:  %v", err))
+
+			return nil, err
+		}
+
+		allocation.PurchaseOrderID = purchaseOrderID
+		allocations = append(allocations, allocation)
+	}
+
+	rows.Close()
+
+	if err = rows.Err(); err != nil {
+		p.log.Log("PurchaseOrderRepository", "ERROR", fmt.Sprintf("Error:  %v", err))
+		return nil, err
+	}
+
+	for _, allocation := range allocations {
+		_, err = p.db.ExecContext(ctx, "UPDATE product_batches SET current_quantity = current_quantity + ? WHERE id = ?", allocation.Quantity, allocation.ProductBatchID)
+		if err != nil {
+			p.log.Log("PurchaseOrderRepository", "ERROR", fmt.Sprintf("Error:  %v", err))
+			return nil, err
+		}
+	}
+
+	p.log.Log("PurchaseOrderRepository", "INFO", fmt.Sprintf("restored %d batch allocations", len(allocations)))
+
+	return
+}
+
 func NewPurchaseOrderRepository(db *sql.DB, log logger.Logger) *PurchaseOrderRepository {
 	return &PurchaseOrderRepository{db: db, log: log}
 }
+
+// WithTx implements interfaces.IPurchaseOrdersRepo.
+func (p *PurchaseOrderRepository) WithTx(tx *sql.Tx) interfaces.IPurchaseOrdersRepo {
+	return &PurchaseOrderRepository{db: tx, log: p.log}
+}
@@ -1,8 +1,10 @@
 package repository_test
 
 import (
+	"context"
 	"database/sql"
 	"errors"
+	"net/http"
 	"testing"
 	"time"
 
@@ -10,6 +12,7 @@ import (
 	"github.com/go-sql-driver/mysql"
 	"github.com/maxwelbm/alkemy-g7.git/internal/model"
 	"github.com/maxwelbm/alkemy-g7.git/internal/repository"
+	"github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -22,6 +25,12 @@ func TestPurchaseOrderRepository_Post(t *testing.T) {
 
 	rp := repository.NewPurchaseOrderRepository(db, logMock)
 
+	querySelectBatches := "SELECT pb.id, pb.current_quantity FROM product_batches pb INNER JOIN product_records pr ON pr.product_id = pb.product_id WHERE pr.id = ? AND pb.current_quantity > 0 AND pb.due_date >= UTC_DATE() ORDER BY pb.due_date, pb.id FOR UPDATE"
+	queryInsertOrder := "INSERT INTO purchase_orders (order_number, order_date, tracking_code, buyer_id, product_record_id, quantity, total_amount) VALUES(?,?,?,?,?,?,?)"
+	queryInsertLine := "INSERT INTO purchase_order_lines (purchase_order_id, line_number, product_id, product_record_id, quantity, unit_price) VALUES(?,?,?,?,?,?)"
+	queryUpdateBatch := "UPDATE product_batches pb INNER JOIN sections s ON s.id = pb.section_id SET pb.current_quantity = pb.current_quantity - ?, s.current_capacity = s.current_capacity - ? WHERE pb.id = ?"
+	queryInsertAllocation := "INSERT INTO purchase_order_batches (purchase_order_id, product_batch_id, quantity) VALUES(?,?,?)"
+
 	t.Run("Verifies successful addition of a purchase Order", func(t *testing.T) {
 		purchaseOrderID := 1
 		createdPurchaseOrder := model.PurchaseOrder{
@@ -30,15 +39,26 @@ func TestPurchaseOrderRepository_Post(t *testing.T) {
 			TrackingCode:    "TC001",
 			BuyerID:         1,
 			ProductRecordID: 1,
+			Quantity:        15,
+			Total:           30,
+			Lines:           []model.PurchaseOrderLine{{LineNumber: 1, ProductID: 1, ProductRecordID: 1, Quantity: 15, UnitPrice: 2}},
 		}
 
-		mock.ExpectPrepare("INSERT INTO purchase_orders (order_number, order_date, tracking_code, buyer_id, product_record_id) VALUES(?,?,?,?,?)").
+		mock.ExpectQuery(querySelectBatches).
+			WithArgs(createdPurchaseOrder.ProductRecordID).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "current_quantity"}).AddRow(3, 10).AddRow(1, 20))
+		mock.ExpectPrepare(queryInsertOrder).
 			ExpectExec().
 			WithArgs(createdPurchaseOrder.OrderNumber, createdPurchaseOrder.OrderDate, createdPurchaseOrder.TrackingCode,
-				createdPurchaseOrder.BuyerID, createdPurchaseOrder.ProductRecordID,
+				createdPurchaseOrder.BuyerID, createdPurchaseOrder.ProductRecordID, createdPurchaseOrder.Quantity, createdPurchaseOrder.Total,
 			).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(queryInsertLine).WithArgs(int64(purchaseOrderID), 1, 1, 1, 15, float64(2)).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(queryUpdateBatch).WithArgs(10, 10, 3).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(queryInsertAllocation).WithArgs(int64(purchaseOrderID), 3, 10).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(queryUpdateBatch).WithArgs(5, 5, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(queryInsertAllocation).WithArgs(int64(purchaseOrderID), 1, 5).WillReturnResult(sqlmock.NewResult(2, 1))
 
-		ID, err := rp.Post(createdPurchaseOrder)
+		ID, err := rp.Post(context.Background(), createdPurchaseOrder)
 		MockErr := mock.ExpectationsWereMet()
 
 		assert.Equal(t, int64(purchaseOrderID), ID)
@@ -47,6 +67,61 @@ func TestPurchaseOrderRepository_Post(t *testing.T) {
 
 	})
 
+	t.Run("return conflict when product batches have insufficient stock", func(t *testing.T) {
+		purchaseOrderID := 0
+		createdPurchaseOrder := model.PurchaseOrder{
+			OrderNumber:     "ON001",
+			OrderDate:       time.Time{},
+			TrackingCode:    "TC001",
+			BuyerID:         1,
+			ProductRecordID: 1,
+			Quantity:        50,
+			Total:           100,
+			Lines:           []model.PurchaseOrderLine{{LineNumber: 1, ProductID: 1, ProductRecordID: 1, Quantity: 50, UnitPrice: 2}},
+		}
+
+		mock.ExpectQuery(querySelectBatches).
+			WithArgs(createdPurchaseOrder.ProductRecordID).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "current_quantity"}).AddRow(3, 10).AddRow(1, 20))
+
+		ID, err := rp.Post(context.Background(), createdPurchaseOrder)
+		MockErr := mock.ExpectationsWereMet()
+
+		expectedErr := customerror.NewPurcahseOrderError(http.StatusConflict, customerror.ErrInsufficientStock.Error(), "product batches")
+
+		assert.Equal(t, int64(purchaseOrderID), ID)
+		assert.Equal(t, expectedErr, err)
+		assert.NoError(t, MockErr)
+
+	})
+
+	t.Run("return conflict when only expired product batches have stock", func(t *testing.T) {
+		createdPurchaseOrder := model.PurchaseOrder{
+			OrderNumber:     "ON001",
+			OrderDate:       time.Time{},
+			TrackingCode:    "TC001",
+			BuyerID:         1,
+			ProductRecordID: 1,
+			Quantity:        5,
+			Total:           10,
+			Lines:           []model.PurchaseOrderLine{{LineNumber: 1, ProductID: 1, ProductRecordID: 1, Quantity: 5, UnitPrice: 2}},
+		}
+
+		// the expired batches are left out by the query, so none is returned
+		mock.ExpectQuery(querySelectBatches).
+			WithArgs(createdPurchaseOrder.ProductRecordID).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "current_quantity"}))
+
+		ID, err := rp.Post(context.Background(), createdPurchaseOrder)
+		MockErr := mock.ExpectationsWereMet()
+
+		expectedErr := customerror.NewPurcahseOrderError(http.StatusConflict, customerror.ErrInsufficientStock.Error(), "product batches")
+
+		assert.Zero(t, ID)
+		assert.Equal(t, expectedErr, err)
+		assert.NoError(t, MockErr)
+	})
+
 	t.Run("return MySQLError type 1062 an create purchase order", func(t *testing.T) {
 		purchaseOrderID := 0
 		createdPurchaseOrder := model.PurchaseOrder{
@@ -55,17 +130,23 @@ func TestPurchaseOrderRepository_Post(t *testing.T) {
 			TrackingCode:    "TC001",
 			BuyerID:         1,
 			ProductRecordID: 1,
+			Quantity:        1,
+			Total:           2,
+			Lines:           []model.PurchaseOrderLine{{LineNumber: 1, ProductID: 1, ProductRecordID: 1, Quantity: 1, UnitPrice: 2}},
 		}
 
-		mock.ExpectPrepare("INSERT INTO purchase_orders (order_number, order_date, tracking_code, buyer_id, product_record_id) VALUES(?,?,?,?,?)").
+		mock.ExpectQuery(querySelectBatches).
+			WithArgs(createdPurchaseOrder.ProductRecordID).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "current_quantity"}).AddRow(1, 20))
+		mock.ExpectPrepare(queryInsertOrder).
 			ExpectExec().
 			WithArgs(createdPurchaseOrder.OrderNumber, createdPurchaseOrder.OrderDate, createdPurchaseOrder.TrackingCode,
-				createdPurchaseOrder.BuyerID, createdPurchaseOrder.ProductRecordID,
+				createdPurchaseOrder.BuyerID, createdPurchaseOrder.ProductRecordID, createdPurchaseOrder.Quantity, createdPurchaseOrder.Total,
 			).WillReturnError(&mysql.MySQLError{
 			Number: 1062,
 		})
 
-		ID, err := rp.Post(createdPurchaseOrder)
+		ID, err := rp.Post(context.Background(), createdPurchaseOrder)
 		MockErr := mock.ExpectationsWereMet()
 
 		assert.Equal(t, int64(purchaseOrderID), ID)
@@ -82,12 +163,18 @@ func TestPurchaseOrderRepository_Post(t *testing.T) {
 			TrackingCode:    "TC001",
 			BuyerID:         1,
 			ProductRecordID: 1,
+			Quantity:        1,
+			Total:           2,
+			Lines:           []model.PurchaseOrderLine{{LineNumber: 1, ProductID: 1, ProductRecordID: 1, Quantity: 1, UnitPrice: 2}},
 		}
 
-		mock.ExpectPrepare("INSERT INTO purchase_orders (order_number, order_date, tracking_code, buyer_id, product_record_id) VALUES(?,?,?,?,?)").
+		mock.ExpectQuery(querySelectBatches).
+			WithArgs(createdPurchaseOrder.ProductRecordID).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "current_quantity"}).AddRow(1, 20))
+		mock.ExpectPrepare(queryInsertOrder).
 			WillReturnError(errors.New("error prepare"))
 
-		ID, err := rp.Post(createdPurchaseOrder)
+		ID, err := rp.Post(context.Background(), createdPurchaseOrder)
 		MockErr := mock.ExpectationsWereMet()
 
 		assert.Equal(t, int64(purchaseOrderID), ID)
@@ -95,6 +182,77 @@ func TestPurchaseOrderRepository_Post(t *testing.T) {
 		assert.NoError(t, MockErr)
 
 	})
+
+	t.Run("return error when updating the product batch fails", func(t *testing.T) {
+		createdPurchaseOrder := model.PurchaseOrder{
+			OrderNumber:     "ON001",
+			OrderDate:       time.Time{},
+			TrackingCode:    "TC001",
+			BuyerID:         1,
+			ProductRecordID: 1,
+			Quantity:        1,
+			Total:           2,
+			Lines:           []model.PurchaseOrderLine{{LineNumber: 1, ProductID: 1, ProductRecordID: 1, Quantity: 1, UnitPrice: 2}},
+		}
+
+		mock.ExpectQuery(querySelectBatches).
+			WithArgs(createdPurchaseOrder.ProductRecordID).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "current_quantity"}).AddRow(1, 20))
+		mock.ExpectPrepare(queryInsertOrder).
+			ExpectExec().
+			WithArgs(createdPurchaseOrder.OrderNumber, createdPurchaseOrder.OrderDate, createdPurchaseOrder.TrackingCode,
+				createdPurchaseOrder.BuyerID, createdPurchaseOrder.ProductRecordID, createdPurchaseOrder.Quantity, createdPurchaseOrder.Total,
+			).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(queryInsertLine).WithArgs(int64(1), 1, 1, 1, 1, float64(2)).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(queryUpdateBatch).WithArgs(1, 1, 1).WillReturnError(errors.New("error update"))
+
+		ID, err := rp.Post(context.Background(), createdPurchaseOrder)
+		MockErr := mock.ExpectationsWereMet()
+
+		assert.Equal(t, int64(0), ID)
+		assert.Error(t, err)
+		assert.NoError(t, MockErr)
+
+	})
+
+	t.Run("allocates each line of a multi-line purchase order", func(t *testing.T) {
+		createdPurchaseOrder := model.PurchaseOrder{
+			OrderNumber:  "ON002",
+			OrderDate:    time.Time{},
+			TrackingCode: "TC002",
+			BuyerID:      1,
+			Quantity:     7,
+			Total:        19.5,
+			Lines: []model.PurchaseOrderLine{
+				{LineNumber: 1, ProductID: 1, ProductRecordID: 4, Quantity: 5, UnitPrice: 2.5},
+				{LineNumber: 2, ProductID: 2, ProductRecordID: 6, Quantity: 2, UnitPrice: 3.5},
+			},
+		}
+
+		mock.ExpectQuery(querySelectBatches).WithArgs(4).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "current_quantity"}).AddRow(3, 10))
+		mock.ExpectQuery(querySelectBatches).WithArgs(6).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "current_quantity"}).AddRow(8, 1).AddRow(9, 5))
+		mock.ExpectPrepare(queryInsertOrder).
+			ExpectExec().
+			WithArgs(createdPurchaseOrder.OrderNumber, createdPurchaseOrder.OrderDate, createdPurchaseOrder.TrackingCode,
+				createdPurchaseOrder.BuyerID, nil, createdPurchaseOrder.Quantity, createdPurchaseOrder.Total,
+			).WillReturnResult(sqlmock.NewResult(2, 1))
+		mock.ExpectExec(queryInsertLine).WithArgs(int64(2), 1, 1, 4, 5, 2.5).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(queryUpdateBatch).WithArgs(5, 5, 3).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(queryInsertAllocation).WithArgs(int64(2), 3, 5).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(queryInsertLine).WithArgs(int64(2), 2, 2, 6, 2, 3.5).WillReturnResult(sqlmock.NewResult(2, 1))
+		mock.ExpectExec(queryUpdateBatch).WithArgs(1, 1, 8).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(queryInsertAllocation).WithArgs(int64(2), 8, 1).WillReturnResult(sqlmock.NewResult(2, 1))
+		mock.ExpectExec(queryUpdateBatch).WithArgs(1, 1, 9).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(queryInsertAllocation).WithArgs(int64(2), 9, 1).WillReturnResult(sqlmock.NewResult(3, 1))
+
+		ID, err := rp.Post(context.Background(), createdPurchaseOrder)
+
+		assert.Equal(t, int64(2), ID)
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
 }
 
 func TestPurchaseOrderRepository_GetByID(t *testing.T) {
@@ -105,6 +263,8 @@ func TestPurchaseOrderRepository_GetByID(t *testing.T) {
 	}
 
 	rp := repository.NewPurchaseOrderRepository(db, logMock)
+	querySelectOrder := "SELECT id, order_number, order_date, tracking_code, buyer_id, COALESCE(product_record_id, 0), quantity, status, total_amount FROM purchase_orders WHERE id = ?"
+	querySelectLines := "SELECT id, line_number, product_id, product_record_id, quantity, unit_price FROM purchase_order_lines WHERE purchase_order_id = ? ORDER BY line_number"
 
 	t.Run("retrieve existing Purchase Order", func(t *testing.T) {
 		purchaseOrderID := 1
@@ -115,16 +275,22 @@ func TestPurchaseOrderRepository_GetByID(t *testing.T) {
 			TrackingCode:    "TC001",
 			BuyerID:         1,
 			ProductRecordID: 1,
+			Quantity:        1,
+			Status:          model.PurchaseOrderStatusPending,
+			Total:           2.5,
+			Lines:           []model.PurchaseOrderLine{{ID: 1, LineNumber: 1, ProductID: 1, ProductRecordID: 1, Quantity: 1, UnitPrice: 2.5, Subtotal: 2.5}},
 		}
 
-		rows := sqlmock.NewRows([]string{"id", "order_number", "order_date", "tracking_code", "buyer_id", "product_record_id"}).
+		rows := sqlmock.NewRows([]string{"id", "order_number", "order_date", "tracking_code", "buyer_id", "product_record_id", "quantity", "status", "total_amount"}).
 			AddRow(ExpectedPurchaseOrder.ID, ExpectedPurchaseOrder.OrderNumber, ExpectedPurchaseOrder.OrderDate, ExpectedPurchaseOrder.TrackingCode,
-				ExpectedPurchaseOrder.BuyerID, ExpectedPurchaseOrder.ProductRecordID)
+				ExpectedPurchaseOrder.BuyerID, ExpectedPurchaseOrder.ProductRecordID, ExpectedPurchaseOrder.Quantity, ExpectedPurchaseOrder.Status, ExpectedPurchaseOrder.Total)
 
-		mock.ExpectQuery("SELECT id, order_number, order_date, tracking_code, buyer_id, product_record_id FROM purchase_orders WHERE id = ?").
+		mock.ExpectQuery(querySelectOrder).
 			WithArgs(purchaseOrderID).WillReturnRows(rows)
+		mock.ExpectQuery(querySelectLines).
+			WithArgs(purchaseOrderID).WillReturnRows(sqlmock.NewRows([]string{"id", "line_number", "product_id", "product_record_id", "quantity", "unit_price"}).AddRow(1, 1, 1, 1, 1, 2.5))
 
-		purchase, err := rp.GetByID(purchaseOrderID)
+		purchase, err := rp.GetByID(context.Background(), purchaseOrderID)
 		mockErr := mock.ExpectationsWereMet()
 
 		assert.NoError(t, mockErr)
@@ -137,10 +303,10 @@ func TestPurchaseOrderRepository_GetByID(t *testing.T) {
 		purchaseOrderID := 1
 		ExpectedPurchaseOrder := model.PurchaseOrder{}
 
-		mock.ExpectQuery("SELECT id, order_number, order_date, tracking_code, buyer_id, product_record_id FROM purchase_orders WHERE id = ?").
+		mock.ExpectQuery(querySelectOrder).
 			WithArgs(purchaseOrderID).WillReturnError(sql.ErrNoRows)
 
-		purchase, err := rp.GetByID(purchaseOrderID)
+		purchase, err := rp.GetByID(context.Background(), purchaseOrderID)
 		mockErr := mock.ExpectationsWereMet()
 
 		assert.NoError(t, mockErr)
@@ -149,3 +315,198 @@ func TestPurchaseOrderRepository_GetByID(t *testing.T) {
 
 	})
 }
+
+func TestPurchaseOrderRepository_GetByIDForUpdate(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	defer db.Close()
+
+	rp := repository.NewPurchaseOrderRepository(db, logMock)
+	querySelectOrder := "SELECT id, order_number, order_date, tracking_code, buyer_id, COALESCE(product_record_id, 0), quantity, status, total_amount FROM purchase_orders WHERE id = ? FOR UPDATE"
+	querySelectLines := "SELECT id, line_number, product_id, product_record_id, quantity, unit_price FROM purchase_order_lines WHERE purchase_order_id = ? ORDER BY line_number"
+
+	t.Run("lock and retrieve an existing Purchase Order", func(t *testing.T) {
+		mock.ExpectQuery(querySelectOrder).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "order_number", "order_date", "tracking_code", "buyer_id", "product_record_id", "quantity", "status", "total_amount"}).
+				AddRow(1, "ON001", time.Time{}, "TC001", 1, 0, 1, model.PurchaseOrderStatusPending, 2.5))
+		mock.ExpectQuery(querySelectLines).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "line_number", "product_id", "product_record_id", "quantity", "unit_price"}).AddRow(1, 1, 1, 1, 1, 2.5))
+
+		purchase, err := rp.GetByIDForUpdate(context.Background(), 1)
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+		assert.NoError(t, err)
+		assert.Equal(t, model.PurchaseOrderStatusPending, purchase.Status)
+		assert.Len(t, purchase.Lines, 1)
+	})
+
+	t.Run("return not found for an unknown Purchase Order", func(t *testing.T) {
+		mock.ExpectQuery(querySelectOrder).
+			WithArgs(99).
+			WillReturnError(sql.ErrNoRows)
+
+		_, err := rp.GetByIDForUpdate(context.Background(), 99)
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+		assert.Equal(t, customerror.NewPurcahseOrderError(http.StatusNotFound, customerror.ErrNotFound.Error(), "Purchase Order"), err)
+	})
+}
+
+func TestPurchaseOrderRepository_Get(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	rp := repository.NewPurchaseOrderRepository(db, logMock)
+	columns := []string{"id", "order_number", "order_date", "tracking_code", "buyer_id", "product_record_id", "quantity", "status", "total_amount"}
+
+	t.Run("list purchase orders filtered by buyer, date range and status", func(t *testing.T) {
+		params := model.ListParams{Page: 2, PageSize: 1, Sort: "order_date", Desc: true, Filters: map[string]string{
+			"buyer_id":        "1",
+			"order_date_from": "2025-01-01",
+			"order_date_to":   "2025-01-31",
+			"status":          model.PurchaseOrderStatusPending,
+		}}
+		expected := model.PurchaseOrder{ID: 2, OrderNumber: "ON002", OrderDate: time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC), TrackingCode: "TC002", BuyerID: 1, ProductRecordID: 1, Quantity: 3, Status: model.PurchaseOrderStatusPending, Total: 7.5}
+		where := " WHERE `buyer_id` = ? AND `order_date` >= ? AND `order_date` < ? + INTERVAL 1 DAY AND `status` = ?"
+
+		mock.ExpectQuery("SELECT `id`, `order_number`, `order_date`, `tracking_code`, `buyer_id`, COALESCE(`product_record_id`, 0), `quantity`, `status`, `total_amount` FROM `purchase_orders`"+where+" ORDER BY `order_date` DESC, `id` LIMIT ? OFFSET ?").
+			WithArgs("1", "2025-01-01", "2025-01-31", model.PurchaseOrderStatusPending, 1, 1).
+			WillReturnRows(sqlmock.NewRows(columns).AddRow(expected.ID, expected.OrderNumber, expected.OrderDate, expected.TrackingCode, expected.BuyerID, expected.ProductRecordID, expected.Quantity, expected.Status, expected.Total))
+		mock.ExpectQuery("SELECT COUNT(*) FROM `purchase_orders`"+where).
+			WithArgs("1", "2025-01-01", "2025-01-31", model.PurchaseOrderStatusPending).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+
+		purchaseOrders, total, err := rp.Get(context.Background(), params)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []model.PurchaseOrder{expected}, purchaseOrders)
+		assert.Equal(t, 2, total)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("return error when the query fails", func(t *testing.T) {
+		mock.ExpectQuery("SELECT `id`, `order_number`, `order_date`, `tracking_code`, `buyer_id`, COALESCE(`product_record_id`, 0), `quantity`, `status`, `total_amount` FROM `purchase_orders` ORDER BY `id`").
+			WillReturnError(sql.ErrConnDone)
+
+		purchaseOrders, total, err := rp.Get(context.Background(), model.ListParams{})
+
+		assert.ErrorIs(t, err, sql.ErrConnDone)
+		assert.Nil(t, purchaseOrders)
+		assert.Zero(t, total)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPurchaseOrderRepository_UpdateStatus(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	rp := repository.NewPurchaseOrderRepository(db, logMock)
+	query := "UPDATE purchase_orders SET status = ? WHERE id = ? AND status = ?"
+
+	t.Run("update the status of a purchase order", func(t *testing.T) {
+		mock.ExpectExec(query).WithArgs(model.PurchaseOrderStatusConfirmed, 1, model.PurchaseOrderStatusPending).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := rp.UpdateStatus(context.Background(), 1, model.PurchaseOrderStatusPending, model.PurchaseOrderStatusConfirmed)
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("return conflict when the status changed concurrently", func(t *testing.T) {
+		mock.ExpectExec(query).WithArgs(model.PurchaseOrderStatusCancelled, 1, model.PurchaseOrderStatusPending).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := rp.UpdateStatus(context.Background(), 1, model.PurchaseOrderStatusPending, model.PurchaseOrderStatusCancelled)
+
+		assert.Equal(t, customerror.NewPurcahseOrderError(http.StatusConflict, "status is no longer pending", "Purchase Order"), err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPurchaseOrderRepository_RestoreBatches(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	rp := repository.NewPurchaseOrderRepository(db, logMock)
+	querySelectAllocations := "SELECT pob.product_batch_id, pob.quantity, pb.section_id FROM purchase_order_batches pob INNER JOIN product_batches pb ON pb.id = pob.product_batch_id WHERE pob.purchase_order_id = ? ORDER BY pob.id"
+	queryRestoreBatch := "UPDATE product_batches SET current_quantity = current_quantity + ? WHERE id = ?"
+
+	t.Run("give the allocated quantity back to each batch and return the allocations", func(t *testing.T) {
+		mock.ExpectQuery(querySelectAllocations).WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"product_batch_id", "quantity", "section_id"}).AddRow(3, 10, 2).AddRow(1, 5, 4))
+		mock.ExpectExec(queryRestoreBatch).WithArgs(10, 3).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(queryRestoreBatch).WithArgs(5, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		allocations, err := rp.RestoreBatches(context.Background(), 1)
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+		assert.Equal(t, []model.PurchaseOrderBatch{
+			{PurchaseOrderID: 1, ProductBatchID: 3, Quantity: 10, SectionID: 2},
+			{PurchaseOrderID: 1, ProductBatchID: 1, Quantity: 5, SectionID: 4},
+		}, allocations)
+	})
+
+	t.Run("return error when a batch cannot be restored", func(t *testing.T) {
+		mock.ExpectQuery(querySelectAllocations).WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"product_batch_id", "quantity", "section_id"}).AddRow(3, 10, 2))
+		mock.ExpectExec(queryRestoreBatch).WithArgs(10, 3).WillReturnError(sql.ErrConnDone)
+
+		allocations, err := rp.RestoreBatches(context.Background(), 1)
+
+		assert.ErrorIs(t, err, sql.ErrConnDone)
+		assert.Nil(t, allocations)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPurchaseOrderRepository_GetStatusesForUpdate(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	defer db.Close()
+
+	rp := repository.NewPurchaseOrderRepository(db, logMock)
+
+	query := "SELECT id, status FROM purchase_orders WHERE id IN (?, ?, ?) ORDER BY id FOR UPDATE"
+
+	t.Run("lock the purchase orders and return the status of those found", func(t *testing.T) {
+		mock.ExpectQuery(query).
+			WithArgs(3, 1, 2).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "status"}).AddRow(1, model.PurchaseOrderStatusPending).AddRow(3, model.PurchaseOrderStatusCancelled))
+
+		statuses, err := rp.GetStatusesForUpdate(context.Background(), []int{3, 1, 2})
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+		assert.NoError(t, err)
+		assert.Equal(t, map[int]string{1: model.PurchaseOrderStatusPending, 3: model.PurchaseOrderStatusCancelled}, statuses)
+	})
+
+	t.Run("return error when the query fails", func(t *testing.T) {
+		mock.ExpectQuery(query).
+			WithArgs(3, 1, 2).
+			WillReturnError(sql.ErrConnDone)
+
+		statuses, err := rp.GetStatusesForUpdate(context.Background(), []int{3, 1, 2})
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+		assert.ErrorIs(t, err, sql.ErrConnDone)
+		assert.Nil(t, statuses)
+	})
+}
@@ -1,18 +1,21 @@
 package repository
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
+	"net/http"
 
 	"github.com/maxwelbm/alkemy-g7.git/pkg/logger"
 
 	"github.com/go-sql-driver/mysql"
 	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+	"github.com/maxwelbm/alkemy-g7.git/internal/repository/interfaces"
 	"github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
 )
 
 type SectionRepository struct {
-	db  *sql.DB
+	db  DBTX
 	log logger.Logger
 }
 
@@ -20,11 +23,12 @@ func CreateRepositorySections(db *sql.DB, log logger.Logger) *SectionRepository
 	return &SectionRepository{db: db, log: log}
 }
 
-func (r *SectionRepository) Get() (sections []model.Section, err error) {
+func (r *SectionRepository) Get(ctx context.Context, params model.ListParams) (sections []model.Section, total int, err error) {
 	r.log.Log("SectionRepository", "INFO", "initializing Get function")
 
-	queryGetAll := "SELECT `id`, `section_number`, `current_temperature`, `minimum_temperature`, `current_capacity`, `minimum_capacity`, `maximum_capacity`, `warehouse_id`, `product_type_id` FROM `sections`"
-	rows, err := r.db.Query(queryGetAll)
+	list := newListQuery(params, model.SectionListOptions)
+	queryGetAll, args := list.selectQuery("SELECT `id`, `section_number`, `current_temperature`, `minimum_temperature`, `current_capacity`, `minimum_capacity`, `maximum_capacity`, `warehouse_id`, `product_type_id` FROM `sections`")
+	rows, err := r.db.QueryContext(ctx, queryGetAll, args...)
 
 	if err != nil {
 		r.log.Log("SectionRepository", "ERROR", fmt.Sprintf("Error: %v", err))
@@ -32,6 +36,8 @@ func (r *SectionRepository) Get() (sections []model.Section, err error) {
 		return
 	}
 
+	defer rows.Close()
+
 	for rows.Next() {
 		var section model.Section
 		err = rows.Scan(&section.ID, &section.SectionNumber, &section.CurrentTemperature, &section.MinimumTemperature, &section.CurrentCapacity, &section.MinimumCapacity, &section.MaximumCapacity, &section.WarehouseID, &section.ProductTypeID)
@@ -52,17 +58,29 @@ func (r *SectionRepository) Get() (sections []model.Section, err error) {
 		return
 	}
 
+	total = len(sections)
+
+	if params.PageSize > 0 {
+		total, err = countRows(ctx, r.db, "SELECT COUNT(*) FROM `sections`", list)
+
+		if err != nil {
+			r.log.Log("SectionRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+
+			return nil, 0, err
+		}
+	}
+
 	r.log.Log("SectionRepository", "INFO", fmt.Sprintf("returning a slice of sections with no error: %v", sections))
 
 	return
 }
 
-func (r *SectionRepository) GetByID(id int) (section model.Section, err error) {
+func (r *SectionRepository) GetByID(ctx context.Context, id int) (section model.Section, err error) {
 	r.log.Log("SectionRepository", "INFO", "initializing GetByID function with id param")
 
 	queryGetByID := "SELECT id, section_number, current_temperature, minimum_temperature, current_capacity, minimum_capacity, maximum_capacity, warehouse_id, product_type_id FROM sections WHERE id = ?"
 
-	row := r.db.QueryRow(queryGetByID, id)
+	row := r.db.QueryRowContext(ctx, queryGetByID, id)
 
 	err = row.Scan(&section.ID, &section.SectionNumber, &section.CurrentTemperature, &section.MinimumTemperature, &section.CurrentCapacity, &section.MinimumCapacity, &section.MaximumCapacity, &section.WarehouseID, &section.ProductTypeID)
 	if err != nil {
@@ -83,12 +101,12 @@ func (r *SectionRepository) GetByID(id int) (section model.Section, err error) {
 	return
 }
 
-func (r *SectionRepository) Post(section *model.Section) (s model.Section, err error) {
+func (r *SectionRepository) Post(ctx context.Context, section *model.Section) (s model.Section, err error) {
 	r.log.Log("SectionRepository", "INFO", "initializing Post function with a section")
 
 	queryPost := "INSERT INTO `sections` (`section_number`, `current_temperature`, `minimum_temperature`, `current_capacity`, `minimum_capacity`, `maximum_capacity`, `warehouse_id`, `product_type_id`) VALUES (?, ?, ?, ?, ?, ?, ?, ?)"
 
-	result, err := r.db.Exec(queryPost, (*section).SectionNumber, (*section).CurrentTemperature, (*section).MinimumTemperature, (*section).CurrentCapacity, (*section).MinimumCapacity, (*section).MaximumCapacity, (*section).WarehouseID, (*section).ProductTypeID)
+	result, err := r.db.ExecContext(ctx, queryPost, (*section).SectionNumber, (*section).CurrentTemperature, (*section).MinimumTemperature, (*section).CurrentCapacity, (*section).MinimumCapacity, (*section).MaximumCapacity, (*section).WarehouseID, (*section).ProductTypeID)
 	if err != nil {
 		if err.(*mysql.MySQLError).Number == 1062 {
 			err = customerror.HandleError("section", customerror.ErrorConflict, "")
@@ -107,18 +125,21 @@ func (r *SectionRepository) Post(section *model.Section) (s model.Section, err e
 
 	(*section).ID = int(id)
 
-	s, _ = r.GetByID(int(id))
+	s, _ = r.GetByID(ctx, int(id))
 
 	r.log.Log("SectionRepository", "INFO", fmt.Sprintf("saving a section to the database: %v", s))
 
 	return
 }
 
-func (r *SectionRepository) Update(id int, section *model.Section) (newSec model.Section, err error) {
+// Update writes every field of the section except its current capacity, which is only moved by the product
+// batches. The maximum capacity is checked against the stored current capacity in the same statement, so a
+// concurrent stock change cannot slip in between.
+func (r *SectionRepository) Update(ctx context.Context, id int, section *model.Section) (newSec model.Section, err error) {
 	r.log.Log("SectionRepository", "INFO", "initializing Update function with id and section parameters")
 
-	queryUpdate := "UPDATE `sections` SET `section_number` = ?, `current_temperature` = ?, `minimum_temperature` = ?, `current_capacity` = ?, `minimum_capacity` = ?, `maximum_capacity` = ?, `warehouse_id` = ?, `product_type_id` = ? WHERE `id` = ?"
-	_, err = r.db.Exec(queryUpdate, (*section).SectionNumber, (*section).CurrentTemperature, (*section).MinimumTemperature, (*section).CurrentCapacity, (*section).MinimumCapacity, (*section).MaximumCapacity, (*section).WarehouseID, (*section).ProductTypeID, id)
+	queryUpdate := "UPDATE `sections` SET `section_number` = ?, `current_temperature` = ?, `minimum_temperature` = ?, `minimum_capacity` = ?, `maximum_capacity` = ?, `warehouse_id` = ?, `product_type_id` = ? WHERE `id` = ? AND ? >= `current_capacity`"
+	result, err := r.db.ExecContext(ctx, queryUpdate, (*section).SectionNumber, (*section).CurrentTemperature, (*section).MinimumTemperature, (*section).MinimumCapacity, (*section).MaximumCapacity, (*section).WarehouseID, (*section).ProductTypeID, id, (*section).MaximumCapacity)
 
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -140,18 +161,48 @@ func (r *SectionRepository) Update(id int, section *model.Section) (newSec model
 		return
 	}
 
-	newSec, _ = r.GetByID(id)
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.log.Log("SectionRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	// no row is affected either when the maximum capacity was refused or when nothing changed
+	if rowsAffected == 0 {
+		var currentCapacity int
+
+		err = r.db.QueryRowContext(ctx, "SELECT `current_capacity` FROM `sections` WHERE `id` = ?", id).Scan(&currentCapacity)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				err = customerror.HandleError("section", customerror.ErrorNotFound, "")
+			}
+
+			r.log.Log("SectionRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+
+			return
+		}
+
+		if (*section).MaximumCapacity < currentCapacity {
+			err = customerror.NewError(http.StatusConflict, customerror.ErrCapacityBelowCurrent.Error(), "section", "")
+			r.log.Log("SectionRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+
+			return
+		}
+	}
+
+	newSec, _ = r.GetByID(ctx, id)
 
 	r.log.Log("SectionRepository", "INFO", fmt.Sprintf("updating a section based on the id and section parameter to database: %v", newSec))
 
 	return
 }
 
-func (r *SectionRepository) Delete(id int) (err error) {
+func (r *SectionRepository) Delete(ctx context.Context, id int) (err error) {
 	r.log.Log("SectionRepository", "INFO", "initializing Delete function with id parameter")
 
 	queryDelete := "DELETE FROM `sections` WHERE `id` = ?"
-	_, err = r.db.Exec(queryDelete, id)
+	_, err = r.db.ExecContext(ctx, queryDelete, id)
 
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -171,37 +222,121 @@ func (r *SectionRepository) Delete(id int) (err error) {
 	return
 }
 
-func (r *SectionRepository) CountProductBatchesBySectionID(id int) (countProdBatches model.SectionProductBatches, err error) {
-	r.log.Log("SectionRepository", "INFO", "initializing CountProductBatchesBySectionID function with id parameter")
+// CountProductBatchesSections counts the product batches per section, restricted to a single section when id is set.
+func (r *SectionRepository) CountProductBatchesSections(ctx context.Context, id int) (countProductBatches []model.SectionProductBatches, err error) {
+	r.log.Log("SectionRepository", "INFO", fmt.Sprintf("initializing CountProductBatchesSections function with id %d", id))
 
-	query := "SELECT s.id, s.section_number, COUNT(pb.section_id) as products_count FROM sections s INNER JOIN product_batches pb ON pb.section_id = s.id WHERE s.id = ? GROUP BY s.id"
+	query := "SELECT s.id, s.section_number, COUNT(pb.section_id) as products_count FROM sections s INNER JOIN product_batches pb ON pb.section_id = s.id"
 
-	row := r.db.QueryRow(query, id)
+	var args []any
+
+	if id > 0 {
+		query += " WHERE s.id = ?"
+
+		args = append(args, id)
+	}
 
-	err = row.Scan(&countProdBatches.ID, &countProdBatches.SectionNumber, &countProdBatches.ProductsCount)
+	query += " GROUP BY s.id"
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
 
 	if err != nil {
-		if err == sql.ErrNoRows {
-			err = customerror.HandleError("section", customerror.ErrorNotFound, "")
+		r.log.Log("SectionRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+		return
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var sectionProductBatches model.SectionProductBatches
+		err = rows.Scan(&sectionProductBatches.ID, &sectionProductBatches.SectionNumber, &sectionProductBatches.ProductsCount)
+
+		if err != nil {
+			r.log.Log("SectionRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+			return
 		}
 
+		countProductBatches = append(countProductBatches, sectionProductBatches)
+	}
+
+	r.log.Log("SectionRepository", "INFO", fmt.Sprintf("returning count of product batches per section: %v", countProductBatches))
+
+	return
+}
+
+// IncreaseCurrentCapacity adds quantity to the section's current capacity, refusing to go over its maximum capacity.
+func (r *SectionRepository) IncreaseCurrentCapacity(ctx context.Context, id int, quantity int) (err error) {
+	r.log.Log("SectionRepository", "INFO", fmt.Sprintf("initializing IncreaseCurrentCapacity function with id %d and quantity %d", id, quantity))
+
+	query := "UPDATE `sections` SET `current_capacity` = `current_capacity` + ? WHERE `id` = ? AND `current_capacity` + ? <= `maximum_capacity`"
+
+	result, err := r.db.ExecContext(ctx, query, quantity, id, quantity)
+	if err != nil {
+		r.log.Log("SectionRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+		return
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.log.Log("SectionRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+		return
+	}
+
+	if rowsAffected == 0 {
+		err = customerror.NewError(http.StatusConflict, customerror.ErrCapacityExceeded.Error(), "section", "")
 		r.log.Log("SectionRepository", "ERROR", fmt.Sprintf("Error: %v", err))
 
 		return
 	}
 
-	r.log.Log("SectionRepository", "INFO", fmt.Sprintf("returning a count of product batches by section id: %v", countProdBatches))
+	r.log.Log("SectionRepository", "INFO", "section current capacity updated")
 
 	return
 }
 
-func (r *SectionRepository) CountProductBatchesSections() (countProductBatches []model.SectionProductBatches, err error) {
-	r.log.Log("SectionRepository", "INFO", "initializing CountProductBatchesSections function")
+// DecreaseCurrentCapacity releases the space of the quantity removed from the section, never going below zero.
+func (r *SectionRepository) DecreaseCurrentCapacity(ctx context.Context, id int, quantity int) (err error) {
+	r.log.Log("SectionRepository", "INFO", fmt.Sprintf("initializing DecreaseCurrentCapacity function with id %d and quantity %d", id, quantity))
 
-	query := "SELECT s.id, s.section_number, COUNT(pb.section_id) as products_count FROM sections s INNER JOIN product_batches pb ON pb.section_id = s.id GROUP BY s.id"
+	query := "UPDATE `sections` SET `current_capacity` = GREATEST(`current_capacity` - ?, 0) WHERE `id` = ?"
+
+	_, err = r.db.ExecContext(ctx, query, quantity, id)
+	if err != nil {
+		r.log.Log("SectionRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+		return
+	}
+
+	r.log.Log("SectionRepository", "INFO", "section current capacity updated")
+
+	return
+}
 
-	rows, err := r.db.Query(query)
+// SyncCurrentTemperature sets the section's current temperature to its most recent reading, so readings
+// ingested out of order never overwrite a newer value.
+func (r *SectionRepository) SyncCurrentTemperature(ctx context.Context, id int) (err error) {
+	r.log.Log("SectionRepository", "INFO", fmt.Sprintf("initializing SyncCurrentTemperature function with id %d", id))
 
+	query := "UPDATE `sections` SET `current_temperature` = " +
+		"(SELECT `temperature` FROM `temperature_readings` WHERE `section_id` = ? ORDER BY `recorded_at` DESC, `id` DESC LIMIT 1) " +
+		"WHERE `id` = ? AND EXISTS (SELECT 1 FROM `temperature_readings` WHERE `section_id` = ?)"
+
+	_, err = r.db.ExecContext(ctx, query, id, id, id)
+	if err != nil {
+		r.log.Log("SectionRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+		return
+	}
+
+	r.log.Log("SectionRepository", "INFO", "section current temperature updated")
+
+	return
+}
+
+func (r *SectionRepository) GetCapacityReport(ctx context.Context, nearFullRatio float64) (report []model.SectionCapacity, err error) {
+	r.log.Log("SectionRepository", "INFO", "initializing GetCapacityReport function")
+
+	query := "SELECT `id`, `section_number`, `warehouse_id`, `current_capacity`, `minimum_capacity`, `maximum_capacity` FROM `sections` WHERE `current_capacity` < `minimum_capacity` OR `current_capacity` >= `maximum_capacity` * ? ORDER BY `id`"
+
+	rows, err := r.db.QueryContext(ctx, query, nearFullRatio)
 	if err != nil {
 		r.log.Log("SectionRepository", "ERROR", fmt.Sprintf("Error: %v", err))
 		return
@@ -210,18 +345,34 @@ func (r *SectionRepository) CountProductBatchesSections() (countProductBatches [
 	defer rows.Close()
 
 	for rows.Next() {
-		var sectionProductBatches model.SectionProductBatches
-		err = rows.Scan(&sectionProductBatches.ID, &sectionProductBatches.SectionNumber, &sectionProductBatches.ProductsCount)
+		var section model.SectionCapacity
 
+		err = rows.Scan(&section.ID, &section.SectionNumber, &section.WarehouseID, &section.CurrentCapacity, &section.MinimumCapacity, &section.MaximumCapacity)
 		if err != nil {
 			r.log.Log("SectionRepository", "ERROR", fmt.Sprintf("Error: %v", err))
-			return
+			return nil, err
 		}
 
-		countProductBatches = append(countProductBatches, sectionProductBatches)
+		section.Status = model.SectionCapacityNearFull
+		if section.CurrentCapacity < section.MinimumCapacity {
+			section.Status = model.SectionCapacityBelowMinimum
+		}
+
+		report = append(report, section)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		r.log.Log("SectionRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+		return nil, err
 	}
 
-	r.log.Log("SectionRepository", "INFO", fmt.Sprintf("returning all count of product batches per section: %v", countProductBatches))
+	r.log.Log("SectionRepository", "INFO", fmt.Sprintf("returning capacity report with %d sections", len(report)))
 
 	return
 }
+
+// WithTx implements interfaces.ISectionRepo.
+func (r *SectionRepository) WithTx(tx *sql.Tx) interfaces.ISectionRepo {
+	return &SectionRepository{db: tx, log: r.log}
+}
@@ -1,8 +1,10 @@
 package repository_test
 
 import (
+	"context"
 	"database/sql"
 	"errors"
+	"net/http"
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
@@ -32,7 +34,7 @@ func TestSectionRepository_Post(t *testing.T) {
 
 		mock.ExpectQuery("SELECT id, section_number, current_temperature, minimum_temperature, current_capacity, minimum_capacity, maximum_capacity, warehouse_id, product_type_id FROM sections WHERE id = ?").WithArgs(expectedSection.ID).WillReturnRows(sqlmock.NewRows([]string{"id", "section_number", "current_temperature", "minimum_temperature", "current_capacity", "minimum_capacity", "maximum_capacity", "warehouse_id", "product_type_id"}).AddRow(expectedSection.ID, expectedSection.SectionNumber, expectedSection.CurrentTemperature, expectedSection.MinimumTemperature, expectedSection.CurrentCapacity, expectedSection.MinimumCapacity, expectedSection.MaximumCapacity, expectedSection.WarehouseID, expectedSection.ProductTypeID))
 
-		section, err := rp.Post(&expectedSection)
+		section, err := rp.Post(context.Background(), &expectedSection)
 
 		errMock := mock.ExpectationsWereMet()
 
@@ -50,7 +52,7 @@ func TestSectionRepository_Post(t *testing.T) {
 			Message:  "Duplicate entry",
 		})
 
-		_, err := rp.Post(&expectedSection)
+		_, err := rp.Post(context.Background(), &expectedSection)
 
 		errMock := mock.ExpectationsWereMet()
 
@@ -77,7 +79,7 @@ func TestSectionRepository_GetByID(t *testing.T) {
 
 		mock.ExpectQuery("SELECT id, section_number, current_temperature, minimum_temperature, current_capacity, minimum_capacity, maximum_capacity, warehouse_id, product_type_id FROM sections WHERE id = ?").WithArgs(sectionID).WillReturnRows(rows)
 
-		result, err := rp.GetByID(sectionID)
+		result, err := rp.GetByID(context.Background(), sectionID)
 		assert.NoError(t, err)
 
 		errMock := mock.ExpectationsWereMet()
@@ -91,7 +93,7 @@ func TestSectionRepository_GetByID(t *testing.T) {
 
 		mock.ExpectQuery("SELECT id, section_number, current_temperature, minimum_temperature, current_capacity, minimum_capacity, maximum_capacity, warehouse_id, product_type_id FROM sections WHERE id = ?").WithArgs(sectionID).WillReturnError(sql.ErrNoRows)
 
-		section, err := rp.GetByID(sectionID)
+		section, err := rp.GetByID(context.Background(), sectionID)
 		errorExpected := customerror.HandleError("section", customerror.ErrorNotFound, "")
 		errMock := mock.ExpectationsWereMet()
 
@@ -122,9 +124,9 @@ func TestSectionRepository_Get(t *testing.T) {
 			rows.AddRow(section.ID, section.SectionNumber, section.CurrentTemperature, section.MinimumTemperature, section.CurrentCapacity, section.MinimumCapacity, section.MaximumCapacity, section.WarehouseID, section.ProductTypeID)
 		}
 
-		mock.ExpectQuery("SELECT `id`, `section_number`, `current_temperature`, `minimum_temperature`, `current_capacity`, `minimum_capacity`, `maximum_capacity`, `warehouse_id`, `product_type_id` FROM `sections`").WillReturnRows(rows)
+		mock.ExpectQuery("SELECT `id`, `section_number`, `current_temperature`, `minimum_temperature`, `current_capacity`, `minimum_capacity`, `maximum_capacity`, `warehouse_id`, `product_type_id` FROM `sections` ORDER BY `id`").WillReturnRows(rows)
 
-		sections, err := rp.Get()
+		sections, _, err := rp.Get(context.Background(), model.ListParams{})
 		errMock := mock.ExpectationsWereMet()
 		assert.NoError(t, err)
 		assert.NoError(t, errMock)
@@ -132,9 +134,9 @@ func TestSectionRepository_Get(t *testing.T) {
 	})
 
 	t.Run("return all the sections", func(t *testing.T) {
-		mock.ExpectQuery("SELECT `id`, `section_number`, `current_temperature`, `minimum_temperature`, `current_capacity`, `minimum_capacity`, `maximum_capacity`, `warehouse_id`, `product_type_id` FROM `sections`").WillReturnError(errors.New("unmapped error"))
+		mock.ExpectQuery("SELECT `id`, `section_number`, `current_temperature`, `minimum_temperature`, `current_capacity`, `minimum_capacity`, `maximum_capacity`, `warehouse_id`, `product_type_id` FROM `sections` ORDER BY `id`").WillReturnError(errors.New("unmapped error"))
 
-		sections, err := rp.Get()
+		sections, _, err := rp.Get(context.Background(), model.ListParams{})
 		errMock := mock.ExpectationsWereMet()
 		assert.Error(t, err)
 		assert.NoError(t, errMock)
@@ -156,23 +158,36 @@ func TestSectionRepository_Update(t *testing.T) {
 		sectionID := 1
 		section := model.Section{ID: 1, SectionNumber: "S01", CurrentTemperature: 10.0, MinimumTemperature: 5.0, CurrentCapacity: 10, MinimumCapacity: 5, MaximumCapacity: 20, WarehouseID: 1, ProductTypeID: 1}
 
-		mock.ExpectExec("UPDATE `sections` SET `section_number` = ?, `current_temperature` = ?, `minimum_temperature` = ?, `current_capacity` = ?, `minimum_capacity` = ?, `maximum_capacity` = ?, `warehouse_id` = ?, `product_type_id` = ? WHERE `id` = ?").WithArgs(section.SectionNumber, section.CurrentTemperature, section.MinimumTemperature, section.CurrentCapacity, section.MinimumCapacity, section.MaximumCapacity, section.WarehouseID, section.ProductTypeID, section.ID).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec("UPDATE `sections` SET `section_number` = ?, `current_temperature` = ?, `minimum_temperature` = ?, `minimum_capacity` = ?, `maximum_capacity` = ?, `warehouse_id` = ?, `product_type_id` = ? WHERE `id` = ? AND ? >= `current_capacity`").WithArgs(section.SectionNumber, section.CurrentTemperature, section.MinimumTemperature, section.MinimumCapacity, section.MaximumCapacity, section.WarehouseID, section.ProductTypeID, section.ID, section.MaximumCapacity).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		_, err := rp.Update(sectionID, &section)
+		_, err := rp.Update(context.Background(), sectionID, &section)
 		mockErr := mock.ExpectationsWereMet()
 
 		assert.NoError(t, err)
 		assert.NoError(t, mockErr)
 	})
 
+	t.Run("given a maximum capacity lower than the stored current capacity then return conflict", func(t *testing.T) {
+		section := model.Section{ID: 1, SectionNumber: "S01", CurrentTemperature: 10.0, MinimumTemperature: 5.0, MinimumCapacity: 5, MaximumCapacity: 20, WarehouseID: 1, ProductTypeID: 1}
+
+		mock.ExpectExec("UPDATE `sections` SET `section_number` = ?, `current_temperature` = ?, `minimum_temperature` = ?, `minimum_capacity` = ?, `maximum_capacity` = ?, `warehouse_id` = ?, `product_type_id` = ? WHERE `id` = ? AND ? >= `current_capacity`").WithArgs(section.SectionNumber, section.CurrentTemperature, section.MinimumTemperature, section.MinimumCapacity, section.MaximumCapacity, section.WarehouseID, section.ProductTypeID, section.ID, section.MaximumCapacity).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery("SELECT `current_capacity` FROM `sections` WHERE `id` = ?").WithArgs(section.ID).WillReturnRows(sqlmock.NewRows([]string{"current_capacity"}).AddRow(25))
+
+		_, err := rp.Update(context.Background(), section.ID, &section)
+		mockErr := mock.ExpectationsWereMet()
+
+		assert.Equal(t, customerror.NewError(http.StatusConflict, customerror.ErrCapacityBelowCurrent.Error(), "section", ""), err)
+		assert.NoError(t, mockErr)
+	})
+
 	t.Run("given a duplicate section then return error", func(t *testing.T) {
 		sectionID := 1
 		section := model.Section{ID: 1, SectionNumber: "S01", CurrentTemperature: 10.0, MinimumTemperature: 5.0, CurrentCapacity: 10, MinimumCapacity: 5, MaximumCapacity: 20, WarehouseID: 1, ProductTypeID: 1}
 		expectedError := customerror.HandleError("section", customerror.ErrorConflict, "")
 
-		mock.ExpectExec("UPDATE `sections` SET `section_number` = ?, `current_temperature` = ?, `minimum_temperature` = ?, `current_capacity` = ?, `minimum_capacity` = ?, `maximum_capacity` = ?, `warehouse_id` = ?, `product_type_id` = ? WHERE `id` = ?").WithArgs(section.SectionNumber, section.CurrentTemperature, section.MinimumTemperature, section.CurrentCapacity, section.MinimumCapacity, section.MaximumCapacity, section.WarehouseID, section.ProductTypeID, section.ID).WillReturnError(&mysql.MySQLError{Number: 1062, SQLState: [5]byte{'2', '3', '0', '0', '0'}, Message: "Duplicate entry"})
+		mock.ExpectExec("UPDATE `sections` SET `section_number` = ?, `current_temperature` = ?, `minimum_temperature` = ?, `minimum_capacity` = ?, `maximum_capacity` = ?, `warehouse_id` = ?, `product_type_id` = ? WHERE `id` = ? AND ? >= `current_capacity`").WithArgs(section.SectionNumber, section.CurrentTemperature, section.MinimumTemperature, section.MinimumCapacity, section.MaximumCapacity, section.WarehouseID, section.ProductTypeID, section.ID, section.MaximumCapacity).WillReturnError(&mysql.MySQLError{Number: 1062, SQLState: [5]byte{'2', '3', '0', '0', '0'}, Message: "Duplicate entry"})
 
-		_, err := rp.Update(sectionID, &section)
+		_, err := rp.Update(context.Background(), sectionID, &section)
 		mockErr := mock.ExpectationsWereMet()
 
 		assert.Error(t, expectedError, err)
@@ -184,9 +199,9 @@ func TestSectionRepository_Update(t *testing.T) {
 		section := model.Section{ID: 1, SectionNumber: "S01", CurrentTemperature: 10.0, MinimumTemperature: 5.0, CurrentCapacity: 10, MinimumCapacity: 5, MaximumCapacity: 20, WarehouseID: 1, ProductTypeID: 1}
 		expectedError := customerror.HandleError("section", customerror.ErrorNotFound, "")
 
-		mock.ExpectExec("UPDATE `sections` SET `section_number` = ?, `current_temperature` = ?, `minimum_temperature` = ?, `current_capacity` = ?, `minimum_capacity` = ?, `maximum_capacity` = ?, `warehouse_id` = ?, `product_type_id` = ? WHERE `id` = ?").WillReturnError(sql.ErrNoRows)
+		mock.ExpectExec("UPDATE `sections` SET `section_number` = ?, `current_temperature` = ?, `minimum_temperature` = ?, `minimum_capacity` = ?, `maximum_capacity` = ?, `warehouse_id` = ?, `product_type_id` = ? WHERE `id` = ? AND ? >= `current_capacity`").WillReturnError(sql.ErrNoRows)
 
-		_, err := rp.Update(sectionID, &section)
+		_, err := rp.Update(context.Background(), sectionID, &section)
 		mockErr := mock.ExpectationsWereMet()
 
 		assert.Error(t, err)
@@ -211,7 +226,7 @@ func TestSectionRepository_Delete(t *testing.T) {
 
 		mock.ExpectExec("DELETE FROM `sections` WHERE `id` = ?").WithArgs(sectionID).WillReturnResult(sqlmock.NewResult(0, 1))
 
-		err := rp.Delete(sectionID)
+		err := rp.Delete(context.Background(), sectionID)
 
 		mockErr := mock.ExpectationsWereMet()
 
@@ -226,7 +241,7 @@ func TestSectionRepository_Delete(t *testing.T) {
 
 		mock.ExpectExec("DELETE FROM `sections` WHERE `id` = ?").WithArgs(sectionID).WillReturnError(sql.ErrNoRows)
 
-		err := rp.Delete(sectionID)
+		err := rp.Delete(context.Background(), sectionID)
 
 		mockErr := mock.ExpectationsWereMet()
 
@@ -254,27 +269,26 @@ func TestCountProductBatchesBySectionID(t *testing.T) {
 
 		mock.ExpectQuery("SELECT s.id, s.section_number, COUNT(pb.section_id) as products_count FROM sections s INNER JOIN product_batches pb ON pb.section_id = s.id WHERE s.id = ? GROUP BY s.id").WithArgs(sectionID).WillReturnRows(rows)
 
-		count, err := rp.CountProductBatchesBySectionID(sectionID)
+		count, err := rp.CountProductBatchesSections(context.Background(), sectionID)
 
 		errMock := mock.ExpectationsWereMet()
 		assert.NoError(t, errMock)
 		assert.NoError(t, err)
-		assert.Equal(t, expectedCount, count)
+		assert.Equal(t, []model.SectionProductBatches{expectedCount}, count)
 
 	})
 
-	t.Run("given an invalid product batch then return error", func(t *testing.T) {
+	t.Run("given a section without product batches then return an empty count", func(t *testing.T) {
 		sectionID := 99
-		expectedCount := model.SectionProductBatches{}
 
-		mock.ExpectQuery("SELECT s.id, s.section_number, COUNT(pb.section_id) as products_count FROM sections s INNER JOIN product_batches pb ON pb.section_id = s.id WHERE s.id = ? GROUP BY s.id").WillReturnError(sql.ErrNoRows)
+		mock.ExpectQuery("SELECT s.id, s.section_number, COUNT(pb.section_id) as products_count FROM sections s INNER JOIN product_batches pb ON pb.section_id = s.id WHERE s.id = ? GROUP BY s.id").WithArgs(sectionID).WillReturnRows(sqlmock.NewRows([]string{"id", "section_number", "products_count"}))
 
-		count, err := rp.CountProductBatchesBySectionID(sectionID)
+		count, err := rp.CountProductBatchesSections(context.Background(), sectionID)
 
 		errMock := mock.ExpectationsWereMet()
 		assert.NoError(t, errMock)
-		assert.Error(t, err)
-		assert.Equal(t, expectedCount, count)
+		assert.NoError(t, err)
+		assert.Empty(t, count)
 
 	})
 }
@@ -301,7 +315,7 @@ func TestCountProductBatchesSections(t *testing.T) {
 
 		mock.ExpectQuery("SELECT s.id, s.section_number, COUNT(pb.section_id) as products_count FROM sections s INNER JOIN product_batches pb ON pb.section_id = s.id GROUP BY s.id").WillReturnRows(rows)
 
-		countPB, err := rp.CountProductBatchesSections()
+		countPB, err := rp.CountProductBatchesSections(context.Background(), 0)
 
 		errMock := mock.ExpectationsWereMet()
 		assert.NoError(t, errMock)
@@ -314,7 +328,7 @@ func TestCountProductBatchesSections(t *testing.T) {
 
 		mock.ExpectQuery("SELECT s.id, s.section_number, COUNT(pb.section_id) as products_count FROM sections s INNER JOIN product_batches pb ON pb.section_id = s.id GROUP BY s.id").WillReturnError(errors.New("unmapped error"))
 
-		countPB, err := rp.CountProductBatchesSections()
+		countPB, err := rp.CountProductBatchesSections(context.Background(), 0)
 
 		errMock := mock.ExpectationsWereMet()
 		assert.NoError(t, errMock)
@@ -323,3 +337,118 @@ func TestCountProductBatchesSections(t *testing.T) {
 
 	})
 }
+
+func TestSectionRepository_IncreaseCurrentCapacity(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	defer db.Close()
+
+	rp := repository.CreateRepositorySections(db, logMock)
+
+	query := "UPDATE `sections` SET `current_capacity` = `current_capacity` + ? WHERE `id` = ? AND `current_capacity` + ? <= `maximum_capacity`"
+
+	t.Run("given a quantity that fits then increase the current capacity", func(t *testing.T) {
+		mock.ExpectExec(query).WithArgs(5, 1, 5).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := rp.IncreaseCurrentCapacity(context.Background(), 1, 5)
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+		assert.NoError(t, err)
+	})
+
+	t.Run("given a quantity that exceeds the maximum capacity then return error", func(t *testing.T) {
+		mock.ExpectExec(query).WithArgs(50, 1, 50).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := rp.IncreaseCurrentCapacity(context.Background(), 1, 50)
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+		assert.Equal(t, customerror.NewError(http.StatusConflict, customerror.ErrCapacityExceeded.Error(), "section", ""), err)
+	})
+}
+
+func TestSectionRepository_GetCapacityReport(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	defer db.Close()
+
+	rp := repository.CreateRepositorySections(db, logMock)
+
+	query := "SELECT `id`, `section_number`, `warehouse_id`, `current_capacity`, `minimum_capacity`, `maximum_capacity` FROM `sections` WHERE `current_capacity` < `minimum_capacity` OR `current_capacity` >= `maximum_capacity` * ? ORDER BY `id`"
+
+	t.Run("return the sections below the minimum or near full", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"id", "section_number", "warehouse_id", "current_capacity", "minimum_capacity", "maximum_capacity"}).
+			AddRow(1, "S01", 1, 2, 5, 20).
+			AddRow(2, "S02", 1, 19, 5, 20)
+
+		mock.ExpectQuery(query).WithArgs(0.9).WillReturnRows(rows)
+
+		report, err := rp.GetCapacityReport(context.Background(), 0.9)
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+		assert.NoError(t, err)
+		assert.Equal(t, []model.SectionCapacity{
+			{ID: 1, SectionNumber: "S01", WarehouseID: 1, CurrentCapacity: 2, MinimumCapacity: 5, MaximumCapacity: 20, Status: model.SectionCapacityBelowMinimum},
+			{ID: 2, SectionNumber: "S02", WarehouseID: 1, CurrentCapacity: 19, MinimumCapacity: 5, MaximumCapacity: 20, Status: model.SectionCapacityNearFull},
+		}, report)
+	})
+
+	t.Run("return error when the query fails", func(t *testing.T) {
+		mock.ExpectQuery(query).WithArgs(0.9).WillReturnError(errors.New("unmapped error"))
+
+		report, err := rp.GetCapacityReport(context.Background(), 0.9)
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+		assert.Error(t, err)
+		assert.Nil(t, report)
+	})
+}
+
+func TestSectionRepository_DecreaseCurrentCapacity(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	defer db.Close()
+
+	rp := repository.CreateRepositorySections(db, logMock)
+
+	t.Run("given a released quantity then decrease the current capacity", func(t *testing.T) {
+		mock.ExpectExec("UPDATE `sections` SET `current_capacity` = GREATEST(`current_capacity` - ?, 0) WHERE `id` = ?").WithArgs(5, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := rp.DecreaseCurrentCapacity(context.Background(), 1, 5)
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+		assert.NoError(t, err)
+	})
+}
+
+func TestSectionRepository_SyncCurrentTemperature(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	defer db.Close()
+
+	rp := repository.CreateRepositorySections(db, logMock)
+
+	t.Run("set the current temperature to the most recent reading", func(t *testing.T) {
+		mock.ExpectExec("UPDATE `sections` SET `current_temperature` = " +
+			"(SELECT `temperature` FROM `temperature_readings` WHERE `section_id` = ? ORDER BY `recorded_at` DESC, `id` DESC LIMIT 1) " +
+			"WHERE `id` = ? AND EXISTS (SELECT 1 FROM `temperature_readings` WHERE `section_id` = ?)").
+			WithArgs(1, 1, 1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := rp.SyncCurrentTemperature(context.Background(), 1)
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+		assert.NoError(t, err)
+	})
+}
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
@@ -10,6 +11,7 @@ import (
 
 	"github.com/go-sql-driver/mysql"
 	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+	"github.com/maxwelbm/alkemy-g7.git/internal/repository/interfaces"
 	er "github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
 )
 
@@ -18,15 +20,16 @@ func CreateRepositorySellers(db *sql.DB, log logger.Logger) *SellersRepository {
 }
 
 type SellersRepository struct {
-	db  *sql.DB
+	db  DBTX
 	log logger.Logger
 }
 
-func (rp *SellersRepository) Get() (sellers []model.Seller, err error) {
+func (rp *SellersRepository) Get(ctx context.Context, params model.ListParams) (sellers []model.Seller, total int, err error) {
 	rp.log.Log("SellersRepository", "INFO", "Get function initializing")
 
-	query := "SELECT `id`, `cid`, `company_name`, `address`, `telephone`, `locality_id` FROM `sellers`"
-	rows, err := rp.db.Query(query)
+	list := newListQuery(params, model.SellerListOptions)
+	query, args := list.selectQuery("SELECT `id`, `cid`, `company_name`, `address`, `telephone`, `locality_id` FROM `sellers`")
+	rows, err := rp.db.QueryContext(ctx, query, args...)
 
 	if err != nil {
 		rp.log.Log("SellersRepository", "ERROR", fmt.Sprintf("Error: %v", err))
@@ -49,17 +52,29 @@ func (rp *SellersRepository) Get() (sellers []model.Seller, err error) {
 		sellers = append(sellers, seller)
 	}
 
+	total = len(sellers)
+
+	if params.PageSize > 0 {
+		total, err = countRows(ctx, rp.db, "SELECT COUNT(*) FROM `sellers`", list)
+
+		if err != nil {
+			rp.log.Log("SellersRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+
+			return nil, 0, err
+		}
+	}
+
 	rp.log.Log("SellersRepository", "INFO", fmt.Sprintf("Retrieved sellers: %+v", sellers))
 	rp.log.Log("SellersRepository", "INFO", "Get function completed")
 
 	return
 }
 
-func (rp *SellersRepository) GetByID(id int) (sl model.Seller, err error) {
+func (rp *SellersRepository) GetByID(ctx context.Context, id int) (sl model.Seller, err error) {
 	rp.log.Log("SellersRepository", "INFO", "Get seller by ID function initializing")
 
 	query := "SELECT `id`, `cid`, `company_name`, `address`, `telephone`, `locality_id` FROM `sellers` WHERE `id` = ?"
-	row := rp.db.QueryRow(query, id)
+	row := rp.db.QueryRowContext(ctx, query, id)
 
 	err = row.Scan(&sl.ID, &sl.CID, &sl.CompanyName, &sl.Address, &sl.Telephone, &sl.Locality)
 
@@ -76,11 +91,11 @@ func (rp *SellersRepository) GetByID(id int) (sl model.Seller, err error) {
 	return
 }
 
-func (rp *SellersRepository) Post(seller *model.Seller) (sl model.Seller, err error) {
+func (rp *SellersRepository) Post(ctx context.Context, seller *model.Seller) (sl model.Seller, err error) {
 	rp.log.Log("SellersRepository", "INFO", "Post function initializing")
 
 	query := "INSERT INTO `sellers` (`cid`, `company_name`, `address`, `telephone`, `locality_id`) VALUES (?, ?, ?, ?, ?)"
-	result, err := rp.db.Exec(query, (*seller).CID, (*seller).CompanyName, (*seller).Address, (*seller).Telephone, (*seller).Locality)
+	result, err := rp.db.ExecContext(ctx, query, (*seller).CID, (*seller).CompanyName, (*seller).Address, (*seller).Telephone, (*seller).Locality)
 	err = rp.validateSQLError(err)
 
 	if err != nil {
@@ -96,7 +111,7 @@ func (rp *SellersRepository) Post(seller *model.Seller) (sl model.Seller, err er
 		return
 	}
 
-	sl, _ = rp.GetByID(int(id))
+	sl, _ = rp.GetByID(ctx, int(id))
 
 	rp.log.Log("SellersRepository", "INFO", fmt.Sprintf("Created seller: %+v", sl))
 	rp.log.Log("SellersRepository", "INFO", "Post function completed")
@@ -104,7 +119,7 @@ func (rp *SellersRepository) Post(seller *model.Seller) (sl model.Seller, err er
 	return
 }
 
-func (rp *SellersRepository) Patch(id int, seller *model.Seller) (sl model.Seller, err error) {
+func (rp *SellersRepository) Patch(ctx context.Context, id int, seller *model.Seller) (sl model.Seller, err error) {
 	rp.log.Log("SellersRepository", "INFO", "Patch function initializing")
 
 	query := "UPDATE `sellers` SET"
@@ -147,7 +162,7 @@ func (rp *SellersRepository) Patch(id int, seller *model.Seller) (sl model.Selle
 		args = append(args, id)
 	}
 
-	_, err = rp.db.Exec(query, args...)
+	_, err = rp.db.ExecContext(ctx, query, args...)
 	err = rp.validateSQLError(err)
 
 	if err != nil {
@@ -156,7 +171,7 @@ func (rp *SellersRepository) Patch(id int, seller *model.Seller) (sl model.Selle
 		return sl, err
 	}
 
-	sl, _ = rp.GetByID(id)
+	sl, _ = rp.GetByID(ctx, id)
 
 	rp.log.Log("SellersRepository", "INFO", fmt.Sprintf("Updated seller: %+v", sl))
 	rp.log.Log("SellersRepository", "INFO", "Patch function completed")
@@ -164,11 +179,11 @@ func (rp *SellersRepository) Patch(id int, seller *model.Seller) (sl model.Selle
 	return sl, err
 }
 
-func (rp *SellersRepository) Delete(id int) error {
+func (rp *SellersRepository) Delete(ctx context.Context, id int) error {
 	rp.log.Log("SellersRepository", "INFO", "Delete function initializing")
 
 	query := "DELETE FROM `sellers` WHERE `id` = ?"
-	_, err := rp.db.Exec(query, id)
+	_, err := rp.db.ExecContext(ctx, query, id)
 	err = rp.validateSQLError(err)
 
 	if err != nil {
@@ -204,3 +219,8 @@ func (rp *SellersRepository) validateSQLError(err error) (e error) {
 
 	return e
 }
+
+// WithTx implements interfaces.ISellerRepo.
+func (rp *SellersRepository) WithTx(tx *sql.Tx) interfaces.ISellerRepo {
+	return &SellersRepository{db: tx, log: rp.log}
+}
@@ -1,6 +1,7 @@
 package repository_test
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"net/http"
@@ -34,9 +35,9 @@ func TestSellersRepository_Get(t *testing.T) {
 			rows.AddRow(seller.ID, seller.CID, seller.CompanyName, seller.Address, seller.Telephone, seller.Locality)
 		}
 
-		mock.ExpectQuery("SELECT `id`, `cid`, `company_name`, `address`, `telephone`, `locality_id` FROM `sellers`").WillReturnRows(rows)
+		mock.ExpectQuery("SELECT `id`, `cid`, `company_name`, `address`, `telephone`, `locality_id` FROM `sellers` ORDER BY `id`").WillReturnRows(rows)
 
-		sellers, err := rp.Get()
+		sellers, _, err := rp.Get(context.Background(), model.ListParams{})
 		errMock := mock.ExpectationsWereMet()
 
 		assert.NoError(t, err)
@@ -45,9 +46,9 @@ func TestSellersRepository_Get(t *testing.T) {
 	})
 
 	t.Run("test repository method for get all sellers with query error", func(t *testing.T) {
-		mock.ExpectQuery("SELECT `id`, `cid`, `company_name`, `address`, `telephone`, `locality_id` FROM `sellers`").WillReturnError(sql.ErrNoRows)
+		mock.ExpectQuery("SELECT `id`, `cid`, `company_name`, `address`, `telephone`, `locality_id` FROM `sellers` ORDER BY `id`").WillReturnError(sql.ErrNoRows)
 
-		sellers, err := rp.Get()
+		sellers, _, err := rp.Get(context.Background(), model.ListParams{})
 		mockErr := mock.ExpectationsWereMet()
 		assert.Error(t, err)
 		assert.Empty(t, sellers)
@@ -75,7 +76,7 @@ func TestSellersRepository_GetByID(t *testing.T) {
 			WithArgs(ID).
 			WillReturnRows(rows)
 
-		result, err := rp.GetByID(ID)
+		result, err := rp.GetByID(context.Background(), ID)
 
 		errMock := mock.ExpectationsWereMet()
 
@@ -91,7 +92,7 @@ func TestSellersRepository_GetByID(t *testing.T) {
 			WithArgs(ID).
 			WillReturnError(sql.ErrNoRows)
 
-		seller, err := rp.GetByID(ID)
+		seller, err := rp.GetByID(context.Background(), ID)
 		errorExpected := customerror.NewSellerErr(customerror.ErrNotFound.Error(), http.StatusNotFound)
 		errorMock := mock.ExpectationsWereMet()
 
@@ -122,7 +123,7 @@ func TestSellersRepository_Post(t *testing.T) {
 			WillReturnRows(sqlmock.NewRows([]string{"id", "cid", "company_name", "address", "telephone", "locality_id"}).
 				AddRow(seller.ID, seller.CID, seller.CompanyName, seller.Address, seller.Telephone, seller.Locality))
 
-		sl, err := rp.Post(&seller)
+		sl, err := rp.Post(context.Background(), &seller)
 
 		errMock := mock.ExpectationsWereMet()
 
@@ -139,7 +140,7 @@ func TestSellersRepository_Post(t *testing.T) {
 			WithArgs(seller.CID, seller.CompanyName, seller.Address, seller.Telephone, seller.Locality).
 			WillReturnResult(sqlmock.NewErrorResult(errors.New("error")))
 
-		sl, err := rp.Post(&seller)
+		sl, err := rp.Post(context.Background(), &seller)
 
 		errMock := mock.ExpectationsWereMet()
 
@@ -156,7 +157,7 @@ func TestSellersRepository_Post(t *testing.T) {
 			WithArgs(seller.CID, seller.CompanyName, seller.Address, seller.Telephone, seller.Locality).
 			WillReturnError(&mysql.MySQLError{Number: 1062})
 
-		sl, err := rp.Post(&seller)
+		sl, err := rp.Post(context.Background(), &seller)
 		errMock := mock.ExpectationsWereMet()
 		expectedErr := customerror.ErrCIDSellerAlreadyExist
 
@@ -173,7 +174,7 @@ func TestSellersRepository_Post(t *testing.T) {
 			WithArgs(seller.CID, seller.CompanyName, seller.Address, seller.Telephone, seller.Locality).
 			WillReturnError(&mysql.MySQLError{Number: 1048})
 
-		sl, err := rp.Post(&seller)
+		sl, err := rp.Post(context.Background(), &seller)
 		errMock := mock.ExpectationsWereMet()
 		expectedErr := customerror.ErrNullSellerAttribute
 
@@ -190,7 +191,7 @@ func TestSellersRepository_Post(t *testing.T) {
 			WithArgs(seller.CID, seller.CompanyName, seller.Address, seller.Telephone, seller.Locality).
 			WillReturnError(&mysql.MySQLError{Number: 1064})
 
-		sl, err := rp.Post(&seller)
+		sl, err := rp.Post(context.Background(), &seller)
 		errMock := mock.ExpectationsWereMet()
 		expectedErr := customerror.ErrInvalidSellerJSONFormat
 
@@ -207,7 +208,7 @@ func TestSellersRepository_Post(t *testing.T) {
 			WithArgs(seller.CID, seller.CompanyName, seller.Address, seller.Telephone, seller.Locality).
 			WillReturnError(&mysql.MySQLError{Number: 1205})
 
-		sl, err := rp.Post(&seller)
+		sl, err := rp.Post(context.Background(), &seller)
 		errMock := mock.ExpectationsWereMet()
 		expectedErr := customerror.ErrDefaultSellerSQL
 
@@ -240,7 +241,7 @@ func TestSellersRepository_Patch(t *testing.T) {
 			WillReturnRows(sqlmock.NewRows([]string{"id", "cid", "company_name", "address", "telephone", "locality_id"}).
 				AddRow(seller.ID, seller.CID, seller.CompanyName, seller.Address, seller.Telephone, seller.Locality))
 
-		sl, err := rp.Patch(ID, &seller)
+		sl, err := rp.Patch(context.Background(), ID, &seller)
 
 		errMock := mock.ExpectationsWereMet()
 
@@ -258,7 +259,7 @@ func TestSellersRepository_Patch(t *testing.T) {
 			WithArgs(seller.CID, seller.CompanyName, seller.Address, seller.Telephone, seller.Locality, seller.ID).
 			WillReturnError(&mysql.MySQLError{Number: 1062})
 
-		sl, err := rp.Patch(ID, &seller)
+		sl, err := rp.Patch(context.Background(), ID, &seller)
 
 		errMock := mock.ExpectationsWereMet()
 
@@ -284,7 +285,7 @@ func TestSellersRepository_Delete(t *testing.T) {
 			WithArgs(ID).
 			WillReturnResult(sqlmock.NewResult(0, 1))
 
-		err := rp.Delete(ID)
+		err := rp.Delete(context.Background(), ID)
 
 		mockErr := mock.ExpectationsWereMet()
 
@@ -299,7 +300,7 @@ func TestSellersRepository_Delete(t *testing.T) {
 			WithArgs(1).
 			WillReturnError(&mysql.MySQLError{Number: 1451})
 
-		err := rp.Delete(ID)
+		err := rp.Delete(context.Background(), ID)
 
 		mockErr := mock.ExpectationsWereMet()
 
@@ -0,0 +1,231 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+	"github.com/maxwelbm/alkemy-g7.git/internal/repository/interfaces"
+	"github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
+	"github.com/maxwelbm/alkemy-g7.git/pkg/logger"
+)
+
+const selectShipments = "SELECT s.id, s.tracking_code, s.carrier_id, s.status, s.created_at, s.updated_at FROM shipments s"
+
+type ShipmentRepository struct {
+	db  DBTX
+	log logger.Logger
+}
+
+func NewShipmentRepository(db *sql.DB, log logger.Logger) *ShipmentRepository {
+	return &ShipmentRepository{db: db, log: log}
+}
+
+func (r *ShipmentRepository) Create(ctx context.Context, shipment model.Shipment) (id int, err error) {
+	r.log.Log("ShipmentRepository", "INFO", fmt.Sprintf("initializing Create function for carrier %d", shipment.CarrierID))
+
+	result, err := r.db.ExecContext(ctx, "INSERT INTO `shipments` (`tracking_code`, `carrier_id`, `status`, `created_at`, `updated_at`) VALUES (?, ?, ?, ?, ?)",
+		shipment.TrackingCode, shipment.CarrierID, shipment.Status, shipment.CreatedAt, shipment.UpdatedAt)
+	if err != nil {
+		var mysqlErr *mysql.MySQLError
+		if errors.As(err, &mysqlErr) && mysqlErr.Number == 1062 {
+			err = customerror.HandleError("tracking code", customerror.ErrorConflict, "")
+		}
+
+		r.log.Log("ShipmentRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	lastID, err := result.LastInsertId()
+	if err != nil {
+		r.log.Log("ShipmentRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+		return
+	}
+
+	id = int(lastID)
+
+	r.log.Log("ShipmentRepository", "INFO", fmt.Sprintf("shipment %d created", id))
+
+	return
+}
+
+// AddPurchaseOrders links the purchase orders to the shipment and stamps them with its tracking code.
+func (r *ShipmentRepository) AddPurchaseOrders(ctx context.Context, shipmentID int, purchaseOrderIDs []int, trackingCode string) (err error) {
+	r.log.Log("ShipmentRepository", "INFO", fmt.Sprintf("initializing AddPurchaseOrders function for shipment %d", shipmentID))
+
+	values := make([]string, 0, len(purchaseOrderIDs))
+	insertArgs := make([]any, 0, len(purchaseOrderIDs)*2)
+	updateArgs := []any{trackingCode}
+
+	for _, id := range purchaseOrderIDs {
+		values = append(values, "(?, ?)")
+		insertArgs = append(insertArgs, shipmentID, id)
+		updateArgs = append(updateArgs, id)
+	}
+
+	_, err = r.db.ExecContext(ctx, "INSERT INTO `shipment_purchase_orders` (`shipment_id`, `purchase_order_id`) VALUES "+strings.Join(values, ", "), insertArgs...)
+	if err != nil {
+		r.log.Log("ShipmentRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+		return
+	}
+
+	_, err = r.db.ExecContext(ctx, "UPDATE `purchase_orders` SET `tracking_code` = ? WHERE `id` IN ("+placeholders(len(purchaseOrderIDs))+")", updateArgs...)
+	if err != nil {
+		r.log.Log("ShipmentRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+	}
+
+	return
+}
+
+// CountActiveByPurchaseOrders counts the shipments that have not failed among those carrying any of the purchase orders.
+func (r *ShipmentRepository) CountActiveByPurchaseOrders(ctx context.Context, purchaseOrderIDs []int) (count int, err error) {
+	r.log.Log("ShipmentRepository", "INFO", "initializing CountActiveByPurchaseOrders function")
+
+	args := []any{model.ShipmentStatusFailed}
+	for _, id := range purchaseOrderIDs {
+		args = append(args, id)
+	}
+
+	query := "SELECT COUNT(*) FROM shipment_purchase_orders spo INNER JOIN shipments s ON s.id = spo.shipment_id " +
+		"WHERE s.status <> ? AND spo.purchase_order_id IN (" + placeholders(len(purchaseOrderIDs)) + ")"
+
+	err = r.db.QueryRowContext(ctx, query, args...).Scan(&count)
+	if err != nil {
+		r.log.Log("ShipmentRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+	}
+
+	return
+}
+
+func (r *ShipmentRepository) UpdateStatus(ctx context.Context, id int, status string, at time.Time) (err error) {
+	r.log.Log("ShipmentRepository", "INFO", fmt.Sprintf("initializing UpdateStatus function for shipment %d", id))
+
+	_, err = r.db.ExecContext(ctx, "UPDATE `shipments` SET `status` = ?, `updated_at` = ? WHERE `id` = ?", status, at, id)
+	if err != nil {
+		r.log.Log("ShipmentRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+	}
+
+	return
+}
+
+func (r *ShipmentRepository) AddEvent(ctx context.Context, event model.ShipmentEvent) (err error) {
+	r.log.Log("ShipmentRepository", "INFO", fmt.Sprintf("initializing AddEvent function for shipment %d", event.ShipmentID))
+
+	_, err = r.db.ExecContext(ctx, "INSERT INTO `shipment_events` (`shipment_id`, `status`, `description`, `occurred_at`) VALUES (?, ?, ?, ?)",
+		event.ShipmentID, event.Status, event.Description, event.OccurredAt)
+	if err != nil {
+		r.log.Log("ShipmentRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+	}
+
+	return
+}
+
+// GetByID returns the shipment with its purchase orders and its events in chronological order.
+func (r *ShipmentRepository) GetByID(ctx context.Context, id int) (shipment model.Shipment, err error) {
+	r.log.Log("ShipmentRepository", "INFO", fmt.Sprintf("initializing GetByID function for shipment %d", id))
+
+	return r.get(ctx, selectShipments+" WHERE s.id = ?", id)
+}
+
+// GetByIDForUpdate reads the shipment and locks its row until the transaction ends, so concurrent
+// status changes are applied one after the other. It must run inside a transaction.
+func (r *ShipmentRepository) GetByIDForUpdate(ctx context.Context, id int) (shipment model.Shipment, err error) {
+	r.log.Log("ShipmentRepository", "INFO", fmt.Sprintf("initializing GetByIDForUpdate function for shipment %d", id))
+
+	return r.get(ctx, selectShipments+" WHERE s.id = ? FOR UPDATE", id)
+}
+
+// GetLatestByPurchaseOrder returns the most recent shipment carrying the purchase order.
+func (r *ShipmentRepository) GetLatestByPurchaseOrder(ctx context.Context, purchaseOrderID int) (shipment model.Shipment, err error) {
+	r.log.Log("ShipmentRepository", "INFO", fmt.Sprintf("initializing GetLatestByPurchaseOrder function for purchase order %d", purchaseOrderID))
+
+	query := selectShipments + " INNER JOIN shipment_purchase_orders spo ON spo.shipment_id = s.id WHERE spo.purchase_order_id = ? ORDER BY s.id DESC LIMIT 1"
+
+	return r.get(ctx, query, purchaseOrderID)
+}
+
+func (r *ShipmentRepository) get(ctx context.Context, query string, arg any) (shipment model.Shipment, err error) {
+	err = r.db.QueryRowContext(ctx, query, arg).
+		Scan(&shipment.ID, &shipment.TrackingCode, &shipment.CarrierID, &shipment.Status, &shipment.CreatedAt, &shipment.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = customerror.HandleError("shipment", customerror.ErrorNotFound, "")
+		}
+
+		r.log.Log("ShipmentRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return model.Shipment{}, err
+	}
+
+	if shipment.PurchaseOrderIDs, err = r.getPurchaseOrderIDs(ctx, shipment.ID); err != nil {
+		r.log.Log("ShipmentRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+		return model.Shipment{}, err
+	}
+
+	if shipment.Events, err = r.getEvents(ctx, shipment.ID); err != nil {
+		r.log.Log("ShipmentRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+		return model.Shipment{}, err
+	}
+
+	return
+}
+
+func (r *ShipmentRepository) getPurchaseOrderIDs(ctx context.Context, shipmentID int) (ids []int, err error) {
+	rows, err := r.db.QueryContext(ctx, "SELECT `purchase_order_id` FROM `shipment_purchase_orders` WHERE `shipment_id` = ? ORDER BY `purchase_order_id`", shipmentID)
+	if err != nil {
+		return
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var id int
+		if err = rows.Scan(&id); err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, id)
+	}
+
+	err = rows.Err()
+
+	return
+}
+
+func (r *ShipmentRepository) getEvents(ctx context.Context, shipmentID int) (events []model.ShipmentEvent, err error) {
+	rows, err := r.db.QueryContext(ctx, "SELECT `id`, `shipment_id`, `status`, `description`, `occurred_at` FROM `shipment_events` WHERE `shipment_id` = ? ORDER BY `occurred_at`, `id`", shipmentID)
+	if err != nil {
+		return
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var event model.ShipmentEvent
+		if err = rows.Scan(&event.ID, &event.ShipmentID, &event.Status, &event.Description, &event.OccurredAt); err != nil {
+			return nil, err
+		}
+
+		events = append(events, event)
+	}
+
+	err = rows.Err()
+
+	return
+}
+
+// WithTx implements interfaces.IShipmentRepo.
+func (r *ShipmentRepository) WithTx(tx *sql.Tx) interfaces.IShipmentRepo {
+	return &ShipmentRepository{db: tx, log: r.log}
+}
+
+// placeholders returns n comma separated bind placeholders for an IN clause.
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
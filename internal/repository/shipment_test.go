@@ -0,0 +1,209 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+	"github.com/maxwelbm/alkemy-g7.git/internal/repository"
+	"github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShipmentRepository_Create(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	defer db.Close()
+
+	rp := repository.NewShipmentRepository(db, logMock)
+
+	now := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	shipment := model.Shipment{TrackingCode: "ABCDEFGH2345", CarrierID: 1, Status: model.ShipmentStatusCreated, CreatedAt: now, UpdatedAt: now}
+	query := "INSERT INTO `shipments` (`tracking_code`, `carrier_id`, `status`, `created_at`, `updated_at`) VALUES (?, ?, ?, ?, ?)"
+
+	t.Run("insert the shipment", func(t *testing.T) {
+		mock.ExpectExec(query).WithArgs("ABCDEFGH2345", 1, model.ShipmentStatusCreated, now, now).WillReturnResult(sqlmock.NewResult(3, 1))
+
+		id, err := rp.Create(context.Background(), shipment)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, id)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("return conflict for a repeated tracking code", func(t *testing.T) {
+		mock.ExpectExec(query).WillReturnError(&mysql.MySQLError{Number: 1062})
+
+		_, err := rp.Create(context.Background(), shipment)
+
+		assert.Equal(t, customerror.HandleError("tracking code", customerror.ErrorConflict, ""), err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestShipmentRepository_AddPurchaseOrders(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	defer db.Close()
+
+	rp := repository.NewShipmentRepository(db, logMock)
+
+	t.Run("link the purchase orders and stamp the tracking code", func(t *testing.T) {
+		mock.ExpectExec("INSERT INTO `shipment_purchase_orders` (`shipment_id`, `purchase_order_id`) VALUES (?, ?), (?, ?)").
+			WithArgs(3, 1, 3, 2).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectExec("UPDATE `purchase_orders` SET `tracking_code` = ? WHERE `id` IN (?, ?)").
+			WithArgs("ABCDEFGH2345", 1, 2).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+
+		err := rp.AddPurchaseOrders(context.Background(), 3, []int{1, 2}, "ABCDEFGH2345")
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("count the active shipments of the purchase orders", func(t *testing.T) {
+		mock.ExpectQuery("SELECT COUNT(*) FROM shipment_purchase_orders spo INNER JOIN shipments s ON s.id = spo.shipment_id WHERE s.status <> ? AND spo.purchase_order_id IN (?, ?)").
+			WithArgs(model.ShipmentStatusFailed, 1, 2).
+			WillReturnRows(sqlmock.NewRows([]string{"COUNT(*)"}).AddRow(1))
+
+		count, err := rp.CountActiveByPurchaseOrders(context.Background(), []int{1, 2})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, count)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestShipmentRepository_GetByID(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	defer db.Close()
+
+	rp := repository.NewShipmentRepository(db, logMock)
+
+	selectShipment := "SELECT s.id, s.tracking_code, s.carrier_id, s.status, s.created_at, s.updated_at FROM shipments s"
+	selectOrders := "SELECT `purchase_order_id` FROM `shipment_purchase_orders` WHERE `shipment_id` = ? ORDER BY `purchase_order_id`"
+	selectEvents := "SELECT `id`, `shipment_id`, `status`, `description`, `occurred_at` FROM `shipment_events` WHERE `shipment_id` = ? ORDER BY `occurred_at`, `id`"
+	columns := []string{"id", "tracking_code", "carrier_id", "status", "created_at", "updated_at"}
+	createdAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	pickedAt := createdAt.Add(time.Hour)
+
+	expected := model.Shipment{
+		ID: 3, TrackingCode: "ABCDEFGH2345", CarrierID: 1, Status: model.ShipmentStatusPicked, PurchaseOrderIDs: []int{1, 2},
+		CreatedAt: createdAt, UpdatedAt: pickedAt,
+		Events: []model.ShipmentEvent{
+			{ID: 1, ShipmentID: 3, Status: model.ShipmentStatusCreated, Description: "shipment created", OccurredAt: createdAt},
+			{ID: 2, ShipmentID: 3, Status: model.ShipmentStatusPicked, Description: "", OccurredAt: pickedAt},
+		},
+	}
+
+	expectDetails := func() {
+		mock.ExpectQuery(selectOrders).WithArgs(3).WillReturnRows(sqlmock.NewRows([]string{"purchase_order_id"}).AddRow(1).AddRow(2))
+		mock.ExpectQuery(selectEvents).WithArgs(3).WillReturnRows(sqlmock.NewRows([]string{"id", "shipment_id", "status", "description", "occurred_at"}).
+			AddRow(1, 3, model.ShipmentStatusCreated, "shipment created", createdAt).
+			AddRow(2, 3, model.ShipmentStatusPicked, "", pickedAt))
+	}
+
+	t.Run("return the shipment with its purchase orders and events", func(t *testing.T) {
+		mock.ExpectQuery(selectShipment + " WHERE s.id = ?").WithArgs(3).
+			WillReturnRows(sqlmock.NewRows(columns).AddRow(3, "ABCDEFGH2345", 1, model.ShipmentStatusPicked, createdAt, pickedAt))
+		expectDetails()
+
+		shipment, err := rp.GetByID(context.Background(), 3)
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, shipment)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("lock and return the shipment with its purchase orders and events", func(t *testing.T) {
+		mock.ExpectQuery(selectShipment + " WHERE s.id = ? FOR UPDATE").WithArgs(3).
+			WillReturnRows(sqlmock.NewRows(columns).AddRow(3, "ABCDEFGH2345", 1, model.ShipmentStatusPicked, createdAt, pickedAt))
+		expectDetails()
+
+		shipment, err := rp.GetByIDForUpdate(context.Background(), 3)
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, shipment)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("return the latest shipment of a purchase order", func(t *testing.T) {
+		mock.ExpectQuery(selectShipment + " INNER JOIN shipment_purchase_orders spo ON spo.shipment_id = s.id WHERE spo.purchase_order_id = ? ORDER BY s.id DESC LIMIT 1").
+			WithArgs(2).
+			WillReturnRows(sqlmock.NewRows(columns).AddRow(3, "ABCDEFGH2345", 1, model.ShipmentStatusPicked, createdAt, pickedAt))
+		expectDetails()
+
+		shipment, err := rp.GetLatestByPurchaseOrder(context.Background(), 2)
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, shipment)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("return not found for an unknown shipment", func(t *testing.T) {
+		mock.ExpectQuery(selectShipment + " WHERE s.id = ?").WithArgs(99).WillReturnError(sql.ErrNoRows)
+
+		_, err := rp.GetByID(context.Background(), 99)
+
+		assert.Equal(t, customerror.HandleError("shipment", customerror.ErrorNotFound, ""), err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("return error when the events cannot be read", func(t *testing.T) {
+		mock.ExpectQuery(selectShipment + " WHERE s.id = ?").WithArgs(3).
+			WillReturnRows(sqlmock.NewRows(columns).AddRow(3, "ABCDEFGH2345", 1, model.ShipmentStatusPicked, createdAt, pickedAt))
+		mock.ExpectQuery(selectOrders).WithArgs(3).WillReturnRows(sqlmock.NewRows([]string{"purchase_order_id"}).AddRow(1))
+		mock.ExpectQuery(selectEvents).WithArgs(3).WillReturnError(errors.New("unmapped error"))
+
+		shipment, err := rp.GetByID(context.Background(), 3)
+
+		assert.Error(t, err)
+		assert.Equal(t, model.Shipment{}, shipment)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestShipmentRepository_UpdateStatus(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	defer db.Close()
+
+	rp := repository.NewShipmentRepository(db, logMock)
+	at := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	t.Run("update the status and record the event", func(t *testing.T) {
+		mock.ExpectExec("UPDATE `shipments` SET `status` = ?, `updated_at` = ? WHERE `id` = ?").
+			WithArgs(model.ShipmentStatusPicked, at, 3).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("INSERT INTO `shipment_events` (`shipment_id`, `status`, `description`, `occurred_at`) VALUES (?, ?, ?, ?)").
+			WithArgs(3, model.ShipmentStatusPicked, "picked by the carrier", at).
+			WillReturnResult(sqlmock.NewResult(2, 1))
+
+		err := rp.UpdateStatus(context.Background(), 3, model.ShipmentStatusPicked, at)
+		assert.NoError(t, err)
+
+		err = rp.AddEvent(context.Background(), model.ShipmentEvent{ShipmentID: 3, Status: model.ShipmentStatusPicked, Description: "picked by the carrier", OccurredAt: at})
+		assert.NoError(t, err)
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,247 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+	"github.com/maxwelbm/alkemy-g7.git/internal/repository/interfaces"
+	"github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
+	"github.com/maxwelbm/alkemy-g7.git/pkg/logger"
+)
+
+const selectExcursions = "SELECT `id`, `section_id`, `product_batch_id`, `kind`, `limit_temperature`, `peak_temperature`, `started_at`, `ended_at` FROM `temperature_excursions`"
+
+const selectBatchLimits = "SELECT pb.id, pb.section_id, pb.minimum_temperature, p.recommended_freezing_temperature " +
+	"FROM product_batches pb INNER JOIN products p ON p.id = pb.product_id"
+
+type TemperatureExcursionRepository struct {
+	db  DBTX
+	log logger.Logger
+}
+
+func NewTemperatureExcursionRepository(db *sql.DB, log logger.Logger) *TemperatureExcursionRepository {
+	return &TemperatureExcursionRepository{db: db, log: log}
+}
+
+// GetBatchLimits returns the temperature limits of the batches with stock stored in the section.
+func (r *TemperatureExcursionRepository) GetBatchLimits(ctx context.Context, sectionID int) (limits []model.BatchTemperatureLimits, err error) {
+	r.log.Log("TemperatureExcursionRepository", "INFO", fmt.Sprintf("initializing GetBatchLimits function for section %d", sectionID))
+
+	rows, err := r.db.QueryContext(ctx, selectBatchLimits+" WHERE pb.section_id = ? AND pb.current_quantity > 0", sectionID)
+	if err != nil {
+		r.log.Log("TemperatureExcursionRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+		return
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var l model.BatchTemperatureLimits
+
+		err = rows.Scan(&l.BatchID, &l.SectionID, &l.MinimumTemperature, &l.MaximumTemperature)
+		if err != nil {
+			r.log.Log("TemperatureExcursionRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+			return nil, err
+		}
+
+		limits = append(limits, l)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		r.log.Log("TemperatureExcursionRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+		return nil, err
+	}
+
+	return
+}
+
+func (r *TemperatureExcursionRepository) GetBatchLimitsByID(ctx context.Context, batchID int) (limits model.BatchTemperatureLimits, err error) {
+	r.log.Log("TemperatureExcursionRepository", "INFO", fmt.Sprintf("initializing GetBatchLimitsByID function for batch %d", batchID))
+
+	err = r.db.QueryRowContext(ctx, selectBatchLimits+" WHERE pb.id = ?", batchID).
+		Scan(&limits.BatchID, &limits.SectionID, &limits.MinimumTemperature, &limits.MaximumTemperature)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			err = customerror.HandleError("product batches", customerror.ErrorNotFound, "")
+		}
+
+		r.log.Log("TemperatureExcursionRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+	}
+
+	return
+}
+
+func (r *TemperatureExcursionRepository) GetOpen(ctx context.Context, sectionID int) (excursions []model.TemperatureExcursion, err error) {
+	r.log.Log("TemperatureExcursionRepository", "INFO", fmt.Sprintf("initializing GetOpen function for section %d", sectionID))
+
+	return r.query(ctx, selectExcursions+" WHERE `section_id` = ? AND `ended_at` IS NULL", sectionID)
+}
+
+func (r *TemperatureExcursionRepository) Open(ctx context.Context, excursion model.TemperatureExcursion) (id int, err error) {
+	r.log.Log("TemperatureExcursionRepository", "INFO", fmt.Sprintf("initializing Open function for batch %d", excursion.ProductBatchID))
+
+	query := "INSERT INTO `temperature_excursions` (`section_id`, `product_batch_id`, `kind`, `limit_temperature`, `peak_temperature`, `started_at`) VALUES (?, ?, ?, ?, ?, ?)"
+
+	result, err := r.db.ExecContext(ctx, query, excursion.SectionID, excursion.ProductBatchID, excursion.Kind, excursion.LimitTemperature, excursion.PeakTemperature, excursion.StartedAt)
+	if err != nil {
+		r.log.Log("TemperatureExcursionRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+		return
+	}
+
+	lastID, err := result.LastInsertId()
+	if err != nil {
+		r.log.Log("TemperatureExcursionRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+		return
+	}
+
+	id = int(lastID)
+
+	r.log.Log("TemperatureExcursionRepository", "INFO", fmt.Sprintf("temperature excursion %d opened", id))
+
+	return
+}
+
+func (r *TemperatureExcursionRepository) UpdatePeak(ctx context.Context, id int, peak float64) (err error) {
+	r.log.Log("TemperatureExcursionRepository", "INFO", fmt.Sprintf("initializing UpdatePeak function for excursion %d", id))
+
+	_, err = r.db.ExecContext(ctx, "UPDATE `temperature_excursions` SET `peak_temperature` = ? WHERE `id` = ?", peak, id)
+	if err != nil {
+		r.log.Log("TemperatureExcursionRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+	}
+
+	return
+}
+
+// Close ends an open excursion. An end before the start is recorded as the start itself.
+func (r *TemperatureExcursionRepository) Close(ctx context.Context, id int, endedAt time.Time) (err error) {
+	r.log.Log("TemperatureExcursionRepository", "INFO", fmt.Sprintf("initializing Close function for excursion %d", id))
+
+	_, err = r.db.ExecContext(ctx, "UPDATE `temperature_excursions` SET `ended_at` = GREATEST(`started_at`, ?) WHERE `id` = ? AND `ended_at` IS NULL", endedAt, id)
+	if err != nil {
+		r.log.Log("TemperatureExcursionRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+	}
+
+	return
+}
+
+// GetBySection lists the excursions of a section, the most recent first. Status may be open, closed or empty for both.
+func (r *TemperatureExcursionRepository) GetBySection(ctx context.Context, sectionID int, status string) (excursions []model.TemperatureExcursion, err error) {
+	r.log.Log("TemperatureExcursionRepository", "INFO", fmt.Sprintf("initializing GetBySection function for section %d", sectionID))
+
+	query := selectExcursions + " WHERE `section_id` = ?"
+
+	switch status {
+	case model.ExcursionStatusOpen:
+		query += " AND `ended_at` IS NULL"
+	case model.ExcursionStatusClosed:
+		query += " AND `ended_at` IS NOT NULL"
+	}
+
+	return r.query(ctx, query+" ORDER BY `started_at` DESC, `id` DESC", sectionID)
+}
+
+// GetReport summarizes the excursions per section, open ones counting their duration up to now.
+func (r *TemperatureExcursionRepository) GetReport(ctx context.Context, filter model.ExcursionReportFilter) (report []model.SectionExcursionReport, err error) {
+	r.log.Log("TemperatureExcursionRepository", "INFO", "initializing GetReport function")
+
+	var (
+		conditions []string
+		args       []any
+	)
+
+	if !filter.From.IsZero() {
+		conditions = append(conditions, "e.started_at >= ?")
+		args = append(args, filter.From)
+	}
+
+	if !filter.To.IsZero() {
+		conditions = append(conditions, "e.started_at < ?")
+		args = append(args, filter.To)
+	}
+
+	query := "SELECT s.id, s.section_number, s.warehouse_id, COUNT(e.id), SUM(e.ended_at IS NULL), " +
+		"SUM(TIMESTAMPDIFF(MINUTE, e.started_at, COALESCE(e.ended_at, UTC_TIMESTAMP(6)))), MAX(e.started_at) " +
+		"FROM temperature_excursions e INNER JOIN sections s ON s.id = e.section_id"
+
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	query += " GROUP BY s.id, s.section_number, s.warehouse_id ORDER BY COUNT(e.id) DESC, s.id"
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		r.log.Log("TemperatureExcursionRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+		return
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var line model.SectionExcursionReport
+
+		err = rows.Scan(&line.SectionID, &line.SectionNumber, &line.WarehouseID, &line.IncidentsCount, &line.OpenCount, &line.TotalDurationMinutes, &line.LastStartedAt)
+		if err != nil {
+			r.log.Log("TemperatureExcursionRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+			return nil, err
+		}
+
+		report = append(report, line)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		r.log.Log("TemperatureExcursionRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+		return nil, err
+	}
+
+	return
+}
+
+func (r *TemperatureExcursionRepository) query(ctx context.Context, query string, args ...any) (excursions []model.TemperatureExcursion, err error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		r.log.Log("TemperatureExcursionRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+		return
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			e       model.TemperatureExcursion
+			endedAt sql.NullTime
+		)
+
+		err = rows.Scan(&e.ID, &e.SectionID, &e.ProductBatchID, &e.Kind, &e.LimitTemperature, &e.PeakTemperature, &e.StartedAt, &endedAt)
+		if err != nil {
+			r.log.Log("TemperatureExcursionRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+			return nil, err
+		}
+
+		if endedAt.Valid {
+			e.EndedAt = &endedAt.Time
+		}
+
+		excursions = append(excursions, e)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		r.log.Log("TemperatureExcursionRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+		return nil, err
+	}
+
+	return
+}
+
+// WithTx implements interfaces.ITemperatureExcursionRepo.
+func (r *TemperatureExcursionRepository) WithTx(tx *sql.Tx) interfaces.ITemperatureExcursionRepo {
+	return &TemperatureExcursionRepository{db: tx, log: r.log}
+}
@@ -0,0 +1,240 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+	"github.com/maxwelbm/alkemy-g7.git/internal/repository"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTemperatureExcursionRepository_GetBatchLimits(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	defer db.Close()
+
+	rp := repository.NewTemperatureExcursionRepository(db, logMock)
+
+	selectLimits := "SELECT pb.id, pb.section_id, pb.minimum_temperature, p.recommended_freezing_temperature " +
+		"FROM product_batches pb INNER JOIN products p ON p.id = pb.product_id"
+	columns := []string{"id", "section_id", "minimum_temperature", "recommended_freezing_temperature"}
+
+	t.Run("return the limits of the batches stored in the section", func(t *testing.T) {
+		rows := sqlmock.NewRows(columns).AddRow(1, 1, -20.0, -15.0).AddRow(2, 1, -25.0, -10.0)
+		mock.ExpectQuery(selectLimits + " WHERE pb.section_id = ? AND pb.current_quantity > 0").WithArgs(1).WillReturnRows(rows)
+
+		limits, err := rp.GetBatchLimits(context.Background(), 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []model.BatchTemperatureLimits{
+			{BatchID: 1, SectionID: 1, MinimumTemperature: -20, MaximumTemperature: -15},
+			{BatchID: 2, SectionID: 1, MinimumTemperature: -25, MaximumTemperature: -10},
+		}, limits)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("return the limits of a single batch", func(t *testing.T) {
+		rows := sqlmock.NewRows(columns).AddRow(1, 1, -20.0, -15.0)
+		mock.ExpectQuery(selectLimits + " WHERE pb.id = ?").WithArgs(1).WillReturnRows(rows)
+
+		limits, err := rp.GetBatchLimitsByID(context.Background(), 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, model.BatchTemperatureLimits{BatchID: 1, SectionID: 1, MinimumTemperature: -20, MaximumTemperature: -15}, limits)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("return not found for an unknown batch", func(t *testing.T) {
+		mock.ExpectQuery(selectLimits + " WHERE pb.id = ?").WithArgs(99).WillReturnError(sql.ErrNoRows)
+
+		_, err := rp.GetBatchLimitsByID(context.Background(), 99)
+
+		assert.ErrorContains(t, err, "product batches not found")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestTemperatureExcursionRepository_Lifecycle(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	defer db.Close()
+
+	rp := repository.NewTemperatureExcursionRepository(db, logMock)
+
+	startedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	t.Run("open an excursion", func(t *testing.T) {
+		mock.ExpectExec("INSERT INTO `temperature_excursions` (`section_id`, `product_batch_id`, `kind`, `limit_temperature`, `peak_temperature`, `started_at`) VALUES (?, ?, ?, ?, ?, ?)").
+			WithArgs(1, 2, model.ExcursionAboveMaximum, -15.0, -12.0, startedAt).
+			WillReturnResult(sqlmock.NewResult(5, 1))
+
+		id, err := rp.Open(context.Background(), model.TemperatureExcursion{
+			SectionID: 1, ProductBatchID: 2, Kind: model.ExcursionAboveMaximum, LimitTemperature: -15, PeakTemperature: -12, StartedAt: startedAt,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 5, id)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("update the peak of an excursion", func(t *testing.T) {
+		mock.ExpectExec("UPDATE `temperature_excursions` SET `peak_temperature` = ? WHERE `id` = ?").
+			WithArgs(-8.0, 5).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := rp.UpdatePeak(context.Background(), 5, -8)
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("close an excursion", func(t *testing.T) {
+		mock.ExpectExec("UPDATE `temperature_excursions` SET `ended_at` = GREATEST(`started_at`, ?) WHERE `id` = ? AND `ended_at` IS NULL").
+			WithArgs(startedAt.Add(time.Hour), 5).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := rp.Close(context.Background(), 5, startedAt.Add(time.Hour))
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("return error when the excursion cannot be opened", func(t *testing.T) {
+		mock.ExpectExec("INSERT INTO `temperature_excursions` (`section_id`, `product_batch_id`, `kind`, `limit_temperature`, `peak_temperature`, `started_at`) VALUES (?, ?, ?, ?, ?, ?)").
+			WillReturnError(errors.New("unmapped error"))
+
+		id, err := rp.Open(context.Background(), model.TemperatureExcursion{})
+
+		assert.Error(t, err)
+		assert.Zero(t, id)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestTemperatureExcursionRepository_GetBySection(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	defer db.Close()
+
+	rp := repository.NewTemperatureExcursionRepository(db, logMock)
+
+	selectExcursions := "SELECT `id`, `section_id`, `product_batch_id`, `kind`, `limit_temperature`, `peak_temperature`, `started_at`, `ended_at` FROM `temperature_excursions`"
+	columns := []string{"id", "section_id", "product_batch_id", "kind", "limit_temperature", "peak_temperature", "started_at", "ended_at"}
+	startedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	endedAt := startedAt.Add(time.Hour)
+
+	t.Run("return every excursion of the section, the most recent first", func(t *testing.T) {
+		rows := sqlmock.NewRows(columns).
+			AddRow(2, 1, 3, model.ExcursionBelowMinimum, -20.0, -22.0, endedAt, nil).
+			AddRow(1, 1, 3, model.ExcursionAboveMaximum, -15.0, -12.0, startedAt, endedAt)
+		mock.ExpectQuery(selectExcursions + " WHERE `section_id` = ? ORDER BY `started_at` DESC, `id` DESC").WithArgs(1).WillReturnRows(rows)
+
+		excursions, err := rp.GetBySection(context.Background(), 1, "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []model.TemperatureExcursion{
+			{ID: 2, SectionID: 1, ProductBatchID: 3, Kind: model.ExcursionBelowMinimum, LimitTemperature: -20, PeakTemperature: -22, StartedAt: endedAt},
+			{ID: 1, SectionID: 1, ProductBatchID: 3, Kind: model.ExcursionAboveMaximum, LimitTemperature: -15, PeakTemperature: -12, StartedAt: startedAt, EndedAt: &endedAt},
+		}, excursions)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("return only the closed excursions", func(t *testing.T) {
+		rows := sqlmock.NewRows(columns).AddRow(1, 1, 3, model.ExcursionAboveMaximum, -15.0, -12.0, startedAt, endedAt)
+		mock.ExpectQuery(selectExcursions + " WHERE `section_id` = ? AND `ended_at` IS NOT NULL ORDER BY `started_at` DESC, `id` DESC").WithArgs(1).WillReturnRows(rows)
+
+		excursions, err := rp.GetBySection(context.Background(), 1, model.ExcursionStatusClosed)
+
+		assert.NoError(t, err)
+		assert.Len(t, excursions, 1)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("return the open excursions of the section", func(t *testing.T) {
+		rows := sqlmock.NewRows(columns).AddRow(2, 1, 3, model.ExcursionBelowMinimum, -20.0, -22.0, endedAt, nil)
+		mock.ExpectQuery(selectExcursions + " WHERE `section_id` = ? AND `ended_at` IS NULL").WithArgs(1).WillReturnRows(rows)
+
+		excursions, err := rp.GetOpen(context.Background(), 1)
+
+		assert.NoError(t, err)
+		assert.Len(t, excursions, 1)
+		assert.Nil(t, excursions[0].EndedAt)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("return error when the query fails", func(t *testing.T) {
+		mock.ExpectQuery(selectExcursions + " WHERE `section_id` = ? ORDER BY `started_at` DESC, `id` DESC").WithArgs(1).WillReturnError(errors.New("unmapped error"))
+
+		excursions, err := rp.GetBySection(context.Background(), 1, "")
+
+		assert.Error(t, err)
+		assert.Nil(t, excursions)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestTemperatureExcursionRepository_GetReport(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	defer db.Close()
+
+	rp := repository.NewTemperatureExcursionRepository(db, logMock)
+
+	selectReport := "SELECT s.id, s.section_number, s.warehouse_id, COUNT(e.id), SUM(e.ended_at IS NULL), " +
+		"SUM(TIMESTAMPDIFF(MINUTE, e.started_at, COALESCE(e.ended_at, UTC_TIMESTAMP(6)))), MAX(e.started_at) " +
+		"FROM temperature_excursions e INNER JOIN sections s ON s.id = e.section_id"
+	groupBy := " GROUP BY s.id, s.section_number, s.warehouse_id ORDER BY COUNT(e.id) DESC, s.id"
+	columns := []string{"id", "section_number", "warehouse_id", "incidents", "open", "duration", "last_started_at"}
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(24 * time.Hour)
+
+	t.Run("return the incidents per section in the period", func(t *testing.T) {
+		rows := sqlmock.NewRows(columns).AddRow(1, "S01", 1, 2, 1, 90, from.Add(time.Hour))
+		mock.ExpectQuery(selectReport+" WHERE e.started_at >= ? AND e.started_at < ?"+groupBy).WithArgs(from, to).WillReturnRows(rows)
+
+		report, err := rp.GetReport(context.Background(), model.ExcursionReportFilter{From: from, To: to})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []model.SectionExcursionReport{
+			{SectionID: 1, SectionNumber: "S01", WarehouseID: 1, IncidentsCount: 2, OpenCount: 1, TotalDurationMinutes: 90, LastStartedAt: from.Add(time.Hour)},
+		}, report)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("return every incident when no period is informed", func(t *testing.T) {
+		mock.ExpectQuery(selectReport + groupBy).WillReturnRows(sqlmock.NewRows(columns))
+
+		report, err := rp.GetReport(context.Background(), model.ExcursionReportFilter{})
+
+		assert.NoError(t, err)
+		assert.Empty(t, report)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("return error when the query fails", func(t *testing.T) {
+		mock.ExpectQuery(selectReport + groupBy).WillReturnError(errors.New("unmapped error"))
+
+		report, err := rp.GetReport(context.Background(), model.ExcursionReportFilter{})
+
+		assert.Error(t, err)
+		assert.Nil(t, report)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
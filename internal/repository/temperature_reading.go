@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+	"github.com/maxwelbm/alkemy-g7.git/internal/repository/interfaces"
+	"github.com/maxwelbm/alkemy-g7.git/pkg/logger"
+)
+
+type TemperatureReadingRepository struct {
+	db  DBTX
+	log logger.Logger
+}
+
+func NewTemperatureReadingRepository(db *sql.DB, log logger.Logger) *TemperatureReadingRepository {
+	return &TemperatureReadingRepository{db: db, log: log}
+}
+
+// BulkInsert stores all the readings of a section with a single statement.
+func (r *TemperatureReadingRepository) BulkInsert(ctx context.Context, sectionID int, readings []model.TemperatureReading) (inserted int, err error) {
+	r.log.Log("TemperatureReadingRepository", "INFO", fmt.Sprintf("initializing BulkInsert function with %d readings for section %d", len(readings), sectionID))
+
+	placeholders := make([]string, 0, len(readings))
+	args := make([]any, 0, len(readings)*4)
+
+	for _, reading := range readings {
+		placeholders = append(placeholders, "(?, ?, ?, ?)")
+		args = append(args, sectionID, reading.SensorID, reading.Temperature, reading.RecordedAt)
+	}
+
+	query := "INSERT INTO `temperature_readings` (`section_id`, `sensor_id`, `temperature`, `recorded_at`) VALUES " + strings.Join(placeholders, ", ")
+
+	result, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		r.log.Log("TemperatureReadingRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+		return
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.log.Log("TemperatureReadingRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+		return
+	}
+
+	inserted = int(rowsAffected)
+
+	r.log.Log("TemperatureReadingRepository", "INFO", fmt.Sprintf("%d temperature readings stored", inserted))
+
+	return
+}
+
+// GetLatestRecordedAt returns when the most recent reading of the section was taken, or the zero time when
+// the section has no readings.
+func (r *TemperatureReadingRepository) GetLatestRecordedAt(ctx context.Context, sectionID int) (latest time.Time, err error) {
+	r.log.Log("TemperatureReadingRepository", "INFO", fmt.Sprintf("initializing GetLatestRecordedAt function for section %d", sectionID))
+
+	var recordedAt sql.NullTime
+
+	err = r.db.QueryRowContext(ctx, "SELECT MAX(`recorded_at`) FROM `temperature_readings` WHERE `section_id` = ?", sectionID).Scan(&recordedAt)
+	if err != nil {
+		r.log.Log("TemperatureReadingRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+		return
+	}
+
+	latest = recordedAt.Time
+
+	return
+}
+
+// GetAggregates groups the readings of the query period in buckets of the query interval, aligned to the unix epoch.
+// Buckets without readings are not returned. The readings are stored in UTC and the buckets are computed with
+// date arithmetic only, so they do not depend on the time zone of the database session.
+func (r *TemperatureReadingRepository) GetAggregates(ctx context.Context, q model.TemperatureQuery) (aggregates []model.TemperatureAggregate, err error) {
+	r.log.Log("TemperatureReadingRepository", "INFO", fmt.Sprintf("initializing GetAggregates function for section %d", q.SectionID))
+
+	query := "SELECT TIMESTAMP('1970-01-01') + INTERVAL FLOOR(TIMESTAMPDIFF(SECOND, '1970-01-01', `recorded_at`) / ?) * ? SECOND AS period_start, " +
+		"MIN(`temperature`), MAX(`temperature`), AVG(`temperature`), COUNT(*) " +
+		"FROM `temperature_readings` " +
+		"WHERE `section_id` = ? AND `recorded_at` >= ? AND `recorded_at` < ? " +
+		"GROUP BY period_start ORDER BY period_start"
+
+	seconds := int64(q.Interval.Seconds())
+
+	rows, err := r.db.QueryContext(ctx, query, seconds, seconds, q.SectionID, q.From, q.To)
+	if err != nil {
+		r.log.Log("TemperatureReadingRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+		return
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var aggregate model.TemperatureAggregate
+
+		err = rows.Scan(&aggregate.PeriodStart, &aggregate.MinTemperature, &aggregate.MaxTemperature, &aggregate.AvgTemperature, &aggregate.ReadingsCount)
+		if err != nil {
+			r.log.Log("TemperatureReadingRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+			return nil, err
+		}
+
+		aggregates = append(aggregates, aggregate)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		r.log.Log("TemperatureReadingRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+		return nil, err
+	}
+
+	r.log.Log("TemperatureReadingRepository", "INFO", fmt.Sprintf("returning %d temperature aggregates", len(aggregates)))
+
+	return
+}
+
+// WithTx implements interfaces.ITemperatureReadingRepo.
+func (r *TemperatureReadingRepository) WithTx(tx *sql.Tx) interfaces.ITemperatureReadingRepo {
+	return &TemperatureReadingRepository{db: tx, log: r.log}
+}
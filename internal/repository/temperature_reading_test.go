@@ -0,0 +1,141 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+	"github.com/maxwelbm/alkemy-g7.git/internal/repository"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTemperatureReadingRepository_BulkInsert(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	defer db.Close()
+
+	rp := repository.NewTemperatureReadingRepository(db, logMock)
+
+	recordedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	readings := []model.TemperatureReading{
+		{SensorID: "A1", Temperature: -18.5, RecordedAt: recordedAt},
+		{SensorID: "A2", Temperature: -18.1, RecordedAt: recordedAt.Add(time.Minute)},
+	}
+	query := "INSERT INTO `temperature_readings` (`section_id`, `sensor_id`, `temperature`, `recorded_at`) VALUES (?, ?, ?, ?), (?, ?, ?, ?)"
+
+	t.Run("store every reading with a single statement", func(t *testing.T) {
+		mock.ExpectExec(query).
+			WithArgs(1, "A1", -18.5, recordedAt, 1, "A2", -18.1, recordedAt.Add(time.Minute)).
+			WillReturnResult(sqlmock.NewResult(1, 2))
+
+		inserted, err := rp.BulkInsert(context.Background(), 1, readings)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, inserted)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("return error when the insert fails", func(t *testing.T) {
+		mock.ExpectExec(query).WillReturnError(errors.New("unmapped error"))
+
+		inserted, err := rp.BulkInsert(context.Background(), 1, readings)
+
+		assert.Error(t, err)
+		assert.Zero(t, inserted)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestTemperatureReadingRepository_GetLatestRecordedAt(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	defer db.Close()
+
+	rp := repository.NewTemperatureReadingRepository(db, logMock)
+
+	query := "SELECT MAX(`recorded_at`) FROM `temperature_readings` WHERE `section_id` = ?"
+	recordedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	t.Run("return the time of the most recent reading", func(t *testing.T) {
+		mock.ExpectQuery(query).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"MAX(`recorded_at`)"}).AddRow(recordedAt))
+
+		latest, err := rp.GetLatestRecordedAt(context.Background(), 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, recordedAt, latest)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("return the zero time for a section without readings", func(t *testing.T) {
+		mock.ExpectQuery(query).WithArgs(2).WillReturnRows(sqlmock.NewRows([]string{"MAX(`recorded_at`)"}).AddRow(nil))
+
+		latest, err := rp.GetLatestRecordedAt(context.Background(), 2)
+
+		assert.NoError(t, err)
+		assert.True(t, latest.IsZero())
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("return error when the query fails", func(t *testing.T) {
+		mock.ExpectQuery(query).WithArgs(1).WillReturnError(errors.New("unmapped error"))
+
+		_, err := rp.GetLatestRecordedAt(context.Background(), 1)
+
+		assert.Error(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestTemperatureReadingRepository_GetAggregates(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	defer db.Close()
+
+	rp := repository.NewTemperatureReadingRepository(db, logMock)
+
+	query := "SELECT TIMESTAMP('1970-01-01') + INTERVAL FLOOR(TIMESTAMPDIFF(SECOND, '1970-01-01', `recorded_at`) / ?) * ? SECOND AS period_start, " +
+		"MIN(`temperature`), MAX(`temperature`), AVG(`temperature`), COUNT(*) " +
+		"FROM `temperature_readings` " +
+		"WHERE `section_id` = ? AND `recorded_at` >= ? AND `recorded_at` < ? " +
+		"GROUP BY period_start ORDER BY period_start"
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(24 * time.Hour)
+
+	t.Run("return the statistics per interval", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"period_start", "MIN(`temperature`)", "MAX(`temperature`)", "AVG(`temperature`)", "COUNT(*)"}).
+			AddRow(from, -19.0, -17.0, -18.0, 4).
+			AddRow(from.Add(15*time.Minute), -18.5, -18.5, -18.5, 1)
+		mock.ExpectQuery(query).WithArgs(int64(900), int64(900), 1, from, to).WillReturnRows(rows)
+
+		aggregates, err := rp.GetAggregates(context.Background(), model.TemperatureQuery{SectionID: 1, From: from, To: to, Interval: 15 * time.Minute})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []model.TemperatureAggregate{
+			{PeriodStart: from, MinTemperature: -19.0, MaxTemperature: -17.0, AvgTemperature: -18.0, ReadingsCount: 4},
+			{PeriodStart: from.Add(15 * time.Minute), MinTemperature: -18.5, MaxTemperature: -18.5, AvgTemperature: -18.5, ReadingsCount: 1},
+		}, aggregates)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("return error when the query fails", func(t *testing.T) {
+		mock.ExpectQuery(query).WillReturnError(errors.New("unmapped error"))
+
+		aggregates, err := rp.GetAggregates(context.Background(), model.TemperatureQuery{SectionID: 1, From: from, To: to, Interval: time.Hour})
+
+		assert.Error(t, err)
+		assert.Nil(t, aggregates)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/maxwelbm/alkemy-g7.git/pkg/logger"
+)
+
+// DBTX is the set of operations shared by *sql.DB and *sql.Tx, so a repository
+// can run against the connection pool or inside a unit of work.
+type DBTX interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	PrepareContext(ctx context.Context, query string) (*sql.Stmt, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+type UnitOfWork struct {
+	db  *sql.DB
+	log logger.Logger
+}
+
+func NewUnitOfWork(db *sql.DB, log logger.Logger) *UnitOfWork {
+	return &UnitOfWork{db: db, log: log}
+}
+
+// Do runs fn inside a single transaction. The transaction is committed when fn
+// returns nil and rolled back when it returns an error or panics.
+func (u *UnitOfWork) Do(ctx context.Context, fn func(tx *sql.Tx) error) (err error) {
+	u.log.Log("UnitOfWork", "INFO", "initializing transaction")
+
+	tx, err := u.db.BeginTx(ctx, nil)
+
+	if err != nil {
+		u.log.Log("UnitOfWork", "ERROR", fmt.Sprintf("Error: %v", err))
+		return
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+
+			u.log.Log("UnitOfWork", "ERROR", fmt.Sprintf("transaction rolled back after panic: %v", p))
+			panic(p)
+		}
+	}()
+
+	if err = fn(tx); err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			u.log.Log("UnitOfWork", "ERROR", fmt.Sprintf("Error: %v", rbErr))
+		}
+
+		u.log.Log("UnitOfWork", "ERROR", fmt.Sprintf("transaction rolled back: %v", err))
+
+		return
+	}
+
+	if err = tx.Commit(); err != nil {
+		u.log.Log("UnitOfWork", "ERROR", fmt.Sprintf("Error: %v", err))
+		return
+	}
+
+	u.log.Log("UnitOfWork", "INFO", "transaction committed")
+
+	return
+}
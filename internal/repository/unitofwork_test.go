@@ -0,0 +1,86 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/maxwelbm/alkemy-g7.git/internal/repository"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitOfWork_Do(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	uow := repository.NewUnitOfWork(db, logMock)
+
+	t.Run("commits the transaction when every step succeeds", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec("DELETE FROM `sellers` WHERE `id` = ?").WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		err := uow.Do(context.Background(), func(tx *sql.Tx) error {
+			_, err := tx.Exec("DELETE FROM `sellers` WHERE `id` = ?", 1)
+			return err
+		})
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("rolls back the transaction when a step fails", func(t *testing.T) {
+		expectedErr := errors.New("step failed")
+
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+
+		err := uow.Do(context.Background(), func(tx *sql.Tx) error {
+			return expectedErr
+		})
+
+		assert.ErrorIs(t, err, expectedErr)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("rolls back the transaction and re-panics when a step panics", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+
+		assert.Panics(t, func() {
+			_ = uow.Do(context.Background(), func(tx *sql.Tx) error {
+				panic("unexpected")
+			})
+		})
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("returns the error when the transaction cannot begin", func(t *testing.T) {
+		mock.ExpectBegin().WillReturnError(errors.New("connection refused"))
+
+		err := uow.Do(context.Background(), func(tx *sql.Tx) error {
+			t.Fatal("step must not run without a transaction")
+			return nil
+		})
+
+		assert.Error(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("returns the error when the commit fails", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectCommit().WillReturnError(errors.New("commit failed"))
+
+		err := uow.Do(context.Background(), func(tx *sql.Tx) error {
+			return nil
+		})
+
+		assert.Error(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
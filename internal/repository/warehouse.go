@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
@@ -10,6 +11,7 @@ import (
 
 	"github.com/go-sql-driver/mysql"
 	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+	"github.com/maxwelbm/alkemy-g7.git/internal/repository/interfaces"
 	"github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
 )
 
@@ -18,22 +20,30 @@ func NewWareHouseRepository(db *sql.DB, log logger.Logger) *WarehouseMysql {
 }
 
 type WarehouseMysql struct {
-	db  *sql.DB
+	db  DBTX
 	log logger.Logger
 }
 
-func (r *WarehouseMysql) GetAllWareHouse() (w []model.WareHouse, err error) {
+const wareHouseColumns = "w.id, w.warehouse_code, w.address, w.telephone, w.minimum_capacity, w.minimum_temperature, w.latitude, w.longitude"
+
+func (r *WarehouseMysql) GetAllWareHouse(ctx context.Context, params model.ListParams) (w []model.WareHouse, total int, err error) {
 	r.log.Log("WareHouseRepository", "INFO", "initializing GetAllWareHouse function")
-	rows, err := r.db.Query("SELECT w.id, w.warehouse_code, w.address, w.telephone, w.minimum_capacity, w.minimum_temperature FROM warehouses w")
+
+	list := newListQuery(params, model.WareHouseListOptions)
+	query, args := list.selectQuery("SELECT " + wareHouseColumns + " FROM warehouses w")
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		r.log.Log("WareHouseRepository", "ERROR", fmt.Sprintf("Error: %v", err))
 
 		return
 	}
 
+	defer rows.Close()
+
 	for rows.Next() {
 		var warehouse model.WareHouse
-		err = rows.Scan(&warehouse.ID, &warehouse.WareHouseCode, &warehouse.Address, &warehouse.Telephone, &warehouse.MinimunCapacity, &warehouse.MinimunTemperature)
+		err = rows.Scan(&warehouse.ID, &warehouse.WareHouseCode, &warehouse.Address, &warehouse.Telephone, &warehouse.MinimunCapacity, &warehouse.MinimunTemperature, &warehouse.Latitude, &warehouse.Longitude)
 
 		if err != nil {
 			r.log.Log("WareHouseRepository", "ERROR", fmt.Sprintf("Error: %v", err))
@@ -50,18 +60,31 @@ func (r *WarehouseMysql) GetAllWareHouse() (w []model.WareHouse, err error) {
 
 		return
 	}
+
+	total = len(w)
+
+	if params.PageSize > 0 {
+		total, err = countRows(ctx, r.db, "SELECT COUNT(*) FROM warehouses w", list)
+
+		if err != nil {
+			r.log.Log("WareHouseRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+
+			return nil, 0, err
+		}
+	}
+
 	r.log.Log("WareHouseRepository", "INFO", "GetAllWareHouse completed successfully")
 
 	return
 }
 
-func (r *WarehouseMysql) GetByIDWareHouse(id int) (w model.WareHouse, err error) {
+func (r *WarehouseMysql) GetByIDWareHouse(ctx context.Context, id int) (w model.WareHouse, err error) {
 
 	r.log.Log("WareHouseRepository", "INFO", "initializing GetByIDWareHouse function")
 
-	row := r.db.QueryRow("SELECT w.id, w.warehouse_code, w.address, w.telephone, w.minimum_capacity, w.minimum_temperature FROM warehouses w WHERE w.id = ?", id)
+	row := r.db.QueryRowContext(ctx, "SELECT "+wareHouseColumns+" FROM warehouses w WHERE w.id = ?", id)
 
-	err = row.Scan(&w.ID, &w.WareHouseCode, &w.Address, &w.Telephone, &w.MinimunCapacity, &w.MinimunTemperature)
+	err = row.Scan(&w.ID, &w.WareHouseCode, &w.Address, &w.Telephone, &w.MinimunCapacity, &w.MinimunTemperature, &w.Latitude, &w.Longitude)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			r.log.Log("WareHouseRepository", "ERROR", fmt.Sprintf("Error: %v", err))
@@ -73,12 +96,13 @@ func (r *WarehouseMysql) GetByIDWareHouse(id int) (w model.WareHouse, err error)
 	return
 }
 
-func (r *WarehouseMysql) PostWareHouse(warehouse model.WareHouse) (id int64, err error) {
+func (r *WarehouseMysql) PostWareHouse(ctx context.Context, warehouse model.WareHouse) (id int64, err error) {
 	r.log.Log("WareHouseRepository", "INFO", "initializing PostWareHouse function")
 
-	result, err := r.db.Exec(
-		"INSERT INTO `warehouses` (`warehouse_code`, `address`, `telephone`, `minimum_capacity`, `minimum_temperature`) VALUES (?, ?, ?, ?, ?)",
-		warehouse.WareHouseCode, warehouse.Address, warehouse.Telephone, warehouse.MinimunCapacity, warehouse.MinimunTemperature,
+	result, err := r.db.ExecContext(
+		ctx,
+		"INSERT INTO `warehouses` (`warehouse_code`, `address`, `telephone`, `minimum_capacity`, `minimum_temperature`, `latitude`, `longitude`) VALUES (?, ?, ?, ?, ?, ?, ?)",
+		warehouse.WareHouseCode, warehouse.Address, warehouse.Telephone, warehouse.MinimunCapacity, warehouse.MinimunTemperature, warehouse.Latitude, warehouse.Longitude,
 	)
 
 	if err != nil {
@@ -109,12 +133,13 @@ func (r *WarehouseMysql) PostWareHouse(warehouse model.WareHouse) (id int64, err
 	return
 }
 
-func (r *WarehouseMysql) UpdateWareHouse(id int, warehouse model.WareHouse) (err error) {
+func (r *WarehouseMysql) UpdateWareHouse(ctx context.Context, id int, warehouse model.WareHouse) (err error) {
 	r.log.Log("WareHouseRepository", "INFO", "initializing UpdateWareHouse function")
 
-	_, err = r.db.Exec(
-		"UPDATE warehouses w SET w.warehouse_code = ?, w.address = ?, w.telephone = ?, w.minimum_capacity = ?, w.minimum_temperature = ? WHERE w.id = ?",
-		warehouse.WareHouseCode, warehouse.Address, warehouse.Telephone, warehouse.MinimunCapacity, warehouse.MinimunTemperature, warehouse.ID,
+	_, err = r.db.ExecContext(
+		ctx,
+		"UPDATE warehouses w SET w.warehouse_code = ?, w.address = ?, w.telephone = ?, w.minimum_capacity = ?, w.minimum_temperature = ?, w.latitude = ?, w.longitude = ? WHERE w.id = ?",
+		warehouse.WareHouseCode, warehouse.Address, warehouse.Telephone, warehouse.MinimunCapacity, warehouse.MinimunTemperature, warehouse.Latitude, warehouse.Longitude, warehouse.ID,
 	)
 	if err != nil {
 		var mysqlErr *mysql.MySQLError
@@ -135,10 +160,10 @@ func (r *WarehouseMysql) UpdateWareHouse(id int, warehouse model.WareHouse) (err
 	return
 }
 
-func (r *WarehouseMysql) DeleteByIDWareHouse(id int) (err error) {
+func (r *WarehouseMysql) DeleteByIDWareHouse(ctx context.Context, id int) (err error) {
 	r.log.Log("WareHouseRepository", "INFO", "initializing DeleteByIDWareHouse function")
 
-	_, err = r.db.Exec("DELETE FROM `warehouses` WHERE `id` = ?", id)
+	_, err = r.db.ExecContext(ctx, "DELETE FROM `warehouses` WHERE `id` = ?", id)
 
 	if err != nil {
 		r.log.Log("WareHouseRepository", "ERROR", fmt.Sprintf("Error: %v", err))
@@ -149,3 +174,176 @@ func (r *WarehouseMysql) DeleteByIDWareHouse(id int) (err error) {
 
 	return
 }
+
+// GetNearestWareHouses ranks the warehouses that have coordinates by great-circle distance to
+// params.Latitude and params.Longitude. Free capacity is counted per section, restricted to
+// params.ProductTypeID when set, and warehouses whose roomiest section cannot take
+// params.Quantity more units are left out.
+func (r *WarehouseMysql) GetNearestWareHouses(ctx context.Context, params model.NearestWareHouseParams) (w []model.NearestWareHouse, err error) {
+	r.log.Log("WareHouseRepository", "INFO", "initializing GetNearestWareHouses function")
+
+	query := "SELECT " + wareHouseColumns + "," +
+		" ST_Distance_Sphere(POINT(w.longitude, w.latitude), POINT(?, ?)) / 1000 AS distance_km," +
+		" COALESCE(MAX(s.maximum_capacity - s.current_capacity), 0) AS available_capacity" +
+		" FROM warehouses w LEFT JOIN sections s ON s.warehouse_id = w.id"
+	args := []any{*params.Longitude, *params.Latitude}
+
+	if params.ProductTypeID > 0 {
+		query += " AND s.product_type_id = ?"
+		args = append(args, params.ProductTypeID)
+	}
+
+	query += " WHERE w.latitude IS NOT NULL AND w.longitude IS NOT NULL GROUP BY w.id"
+
+	if params.Quantity > 0 {
+		query += " HAVING available_capacity >= ?"
+		args = append(args, params.Quantity)
+	}
+
+	query += " ORDER BY distance_km, w.id LIMIT ?"
+	args = append(args, params.Limit)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		r.log.Log("WareHouseRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var n model.NearestWareHouse
+		err = rows.Scan(&n.ID, &n.WareHouseCode, &n.Address, &n.Telephone, &n.MinimunCapacity, &n.MinimunTemperature, &n.Latitude, &n.Longitude, &n.DistanceKm, &n.AvailableCapacity)
+
+		if err != nil {
+			r.log.Log("WareHouseRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+
+			return nil, err
+		}
+
+		w = append(w, n)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		r.log.Log("WareHouseRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return nil, err
+	}
+
+	r.log.Log("WareHouseRepository", "INFO", "GetNearestWareHouses completed successfully")
+
+	return
+}
+
+// GetInventoryWareHouse aggregates the batches with stock left in the warehouse per product and section,
+// ordered by product.
+func (r *WarehouseMysql) GetInventoryWareHouse(ctx context.Context, id int) (inventory []model.WareHouseInventoryRow, err error) {
+	r.log.Log("WareHouseRepository", "INFO", "initializing GetInventoryWareHouse function")
+
+	query := "SELECT p.id, p.product_code, p.description, s.id, s.section_number, SUM(pb.current_quantity), COUNT(pb.id), MIN(pb.due_date) " +
+		"FROM sections s " +
+		"INNER JOIN product_batches pb ON pb.section_id = s.id " +
+		"INNER JOIN products p ON p.id = pb.product_id " +
+		"WHERE s.warehouse_id = ? AND pb.current_quantity > 0 " +
+		"GROUP BY p.id, s.id " +
+		"ORDER BY p.id, s.id"
+
+	rows, err := r.db.QueryContext(ctx, query, id)
+	if err != nil {
+		r.log.Log("WareHouseRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			row     model.WareHouseInventoryRow
+			dueDate sql.NullTime
+		)
+
+		err = rows.Scan(&row.ProductID, &row.ProductCode, &row.Description, &row.SectionID, &row.SectionNumber, &row.Quantity, &row.BatchesCount, &dueDate)
+		if err != nil {
+			r.log.Log("WareHouseRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+
+			return nil, err
+		}
+
+		if dueDate.Valid {
+			row.NearestDueDate = &dueDate.Time
+		}
+
+		inventory = append(inventory, row)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		r.log.Log("WareHouseRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return nil, err
+	}
+
+	r.log.Log("WareHouseRepository", "INFO", "GetInventoryWareHouse completed successfully")
+
+	return
+}
+
+// GetCapacityReportWareHouse adds up the section capacities of every warehouse, or only of the given one
+// when id is greater than zero. Warehouses without sections are reported with no capacity.
+func (r *WarehouseMysql) GetCapacityReportWareHouse(ctx context.Context, id int) (report []model.WareHouseCapacity, err error) {
+	r.log.Log("WareHouseRepository", "INFO", "initializing GetCapacityReportWareHouse function")
+
+	query := "SELECT w.id, w.warehouse_code, w.minimum_capacity, COUNT(s.id), COALESCE(SUM(s.maximum_capacity), 0), COALESCE(SUM(s.current_capacity), 0) " +
+		"FROM warehouses w LEFT JOIN sections s ON s.warehouse_id = w.id"
+	args := []any{}
+
+	if id > 0 {
+		query += " WHERE w.id = ?"
+		args = append(args, id)
+	}
+
+	query += " GROUP BY w.id ORDER BY w.id"
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		r.log.Log("WareHouseRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var c model.WareHouseCapacity
+
+		err = rows.Scan(&c.WarehouseID, &c.WarehouseCode, &c.MinimumCapacity, &c.SectionsCount, &c.TotalCapacity, &c.UsedCapacity)
+		if err != nil {
+			r.log.Log("WareHouseRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+
+			return nil, err
+		}
+
+		c.SetUtilization()
+
+		report = append(report, c)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		r.log.Log("WareHouseRepository", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return nil, err
+	}
+
+	r.log.Log("WareHouseRepository", "INFO", "GetCapacityReportWareHouse completed successfully")
+
+	return
+}
+
+// WithTx implements interfaces.IWarehouseRepo.
+func (r *WarehouseMysql) WithTx(tx *sql.Tx) interfaces.IWarehouseRepo {
+	return &WarehouseMysql{db: tx, log: r.log}
+}
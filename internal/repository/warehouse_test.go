@@ -1,9 +1,11 @@
 package repository_test
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/go-sql-driver/mysql"
@@ -43,23 +45,23 @@ func TestWarehouseMysql_GetAllWareHouse(t *testing.T) {
 			},
 		}
 
-		rows := sqlmock.NewRows([]string{"id", "warehouse_code", "address", "telephone", "minimum_capacity", "minimum_temperature"}).
-			AddRow(expectedWarehouses[0].ID, expectedWarehouses[0].WareHouseCode, expectedWarehouses[0].Address, expectedWarehouses[0].Telephone, expectedWarehouses[0].MinimunCapacity, expectedWarehouses[0].MinimunTemperature).
-			AddRow(expectedWarehouses[1].ID, expectedWarehouses[1].WareHouseCode, expectedWarehouses[1].Address, expectedWarehouses[1].Telephone, expectedWarehouses[1].MinimunCapacity, expectedWarehouses[1].MinimunTemperature)
+		rows := sqlmock.NewRows([]string{"id", "warehouse_code", "address", "telephone", "minimum_capacity", "minimum_temperature", "latitude", "longitude"}).
+			AddRow(expectedWarehouses[0].ID, expectedWarehouses[0].WareHouseCode, expectedWarehouses[0].Address, expectedWarehouses[0].Telephone, expectedWarehouses[0].MinimunCapacity, expectedWarehouses[0].MinimunTemperature, nil, nil).
+			AddRow(expectedWarehouses[1].ID, expectedWarehouses[1].WareHouseCode, expectedWarehouses[1].Address, expectedWarehouses[1].Telephone, expectedWarehouses[1].MinimunCapacity, expectedWarehouses[1].MinimunTemperature, nil, nil)
 
-		expectedQuery := "SELECT w.id, w.warehouse_code, w.address, w.telephone, w.minimum_capacity, w.minimum_temperature FROM warehouses w"
+		expectedQuery := "SELECT w.id, w.warehouse_code, w.address, w.telephone, w.minimum_capacity, w.minimum_temperature, w.latitude, w.longitude FROM warehouses w ORDER BY w.id"
 		mock.ExpectQuery(expectedQuery).WillReturnRows(rows)
 
-		warehouses, err := rp.GetAllWareHouse()
+		warehouses, _, err := rp.GetAllWareHouse(context.Background(), model.ListParams{})
 
 		assert.NoError(t, err)
 		assert.Equal(t, expectedWarehouses, warehouses)
 	})
 	t.Run("Error GetAllWareHouse", func(t *testing.T) {
-		expectedQuery := "SELECT w.id, w.warehouse_code, w.address, w.telephone, w.minimum_capacity, w.minimum_temperature FROM warehouses w"
+		expectedQuery := "SELECT w.id, w.warehouse_code, w.address, w.telephone, w.minimum_capacity, w.minimum_temperature, w.latitude, w.longitude FROM warehouses w ORDER BY w.id"
 		mock.ExpectQuery(expectedQuery).WillReturnError(errors.New("database error"))
 
-		warehouses, err := rp.GetAllWareHouse()
+		warehouses, _, err := rp.GetAllWareHouse(context.Background(), model.ListParams{})
 
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "database error")
@@ -67,12 +69,12 @@ func TestWarehouseMysql_GetAllWareHouse(t *testing.T) {
 	})
 
 	t.Run("Empty Result GetAllWareHouse", func(t *testing.T) {
-		rows := sqlmock.NewRows([]string{"id", "warehouse_code", "address", "telephone", "minimum_capacity", "minimum_temperature"})
+		rows := sqlmock.NewRows([]string{"id", "warehouse_code", "address", "telephone", "minimum_capacity", "minimum_temperature", "latitude", "longitude"})
 
-		expectedQuery := "SELECT w.id, w.warehouse_code, w.address, w.telephone, w.minimum_capacity, w.minimum_temperature FROM warehouses w"
+		expectedQuery := "SELECT w.id, w.warehouse_code, w.address, w.telephone, w.minimum_capacity, w.minimum_temperature, w.latitude, w.longitude FROM warehouses w ORDER BY w.id"
 		mock.ExpectQuery(expectedQuery).WillReturnRows(rows)
 
-		warehouses, err := rp.GetAllWareHouse()
+		warehouses, _, err := rp.GetAllWareHouse(context.Background(), model.ListParams{})
 
 		assert.NoError(t, err)
 		assert.Empty(t, warehouses)
@@ -98,25 +100,25 @@ func TestWarehouseMysql_GetByIDWareHouse(t *testing.T) {
 			MinimunTemperature: 20,
 		}
 
-		expectedQuery := "SELECT w.id, w.warehouse_code, w.address, w.telephone, w.minimum_capacity, w.minimum_temperature FROM warehouses w WHERE w.id = ?"
+		expectedQuery := "SELECT w.id, w.warehouse_code, w.address, w.telephone, w.minimum_capacity, w.minimum_temperature, w.latitude, w.longitude FROM warehouses w WHERE w.id = ?"
 		mock.ExpectQuery(expectedQuery).
 			WithArgs(expectedWarehouse.ID).
-			WillReturnRows(sqlmock.NewRows([]string{"id", "warehouse_code", "address", "telephone", "minimum_capacity", "minimum_temperature"}).
-				AddRow(expectedWarehouse.ID, expectedWarehouse.WareHouseCode, expectedWarehouse.Address, expectedWarehouse.Telephone, expectedWarehouse.MinimunCapacity, expectedWarehouse.MinimunTemperature))
+			WillReturnRows(sqlmock.NewRows([]string{"id", "warehouse_code", "address", "telephone", "minimum_capacity", "minimum_temperature", "latitude", "longitude"}).
+				AddRow(expectedWarehouse.ID, expectedWarehouse.WareHouseCode, expectedWarehouse.Address, expectedWarehouse.Telephone, expectedWarehouse.MinimunCapacity, expectedWarehouse.MinimunTemperature, nil, nil))
 
-		warehouse, err := rp.GetByIDWareHouse(expectedWarehouse.ID)
+		warehouse, err := rp.GetByIDWareHouse(context.Background(), expectedWarehouse.ID)
 
 		assert.NoError(t, err)
 		assert.Equal(t, expectedWarehouse, warehouse)
 	})
 
 	t.Run("Error GetByIDWareHouse", func(t *testing.T) {
-		expectedQuery := "SELECT w.id, w.warehouse_code, w.address, w.telephone, w.minimum_capacity, w.minimum_temperature FROM warehouses w WHERE w.id = ?"
+		expectedQuery := "SELECT w.id, w.warehouse_code, w.address, w.telephone, w.minimum_capacity, w.minimum_temperature, w.latitude, w.longitude FROM warehouses w WHERE w.id = ?"
 		mock.ExpectQuery(expectedQuery).
 			WithArgs(1).
 			WillReturnError(errors.New("database error"))
 
-		warehouse, err := rp.GetByIDWareHouse(1)
+		warehouse, err := rp.GetByIDWareHouse(context.Background(), 1)
 
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "database error")
@@ -124,12 +126,12 @@ func TestWarehouseMysql_GetByIDWareHouse(t *testing.T) {
 	})
 
 	t.Run("NotFound GetByIDWareHouse", func(t *testing.T) {
-		expectedQuery := "SELECT w.id, w.warehouse_code, w.address, w.telephone, w.minimum_capacity, w.minimum_temperature FROM warehouses w WHERE w.id = ?"
+		expectedQuery := "SELECT w.id, w.warehouse_code, w.address, w.telephone, w.minimum_capacity, w.minimum_temperature, w.latitude, w.longitude FROM warehouses w WHERE w.id = ?"
 		mock.ExpectQuery(expectedQuery).
 			WithArgs(1).
 			WillReturnError(sql.ErrNoRows)
 
-		warehouse, err := rp.GetByIDWareHouse(1)
+		warehouse, err := rp.GetByIDWareHouse(context.Background(), 1)
 
 		assert.Error(t, err)
 		assert.IsType(t, &customerror.WareHouseError{}, err)
@@ -155,12 +157,12 @@ func TestWarehouseMysql_PostWareHouse(t *testing.T) {
 			MinimunTemperature: 20,
 		}
 
-		expectedQuery := "INSERT INTO `warehouses` (`warehouse_code`, `address`, `telephone`, `minimum_capacity`, `minimum_temperature`) VALUES (?, ?, ?, ?, ?)"
+		expectedQuery := "INSERT INTO `warehouses` (`warehouse_code`, `address`, `telephone`, `minimum_capacity`, `minimum_temperature`, `latitude`, `longitude`) VALUES (?, ?, ?, ?, ?, ?, ?)"
 		mock.ExpectExec(expectedQuery).
-			WithArgs(warehouse.WareHouseCode, warehouse.Address, warehouse.Telephone, warehouse.MinimunCapacity, warehouse.MinimunTemperature).
+			WithArgs(warehouse.WareHouseCode, warehouse.Address, warehouse.Telephone, warehouse.MinimunCapacity, warehouse.MinimunTemperature, warehouse.Latitude, warehouse.Longitude).
 			WillReturnResult(sqlmock.NewResult(1, 1))
 
-		id, err := rp.PostWareHouse(warehouse)
+		id, err := rp.PostWareHouse(context.Background(), warehouse)
 
 		assert.NoError(t, err)
 		assert.Equal(t, int64(1), id)
@@ -175,12 +177,12 @@ func TestWarehouseMysql_PostWareHouse(t *testing.T) {
 			MinimunTemperature: 20,
 		}
 
-		expectedQuery := "INSERT INTO `warehouses` (`warehouse_code`, `address`, `telephone`, `minimum_capacity`, `minimum_temperature`) VALUES (?, ?, ?, ?, ?)"
+		expectedQuery := "INSERT INTO `warehouses` (`warehouse_code`, `address`, `telephone`, `minimum_capacity`, `minimum_temperature`, `latitude`, `longitude`) VALUES (?, ?, ?, ?, ?, ?, ?)"
 		mock.ExpectExec(expectedQuery).
-			WithArgs(warehouse.WareHouseCode, warehouse.Address, warehouse.Telephone, warehouse.MinimunCapacity, warehouse.MinimunTemperature).
+			WithArgs(warehouse.WareHouseCode, warehouse.Address, warehouse.Telephone, warehouse.MinimunCapacity, warehouse.MinimunTemperature, warehouse.Latitude, warehouse.Longitude).
 			WillReturnError(errors.New("database error"))
 
-		id, err := rp.PostWareHouse(warehouse)
+		id, err := rp.PostWareHouse(context.Background(), warehouse)
 
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "database error")
@@ -196,13 +198,13 @@ func TestWarehouseMysql_PostWareHouse(t *testing.T) {
 			MinimunTemperature: 20,
 		}
 
-		expectedQuery := "INSERT INTO `warehouses` (`warehouse_code`, `address`, `telephone`, `minimum_capacity`, `minimum_temperature`) VALUES (?, ?, ?, ?, ?)"
+		expectedQuery := "INSERT INTO `warehouses` (`warehouse_code`, `address`, `telephone`, `minimum_capacity`, `minimum_temperature`, `latitude`, `longitude`) VALUES (?, ?, ?, ?, ?, ?, ?)"
 		mockErr := &mysql.MySQLError{Number: 1062}
 		mock.ExpectExec(expectedQuery).
-			WithArgs(warehouse.WareHouseCode, warehouse.Address, warehouse.Telephone, warehouse.MinimunCapacity, warehouse.MinimunTemperature).
+			WithArgs(warehouse.WareHouseCode, warehouse.Address, warehouse.Telephone, warehouse.MinimunCapacity, warehouse.MinimunTemperature, warehouse.Latitude, warehouse.Longitude).
 			WillReturnError(mockErr)
 
-		id, err := rp.PostWareHouse(warehouse)
+		id, err := rp.PostWareHouse(context.Background(), warehouse)
 
 		assert.Error(t, err)
 		assert.IsType(t, &customerror.WareHouseError{}, err)
@@ -230,12 +232,12 @@ func TestWarehouseMysql_UpdateWareHouse(t *testing.T) {
 			MinimunTemperature: 20,
 		}
 
-		expectedQuery := "UPDATE warehouses w SET w.warehouse_code = ?, w.address = ?, w.telephone = ?, w.minimum_capacity = ?, w.minimum_temperature = ? WHERE w.id = ?"
+		expectedQuery := "UPDATE warehouses w SET w.warehouse_code = ?, w.address = ?, w.telephone = ?, w.minimum_capacity = ?, w.minimum_temperature = ?, w.latitude = ?, w.longitude = ? WHERE w.id = ?"
 		mock.ExpectExec(expectedQuery).
-			WithArgs(warehouse.WareHouseCode, warehouse.Address, warehouse.Telephone, warehouse.MinimunCapacity, warehouse.MinimunTemperature, warehouse.ID).
+			WithArgs(warehouse.WareHouseCode, warehouse.Address, warehouse.Telephone, warehouse.MinimunCapacity, warehouse.MinimunTemperature, warehouse.Latitude, warehouse.Longitude, warehouse.ID).
 			WillReturnResult(sqlmock.NewResult(1, 1))
 
-		err := rp.UpdateWareHouse(id, warehouse)
+		err := rp.UpdateWareHouse(context.Background(), id, warehouse)
 
 		assert.NoError(t, err)
 	})
@@ -251,12 +253,12 @@ func TestWarehouseMysql_UpdateWareHouse(t *testing.T) {
 			MinimunTemperature: 20,
 		}
 
-		expectedQuery := "UPDATE warehouses w SET w.warehouse_code = ?, w.address = ?, w.telephone = ?, w.minimum_capacity = ?, w.minimum_temperature = ? WHERE w.id = ?"
+		expectedQuery := "UPDATE warehouses w SET w.warehouse_code = ?, w.address = ?, w.telephone = ?, w.minimum_capacity = ?, w.minimum_temperature = ?, w.latitude = ?, w.longitude = ? WHERE w.id = ?"
 		mock.ExpectExec(expectedQuery).
-			WithArgs(warehouse.WareHouseCode, warehouse.Address, warehouse.Telephone, warehouse.MinimunCapacity, warehouse.MinimunTemperature, warehouse.ID).
+			WithArgs(warehouse.WareHouseCode, warehouse.Address, warehouse.Telephone, warehouse.MinimunCapacity, warehouse.MinimunTemperature, warehouse.Latitude, warehouse.Longitude, warehouse.ID).
 			WillReturnError(errors.New("database error"))
 
-		err := rp.UpdateWareHouse(id, warehouse)
+		err := rp.UpdateWareHouse(context.Background(), id, warehouse)
 
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "database error")
@@ -273,13 +275,13 @@ func TestWarehouseMysql_UpdateWareHouse(t *testing.T) {
 			MinimunTemperature: 20,
 		}
 
-		expectedQuery := "UPDATE warehouses w SET w.warehouse_code = ?, w.address = ?, w.telephone = ?, w.minimum_capacity = ?, w.minimum_temperature = ? WHERE w.id = ?"
+		expectedQuery := "UPDATE warehouses w SET w.warehouse_code = ?, w.address = ?, w.telephone = ?, w.minimum_capacity = ?, w.minimum_temperature = ?, w.latitude = ?, w.longitude = ? WHERE w.id = ?"
 		mockErr := &mysql.MySQLError{Number: 1062}
 		mock.ExpectExec(expectedQuery).
-			WithArgs(warehouse.WareHouseCode, warehouse.Address, warehouse.Telephone, warehouse.MinimunCapacity, warehouse.MinimunTemperature, warehouse.ID).
+			WithArgs(warehouse.WareHouseCode, warehouse.Address, warehouse.Telephone, warehouse.MinimunCapacity, warehouse.MinimunTemperature, warehouse.Latitude, warehouse.Longitude, warehouse.ID).
 			WillReturnError(mockErr)
 
-		err := rp.UpdateWareHouse(id, warehouse)
+		err := rp.UpdateWareHouse(context.Background(), id, warehouse)
 
 		assert.Error(t, err)
 		assert.IsType(t, &customerror.WareHouseError{}, err)
@@ -303,7 +305,7 @@ func TestWarehouseMysql_DeleteByIDWareHouse(t *testing.T) {
 			WithArgs(id).
 			WillReturnResult(sqlmock.NewResult(1, 1))
 
-		err := rp.DeleteByIDWareHouse(id)
+		err := rp.DeleteByIDWareHouse(context.Background(), id)
 
 		assert.NoError(t, err)
 	})
@@ -316,9 +318,163 @@ func TestWarehouseMysql_DeleteByIDWareHouse(t *testing.T) {
 			WithArgs(id).
 			WillReturnError(errors.New("database error"))
 
-		err := rp.DeleteByIDWareHouse(id)
+		err := rp.DeleteByIDWareHouse(context.Background(), id)
 
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "database error")
 	})
 }
+
+func TestWarehouseMysql_GetNearestWareHouses(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	defer db.Close()
+
+	rp := repository.NewWareHouseRepository(db, logMock)
+	latitude, longitude := -34.6037, -58.3816
+
+	t.Run("Success GetNearestWareHouses", func(t *testing.T) {
+		params := model.NearestWareHouseParams{Latitude: &latitude, Longitude: &longitude, ProductTypeID: 2, Quantity: 50, Limit: 10}
+
+		expectedQuery := "SELECT w.id, w.warehouse_code, w.address, w.telephone, w.minimum_capacity, w.minimum_temperature, w.latitude, w.longitude," +
+			" ST_Distance_Sphere(POINT(w.longitude, w.latitude), POINT(?, ?)) / 1000 AS distance_km," +
+			" COALESCE(MAX(s.maximum_capacity - s.current_capacity), 0) AS available_capacity" +
+			" FROM warehouses w LEFT JOIN sections s ON s.warehouse_id = w.id AND s.product_type_id = ?" +
+			" WHERE w.latitude IS NOT NULL AND w.longitude IS NOT NULL GROUP BY w.id HAVING available_capacity >= ?" +
+			" ORDER BY distance_km, w.id LIMIT ?"
+
+		rows := sqlmock.NewRows([]string{"id", "warehouse_code", "address", "telephone", "minimum_capacity", "minimum_temperature", "latitude", "longitude", "distance_km", "available_capacity"}).
+			AddRow(1, "WH001", "123 Main St", "1234567890", 100, 20, "-34.600000", "-58.380000", 0.43, 80)
+
+		mock.ExpectQuery(expectedQuery).
+			WithArgs(longitude, latitude, 2, 50, 10).
+			WillReturnRows(rows)
+
+		warehouses, err := rp.GetNearestWareHouses(context.Background(), params)
+
+		assert.NoError(t, err)
+		assert.Len(t, warehouses, 1)
+		assert.Equal(t, "WH001", warehouses[0].WareHouseCode)
+		assert.Equal(t, -34.6, *warehouses[0].Latitude)
+		assert.Equal(t, 0.43, warehouses[0].DistanceKm)
+		assert.Equal(t, 80, warehouses[0].AvailableCapacity)
+	})
+
+	t.Run("Error GetNearestWareHouses", func(t *testing.T) {
+		params := model.NearestWareHouseParams{Latitude: &latitude, Longitude: &longitude, Limit: 5}
+
+		expectedQuery := "SELECT w.id, w.warehouse_code, w.address, w.telephone, w.minimum_capacity, w.minimum_temperature, w.latitude, w.longitude," +
+			" ST_Distance_Sphere(POINT(w.longitude, w.latitude), POINT(?, ?)) / 1000 AS distance_km," +
+			" COALESCE(MAX(s.maximum_capacity - s.current_capacity), 0) AS available_capacity" +
+			" FROM warehouses w LEFT JOIN sections s ON s.warehouse_id = w.id" +
+			" WHERE w.latitude IS NOT NULL AND w.longitude IS NOT NULL GROUP BY w.id" +
+			" ORDER BY distance_km, w.id LIMIT ?"
+
+		mock.ExpectQuery(expectedQuery).
+			WithArgs(longitude, latitude, 5).
+			WillReturnError(errors.New("database error"))
+
+		warehouses, err := rp.GetNearestWareHouses(context.Background(), params)
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "database error")
+		assert.Nil(t, warehouses)
+	})
+}
+
+func TestWarehouseMysql_GetInventoryWareHouse(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	defer db.Close()
+
+	rp := repository.NewWareHouseRepository(db, logMock)
+
+	expectedQuery := "SELECT p.id, p.product_code, p.description, s.id, s.section_number, SUM(pb.current_quantity), COUNT(pb.id), MIN(pb.due_date) " +
+		"FROM sections s " +
+		"INNER JOIN product_batches pb ON pb.section_id = s.id " +
+		"INNER JOIN products p ON p.id = pb.product_id " +
+		"WHERE s.warehouse_id = ? AND pb.current_quantity > 0 " +
+		"GROUP BY p.id, s.id " +
+		"ORDER BY p.id, s.id"
+
+	t.Run("Success GetInventoryWareHouse", func(t *testing.T) {
+		dueDate := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+
+		rows := sqlmock.NewRows([]string{"id", "product_code", "description", "id", "section_number", "quantity", "batches_count", "due_date"}).
+			AddRow(1, "P001", "Milk", 3, "S3", 120, 2, dueDate).
+			AddRow(2, "P002", "Cheese", 3, "S3", 40, 1, nil)
+
+		mock.ExpectQuery(expectedQuery).WithArgs(1).WillReturnRows(rows)
+
+		inventory, err := rp.GetInventoryWareHouse(context.Background(), 1)
+
+		expected := []model.WareHouseInventoryRow{
+			{ProductID: 1, ProductCode: "P001", Description: "Milk", SectionID: 3, SectionNumber: "S3", Quantity: 120, BatchesCount: 2, NearestDueDate: &dueDate},
+			{ProductID: 2, ProductCode: "P002", Description: "Cheese", SectionID: 3, SectionNumber: "S3", Quantity: 40, BatchesCount: 1},
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, inventory)
+	})
+
+	t.Run("Error GetInventoryWareHouse", func(t *testing.T) {
+		mock.ExpectQuery(expectedQuery).WithArgs(1).WillReturnError(errors.New("database error"))
+
+		inventory, err := rp.GetInventoryWareHouse(context.Background(), 1)
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "database error")
+		assert.Nil(t, inventory)
+	})
+}
+
+func TestWarehouseMysql_GetCapacityReportWareHouse(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+
+	defer db.Close()
+
+	rp := repository.NewWareHouseRepository(db, logMock)
+
+	t.Run("Success GetCapacityReportWareHouse", func(t *testing.T) {
+		expectedQuery := "SELECT w.id, w.warehouse_code, w.minimum_capacity, COUNT(s.id), COALESCE(SUM(s.maximum_capacity), 0), COALESCE(SUM(s.current_capacity), 0) " +
+			"FROM warehouses w LEFT JOIN sections s ON s.warehouse_id = w.id GROUP BY w.id ORDER BY w.id"
+
+		rows := sqlmock.NewRows([]string{"id", "warehouse_code", "minimum_capacity", "sections_count", "total_capacity", "used_capacity"}).
+			AddRow(1, "WH001", 100, 2, 200, 50).
+			AddRow(2, "WH002", 50, 0, 0, 0)
+
+		mock.ExpectQuery(expectedQuery).WillReturnRows(rows)
+
+		report, err := rp.GetCapacityReportWareHouse(context.Background(), 0)
+
+		expected := []model.WareHouseCapacity{
+			{WarehouseID: 1, WarehouseCode: "WH001", MinimumCapacity: 100, SectionsCount: 2, TotalCapacity: 200, UsedCapacity: 50, AvailableCapacity: 150, Utilization: 0.25},
+			{WarehouseID: 2, WarehouseCode: "WH002", MinimumCapacity: 50, BelowMinimumCapacity: true},
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, report)
+	})
+
+	t.Run("Error GetCapacityReportWareHouse", func(t *testing.T) {
+		expectedQuery := "SELECT w.id, w.warehouse_code, w.minimum_capacity, COUNT(s.id), COALESCE(SUM(s.maximum_capacity), 0), COALESCE(SUM(s.current_capacity), 0) " +
+			"FROM warehouses w LEFT JOIN sections s ON s.warehouse_id = w.id WHERE w.id = ? GROUP BY w.id ORDER BY w.id"
+
+		mock.ExpectQuery(expectedQuery).WithArgs(1).WillReturnError(errors.New("database error"))
+
+		report, err := rp.GetCapacityReportWareHouse(context.Background(), 1)
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "database error")
+		assert.Nil(t, report)
+	})
+}
@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"fmt"
 	"github.com/maxwelbm/alkemy-g7.git/internal/model"
 	"github.com/maxwelbm/alkemy-g7.git/internal/repository/interfaces"
@@ -16,19 +17,19 @@ func NewBuyerService(rp interfaces.IBuyerRepo, log logger.Logger) *BuyerService
 	return &BuyerService{Rp: rp, log: log}
 }
 
-func (bs *BuyerService) GetAllBuyer() (buyers []model.Buyer, err error) {
+func (bs *BuyerService) GetAllBuyer(ctx context.Context, params model.ListParams) (buyers []model.Buyer, total int, err error) {
 	bs.log.Log("BuyerService", "INFO", "initializing Get function")
-	return bs.Rp.Get()
+	return bs.Rp.Get(ctx, params)
 }
 
-func (bs *BuyerService) GetBuyerByID(id int) (buyer model.Buyer, err error) {
+func (bs *BuyerService) GetBuyerByID(ctx context.Context, id int) (buyer model.Buyer, err error) {
 	bs.log.Log("BuyerService", "INFO", fmt.Sprintf("initializing GetByID function with parameter: %d", id))
-	return bs.Rp.GetByID(id)
+	return bs.Rp.GetByID(ctx, id)
 }
 
-func (bs *BuyerService) DeleteBuyerByID(id int) (err error) {
+func (bs *BuyerService) DeleteBuyerByID(ctx context.Context, id int) (err error) {
 	bs.log.Log("BuyerService", "INFO", fmt.Sprintf("initializing DeleteBuyerID function with parameter: %d", id))
-	_, err = bs.GetBuyerByID(id)
+	_, err = bs.GetBuyerByID(ctx, id)
 
 	if err != nil {
 		bs.log.Log("BuyerService", "ERROR", fmt.Sprintf("Error: %v", err))
@@ -37,12 +38,12 @@ func (bs *BuyerService) DeleteBuyerByID(id int) (err error) {
 
 	bs.log.Log("BuyerService", "INFO", "Return in repository successful")
 
-	return bs.Rp.Delete(id)
+	return bs.Rp.Delete(ctx, id)
 }
 
-func (bs *BuyerService) CreateBuyer(newBuyer model.Buyer) (buyer model.Buyer, err error) {
+func (bs *BuyerService) CreateBuyer(ctx context.Context, newBuyer model.Buyer) (buyer model.Buyer, err error) {
 	bs.log.Log("BuyerService", "INFO", fmt.Sprintf("initializing CreateBuyer function with parameter: %v", newBuyer))
-	id, err := bs.Rp.Post(newBuyer)
+	id, err := bs.Rp.Post(ctx, newBuyer)
 
 	if err != nil {
 		bs.log.Log("BuyerService", "ERROR", fmt.Sprintf("Error: %v", err))
@@ -50,16 +51,16 @@ func (bs *BuyerService) CreateBuyer(newBuyer model.Buyer) (buyer model.Buyer, er
 	}
 
 	bs.log.Log("BuyerService", "INFO", fmt.Sprintf("Searching for Buyer created with ID: %v", id))
-	buyer, err = bs.GetBuyerByID(int(id))
+	buyer, err = bs.GetBuyerByID(ctx, int(id))
 
 	bs.log.Log("BuyerService", "INFO", fmt.Sprintf("Create Buyer successful: %v", buyer))
 
 	return
 }
 
-func (bs *BuyerService) UpdateBuyer(id int, newBuyer model.Buyer) (buyer model.Buyer, err error) {
+func (bs *BuyerService) UpdateBuyer(ctx context.Context, id int, newBuyer model.Buyer) (buyer model.Buyer, err error) {
 	bs.log.Log("BuyerService", "INFO", fmt.Sprintf("initializing UpdateBuyer function with parameter: %v And ID: %d", newBuyer, id))
-	existingBuyer, err := bs.GetBuyerByID(id)
+	existingBuyer, err := bs.GetBuyerByID(ctx, id)
 
 	if err != nil {
 		bs.log.Log("BuyerService", "ERROR", fmt.Sprintf("Error: %v", err))
@@ -78,30 +79,30 @@ func (bs *BuyerService) UpdateBuyer(id int, newBuyer model.Buyer) (buyer model.B
 		existingBuyer.LastName = newBuyer.LastName
 	}
 
-	err = bs.Rp.Update(existingBuyer.ID, existingBuyer)
+	err = bs.Rp.Update(ctx, existingBuyer.ID, existingBuyer)
 
 	if err != nil {
 		bs.log.Log("BuyerService", "ERROR", fmt.Sprintf("Error: %v", err))
 		return
 	}
 
-	buyer, err = bs.GetBuyerByID(id)
+	buyer, err = bs.GetBuyerByID(ctx, id)
 	bs.log.Log("BuyerService", "INFO", fmt.Sprintf("return buyer updated: %v", buyer))
 
 	return
 }
 
-func (bs *BuyerService) CountPurchaseOrderByBuyerID(id int) (countBuyerPurchaseOrder model.BuyerPurchaseOrder, err error) {
+func (bs *BuyerService) CountPurchaseOrderByBuyerID(ctx context.Context, id int) (countBuyerPurchaseOrder model.BuyerPurchaseOrder, err error) {
 	bs.log.Log("BuyerService", "INFO", fmt.Sprintf("initializing CountPurchaseOrderByBuyerID function with parameter ID: %d", id))
-	countBuyerPurchaseOrder, err = bs.Rp.CountPurchaseOrderByBuyerID(id)
+	countBuyerPurchaseOrder, err = bs.Rp.CountPurchaseOrderByBuyerID(ctx, id)
 	bs.log.Log("BuyerService", "INFO", fmt.Sprintf("return CountPurchaseOrderByBuyerIDeBuyer: %d successful", id))
 
 	return
 }
 
-func (bs *BuyerService) CountPurchaseOrderBuyer() (countBuyerPurchaseOrder []model.BuyerPurchaseOrder, err error) {
+func (bs *BuyerService) CountPurchaseOrderBuyer(ctx context.Context) (countBuyerPurchaseOrder []model.BuyerPurchaseOrder, err error) {
 	bs.log.Log("BuyerService", "INFO", "initializing CountPurchaseOrderBuyer function")
-	countBuyerPurchaseOrder, err = bs.Rp.CountPurchaseOrderBuyers()
+	countBuyerPurchaseOrder, err = bs.Rp.CountPurchaseOrderBuyers(ctx)
 	bs.log.Log("BuyerService", "INFO", fmt.Sprintf("return CountPurchaseOrderBuyer: %v successful", countBuyerPurchaseOrder))
 
 	return
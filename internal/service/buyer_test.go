@@ -1,12 +1,14 @@
 package service_test
 
 import (
+	"context"
 	"errors"
 	"github.com/maxwelbm/alkemy-g7.git/internal/mocks"
 	"github.com/maxwelbm/alkemy-g7.git/internal/model"
 	"github.com/maxwelbm/alkemy-g7.git/internal/service"
 	"github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"net/http"
 	"testing"
 )
@@ -25,11 +27,13 @@ func TestGetAllBuyer(t *testing.T) {
 			{ID: 2, FirstName: "Ac", LastName: "Milan", CardNumberID: "4321"}}
 
 		mockRepo := svc.Rp.(*mocks.MockIBuyerRepo)
-		mockRepo.On("Get").Return(expectedBuyers, nil)
+		params := model.ListParams{Page: 1, PageSize: 2, Sort: "first_name"}
+		mockRepo.On("Get", mock.Anything, params).Return(expectedBuyers, 5, nil)
 
-		buyers, err := svc.GetAllBuyer()
+		buyers, total, err := svc.GetAllBuyer(context.Background(), params)
 
 		assert.Equal(t, expectedBuyers, buyers)
+		assert.Equal(t, 5, total)
 		assert.NoError(t, err)
 		mockRepo.AssertExpectations(t)
 	})
@@ -38,9 +42,9 @@ func TestGetAllBuyer(t *testing.T) {
 		svc := setup(t)
 
 		mockRepo := svc.Rp.(*mocks.MockIBuyerRepo)
-		mockRepo.On("Get").Return([]model.Buyer{}, errors.New("Unmapped error"))
+		mockRepo.On("Get", mock.Anything, mock.Anything).Return([]model.Buyer{}, 0, errors.New("Unmapped error"))
 
-		buyers, err := svc.GetAllBuyer()
+		buyers, _, err := svc.GetAllBuyer(context.Background(), model.ListParams{})
 
 		assert.Equal(t, []model.Buyer{}, buyers)
 		assert.Error(t, err)
@@ -57,9 +61,9 @@ func TestGetBuyerByID(t *testing.T) {
 		expectedBuyer := model.Buyer{ID: 2, FirstName: "Ac", LastName: "Milan", CardNumberID: "4321"}
 
 		mockRepo := svc.Rp.(*mocks.MockIBuyerRepo)
-		mockRepo.On("GetByID", 2).Return(expectedBuyer, nil)
+		mockRepo.On("GetByID", mock.Anything, 2).Return(expectedBuyer, nil)
 
-		buyer, err := svc.GetBuyerByID(2)
+		buyer, err := svc.GetBuyerByID(context.Background(), 2)
 
 		assert.Equal(t, expectedBuyer, buyer)
 		assert.NoError(t, err)
@@ -71,9 +75,9 @@ func TestGetBuyerByID(t *testing.T) {
 		expectedError := customerror.NewBuyerError(http.StatusNotFound, customerror.ErrNotFound.Error(), "Buyer")
 
 		mockRepo := svc.Rp.(*mocks.MockIBuyerRepo)
-		mockRepo.On("GetByID", 99).Return(model.Buyer{}, expectedError)
+		mockRepo.On("GetByID", mock.Anything, 99).Return(model.Buyer{}, expectedError)
 
-		buyer, err := svc.GetBuyerByID(99)
+		buyer, err := svc.GetBuyerByID(context.Background(), 99)
 
 		assert.Equal(t, model.Buyer{}, buyer)
 		assert.ErrorIs(t, err, expectedError)
@@ -85,9 +89,9 @@ func TestGetBuyerByID(t *testing.T) {
 		svc := setup(t)
 
 		mockRepo := svc.Rp.(*mocks.MockIBuyerRepo)
-		mockRepo.On("GetByID", 2).Return(model.Buyer{}, errors.New("unmapped error"))
+		mockRepo.On("GetByID", mock.Anything, 2).Return(model.Buyer{}, errors.New("unmapped error"))
 
-		buyer, err := svc.GetBuyerByID(2)
+		buyer, err := svc.GetBuyerByID(context.Background(), 2)
 
 		assert.Equal(t, model.Buyer{}, buyer)
 		assert.Error(t, err)
@@ -101,11 +105,11 @@ func TestCreateBuyer(t *testing.T) {
 
 		createdBuyer := model.Buyer{ID: 1, FirstName: "Ac", LastName: "Milan", CardNumberID: "4321"}
 		mockRepo := svc.Rp.(*mocks.MockIBuyerRepo)
-		mockRepo.On("Post", createdBuyer).Return(int64(1), nil)
+		mockRepo.On("Post", mock.Anything, createdBuyer).Return(int64(1), nil)
 
-		mockRepo.On("GetByID", 1).Return(createdBuyer, nil)
+		mockRepo.On("GetByID", mock.Anything, 1).Return(createdBuyer, nil)
 
-		buyer, err := svc.CreateBuyer(createdBuyer)
+		buyer, err := svc.CreateBuyer(context.Background(), createdBuyer)
 
 		assert.NoError(t, err)
 		assert.Equal(t, createdBuyer, buyer)
@@ -119,9 +123,9 @@ func TestCreateBuyer(t *testing.T) {
 		createdBuyer := model.Buyer{ID: 1, FirstName: "Ac", LastName: "Milan", CardNumberID: "4321"}
 
 		mockRepo := svc.Rp.(*mocks.MockIBuyerRepo)
-		mockRepo.On("Post", createdBuyer).Return(int64(0), expectedError)
+		mockRepo.On("Post", mock.Anything, createdBuyer).Return(int64(0), expectedError)
 
-		buyer, err := svc.CreateBuyer(createdBuyer)
+		buyer, err := svc.CreateBuyer(context.Background(), createdBuyer)
 
 		assert.Equal(t, model.Buyer{}, buyer)
 		assert.ErrorIs(t, err, expectedError)
@@ -136,9 +140,9 @@ func TestCreateBuyer(t *testing.T) {
 		createdBuyer := model.Buyer{ID: 1, FirstName: "Ac", LastName: "Milan", CardNumberID: "4321"}
 
 		mockRepo := svc.Rp.(*mocks.MockIBuyerRepo)
-		mockRepo.On("Post", createdBuyer).Return(int64(0), errors.New("unmapped Error"))
+		mockRepo.On("Post", mock.Anything, createdBuyer).Return(int64(0), errors.New("unmapped Error"))
 
-		buyer, err := svc.CreateBuyer(createdBuyer)
+		buyer, err := svc.CreateBuyer(context.Background(), createdBuyer)
 
 		assert.Equal(t, model.Buyer{}, buyer)
 		assert.Error(t, err)
@@ -151,12 +155,12 @@ func TestCreateBuyer(t *testing.T) {
 
 		createdBuyer := model.Buyer{ID: 1, FirstName: "Ac", LastName: "Milan", CardNumberID: "4321"}
 		mockRepo := svc.Rp.(*mocks.MockIBuyerRepo)
-		mockRepo.On("Post", createdBuyer).Return(int64(1), nil)
+		mockRepo.On("Post", mock.Anything, createdBuyer).Return(int64(1), nil)
 
 		expectedError := customerror.NewBuyerError(http.StatusNotFound, customerror.ErrNotFound.Error(), "Buyer")
-		mockRepo.On("GetByID", 1).Return(model.Buyer{}, expectedError)
+		mockRepo.On("GetByID", mock.Anything, 1).Return(model.Buyer{}, expectedError)
 
-		buyer, err := svc.CreateBuyer(createdBuyer)
+		buyer, err := svc.CreateBuyer(context.Background(), createdBuyer)
 
 		assert.ErrorIs(t, err, expectedError)
 		assert.Error(t, err)
@@ -172,11 +176,11 @@ func TestUpdateBuyer(t *testing.T) {
 		updatedBuyer := model.Buyer{ID: 1, FirstName: "Ac", LastName: "Milan", CardNumberID: "4321"}
 
 		mockRepo := svc.Rp.(*mocks.MockIBuyerRepo)
-		mockRepo.On("GetByID", 1).Return(updatedBuyer, nil)
+		mockRepo.On("GetByID", mock.Anything, 1).Return(updatedBuyer, nil)
 
-		mockRepo.On("Update", 1, updatedBuyer).Return(nil)
+		mockRepo.On("Update", mock.Anything, 1, updatedBuyer).Return(nil)
 
-		buyer, err := svc.UpdateBuyer(1, updatedBuyer)
+		buyer, err := svc.UpdateBuyer(context.Background(), 1, updatedBuyer)
 
 		assert.NoError(t, err)
 		assert.Equal(t, updatedBuyer, buyer)
@@ -190,9 +194,9 @@ func TestUpdateBuyer(t *testing.T) {
 		mockRepo := svc.Rp.(*mocks.MockIBuyerRepo)
 
 		expectedError := customerror.NewBuyerError(http.StatusNotFound, customerror.ErrNotFound.Error(), "Buyer")
-		mockRepo.On("GetByID", 99).Return(model.Buyer{}, expectedError)
+		mockRepo.On("GetByID", mock.Anything, 99).Return(model.Buyer{}, expectedError)
 
-		buyer, err := svc.UpdateBuyer(99, UpdateBuyer)
+		buyer, err := svc.UpdateBuyer(context.Background(), 99, UpdateBuyer)
 
 		assert.ErrorIs(t, err, expectedError)
 		assert.Error(t, err)
@@ -207,11 +211,11 @@ func TestUpdateBuyer(t *testing.T) {
 		updatedBuyer := model.Buyer{ID: 1, FirstName: "Ac", LastName: "Milan", CardNumberID: "4321"}
 
 		mockRepo := svc.Rp.(*mocks.MockIBuyerRepo)
-		mockRepo.On("GetByID", 1).Return(updatedBuyer, nil)
+		mockRepo.On("GetByID", mock.Anything, 1).Return(updatedBuyer, nil)
 
-		mockRepo.On("Update", 1, updatedBuyer).Return(expectedError)
+		mockRepo.On("Update", mock.Anything, 1, updatedBuyer).Return(expectedError)
 
-		buyer, err := svc.UpdateBuyer(1, updatedBuyer)
+		buyer, err := svc.UpdateBuyer(context.Background(), 1, updatedBuyer)
 
 		assert.Equal(t, model.Buyer{}, buyer)
 		assert.ErrorIs(t, err, expectedError)
@@ -226,11 +230,11 @@ func TestUpdateBuyer(t *testing.T) {
 		updatedBuyer := model.Buyer{ID: 1, FirstName: "Ac", LastName: "Milan", CardNumberID: "4321"}
 
 		mockRepo := svc.Rp.(*mocks.MockIBuyerRepo)
-		mockRepo.On("GetByID", 1).Return(updatedBuyer, nil)
+		mockRepo.On("GetByID", mock.Anything, 1).Return(updatedBuyer, nil)
 
-		mockRepo.On("Update", 1, updatedBuyer).Return(errors.New("unmapped error"))
+		mockRepo.On("Update", mock.Anything, 1, updatedBuyer).Return(errors.New("unmapped error"))
 
-		buyer, err := svc.UpdateBuyer(1, updatedBuyer)
+		buyer, err := svc.UpdateBuyer(context.Background(), 1, updatedBuyer)
 
 		assert.Equal(t, model.Buyer{}, buyer)
 		assert.Error(t, err)
@@ -246,11 +250,11 @@ func TestDeleteBuyerByID(t *testing.T) {
 
 		deletedBuyer := model.Buyer{ID: 1, FirstName: "Ac", LastName: "Milan", CardNumberID: "4321"}
 		mockRepo := svc.Rp.(*mocks.MockIBuyerRepo)
-		mockRepo.On("GetByID", 1).Return(deletedBuyer, nil)
+		mockRepo.On("GetByID", mock.Anything, 1).Return(deletedBuyer, nil)
 
-		mockRepo.On("Delete", 1).Return(nil)
+		mockRepo.On("Delete", mock.Anything, 1).Return(nil)
 
-		err := svc.DeleteBuyerByID(1)
+		err := svc.DeleteBuyerByID(context.Background(), 1)
 
 		assert.NoError(t, err)
 		mockRepo.AssertExpectations(t)
@@ -262,9 +266,9 @@ func TestDeleteBuyerByID(t *testing.T) {
 		mockRepo := svc.Rp.(*mocks.MockIBuyerRepo)
 
 		expectedError := customerror.NewBuyerError(http.StatusNotFound, customerror.ErrNotFound.Error(), "Buyer")
-		mockRepo.On("GetByID", 99).Return(model.Buyer{}, expectedError)
+		mockRepo.On("GetByID", mock.Anything, 99).Return(model.Buyer{}, expectedError)
 
-		err := svc.DeleteBuyerByID(99)
+		err := svc.DeleteBuyerByID(context.Background(), 99)
 
 		assert.ErrorIs(t, err, expectedError)
 		assert.Error(t, err)
@@ -276,12 +280,12 @@ func TestDeleteBuyerByID(t *testing.T) {
 
 		deletedBuyer := model.Buyer{ID: 1, FirstName: "Ac", LastName: "Milan", CardNumberID: "4321"}
 		mockRepo := svc.Rp.(*mocks.MockIBuyerRepo)
-		mockRepo.On("GetByID", 1).Return(deletedBuyer, nil)
+		mockRepo.On("GetByID", mock.Anything, 1).Return(deletedBuyer, nil)
 
 		expectedError := customerror.NewBuyerError(http.StatusConflict, customerror.ErrDependencies.Error(), "Buyer")
-		mockRepo.On("Delete", 1).Return(expectedError)
+		mockRepo.On("Delete", mock.Anything, 1).Return(expectedError)
 
-		err := svc.DeleteBuyerByID(1)
+		err := svc.DeleteBuyerByID(context.Background(), 1)
 
 		assert.ErrorIs(t, err, expectedError)
 		assert.Error(t, err)
@@ -293,11 +297,11 @@ func TestDeleteBuyerByID(t *testing.T) {
 
 		deletedBuyer := model.Buyer{ID: 1, FirstName: "Ac", LastName: "Milan", CardNumberID: "4321"}
 		mockRepo := svc.Rp.(*mocks.MockIBuyerRepo)
-		mockRepo.On("GetByID", 1).Return(deletedBuyer, nil)
+		mockRepo.On("GetByID", mock.Anything, 1).Return(deletedBuyer, nil)
 
-		mockRepo.On("Delete", 1).Return(errors.New("unmapped Error"))
+		mockRepo.On("Delete", mock.Anything, 1).Return(errors.New("unmapped Error"))
 
-		err := svc.DeleteBuyerByID(1)
+		err := svc.DeleteBuyerByID(context.Background(), 1)
 
 		assert.Error(t, err)
 		mockRepo.AssertExpectations(t)
@@ -317,9 +321,9 @@ func TestCountPurchaseOrderBuyer(t *testing.T) {
 		}}
 
 		mockRepo := svc.Rp.(*mocks.MockIBuyerRepo)
-		mockRepo.On("CountPurchaseOrderBuyers").Return(countPurchases, nil)
+		mockRepo.On("CountPurchaseOrderBuyers", mock.Anything).Return(countPurchases, nil)
 
-		count, err := svc.CountPurchaseOrderBuyer()
+		count, err := svc.CountPurchaseOrderBuyer(context.Background())
 
 		assert.NoError(t, err)
 		assert.Equal(t, count, countPurchases)
@@ -340,9 +344,9 @@ func TestCountPurchaseOrderByBuyerID(t *testing.T) {
 		}
 
 		mockRepo := svc.Rp.(*mocks.MockIBuyerRepo)
-		mockRepo.On("CountPurchaseOrderByBuyerID", countPurchase.ID).Return(countPurchase, nil)
+		mockRepo.On("CountPurchaseOrderByBuyerID", mock.Anything, countPurchase.ID).Return(countPurchase, nil)
 
-		count, err := svc.CountPurchaseOrderByBuyerID(countPurchase.ID)
+		count, err := svc.CountPurchaseOrderByBuyerID(context.Background(), countPurchase.ID)
 
 		assert.NoError(t, err)
 		assert.Equal(t, count, countPurchase)
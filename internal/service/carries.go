@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/maxwelbm/alkemy-g7.git/internal/model"
@@ -23,31 +24,82 @@ func NewCarrierService(rp interfaces.ICarriersRepo, svcLocality svc.ILocalitySer
 	}
 }
 
-func (cp *CarrierDefault) GetByID(id int) (carrier model.Carries, err error) {
+func (cp *CarrierDefault) GetByID(ctx context.Context, id int) (carrier model.Carries, err error) {
 	cp.log.Log("CarrierService", "INFO", "initializing GetByID function")
-	carrier, err = cp.Rp.GetByID(id)
+	carrier, err = cp.Rp.GetByID(ctx, id)
 	cp.log.Log("CarrierService", "INFO", "GetByIDCarrier completed successfully")
 	return
 }
 
-func (cp *CarrierDefault) PostCarrier(newCarrier model.Carries) (carrier model.Carries, err error) {
+func (cp *CarrierDefault) PostCarrier(ctx context.Context, newCarrier model.Carries) (carrier model.Carries, err error) {
 	cp.log.Log("CarrierService", "INFO", "initializing PostCarrier function")
-	_, err = cp.SvcLocality.GetByID(newCarrier.LocalityID)
+	_, err = cp.SvcLocality.GetByID(ctx, newCarrier.LocalityID)
 
 	if err != nil {
 		cp.log.Log("CarrierService", "ERROR", fmt.Sprintf("Error: %v", err))
 		return
 	}
 
-	id, err := cp.Rp.PostCarrier(newCarrier)
+	id, err := cp.Rp.PostCarrier(ctx, newCarrier)
 
 	if err != nil {
 		cp.log.Log("CarrierService", "ERROR", fmt.Sprintf("Error: %v", err))
 		return
 	}
 
-	carrier, err = cp.GetByID(int(id))
+	carrier, err = cp.GetByID(ctx, int(id))
 
 	cp.log.Log("CarrierService", "INFO", "PostCarrier completed successfully")
 	return
 }
+
+func (cp *CarrierDefault) Get(ctx context.Context, params model.ListParams) (carriers []model.Carries, total int, err error) {
+	cp.log.Log("CarrierService", "INFO", "initializing Get function")
+	carriers, total, err = cp.Rp.Get(ctx, params)
+
+	if err != nil {
+		cp.log.Log("CarrierService", "ERROR", fmt.Sprintf("Error: %v", err))
+		return
+	}
+
+	cp.log.Log("CarrierService", "INFO", "GetCarriers completed successfully")
+	return
+}
+
+// PatchCarrier replaces the fields of an existing carrier. The caller merges the changes into the current carrier.
+func (cp *CarrierDefault) PatchCarrier(ctx context.Context, id int, carrier model.Carries) (updated model.Carries, err error) {
+	cp.log.Log("CarrierService", "INFO", "initializing PatchCarrier function")
+
+	if _, err = cp.Rp.GetByID(ctx, id); err != nil {
+		cp.log.Log("CarrierService", "ERROR", fmt.Sprintf("Error: %v", err))
+		return
+	}
+
+	if _, err = cp.SvcLocality.GetByID(ctx, carrier.LocalityID); err != nil {
+		cp.log.Log("CarrierService", "ERROR", fmt.Sprintf("Error: %v", err))
+		return
+	}
+
+	if err = cp.Rp.PatchCarrier(ctx, id, carrier); err != nil {
+		cp.log.Log("CarrierService", "ERROR", fmt.Sprintf("Error: %v", err))
+		return
+	}
+
+	updated, err = cp.GetByID(ctx, id)
+
+	cp.log.Log("CarrierService", "INFO", "PatchCarrier completed successfully")
+	return
+}
+
+func (cp *CarrierDefault) DeleteCarrier(ctx context.Context, id int) (err error) {
+	cp.log.Log("CarrierService", "INFO", "initializing DeleteCarrier function")
+	err = cp.Rp.DeleteCarrier(ctx, id)
+
+	if err != nil {
+		cp.log.Log("CarrierService", "ERROR", fmt.Sprintf("Error: %v", err))
+		return
+	}
+
+	cp.log.Log("CarrierService", "INFO", "DeleteCarrier completed successfully")
+	return
+}
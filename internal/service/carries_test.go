@@ -1,6 +1,7 @@
 package service_test
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"testing"
@@ -10,6 +11,7 @@ import (
 	"github.com/maxwelbm/alkemy-g7.git/internal/service"
 	"github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestCarrierDefault_GetByID(t *testing.T) {
@@ -25,12 +27,12 @@ func TestCarrierDefault_GetByID(t *testing.T) {
 		}
 
 		mockRepo := mocks.NewMockICarriersRepo(t)
-		mockLocality := mocks.NewMockILocalityRepo(t)
+		mockLocality := mocks.NewMockILocalityService(t)
 		service := service.NewCarrierService(mockRepo, mockLocality, logMock)
 
-		mockRepo.On("GetByID", 1).Return(expectedCarries, nil)
+		mockRepo.On("GetByID", mock.Anything, 1).Return(expectedCarries, nil)
 
-		actual, err := service.GetByID(1)
+		actual, err := service.GetByID(context.Background(), 1)
 
 		assert.NoError(t, err)
 		assert.Equal(t, expectedCarries, actual)
@@ -39,12 +41,12 @@ func TestCarrierDefault_GetByID(t *testing.T) {
 	t.Run("Error GetByIDCarries", func(t *testing.T) {
 
 		mockRepo := mocks.NewMockICarriersRepo(t)
-		mockLocality := mocks.NewMockILocalityRepo(t)
+		mockLocality := mocks.NewMockILocalityService(t)
 		service := service.NewCarrierService(mockRepo, mockLocality, logMock)
 		expectedError := customerror.NewCarrierError(customerror.ErrNotFound.Error(), "carrier", http.StatusNotFound)
 
-		mockRepo.On("GetByID", 1).Return(model.Carries{}, expectedError)
-		actual, err := service.GetByID(1)
+		mockRepo.On("GetByID", mock.Anything, 1).Return(model.Carries{}, expectedError)
+		actual, err := service.GetByID(context.Background(), 1)
 
 		assert.ErrorIs(t, expectedError, err)
 
@@ -75,13 +77,13 @@ func TestCarrierDefault_PostCarrier(t *testing.T) {
 		service := service.NewCarrierService(mockRepo, mockLocality, logMock)
 
 		mockLocality = service.SvcLocality.(*mocks.MockILocalityService)
-		mockLocality.On("GetByID", expectedCarries.LocalityID).Return(expectedLocality, nil)
+		mockLocality.On("GetByID", mock.Anything, expectedCarries.LocalityID).Return(expectedLocality, nil)
 
-		mockRepo.On("PostCarrier", expectedCarries).Return(int64(1), nil)
+		mockRepo.On("PostCarrier", mock.Anything, expectedCarries).Return(int64(1), nil)
 
-		mockRepo.On("GetByID", expectedCarries.ID).Return(expectedCarries, nil)
+		mockRepo.On("GetByID", mock.Anything, expectedCarries.ID).Return(expectedCarries, nil)
 
-		actual, err := service.PostCarrier(expectedCarries)
+		actual, err := service.PostCarrier(context.Background(), expectedCarries)
 
 		assert.NoError(t, err)
 		assert.Equal(t, expectedCarries, actual)
@@ -101,9 +103,9 @@ func TestCarrierDefault_PostCarrier(t *testing.T) {
 		mockLocality := mocks.NewMockILocalityService(t)
 		service := service.NewCarrierService(mockRepo, mockLocality, logMock)
 
-		mockLocality.On("GetByID", expectedCarries.LocalityID).Return(model.Locality{}, errors.New("locality not found"))
+		mockLocality.On("GetByID", mock.Anything, expectedCarries.LocalityID).Return(model.Locality{}, errors.New("locality not found"))
 
-		actual, err := service.PostCarrier(expectedCarries)
+		actual, err := service.PostCarrier(context.Background(), expectedCarries)
 
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "locality not found")
@@ -131,10 +133,10 @@ func TestCarrierDefault_PostCarrier(t *testing.T) {
 		mockLocality := mocks.NewMockILocalityService(t)
 		service := service.NewCarrierService(mockRepo, mockLocality, logMock)
 
-		mockLocality.On("GetByID", expectedCarries.LocalityID).Return(expectedLocality, nil)
-		mockRepo.On("PostCarrier", expectedCarries).Return(int64(0), errors.New("failed to post carrier"))
+		mockLocality.On("GetByID", mock.Anything, expectedCarries.LocalityID).Return(expectedLocality, nil)
+		mockRepo.On("PostCarrier", mock.Anything, expectedCarries).Return(int64(0), errors.New("failed to post carrier"))
 
-		actual, err := service.PostCarrier(expectedCarries)
+		actual, err := service.PostCarrier(context.Background(), expectedCarries)
 
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "failed to post carrier")
@@ -162,11 +164,11 @@ func TestCarrierDefault_PostCarrier(t *testing.T) {
 		mockLocality := mocks.NewMockILocalityService(t)
 		service := service.NewCarrierService(mockRepo, mockLocality, logMock)
 
-		mockLocality.On("GetByID", expectedCarries.LocalityID).Return(expectedLocality, nil)
-		mockRepo.On("PostCarrier", expectedCarries).Return(int64(1), nil)
-		mockRepo.On("GetByID", expectedCarries.ID).Return(model.Carries{}, errors.New("carrier not found"))
+		mockLocality.On("GetByID", mock.Anything, expectedCarries.LocalityID).Return(expectedLocality, nil)
+		mockRepo.On("PostCarrier", mock.Anything, expectedCarries).Return(int64(1), nil)
+		mockRepo.On("GetByID", mock.Anything, expectedCarries.ID).Return(model.Carries{}, errors.New("carrier not found"))
 
-		actual, err := service.PostCarrier(expectedCarries)
+		actual, err := service.PostCarrier(context.Background(), expectedCarries)
 
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "carrier not found")
@@ -174,3 +176,110 @@ func TestCarrierDefault_PostCarrier(t *testing.T) {
 	})
 
 }
+
+func TestCarrierDefault_Get(t *testing.T) {
+	t.Run("Success GetCarriers", func(t *testing.T) {
+		expected := []model.Carries{{ID: 1, CID: "CID001", CompanyName: "ABC Company", Address: "123 Main St", Telephone: "1234567890", LocalityID: 1}}
+		params := model.ListParams{Page: 1, PageSize: 20, Sort: "id"}
+
+		mockRepo := mocks.NewMockICarriersRepo(t)
+		service := service.NewCarrierService(mockRepo, mocks.NewMockILocalityService(t), logMock)
+
+		mockRepo.On("Get", mock.Anything, params).Return(expected, 1, nil)
+
+		carriers, total, err := service.Get(context.Background(), params)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, total)
+		assert.Equal(t, expected, carriers)
+	})
+}
+
+func TestCarrierDefault_PatchCarrier(t *testing.T) {
+	carrier := model.Carries{ID: 1, CID: "CID001", CompanyName: "New Company", Address: "123 Main St", Telephone: "1234567890", LocalityID: 2}
+
+	t.Run("Success PatchCarrier", func(t *testing.T) {
+		mockRepo := mocks.NewMockICarriersRepo(t)
+		mockLocality := mocks.NewMockILocalityService(t)
+		service := service.NewCarrierService(mockRepo, mockLocality, logMock)
+
+		mockRepo.On("GetByID", mock.Anything, 1).Return(carrier, nil)
+		mockLocality.On("GetByID", mock.Anything, 2).Return(model.Locality{ID: 2}, nil)
+		mockRepo.On("PatchCarrier", mock.Anything, 1, carrier).Return(nil)
+
+		updated, err := service.PatchCarrier(context.Background(), 1, carrier)
+
+		assert.NoError(t, err)
+		assert.Equal(t, carrier, updated)
+	})
+
+	t.Run("Error PatchCarrier carrier not found", func(t *testing.T) {
+		mockRepo := mocks.NewMockICarriersRepo(t)
+		service := service.NewCarrierService(mockRepo, mocks.NewMockILocalityService(t), logMock)
+		expectedError := customerror.NewCarrierError(customerror.ErrNotFound.Error(), "carrier", http.StatusNotFound)
+
+		mockRepo.On("GetByID", mock.Anything, 99).Return(model.Carries{}, expectedError)
+
+		updated, err := service.PatchCarrier(context.Background(), 99, carrier)
+
+		assert.Equal(t, expectedError, err)
+		assert.Empty(t, updated)
+		mockRepo.AssertNotCalled(t, "PatchCarrier", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Error PatchCarrier locality not found", func(t *testing.T) {
+		mockRepo := mocks.NewMockICarriersRepo(t)
+		mockLocality := mocks.NewMockILocalityService(t)
+		service := service.NewCarrierService(mockRepo, mockLocality, logMock)
+
+		mockRepo.On("GetByID", mock.Anything, 1).Return(carrier, nil)
+		mockLocality.On("GetByID", mock.Anything, 2).Return(model.Locality{}, customerror.ErrLocalityNotFound)
+
+		updated, err := service.PatchCarrier(context.Background(), 1, carrier)
+
+		assert.ErrorIs(t, err, customerror.ErrLocalityNotFound)
+		assert.Empty(t, updated)
+		mockRepo.AssertNotCalled(t, "PatchCarrier", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Error PatchCarrier duplicated cid", func(t *testing.T) {
+		mockRepo := mocks.NewMockICarriersRepo(t)
+		mockLocality := mocks.NewMockILocalityService(t)
+		service := service.NewCarrierService(mockRepo, mockLocality, logMock)
+		expectedError := customerror.NewCarrierError(customerror.ErrConflict.Error(), "cid", http.StatusConflict)
+
+		mockRepo.On("GetByID", mock.Anything, 1).Return(carrier, nil)
+		mockLocality.On("GetByID", mock.Anything, 2).Return(model.Locality{ID: 2}, nil)
+		mockRepo.On("PatchCarrier", mock.Anything, 1, carrier).Return(expectedError)
+
+		updated, err := service.PatchCarrier(context.Background(), 1, carrier)
+
+		assert.Equal(t, expectedError, err)
+		assert.Empty(t, updated)
+	})
+}
+
+func TestCarrierDefault_DeleteCarrier(t *testing.T) {
+	t.Run("Success DeleteCarrier", func(t *testing.T) {
+		mockRepo := mocks.NewMockICarriersRepo(t)
+		service := service.NewCarrierService(mockRepo, mocks.NewMockILocalityService(t), logMock)
+
+		mockRepo.On("DeleteCarrier", mock.Anything, 1).Return(nil)
+
+		err := service.DeleteCarrier(context.Background(), 1)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Error DeleteCarrier with dependencies", func(t *testing.T) {
+		mockRepo := mocks.NewMockICarriersRepo(t)
+		service := service.NewCarrierService(mockRepo, mocks.NewMockILocalityService(t), logMock)
+		expectedError := customerror.NewCarrierError(customerror.ErrDependencies.Error(), "carrier", http.StatusConflict)
+
+		mockRepo.On("DeleteCarrier", mock.Anything, 1).Return(expectedError)
+
+		err := service.DeleteCarrier(context.Background(), 1)
+
+		assert.Equal(t, expectedError, err)
+	})
+}
@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+	"github.com/maxwelbm/alkemy-g7.git/internal/repository/interfaces"
+	"github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
+	"github.com/maxwelbm/alkemy-g7.git/pkg/logger"
+)
+
+type CountryService struct {
+	Rp  interfaces.ICountryRepo
+	log logger.Logger
+}
+
+func NewCountryService(rp interfaces.ICountryRepo, log logger.Logger) *CountryService {
+	return &CountryService{Rp: rp, log: log}
+}
+
+func (s *CountryService) GetAll(ctx context.Context, params model.ListParams) ([]model.Country, int, error) {
+	s.log.Log("CountryService", "INFO", "initializing GetAll function")
+
+	return s.Rp.Get(ctx, params)
+}
+
+func (s *CountryService) GetByID(ctx context.Context, id int) (model.Country, error) {
+	s.log.Log("CountryService", "INFO", fmt.Sprintf("initializing GetByID function with id %d", id))
+
+	return s.Rp.GetByID(ctx, id)
+}
+
+func (s *CountryService) Create(ctx context.Context, country model.Country) (model.Country, error) {
+	s.log.Log("CountryService", "INFO", "initializing Create function")
+
+	if err := country.Validate(); err != nil {
+		s.log.Log("CountryService", "ERROR", fmt.Sprintf("Error: %v", err))
+		return model.Country{}, customerror.HandleError("country", customerror.ErrorInvalid, err.Error())
+	}
+
+	c, err := s.Rp.Post(ctx, country)
+	if err != nil {
+		s.log.Log("CountryService", "ERROR", fmt.Sprintf("Error: %v", err))
+		return model.Country{}, err
+	}
+
+	s.log.Log("CountryService", "INFO", fmt.Sprintf("country created: %v", c))
+
+	return c, nil
+}
+
+func (s *CountryService) Update(ctx context.Context, id int, country model.Country) (model.Country, error) {
+	s.log.Log("CountryService", "INFO", fmt.Sprintf("initializing Update function with id %d", id))
+
+	existing, err := s.Rp.GetByID(ctx, id)
+	if err != nil {
+		s.log.Log("CountryService", "ERROR", fmt.Sprintf("Error: %v", err))
+		return model.Country{}, err
+	}
+
+	if country.Name != "" {
+		existing.Name = country.Name
+	}
+
+	if err := existing.Validate(); err != nil {
+		s.log.Log("CountryService", "ERROR", fmt.Sprintf("Error: %v", err))
+		return model.Country{}, customerror.HandleError("country", customerror.ErrorInvalid, err.Error())
+	}
+
+	c, err := s.Rp.Update(ctx, id, existing)
+	if err != nil {
+		s.log.Log("CountryService", "ERROR", fmt.Sprintf("Error: %v", err))
+		return model.Country{}, err
+	}
+
+	s.log.Log("CountryService", "INFO", fmt.Sprintf("country updated: %v", c))
+
+	return c, nil
+}
+
+// Delete removes the country, refusing while any province still belongs to it.
+func (s *CountryService) Delete(ctx context.Context, id int) error {
+	s.log.Log("CountryService", "INFO", fmt.Sprintf("initializing Delete function with id %d", id))
+
+	if _, err := s.Rp.GetByID(ctx, id); err != nil {
+		s.log.Log("CountryService", "ERROR", fmt.Sprintf("Error: %v", err))
+		return err
+	}
+
+	count, err := s.Rp.CountProvinces(ctx, id)
+	if err != nil {
+		s.log.Log("CountryService", "ERROR", fmt.Sprintf("Error: %v", err))
+		return err
+	}
+
+	if count > 0 {
+		err = customerror.HandleError("country", customerror.ErrorDep, "")
+		s.log.Log("CountryService", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return err
+	}
+
+	err = s.Rp.Delete(ctx, id)
+	if err != nil {
+		s.log.Log("CountryService", "ERROR", fmt.Sprintf("Error: %v", err))
+		return err
+	}
+
+	s.log.Log("CountryService", "INFO", fmt.Sprintf("country with id %d deleted", id))
+
+	return nil
+}
+
+func (s *CountryService) GetReport(ctx context.Context, id int) ([]model.CountryReport, error) {
+	s.log.Log("CountryService", "INFO", fmt.Sprintf("initializing GetReport function with id %d", id))
+
+	return s.Rp.GetReport(ctx, id)
+}
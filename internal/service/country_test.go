@@ -0,0 +1,115 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/maxwelbm/alkemy-g7.git/internal/mocks"
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+	"github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCountryService_Create(t *testing.T) {
+	rp := mocks.NewMockICountryRepo(t)
+	svc := NewCountryService(rp, mocks.MockLog{})
+
+	t.Run("should trim the name and create the country", func(t *testing.T) {
+		rp.On("Post", mock.Anything, model.Country{Name: "Chile"}).Return(model.Country{ID: 1, Name: "Chile"}, nil).Once()
+
+		country, err := svc.Create(context.Background(), model.Country{Name: "  Chile "})
+
+		assert.NoError(t, err)
+		assert.Equal(t, model.Country{ID: 1, Name: "Chile"}, country)
+	})
+
+	t.Run("should return invalid error when the name is missing", func(t *testing.T) {
+		country, err := svc.Create(context.Background(), model.Country{Name: " "})
+
+		assert.Equal(t, customerror.HandleError("country", customerror.ErrorInvalid, "validation errors: country_name is required"), err)
+		assert.Empty(t, country)
+	})
+
+	t.Run("should return the repository error", func(t *testing.T) {
+		expectedErr := customerror.HandleError("country", customerror.ErrorConflict, "")
+		rp.On("Post", mock.Anything, model.Country{Name: "Chile"}).Return(model.Country{}, expectedErr).Once()
+
+		country, err := svc.Create(context.Background(), model.Country{Name: "Chile"})
+
+		assert.Equal(t, expectedErr, err)
+		assert.Empty(t, country)
+	})
+}
+
+func TestCountryService_Update(t *testing.T) {
+	rp := mocks.NewMockICountryRepo(t)
+	svc := NewCountryService(rp, mocks.MockLog{})
+
+	t.Run("should rename the country", func(t *testing.T) {
+		rp.On("GetByID", mock.Anything, 1).Return(model.Country{ID: 1, Name: "Brasil"}, nil).Once()
+		rp.On("Update", mock.Anything, 1, model.Country{ID: 1, Name: "Brazil"}).Return(model.Country{ID: 1, Name: "Brazil"}, nil).Once()
+
+		country, err := svc.Update(context.Background(), 1, model.Country{Name: "Brazil"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, model.Country{ID: 1, Name: "Brazil"}, country)
+	})
+
+	t.Run("should return not found when the country does not exist", func(t *testing.T) {
+		expectedErr := customerror.HandleError("country", customerror.ErrorNotFound, "")
+		rp.On("GetByID", mock.Anything, 99).Return(model.Country{}, expectedErr).Once()
+
+		country, err := svc.Update(context.Background(), 99, model.Country{Name: "Brazil"})
+
+		assert.Equal(t, expectedErr, err)
+		assert.Empty(t, country)
+	})
+}
+
+func TestCountryService_Delete(t *testing.T) {
+	rp := mocks.NewMockICountryRepo(t)
+	svc := NewCountryService(rp, mocks.MockLog{})
+
+	t.Run("should delete a country without provinces", func(t *testing.T) {
+		rp.On("GetByID", mock.Anything, 1).Return(model.Country{ID: 1, Name: "Chile"}, nil).Once()
+		rp.On("CountProvinces", mock.Anything, 1).Return(0, nil).Once()
+		rp.On("Delete", mock.Anything, 1).Return(nil).Once()
+
+		err := svc.Delete(context.Background(), 1)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should refuse to delete a country with provinces", func(t *testing.T) {
+		rp.On("GetByID", mock.Anything, 2).Return(model.Country{ID: 2, Name: "Argentina"}, nil).Once()
+		rp.On("CountProvinces", mock.Anything, 2).Return(3, nil).Once()
+
+		err := svc.Delete(context.Background(), 2)
+
+		assert.Equal(t, customerror.HandleError("country", customerror.ErrorDep, ""), err)
+	})
+
+	t.Run("should return the count error", func(t *testing.T) {
+		rp.On("GetByID", mock.Anything, 3).Return(model.Country{ID: 3, Name: "Peru"}, nil).Once()
+		rp.On("CountProvinces", mock.Anything, 3).Return(0, errors.New("db error")).Once()
+
+		err := svc.Delete(context.Background(), 3)
+
+		assert.EqualError(t, err, "db error")
+	})
+}
+
+func TestCountryService_GetReport(t *testing.T) {
+	rp := mocks.NewMockICountryRepo(t)
+	svc := NewCountryService(rp, mocks.MockLog{})
+
+	expected := []model.CountryReport{{ID: 1, Name: "Argentina", ProvincesCount: 2, LocalitiesCount: 3, SellersCount: 4, CarriersCount: 1}}
+	rp.On("GetReport", mock.Anything, 1).Return(expected, nil).Once()
+
+	report, err := svc.GetReport(context.Background(), 1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, report)
+}
@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/go-sql-driver/mysql"
@@ -20,23 +21,23 @@ func CreateEmployeeService(rp interfaces.IEmployeeRepo, wrSrv interfaces.IWareho
 	return &EmployeeService{rp: rp, wrSrv: wrSrv, log: log}
 }
 
-func (e *EmployeeService) GetEmployees() ([]model.Employee, error) {
+func (e *EmployeeService) GetEmployees(ctx context.Context, params model.ListParams) ([]model.Employee, int, error) {
 	e.log.Log("EmployeeService", "INFO", "Fetching all employees")
-	data, err := e.rp.Get()
+	data, total, err := e.rp.Get(ctx, params)
 
 	if err != nil {
 		e.log.Log("EmployeeService", "ERROR", fmt.Sprintf("Failed to fetch employees: %v", err))
-		return nil, err
+		return nil, 0, err
 	}
 
 	e.log.Log("EmployeeService", "INFO", "Successfully fetched employees")
 
-	return data, nil
+	return data, total, nil
 }
 
-func (e *EmployeeService) GetEmployeeByID(id int) (model.Employee, error) {
+func (e *EmployeeService) GetEmployeeByID(ctx context.Context, id int) (model.Employee, error) {
 	e.log.Log("EmployeeService", "INFO", fmt.Sprintf("Fetching employee with ID %d", id))
-	data, err := e.rp.GetByID(id)
+	data, err := e.rp.GetByID(ctx, id)
 
 	if err != nil {
 		e.log.Log("EmployeeService", "ERROR", fmt.Sprintf("Failed to fetch employee %d: %v", id, err))
@@ -45,21 +46,21 @@ func (e *EmployeeService) GetEmployeeByID(id int) (model.Employee, error) {
 	return data, err
 }
 
-func (e *EmployeeService) InsertEmployee(employee model.Employee) (model.Employee, error) {
+func (e *EmployeeService) InsertEmployee(ctx context.Context, employee model.Employee) (model.Employee, error) {
 	e.log.Log("EmployeeService", "INFO", "Inserting new employee")
 
 	if !employee.IsValidEmployee() {
 		return model.Employee{}, customerror.EmployeeErrInvalid
 	}
 
-	_, err := e.wrSrv.GetByIDWareHouse(employee.WarehouseID)
+	_, err := e.wrSrv.GetByIDWareHouse(ctx, employee.WarehouseID)
 
 	if err != nil {
 		e.log.Log("EmployeeService", "ERROR", "Invalid warehouse ID")
 		return model.Employee{}, customerror.EmployeeErrInvalidWarehouseID
 	}
 
-	employee, err = e.rp.Post(employee)
+	employee, err = e.rp.Post(ctx, employee)
 
 	if err != nil {
 		if mySQLErr, ok := err.(*mysql.MySQLError); ok && mySQLErr.Number == 1062 {
@@ -77,7 +78,7 @@ func (e *EmployeeService) InsertEmployee(employee model.Employee) (model.Employe
 	return employee, nil
 }
 
-func (e *EmployeeService) UpdateEmployee(id int, employee model.Employee) (model.Employee, error) {
+func (e *EmployeeService) UpdateEmployee(ctx context.Context, id int, employee model.Employee) (model.Employee, error) {
 	e.log.Log("EmployeeService", "INFO", fmt.Sprintf("Updating employee with ID %d", id))
 
 	if employee.IsEmptyEmployee() {
@@ -85,7 +86,7 @@ func (e *EmployeeService) UpdateEmployee(id int, employee model.Employee) (model
 	}
 
 	if employee.WarehouseID != 0 {
-		_, err := e.wrSrv.GetByIDWareHouse(employee.WarehouseID)
+		_, err := e.wrSrv.GetByIDWareHouse(ctx, employee.WarehouseID)
 
 		if err != nil {
 			e.log.Log("EmployeeService", "ERROR", "Invalid warehouse ID for update")
@@ -93,7 +94,7 @@ func (e *EmployeeService) UpdateEmployee(id int, employee model.Employee) (model
 		}
 	}
 
-	existingEmployee, err := e.rp.GetByID(id)
+	existingEmployee, err := e.rp.GetByID(ctx, id)
 
 	if err != nil {
 		e.log.Log("EmployeeService", "ERROR", fmt.Sprintf("Employee %d not found: %v", id, err))
@@ -101,7 +102,7 @@ func (e *EmployeeService) UpdateEmployee(id int, employee model.Employee) (model
 	}
 
 	updateEmployeeFields(&existingEmployee, employee)
-	updatedEmployee, err := e.rp.Update(id, existingEmployee)
+	updatedEmployee, err := e.rp.Update(ctx, id, existingEmployee)
 
 	if err != nil {
 		e.log.Log("EmployeeService", "ERROR", fmt.Sprintf("Failed to update employee %d: %v", id, err))
@@ -114,16 +115,16 @@ func (e *EmployeeService) UpdateEmployee(id int, employee model.Employee) (model
 	return updatedEmployee, nil
 }
 
-func (e *EmployeeService) DeleteEmployee(id int) error {
+func (e *EmployeeService) DeleteEmployee(ctx context.Context, id int) error {
 	e.log.Log("EmployeeService", "INFO", fmt.Sprintf("Deleting employee with ID %d", id))
-	_, err := e.rp.GetByID(id)
+	_, err := e.rp.GetByID(ctx, id)
 
 	if err != nil {
 		e.log.Log("EmployeeService", "ERROR", fmt.Sprintf("Failed to find employee %d: %v", id, err))
 		return err
 	}
 
-	err = e.rp.Delete(id)
+	err = e.rp.Delete(ctx, id)
 	if err != nil {
 		e.log.Log("EmployeeService", "ERROR", fmt.Sprintf("Failed to delete employee %d: %v", id, err))
 		return err
@@ -134,21 +135,21 @@ func (e *EmployeeService) DeleteEmployee(id int) error {
 	return nil
 }
 
-func (e *EmployeeService) GetInboundOrdersReportByEmployee(employeeID int) (model.InboundOrdersReportByEmployee, error) {
+func (e *EmployeeService) GetInboundOrdersReportByEmployee(ctx context.Context, employeeID int) (model.InboundOrdersReportByEmployee, error) {
 	e.log.Log("EmployeeService", "INFO", fmt.Sprintf("Fetching inbound orders report for employee %d", employeeID))
 
 	if employeeID <= 0 {
 		return model.InboundOrdersReportByEmployee{}, customerror.EmployeeErrInvalid
 	}
 
-	_, err := e.rp.GetByID(employeeID)
+	_, err := e.rp.GetByID(ctx, employeeID)
 
 	if err != nil {
 		e.log.Log("EmployeeService", "ERROR", fmt.Sprintf("Employee %d not found: %v", employeeID, err))
 		return model.InboundOrdersReportByEmployee{}, err
 	}
 
-	data, err := e.rp.GetInboundOrdersReportByEmployee(employeeID)
+	data, err := e.rp.GetInboundOrdersReportByEmployee(ctx, employeeID)
 
 	if err != nil {
 		e.log.Log("EmployeeService", "ERROR", fmt.Sprintf("Failed to fetch report for employee %d: %v", employeeID, err))
@@ -160,8 +161,8 @@ func (e *EmployeeService) GetInboundOrdersReportByEmployee(employeeID int) (mode
 	return data, nil
 }
 
-func (e *EmployeeService) GetInboundOrdersReports() ([]model.InboundOrdersReportByEmployee, error) {
-	return e.rp.GetInboundOrdersReports()
+func (e *EmployeeService) GetInboundOrdersReports(ctx context.Context) ([]model.InboundOrdersReportByEmployee, error) {
+	return e.rp.GetInboundOrdersReports(ctx)
 }
 
 func updateEmployeeFields(existing *model.Employee, updates model.Employee) {
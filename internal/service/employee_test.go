@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -17,8 +18,8 @@ func TestInsertEmployee(t *testing.T) {
 	warehouseRepo := mocks.NewMockIWarehouseRepo(t)
 	employeeSv := CreateEmployeeService(employeeRepo, warehouseRepo, mocks.MockLog{})
 	t.Run("should create the employee case everything is ok", func(t *testing.T) {
-		warehouseRepo.On("GetByIDWareHouse", 1).Return(model.WareHouse{}, nil).Once()
-		employeeRepo.On("Post", mock.Anything).Return(model.Employee{ID: 10, CardNumberID: "#123", FirstName: "Bruce", LastName: "Wayne", WarehouseID: 1}, nil).Once()
+		warehouseRepo.On("GetByIDWareHouse", mock.Anything, 1).Return(model.WareHouse{}, nil).Once()
+		employeeRepo.On("Post", mock.Anything, mock.Anything).Return(model.Employee{ID: 10, CardNumberID: "#123", FirstName: "Bruce", LastName: "Wayne", WarehouseID: 1}, nil).Once()
 
 		validEntry := model.Employee{
 			CardNumberID: "#123",
@@ -27,7 +28,7 @@ func TestInsertEmployee(t *testing.T) {
 			WarehouseID:  1,
 		}
 
-		newEmployee, err := employeeSv.InsertEmployee(validEntry)
+		newEmployee, err := employeeSv.InsertEmployee(context.Background(), validEntry)
 
 		assert.Nil(t, err)
 		assert.NotEmpty(t, newEmployee)
@@ -40,7 +41,7 @@ func TestInsertEmployee(t *testing.T) {
 			LastName:     "Wayne",
 		}
 
-		employee, err := employeeSv.InsertEmployee(invalidEntry)
+		employee, err := employeeSv.InsertEmployee(context.Background(), invalidEntry)
 
 		assert.NotNil(t, err)
 		assert.Error(t, err)
@@ -48,7 +49,7 @@ func TestInsertEmployee(t *testing.T) {
 	})
 
 	t.Run("should return an error in case of warehouseNotFound", func(t *testing.T) {
-		warehouseRepo.On("GetByIDWareHouse", 2).Return(model.WareHouse{}, customerror.ErrNotFound).Once()
+		warehouseRepo.On("GetByIDWareHouse", mock.Anything, 2).Return(model.WareHouse{}, customerror.ErrNotFound).Once()
 
 		validEntry := model.Employee{
 			CardNumberID: "#123",
@@ -57,7 +58,7 @@ func TestInsertEmployee(t *testing.T) {
 			WarehouseID:  2,
 		}
 
-		employee, err := employeeSv.InsertEmployee(validEntry)
+		employee, err := employeeSv.InsertEmployee(context.Background(), validEntry)
 
 		assert.NotNil(t, err)
 		assert.Error(t, err)
@@ -65,8 +66,8 @@ func TestInsertEmployee(t *testing.T) {
 	})
 
 	t.Run("should return an error in case of database error", func(t *testing.T) {
-		warehouseRepo.On("GetByIDWareHouse", 1).Return(model.WareHouse{}, nil)
-		employeeRepo.On("Post", mock.Anything).Return(model.Employee{}, &mysql.MySQLError{Number: 1062})
+		warehouseRepo.On("GetByIDWareHouse", mock.Anything, 1).Return(model.WareHouse{}, nil)
+		employeeRepo.On("Post", mock.Anything, mock.Anything).Return(model.Employee{}, &mysql.MySQLError{Number: 1062})
 
 		validEntry := model.Employee{
 			CardNumberID: "#123",
@@ -75,7 +76,7 @@ func TestInsertEmployee(t *testing.T) {
 			WarehouseID:  1,
 		}
 
-		employee, err := employeeSv.InsertEmployee(validEntry)
+		employee, err := employeeSv.InsertEmployee(context.Background(), validEntry)
 
 		assert.Error(t, err)
 		assert.EqualValues(t, customerror.EmployeeErrDuplicatedCardNumber, err)
@@ -88,19 +89,20 @@ func TestGetEmployees(t *testing.T) {
 	employeeSv := CreateEmployeeService(employeeRepo, nil, mocks.MockLog{})
 
 	t.Run("should return all the employees", func(t *testing.T) {
-		employeeRepo.On("Get", mock.Anything).Return([]model.Employee{{CardNumberID: "#123", ID: 1, FirstName: "Bruce", LastName: "Wayne", WarehouseID: 1}, {ID: 2, CardNumberID: "#234", FirstName: "Yami", LastName: "Sukehiro", WarehouseID: 2}}, nil).Once()
+		employeeRepo.On("Get", mock.Anything, mock.Anything).Return([]model.Employee{{CardNumberID: "#123", ID: 1, FirstName: "Bruce", LastName: "Wayne", WarehouseID: 1}, {ID: 2, CardNumberID: "#234", FirstName: "Yami", LastName: "Sukehiro", WarehouseID: 2}}, 2, nil).Once()
 
-		employee, err := employeeSv.GetEmployees()
+		employee, total, err := employeeSv.GetEmployees(context.Background(), model.ListParams{})
 
 		assert.NotNil(t, employee)
 		assert.Len(t, employee, 2)
+		assert.Equal(t, 2, total)
 		assert.Nil(t, err)
 	})
 
 	t.Run("should return the error in case of repository err", func(t *testing.T) {
-		employeeRepo.On("Get", mock.Anything).Return([]model.Employee{}, customerror.EmployeeErrInvalid).Once()
+		employeeRepo.On("Get", mock.Anything, mock.Anything).Return([]model.Employee{}, 0, customerror.EmployeeErrInvalid).Once()
 
-		employee, err := employeeSv.GetEmployees()
+		employee, _, err := employeeSv.GetEmployees(context.Background(), model.ListParams{})
 
 		assert.Nil(t, employee)
 		assert.Error(t, err)
@@ -113,9 +115,9 @@ func TestGetEmployeeByID(t *testing.T) {
 
 	t.Run("should return the employee by id", func(t *testing.T) {
 		mockEmployee := model.Employee{ID: 1, CardNumberID: "#123", FirstName: "Jack", LastName: "Chan", WarehouseID: 2}
-		employeeRepo.On("GetByID", mock.Anything).Return(mockEmployee, nil).Once()
+		employeeRepo.On("GetByID", mock.Anything, mock.Anything).Return(mockEmployee, nil).Once()
 
-		employee, err := employeeSv.GetEmployeeByID(1)
+		employee, err := employeeSv.GetEmployeeByID(context.Background(), 1)
 
 		assert.ObjectsAreEqualValues(employee, mockEmployee)
 		assert.Nil(t, err)
@@ -136,12 +138,12 @@ func TestUpdateEmployee(t *testing.T) {
 
 	t.Run("should merge the entry data with the existing employee data and return the updated employee", func(t *testing.T) {
 		existingEmployeeMock := model.Employee{ID: 1, CardNumberID: "#123", FirstName: "Islam", LastName: "Makhachev", WarehouseID: 1}
-		employeeRepo.On("GetByID", mock.Anything).Return(existingEmployeeMock, nil).Once()
-		employeeRepo.On("Update", mock.Anything, mock.Anything).Return(model.Employee{ID: existingEmployeeMock.ID, CardNumberID: validEntry.CardNumberID, FirstName: validEntry.FirstName, LastName: validEntry.LastName, WarehouseID: validEntry.WarehouseID}, nil)
+		employeeRepo.On("GetByID", mock.Anything, mock.Anything).Return(existingEmployeeMock, nil).Once()
+		employeeRepo.On("Update", mock.Anything, mock.Anything, mock.Anything).Return(model.Employee{ID: existingEmployeeMock.ID, CardNumberID: validEntry.CardNumberID, FirstName: validEntry.FirstName, LastName: validEntry.LastName, WarehouseID: validEntry.WarehouseID}, nil)
 
-		warehouseRepo.On("GetByIDWareHouse", mock.Anything).Return(model.WareHouse{}, nil).Once()
+		warehouseRepo.On("GetByIDWareHouse", mock.Anything, mock.Anything).Return(model.WareHouse{}, nil).Once()
 
-		employee, err := employeeSv.UpdateEmployee(1, validEntry)
+		employee, err := employeeSv.UpdateEmployee(context.Background(), 1, validEntry)
 
 		assert.Nil(t, err)
 		assert.NotEmpty(t, employee)
@@ -154,26 +156,26 @@ func TestUpdateEmployee(t *testing.T) {
 	t.Run("should return an error in case an empty employeee", func(t *testing.T) {
 		invalidEntry := model.Employee{}
 
-		employee, err := employeeSv.UpdateEmployee(1, invalidEntry)
+		employee, err := employeeSv.UpdateEmployee(context.Background(), 1, invalidEntry)
 
 		assert.Error(t, err)
 		assert.Empty(t, employee)
 	})
 
 	t.Run("should return an error in case of new warehouseid does not exist", func(t *testing.T) {
-		warehouseRepo.On("GetByIDWareHouse", mock.Anything).Return(model.WareHouse{}, errors.New("")).Once()
+		warehouseRepo.On("GetByIDWareHouse", mock.Anything, mock.Anything).Return(model.WareHouse{}, errors.New("")).Once()
 
-		employee, err := employeeSv.UpdateEmployee(1, validEntry)
+		employee, err := employeeSv.UpdateEmployee(context.Background(), 1, validEntry)
 
 		assert.Error(t, err)
 		assert.Empty(t, employee)
 	})
 
 	t.Run("should return an error in case of employeeid does not exist", func(t *testing.T) {
-		warehouseRepo.On("GetByIDWareHouse", mock.Anything).Return(model.WareHouse{}, nil).Once()
-		employeeRepo.On("GetByID", mock.Anything).Return(model.Employee{}, customerror.EmployeeErrNotFound).Once()
+		warehouseRepo.On("GetByIDWareHouse", mock.Anything, mock.Anything).Return(model.WareHouse{}, nil).Once()
+		employeeRepo.On("GetByID", mock.Anything, mock.Anything).Return(model.Employee{}, customerror.EmployeeErrNotFound).Once()
 
-		employee, err := employeeSv.UpdateEmployee(1, validEntry)
+		employee, err := employeeSv.UpdateEmployee(context.Background(), 1, validEntry)
 
 		assert.Error(t, err)
 		assert.Empty(t, employee)
@@ -185,18 +187,18 @@ func TestDeleteEmployee(t *testing.T) {
 	employeeSv := CreateEmployeeService(employeeRepo, nil, mocks.MockLog{})
 
 	t.Run("should return nil case success", func(t *testing.T) {
-		employeeRepo.On("GetByID", 1).Return(model.Employee{}, nil).Once()
-		employeeRepo.On("Delete", mock.Anything).Return(nil).Once()
+		employeeRepo.On("GetByID", mock.Anything, 1).Return(model.Employee{}, nil).Once()
+		employeeRepo.On("Delete", mock.Anything, mock.Anything).Return(nil).Once()
 
-		err := employeeSv.DeleteEmployee(1)
+		err := employeeSv.DeleteEmployee(context.Background(), 1)
 
 		assert.Nil(t, err)
 	})
 
 	t.Run("should return an error case employee id in case of employee id does not exist", func(t *testing.T) {
-		employeeRepo.On("GetByID", 1).Return(model.Employee{}, customerror.EmployeeErrNotFound).Once()
+		employeeRepo.On("GetByID", mock.Anything, 1).Return(model.Employee{}, customerror.EmployeeErrNotFound).Once()
 
-		err := employeeSv.DeleteEmployee(1)
+		err := employeeSv.DeleteEmployee(context.Background(), 1)
 
 		assert.Error(t, err)
 	})
@@ -207,11 +209,11 @@ func TestGetInboundOrdersReports(t *testing.T) {
 	employeeSv := CreateEmployeeService(employeeRepo, nil, mocks.MockLog{})
 
 	t.Run("should return all inbound orders reports", func(t *testing.T) {
-		employeeRepo.On("GetInboundOrdersReports", mock.Anything).Return([]model.InboundOrdersReportByEmployee{
+		employeeRepo.On("GetInboundOrdersReports", mock.Anything, mock.Anything).Return([]model.InboundOrdersReportByEmployee{
 			{ID: 1, CardNumberID: "123", FirstName: "Islam", LastName: "Makhachev", WarehouseID: 1, InboundOrdersCount: 3},
 			{ID: 2, CardNumberID: "456", FirstName: "Jon", LastName: "Jones", WarehouseID: 2, InboundOrdersCount: 0}}, nil)
 
-		inboundOrders, err := employeeSv.GetInboundOrdersReports()
+		inboundOrders, err := employeeSv.GetInboundOrdersReports(context.Background())
 
 		assert.Nil(t, err)
 		assert.NotEmpty(t, inboundOrders)
@@ -225,24 +227,24 @@ func TestGetInboundOrdersReportByEmployee(t *testing.T) {
 
 	t.Run("should return the inbound orders by employee", func(t *testing.T) {
 		mockData := model.InboundOrdersReportByEmployee{ID: 1, CardNumberID: "#123", FirstName: "Jon", LastName: "Jones", WarehouseID: 2, InboundOrdersCount: 30}
-		employeeRepo.On("GetByID", mock.Anything).Return(model.Employee{}, nil).Once()
-		employeeRepo.On("GetInboundOrdersReportByEmployee", mock.Anything).Return(mockData, nil).Once()
-		inboundOrders, err := employeeSv.GetInboundOrdersReportByEmployee(1)
+		employeeRepo.On("GetByID", mock.Anything, mock.Anything).Return(model.Employee{}, nil).Once()
+		employeeRepo.On("GetInboundOrdersReportByEmployee", mock.Anything, mock.Anything).Return(mockData, nil).Once()
+		inboundOrders, err := employeeSv.GetInboundOrdersReportByEmployee(context.Background(), 1)
 
 		assert.Nil(t, err)
 		assert.NotEmpty(t, inboundOrders)
 		assert.EqualValues(t, inboundOrders, mockData)
 	})
 	t.Run("should return an error in case of negative employeeid", func(t *testing.T) {
-		inboundOrders, err := employeeSv.GetInboundOrdersReportByEmployee(-1)
+		inboundOrders, err := employeeSv.GetInboundOrdersReportByEmployee(context.Background(), -1)
 
 		assert.Error(t, err)
 		assert.Empty(t, inboundOrders)
 		assert.EqualValues(t, err, customerror.EmployeeErrInvalid)
 	})
 	t.Run("should return an error in case of employee does not exist", func(t *testing.T) {
-		employeeRepo.On("GetByID", mock.Anything).Return(model.Employee{}, customerror.EmployeeErrNotFound).Once()
-		inboundOrders, err := employeeSv.GetInboundOrdersReportByEmployee(1)
+		employeeRepo.On("GetByID", mock.Anything, mock.Anything).Return(model.Employee{}, customerror.EmployeeErrNotFound).Once()
+		inboundOrders, err := employeeSv.GetInboundOrdersReportByEmployee(context.Background(), 1)
 
 		assert.Error(t, err)
 		assert.Empty(t, inboundOrders)
@@ -250,9 +252,9 @@ func TestGetInboundOrdersReportByEmployee(t *testing.T) {
 	})
 	t.Run("should return an error in case of repository method fails", func(t *testing.T) {
 		repoMockErr := errors.New("something went wrong")
-		employeeRepo.On("GetByID", mock.Anything).Return(model.Employee{}, nil).Once()
-		employeeRepo.On("GetInboundOrdersReportByEmployee", mock.Anything).Return(model.InboundOrdersReportByEmployee{}, repoMockErr).Once()
-		inboundOrders, err := employeeSv.GetInboundOrdersReportByEmployee(1)
+		employeeRepo.On("GetByID", mock.Anything, mock.Anything).Return(model.Employee{}, nil).Once()
+		employeeRepo.On("GetInboundOrdersReportByEmployee", mock.Anything, mock.Anything).Return(model.InboundOrdersReportByEmployee{}, repoMockErr).Once()
+		inboundOrders, err := employeeSv.GetInboundOrdersReportByEmployee(context.Background(), 1)
 
 		assert.Error(t, err)
 		assert.Empty(t, inboundOrders)
@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+	irepo "github.com/maxwelbm/alkemy-g7.git/internal/repository/interfaces"
+	"github.com/maxwelbm/alkemy-g7.git/internal/service/interfaces"
+	"github.com/maxwelbm/alkemy-g7.git/pkg/logger"
+)
+
+const DefaultExpiryScanInterval = time.Hour
+
+// LogNotifier is the default notifier, it writes the expiry alerts to the application log.
+type LogNotifier struct {
+	log logger.Logger
+}
+
+func NewLogNotifier(log logger.Logger) *LogNotifier {
+	return &LogNotifier{log: log}
+}
+
+func (n *LogNotifier) NotifyExpiry(_ context.Context, batch model.ExpiringBatch) error {
+	n.log.Log("ExpiryNotifier", "WARN", fmt.Sprintf("product batch %s (id %d) in section %s of warehouse %s is %s, due date %s, %d units left",
+		batch.BatchNumber, batch.ID, batch.SectionNumber, batch.WarehouseCode, batch.Status, batch.DueDate.Format(time.DateOnly), batch.CurrentQuantity))
+
+	return nil
+}
+
+// ExpiryMonitor periodically scans the product batches due within WindowDays and notifies each batch
+// once when it starts expiring and once more when it expires. A failed notification is retried on the
+// next scan. The notified state lives in memory, so a restart notifies the current batches again.
+type ExpiryMonitor struct {
+	Rp         irepo.IProductBatchesRepo
+	Notifier   interfaces.INotifier
+	Interval   time.Duration
+	WindowDays int
+	notified   map[int]string
+	log        logger.Logger
+}
+
+func NewExpiryMonitor(rp irepo.IProductBatchesRepo, notifier interfaces.INotifier, interval time.Duration, windowDays int, log logger.Logger) *ExpiryMonitor {
+	return &ExpiryMonitor{Rp: rp, Notifier: notifier, Interval: interval, WindowDays: windowDays, notified: map[int]string{}, log: log}
+}
+
+// Run scans right away and then on every interval until ctx is cancelled.
+func (m *ExpiryMonitor) Run(ctx context.Context) {
+	m.log.Log("ExpiryMonitor", "INFO", fmt.Sprintf("starting expiry monitor every %s for a %d days window", m.Interval, m.WindowDays))
+
+	ticker := time.NewTicker(m.Interval)
+	defer ticker.Stop()
+
+	for {
+		_ = m.Scan(ctx)
+
+		select {
+		case <-ctx.Done():
+			m.log.Log("ExpiryMonitor", "INFO", "expiry monitor stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Scan runs a single pass over the expiring batches. It is not safe for concurrent use.
+func (m *ExpiryMonitor) Scan(ctx context.Context) error {
+	now := time.Now()
+
+	batches, err := m.Rp.GetExpiring(ctx, now.AddDate(0, 0, m.WindowDays))
+	if err != nil {
+		m.log.Log("ExpiryMonitor", "ERROR", fmt.Sprintf("Error: %v", err))
+		return err
+	}
+
+	current := make(map[int]string, len(batches))
+
+	for _, batch := range batches {
+		batch.Status = batch.ExpiryStatus(now)
+
+		if m.notified[batch.ID] == batch.Status {
+			current[batch.ID] = batch.Status
+			continue
+		}
+
+		if err := m.Notifier.NotifyExpiry(ctx, batch); err != nil {
+			m.log.Log("ExpiryMonitor", "ERROR", fmt.Sprintf("Error: %v", err))
+
+			if status, ok := m.notified[batch.ID]; ok {
+				current[batch.ID] = status
+			}
+
+			continue
+		}
+
+		current[batch.ID] = batch.Status
+	}
+
+	// batches consumed, removed or postponed out of the window are forgotten
+	m.notified = current
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/maxwelbm/alkemy-g7.git/internal/mocks"
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+	"github.com/maxwelbm/alkemy-g7.git/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestExpiryMonitor_Scan(t *testing.T) {
+	expiring := model.ExpiringBatch{ID: 1, BatchNumber: "B01", DueDate: time.Now().AddDate(0, 0, 3)}
+	expired := model.ExpiringBatch{ID: 1, BatchNumber: "B01", DueDate: time.Now().AddDate(0, 0, -1)}
+
+	t.Run("notify a batch once per status change", func(t *testing.T) {
+		mockRepo := mocks.NewMockIProductBatchesRepo(t)
+		mockNotifier := mocks.NewMockINotifier(t)
+		monitor := service.NewExpiryMonitor(mockRepo, mockNotifier, time.Hour, 7, logMock)
+
+		mockRepo.On("GetExpiring", mock.Anything, mock.AnythingOfType("time.Time")).Return([]model.ExpiringBatch{expiring}, nil).Twice()
+		mockRepo.On("GetExpiring", mock.Anything, mock.AnythingOfType("time.Time")).Return([]model.ExpiringBatch{expired}, nil).Once()
+		mockNotifier.On("NotifyExpiry", mock.Anything, mock.MatchedBy(func(b model.ExpiringBatch) bool { return b.Status == model.ExpiryStatusExpiring })).Return(nil).Once()
+		mockNotifier.On("NotifyExpiry", mock.Anything, mock.MatchedBy(func(b model.ExpiringBatch) bool { return b.Status == model.ExpiryStatusExpired })).Return(nil).Once()
+
+		assert.NoError(t, monitor.Scan(context.Background()))
+		assert.NoError(t, monitor.Scan(context.Background()))
+		assert.NoError(t, monitor.Scan(context.Background()))
+
+		mockNotifier.AssertNumberOfCalls(t, "NotifyExpiry", 2)
+	})
+
+	t.Run("retry a failed notification on the next scan", func(t *testing.T) {
+		mockRepo := mocks.NewMockIProductBatchesRepo(t)
+		mockNotifier := mocks.NewMockINotifier(t)
+		monitor := service.NewExpiryMonitor(mockRepo, mockNotifier, time.Hour, 7, logMock)
+
+		mockRepo.On("GetExpiring", mock.Anything, mock.AnythingOfType("time.Time")).Return([]model.ExpiringBatch{expiring}, nil)
+		mockNotifier.On("NotifyExpiry", mock.Anything, mock.Anything).Return(errors.New("notifier unavailable")).Once()
+		mockNotifier.On("NotifyExpiry", mock.Anything, mock.Anything).Return(nil).Once()
+
+		assert.NoError(t, monitor.Scan(context.Background()))
+		assert.NoError(t, monitor.Scan(context.Background()))
+		assert.NoError(t, monitor.Scan(context.Background()))
+
+		mockNotifier.AssertNumberOfCalls(t, "NotifyExpiry", 2)
+	})
+
+	t.Run("return the repository error", func(t *testing.T) {
+		mockRepo := mocks.NewMockIProductBatchesRepo(t)
+		monitor := service.NewExpiryMonitor(mockRepo, mocks.NewMockINotifier(t), time.Hour, 7, logMock)
+
+		mockRepo.On("GetExpiring", mock.Anything, mock.AnythingOfType("time.Time")).Return(nil, errors.New("db down"))
+
+		assert.EqualError(t, monitor.Scan(context.Background()), "db down")
+	})
+}
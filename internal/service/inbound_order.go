@@ -1,7 +1,10 @@
 package service
 
 import (
+	"context"
+	"database/sql"
 	"fmt"
+	"time"
 
 	"github.com/go-sql-driver/mysql"
 	"github.com/maxwelbm/alkemy-g7.git/internal/model"
@@ -13,25 +16,66 @@ import (
 
 type InboundOrderService struct {
 	rp          interfaces.IInboundOrderRepository
+	rpBatches   interfaces.IProductBatchesRepo
+	rpSec       interfaces.ISectionRepo
+	uow         interfaces.IUnitOfWork
 	employeeSv  servicesInterfaces.IEmployeeService
 	warehouseSv servicesInterfaces.IWarehouseService
+	batchesSv   servicesInterfaces.IProductBatchesService
+	excursionSv servicesInterfaces.ITemperatureExcursionService
 	log         logger.Logger
 }
 
 func NewInboundOrderService(
 	rp interfaces.IInboundOrderRepository,
+	rpBatches interfaces.IProductBatchesRepo,
+	rpSec interfaces.ISectionRepo,
+	uow interfaces.IUnitOfWork,
 	employeeSv servicesInterfaces.IEmployeeService,
 	warehouseSv servicesInterfaces.IWarehouseService,
+	batchesSv servicesInterfaces.IProductBatchesService,
+	excursionSv servicesInterfaces.ITemperatureExcursionService,
 	log logger.Logger) *InboundOrderService {
 	return &InboundOrderService{
 		rp:          rp,
+		rpBatches:   rpBatches,
+		rpSec:       rpSec,
+		uow:         uow,
 		employeeSv:  employeeSv,
 		warehouseSv: warehouseSv,
+		batchesSv:   batchesSv,
+		excursionSv: excursionSv,
 		log:         log,
 	}
 }
 
-func (i *InboundOrderService) Post(inboundOrder model.InboundOrder) (model.InboundOrder, error) {
+func (i *InboundOrderService) Get(ctx context.Context, params model.ListParams) ([]model.InboundOrder, int, error) {
+	i.log.Log("InboundOrderService", "INFO", "initializing Get function for inbound orders")
+
+	inboundOrders, total, err := i.rp.Get(ctx, params)
+
+	if err != nil {
+		i.log.Log("InboundOrderService", "ERROR", fmt.Sprintf("failed to list inbound orders: %v", err))
+		return nil, 0, err
+	}
+
+	return inboundOrders, total, nil
+}
+
+func (i *InboundOrderService) GetByID(ctx context.Context, id int) (model.InboundOrder, error) {
+	i.log.Log("InboundOrderService", "INFO", fmt.Sprintf("initializing GetByID function for inbound order %d", id))
+
+	inboundOrder, err := i.rp.GetByID(ctx, id)
+
+	if err != nil {
+		i.log.Log("InboundOrderService", "ERROR", fmt.Sprintf("failed to get inbound order: %v", err))
+		return model.InboundOrder{}, err
+	}
+
+	return inboundOrder, nil
+}
+
+func (i *InboundOrderService) Post(ctx context.Context, inboundOrder model.InboundOrder) (model.InboundOrder, error) {
 	i.log.Log("InboundOrderService", "INFO", "initializing Post function for inbound order")
 
 	isValid := inboundOrder.IsValid()
@@ -41,41 +85,131 @@ func (i *InboundOrderService) Post(inboundOrder model.InboundOrder) (model.Inbou
 		return model.InboundOrder{}, customerror.InboundErrInvalidEntry
 	}
 
-	_, err := i.employeeSv.GetEmployeeByID(inboundOrder.EmployeeID)
+	employee, err := i.employeeSv.GetEmployeeByID(ctx, inboundOrder.EmployeeID)
 
 	if err != nil {
 		i.log.Log("InboundOrderService", "ERROR", fmt.Sprintf("invalid employee ID: %d, error: %v", inboundOrder.EmployeeID, err))
 		return model.InboundOrder{}, customerror.InboundErrInvalidEmployee
 	}
 
-	_, err = i.warehouseSv.GetByIDWareHouse(inboundOrder.WareHouseID)
+	_, err = i.warehouseSv.GetByIDWareHouse(ctx, inboundOrder.WareHouseID)
 
 	if err != nil {
 		i.log.Log("InboundOrderService", "ERROR", fmt.Sprintf("invalid warehouse ID: %d, error: %v", inboundOrder.WareHouseID, err))
 		return model.InboundOrder{}, customerror.InboundErrInvalidWarehouse
 	}
 
-	entry, err := i.rp.Post(inboundOrder)
+	if employee.WarehouseID != inboundOrder.WareHouseID {
+		i.log.Log("InboundOrderService", "ERROR", fmt.Sprintf("employee %d works in warehouse %d, not in warehouse %d", employee.ID, employee.WarehouseID, inboundOrder.WareHouseID))
+		return model.InboundOrder{}, customerror.InboundErrEmployeeWarehouse
+	}
+
+	if len(inboundOrder.ProductBatches) > 0 {
+		return i.postWithBatches(ctx, inboundOrder)
+	}
+
+	batch, err := i.rpBatches.GetByID(ctx, inboundOrder.ProductBatchID)
+
+	if err != nil {
+		i.log.Log("InboundOrderService", "ERROR", fmt.Sprintf("invalid product batch ID: %d, error: %v", inboundOrder.ProductBatchID, err))
+		return model.InboundOrder{}, customerror.InboundErrInvalidProductBatch
+	}
+
+	err = i.checkSectionsWarehouse(ctx, inboundOrder.WareHouseID, []int{batch.SectionID})
+
+	if err != nil {
+		return model.InboundOrder{}, err
+	}
+
+	entry, err := i.rp.Post(ctx, inboundOrder)
+
+	if err != nil {
+		return model.InboundOrder{}, i.mapPostError(err)
+	}
+
+	i.log.Log("InboundOrderService", "INFO", fmt.Sprintf("Post function finished successfully, created inbound order with ID: %d", entry.ID))
+
+	return entry, nil
+}
+
+// postWithBatches creates the received batches and the order in a single transaction. The order keeps
+// the first batch as its product_batch_id and every batch is linked to it.
+func (i *InboundOrderService) postWithBatches(ctx context.Context, inboundOrder model.InboundOrder) (model.InboundOrder, error) {
+	batches := make([]*model.ProductBatches, len(inboundOrder.ProductBatches))
+	sectionIDs := make([]int, len(inboundOrder.ProductBatches))
+
+	for idx := range inboundOrder.ProductBatches {
+		batches[idx] = &inboundOrder.ProductBatches[idx]
+		sectionIDs[idx] = inboundOrder.ProductBatches[idx].SectionID
+	}
+
+	err := i.checkSectionsWarehouse(ctx, inboundOrder.WareHouseID, sectionIDs)
+
+	if err != nil {
+		return model.InboundOrder{}, err
+	}
+
+	err = i.batchesSv.ValidateNew(ctx, batches)
 
 	if err != nil {
-		i.log.Log("InboundOrderService", "ERROR", fmt.Sprintf("failed to create inbound order: %v", err))
+		i.log.Log("InboundOrderService", "ERROR", fmt.Sprintf("invalid product batches: %v", err))
+		return model.InboundOrder{}, err
+	}
+
+	var entry model.InboundOrder
+
+	err = i.uow.Do(ctx, func(tx *sql.Tx) error {
+		created := make([]model.ProductBatches, 0, len(batches))
+		ids := make([]int, 0, len(batches))
+
+		for _, batch := range batches {
+			newBatch, err := i.rpBatches.WithTx(tx).Post(ctx, batch)
+
+			if err != nil {
+				return err
+			}
+
+			err = i.rpSec.WithTx(tx).IncreaseCurrentCapacity(ctx, batch.SectionID, batch.CurrentQuantity)
+
+			if err != nil {
+				return err
+			}
+
+			created = append(created, newBatch)
+			ids = append(ids, newBatch.ID)
+		}
+
+		inboundOrder.ProductBatchID = ids[0]
+
+		order, err := i.rp.WithTx(tx).Post(ctx, inboundOrder)
+
+		if err != nil {
+			return i.mapPostError(err)
+		}
 
-		mysqlErr, ok := err.(*mysql.MySQLError)
-		if !ok {
-			i.log.Log("InboundOrderService", "ERROR", fmt.Sprintf("unexpected error: %v", err))
-			return model.InboundOrder{}, err
+		err = i.rp.WithTx(tx).AddBatches(ctx, order.ID, ids)
+
+		if err != nil {
+			return err
 		}
 
-		switch mysqlErr.Number {
-		case 1452:
-			i.log.Log("InboundOrderService", "ERROR", "invalid product batch ID")
-			return model.InboundOrder{}, customerror.InboundErrInvalidProductBatch
-		case 1062:
-			i.log.Log("InboundOrderService", "ERROR", "duplicated order number")
-			return model.InboundOrder{}, customerror.InboundErrDuplicatedOrderNumber
-		default:
-			i.log.Log("InboundOrderService", "ERROR", fmt.Sprintf("MySQL error: %v", mysqlErr))
-			return model.InboundOrder{}, err
+		entry = order
+		entry.ProductBatchIDs = ids
+		entry.ProductBatches = created
+
+		return nil
+	})
+
+	if err != nil {
+		i.log.Log("InboundOrderService", "ERROR", fmt.Sprintf("failed to create inbound order with product batches: %v", err))
+		return model.InboundOrder{}, err
+	}
+
+	for _, batch := range entry.ProductBatches {
+		err = i.excursionSv.EvaluateBatch(ctx, batch.ID, batch.CurrentTemperature, time.Now())
+
+		if err != nil {
+			i.log.Log("InboundOrderService", "ERROR", fmt.Sprintf("unable to evaluate temperature excursions: %v", err))
 		}
 	}
 
@@ -83,3 +217,53 @@ func (i *InboundOrderService) Post(inboundOrder model.InboundOrder) (model.Inbou
 
 	return entry, nil
 }
+
+// checkSectionsWarehouse makes sure every section the order stores batches in belongs to the order warehouse.
+func (i *InboundOrderService) checkSectionsWarehouse(ctx context.Context, warehouseID int, sectionIDs []int) error {
+	checked := make(map[int]bool)
+
+	for _, sectionID := range sectionIDs {
+		if checked[sectionID] {
+			continue
+		}
+
+		section, err := i.rpSec.GetByID(ctx, sectionID)
+
+		if err != nil {
+			i.log.Log("InboundOrderService", "ERROR", fmt.Sprintf("failed to get section %d: %v", sectionID, err))
+			return err
+		}
+
+		if section.WarehouseID != warehouseID {
+			i.log.Log("InboundOrderService", "ERROR", fmt.Sprintf("section %d belongs to warehouse %d, not to warehouse %d", sectionID, section.WarehouseID, warehouseID))
+			return customerror.InboundErrSectionWarehouse
+		}
+
+		checked[sectionID] = true
+	}
+
+	return nil
+}
+
+// mapPostError translates the MySQL errors raised when inserting an inbound order.
+func (i *InboundOrderService) mapPostError(err error) error {
+	i.log.Log("InboundOrderService", "ERROR", fmt.Sprintf("failed to create inbound order: %v", err))
+
+	mysqlErr, ok := err.(*mysql.MySQLError)
+	if !ok {
+		i.log.Log("InboundOrderService", "ERROR", fmt.Sprintf("unexpected error: %v", err))
+		return err
+	}
+
+	switch mysqlErr.Number {
+	case 1452:
+		i.log.Log("InboundOrderService", "ERROR", "invalid product batch ID")
+		return customerror.InboundErrInvalidProductBatch
+	case 1062:
+		i.log.Log("InboundOrderService", "ERROR", "duplicated order number")
+		return customerror.InboundErrDuplicatedOrderNumber
+	default:
+		i.log.Log("InboundOrderService", "ERROR", fmt.Sprintf("MySQL error: %v", mysqlErr))
+		return err
+	}
+}
@@ -1,6 +1,8 @@
 package service
 
 import (
+	"context"
+	"database/sql"
 	"errors"
 	"testing"
 	"time"
@@ -10,6 +12,7 @@ import (
 	"github.com/maxwelbm/alkemy-g7.git/internal/model"
 	"github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestPostInboundOrder(t *testing.T) {
@@ -17,7 +20,10 @@ func TestPostInboundOrder(t *testing.T) {
 	employeeSvc := mocks.NewMockIEmployeeService(t)
 	warehouseSvc := mocks.NewMockIWarehouseService(t)
 
-	service := NewInboundOrderService(repo, employeeSvc, warehouseSvc, mocks.MockLog{})
+	batchesRepo := mocks.NewMockIProductBatchesRepo(t)
+	sectionRepo := mocks.NewMockISectionRepo(t)
+
+	service := NewInboundOrderService(repo, batchesRepo, sectionRepo, mocks.NewMockIUnitOfWork(t), employeeSvc, warehouseSvc, mocks.NewMockIProductBatchesService(t), mocks.NewMockITemperatureExcursionService(t), mocks.MockLog{})
 
 	inboundOrder := model.InboundOrder{
 		ID:             1,
@@ -28,12 +34,15 @@ func TestPostInboundOrder(t *testing.T) {
 		WareHouseID:    1,
 	}
 
+	batchesRepo.On("GetByID", mock.Anything, inboundOrder.ProductBatchID).Return(model.ProductBatches{ID: 1, SectionID: 1}, nil).Maybe()
+	sectionRepo.On("GetByID", mock.Anything, 1).Return(model.Section{ID: 1, WarehouseID: 1}, nil).Maybe()
+
 	t.Run("should return the created inbound order and no error", func(t *testing.T) {
-		employeeSvc.On("GetEmployeeByID", inboundOrder.EmployeeID).Return(model.Employee{ID: 1}, nil).Once()
-		warehouseSvc.On("GetByIDWareHouse", inboundOrder.WareHouseID).Return(model.WareHouse{ID: 1}, nil).Once()
-		repo.On("Post", inboundOrder).Return(inboundOrder, nil).Once()
+		employeeSvc.On("GetEmployeeByID", mock.Anything, inboundOrder.EmployeeID).Return(model.Employee{ID: 1, WarehouseID: 1}, nil).Once()
+		warehouseSvc.On("GetByIDWareHouse", mock.Anything, inboundOrder.WareHouseID).Return(model.WareHouse{ID: 1}, nil).Once()
+		repo.On("Post", mock.Anything, inboundOrder).Return(inboundOrder, nil).Once()
 
-		result, err := service.Post(inboundOrder)
+		result, err := service.Post(context.Background(), inboundOrder)
 
 		assert.NoError(t, err)
 		assert.Equal(t, inboundOrder, result)
@@ -45,7 +54,7 @@ func TestPostInboundOrder(t *testing.T) {
 			OrderNumber: "",
 		}
 
-		result, err := service.Post(invalidInboundOrder)
+		result, err := service.Post(context.Background(), invalidInboundOrder)
 
 		assert.Error(t, err)
 		assert.Equal(t, customerror.InboundErrInvalidEntry, err)
@@ -53,9 +62,9 @@ func TestPostInboundOrder(t *testing.T) {
 	})
 
 	t.Run("should return error when employee does not exist", func(t *testing.T) {
-		employeeSvc.On("GetEmployeeByID", inboundOrder.EmployeeID).Return(model.Employee{}, customerror.EmployeeErrNotFound).Once()
+		employeeSvc.On("GetEmployeeByID", mock.Anything, inboundOrder.EmployeeID).Return(model.Employee{}, customerror.EmployeeErrNotFound).Once()
 
-		result, err := service.Post(inboundOrder)
+		result, err := service.Post(context.Background(), inboundOrder)
 
 		assert.Error(t, err)
 		assert.Equal(t, customerror.InboundErrInvalidEmployee, err)
@@ -63,10 +72,10 @@ func TestPostInboundOrder(t *testing.T) {
 	})
 
 	t.Run("should return error when warehouse does not exist", func(t *testing.T) {
-		employeeSvc.On("GetEmployeeByID", inboundOrder.EmployeeID).Return(model.Employee{ID: 1}, nil).Once()
-		warehouseSvc.On("GetByIDWareHouse", inboundOrder.WareHouseID).Return(model.WareHouse{}, customerror.InboundErrInvalidWarehouse).Once()
+		employeeSvc.On("GetEmployeeByID", mock.Anything, inboundOrder.EmployeeID).Return(model.Employee{ID: 1, WarehouseID: 1}, nil).Once()
+		warehouseSvc.On("GetByIDWareHouse", mock.Anything, inboundOrder.WareHouseID).Return(model.WareHouse{}, customerror.InboundErrInvalidWarehouse).Once()
 
-		result, err := service.Post(inboundOrder)
+		result, err := service.Post(context.Background(), inboundOrder)
 
 		assert.Error(t, err)
 		assert.Equal(t, customerror.InboundErrInvalidWarehouse, err)
@@ -74,12 +83,52 @@ func TestPostInboundOrder(t *testing.T) {
 
 	})
 
+	t.Run("should return error when employee works in another warehouse", func(t *testing.T) {
+		employeeSvc.On("GetEmployeeByID", mock.Anything, inboundOrder.EmployeeID).Return(model.Employee{ID: 1, WarehouseID: 2}, nil).Once()
+		warehouseSvc.On("GetByIDWareHouse", mock.Anything, inboundOrder.WareHouseID).Return(model.WareHouse{ID: 1}, nil).Once()
+
+		result, err := service.Post(context.Background(), inboundOrder)
+
+		assert.Equal(t, customerror.InboundErrEmployeeWarehouse, err)
+		assert.Empty(t, result)
+	})
+
+	t.Run("should return error when product batch is not found", func(t *testing.T) {
+		unknownBatch := inboundOrder
+		unknownBatch.ProductBatchID = 99
+
+		employeeSvc.On("GetEmployeeByID", mock.Anything, inboundOrder.EmployeeID).Return(model.Employee{ID: 1, WarehouseID: 1}, nil).Once()
+		warehouseSvc.On("GetByIDWareHouse", mock.Anything, inboundOrder.WareHouseID).Return(model.WareHouse{ID: 1}, nil).Once()
+		batchesRepo.On("GetByID", mock.Anything, 99).Return(model.ProductBatches{}, customerror.HandleError("product batches", customerror.ErrorNotFound, "")).Once()
+
+		result, err := service.Post(context.Background(), unknownBatch)
+
+		assert.Equal(t, customerror.InboundErrInvalidProductBatch, err)
+		assert.Empty(t, result)
+	})
+
+	t.Run("should return error when product batch is stored in another warehouse", func(t *testing.T) {
+		otherBatch := inboundOrder
+		otherBatch.ProductBatchID = 2
+
+		employeeSvc.On("GetEmployeeByID", mock.Anything, inboundOrder.EmployeeID).Return(model.Employee{ID: 1, WarehouseID: 1}, nil).Once()
+		warehouseSvc.On("GetByIDWareHouse", mock.Anything, inboundOrder.WareHouseID).Return(model.WareHouse{ID: 1}, nil).Once()
+		batchesRepo.On("GetByID", mock.Anything, 2).Return(model.ProductBatches{ID: 2, SectionID: 2}, nil).Once()
+		sectionRepo.On("GetByID", mock.Anything, 2).Return(model.Section{ID: 2, WarehouseID: 2}, nil).Once()
+
+		result, err := service.Post(context.Background(), otherBatch)
+
+		assert.Equal(t, customerror.InboundErrSectionWarehouse, err)
+		assert.Empty(t, result)
+		repo.AssertNotCalled(t, "Post", mock.Anything, otherBatch)
+	})
+
 	t.Run("should return error when product batch does not exist", func(t *testing.T) {
-		employeeSvc.On("GetEmployeeByID", inboundOrder.EmployeeID).Return(model.Employee{ID: 1}, nil).Once()
-		warehouseSvc.On("GetByIDWareHouse", inboundOrder.WareHouseID).Return(model.WareHouse{ID: 1}, nil).Once()
-		repo.On("Post", inboundOrder).Return(model.InboundOrder{}, &mysql.MySQLError{Number: 1452}).Once()
+		employeeSvc.On("GetEmployeeByID", mock.Anything, inboundOrder.EmployeeID).Return(model.Employee{ID: 1, WarehouseID: 1}, nil).Once()
+		warehouseSvc.On("GetByIDWareHouse", mock.Anything, inboundOrder.WareHouseID).Return(model.WareHouse{ID: 1}, nil).Once()
+		repo.On("Post", mock.Anything, inboundOrder).Return(model.InboundOrder{}, &mysql.MySQLError{Number: 1452}).Once()
 
-		result, err := service.Post(inboundOrder)
+		result, err := service.Post(context.Background(), inboundOrder)
 
 		assert.Error(t, err)
 		assert.Equal(t, customerror.InboundErrInvalidProductBatch, err)
@@ -88,11 +137,11 @@ func TestPostInboundOrder(t *testing.T) {
 	})
 
 	t.Run("should return error when order number is duplicated", func(t *testing.T) {
-		employeeSvc.On("GetEmployeeByID", inboundOrder.EmployeeID).Return(model.Employee{ID: 1}, nil).Once()
-		warehouseSvc.On("GetByIDWareHouse", inboundOrder.WareHouseID).Return(model.WareHouse{ID: 1}, nil).Once()
-		repo.On("Post", inboundOrder).Return(model.InboundOrder{}, &mysql.MySQLError{Number: 1062}).Once()
+		employeeSvc.On("GetEmployeeByID", mock.Anything, inboundOrder.EmployeeID).Return(model.Employee{ID: 1, WarehouseID: 1}, nil).Once()
+		warehouseSvc.On("GetByIDWareHouse", mock.Anything, inboundOrder.WareHouseID).Return(model.WareHouse{ID: 1}, nil).Once()
+		repo.On("Post", mock.Anything, inboundOrder).Return(model.InboundOrder{}, &mysql.MySQLError{Number: 1062}).Once()
 
-		result, err := service.Post(inboundOrder)
+		result, err := service.Post(context.Background(), inboundOrder)
 
 		assert.Error(t, err)
 		assert.Equal(t, customerror.InboundErrDuplicatedOrderNumber, err)
@@ -101,11 +150,11 @@ func TestPostInboundOrder(t *testing.T) {
 	})
 
 	t.Run("should return error when unmapped sql error occurs", func(t *testing.T) {
-		employeeSvc.On("GetEmployeeByID", inboundOrder.EmployeeID).Return(model.Employee{ID: 1}, nil).Once()
-		warehouseSvc.On("GetByIDWareHouse", inboundOrder.WareHouseID).Return(model.WareHouse{ID: 1}, nil).Once()
-		repo.On("Post", inboundOrder).Return(model.InboundOrder{}, &mysql.MySQLError{Number: 66}).Once()
+		employeeSvc.On("GetEmployeeByID", mock.Anything, inboundOrder.EmployeeID).Return(model.Employee{ID: 1, WarehouseID: 1}, nil).Once()
+		warehouseSvc.On("GetByIDWareHouse", mock.Anything, inboundOrder.WareHouseID).Return(model.WareHouse{ID: 1}, nil).Once()
+		repo.On("Post", mock.Anything, inboundOrder).Return(model.InboundOrder{}, &mysql.MySQLError{Number: 66}).Once()
 
-		result, err := service.Post(inboundOrder)
+		result, err := service.Post(context.Background(), inboundOrder)
 
 		assert.Error(t, err)
 		assert.Empty(t, result)
@@ -113,11 +162,11 @@ func TestPostInboundOrder(t *testing.T) {
 	})
 
 	t.Run("should return error when an unexpected error occurs", func(t *testing.T) {
-		employeeSvc.On("GetEmployeeByID", inboundOrder.EmployeeID).Return(model.Employee{ID: 1}, nil).Once()
-		warehouseSvc.On("GetByIDWareHouse", inboundOrder.WareHouseID).Return(model.WareHouse{ID: 1}, nil).Once()
-		repo.On("Post", inboundOrder).Return(model.InboundOrder{}, errors.New("unexpected error")).Once()
+		employeeSvc.On("GetEmployeeByID", mock.Anything, inboundOrder.EmployeeID).Return(model.Employee{ID: 1, WarehouseID: 1}, nil).Once()
+		warehouseSvc.On("GetByIDWareHouse", mock.Anything, inboundOrder.WareHouseID).Return(model.WareHouse{ID: 1}, nil).Once()
+		repo.On("Post", mock.Anything, inboundOrder).Return(model.InboundOrder{}, errors.New("unexpected error")).Once()
 
-		result, err := service.Post(inboundOrder)
+		result, err := service.Post(context.Background(), inboundOrder)
 
 		assert.Error(t, err)
 		assert.Equal(t, errors.New("unexpected error"), err)
@@ -125,3 +174,189 @@ func TestPostInboundOrder(t *testing.T) {
 
 	})
 }
+
+func TestPostInboundOrderWithBatches(t *testing.T) {
+	setup := func(t *testing.T) (*InboundOrderService, *mocks.MockIInboundOrderRepository, *mocks.MockIProductBatchesRepo, *mocks.MockISectionRepo, *mocks.MockIProductBatchesService, *mocks.MockITemperatureExcursionService) {
+		repo := mocks.NewMockIInboundOrderRepository(t)
+		batchesRepo := mocks.NewMockIProductBatchesRepo(t)
+		sectionRepo := mocks.NewMockISectionRepo(t)
+		uow := mocks.NewMockIUnitOfWork(t)
+		employeeSvc := mocks.NewMockIEmployeeService(t)
+		warehouseSvc := mocks.NewMockIWarehouseService(t)
+		batchesSvc := mocks.NewMockIProductBatchesService(t)
+		excursionSvc := mocks.NewMockITemperatureExcursionService(t)
+
+		repo.On("WithTx", mock.Anything).Return(repo).Maybe()
+		batchesRepo.On("WithTx", mock.Anything).Return(batchesRepo).Maybe()
+		sectionRepo.On("WithTx", mock.Anything).Return(sectionRepo).Maybe()
+		uow.On("Do", mock.Anything, mock.Anything).Return(func(ctx context.Context, fn func(*sql.Tx) error) error { return fn(nil) }).Maybe()
+		employeeSvc.On("GetEmployeeByID", mock.Anything, 1).Return(model.Employee{ID: 1, WarehouseID: 1}, nil).Maybe()
+		warehouseSvc.On("GetByIDWareHouse", mock.Anything, 1).Return(model.WareHouse{ID: 1}, nil).Maybe()
+		sectionRepo.On("GetByID", mock.Anything, 1).Return(model.Section{ID: 1, WarehouseID: 1}, nil).Maybe()
+		sectionRepo.On("GetByID", mock.Anything, 2).Return(model.Section{ID: 2, WarehouseID: 1}, nil).Maybe()
+
+		service := NewInboundOrderService(repo, batchesRepo, sectionRepo, uow, employeeSvc, warehouseSvc, batchesSvc, excursionSvc, mocks.MockLog{})
+
+		return service, repo, batchesRepo, sectionRepo, batchesSvc, excursionSvc
+	}
+
+	orderDate := time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	newInboundOrder := func() model.InboundOrder {
+		return model.InboundOrder{
+			OrderDate:   orderDate,
+			OrderNumber: "ORD123",
+			EmployeeID:  1,
+			WareHouseID: 1,
+			ProductBatches: []model.ProductBatches{
+				{BatchNumber: "B01", CurrentQuantity: 10, CurrentTemperature: 5, ProductID: 1, SectionID: 1},
+				{BatchNumber: "B02", CurrentQuantity: 5, CurrentTemperature: 6, ProductID: 2, SectionID: 2},
+			},
+		}
+	}
+
+	t.Run("should create the batches and the order in one transaction", func(t *testing.T) {
+		service, repo, batchesRepo, sectionRepo, batchesSvc, excursionSvc := setup(t)
+		inboundOrder := newInboundOrder()
+
+		batchesSvc.On("ValidateNew", mock.Anything, mock.Anything).Return(nil).Once()
+		batchesRepo.On("Post", mock.Anything, mock.MatchedBy(func(pb *model.ProductBatches) bool { return pb.BatchNumber == "B01" })).
+			Return(model.ProductBatches{ID: 4, BatchNumber: "B01", CurrentQuantity: 10, CurrentTemperature: 5, ProductID: 1, SectionID: 1}, nil).Once()
+		batchesRepo.On("Post", mock.Anything, mock.MatchedBy(func(pb *model.ProductBatches) bool { return pb.BatchNumber == "B02" })).
+			Return(model.ProductBatches{ID: 5, BatchNumber: "B02", CurrentQuantity: 5, CurrentTemperature: 6, ProductID: 2, SectionID: 2}, nil).Once()
+		sectionRepo.On("IncreaseCurrentCapacity", mock.Anything, 1, 10).Return(nil).Once()
+		sectionRepo.On("IncreaseCurrentCapacity", mock.Anything, 2, 5).Return(nil).Once()
+		repo.On("Post", mock.Anything, mock.MatchedBy(func(io model.InboundOrder) bool { return io.ProductBatchID == 4 })).
+			Return(model.InboundOrder{ID: 7, OrderDate: orderDate, OrderNumber: "ORD123", EmployeeID: 1, ProductBatchID: 4, WareHouseID: 1}, nil).Once()
+		repo.On("AddBatches", mock.Anything, 7, []int{4, 5}).Return(nil).Once()
+		excursionSvc.On("EvaluateBatch", mock.Anything, 4, 5.0, mock.Anything).Return(nil).Once()
+		excursionSvc.On("EvaluateBatch", mock.Anything, 5, 6.0, mock.Anything).Return(errors.New("unexpected error")).Once()
+
+		result, err := service.Post(context.Background(), inboundOrder)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 7, result.ID)
+		assert.Equal(t, 4, result.ProductBatchID)
+		assert.Equal(t, []int{4, 5}, result.ProductBatchIDs)
+		assert.Len(t, result.ProductBatches, 2)
+	})
+
+	t.Run("should return error when the batches are not valid", func(t *testing.T) {
+		service, _, batchesRepo, _, batchesSvc, _ := setup(t)
+		expectedErr := customerror.NewError(409, customerror.ErrCapacityExceeded.Error(), "section", "")
+
+		batchesSvc.On("ValidateNew", mock.Anything, mock.Anything).Return(expectedErr).Once()
+
+		result, err := service.Post(context.Background(), newInboundOrder())
+
+		assert.Equal(t, expectedErr, err)
+		assert.Empty(t, result)
+		batchesRepo.AssertNotCalled(t, "Post", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should return error and stop when a batch cannot be created", func(t *testing.T) {
+		service, repo, batchesRepo, _, batchesSvc, _ := setup(t)
+
+		batchesSvc.On("ValidateNew", mock.Anything, mock.Anything).Return(nil).Once()
+		batchesRepo.On("Post", mock.Anything, mock.Anything).Return(model.ProductBatches{}, errors.New("unexpected error")).Once()
+
+		result, err := service.Post(context.Background(), newInboundOrder())
+
+		assert.Equal(t, errors.New("unexpected error"), err)
+		assert.Empty(t, result)
+		repo.AssertNotCalled(t, "Post", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should return error when order number is duplicated", func(t *testing.T) {
+		service, repo, batchesRepo, sectionRepo, batchesSvc, _ := setup(t)
+
+		batchesSvc.On("ValidateNew", mock.Anything, mock.Anything).Return(nil).Once()
+		batchesRepo.On("Post", mock.Anything, mock.Anything).Return(model.ProductBatches{ID: 4}, nil).Twice()
+		sectionRepo.On("IncreaseCurrentCapacity", mock.Anything, mock.Anything, mock.Anything).Return(nil).Twice()
+		repo.On("Post", mock.Anything, mock.Anything).Return(model.InboundOrder{}, &mysql.MySQLError{Number: 1062}).Once()
+
+		result, err := service.Post(context.Background(), newInboundOrder())
+
+		assert.Equal(t, customerror.InboundErrDuplicatedOrderNumber, err)
+		assert.Empty(t, result)
+		repo.AssertNotCalled(t, "AddBatches", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("should return error when a batch is stored in a section of another warehouse", func(t *testing.T) {
+		service, _, _, sectionRepo, batchesSvc, _ := setup(t)
+		inboundOrder := newInboundOrder()
+		inboundOrder.ProductBatches[1].SectionID = 3
+
+		sectionRepo.On("GetByID", mock.Anything, 3).Return(model.Section{ID: 3, WarehouseID: 2}, nil).Once()
+
+		result, err := service.Post(context.Background(), inboundOrder)
+
+		assert.Equal(t, customerror.InboundErrSectionWarehouse, err)
+		assert.Empty(t, result)
+		batchesSvc.AssertNotCalled(t, "ValidateNew", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should return error when an existing batch is also given", func(t *testing.T) {
+		service, _, _, _, _, _ := setup(t)
+		inboundOrder := newInboundOrder()
+		inboundOrder.ProductBatchID = 1
+
+		result, err := service.Post(context.Background(), inboundOrder)
+
+		assert.Equal(t, customerror.InboundErrInvalidEntry, err)
+		assert.Empty(t, result)
+	})
+}
+
+func TestGetInboundOrders(t *testing.T) {
+	repo := mocks.NewMockIInboundOrderRepository(t)
+	service := NewInboundOrderService(repo, nil, nil, nil, nil, nil, nil, nil, mocks.MockLog{})
+
+	t.Run("should return the inbound orders page", func(t *testing.T) {
+		params := model.ListParams{Page: 1, PageSize: 10, Filters: map[string]string{"warehouse_id": "1"}}
+		expected := []model.InboundOrder{{ID: 1, OrderNumber: "ORD123", WareHouseID: 1}}
+
+		repo.On("Get", mock.Anything, params).Return(expected, 1, nil).Once()
+
+		result, total, err := service.Get(context.Background(), params)
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, result)
+		assert.Equal(t, 1, total)
+	})
+
+	t.Run("should return error when the repository fails", func(t *testing.T) {
+		repo.On("Get", mock.Anything, model.ListParams{}).Return(nil, 0, errors.New("unexpected error")).Once()
+
+		result, total, err := service.Get(context.Background(), model.ListParams{})
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		assert.Zero(t, total)
+	})
+}
+
+func TestGetInboundOrderByID(t *testing.T) {
+	repo := mocks.NewMockIInboundOrderRepository(t)
+	service := NewInboundOrderService(repo, nil, nil, nil, nil, nil, nil, nil, mocks.MockLog{})
+
+	t.Run("should return the inbound order", func(t *testing.T) {
+		expected := model.InboundOrder{ID: 1, OrderNumber: "ORD123", ProductBatchID: 4, ProductBatchIDs: []int{4, 5}}
+
+		repo.On("GetByID", mock.Anything, 1).Return(expected, nil).Once()
+
+		result, err := service.GetByID(context.Background(), 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, result)
+	})
+
+	t.Run("should return error when the inbound order does not exist", func(t *testing.T) {
+		repo.On("GetByID", mock.Anything, 99).Return(model.InboundOrder{}, customerror.InboundErrNotFound).Once()
+
+		result, err := service.GetByID(context.Background(), 99)
+
+		assert.Equal(t, customerror.InboundErrNotFound, err)
+		assert.Empty(t, result)
+	})
+}
@@ -1,13 +1,17 @@
 package interfaces
 
-import "github.com/maxwelbm/alkemy-g7.git/internal/model"
+import (
+	"context"
+
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+)
 
 type IBuyerservice interface {
-	GetAllBuyer() (buyers []model.Buyer, err error)
-	GetBuyerByID(id int) (buyer model.Buyer, err error)
-	DeleteBuyerByID(id int) (err error)
-	CreateBuyer(newBuyer model.Buyer) (buyer model.Buyer, err error)
-	UpdateBuyer(id int, newBuyer model.Buyer) (buyer model.Buyer, err error)
-	CountPurchaseOrderBuyer() (countBuyerPurchaseOrder []model.BuyerPurchaseOrder, err error)
-	CountPurchaseOrderByBuyerID(id int) (countBuyerPurchaseOrder model.BuyerPurchaseOrder, err error)
+	GetAllBuyer(ctx context.Context, params model.ListParams) (buyers []model.Buyer, total int, err error)
+	GetBuyerByID(ctx context.Context, id int) (buyer model.Buyer, err error)
+	DeleteBuyerByID(ctx context.Context, id int) (err error)
+	CreateBuyer(ctx context.Context, newBuyer model.Buyer) (buyer model.Buyer, err error)
+	UpdateBuyer(ctx context.Context, id int, newBuyer model.Buyer) (buyer model.Buyer, err error)
+	CountPurchaseOrderBuyer(ctx context.Context) (countBuyerPurchaseOrder []model.BuyerPurchaseOrder, err error)
+	CountPurchaseOrderByBuyerID(ctx context.Context, id int) (countBuyerPurchaseOrder model.BuyerPurchaseOrder, err error)
 }
@@ -1,8 +1,15 @@
 package interfaces
 
-import "github.com/maxwelbm/alkemy-g7.git/internal/model"
+import (
+	"context"
+
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+)
 
 type ICarrierService interface {
-	PostCarrier(newCarrier model.Carries) (carrier model.Carries, err error)
-	GetByID(id int) (carrier model.Carries, err error)
+	PostCarrier(ctx context.Context, newCarrier model.Carries) (carrier model.Carries, err error)
+	GetByID(ctx context.Context, id int) (carrier model.Carries, err error)
+	Get(ctx context.Context, params model.ListParams) (carriers []model.Carries, total int, err error)
+	PatchCarrier(ctx context.Context, id int, carrier model.Carries) (updated model.Carries, err error)
+	DeleteCarrier(ctx context.Context, id int) (err error)
 }
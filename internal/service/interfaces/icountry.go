@@ -0,0 +1,16 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+)
+
+type ICountryService interface {
+	GetAll(ctx context.Context, params model.ListParams) ([]model.Country, int, error)
+	GetByID(ctx context.Context, id int) (model.Country, error)
+	Create(ctx context.Context, country model.Country) (model.Country, error)
+	Update(ctx context.Context, id int, country model.Country) (model.Country, error)
+	Delete(ctx context.Context, id int) error
+	GetReport(ctx context.Context, id int) ([]model.CountryReport, error)
+}
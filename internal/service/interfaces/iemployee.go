@@ -1,13 +1,17 @@
 package interfaces
 
-import "github.com/maxwelbm/alkemy-g7.git/internal/model"
+import (
+	"context"
+
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+)
 
 type IEmployeeService interface {
-	GetEmployees() ([]model.Employee, error)
-	GetEmployeeByID(id int) (model.Employee, error)
-	UpdateEmployee(id int, employee model.Employee) (model.Employee, error)
-	InsertEmployee(employee model.Employee) (model.Employee, error)
-	DeleteEmployee(id int) error
-	GetInboundOrdersReportByEmployee(employeeID int) (model.InboundOrdersReportByEmployee, error)
-	GetInboundOrdersReports() ([]model.InboundOrdersReportByEmployee, error)
+	GetEmployees(ctx context.Context, params model.ListParams) ([]model.Employee, int, error)
+	GetEmployeeByID(ctx context.Context, id int) (model.Employee, error)
+	UpdateEmployee(ctx context.Context, id int, employee model.Employee) (model.Employee, error)
+	InsertEmployee(ctx context.Context, employee model.Employee) (model.Employee, error)
+	DeleteEmployee(ctx context.Context, id int) error
+	GetInboundOrdersReportByEmployee(ctx context.Context, employeeID int) (model.InboundOrdersReportByEmployee, error)
+	GetInboundOrdersReports(ctx context.Context) ([]model.InboundOrdersReportByEmployee, error)
 }
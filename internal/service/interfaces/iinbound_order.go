@@ -1,7 +1,13 @@
 package interfaces
 
-import "github.com/maxwelbm/alkemy-g7.git/internal/model"
+import (
+	"context"
+
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+)
 
 type IInboundOrderService interface {
-	Post(inboundOrder model.InboundOrder) (model.InboundOrder, error)
+	Get(ctx context.Context, params model.ListParams) ([]model.InboundOrder, int, error)
+	GetByID(ctx context.Context, id int) (model.InboundOrder, error)
+	Post(ctx context.Context, inboundOrder model.InboundOrder) (model.InboundOrder, error)
 }
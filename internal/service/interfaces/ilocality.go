@@ -1,12 +1,17 @@
 package interfaces
 
 import (
+	"context"
+
 	"github.com/maxwelbm/alkemy-g7.git/internal/model"
 )
 
 type ILocalityService interface {
-	GetSellers(id int) (report []model.LocalitiesJSONSellers, err error)
-	GetCarriers(id int) (report []model.LocalitiesJSONCarriers, err error)
-	GetByID(id int) (locality model.Locality, err error)
-	CreateLocality(locality *model.Locality) (l model.Locality, err error)
+	GetSellers(ctx context.Context, id int) (report []model.LocalitiesJSONSellers, err error)
+	GetCarriers(ctx context.Context, id int) (report []model.LocalitiesJSONCarriers, err error)
+	GetByID(ctx context.Context, id int) (locality model.Locality, err error)
+	GetAll(ctx context.Context, params model.ListParams) (localities []model.Locality, total int, err error)
+	CreateLocality(ctx context.Context, locality *model.Locality) (l model.Locality, err error)
+	UpdateLocality(ctx context.Context, id int, locality *model.Locality) (l model.Locality, err error)
+	DeleteLocality(ctx context.Context, id int) (d model.LocalityDependencies, err error)
 }
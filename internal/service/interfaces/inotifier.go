@@ -0,0 +1,12 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+)
+
+// INotifier delivers the alerts raised for batches that are about to expire or already expired.
+type INotifier interface {
+	NotifyExpiry(ctx context.Context, batch model.ExpiringBatch) error
+}
@@ -1,8 +1,17 @@
 package interfaces
 
-import "github.com/maxwelbm/alkemy-g7.git/internal/model"
+import (
+	"context"
+
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+)
 
 type IProductBatchesService interface {
-	GetByID(id int) (model.ProductBatches, error)
-	Post(prodBatches *model.ProductBatches) (model.ProductBatches, error)
+	Get(ctx context.Context, params model.ListParams) ([]model.ProductBatches, int, error)
+	GetByID(ctx context.Context, id int) (model.ProductBatches, error)
+	Post(ctx context.Context, prodBatches *model.ProductBatches) (model.ProductBatches, error)
+	ValidateNew(ctx context.Context, batches []*model.ProductBatches) error
+	Update(ctx context.Context, id int, update model.ProductBatchesUpdate) (model.ProductBatches, error)
+	Delete(ctx context.Context, id int) error
+	GetExpiringReport(ctx context.Context, days int) ([]model.ExpiringBatchesWarehouse, error)
 }
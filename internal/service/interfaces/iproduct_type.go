@@ -0,0 +1,17 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+)
+
+type IProductTypeService interface {
+	GetAll(ctx context.Context) ([]model.ProductType, error)
+	GetByID(ctx context.Context, id int) (model.ProductType, error)
+	Create(ctx context.Context, productType model.ProductType) (model.ProductType, error)
+	Update(ctx context.Context, id int, productType model.ProductType) (model.ProductType, error)
+	Delete(ctx context.Context, id int) error
+	GetUsageReport(ctx context.Context) ([]model.ProductTypeUsage, error)
+	GetUsageReportByID(ctx context.Context, id int) (model.ProductTypeUsage, error)
+}
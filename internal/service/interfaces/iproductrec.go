@@ -1,9 +1,20 @@
 package interfaces
 
-import "github.com/maxwelbm/alkemy-g7.git/internal/model"
+import (
+	"context"
+	"time"
+
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+)
 
 type IProductRecService interface {
-	CreateProductRecords(pr model.ProductRecords) (model.ProductRecords, error)
-	GetProductRecordByID(id int) (model.ProductRecords, error)
-	GetProductRecordReport(idProduct int) ([]model.ProductRecordsReport, error)
+	CreateProductRecords(ctx context.Context, pr model.ProductRecords) (model.ProductRecords, error)
+	GetProductRecords(ctx context.Context, params model.ListParams) ([]model.ProductRecords, int, error)
+	GetProductRecordByID(ctx context.Context, id int) (model.ProductRecords, error)
+	DeleteProductRecord(ctx context.Context, id int) error
+	GetLatestProductRecord(ctx context.Context, idProduct int) (model.ProductRecords, error)
+	GetProductRecordReport(ctx context.Context, idProduct int) ([]model.ProductRecordsReport, error)
+	GetProductPriceHistory(ctx context.Context, idProduct int, from, to time.Time) (model.ProductPriceHistory, error)
+	GetProductMarginReport(ctx context.Context, filter model.ProductMarginFilter) ([]model.ProductMarginReport, error)
+	GetSellerMarginReport(ctx context.Context, filter model.ProductMarginFilter) ([]model.SellerMarginReport, error)
 }
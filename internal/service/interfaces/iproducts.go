@@ -1,11 +1,15 @@
 package interfaces
 
-import "github.com/maxwelbm/alkemy-g7.git/internal/model"
+import (
+	"context"
+
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+)
 
 type IProductService interface {
-	GetAllProducts() ([]model.Product, error)
-	GetProductByID(id int) (model.Product, error)
-	CreateProduct(product model.Product) (model.Product, error)
-	UpdateProduct(id int, product model.Product) (model.Product, error)
-	DeleteProduct(id int) error 
+	GetAllProducts(ctx context.Context, params model.ListParams) ([]model.Product, int, error)
+	GetProductByID(ctx context.Context, id int) (model.Product, error)
+	CreateProduct(ctx context.Context, product model.Product) (model.Product, error)
+	UpdateProduct(ctx context.Context, id int, product model.Product) (model.Product, error)
+	DeleteProduct(ctx context.Context, id int) error 
 }
\ No newline at end of file
@@ -0,0 +1,16 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+)
+
+type IProvinceService interface {
+	GetAll(ctx context.Context, params model.ListParams) ([]model.Province, int, error)
+	GetByID(ctx context.Context, id int) (model.Province, error)
+	Create(ctx context.Context, province model.Province) (model.Province, error)
+	Update(ctx context.Context, id int, province model.Province) (model.Province, error)
+	Delete(ctx context.Context, id int) error
+	GetReport(ctx context.Context, id int) ([]model.ProvinceReport, error)
+}
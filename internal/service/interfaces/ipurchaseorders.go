@@ -1,8 +1,15 @@
 package interfaces
 
-import "github.com/maxwelbm/alkemy-g7.git/internal/model"
+import (
+	"context"
+
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+)
 
 type IPurchaseOrdersService interface {
-	GetPurchaseOrderByID(id int) (purchaseOrder model.PurchaseOrder, err error)
-	CreatePurchaseOrder(newPurchaseOrder model.PurchaseOrder) (purchaseOrder model.PurchaseOrder, err error)
+	GetPurchaseOrderByID(ctx context.Context, id int) (purchaseOrder model.PurchaseOrder, err error)
+	GetPurchaseOrders(ctx context.Context, params model.ListParams) (purchaseOrders []model.PurchaseOrder, total int, err error)
+	CreatePurchaseOrder(ctx context.Context, newPurchaseOrder model.PurchaseOrder) (purchaseOrder model.PurchaseOrder, err error)
+	UpdatePurchaseOrderStatus(ctx context.Context, id int, update model.PurchaseOrderStatusUpdate) (purchaseOrder model.PurchaseOrder, err error)
+	CancelPurchaseOrder(ctx context.Context, id int) (purchaseOrder model.PurchaseOrder, err error)
 }
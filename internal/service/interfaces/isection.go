@@ -1,13 +1,18 @@
 package interfaces
 
-import "github.com/maxwelbm/alkemy-g7.git/internal/model"
+import (
+	"context"
+
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+)
 
 type ISectionService interface {
-	Get() ([]model.Section, error)
-	GetByID(id int) (model.Section, error)
-	Post(section *model.Section) (model.Section, error)
-	Update(id int, section *model.Section) (model.Section, error)
-	Delete(id int) error
-	CountProductBatchesBySectionID(id int) (countProdBatches model.SectionProductBatches, err error)
-	CountProductBatchesSections() (countProductBatches []model.SectionProductBatches, err error)
+	Get(ctx context.Context, params model.ListParams) ([]model.Section, int, error)
+	GetByID(ctx context.Context, id int) (model.Section, error)
+	Post(ctx context.Context, section *model.Section) (model.Section, error)
+	Update(ctx context.Context, id int, section *model.Section) (model.Section, error)
+	Delete(ctx context.Context, id int) error
+	CountProductBatchesBySectionID(ctx context.Context, id int) (countProdBatches model.SectionProductBatches, err error)
+	CountProductBatchesSections(ctx context.Context) (countProductBatches []model.SectionProductBatches, err error)
+	GetCapacityReport(ctx context.Context, nearFullRatio float64) ([]model.SectionCapacity, error)
 }
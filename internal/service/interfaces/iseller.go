@@ -1,11 +1,15 @@
 package interfaces
 
-import "github.com/maxwelbm/alkemy-g7.git/internal/model"
+import (
+	"context"
+
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+)
 
 type ISellerService interface {
-	GetAll() (sellers []model.Seller, err error)
-	GetByID(id int) (sl model.Seller, err error)
-	CreateSeller(seller *model.Seller) (sl model.Seller, err error)
-	UpdateSeller(id int, seller *model.Seller) (sl model.Seller, err error)
-	DeleteSeller(id int) error
+	GetAll(ctx context.Context, params model.ListParams) (sellers []model.Seller, total int, err error)
+	GetByID(ctx context.Context, id int) (sl model.Seller, err error)
+	CreateSeller(ctx context.Context, seller *model.Seller) (sl model.Seller, err error)
+	UpdateSeller(ctx context.Context, id int, seller *model.Seller) (sl model.Seller, err error)
+	DeleteSeller(ctx context.Context, id int) error
 }
@@ -0,0 +1,14 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+)
+
+type IShipmentService interface {
+	Create(ctx context.Context, shipment model.ShipmentCreate) (model.Shipment, error)
+	GetByID(ctx context.Context, id int) (model.Shipment, error)
+	UpdateStatus(ctx context.Context, id int, update model.ShipmentStatusUpdate) (model.Shipment, error)
+	GetTracking(ctx context.Context, purchaseOrderID int) (model.PurchaseOrderTracking, error)
+}
@@ -0,0 +1,15 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+)
+
+type ITemperatureExcursionService interface {
+	EvaluateSection(ctx context.Context, sectionID int, readings []model.TemperatureReading) error
+	EvaluateBatch(ctx context.Context, batchID int, temperature float64, at time.Time) error
+	GetBySection(ctx context.Context, sectionID int, status string) ([]model.TemperatureExcursion, error)
+	GetReport(ctx context.Context, filter model.ExcursionReportFilter) ([]model.SectionExcursionReport, error)
+}
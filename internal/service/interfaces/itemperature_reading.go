@@ -0,0 +1,12 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+)
+
+type ITemperatureReadingService interface {
+	Ingest(ctx context.Context, sectionID int, readings []model.TemperatureReading) (model.TemperatureIngestResult, error)
+	GetAggregates(ctx context.Context, query model.TemperatureQuery) ([]model.TemperatureAggregate, error)
+}
@@ -1,65 +1,171 @@
 package service
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+
 	"github.com/maxwelbm/alkemy-g7.git/internal/model"
 	"github.com/maxwelbm/alkemy-g7.git/internal/repository/interfaces"
+	er "github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
 	"github.com/maxwelbm/alkemy-g7.git/pkg/logger"
 )
 
-func CreateServiceLocalities(rp interfaces.ILocalityRepo, log logger.Logger) *LocalitiesService {
-	return &LocalitiesService{Rp: rp, log: log}
+func CreateServiceLocalities(rp interfaces.ILocalityRepo, rpProvince interfaces.IProvinceRepo, log logger.Logger) *LocalitiesService {
+	return &LocalitiesService{Rp: rp, RpProvince: rpProvince, log: log}
 }
 
 type LocalitiesService struct {
-	Rp  interfaces.ILocalityRepo
-	log logger.Logger
+	Rp         interfaces.ILocalityRepo
+	RpProvince interfaces.IProvinceRepo
+	log        logger.Logger
 }
 
-func (s *LocalitiesService) GetSellers(id int) (report []model.LocalitiesJSONSellers, err error) {
-	if id != 0 {
-		report, err = s.Rp.GetReportSellersWithID(id)
-		return
-	}
-
-	report, err = s.Rp.GetSellers(id)
+func (s *LocalitiesService) GetSellers(ctx context.Context, id int) (report []model.LocalitiesJSONSellers, err error) {
+	report, err = s.Rp.GetSellers(ctx, id)
 
 	s.log.Log("LocalitiesService", "INFO", fmt.Sprintf("Retrieved report sellers: %+v", report))
 
 	return
 }
 
-func (s *LocalitiesService) GetCarriers(id int) (report []model.LocalitiesJSONCarriers, err error) {
-	if id != 0 {
-		report, err = s.Rp.GetReportCarriersWithID(id)
-		return
-	}
-
-	report, err = s.Rp.GetCarriers(id)
+func (s *LocalitiesService) GetCarriers(ctx context.Context, id int) (report []model.LocalitiesJSONCarriers, err error) {
+	report, err = s.Rp.GetCarriers(ctx, id)
 
 	s.log.Log("LocalitiesService", "INFO", fmt.Sprintf("Retrieved report carriers: %+v", report))
 
 	return
 }
 
-func (s *LocalitiesService) GetByID(id int) (locality model.Locality, err error) {
-	locality, err = s.Rp.GetByID(id)
+func (s *LocalitiesService) GetByID(ctx context.Context, id int) (locality model.Locality, err error) {
+	locality, err = s.Rp.GetByID(ctx, id)
 
 	s.log.Log("LocalitiesService", "INFO", fmt.Sprintf("Retrieved locality by ID: %+v", locality))
 
 	return
 }
 
-func (s *LocalitiesService) CreateLocality(locality *model.Locality) (l model.Locality, err error) {
+func (s *LocalitiesService) CreateLocality(ctx context.Context, locality *model.Locality) (l model.Locality, err error) {
 	if err := locality.ValidateEmptyFields(locality); err != nil {
 		s.log.Log("LocalitiesService", "ERROR", fmt.Sprintf("Error: %+v", err))
 
 		return l, err
 	}
 
-	l, err = s.Rp.CreateLocality(locality)
+	if locality.ProvinceID == 0 {
+		locality.ProvinceID, err = s.provinceID(ctx, locality.Province, locality.Country)
+		if err != nil {
+			return l, err
+		}
+	}
+
+	l, err = s.Rp.CreateLocality(ctx, locality)
 
 	s.log.Log("LocalitiesService", "INFO", fmt.Sprintf("Created locality: %+v", l))
 
 	return
 }
+
+func (s *LocalitiesService) GetAll(ctx context.Context, params model.ListParams) (localities []model.Locality, total int, err error) {
+	localities, total, err = s.Rp.Get(ctx, params)
+
+	s.log.Log("LocalitiesService", "INFO", fmt.Sprintf("Retrieved %d of %d localities", len(localities), total))
+
+	return
+}
+
+// UpdateLocality renames the locality, sets its coordinates and moves it to another province, given
+// by province_id or by province and country names as on creation; fields left empty keep their value.
+func (s *LocalitiesService) UpdateLocality(ctx context.Context, id int, locality *model.Locality) (l model.Locality, err error) {
+	existing, err := s.Rp.GetByID(ctx, id)
+	if err != nil {
+		s.log.Log("LocalitiesService", "ERROR", fmt.Sprintf("Error: %+v", err))
+
+		return l, err
+	}
+
+	if locality.Locality != "" {
+		existing.Locality = locality.Locality
+	}
+
+	if locality.Latitude != nil || locality.Longitude != nil {
+		if err = model.ValidateCoordinates(locality.Latitude, locality.Longitude); err != nil {
+			s.log.Log("LocalitiesService", "ERROR", fmt.Sprintf("Error: %+v", err))
+
+			return l, er.NewLocalityErr(err.Error(), http.StatusUnprocessableEntity)
+		}
+
+		existing.Latitude, existing.Longitude = locality.Latitude, locality.Longitude
+	}
+
+	switch {
+	case locality.ProvinceID != 0:
+		existing.ProvinceID = locality.ProvinceID
+	case locality.Province != "" || locality.Country != "":
+		if locality.Province == "" || locality.Country == "" {
+			s.log.Log("LocalitiesService", "ERROR", fmt.Sprintf("Error: %+v", er.ErrNullLocalityAttribute))
+
+			return l, er.ErrNullLocalityAttribute
+		}
+
+		existing.ProvinceID, err = s.provinceID(ctx, locality.Province, locality.Country)
+		if err != nil {
+			return l, err
+		}
+	}
+
+	l, err = s.Rp.UpdateLocality(ctx, id, &existing)
+
+	s.log.Log("LocalitiesService", "INFO", fmt.Sprintf("Updated locality: %+v", l))
+
+	return
+}
+
+// DeleteLocality removes the locality unless sellers or carriers still reference it,
+// in which case the counts are returned along with er.ErrLocalityDependencies.
+func (s *LocalitiesService) DeleteLocality(ctx context.Context, id int) (d model.LocalityDependencies, err error) {
+	if _, err = s.Rp.GetByID(ctx, id); err != nil {
+		s.log.Log("LocalitiesService", "ERROR", fmt.Sprintf("Error: %+v", err))
+
+		return
+	}
+
+	d, err = s.Rp.CountDependencies(ctx, id)
+	if err != nil {
+		s.log.Log("LocalitiesService", "ERROR", fmt.Sprintf("Error: %+v", err))
+
+		return
+	}
+
+	if d.Sellers > 0 || d.Carriers > 0 {
+		s.log.Log("LocalitiesService", "ERROR", fmt.Sprintf("Error: %+v, dependencies: %+v", er.ErrLocalityDependencies, d))
+
+		return d, er.ErrLocalityDependencies
+	}
+
+	if err = s.Rp.DeleteLocality(ctx, id); err != nil {
+		s.log.Log("LocalitiesService", "ERROR", fmt.Sprintf("Error: %+v", err))
+
+		return
+	}
+
+	s.log.Log("LocalitiesService", "INFO", fmt.Sprintf("Deleted locality with ID: %d", id))
+
+	return
+}
+
+// provinceID looks the province up by its name and the name of its country.
+func (s *LocalitiesService) provinceID(ctx context.Context, provinceName, countryName string) (int, error) {
+	province, err := s.RpProvince.GetByName(ctx, provinceName, countryName)
+	if err != nil {
+		s.log.Log("LocalitiesService", "ERROR", fmt.Sprintf("Error: %+v", err))
+
+		if e, ok := err.(*er.GenericError); ok && e.Code == http.StatusNotFound {
+			err = er.ErrLocalityProvinceNotFound
+		}
+
+		return 0, err
+	}
+
+	return province.ID, nil
+}
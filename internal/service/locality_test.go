@@ -1,11 +1,14 @@
 package service_test
 
 import (
+	"context"
+	"errors"
 	"github.com/maxwelbm/alkemy-g7.git/internal/mocks"
 	"github.com/maxwelbm/alkemy-g7.git/internal/model"
 	"github.com/maxwelbm/alkemy-g7.git/internal/service"
 	"github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
 	"github.com/stretchr/testify/assert"
+	testifymock "github.com/stretchr/testify/mock"
 	"testing"
 )
 
@@ -13,7 +16,8 @@ var logMock = mocks.MockLog{}
 
 func setupLocalityServiceTest(t *testing.T) *service.LocalitiesService {
 	mock := mocks.NewMockILocalityRepo(t)
-	return service.CreateServiceLocalities(mock, logMock)
+	mockProvince := mocks.NewMockIProvinceRepo(t)
+	return service.CreateServiceLocalities(mock, mockProvince, logMock)
 }
 
 func TestLocalitiesService_GetByID(t *testing.T) {
@@ -24,9 +28,9 @@ func TestLocalitiesService_GetByID(t *testing.T) {
 		ID := 1
 		l := model.Locality{ID: 1, Locality: "Tokyo", Province: "Kanto", Country: "Japan"}
 
-		mock.On("GetByID", ID).Return(l, nil).Once()
+		mock.On("GetByID", testifymock.Anything, ID).Return(l, nil).Once()
 
-		locality, err := s.GetByID(ID)
+		locality, err := s.GetByID(context.Background(), ID)
 
 		assert.NoError(t, err)
 		assert.Equal(t, l, locality)
@@ -38,31 +42,220 @@ func TestLocalitiesService_CreateLocality(t *testing.T) {
 	s := setupLocalityServiceTest(t)
 	mock := s.Rp.(*mocks.MockILocalityRepo)
 
+	mockProvince := s.RpProvince.(*mocks.MockIProvinceRepo)
+
 	t.Run("test service method for create localities successfully", func(t *testing.T) {
 		arg := model.Locality{Locality: "Brooklyn", Province: "New York", Country: "EUA"}
-		l := model.Locality{ID: 2, Locality: "Brooklyn", Province: "New York", Country: "EUA"}
+		l := model.Locality{ID: 2, Locality: "Brooklyn", ProvinceID: 3, Province: "New York", Country: "EUA"}
+
+		mockProvince.On("GetByName", testifymock.Anything, "New York", "EUA").Return(model.Province{ID: 3, Name: "New York", CountryID: 1}, nil).Once()
+		mock.On("CreateLocality", testifymock.Anything, &arg).Return(l, nil).Once()
+
+		locality, err := s.CreateLocality(context.Background(), &arg)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, arg.ProvinceID)
+		assert.Equal(t, l, locality)
+		mock.AssertExpectations(t)
+		mockProvince.AssertExpectations(t)
+	})
+
+	t.Run("test service method for create localities by province id", func(t *testing.T) {
+		arg := model.Locality{Locality: "Queens", ProvinceID: 3}
+		l := model.Locality{ID: 4, Locality: "Queens", ProvinceID: 3, Province: "New York", Country: "EUA"}
 
-		mock.On("CreateLocality", &arg).Return(l, nil).Once()
+		mock.On("CreateLocality", testifymock.Anything, &arg).Return(l, nil).Once()
 
-		locality, err := s.CreateLocality(&arg)
+		locality, err := s.CreateLocality(context.Background(), &arg)
 
 		assert.NoError(t, err)
 		assert.Equal(t, l, locality)
 		mock.AssertExpectations(t)
 	})
 
+	t.Run("test service method for create localities with unknown province names", func(t *testing.T) {
+		arg := model.Locality{Locality: "Springfield", Province: "Nowhere", Country: "EUA"}
+
+		mockProvince.On("GetByName", testifymock.Anything, "Nowhere", "EUA").Return(model.Province{}, customerror.HandleError("province", customerror.ErrorNotFound, "")).Once()
+
+		locality, err := s.CreateLocality(context.Background(), &arg)
+
+		assert.ErrorIs(t, err, customerror.ErrLocalityProvinceNotFound)
+		assert.Equal(t, model.Locality{}, locality)
+	})
+
+	t.Run("test service method for create localities with province lookup error", func(t *testing.T) {
+		arg := model.Locality{Locality: "Albany", Province: "New York", Country: "EUA"}
+		errS := errors.New("connection lost")
+
+		mockProvince.On("GetByName", testifymock.Anything, "New York", "EUA").Return(model.Province{}, errS).Once()
+
+		locality, err := s.CreateLocality(context.Background(), &arg)
+
+		assert.ErrorIs(t, err, errS)
+		assert.Equal(t, model.Locality{}, locality)
+	})
+
 	t.Run("test service method for create localities with empty attributes", func(t *testing.T) {
 		arg := model.Locality{Locality: "", Province: "", Country: ""}
 		l := model.Locality{}
 		errS := customerror.ErrNullLocalityAttribute
 
-		locality, err := s.CreateLocality(&arg)
+		locality, err := s.CreateLocality(context.Background(), &arg)
 
 		assert.ErrorIs(t, errS, err)
 		assert.Equal(t, l, locality)
 	})
 }
 
+func TestLocalitiesService_GetAll(t *testing.T) {
+	s := setupLocalityServiceTest(t)
+	mock := s.Rp.(*mocks.MockILocalityRepo)
+
+	t.Run("test service method for list localities successfully", func(t *testing.T) {
+		params := model.ListParams{Page: 1, PageSize: 20, Sort: "id", Filters: map[string]string{"country_name": "Jap"}}
+		ls := []model.Locality{{ID: 1, Locality: "Tokyo", ProvinceID: 1, Province: "Kanto", Country: "Japan"}}
+
+		mock.On("Get", testifymock.Anything, params).Return(ls, 1, nil).Once()
+
+		localities, total, err := s.GetAll(context.Background(), params)
+
+		assert.NoError(t, err)
+		assert.Equal(t, ls, localities)
+		assert.Equal(t, 1, total)
+		mock.AssertExpectations(t)
+	})
+}
+
+func TestLocalitiesService_UpdateLocality(t *testing.T) {
+	s := setupLocalityServiceTest(t)
+	mock := s.Rp.(*mocks.MockILocalityRepo)
+	mockProvince := s.RpProvince.(*mocks.MockIProvinceRepo)
+
+	existing := model.Locality{ID: 1, Locality: "Denvr", ProvinceID: 1, Province: "Colorado", Country: "EUA"}
+
+	t.Run("test service method for rename locality keeping its province", func(t *testing.T) {
+		expected := model.Locality{ID: 1, Locality: "Denver", ProvinceID: 1, Province: "Colorado", Country: "EUA"}
+		arg := model.Locality{Locality: "Denver"}
+		merged := existing
+		merged.Locality = "Denver"
+
+		mock.On("GetByID", testifymock.Anything, 1).Return(existing, nil).Once()
+		mock.On("UpdateLocality", testifymock.Anything, 1, &merged).Return(expected, nil).Once()
+
+		locality, err := s.UpdateLocality(context.Background(), 1, &arg)
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, locality)
+		mock.AssertExpectations(t)
+	})
+
+	t.Run("test service method for move locality to a province given by names", func(t *testing.T) {
+		expected := model.Locality{ID: 1, Locality: "Denvr", ProvinceID: 4, Province: "Kansas", Country: "EUA"}
+		arg := model.Locality{Province: "Kansas", Country: "EUA"}
+		merged := existing
+		merged.ProvinceID = 4
+
+		mock.On("GetByID", testifymock.Anything, 1).Return(existing, nil).Once()
+		mockProvince.On("GetByName", testifymock.Anything, "Kansas", "EUA").Return(model.Province{ID: 4, Name: "Kansas", CountryID: 1}, nil).Once()
+		mock.On("UpdateLocality", testifymock.Anything, 1, &merged).Return(expected, nil).Once()
+
+		locality, err := s.UpdateLocality(context.Background(), 1, &arg)
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, locality)
+		mock.AssertExpectations(t)
+		mockProvince.AssertExpectations(t)
+	})
+
+	t.Run("test service method for set locality coordinates", func(t *testing.T) {
+		latitude, longitude := 39.7392, -104.9903
+		arg := model.Locality{Latitude: &latitude, Longitude: &longitude}
+		merged := existing
+		merged.Latitude, merged.Longitude = &latitude, &longitude
+
+		mock.On("GetByID", testifymock.Anything, 1).Return(existing, nil).Once()
+		mock.On("UpdateLocality", testifymock.Anything, 1, &merged).Return(merged, nil).Once()
+
+		locality, err := s.UpdateLocality(context.Background(), 1, &arg)
+
+		assert.NoError(t, err)
+		assert.Equal(t, merged, locality)
+		mock.AssertExpectations(t)
+	})
+
+	t.Run("test service method for update locality with only one coordinate", func(t *testing.T) {
+		latitude := 39.7392
+		arg := model.Locality{Latitude: &latitude}
+
+		mock.On("GetByID", testifymock.Anything, 1).Return(existing, nil).Once()
+
+		locality, err := s.UpdateLocality(context.Background(), 1, &arg)
+
+		assert.EqualError(t, err, model.ErrCoordinatesIncomplete.Error())
+		assert.Equal(t, model.Locality{}, locality)
+	})
+
+	t.Run("test service method for update locality with province name but no country", func(t *testing.T) {
+		arg := model.Locality{Province: "Kansas"}
+
+		mock.On("GetByID", testifymock.Anything, 1).Return(existing, nil).Once()
+
+		locality, err := s.UpdateLocality(context.Background(), 1, &arg)
+
+		assert.ErrorIs(t, err, customerror.ErrNullLocalityAttribute)
+		assert.Equal(t, model.Locality{}, locality)
+	})
+
+	t.Run("test service method for update locality not found", func(t *testing.T) {
+		arg := model.Locality{Locality: "Denver"}
+
+		mock.On("GetByID", testifymock.Anything, 99).Return(model.Locality{}, customerror.ErrLocalityNotFound).Once()
+
+		locality, err := s.UpdateLocality(context.Background(), 99, &arg)
+
+		assert.ErrorIs(t, err, customerror.ErrLocalityNotFound)
+		assert.Equal(t, model.Locality{}, locality)
+	})
+}
+
+func TestLocalitiesService_DeleteLocality(t *testing.T) {
+	s := setupLocalityServiceTest(t)
+	mock := s.Rp.(*mocks.MockILocalityRepo)
+
+	t.Run("test service method for delete locality without dependencies", func(t *testing.T) {
+		mock.On("GetByID", testifymock.Anything, 3).Return(model.Locality{ID: 3}, nil).Once()
+		mock.On("CountDependencies", testifymock.Anything, 3).Return(model.LocalityDependencies{ID: 3}, nil).Once()
+		mock.On("DeleteLocality", testifymock.Anything, 3).Return(nil).Once()
+
+		_, err := s.DeleteLocality(context.Background(), 3)
+
+		assert.NoError(t, err)
+		mock.AssertExpectations(t)
+	})
+
+	t.Run("test service method for delete locality referenced by sellers and carriers", func(t *testing.T) {
+		d := model.LocalityDependencies{ID: 1, Sellers: 2, Carriers: 1}
+
+		mock.On("GetByID", testifymock.Anything, 1).Return(model.Locality{ID: 1}, nil).Once()
+		mock.On("CountDependencies", testifymock.Anything, 1).Return(d, nil).Once()
+
+		dependencies, err := s.DeleteLocality(context.Background(), 1)
+
+		assert.ErrorIs(t, err, customerror.ErrLocalityDependencies)
+		assert.Equal(t, d, dependencies)
+		mock.AssertNotCalled(t, "DeleteLocality", testifymock.Anything, 1)
+	})
+
+	t.Run("test service method for delete locality not found", func(t *testing.T) {
+		mock.On("GetByID", testifymock.Anything, 99).Return(model.Locality{}, customerror.ErrLocalityNotFound).Once()
+
+		_, err := s.DeleteLocality(context.Background(), 99)
+
+		assert.ErrorIs(t, err, customerror.ErrLocalityNotFound)
+	})
+}
+
 func TestLocalitiesService_GetSellers(t *testing.T) {
 	s := setupLocalityServiceTest(t)
 	mock := s.Rp.(*mocks.MockILocalityRepo)
@@ -71,9 +264,9 @@ func TestLocalitiesService_GetSellers(t *testing.T) {
 		ID := 3
 		l := []model.LocalitiesJSONSellers{{ID: "3", Locality: "Phoenix", Sellers: 5}}
 
-		mock.On("GetReportSellersWithID", ID).Return(l, nil).Once()
+		mock.On("GetSellers", testifymock.Anything, ID).Return(l, nil).Once()
 
-		report, err := s.GetSellers(ID)
+		report, err := s.GetSellers(context.Background(), ID)
 
 		assert.NoError(t, err)
 		assert.Equal(t, l, report)
@@ -89,9 +282,9 @@ func TestLocalitiesService_GetSellers(t *testing.T) {
 			{ID: "7", Locality: "Kansas", Sellers: 3},
 		}
 
-		mock.On("GetSellers", ID).Return(l, nil).Once()
+		mock.On("GetSellers", testifymock.Anything, ID).Return(l, nil).Once()
 
-		report, err := s.GetSellers(ID)
+		report, err := s.GetSellers(context.Background(), ID)
 
 		assert.NoError(t, err)
 		assert.Equal(t, l, report)
@@ -107,9 +300,9 @@ func TestLocalitiesService_GetCarriers(t *testing.T) {
 		ID := 3
 		l := []model.LocalitiesJSONCarriers{{ID: "3", Locality: "Phoenix", Carriers: 5}}
 
-		mock.On("GetReportCarriersWithID", ID).Return(l, nil).Once()
+		mock.On("GetCarriers", testifymock.Anything, ID).Return(l, nil).Once()
 
-		report, err := s.GetCarriers(ID)
+		report, err := s.GetCarriers(context.Background(), ID)
 
 		assert.NoError(t, err)
 		assert.Equal(t, l, report)
@@ -125,9 +318,9 @@ func TestLocalitiesService_GetCarriers(t *testing.T) {
 			{ID: "7", Locality: "Kansas", Carriers: 3},
 		}
 
-		mock.On("GetCarriers", ID).Return(l, nil).Once()
+		mock.On("GetCarriers", testifymock.Anything, ID).Return(l, nil).Once()
 
-		report, err := s.GetCarriers(ID)
+		report, err := s.GetCarriers(context.Background(), ID)
 
 		assert.NoError(t, err)
 		assert.Equal(t, l, report)
@@ -1,7 +1,12 @@
 package service
 
 import (
+	"context"
+	"database/sql"
 	"fmt"
+	"net/http"
+	"strings"
+	"time"
 
 	"github.com/maxwelbm/alkemy-g7.git/internal/model"
 	irepo "github.com/maxwelbm/alkemy-g7.git/internal/repository/interfaces"
@@ -12,48 +17,268 @@ import (
 
 type ProductBatchesService struct {
 	Rp      irepo.IProductBatchesRepo
+	RpSec   irepo.ISectionRepo
+	Uow     irepo.IUnitOfWork
 	SvcProd interfaces.IProductService
 	SvcSec  interfaces.ISectionService
+	SvcExc  interfaces.ITemperatureExcursionService
 	log     logger.Logger
 }
 
-func CreateProductBatchesService(rp irepo.IProductBatchesRepo, SvcProd interfaces.IProductService, SvcSec interfaces.ISectionService, log logger.Logger) *ProductBatchesService {
-	return &ProductBatchesService{Rp: rp, SvcProd: SvcProd, SvcSec: SvcSec, log: log}
+func CreateProductBatchesService(rp irepo.IProductBatchesRepo, rpSec irepo.ISectionRepo, uow irepo.IUnitOfWork, SvcProd interfaces.IProductService, SvcSec interfaces.ISectionService, SvcExc interfaces.ITemperatureExcursionService, log logger.Logger) *ProductBatchesService {
+	return &ProductBatchesService{Rp: rp, RpSec: rpSec, Uow: uow, SvcProd: SvcProd, SvcSec: SvcSec, SvcExc: SvcExc, log: log}
 }
 
-func (s *ProductBatchesService) GetByID(id int) (prodBatches model.ProductBatches, err error) {
+func (s *ProductBatchesService) Get(ctx context.Context, params model.ListParams) (batches []model.ProductBatches, total int, err error) {
+	s.log.Log("ProductBatchesService", "INFO", "initializing Get function with params parameter")
+	batches, total, err = s.Rp.Get(ctx, params)
+
+	return
+}
+
+func (s *ProductBatchesService) GetByID(ctx context.Context, id int) (prodBatches model.ProductBatches, err error) {
 	s.log.Log("ProductBatchesService", "INFO", "initializing GetByID function with id parameter")
-	prodBatches, err = s.Rp.GetByID(id)
+	prodBatches, err = s.Rp.GetByID(ctx, id)
 
 	return
 }
 
-func (s *ProductBatchesService) Post(prodBatches *model.ProductBatches) (newProdBatches model.ProductBatches, err error) {
+func (s *ProductBatchesService) Post(ctx context.Context, prodBatches *model.ProductBatches) (newProdBatches model.ProductBatches, err error) {
 	s.log.Log("ProductBatchesService", "INFO", "initializing Post function with prodBatches parameter")
 
-	if err = prodBatches.Validate(); err != nil {
+	if err = s.ValidateNew(ctx, []*model.ProductBatches{prodBatches}); err != nil {
+		return model.ProductBatches{}, err
+	}
+
+	err = s.Uow.Do(ctx, func(tx *sql.Tx) error {
+		newProdBatches, err = s.Rp.WithTx(tx).Post(ctx, prodBatches)
+		if err != nil {
+			return err
+		}
+
+		return s.RpSec.WithTx(tx).IncreaseCurrentCapacity(ctx, prodBatches.SectionID, prodBatches.CurrentQuantity)
+	})
+
+	if err != nil {
 		s.log.Log("ProductBatchesService", "ERROR", fmt.Sprintf("Error: %v", err))
 
-		return model.ProductBatches{}, customerror.HandleError("product batches", customerror.ErrorInvalid, err.Error())
+		return model.ProductBatches{}, err
+	}
+
+	s.evaluateExcursions(ctx, newProdBatches)
+
+	s.log.Log("ProductBatchesService", "INFO", "successfully executed post function")
+
+	return
+}
+
+// ValidateNew checks the batches about to be stored: their fields, the product and section they refer to,
+// the placement rules and whether the sections can hold them all.
+func (s *ProductBatchesService) ValidateNew(ctx context.Context, batches []*model.ProductBatches) (err error) {
+	s.log.Log("ProductBatchesService", "INFO", fmt.Sprintf("initializing ValidateNew function for %d batches", len(batches)))
+
+	sections := make(map[int]model.Section)
+	incoming := make(map[int]int)
+
+	for _, prodBatches := range batches {
+		if err = prodBatches.Validate(); err != nil {
+			s.log.Log("ProductBatchesService", "ERROR", fmt.Sprintf("Error: %v", err))
+
+			return customerror.HandleError("product batches", customerror.ErrorInvalid, err.Error())
+		}
+
+		product, err := s.SvcProd.GetProductByID(ctx, prodBatches.ProductID)
+		if err != nil {
+			s.log.Log("ProductBatchesService", "ERROR", fmt.Sprintf("Error: %v", err))
+
+			return err
+		}
+
+		section, ok := sections[prodBatches.SectionID]
+		if !ok {
+			section, err = s.SvcSec.GetByID(ctx, prodBatches.SectionID)
+			if err != nil {
+				s.log.Log("ProductBatchesService", "ERROR", fmt.Sprintf("Error: %v", err))
+
+				return err
+			}
+
+			sections[prodBatches.SectionID] = section
+		}
+
+		if err = prodBatches.ValidatePlacement(product, section); err != nil {
+			s.log.Log("ProductBatchesService", "ERROR", fmt.Sprintf("Error: %v", err))
+
+			return customerror.HandleError("product batches", customerror.ErrorInvalid, err.Error())
+		}
+
+		incoming[prodBatches.SectionID] += prodBatches.CurrentQuantity
+
+		if section.CurrentCapacity+incoming[prodBatches.SectionID] > section.MaximumCapacity {
+			err = customerror.NewError(http.StatusConflict, customerror.ErrCapacityExceeded.Error(), "section", "")
+			s.log.Log("ProductBatchesService", "ERROR", fmt.Sprintf("Error: %v", err))
+
+			return err
+		}
 	}
 
-	_, err = s.SvcProd.GetProductByID(prodBatches.ProductID)
+	return nil
+}
+
+// Update corrects the quantity and temperatures of a stored batch. The batch is locked while it is
+// corrected, the placement rules are checked again when a temperature changes and the section capacity
+// follows the difference from the locked quantity.
+func (s *ProductBatchesService) Update(ctx context.Context, id int, update model.ProductBatchesUpdate) (updated model.ProductBatches, err error) {
+	s.log.Log("ProductBatchesService", "INFO", "initializing Update function with id and update parameters")
+
+	err = s.Uow.Do(ctx, func(tx *sql.Tx) error {
+		rp := s.Rp.WithTx(tx)
+
+		existing, err := rp.GetByIDForUpdate(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		prodBatches := existing
+
+		if err = update.Apply(&prodBatches); err != nil {
+			return customerror.HandleError("product batches", customerror.ErrorInvalid, err.Error())
+		}
+
+		if update.CurrentTemperature != nil || update.MinimumTemperature != nil {
+			if err = s.validatePlacement(ctx, prodBatches); err != nil {
+				return err
+			}
+		}
+
+		updated, err = rp.Update(ctx, id, &prodBatches)
+		if err != nil {
+			return err
+		}
+
+		delta := prodBatches.CurrentQuantity - existing.CurrentQuantity
+
+		switch {
+		case delta > 0:
+			return s.RpSec.WithTx(tx).IncreaseCurrentCapacity(ctx, prodBatches.SectionID, delta)
+		case delta < 0:
+			return s.RpSec.WithTx(tx).DecreaseCurrentCapacity(ctx, prodBatches.SectionID, -delta)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		s.log.Log("ProductBatchesService", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return model.ProductBatches{}, err
+	}
+
+	if update.CurrentTemperature != nil || update.MinimumTemperature != nil {
+		s.evaluateExcursions(ctx, updated)
+	}
+
+	s.log.Log("ProductBatchesService", "INFO", "successfully executed update function")
+
+	return
+}
+
+// validatePlacement checks the corrected temperatures of the batch against its product and section.
+func (s *ProductBatchesService) validatePlacement(ctx context.Context, prodBatches model.ProductBatches) error {
+	product, err := s.SvcProd.GetProductByID(ctx, prodBatches.ProductID)
+	if err != nil {
+		return err
+	}
+
+	section, err := s.SvcSec.GetByID(ctx, prodBatches.SectionID)
+	if err != nil {
+		return err
+	}
+
+	if err = prodBatches.ValidatePlacement(product, section); err != nil {
+		return customerror.HandleError("product batches", customerror.ErrorInvalid, err.Error())
+	}
+
+	return nil
+}
+
+// evaluateExcursions checks the batch against its temperature limits. The batch is already stored,
+// so a failure is only logged.
+func (s *ProductBatchesService) evaluateExcursions(ctx context.Context, prodBatches model.ProductBatches) {
+	if err := s.SvcExc.EvaluateBatch(ctx, prodBatches.ID, prodBatches.CurrentTemperature, time.Now()); err != nil {
+		s.log.Log("ProductBatchesService", "ERROR", fmt.Sprintf("unable to evaluate temperature excursions: %v", err))
+	}
+}
+
+// Delete removes a batch that no inbound order, purchase order or temperature excursion refers to and
+// releases its space in the section. The batch is locked while the references are counted, so no order
+// can allocate it nor excursion refer to it before it is gone.
+func (s *ProductBatchesService) Delete(ctx context.Context, id int) (err error) {
+	s.log.Log("ProductBatchesService", "INFO", "initializing Delete function with id parameter")
+
+	err = s.Uow.Do(ctx, func(tx *sql.Tx) error {
+		rp := s.Rp.WithTx(tx)
+
+		existing, err := rp.GetByIDForUpdate(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		refs, err := rp.CountReferences(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if names := refs.Names(); len(names) > 0 {
+			return customerror.NewError(http.StatusConflict, "cannot be deleted while referenced by "+strings.Join(names, ", "), "product batches", "")
+		}
+
+		if err = rp.Delete(ctx, id); err != nil {
+			return err
+		}
+
+		return s.RpSec.WithTx(tx).DecreaseCurrentCapacity(ctx, existing.SectionID, existing.CurrentQuantity)
+	})
+
 	if err != nil {
 		s.log.Log("ProductBatchesService", "ERROR", fmt.Sprintf("Error: %v", err))
 
 		return
 	}
 
-	_, err = s.SvcSec.GetByID(prodBatches.SectionID)
+	s.log.Log("ProductBatchesService", "INFO", "successfully executed delete function")
+
+	return
+}
+
+// GetExpiringReport lists, grouped by warehouse and section, the batches that expire within the next days,
+// together with the ones that already expired and still have stock.
+func (s *ProductBatchesService) GetExpiringReport(ctx context.Context, days int) (report []model.ExpiringBatchesWarehouse, err error) {
+	s.log.Log("ProductBatchesService", "INFO", fmt.Sprintf("initializing GetExpiringReport function with days %d", days))
+
+	if days < 0 || days > model.MaxExpiryWindowDays {
+		err = customerror.HandleError("product batches", customerror.ErrorInvalid, fmt.Sprintf("days must be between 0 and %d", model.MaxExpiryWindowDays))
+		s.log.Log("ProductBatchesService", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	now := time.Now()
+
+	batches, err := s.Rp.GetExpiring(ctx, now.AddDate(0, 0, days))
 	if err != nil {
 		s.log.Log("ProductBatchesService", "ERROR", fmt.Sprintf("Error: %v", err))
 
 		return
 	}
 
-	newProdBatches, err = s.Rp.Post(prodBatches)
+	for i := range batches {
+		batches[i].Status = batches[i].ExpiryStatus(now)
+	}
 
-	s.log.Log("ProductBatchesService", "INFO", "successfully executed post function")
+	report = model.GroupExpiringBatches(batches)
+
+	s.log.Log("ProductBatchesService", "INFO", "successfully executed GetExpiringReport function")
 
 	return
 }
@@ -1,6 +1,9 @@
 package service_test
 
 import (
+	"context"
+	"database/sql"
+	"net/http"
 	"testing"
 	"time"
 
@@ -9,11 +12,22 @@ import (
 	"github.com/maxwelbm/alkemy-g7.git/internal/service"
 	"github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func setupProductBatches(t *testing.T) *service.ProductBatchesService {
 	mockRepo := mocks.NewMockIProductBatchesRepo(t)
-	pbService := service.CreateProductBatchesService(mockRepo, mocks.NewMockIProductService(t), mocks.NewMockISectionService(t), logMock)
+	mockRepoSec := mocks.NewMockISectionRepo(t)
+	mockUow := mocks.NewMockIUnitOfWork(t)
+
+	mockRepo.On("WithTx", mock.Anything).Return(mockRepo).Maybe()
+	mockRepoSec.On("WithTx", mock.Anything).Return(mockRepoSec).Maybe()
+	mockUow.On("Do", mock.Anything, mock.Anything).Return(func(ctx context.Context, fn func(*sql.Tx) error) error { return fn(nil) }).Maybe()
+
+	mockSvcExc := mocks.NewMockITemperatureExcursionService(t)
+	mockSvcExc.On("EvaluateBatch", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	pbService := service.CreateProductBatchesService(mockRepo, mockRepoSec, mockUow, mocks.NewMockIProductService(t), mocks.NewMockISectionService(t), mockSvcExc, logMock)
 	return pbService
 }
 
@@ -42,7 +56,7 @@ func TestServiceProductBatches_Post(t *testing.T) {
 		}
 
 		mockProductService := svc.SvcProd.(*mocks.MockIProductService)
-		mockProductService.On("GetProductByID", createdPB.ProductID).Return(model.Product{
+		mockProductService.On("GetProductByID", mock.Anything, createdPB.ProductID).Return(model.Product{
 			ID:                             1,
 			ProductCode:                    "P01",
 			Description:                    "Product 1",
@@ -58,22 +72,25 @@ func TestServiceProductBatches_Post(t *testing.T) {
 		}, nil)
 
 		mockSectionService := svc.SvcSec.(*mocks.MockISectionService)
-		mockSectionService.On("GetByID", createdPB.SectionID).Return(model.Section{
+		mockSectionService.On("GetByID", mock.Anything, createdPB.SectionID).Return(model.Section{
 			ID:                 1,
 			SectionNumber:      "S01",
 			CurrentTemperature: 10.00,
 			MinimumTemperature: 5.00,
 			CurrentCapacity:    5,
 			MinimumCapacity:    1,
-			MaximumCapacity:    10,
+			MaximumCapacity:    20,
 			WarehouseID:        1,
 			ProductTypeID:      1,
 		}, nil)
 
 		mockRepo := svc.Rp.(*mocks.MockIProductBatchesRepo)
-		mockRepo.On("Post", &createdPB).Return(createdPB, nil)
+		mockRepo.On("Post", mock.Anything, &createdPB).Return(createdPB, nil)
+
+		mockRepoSec := svc.RpSec.(*mocks.MockISectionRepo)
+		mockRepoSec.On("IncreaseCurrentCapacity", mock.Anything, createdPB.SectionID, createdPB.CurrentQuantity).Return(nil)
 
-		pb, err := svc.Post(&createdPB)
+		pb, err := svc.Post(context.Background(), &createdPB)
 
 		assert.NoError(t, err)
 		assert.Equal(t, createdPB, pb)
@@ -105,9 +122,9 @@ func TestServiceProductBatches_Post(t *testing.T) {
 		expectedError := customerror.HandleError("product", customerror.ErrorNotFound, "")
 
 		mockProductService := svc.SvcProd.(*mocks.MockIProductService)
-		mockProductService.On("GetProductByID", createdPB.ProductID).Return(model.Product{}, expectedError)
+		mockProductService.On("GetProductByID", mock.Anything, createdPB.ProductID).Return(model.Product{}, expectedError)
 
-		pb, err := svc.Post(&createdPB)
+		pb, err := svc.Post(context.Background(), &createdPB)
 
 		assert.ErrorIs(t, expectedError, err)
 		assert.Equal(t, model.ProductBatches{}, pb)
@@ -140,7 +157,7 @@ func TestServiceProductBatches_Post(t *testing.T) {
 		expectedError := customerror.HandleError("section", customerror.ErrorNotFound, "")
 
 		mockProductService := svc.SvcProd.(*mocks.MockIProductService)
-		mockProductService.On("GetProductByID", createdPB.ProductID).Return(model.Product{
+		mockProductService.On("GetProductByID", mock.Anything, createdPB.ProductID).Return(model.Product{
 			ID:                             1,
 			ProductCode:                    "P01",
 			Description:                    "Product 1",
@@ -156,14 +173,107 @@ func TestServiceProductBatches_Post(t *testing.T) {
 		}, nil)
 
 		mockSectionService := svc.SvcSec.(*mocks.MockISectionService)
-		mockSectionService.On("GetByID", createdPB.SectionID).Return(model.Section{}, expectedError)
+		mockSectionService.On("GetByID", mock.Anything, createdPB.SectionID).Return(model.Section{}, expectedError)
 
-		pb, err := svc.Post(&createdPB)
+		pb, err := svc.Post(context.Background(), &createdPB)
 
 		assert.ErrorIs(t, expectedError, err)
 		assert.Equal(t, model.ProductBatches{}, pb)
 	})
 
+	t.Run("given a product whose type does not match the section then return error", func(t *testing.T) {
+		svc := setupProductBatches(t)
+
+		parsedTime, err := time.Parse(time.RFC3339, "2025-01-01T00:00:00Z")
+		assert.NoError(t, err)
+
+		createdPB := model.ProductBatches{BatchNumber: "B01", CurrentQuantity: 10, CurrentTemperature: 10.00, MinimumTemperature: 5.00, DueDate: parsedTime, InitialQuantity: 5, ManufacturingDate: parsedTime, ManufacturingHour: 10, ProductID: 1, SectionID: 1}
+
+		mockProductService := svc.SvcProd.(*mocks.MockIProductService)
+		mockProductService.On("GetProductByID", mock.Anything, createdPB.ProductID).Return(model.Product{ID: 1, RecommendedFreezingTemperature: 20.00, ProductTypeID: 2}, nil)
+
+		mockSectionService := svc.SvcSec.(*mocks.MockISectionService)
+		mockSectionService.On("GetByID", mock.Anything, createdPB.SectionID).Return(model.Section{ID: 1, CurrentTemperature: 10.00, MinimumTemperature: 5.00, ProductTypeID: 1}, nil)
+
+		expectedError := customerror.HandleError("product batches", customerror.ErrorInvalid, "product type 2 does not match section product type 1")
+
+		pb, err := svc.Post(context.Background(), &createdPB)
+
+		assert.Equal(t, expectedError, err)
+		assert.Equal(t, model.ProductBatches{}, pb)
+	})
+
+	t.Run("given a section with incompatible temperatures then return error", func(t *testing.T) {
+		svc := setupProductBatches(t)
+
+		parsedTime, err := time.Parse(time.RFC3339, "2025-01-01T00:00:00Z")
+		assert.NoError(t, err)
+
+		createdPB := model.ProductBatches{BatchNumber: "B01", CurrentQuantity: 10, CurrentTemperature: 15.00, MinimumTemperature: 12.00, DueDate: parsedTime, InitialQuantity: 5, ManufacturingDate: parsedTime, ManufacturingHour: 10, ProductID: 1, SectionID: 1}
+
+		mockProductService := svc.SvcProd.(*mocks.MockIProductService)
+		mockProductService.On("GetProductByID", mock.Anything, createdPB.ProductID).Return(model.Product{ID: 1, RecommendedFreezingTemperature: -18.00, ProductTypeID: 1}, nil)
+
+		mockSectionService := svc.SvcSec.(*mocks.MockISectionService)
+		mockSectionService.On("GetByID", mock.Anything, createdPB.SectionID).Return(model.Section{ID: 1, CurrentTemperature: 10.00, MinimumTemperature: 5.00, ProductTypeID: 1}, nil)
+
+		expectedError := customerror.HandleError("product batches", customerror.ErrorInvalid,
+			"section current temperature 10.00 is below the batch minimum temperature 12.00; section minimum temperature 5.00 cannot reach the product recommended freezing temperature -18.00")
+
+		pb, err := svc.Post(context.Background(), &createdPB)
+
+		assert.Equal(t, expectedError, err)
+		assert.Equal(t, model.ProductBatches{}, pb)
+	})
+
+	t.Run("given a batch that exceeds the section maximum capacity then return error", func(t *testing.T) {
+		svc := setupProductBatches(t)
+
+		parsedTime, err := time.Parse(time.RFC3339, "2025-01-01T00:00:00Z")
+		assert.NoError(t, err)
+
+		createdPB := model.ProductBatches{BatchNumber: "B01", CurrentQuantity: 10, CurrentTemperature: 10.00, MinimumTemperature: 5.00, DueDate: parsedTime, InitialQuantity: 5, ManufacturingDate: parsedTime, ManufacturingHour: 10, ProductID: 1, SectionID: 1}
+
+		mockProductService := svc.SvcProd.(*mocks.MockIProductService)
+		mockProductService.On("GetProductByID", mock.Anything, createdPB.ProductID).Return(model.Product{ID: 1, RecommendedFreezingTemperature: 20.00, ProductTypeID: 1}, nil)
+
+		mockSectionService := svc.SvcSec.(*mocks.MockISectionService)
+		mockSectionService.On("GetByID", mock.Anything, createdPB.SectionID).Return(model.Section{ID: 1, CurrentTemperature: 10.00, MinimumTemperature: 5.00, CurrentCapacity: 15, MaximumCapacity: 20, ProductTypeID: 1}, nil)
+
+		pb, err := svc.Post(context.Background(), &createdPB)
+
+		assert.Equal(t, customerror.NewError(http.StatusConflict, customerror.ErrCapacityExceeded.Error(), "section", ""), err)
+		assert.Equal(t, model.ProductBatches{}, pb)
+		svc.Rp.(*mocks.MockIProductBatchesRepo).AssertNotCalled(t, "Post", mock.Anything, mock.Anything)
+	})
+
+	t.Run("given a concurrent placement that fills the section then return error", func(t *testing.T) {
+		svc := setupProductBatches(t)
+
+		parsedTime, err := time.Parse(time.RFC3339, "2025-01-01T00:00:00Z")
+		assert.NoError(t, err)
+
+		createdPB := model.ProductBatches{BatchNumber: "B01", CurrentQuantity: 5, CurrentTemperature: 10.00, MinimumTemperature: 5.00, DueDate: parsedTime, InitialQuantity: 5, ManufacturingDate: parsedTime, ManufacturingHour: 10, ProductID: 1, SectionID: 1}
+		expectedError := customerror.NewError(http.StatusConflict, customerror.ErrCapacityExceeded.Error(), "section", "")
+
+		mockProductService := svc.SvcProd.(*mocks.MockIProductService)
+		mockProductService.On("GetProductByID", mock.Anything, createdPB.ProductID).Return(model.Product{ID: 1, RecommendedFreezingTemperature: 20.00, ProductTypeID: 1}, nil)
+
+		mockSectionService := svc.SvcSec.(*mocks.MockISectionService)
+		mockSectionService.On("GetByID", mock.Anything, createdPB.SectionID).Return(model.Section{ID: 1, CurrentTemperature: 10.00, MinimumTemperature: 5.00, CurrentCapacity: 10, MaximumCapacity: 20, ProductTypeID: 1}, nil)
+
+		mockRepo := svc.Rp.(*mocks.MockIProductBatchesRepo)
+		mockRepo.On("Post", mock.Anything, &createdPB).Return(createdPB, nil)
+
+		mockRepoSec := svc.RpSec.(*mocks.MockISectionRepo)
+		mockRepoSec.On("IncreaseCurrentCapacity", mock.Anything, 1, 5).Return(expectedError)
+
+		pb, err := svc.Post(context.Background(), &createdPB)
+
+		assert.Equal(t, expectedError, err)
+		assert.Equal(t, model.ProductBatches{}, pb)
+	})
+
 	t.Run("given an invalid product batch then return error", func(t *testing.T) {
 		svc := setupProductBatches(t)
 
@@ -171,7 +281,7 @@ func TestServiceProductBatches_Post(t *testing.T) {
 
 		expectedError := customerror.HandleError("product batches", customerror.ErrorInvalid, "")
 
-		pb, err := svc.Post(&createdPB)
+		pb, err := svc.Post(context.Background(), &createdPB)
 
 		assert.Error(t, expectedError, err)
 		assert.Equal(t, model.ProductBatches{}, pb)
@@ -179,6 +289,48 @@ func TestServiceProductBatches_Post(t *testing.T) {
 	})
 }
 
+func TestServiceProductBatches_ValidateNew(t *testing.T) {
+	parsedTime, _ := time.Parse(time.RFC3339, "2025-01-01T00:00:00Z")
+
+	newBatch := func(batchNumber string, quantity int) *model.ProductBatches {
+		return &model.ProductBatches{BatchNumber: batchNumber, CurrentQuantity: quantity, CurrentTemperature: 10.00, MinimumTemperature: 5.00, DueDate: parsedTime, InitialQuantity: quantity, ManufacturingDate: parsedTime, ManufacturingHour: 10, ProductID: 1, SectionID: 1}
+	}
+
+	t.Run("given batches the section can hold then return no error", func(t *testing.T) {
+		svc := setupProductBatches(t)
+
+		svc.SvcProd.(*mocks.MockIProductService).On("GetProductByID", mock.Anything, 1).Return(model.Product{ID: 1, RecommendedFreezingTemperature: 20.00, ProductTypeID: 1}, nil)
+
+		mockSectionService := svc.SvcSec.(*mocks.MockISectionService)
+		mockSectionService.On("GetByID", mock.Anything, 1).Return(model.Section{ID: 1, CurrentTemperature: 10.00, MinimumTemperature: 5.00, CurrentCapacity: 5, MaximumCapacity: 20, ProductTypeID: 1}, nil).Once()
+
+		err := svc.ValidateNew(context.Background(), []*model.ProductBatches{newBatch("B01", 10), newBatch("B02", 5)})
+
+		assert.NoError(t, err)
+		mockSectionService.AssertNumberOfCalls(t, "GetByID", 1)
+	})
+
+	t.Run("given batches that together exceed the section maximum capacity then return error", func(t *testing.T) {
+		svc := setupProductBatches(t)
+
+		svc.SvcProd.(*mocks.MockIProductService).On("GetProductByID", mock.Anything, 1).Return(model.Product{ID: 1, RecommendedFreezingTemperature: 20.00, ProductTypeID: 1}, nil)
+		svc.SvcSec.(*mocks.MockISectionService).On("GetByID", mock.Anything, 1).Return(model.Section{ID: 1, CurrentTemperature: 10.00, MinimumTemperature: 5.00, CurrentCapacity: 5, MaximumCapacity: 20, ProductTypeID: 1}, nil)
+
+		err := svc.ValidateNew(context.Background(), []*model.ProductBatches{newBatch("B01", 10), newBatch("B02", 10)})
+
+		assert.Equal(t, customerror.NewError(http.StatusConflict, customerror.ErrCapacityExceeded.Error(), "section", ""), err)
+	})
+
+	t.Run("given an invalid batch then return error", func(t *testing.T) {
+		svc := setupProductBatches(t)
+
+		err := svc.ValidateNew(context.Background(), []*model.ProductBatches{{}})
+
+		assert.Error(t, err)
+		assert.Equal(t, http.StatusUnprocessableEntity, err.(*customerror.GenericError).Code)
+	})
+}
+
 func TestServiceProductBatches_GetByID(t *testing.T) {
 	t.Run("Get existing purchase order successfully", func(t *testing.T) {
 		svc := setupProductBatches(t)
@@ -204,12 +356,263 @@ func TestServiceProductBatches_GetByID(t *testing.T) {
 		}
 
 		mockRepo := svc.Rp.(*mocks.MockIProductBatchesRepo)
-		mockRepo.On("GetByID", createdPB.ID).Return(createdPB, nil)
+		mockRepo.On("GetByID", mock.Anything, createdPB.ID).Return(createdPB, nil)
 
-		pb, err := svc.GetByID(createdPB.ID)
+		pb, err := svc.GetByID(context.Background(), createdPB.ID)
 
 		assert.NoError(t, err)
 		assert.Equal(t, createdPB, pb)
 		mockRepo.AssertExpectations(t)
 	})
 }
+
+func TestServiceProductBatches_Get(t *testing.T) {
+	t.Run("given list params then return the repository page", func(t *testing.T) {
+		svc := setupProductBatches(t)
+
+		params := model.ListParams{Page: 1, PageSize: 20, Filters: map[string]string{"section_id": "1"}}
+		expected := []model.ProductBatches{{ID: 1, BatchNumber: "B01", SectionID: 1}}
+
+		mockRepo := svc.Rp.(*mocks.MockIProductBatchesRepo)
+		mockRepo.On("Get", mock.Anything, params).Return(expected, 1, nil)
+
+		batches, total, err := svc.Get(context.Background(), params)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, total)
+		assert.Equal(t, expected, batches)
+	})
+}
+
+func TestServiceProductBatches_Update(t *testing.T) {
+	parsedTime, _ := time.Parse(time.RFC3339, "2025-01-01T00:00:00Z")
+	existingPB := model.ProductBatches{ID: 1, BatchNumber: "B01", CurrentQuantity: 10, CurrentTemperature: 10.00, MinimumTemperature: 5.00, DueDate: parsedTime, InitialQuantity: 10, ManufacturingDate: parsedTime, ManufacturingHour: 10, ProductID: 1, SectionID: 1}
+
+	t.Run("given a higher quantity then update the batch and take the section capacity", func(t *testing.T) {
+		svc := setupProductBatches(t)
+
+		quantity := 15
+		updatedPB := existingPB
+		updatedPB.CurrentQuantity = quantity
+
+		mockRepo := svc.Rp.(*mocks.MockIProductBatchesRepo)
+		mockRepo.On("GetByIDForUpdate", mock.Anything, 1).Return(existingPB, nil)
+		mockRepo.On("Update", mock.Anything, 1, &updatedPB).Return(updatedPB, nil)
+
+		mockRepoSec := svc.RpSec.(*mocks.MockISectionRepo)
+		mockRepoSec.On("IncreaseCurrentCapacity", mock.Anything, 1, 5).Return(nil)
+
+		pb, err := svc.Update(context.Background(), 1, model.ProductBatchesUpdate{CurrentQuantity: &quantity})
+
+		assert.NoError(t, err)
+		assert.Equal(t, updatedPB, pb)
+		mockRepoSec.AssertNotCalled(t, "DecreaseCurrentCapacity", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("given a lower quantity then release the section capacity", func(t *testing.T) {
+		svc := setupProductBatches(t)
+
+		quantity := 4
+		updatedPB := existingPB
+		updatedPB.CurrentQuantity = quantity
+
+		mockRepo := svc.Rp.(*mocks.MockIProductBatchesRepo)
+		mockRepo.On("GetByIDForUpdate", mock.Anything, 1).Return(existingPB, nil)
+		mockRepo.On("Update", mock.Anything, 1, &updatedPB).Return(updatedPB, nil)
+
+		mockRepoSec := svc.RpSec.(*mocks.MockISectionRepo)
+		mockRepoSec.On("DecreaseCurrentCapacity", mock.Anything, 1, 6).Return(nil)
+
+		pb, err := svc.Update(context.Background(), 1, model.ProductBatchesUpdate{CurrentQuantity: &quantity})
+
+		assert.NoError(t, err)
+		assert.Equal(t, updatedPB, pb)
+	})
+
+	t.Run("given a zero quantity then write off the batch", func(t *testing.T) {
+		svc := setupProductBatches(t)
+
+		quantity := 0
+		updatedPB := existingPB
+		updatedPB.CurrentQuantity = quantity
+
+		mockRepo := svc.Rp.(*mocks.MockIProductBatchesRepo)
+		mockRepo.On("GetByIDForUpdate", mock.Anything, 1).Return(existingPB, nil)
+		mockRepo.On("Update", mock.Anything, 1, &updatedPB).Return(updatedPB, nil)
+
+		mockRepoSec := svc.RpSec.(*mocks.MockISectionRepo)
+		mockRepoSec.On("DecreaseCurrentCapacity", mock.Anything, 1, 10).Return(nil)
+
+		pb, err := svc.Update(context.Background(), 1, model.ProductBatchesUpdate{CurrentQuantity: &quantity})
+
+		assert.NoError(t, err)
+		assert.Equal(t, updatedPB, pb)
+	})
+
+	t.Run("given an emptied batch then correct its temperature", func(t *testing.T) {
+		svc := setupProductBatches(t)
+
+		emptiedPB := existingPB
+		emptiedPB.CurrentQuantity = 0
+
+		currentTemperature := 11.00
+		updatedPB := emptiedPB
+		updatedPB.CurrentTemperature = currentTemperature
+
+		mockRepo := svc.Rp.(*mocks.MockIProductBatchesRepo)
+		mockRepo.On("GetByIDForUpdate", mock.Anything, 1).Return(emptiedPB, nil)
+		mockRepo.On("Update", mock.Anything, 1, &updatedPB).Return(updatedPB, nil)
+
+		mockProductService := svc.SvcProd.(*mocks.MockIProductService)
+		mockProductService.On("GetProductByID", mock.Anything, 1).Return(model.Product{ID: 1, RecommendedFreezingTemperature: 20.00, ProductTypeID: 1}, nil)
+
+		mockSectionService := svc.SvcSec.(*mocks.MockISectionService)
+		mockSectionService.On("GetByID", mock.Anything, 1).Return(model.Section{ID: 1, CurrentTemperature: 10.00, MinimumTemperature: 5.00, ProductTypeID: 1}, nil)
+
+		pb, err := svc.Update(context.Background(), 1, model.ProductBatchesUpdate{CurrentTemperature: &currentTemperature})
+
+		assert.NoError(t, err)
+		assert.Equal(t, updatedPB, pb)
+	})
+
+	t.Run("given a negative quantity then return error", func(t *testing.T) {
+		svc := setupProductBatches(t)
+
+		quantity := -1
+
+		mockRepo := svc.Rp.(*mocks.MockIProductBatchesRepo)
+		mockRepo.On("GetByIDForUpdate", mock.Anything, 1).Return(existingPB, nil)
+
+		_, err := svc.Update(context.Background(), 1, model.ProductBatchesUpdate{CurrentQuantity: &quantity})
+
+		assert.Equal(t, customerror.HandleError("product batches", customerror.ErrorInvalid, "CurrentQuantity cannot be negative"), err)
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("given a minimum temperature the section cannot hold then return error", func(t *testing.T) {
+		svc := setupProductBatches(t)
+
+		minimumTemperature := 12.00
+
+		mockRepo := svc.Rp.(*mocks.MockIProductBatchesRepo)
+		mockRepo.On("GetByIDForUpdate", mock.Anything, 1).Return(existingPB, nil)
+
+		mockProductService := svc.SvcProd.(*mocks.MockIProductService)
+		mockProductService.On("GetProductByID", mock.Anything, 1).Return(model.Product{ID: 1, RecommendedFreezingTemperature: 20.00, ProductTypeID: 1}, nil)
+
+		mockSectionService := svc.SvcSec.(*mocks.MockISectionService)
+		mockSectionService.On("GetByID", mock.Anything, 1).Return(model.Section{ID: 1, CurrentTemperature: 10.00, MinimumTemperature: 5.00, ProductTypeID: 1}, nil)
+
+		pb, err := svc.Update(context.Background(), 1, model.ProductBatchesUpdate{MinimumTemperature: &minimumTemperature})
+
+		assert.Equal(t, customerror.HandleError("product batches", customerror.ErrorInvalid, "section current temperature 10.00 is below the batch minimum temperature 12.00"), err)
+		assert.Equal(t, model.ProductBatches{}, pb)
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("given an empty update then return error", func(t *testing.T) {
+		svc := setupProductBatches(t)
+
+		mockRepo := svc.Rp.(*mocks.MockIProductBatchesRepo)
+		mockRepo.On("GetByIDForUpdate", mock.Anything, 1).Return(existingPB, nil)
+
+		_, err := svc.Update(context.Background(), 1, model.ProductBatchesUpdate{})
+
+		assert.Equal(t, customerror.HandleError("product batches", customerror.ErrorInvalid, "at least one of CurrentQuantity, CurrentTemperature or MinimumTemperature must be informed"), err)
+	})
+}
+
+func TestServiceProductBatches_Delete(t *testing.T) {
+	existingPB := model.ProductBatches{ID: 1, BatchNumber: "B01", CurrentQuantity: 10, ProductID: 1, SectionID: 2}
+
+	t.Run("given a batch without inbound orders then delete it and release the section capacity", func(t *testing.T) {
+		svc := setupProductBatches(t)
+
+		mockRepo := svc.Rp.(*mocks.MockIProductBatchesRepo)
+		mockRepo.On("GetByIDForUpdate", mock.Anything, 1).Return(existingPB, nil)
+		mockRepo.On("CountReferences", mock.Anything, 1).Return(model.ProductBatchesReferences{}, nil)
+		mockRepo.On("Delete", mock.Anything, 1).Return(nil)
+
+		mockRepoSec := svc.RpSec.(*mocks.MockISectionRepo)
+		mockRepoSec.On("DecreaseCurrentCapacity", mock.Anything, 2, 10).Return(nil)
+
+		err := svc.Delete(context.Background(), 1)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("given a batch referenced by inbound orders then return a dependency error", func(t *testing.T) {
+		svc := setupProductBatches(t)
+
+		mockRepo := svc.Rp.(*mocks.MockIProductBatchesRepo)
+		mockRepo.On("GetByIDForUpdate", mock.Anything, 1).Return(existingPB, nil)
+		mockRepo.On("CountReferences", mock.Anything, 1).Return(model.ProductBatchesReferences{InboundOrders: 3}, nil)
+
+		err := svc.Delete(context.Background(), 1)
+
+		assert.Equal(t, customerror.NewError(http.StatusConflict, "cannot be deleted while referenced by inbound orders", "product batches", ""), err)
+		mockRepo.AssertNotCalled(t, "Delete", mock.Anything, 1)
+	})
+
+	t.Run("given a batch referenced by purchase orders and excursions then name them in the error", func(t *testing.T) {
+		svc := setupProductBatches(t)
+
+		mockRepo := svc.Rp.(*mocks.MockIProductBatchesRepo)
+		mockRepo.On("GetByIDForUpdate", mock.Anything, 1).Return(existingPB, nil)
+		mockRepo.On("CountReferences", mock.Anything, 1).Return(model.ProductBatchesReferences{PurchaseOrders: 1, TemperatureExcursions: 2}, nil)
+
+		err := svc.Delete(context.Background(), 1)
+
+		assert.Equal(t, customerror.NewError(http.StatusConflict, "cannot be deleted while referenced by purchase orders, temperature excursions", "product batches", ""), err)
+		mockRepo.AssertNotCalled(t, "Delete", mock.Anything, 1)
+	})
+
+	t.Run("given an unknown batch then return not found", func(t *testing.T) {
+		svc := setupProductBatches(t)
+
+		expectedErr := customerror.HandleError("product batches", customerror.ErrorNotFound, "")
+
+		mockRepo := svc.Rp.(*mocks.MockIProductBatchesRepo)
+		mockRepo.On("GetByIDForUpdate", mock.Anything, 99).Return(model.ProductBatches{}, expectedErr)
+
+		err := svc.Delete(context.Background(), 99)
+
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestServiceProductBatches_GetExpiringReport(t *testing.T) {
+	t.Run("group the expiring batches by warehouse and section", func(t *testing.T) {
+		svc := setupProductBatches(t)
+
+		expired := time.Now().AddDate(0, 0, -2)
+		expiring := time.Now().AddDate(0, 0, 3)
+
+		mockRepo := svc.Rp.(*mocks.MockIProductBatchesRepo)
+		mockRepo.On("GetExpiring", mock.Anything, mock.AnythingOfType("time.Time")).Return([]model.ExpiringBatch{
+			{ID: 1, BatchNumber: "B01", DueDate: expired, SectionID: 1, SectionNumber: "S01", WarehouseID: 1, WarehouseCode: "W01"},
+			{ID: 2, BatchNumber: "B02", DueDate: expiring, SectionID: 1, SectionNumber: "S01", WarehouseID: 1, WarehouseCode: "W01"},
+			{ID: 3, BatchNumber: "B03", DueDate: expiring, SectionID: 4, SectionNumber: "S04", WarehouseID: 2, WarehouseCode: "W02"},
+		}, nil)
+
+		report, err := svc.GetExpiringReport(context.Background(), 7)
+
+		assert.NoError(t, err)
+		assert.Len(t, report, 2)
+		assert.Equal(t, "W01", report[0].WarehouseCode)
+		assert.Len(t, report[0].Sections, 1)
+		assert.Len(t, report[0].Sections[0].Batches, 2)
+		assert.Equal(t, model.ExpiryStatusExpired, report[0].Sections[0].Batches[0].Status)
+		assert.Equal(t, model.ExpiryStatusExpiring, report[0].Sections[0].Batches[1].Status)
+		assert.Equal(t, 4, report[1].Sections[0].SectionID)
+	})
+
+	t.Run("given a negative number of days then return error", func(t *testing.T) {
+		svc := setupProductBatches(t)
+
+		report, err := svc.GetExpiringReport(context.Background(), -1)
+
+		assert.Equal(t, customerror.HandleError("product batches", customerror.ErrorInvalid, "days must be between 0 and 365"), err)
+		assert.Nil(t, report)
+	})
+}
@@ -1,6 +1,7 @@
 package service_test
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -15,15 +16,15 @@ import (
 func loadDependencies() *service.ProductService {
 	productRepoMock := new(mocks.MockIProductsRepo)
 	sellerRepositoryMock := new(mocks.MockISellerRepo)
-	productServiceMock := service.NewProductService(productRepoMock, sellerRepositoryMock, logMock)
+	productTypeRepositoryMock := new(mocks.MockIProductTypeRepo)
+	productServiceMock := service.NewProductService(productRepoMock, sellerRepositoryMock, productTypeRepositoryMock, logMock)
 	return productServiceMock
 }
 
 func TestGetAllProducts(t *testing.T) {
 	t.Run("should return the list of products", func(t *testing.T) {
 		productService := loadDependencies()
-		data := make(map[int]model.Product)
-		data[1] = model.Product{
+		data := []model.Product{{
 			ID:                             1,
 			ProductCode:                    "P001",
 			Description:                    "Product 1",
@@ -36,7 +37,7 @@ func TestGetAllProducts(t *testing.T) {
 			FreezingRate:                   0,
 			ProductTypeID:                  0,
 			SellerID:                       0,
-		}
+		}}
 
 		expectedValue := []model.Product{{
 			ID:                             1,
@@ -55,12 +56,13 @@ func TestGetAllProducts(t *testing.T) {
 
 		mockRepo := productService.ProductRepository.(*mocks.MockIProductsRepo)
 
-		mockRepo.On("GetAll").Return(data, nil)
+		mockRepo.On("GetAll", mock.Anything, model.ListParams{Page: 1, PageSize: 20}).Return(data, 1, nil)
 
-		productList, err := productService.GetAllProducts()
+		productList, total, err := productService.GetAllProducts(context.Background(), model.ListParams{Page: 1, PageSize: 20})
 
 		assert.NoError(t, err)
 		assert.Equal(t, expectedValue, productList)
+		assert.Equal(t, 1, total)
 		mockRepo.AssertExpectations(t)
 	})
 
@@ -69,9 +71,9 @@ func TestGetAllProducts(t *testing.T) {
 		expectedError := errors.New("error to get a product")
 		mockRepo := productService.ProductRepository.(*mocks.MockIProductsRepo)
 
-		mockRepo.On("GetAll").Return(map[int]model.Product{}, errors.New("error to get a product"))
+		mockRepo.On("GetAll", mock.Anything, mock.Anything).Return([]model.Product{}, 0, errors.New("error to get a product"))
 
-		_, err := productService.GetAllProducts()
+		_, _, err := productService.GetAllProducts(context.Background(), model.ListParams{})
 
 		assert.EqualError(t, expectedError, err.Error())
 		mockRepo.AssertExpectations(t)
@@ -97,9 +99,9 @@ func TestGetProductByID(t *testing.T) {
 	t.Run("should return the product", func(t *testing.T) {
 		productService := loadDependencies()
 		mockRepo := productService.ProductRepository.(*mocks.MockIProductsRepo)
-		mockRepo.On("GetByID", 1).Return(expectedProduct, nil)
+		mockRepo.On("GetByID", mock.Anything, 1).Return(expectedProduct, nil)
 
-		product, err := productService.GetProductByID(1)
+		product, err := productService.GetProductByID(context.Background(), 1)
 
 		assert.NoError(t, err)
 		assert.Equal(t, expectedProduct, product)
@@ -113,9 +115,9 @@ func TestGetProductByID(t *testing.T) {
 		mockRepo := productService.ProductRepository.(*mocks.MockIProductsRepo)
 		expectedError := customerror.HandleError("product", customerror.ErrorNotFound, "")
 
-		mockRepo.On("GetByID", id).Return(model.Product{}, expectedError)
+		mockRepo.On("GetByID", mock.Anything, id).Return(model.Product{}, expectedError)
 
-		product, err := productService.GetProductByID(id)
+		product, err := productService.GetProductByID(context.Background(), id)
 
 		assert.ErrorIs(t, err, expectedError)
 		assert.Equal(t, model.Product{}, product)
@@ -142,10 +144,10 @@ func TestDeleteById(t *testing.T) {
 	t.Run("should delete the product", func(t *testing.T) {
 		productService := loadDependencies()
 		mockRepo := productService.ProductRepository.(*mocks.MockIProductsRepo)
-		mockRepo.On("GetByID", 1).Return(data, nil)
-		mockRepo.On("Delete", 1).Return(nil)
+		mockRepo.On("GetByID", mock.Anything, 1).Return(data, nil)
+		mockRepo.On("Delete", mock.Anything, 1).Return(nil)
 
-		err := productService.DeleteProduct(1)
+		err := productService.DeleteProduct(context.Background(), 1)
 
 		assert.NoError(t, err)
 		mockRepo.AssertExpectations(t)
@@ -156,9 +158,9 @@ func TestDeleteById(t *testing.T) {
 		id := 2
 		mockRepo := productService.ProductRepository.(*mocks.MockIProductsRepo)
 		expectedError := customerror.HandleError("product", customerror.ErrorNotFound, "")
-		mockRepo.On("GetByID", id).Return(model.Product{}, expectedError)
+		mockRepo.On("GetByID", mock.Anything, id).Return(model.Product{}, expectedError)
 
-		err := productService.DeleteProduct(id)
+		err := productService.DeleteProduct(context.Background(), id)
 
 		assert.Equal(t, err, expectedError)
 		mockRepo.AssertExpectations(t)
@@ -168,10 +170,10 @@ func TestDeleteById(t *testing.T) {
 		expectedError := errors.New("error in delete product")
 		productService := loadDependencies()
 		mockRepo := productService.ProductRepository.(*mocks.MockIProductsRepo)
-		mockRepo.On("GetByID", 1).Return(data, nil)
-		mockRepo.On("Delete", 1).Return(errors.New("error in delete product"))
+		mockRepo.On("GetByID", mock.Anything, 1).Return(data, nil)
+		mockRepo.On("Delete", mock.Anything, 1).Return(errors.New("error in delete product"))
 
-		err := productService.DeleteProduct(1)
+		err := productService.DeleteProduct(context.Background(), 1)
 
 		assert.EqualError(t, err, expectedError.Error())
 		mockRepo.AssertExpectations(t)
@@ -179,8 +181,7 @@ func TestDeleteById(t *testing.T) {
 }
 
 func TestCreateProduct(t *testing.T) {
-	listOfProducts := make(map[int]model.Product)
-	listOfProducts[1] = model.Product{
+	listOfProducts := []model.Product{{
 		ID:                             1,
 		ProductCode:                    "P001",
 		Description:                    "Product 1",
@@ -193,7 +194,7 @@ func TestCreateProduct(t *testing.T) {
 		FreezingRate:                   1,
 		ProductTypeID:                  1,
 		SellerID:                       1,
-	}
+	}}
 
 	dataProduct := model.Product{
 		ID:                             1,
@@ -219,18 +220,22 @@ func TestCreateProduct(t *testing.T) {
 
 		productRepoMock := productService.ProductRepository.(*mocks.MockIProductsRepo)
 		sellerRepoMock := productService.SellerRepository.(*mocks.MockISellerRepo)
+		productTypeRepoMock := productService.ProductTypeRepository.(*mocks.MockIProductTypeRepo)
 
-		sellerRepoMock.On("GetByID", dataProduct.SellerID).
+		sellerRepoMock.On("GetByID", mock.Anything, dataProduct.SellerID).
 			Return(dataSeller, nil)
-		productRepoMock.On("GetAll").Return(listOfProducts, nil)
-		productRepoMock.On("Create", dataProduct).Return(dataProduct, nil)
+		productTypeRepoMock.On("GetByID", mock.Anything, dataProduct.ProductTypeID).
+			Return(model.ProductType{ID: dataProduct.ProductTypeID}, nil)
+		productRepoMock.On("GetAll", mock.Anything, model.ListParams{}).Return(listOfProducts, len(listOfProducts), nil)
+		productRepoMock.On("Create", mock.Anything, dataProduct).Return(dataProduct, nil)
 
-		product, err := productService.CreateProduct(dataProduct)
+		product, err := productService.CreateProduct(context.Background(), dataProduct)
 
 		assert.NoError(t, err)
 		assert.Equal(t, dataProduct, product)
 		productRepoMock.AssertExpectations(t)
 		sellerRepoMock.AssertExpectations(t)
+		productTypeRepoMock.AssertExpectations(t)
 	})
 
 	t.Run("Should return validation error", func(t *testing.T) {
@@ -254,11 +259,11 @@ func TestCreateProduct(t *testing.T) {
 			SellerID:                       1,
 		}
 
-		sellerRepoMock.On("GetByID", invalidProduct.SellerID).
+		sellerRepoMock.On("GetByID", mock.Anything, invalidProduct.SellerID).
 			Return(dataSeller, nil)
-		productRepoMock.On("GetAll").Return(listOfProducts, nil)
+		productRepoMock.On("GetAll", mock.Anything, model.ListParams{}).Return(listOfProducts, len(listOfProducts), nil)
 
-		product, err := productService.CreateProduct(invalidProduct)
+		product, err := productService.CreateProduct(context.Background(), invalidProduct)
 
 		assert.EqualError(t, err, "validation errors: ProductCode is required")
 		assert.Equal(t, model.Product{}, product)
@@ -285,11 +290,11 @@ func TestCreateProduct(t *testing.T) {
 			SellerID:                       1,
 		}
 
-		sellerRepoMock.On("GetByID", invalidProduct.SellerID).
+		sellerRepoMock.On("GetByID", mock.Anything, invalidProduct.SellerID).
 			Return(dataSeller, nil)
-		productRepoMock.On("GetAll").Return(listOfProducts, nil)
+		productRepoMock.On("GetAll", mock.Anything, model.ListParams{}).Return(listOfProducts, len(listOfProducts), nil)
 
-		product, err := productService.CreateProduct(invalidProduct)
+		product, err := productService.CreateProduct(context.Background(), invalidProduct)
 
 		assert.EqualError(t, err, "validation errors: ProductCode is required")
 		assert.Equal(t, model.Product{}, product)
@@ -300,14 +305,17 @@ func TestCreateProduct(t *testing.T) {
 
 		productRepoMock := productService.ProductRepository.(*mocks.MockIProductsRepo)
 		sellerRepoMock := productService.SellerRepository.(*mocks.MockISellerRepo)
+		productTypeRepoMock := productService.ProductTypeRepository.(*mocks.MockIProductTypeRepo)
 
-		sellerRepoMock.On("GetByID", dataProduct.SellerID).
+		sellerRepoMock.On("GetByID", mock.Anything, dataProduct.SellerID).
 			Return(dataSeller, nil)
-		productRepoMock.On("GetAll").Return(listOfProducts, nil)
+		productTypeRepoMock.On("GetByID", mock.Anything, dataProduct.ProductTypeID).
+			Return(model.ProductType{ID: dataProduct.ProductTypeID}, nil)
+		productRepoMock.On("GetAll", mock.Anything, model.ListParams{}).Return(listOfProducts, len(listOfProducts), nil)
 
-		product, err := productService.CreateProduct(listOfProducts[1])
+		product, err := productService.CreateProduct(context.Background(), listOfProducts[0])
 
-		assert.Equal(t, customerror.CustomError{Object: listOfProducts[1].ProductCode, Err: customerror.ErrConflict}, err)
+		assert.Equal(t, customerror.CustomError{Object: listOfProducts[0].ProductCode, Err: customerror.ErrConflict}, err)
 		assert.Equal(t, model.Product{}, product)
 		productRepoMock.AssertExpectations(t)
 		sellerRepoMock.AssertExpectations(t)
@@ -319,28 +327,53 @@ func TestCreateProduct(t *testing.T) {
 
 		sellerRepoMock := productService.SellerRepository.(*mocks.MockISellerRepo)
 
-		sellerRepoMock.On("GetByID", dataProduct.SellerID).
+		sellerRepoMock.On("GetByID", mock.Anything, dataProduct.SellerID).
 			Return(model.Seller{}, errors.New("seller not found"))
 
-		_, err := productService.CreateProduct(dataProduct)
+		_, err := productService.CreateProduct(context.Background(), dataProduct)
 
 		assert.EqualError(t, expectedErr, err.Error())
 		sellerRepoMock.AssertExpectations(t)
 	})
 
+	t.Run("Should return error product type not found", func(t *testing.T) {
+		expectedErr := customerror.HandleError("product type", customerror.ErrorNotFound, "")
+		productService := loadDependencies()
+
+		productRepoMock := productService.ProductRepository.(*mocks.MockIProductsRepo)
+		sellerRepoMock := productService.SellerRepository.(*mocks.MockISellerRepo)
+		productTypeRepoMock := productService.ProductTypeRepository.(*mocks.MockIProductTypeRepo)
+
+		sellerRepoMock.On("GetByID", mock.Anything, dataProduct.SellerID).
+			Return(dataSeller, nil)
+		productTypeRepoMock.On("GetByID", mock.Anything, dataProduct.ProductTypeID).
+			Return(model.ProductType{}, expectedErr)
+
+		product, err := productService.CreateProduct(context.Background(), dataProduct)
+
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, model.Product{}, product)
+		productRepoMock.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		sellerRepoMock.AssertExpectations(t)
+		productTypeRepoMock.AssertExpectations(t)
+	})
+
 	t.Run("Should return error in create product", func(t *testing.T) {
 		expectedError := errors.New("error to create product")
 		productService := loadDependencies()
 
 		productRepoMock := productService.ProductRepository.(*mocks.MockIProductsRepo)
 		sellerRepoMock := productService.SellerRepository.(*mocks.MockISellerRepo)
+		productTypeRepoMock := productService.ProductTypeRepository.(*mocks.MockIProductTypeRepo)
 
-		sellerRepoMock.On("GetByID", dataProduct.SellerID).
+		sellerRepoMock.On("GetByID", mock.Anything, dataProduct.SellerID).
 			Return(dataSeller, nil)
-		productRepoMock.On("GetAll").Return(listOfProducts, nil)
-		productRepoMock.On("Create", dataProduct).Return(model.Product{}, errors.New("error to create product"))
+		productTypeRepoMock.On("GetByID", mock.Anything, dataProduct.ProductTypeID).
+			Return(model.ProductType{ID: dataProduct.ProductTypeID}, nil)
+		productRepoMock.On("GetAll", mock.Anything, model.ListParams{}).Return(listOfProducts, len(listOfProducts), nil)
+		productRepoMock.On("Create", mock.Anything, dataProduct).Return(model.Product{}, errors.New("error to create product"))
 
-		_, err := productService.CreateProduct(dataProduct)
+		_, err := productService.CreateProduct(context.Background(), dataProduct)
 
 		assert.EqualError(t, expectedError, err.Error())
 		productRepoMock.AssertExpectations(t)
@@ -370,8 +403,8 @@ func TestUpdateProducts(t *testing.T) {
 			SellerID:                       1,
 		}
 
-		listOfProducts := map[int]model.Product{
-			1: {
+		listOfProducts := []model.Product{
+			{
 				ID:                             1,
 				ProductCode:                    "P001",
 				Description:                    "Product 1",
@@ -387,12 +420,15 @@ func TestUpdateProducts(t *testing.T) {
 			},
 		}
 
-		srm.On("GetByID", 1).Return(model.Seller{ID: 1}, nil)
-		prm.On("GetAll").Return(listOfProducts, nil)
-		prm.On("GetByID", 1).Return(listOfProducts[1], nil)
-		prm.On("Update", 1, mock.Anything).Return(inputProduct, nil)
+		ptm := productService.ProductTypeRepository.(*mocks.MockIProductTypeRepo)
+
+		srm.On("GetByID", mock.Anything, 1).Return(model.Seller{ID: 1}, nil)
+		ptm.On("GetByID", mock.Anything, 1).Return(model.ProductType{ID: 1}, nil)
+		prm.On("GetAll", mock.Anything, model.ListParams{}).Return(listOfProducts, len(listOfProducts), nil)
+		prm.On("GetByID", mock.Anything, 1).Return(listOfProducts[0], nil)
+		prm.On("Update", mock.Anything, 1, mock.Anything).Return(inputProduct, nil)
 
-		productUpdated, err := productService.UpdateProduct(1, inputProduct)
+		productUpdated, err := productService.UpdateProduct(context.Background(), 1, inputProduct)
 
 		assert.NoError(t, err)
 		assert.Equal(t, inputProduct, productUpdated)
@@ -405,9 +441,9 @@ func TestUpdateProducts(t *testing.T) {
 		prm := productService.ProductRepository.(*mocks.MockIProductsRepo)
 		srm := productService.SellerRepository.(*mocks.MockISellerRepo)
 
-		srm.On("GetByID", 1).Return(model.Seller{}, errors.New("seller not found"))
+		srm.On("GetByID", mock.Anything, 1).Return(model.Seller{}, errors.New("seller not found"))
 
-		productUpdated, err := productService.UpdateProduct(1, model.Product{SellerID: 1})
+		productUpdated, err := productService.UpdateProduct(context.Background(), 1, model.Product{SellerID: 1})
 
 		assert.EqualError(t, err, "seller not found")
 		assert.Equal(t, model.Product{}, productUpdated)
@@ -420,11 +456,11 @@ func TestUpdateProducts(t *testing.T) {
 		prm := productService.ProductRepository.(*mocks.MockIProductsRepo)
 		srm := productService.SellerRepository.(*mocks.MockISellerRepo)
 
-		srm.On("GetByID", 1).Return(model.Seller{ID: 1}, nil)
-		prm.On("GetAll").Return(make(map[int]model.Product), nil)
-		prm.On("GetByID", 2).Return(model.Product{}, customerror.HandleError("product", customerror.ErrorNotFound, ""))
+		srm.On("GetByID", mock.Anything, 1).Return(model.Seller{ID: 1}, nil)
+		prm.On("GetAll", mock.Anything, model.ListParams{}).Return([]model.Product{}, 0, nil)
+		prm.On("GetByID", mock.Anything, 2).Return(model.Product{}, customerror.HandleError("product", customerror.ErrorNotFound, ""))
 
-		productUpdated, err := productService.UpdateProduct(2, model.Product{SellerID: 1})
+		productUpdated, err := productService.UpdateProduct(context.Background(), 2, model.Product{SellerID: 1})
 
 		assert.Equal(t, customerror.HandleError("product", customerror.ErrorNotFound, ""), err)
 		assert.Equal(t, model.Product{}, productUpdated)
@@ -437,10 +473,13 @@ func TestUpdateProducts(t *testing.T) {
 		prm := productService.ProductRepository.(*mocks.MockIProductsRepo)
 		srm := productService.SellerRepository.(*mocks.MockISellerRepo)
 
-		srm.On("GetByID", 1).Return(model.Seller{ID: 1}, nil)
+		ptm := productService.ProductTypeRepository.(*mocks.MockIProductTypeRepo)
 
-		listOfProducts := map[int]model.Product{
-			1: {
+		srm.On("GetByID", mock.Anything, 1).Return(model.Seller{ID: 1}, nil)
+		ptm.On("GetByID", mock.Anything, 1).Return(model.ProductType{ID: 1}, nil)
+
+		listOfProducts := []model.Product{
+			{
 				ID:                             1,
 				ProductCode:                    "P001",
 				Description:                    "Product 1",
@@ -456,9 +495,9 @@ func TestUpdateProducts(t *testing.T) {
 			},
 		}
 
-		prm.On("GetAll").Return(listOfProducts, nil)
+		prm.On("GetAll", mock.Anything, model.ListParams{}).Return(listOfProducts, len(listOfProducts), nil)
 
-		productUpdated, err := productService.UpdateProduct(2, model.Product{
+		productUpdated, err := productService.UpdateProduct(context.Background(), 2, model.Product{
 			ID:                             1,
 			ProductCode:                    "P001",
 			Description:                    "Product updated 1",
@@ -478,4 +517,19 @@ func TestUpdateProducts(t *testing.T) {
 		prm.AssertExpectations(t)
 		srm.AssertExpectations(t)
 	})
+	t.Run("Should return error for product type not found", func(t *testing.T) {
+		productService := loadDependencies()
+		prm := productService.ProductRepository.(*mocks.MockIProductsRepo)
+		ptm := productService.ProductTypeRepository.(*mocks.MockIProductTypeRepo)
+
+		expectedErr := customerror.HandleError("product type", customerror.ErrorNotFound, "")
+		ptm.On("GetByID", mock.Anything, 9).Return(model.ProductType{}, expectedErr)
+
+		productUpdated, err := productService.UpdateProduct(context.Background(), 1, model.Product{ProductTypeID: 9})
+
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, model.Product{}, productUpdated)
+		prm.AssertExpectations(t)
+		ptm.AssertExpectations(t)
+	})
 }
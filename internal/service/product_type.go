@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+	"github.com/maxwelbm/alkemy-g7.git/internal/repository/interfaces"
+	"github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
+	"github.com/maxwelbm/alkemy-g7.git/pkg/logger"
+)
+
+type ProductTypeService struct {
+	Rp  interfaces.IProductTypeRepo
+	log logger.Logger
+}
+
+func NewProductTypeService(rp interfaces.IProductTypeRepo, log logger.Logger) *ProductTypeService {
+	return &ProductTypeService{Rp: rp, log: log}
+}
+
+func (s *ProductTypeService) GetAll(ctx context.Context) ([]model.ProductType, error) {
+	s.log.Log("ProductTypeService", "INFO", "initializing GetAll function")
+
+	return s.Rp.Get(ctx)
+}
+
+func (s *ProductTypeService) GetByID(ctx context.Context, id int) (model.ProductType, error) {
+	s.log.Log("ProductTypeService", "INFO", fmt.Sprintf("initializing GetByID function with id %d", id))
+
+	return s.Rp.GetByID(ctx, id)
+}
+
+func (s *ProductTypeService) Create(ctx context.Context, productType model.ProductType) (model.ProductType, error) {
+	s.log.Log("ProductTypeService", "INFO", "initializing Create function")
+
+	if err := productType.Validate(); err != nil {
+		s.log.Log("ProductTypeService", "ERROR", fmt.Sprintf("Error: %v", err))
+		return model.ProductType{}, customerror.HandleError("product type", customerror.ErrorInvalid, err.Error())
+	}
+
+	pt, err := s.Rp.Post(ctx, productType)
+	if err != nil {
+		s.log.Log("ProductTypeService", "ERROR", fmt.Sprintf("Error: %v", err))
+		return model.ProductType{}, err
+	}
+
+	s.log.Log("ProductTypeService", "INFO", fmt.Sprintf("product type created: %v", pt))
+
+	return pt, nil
+}
+
+func (s *ProductTypeService) Update(ctx context.Context, id int, productType model.ProductType) (model.ProductType, error) {
+	s.log.Log("ProductTypeService", "INFO", fmt.Sprintf("initializing Update function with id %d", id))
+
+	existing, err := s.Rp.GetByID(ctx, id)
+	if err != nil {
+		s.log.Log("ProductTypeService", "ERROR", fmt.Sprintf("Error: %v", err))
+		return model.ProductType{}, err
+	}
+
+	if productType.TypeName != "" {
+		existing.TypeName = productType.TypeName
+	}
+
+	if err := existing.Validate(); err != nil {
+		s.log.Log("ProductTypeService", "ERROR", fmt.Sprintf("Error: %v", err))
+		return model.ProductType{}, customerror.HandleError("product type", customerror.ErrorInvalid, err.Error())
+	}
+
+	pt, err := s.Rp.Update(ctx, id, existing)
+	if err != nil {
+		s.log.Log("ProductTypeService", "ERROR", fmt.Sprintf("Error: %v", err))
+		return model.ProductType{}, err
+	}
+
+	s.log.Log("ProductTypeService", "INFO", fmt.Sprintf("product type updated: %v", pt))
+
+	return pt, nil
+}
+
+func (s *ProductTypeService) Delete(ctx context.Context, id int) error {
+	s.log.Log("ProductTypeService", "INFO", fmt.Sprintf("initializing Delete function with id %d", id))
+
+	usage, err := s.Rp.CountUsageByID(ctx, id)
+	if err != nil {
+		s.log.Log("ProductTypeService", "ERROR", fmt.Sprintf("Error: %v", err))
+		return err
+	}
+
+	if usage.ProductsCount > 0 || usage.SectionsCount > 0 {
+		err = customerror.HandleError("product type", customerror.ErrorDep, "")
+		s.log.Log("ProductTypeService", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return err
+	}
+
+	err = s.Rp.Delete(ctx, id)
+	if err != nil {
+		s.log.Log("ProductTypeService", "ERROR", fmt.Sprintf("Error: %v", err))
+		return err
+	}
+
+	s.log.Log("ProductTypeService", "INFO", fmt.Sprintf("product type with id %d deleted", id))
+
+	return nil
+}
+
+func (s *ProductTypeService) GetUsageReport(ctx context.Context) ([]model.ProductTypeUsage, error) {
+	s.log.Log("ProductTypeService", "INFO", "initializing GetUsageReport function")
+
+	return s.Rp.CountUsage(ctx)
+}
+
+func (s *ProductTypeService) GetUsageReportByID(ctx context.Context, id int) (model.ProductTypeUsage, error) {
+	s.log.Log("ProductTypeService", "INFO", fmt.Sprintf("initializing GetUsageReportByID function with id %d", id))
+
+	return s.Rp.CountUsageByID(ctx, id)
+}
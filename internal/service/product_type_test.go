@@ -0,0 +1,94 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/maxwelbm/alkemy-g7.git/internal/mocks"
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+	"github.com/maxwelbm/alkemy-g7.git/internal/service"
+	"github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func setupProductType(t *testing.T) *service.ProductTypeService {
+	mockRepo := mocks.NewMockIProductTypeRepo(t)
+	return service.NewProductTypeService(mockRepo, logMock)
+}
+
+func TestProductTypeService_Create(t *testing.T) {
+	t.Run("create a valid product type", func(t *testing.T) {
+		svc := setupProductType(t)
+		mockRepo := svc.Rp.(*mocks.MockIProductTypeRepo)
+
+		mockRepo.On("Post", mock.Anything, model.ProductType{TypeName: "Frozen"}).Return(model.ProductType{ID: 1, TypeName: "Frozen"}, nil)
+
+		productType, err := svc.Create(context.Background(), model.ProductType{TypeName: "Frozen"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, model.ProductType{ID: 1, TypeName: "Frozen"}, productType)
+	})
+
+	t.Run("return unprocessable entity when the name is empty", func(t *testing.T) {
+		svc := setupProductType(t)
+
+		productType, err := svc.Create(context.Background(), model.ProductType{TypeName: " "})
+
+		assert.Equal(t, customerror.HandleError("product type", customerror.ErrorInvalid, "validation errors: TypeName is required"), err)
+		assert.Equal(t, model.ProductType{}, productType)
+	})
+}
+
+func TestProductTypeService_Update(t *testing.T) {
+	t.Run("update an existing product type", func(t *testing.T) {
+		svc := setupProductType(t)
+		mockRepo := svc.Rp.(*mocks.MockIProductTypeRepo)
+
+		mockRepo.On("GetByID", mock.Anything, 1).Return(model.ProductType{ID: 1, TypeName: "Frozen"}, nil)
+		mockRepo.On("Update", mock.Anything, 1, model.ProductType{ID: 1, TypeName: "Chilled"}).Return(model.ProductType{ID: 1, TypeName: "Chilled"}, nil)
+
+		productType, err := svc.Update(context.Background(), 1, model.ProductType{TypeName: "Chilled"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, model.ProductType{ID: 1, TypeName: "Chilled"}, productType)
+	})
+
+	t.Run("return not found for an unknown product type", func(t *testing.T) {
+		svc := setupProductType(t)
+		mockRepo := svc.Rp.(*mocks.MockIProductTypeRepo)
+
+		expectedErr := customerror.HandleError("product type", customerror.ErrorNotFound, "")
+		mockRepo.On("GetByID", mock.Anything, 99).Return(model.ProductType{}, expectedErr)
+
+		_, err := svc.Update(context.Background(), 99, model.ProductType{TypeName: "Chilled"})
+
+		assert.ErrorIs(t, err, expectedErr)
+	})
+}
+
+func TestProductTypeService_Delete(t *testing.T) {
+	t.Run("delete an unused product type", func(t *testing.T) {
+		svc := setupProductType(t)
+		mockRepo := svc.Rp.(*mocks.MockIProductTypeRepo)
+
+		mockRepo.On("CountUsageByID", mock.Anything, 1).Return(model.ProductTypeUsage{ID: 1, TypeName: "Frozen"}, nil)
+		mockRepo.On("Delete", mock.Anything, 1).Return(nil)
+
+		err := svc.Delete(context.Background(), 1)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("return conflict when products or sections use the product type", func(t *testing.T) {
+		svc := setupProductType(t)
+		mockRepo := svc.Rp.(*mocks.MockIProductTypeRepo)
+
+		mockRepo.On("CountUsageByID", mock.Anything, 1).Return(model.ProductTypeUsage{ID: 1, TypeName: "Frozen", SectionsCount: 1}, nil)
+
+		err := svc.Delete(context.Background(), 1)
+
+		assert.Equal(t, customerror.HandleError("product type", customerror.ErrorDep, ""), err)
+		mockRepo.AssertNotCalled(t, "Delete", mock.Anything, 1)
+	})
+}
@@ -1,7 +1,9 @@
 package service
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/maxwelbm/alkemy-g7.git/internal/model"
@@ -25,7 +27,7 @@ func NewProductRecService(productRecRepo repo.IProductRecRepository, productServ
 	}
 }
 
-func (prs *ProductRecService) CreateProductRecords(pr model.ProductRecords) (model.ProductRecords, error) {
+func (prs *ProductRecService) CreateProductRecords(ctx context.Context, pr model.ProductRecords) (model.ProductRecords, error) {
 	prs.log.Log("ProductRecService", "INFO", "CreateProductRecords function initializing")
 
 	if err := pr.Validate(); err != nil {
@@ -33,7 +35,7 @@ func (prs *ProductRecService) CreateProductRecords(pr model.ProductRecords) (mod
 		return model.ProductRecords{}, appErr.HandleError("product record", appErr.ErrorInvalid, err.Error())
 	}
 
-	if _, err := prs.ProductSv.GetProductByID(pr.ProductID); err != nil {
+	if _, err := prs.ProductSv.GetProductByID(ctx, pr.ProductID); err != nil {
 		prs.log.Log("ProductRecService", "ERROR", fmt.Sprintf("Product not found with ID: %d", pr.ProductID))
 		return model.ProductRecords{}, err
 	}
@@ -41,7 +43,7 @@ func (prs *ProductRecService) CreateProductRecords(pr model.ProductRecords) (mod
 	pr.LastUpdateDate = time.Now()
 	prs.log.Log("ProductRecService", "INFO", fmt.Sprintf("Creating product record for Product ID: %d", pr.ProductID))
 
-	productRecord, err := prs.ProductRecRepository.Create(pr)
+	productRecord, err := prs.ProductRecRepository.Create(ctx, pr)
 
 	if err != nil {
 		prs.log.Log("ProductRecService", "ERROR", "Error creating product record: "+err.Error())
@@ -52,10 +54,10 @@ func (prs *ProductRecService) CreateProductRecords(pr model.ProductRecords) (mod
 	return productRecord, nil
 }
 
-func (prs *ProductRecService) GetProductRecordByID(id int) (model.ProductRecords, error) {
+func (prs *ProductRecService) GetProductRecordByID(ctx context.Context, id int) (model.ProductRecords, error) {
 	prs.log.Log("ProductRecService", "INFO", fmt.Sprintf("GetProductRecordByID function initializing for ID: %d", id))
 
-	productRecord, err := prs.ProductRecRepository.GetByID(id)
+	productRecord, err := prs.ProductRecRepository.GetByID(ctx, id)
 	if err != nil {
 		prs.log.Log("ProductRecService", "ERROR", fmt.Sprintf("Error retrieving product record with ID: %d , error: %s", id, err.Error()))
 		return model.ProductRecords{}, err
@@ -65,33 +67,184 @@ func (prs *ProductRecService) GetProductRecordByID(id int) (model.ProductRecords
 	return productRecord, nil
 }
 
-func (prs *ProductRecService) GetProductRecordReport(idProduct int) ([]model.ProductRecordsReport, error) {
+func (prs *ProductRecService) GetProductRecords(ctx context.Context, params model.ListParams) ([]model.ProductRecords, int, error) {
+	prs.log.Log("ProductRecService", "INFO", "GetProductRecords function initializing")
+
+	productRecords, total, err := prs.ProductRecRepository.GetAll(ctx, params)
+	if err != nil {
+		prs.log.Log("ProductRecService", "ERROR", "Error retrieving product records: "+err.Error())
+		return nil, 0, err
+	}
+
+	prs.log.Log("ProductRecService", "INFO", fmt.Sprintf("Retrieved %d product records", len(productRecords)))
+	return productRecords, total, nil
+}
+
+// DeleteProductRecord removes a record no purchase order is priced with.
+func (prs *ProductRecService) DeleteProductRecord(ctx context.Context, id int) error {
+	prs.log.Log("ProductRecService", "INFO", fmt.Sprintf("DeleteProductRecord function initializing for ID: %d", id))
+
+	if _, err := prs.ProductRecRepository.GetByID(ctx, id); err != nil {
+		prs.log.Log("ProductRecService", "ERROR", fmt.Sprintf("Error retrieving product record with ID: %d , error: %s", id, err.Error()))
+		return err
+	}
+
+	count, err := prs.ProductRecRepository.CountPurchaseOrders(ctx, id)
+	if err != nil {
+		prs.log.Log("ProductRecService", "ERROR", fmt.Sprintf("Error counting purchase orders of product record with ID: %d , error: %s", id, err.Error()))
+		return err
+	}
+
+	if count > 0 {
+		prs.log.Log("ProductRecService", "ERROR", fmt.Sprintf("Product record with ID: %d is referenced by %d purchase orders", id, count))
+		return appErr.HandleError("product record", appErr.ErrorDep, "")
+	}
+
+	if err := prs.ProductRecRepository.Delete(ctx, id); err != nil {
+		prs.log.Log("ProductRecService", "ERROR", fmt.Sprintf("Error deleting product record with ID: %d , error: %s", id, err.Error()))
+		return err
+	}
+
+	prs.log.Log("ProductRecService", "INFO", fmt.Sprintf("Product record with ID: %d deleted successfully", id))
+	return nil
+}
+
+// GetLatestProductRecord returns the record holding the current prices of the product.
+func (prs *ProductRecService) GetLatestProductRecord(ctx context.Context, idProduct int) (model.ProductRecords, error) {
+	prs.log.Log("ProductRecService", "INFO", fmt.Sprintf("GetLatestProductRecord function initializing for ProductID: %d", idProduct))
+
+	if _, err := prs.ProductSv.GetProductByID(ctx, idProduct); err != nil {
+		prs.log.Log("ProductRecService", "ERROR", fmt.Sprintf("Product not found with ID: %d", idProduct))
+		return model.ProductRecords{}, err
+	}
+
+	productRecord, err := prs.ProductRecRepository.GetLatestByIDProduct(ctx, idProduct)
+	if err != nil {
+		prs.log.Log("ProductRecService", "ERROR", fmt.Sprintf("Error retrieving latest product record for ProductID: %d , error: %s", idProduct, err.Error()))
+		return model.ProductRecords{}, err
+	}
+
+	prs.log.Log("ProductRecService", "INFO", fmt.Sprintf("Retrieved latest product record: %v", productRecord))
+	return productRecord, nil
+}
+
+func (prs *ProductRecService) GetProductRecordReport(ctx context.Context, idProduct int) ([]model.ProductRecordsReport, error) {
 	prs.log.Log("ProductRecService", "INFO", fmt.Sprintf("GetProductRecordReport function initializing for ProductID: %d", idProduct))
 
-	allReports, err := prs.ProductRecRepository.GetAllReport()
+	if idProduct != 0 {
+		if _, err := prs.ProductSv.GetProductByID(ctx, idProduct); err != nil {
+			prs.log.Log("ProductRecService", "ERROR", fmt.Sprintf("Product not found with ID: %d", idProduct))
+			return nil, err
+		}
+	}
+
+	reports, err := prs.ProductRecRepository.GetAllReport(ctx, idProduct)
 	if err != nil {
 		prs.log.Log("ProductRecService", "ERROR", "Error retrieving product record reports: "+err.Error())
 		return nil, err
 	}
 
-	var filteredReports []model.ProductRecordsReport
+	prs.log.Log("ProductRecService", "INFO", fmt.Sprintf("Retrieved reports for ProductID: %d, Count: %d", idProduct, len(reports)))
+	return reports, nil
+}
+
+// GetProductPriceHistory returns the prices of the product within the period together with its current prices.
+func (prs *ProductRecService) GetProductPriceHistory(ctx context.Context, idProduct int, from, to time.Time) (model.ProductPriceHistory, error) {
+	prs.log.Log("ProductRecService", "INFO", fmt.Sprintf("GetProductPriceHistory function initializing for ProductID: %d", idProduct))
 
-	if idProduct == 0 {
-		prs.log.Log("ProductRecService", "INFO", "No ProductID provided, returning all reports.")
-		return allReports, nil
+	if err := (model.ProductMarginFilter{From: from, To: to}).Validate(); err != nil {
+		prs.log.Log("ProductRecService", "ERROR", "Validation error: "+err.Error())
+		return model.ProductPriceHistory{}, appErr.HandleError("product record", appErr.ErrorInvalid, err.Error())
 	}
 
-	if _, err := prs.ProductSv.GetProductByID(idProduct); err != nil {
+	if _, err := prs.ProductSv.GetProductByID(ctx, idProduct); err != nil {
 		prs.log.Log("ProductRecService", "ERROR", fmt.Sprintf("Product not found with ID: %d", idProduct))
-		return filteredReports, err
+		return model.ProductPriceHistory{}, err
+	}
+
+	records, err := prs.ProductRecRepository.GetPriceHistory(ctx, idProduct, from, to)
+	if err != nil {
+		prs.log.Log("ProductRecService", "ERROR", fmt.Sprintf("Error retrieving price history for ProductID: %d , error: %s", idProduct, err.Error()))
+		return model.ProductPriceHistory{}, err
+	}
+
+	history := model.ProductPriceHistory{ProductID: idProduct, Records: make([]model.ProductPricePoint, 0, len(records))}
+
+	for _, record := range records {
+		history.Records = append(history.Records, record.ToPricePoint())
+	}
+
+	latest, err := prs.ProductRecRepository.GetLatestByIDProduct(ctx, idProduct)
+	if err != nil {
+		if e, ok := err.(*appErr.GenericError); !ok || e.Code != http.StatusNotFound {
+			prs.log.Log("ProductRecService", "ERROR", fmt.Sprintf("Error retrieving latest product record for ProductID: %d , error: %s", idProduct, err.Error()))
+			return model.ProductPriceHistory{}, err
+		}
+	} else {
+		current := latest.ToPricePoint()
+		history.Current = &current
+	}
+
+	prs.log.Log("ProductRecService", "INFO", fmt.Sprintf("Retrieved %d price history records for ProductID: %d", len(history.Records), idProduct))
+	return history, nil
+}
+
+// GetProductMarginReport returns the margins of each product within the filter.
+func (prs *ProductRecService) GetProductMarginReport(ctx context.Context, filter model.ProductMarginFilter) ([]model.ProductMarginReport, error) {
+	prs.log.Log("ProductRecService", "INFO", "GetProductMarginReport function initializing")
+
+	if err := prs.validateMarginFilter(ctx, filter); err != nil {
+		return nil, err
+	}
+
+	reports, err := prs.ProductRecRepository.GetProductMargins(ctx, filter)
+	if err != nil {
+		prs.log.Log("ProductRecService", "ERROR", "Error retrieving product margins: "+err.Error())
+		return nil, err
+	}
+
+	for i := range reports {
+		reports[i].Round()
+	}
+
+	prs.log.Log("ProductRecService", "INFO", fmt.Sprintf("Built margin report for %d products", len(reports)))
+	return reports, nil
+}
+
+// GetSellerMarginReport returns the margins of the products of each seller within the filter.
+func (prs *ProductRecService) GetSellerMarginReport(ctx context.Context, filter model.ProductMarginFilter) ([]model.SellerMarginReport, error) {
+	prs.log.Log("ProductRecService", "INFO", "GetSellerMarginReport function initializing")
+
+	if err := prs.validateMarginFilter(ctx, filter); err != nil {
+		return nil, err
+	}
+
+	reports, err := prs.ProductRecRepository.GetSellerMargins(ctx, filter)
+	if err != nil {
+		prs.log.Log("ProductRecService", "ERROR", "Error retrieving seller margins: "+err.Error())
+		return nil, err
+	}
+
+	for i := range reports {
+		reports[i].Round()
+	}
+
+	prs.log.Log("ProductRecService", "INFO", fmt.Sprintf("Built margin report for %d sellers", len(reports)))
+	return reports, nil
+}
+
+func (prs *ProductRecService) validateMarginFilter(ctx context.Context, filter model.ProductMarginFilter) error {
+	if err := filter.Validate(); err != nil {
+		prs.log.Log("ProductRecService", "ERROR", "Validation error: "+err.Error())
+		return appErr.HandleError("product record", appErr.ErrorInvalid, err.Error())
 	}
 
-	for _, report := range allReports {
-		if report.ProductID == idProduct {
-			filteredReports = append(filteredReports, report)
+	if filter.ProductID > 0 {
+		if _, err := prs.ProductSv.GetProductByID(ctx, filter.ProductID); err != nil {
+			prs.log.Log("ProductRecService", "ERROR", fmt.Sprintf("Product not found with ID: %d", filter.ProductID))
+			return err
 		}
 	}
 
-	prs.log.Log("ProductRecService", "INFO", fmt.Sprintf("Filtered reports for ProductID: %d, Count: %d", idProduct, len(filteredReports)))
-	return filteredReports, nil
+	return nil
 }
@@ -1,12 +1,15 @@
 package service_test
 
 import (
+	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/maxwelbm/alkemy-g7.git/internal/mocks"
 	"github.com/maxwelbm/alkemy-g7.git/internal/model"
 	"github.com/maxwelbm/alkemy-g7.git/internal/service"
+	appErr "github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -25,11 +28,11 @@ func TestProductRecService_CreateProductRecords(t *testing.T) {
 
 		pId := 1
 
-		productSv.On("GetProductByID", pId).Return(model.Product{}, nil)
+		productSv.On("GetProductByID", mock.Anything, pId).Return(model.Product{}, nil)
 
-		productRecRepo.On("Create", mock.Anything).Return(product, nil)
+		productRecRepo.On("Create", mock.Anything, mock.Anything).Return(product, nil)
 
-		res, err := sv.CreateProductRecords(product)
+		res, err := sv.CreateProductRecords(context.Background(), product)
 
 		assert.NoError(t, err)
 		assert.Equal(t, product.PurchasePrice, res.PurchasePrice)
@@ -44,7 +47,7 @@ func TestProductRecService_CreateProductRecords(t *testing.T) {
 
 		product.PurchasePrice = 0
 
-		_, err := sv.CreateProductRecords(product)
+		_, err := sv.CreateProductRecords(context.Background(), product)
 
 		assert.EqualError(t, err, "product record had errors: Purchase price is invalid")
 	})
@@ -56,9 +59,9 @@ func TestProductRecService_CreateProductRecords(t *testing.T) {
 
 		product.PurchasePrice = 11.0
 
-		productSv.On("GetProductByID", mock.Anything).Return(model.Product{}, errors.New("product not found"))
+		productSv.On("GetProductByID", mock.Anything, mock.Anything).Return(model.Product{}, errors.New("product not found"))
 
-		_, err := sv.CreateProductRecords(product)
+		_, err := sv.CreateProductRecords(context.Background(), product)
 
 		assert.EqualError(t, err, "product not found")
 	})
@@ -70,10 +73,10 @@ func TestProductRecService_CreateProductRecords(t *testing.T) {
 
 		product.PurchasePrice = 11.0
 
-		productSv.On("GetProductByID", mock.Anything).Return(model.Product{}, nil)
-		productRecRepo.On("Create", mock.Anything).Return(model.ProductRecords{}, errors.New("error creating product"))
+		productSv.On("GetProductByID", mock.Anything, mock.Anything).Return(model.Product{}, nil)
+		productRecRepo.On("Create", mock.Anything, mock.Anything).Return(model.ProductRecords{}, errors.New("error creating product"))
 
-		_, err := sv.CreateProductRecords(product)
+		_, err := sv.CreateProductRecords(context.Background(), product)
 
 		assert.EqualError(t, err, "error creating product")
 	})
@@ -93,9 +96,9 @@ func TestProductRecService_GetProductRecordByID(t *testing.T) {
 		productSv := new(mocks.MockIProductService)
 		sv := service.NewProductRecService(productRecRepo, productSv, logMock)
 
-		productRecRepo.On("GetByID", mock.Anything).Return(product, nil)
+		productRecRepo.On("GetByID", mock.Anything, mock.Anything).Return(product, nil)
 
-		res, err := sv.GetProductRecordByID(1)
+		res, err := sv.GetProductRecordByID(context.Background(), 1)
 
 		assert.NoError(t, err)
 		assert.Equal(t, product, res)
@@ -106,14 +109,50 @@ func TestProductRecService_GetProductRecordByID(t *testing.T) {
 		productSv := new(mocks.MockIProductService)
 		sv := service.NewProductRecService(productRecRepo, productSv, logMock)
 
-		productRecRepo.On("GetByID", mock.Anything).Return(model.ProductRecords{}, errors.New("Not found"))
+		productRecRepo.On("GetByID", mock.Anything, mock.Anything).Return(model.ProductRecords{}, errors.New("Not found"))
 
-		_, err := sv.GetProductRecordByID(1)
+		_, err := sv.GetProductRecordByID(context.Background(), 1)
 
 		assert.EqualError(t, err, "Not found")
 	})
 }
 
+func TestProductRecService_GetLatestProductRecord(t *testing.T) {
+	product := model.ProductRecords{
+		ProductID:     1,
+		ID:            4,
+		PurchasePrice: 11.9,
+		SalePrice:     32.4,
+	}
+
+	t.Run("Sucess getting latest product rec", func(t *testing.T) {
+		productRecRepo := new(mocks.MockIProductRecRepository)
+		productSv := new(mocks.MockIProductService)
+		sv := service.NewProductRecService(productRecRepo, productSv, logMock)
+
+		productSv.On("GetProductByID", mock.Anything, 1).Return(model.Product{ID: 1}, nil)
+		productRecRepo.On("GetLatestByIDProduct", mock.Anything, 1).Return(product, nil)
+
+		res, err := sv.GetLatestProductRecord(context.Background(), 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, product, res)
+	})
+
+	t.Run("Error product not found", func(t *testing.T) {
+		productRecRepo := new(mocks.MockIProductRecRepository)
+		productSv := new(mocks.MockIProductService)
+		sv := service.NewProductRecService(productRecRepo, productSv, logMock)
+
+		productSv.On("GetProductByID", mock.Anything, 1).Return(model.Product{}, errors.New("Not found"))
+
+		_, err := sv.GetLatestProductRecord(context.Background(), 1)
+
+		assert.EqualError(t, err, "Not found")
+		productRecRepo.AssertNotCalled(t, "GetLatestByIDProduct", mock.Anything, mock.Anything)
+	})
+}
+
 func TestProductRecService_GetProductRecordReport(t *testing.T) {
 	t.Run("Success getting filtered reports by product ID", func(t *testing.T) {
 		productRecRepo := new(mocks.MockIProductRecRepository)
@@ -124,13 +163,12 @@ func TestProductRecService_GetProductRecordReport(t *testing.T) {
 
 		mockReports := []model.ProductRecordsReport{
 			{ProductID: 1, Description: "Product A", RecordsCount: 2},
-			{ProductID: 2, Description: "Product B", RecordsCount: 3},
 		}
 
-		productRecRepo.On("GetAllReport").Return(mockReports, nil)
-		productSv.On("GetProductByID", idProduct).Return(model.Product{}, nil)
+		productRecRepo.On("GetAllReport", mock.Anything, idProduct).Return(mockReports, nil)
+		productSv.On("GetProductByID", mock.Anything, idProduct).Return(model.Product{}, nil)
 
-		res, err := sv.GetProductRecordReport(idProduct)
+		res, err := sv.GetProductRecordReport(context.Background(), idProduct)
 
 		assert.NoError(t, err)
 		assert.Equal(t, 1, len(res))                    
@@ -148,9 +186,9 @@ func TestProductRecService_GetProductRecordReport(t *testing.T) {
 			{ProductID: 1, Description: "Product A", RecordsCount: 2},
 			{ProductID: 2, Description: "Product B", RecordsCount: 3},
 		}
-		productRecRepo.On("GetAllReport").Return(mockReports, nil)
+		productRecRepo.On("GetAllReport", mock.Anything, 0).Return(mockReports, nil)
 
-		res, err := sv.GetProductRecordReport(idProduct)
+		res, err := sv.GetProductRecordReport(context.Background(), idProduct)
 
 		assert.NoError(t, err)
 		assert.Equal(t, mockReports, res)
@@ -162,9 +200,10 @@ func TestProductRecService_GetProductRecordReport(t *testing.T) {
 		sv := service.NewProductRecService(productRecRepo, productSv, logMock)
 
 		idProduct := 1
-		productRecRepo.On("GetAllReport").Return(nil, assert.AnError)
+		productRecRepo.On("GetAllReport", mock.Anything, idProduct).Return(nil, assert.AnError)
+		productSv.On("GetProductByID", mock.Anything, idProduct).Return(model.Product{}, nil)
 
-		res, err := sv.GetProductRecordReport(idProduct)
+		res, err := sv.GetProductRecordReport(context.Background(), idProduct)
 
 		assert.Error(t, err)
 		assert.Nil(t, res)
@@ -176,17 +215,239 @@ func TestProductRecService_GetProductRecordReport(t *testing.T) {
 		sv := service.NewProductRecService(productRecRepo, productSv, logMock)
 
 		idProduct := 1
-		mockReports := []model.ProductRecordsReport{
-			{ProductID: 1, Description: "Product A", RecordsCount: 2},
-			{ProductID: 2, Description: "Product B", RecordsCount: 3},
-		}
-		productRecRepo.On("GetAllReport").Return(mockReports, nil)
-		productSv.On("GetProductByID", idProduct).Return(model.Product{}, assert.AnError)
+		productSv.On("GetProductByID", mock.Anything, idProduct).Return(model.Product{}, assert.AnError)
 
-		res, err := sv.GetProductRecordReport(idProduct)
+		res, err := sv.GetProductRecordReport(context.Background(), idProduct)
 
 		assert.Error(t, err)
 		assert.Equal(t, 0, len(res))
+		productRecRepo.AssertNotCalled(t, "GetAllReport", mock.Anything, idProduct)
+	})
+
+}
+
+func TestProductRecService_GetProductPriceHistory(t *testing.T) {
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)
+	records := []model.ProductRecords{
+		{ID: 1, ProductID: 1, LastUpdateDate: time.Date(2025, 1, 5, 0, 0, 0, 0, time.UTC), PurchasePrice: 10, SalePrice: 20},
+		{ID: 2, ProductID: 1, LastUpdateDate: time.Date(2025, 1, 20, 0, 0, 0, 0, time.UTC), PurchasePrice: 12, SalePrice: 16},
+	}
+
+	t.Run("Success getting the price history with the current prices", func(t *testing.T) {
+		productRecRepo := new(mocks.MockIProductRecRepository)
+		productSv := new(mocks.MockIProductService)
+		sv := service.NewProductRecService(productRecRepo, productSv, logMock)
+
+		productSv.On("GetProductByID", mock.Anything, 1).Return(model.Product{ID: 1}, nil)
+		productRecRepo.On("GetPriceHistory", mock.Anything, 1, from, to).Return(records, nil)
+		productRecRepo.On("GetLatestByIDProduct", mock.Anything, 1).Return(model.ProductRecords{ID: 3, ProductID: 1, PurchasePrice: 15, SalePrice: 20}, nil)
+
+		res, err := sv.GetProductPriceHistory(context.Background(), 1, from, to)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, res.ProductID)
+		assert.Equal(t, []model.ProductPricePoint{
+			{ID: 1, LastUpdateDate: records[0].LastUpdateDate, PurchasePrice: 10, SalePrice: 20, Margin: 50},
+			{ID: 2, LastUpdateDate: records[1].LastUpdateDate, PurchasePrice: 12, SalePrice: 16, Margin: 25},
+		}, res.Records)
+		assert.Equal(t, &model.ProductPricePoint{ID: 3, PurchasePrice: 15, SalePrice: 20, Margin: 25}, res.Current)
+	})
+
+	t.Run("Success getting an empty history of a product without records", func(t *testing.T) {
+		productRecRepo := new(mocks.MockIProductRecRepository)
+		productSv := new(mocks.MockIProductService)
+		sv := service.NewProductRecService(productRecRepo, productSv, logMock)
+
+		productSv.On("GetProductByID", mock.Anything, 1).Return(model.Product{ID: 1}, nil)
+		productRecRepo.On("GetPriceHistory", mock.Anything, 1, time.Time{}, time.Time{}).Return(nil, nil)
+		productRecRepo.On("GetLatestByIDProduct", mock.Anything, 1).Return(model.ProductRecords{}, appErr.HandleError("product record", appErr.ErrorNotFound, ""))
+
+		res, err := sv.GetProductPriceHistory(context.Background(), 1, time.Time{}, time.Time{})
+
+		assert.NoError(t, err)
+		assert.Empty(t, res.Records)
+		assert.Nil(t, res.Current)
+	})
+
+	t.Run("Error reversed period", func(t *testing.T) {
+		productRecRepo := new(mocks.MockIProductRecRepository)
+		productSv := new(mocks.MockIProductService)
+		sv := service.NewProductRecService(productRecRepo, productSv, logMock)
+
+		_, err := sv.GetProductPriceHistory(context.Background(), 1, to, from)
+
+		assert.Equal(t, appErr.HandleError("product record", appErr.ErrorInvalid, "from cannot be after to"), err)
+		productSv.AssertNotCalled(t, "GetProductByID", mock.Anything, mock.Anything)
 	})
 
+	t.Run("Error product not found", func(t *testing.T) {
+		productRecRepo := new(mocks.MockIProductRecRepository)
+		productSv := new(mocks.MockIProductService)
+		sv := service.NewProductRecService(productRecRepo, productSv, logMock)
+
+		productSv.On("GetProductByID", mock.Anything, 1).Return(model.Product{}, errors.New("Not found"))
+
+		_, err := sv.GetProductPriceHistory(context.Background(), 1, from, to)
+
+		assert.EqualError(t, err, "Not found")
+		productRecRepo.AssertNotCalled(t, "GetPriceHistory", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestProductRecService_GetMarginReports(t *testing.T) {
+	t.Run("Success getting the margin report per product", func(t *testing.T) {
+		productRecRepo := new(mocks.MockIProductRecRepository)
+		productSv := new(mocks.MockIProductService)
+		sv := service.NewProductRecService(productRecRepo, productSv, logMock)
+
+		productRecRepo.On("GetProductMargins", mock.Anything, model.ProductMarginFilter{}).Return([]model.ProductMarginReport{
+			{ProductID: 1, Description: "Product A", SellerID: 1, RecordsCount: 2, AverageMargin: 45, MinMargin: 40, MaxMargin: 50, PriceChangePercentage: 25},
+			{ProductID: 2, Description: "Product B", SellerID: 1, RecordsCount: 3, AverageMargin: 33.333333, MinMargin: 25, MaxMargin: 41.666666, PriceChangePercentage: -8.333333},
+		}, nil)
+
+		res, err := sv.GetProductMarginReport(context.Background(), model.ProductMarginFilter{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []model.ProductMarginReport{
+			{ProductID: 1, Description: "Product A", SellerID: 1, RecordsCount: 2, AverageMargin: 45, MinMargin: 40, MaxMargin: 50, PriceChangePercentage: 25},
+			{ProductID: 2, Description: "Product B", SellerID: 1, RecordsCount: 3, AverageMargin: 33.33, MinMargin: 25, MaxMargin: 41.67, PriceChangePercentage: -8.33},
+		}, res)
+	})
+
+	t.Run("Success getting the margin report per seller", func(t *testing.T) {
+		productRecRepo := new(mocks.MockIProductRecRepository)
+		productSv := new(mocks.MockIProductService)
+		sv := service.NewProductRecService(productRecRepo, productSv, logMock)
+
+		productRecRepo.On("GetSellerMargins", mock.Anything, model.ProductMarginFilter{}).Return([]model.SellerMarginReport{
+			{SellerID: 1, ProductsCount: 2, RecordsCount: 3, AverageMargin: 38.333333, MinMargin: 25, MaxMargin: 50, PriceChangePercentage: 12.5},
+			{SellerID: 2, ProductsCount: 1, RecordsCount: 1, AverageMargin: 50, MinMargin: 50, MaxMargin: 50},
+		}, nil)
+
+		res, err := sv.GetSellerMarginReport(context.Background(), model.ProductMarginFilter{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []model.SellerMarginReport{
+			{SellerID: 1, ProductsCount: 2, RecordsCount: 3, AverageMargin: 38.33, MinMargin: 25, MaxMargin: 50, PriceChangePercentage: 12.5},
+			{SellerID: 2, ProductsCount: 1, RecordsCount: 1, AverageMargin: 50, MinMargin: 50, MaxMargin: 50},
+		}, res)
+	})
+
+	t.Run("Error invalid period", func(t *testing.T) {
+		productRecRepo := new(mocks.MockIProductRecRepository)
+		productSv := new(mocks.MockIProductService)
+		sv := service.NewProductRecService(productRecRepo, productSv, logMock)
+
+		filter := model.ProductMarginFilter{From: time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC), To: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+		_, err := sv.GetSellerMarginReport(context.Background(), filter)
+
+		assert.Equal(t, appErr.HandleError("product record", appErr.ErrorInvalid, "from cannot be after to"), err)
+		productRecRepo.AssertNotCalled(t, "GetSellerMargins", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Error product not found", func(t *testing.T) {
+		productRecRepo := new(mocks.MockIProductRecRepository)
+		productSv := new(mocks.MockIProductService)
+		sv := service.NewProductRecService(productRecRepo, productSv, logMock)
+
+		productSv.On("GetProductByID", mock.Anything, 9).Return(model.Product{}, errors.New("Not found"))
+
+		_, err := sv.GetProductMarginReport(context.Background(), model.ProductMarginFilter{ProductID: 9})
+
+		assert.EqualError(t, err, "Not found")
+		productRecRepo.AssertNotCalled(t, "GetProductMargins", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Error when calling GetSellerMargins", func(t *testing.T) {
+		productRecRepo := new(mocks.MockIProductRecRepository)
+		productSv := new(mocks.MockIProductService)
+		sv := service.NewProductRecService(productRecRepo, productSv, logMock)
+
+		productRecRepo.On("GetSellerMargins", mock.Anything, model.ProductMarginFilter{SellerID: 1}).Return(nil, errors.New("Database error"))
+
+		res, err := sv.GetSellerMarginReport(context.Background(), model.ProductMarginFilter{SellerID: 1})
+
+		assert.EqualError(t, err, "Database error")
+		assert.Nil(t, res)
+	})
+}
+
+func TestProductRecService_GetProductRecords(t *testing.T) {
+	params := model.ListParams{Page: 1, PageSize: 10, Filters: map[string]string{"product_id": "1"}}
+
+	t.Run("Success getting a page of product records", func(t *testing.T) {
+		productRecRepo := new(mocks.MockIProductRecRepository)
+		productSv := new(mocks.MockIProductService)
+		sv := service.NewProductRecService(productRecRepo, productSv, logMock)
+
+		expected := []model.ProductRecords{{ID: 1, ProductID: 1, PurchasePrice: 10, SalePrice: 20}}
+		productRecRepo.On("GetAll", mock.Anything, params).Return(expected, 1, nil)
+
+		res, total, err := sv.GetProductRecords(context.Background(), params)
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, res)
+		assert.Equal(t, 1, total)
+	})
+
+	t.Run("Error when calling GetAll", func(t *testing.T) {
+		productRecRepo := new(mocks.MockIProductRecRepository)
+		productSv := new(mocks.MockIProductService)
+		sv := service.NewProductRecService(productRecRepo, productSv, logMock)
+
+		productRecRepo.On("GetAll", mock.Anything, params).Return(nil, 0, errors.New("Database error"))
+
+		res, total, err := sv.GetProductRecords(context.Background(), params)
+
+		assert.EqualError(t, err, "Database error")
+		assert.Nil(t, res)
+		assert.Zero(t, total)
+	})
+}
+
+func TestProductRecService_DeleteProductRecord(t *testing.T) {
+	t.Run("Success deleting a product record without purchase orders", func(t *testing.T) {
+		productRecRepo := new(mocks.MockIProductRecRepository)
+		productSv := new(mocks.MockIProductService)
+		sv := service.NewProductRecService(productRecRepo, productSv, logMock)
+
+		productRecRepo.On("GetByID", mock.Anything, 1).Return(model.ProductRecords{ID: 1}, nil)
+		productRecRepo.On("CountPurchaseOrders", mock.Anything, 1).Return(0, nil)
+		productRecRepo.On("Delete", mock.Anything, 1).Return(nil)
+
+		err := sv.DeleteProductRecord(context.Background(), 1)
+
+		assert.NoError(t, err)
+		productRecRepo.AssertExpectations(t)
+	})
+
+	t.Run("Error product record referenced by purchase orders", func(t *testing.T) {
+		productRecRepo := new(mocks.MockIProductRecRepository)
+		productSv := new(mocks.MockIProductService)
+		sv := service.NewProductRecService(productRecRepo, productSv, logMock)
+
+		productRecRepo.On("GetByID", mock.Anything, 1).Return(model.ProductRecords{ID: 1}, nil)
+		productRecRepo.On("CountPurchaseOrders", mock.Anything, 1).Return(2, nil)
+
+		err := sv.DeleteProductRecord(context.Background(), 1)
+
+		assert.Equal(t, appErr.HandleError("product record", appErr.ErrorDep, ""), err)
+		productRecRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Error product record not found", func(t *testing.T) {
+		productRecRepo := new(mocks.MockIProductRecRepository)
+		productSv := new(mocks.MockIProductService)
+		sv := service.NewProductRecService(productRecRepo, productSv, logMock)
+
+		expectedErr := appErr.HandleError("product record", appErr.ErrorNotFound, "")
+		productRecRepo.On("GetByID", mock.Anything, 9).Return(model.ProductRecords{}, expectedErr)
+
+		err := sv.DeleteProductRecord(context.Background(), 9)
+
+		assert.Equal(t, expectedErr, err)
+		productRecRepo.AssertNotCalled(t, "CountPurchaseOrders", mock.Anything, mock.Anything)
+	})
 }
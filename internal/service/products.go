@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 
@@ -11,43 +12,39 @@ import (
 )
 
 type ProductService struct {
-	ProductRepository interfaces.IProductsRepo
-	SellerRepository  interfaces.ISellerRepo
-	log               logger.Logger
+	ProductRepository     interfaces.IProductsRepo
+	SellerRepository      interfaces.ISellerRepo
+	ProductTypeRepository interfaces.IProductTypeRepo
+	log                   logger.Logger
 }
 
-func NewProductService(productRepo interfaces.IProductsRepo, sellerRepo interfaces.ISellerRepo, logger logger.Logger) *ProductService {
+func NewProductService(productRepo interfaces.IProductsRepo, sellerRepo interfaces.ISellerRepo, productTypeRepo interfaces.IProductTypeRepo, logger logger.Logger) *ProductService {
 	return &ProductService{
-		ProductRepository: productRepo,
-		SellerRepository:  sellerRepo,
-		log:               logger,
+		ProductRepository:     productRepo,
+		SellerRepository:      sellerRepo,
+		ProductTypeRepository: productTypeRepo,
+		log:                   logger,
 	}
 }
 
-func (ps *ProductService) GetAllProducts() ([]model.Product, error) {
+func (ps *ProductService) GetAllProducts(ctx context.Context, params model.ListParams) ([]model.Product, int, error) {
 	ps.log.Log("ProductService", "INFO", "GetAllProducts function initializing")
 
-	products, err := ps.ProductRepository.GetAll()
-
-	var productSlice []model.Product
+	products, total, err := ps.ProductRepository.GetAll(ctx, params)
 
 	if err != nil {
 		ps.log.Log("ProductService", "ERROR", fmt.Sprintf("Error retrieving all products: %v", err))
-		return productSlice, err
-	}
-
-	for _, product := range products {
-		productSlice = append(productSlice, product)
+		return nil, 0, err
 	}
 
-	ps.log.Log("ProductService", "INFO", fmt.Sprintf("Retrieved all products count: %d", len(productSlice)))
-	return productSlice, nil
+	ps.log.Log("ProductService", "INFO", fmt.Sprintf("Retrieved all products count: %d", len(products)))
+	return products, total, nil
 }
 
-func (ps *ProductService) GetProductByID(id int) (model.Product, error) {
+func (ps *ProductService) GetProductByID(ctx context.Context, id int) (model.Product, error) {
 	ps.log.Log("ProductService", "INFO", fmt.Sprintf("GetProductByID function initializing for ID: %d", id))
 
-	product, err := ps.ProductRepository.GetByID(id)
+	product, err := ps.ProductRepository.GetByID(ctx, id)
 	if err != nil {
 		ps.log.Log("ProductService", "ERROR", fmt.Sprintf("Error retrieving product with ID: %d, error: %v", id, err))
 		return model.Product{}, err
@@ -57,7 +54,7 @@ func (ps *ProductService) GetProductByID(id int) (model.Product, error) {
 	return product, nil
 }
 
-func (ps *ProductService) CreateProduct(product model.Product) (model.Product, error) {
+func (ps *ProductService) CreateProduct(ctx context.Context, product model.Product) (model.Product, error) {
 	ps.log.Log("ProductService", "INFO", "CreateProduct function initializing")
 
 	err := product.Validate()
@@ -66,13 +63,19 @@ func (ps *ProductService) CreateProduct(product model.Product) (model.Product, e
 		return model.Product{}, err
 	}
 
-	_, err = ps.SellerRepository.GetByID(product.SellerID)
+	_, err = ps.SellerRepository.GetByID(ctx, product.SellerID)
 	if err != nil {
 		ps.log.Log("ProductService", "ERROR", fmt.Sprintf("Seller not found with ID: %d", product.SellerID))
 		return model.Product{}, err
 	}
 
-	productsList, _ := ps.ProductRepository.GetAll()
+	_, err = ps.ProductTypeRepository.GetByID(ctx, product.ProductTypeID)
+	if err != nil {
+		ps.log.Log("ProductService", "ERROR", fmt.Sprintf("Product type not found with ID: %d", product.ProductTypeID))
+		return model.Product{}, err
+	}
+
+	productsList, _, _ := ps.ProductRepository.GetAll(ctx, model.ListParams{})
 	existsByCode := existsByProductCode(product.ProductCode, productsList)
 
 	if existsByCode {
@@ -80,7 +83,7 @@ func (ps *ProductService) CreateProduct(product model.Product) (model.Product, e
 		return model.Product{}, customerror.CustomError{Object: product.ProductCode, Err: customerror.ErrConflict}
 	}
 
-	productDB, err := ps.ProductRepository.Create(product)
+	productDB, err := ps.ProductRepository.Create(ctx, product)
 	if err != nil {
 		ps.log.Log("ProductService", "ERROR", fmt.Sprintf("Error creating product: %v", err))
 		return model.Product{}, err
@@ -90,31 +93,39 @@ func (ps *ProductService) CreateProduct(product model.Product) (model.Product, e
 	return productDB, nil
 }
 
-func (ps *ProductService) UpdateProduct(id int, product model.Product) (model.Product, error) {
+func (ps *ProductService) UpdateProduct(ctx context.Context, id int, product model.Product) (model.Product, error) {
 	ps.log.Log("ProductService", "INFO", fmt.Sprintf("UpdateProduct function initializing for ID: %d", id))
 
 	if product.SellerID != 0 {
-		_, err := ps.SellerRepository.GetByID(product.SellerID)
+		_, err := ps.SellerRepository.GetByID(ctx, product.SellerID)
 		if err != nil {
 			ps.log.Log("ProductService", "ERROR", fmt.Sprintf("Seller not found with ID: %d", product.SellerID))
 			return model.Product{}, err
 		}
 	}
 
-	listOfProducts, _ := ps.ProductRepository.GetAll()
+	if product.ProductTypeID != 0 {
+		_, err := ps.ProductTypeRepository.GetByID(ctx, product.ProductTypeID)
+		if err != nil {
+			ps.log.Log("ProductService", "ERROR", fmt.Sprintf("Product type not found with ID: %d", product.ProductTypeID))
+			return model.Product{}, err
+		}
+	}
+
+	listOfProducts, _, _ := ps.ProductRepository.GetAll(ctx, model.ListParams{})
 	if existsByProductCode(product.ProductCode, listOfProducts) {
 		ps.log.Log("ProductService", "ERROR", fmt.Sprintf("Product code already exists conflicting during update: %s", product.ProductCode))
 		return model.Product{}, customerror.CustomError{Object: product.ProductCode, Err: customerror.ErrConflict}
 	}
 
-	productInDB, err := ps.ProductRepository.GetByID(id)
+	productInDB, err := ps.ProductRepository.GetByID(ctx, id)
 	if err != nil {
 		ps.log.Log("ProductService", "ERROR", fmt.Sprintf("Error retrieving product with ID: %d, error: %v", id, err))
 		return model.Product{}, err
 	}
 
 	productAdjusted := updateProduct(productInDB, product)
-	productUpdated, err := ps.ProductRepository.Update(id, productAdjusted)
+	productUpdated, err := ps.ProductRepository.Update(ctx, id, productAdjusted)
 
 	if err != nil {
 		ps.log.Log("ProductService", "ERROR", fmt.Sprintf("Error updating product: %v", err))
@@ -125,16 +136,16 @@ func (ps *ProductService) UpdateProduct(id int, product model.Product) (model.Pr
 	return productUpdated, nil
 }
 
-func (ps *ProductService) DeleteProduct(id int) error {
+func (ps *ProductService) DeleteProduct(ctx context.Context, id int) error {
 	ps.log.Log("ProductService", "INFO", fmt.Sprintf("DeleteProduct function initializing for ID: %d", id))
 
-	_, err := ps.ProductRepository.GetByID(id)
+	_, err := ps.ProductRepository.GetByID(ctx, id)
 	if err != nil {
 		ps.log.Log("ProductService", "ERROR", fmt.Sprintf("Error retrieving product for deletion with ID: %d, error: %v", id, err))
 		return customerror.HandleError("product", customerror.ErrorNotFound, "")
 	}
 
-	err = ps.ProductRepository.Delete(id)
+	err = ps.ProductRepository.Delete(ctx, id)
 	if err != nil {
 		ps.log.Log("ProductService", "ERROR", fmt.Sprintf("Error deleting product with ID: %d, error: %v", id, err))
 		return err
@@ -144,7 +155,7 @@ func (ps *ProductService) DeleteProduct(id int) error {
 	return nil
 }
 
-func existsByProductCode(productCode string, products map[int]model.Product) bool {
+func existsByProductCode(productCode string, products []model.Product) bool {
 	for _, product := range products {
 		if product.ProductCode == productCode {
 			return true
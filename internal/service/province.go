@@ -0,0 +1,134 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+	"github.com/maxwelbm/alkemy-g7.git/internal/repository/interfaces"
+	"github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
+	"github.com/maxwelbm/alkemy-g7.git/pkg/logger"
+)
+
+type ProvinceService struct {
+	Rp        interfaces.IProvinceRepo
+	RpCountry interfaces.ICountryRepo
+	log       logger.Logger
+}
+
+func NewProvinceService(rp interfaces.IProvinceRepo, rpCountry interfaces.ICountryRepo, log logger.Logger) *ProvinceService {
+	return &ProvinceService{Rp: rp, RpCountry: rpCountry, log: log}
+}
+
+func (s *ProvinceService) GetAll(ctx context.Context, params model.ListParams) ([]model.Province, int, error) {
+	s.log.Log("ProvinceService", "INFO", "initializing GetAll function")
+
+	return s.Rp.Get(ctx, params)
+}
+
+func (s *ProvinceService) GetByID(ctx context.Context, id int) (model.Province, error) {
+	s.log.Log("ProvinceService", "INFO", fmt.Sprintf("initializing GetByID function with id %d", id))
+
+	return s.Rp.GetByID(ctx, id)
+}
+
+func (s *ProvinceService) Create(ctx context.Context, province model.Province) (model.Province, error) {
+	s.log.Log("ProvinceService", "INFO", "initializing Create function")
+
+	if err := province.Validate(); err != nil {
+		s.log.Log("ProvinceService", "ERROR", fmt.Sprintf("Error: %v", err))
+		return model.Province{}, customerror.HandleError("province", customerror.ErrorInvalid, err.Error())
+	}
+
+	if _, err := s.RpCountry.GetByID(ctx, province.CountryID); err != nil {
+		s.log.Log("ProvinceService", "ERROR", fmt.Sprintf("Error: %v", err))
+		return model.Province{}, err
+	}
+
+	p, err := s.Rp.Post(ctx, province)
+	if err != nil {
+		s.log.Log("ProvinceService", "ERROR", fmt.Sprintf("Error: %v", err))
+		return model.Province{}, err
+	}
+
+	s.log.Log("ProvinceService", "INFO", fmt.Sprintf("province created: %v", p))
+
+	return p, nil
+}
+
+func (s *ProvinceService) Update(ctx context.Context, id int, province model.Province) (model.Province, error) {
+	s.log.Log("ProvinceService", "INFO", fmt.Sprintf("initializing Update function with id %d", id))
+
+	existing, err := s.Rp.GetByID(ctx, id)
+	if err != nil {
+		s.log.Log("ProvinceService", "ERROR", fmt.Sprintf("Error: %v", err))
+		return model.Province{}, err
+	}
+
+	if province.Name != "" {
+		existing.Name = province.Name
+	}
+
+	if province.CountryID != 0 && province.CountryID != existing.CountryID {
+		if _, err := s.RpCountry.GetByID(ctx, province.CountryID); err != nil {
+			s.log.Log("ProvinceService", "ERROR", fmt.Sprintf("Error: %v", err))
+			return model.Province{}, err
+		}
+
+		existing.CountryID = province.CountryID
+	}
+
+	if err := existing.Validate(); err != nil {
+		s.log.Log("ProvinceService", "ERROR", fmt.Sprintf("Error: %v", err))
+		return model.Province{}, customerror.HandleError("province", customerror.ErrorInvalid, err.Error())
+	}
+
+	p, err := s.Rp.Update(ctx, id, existing)
+	if err != nil {
+		s.log.Log("ProvinceService", "ERROR", fmt.Sprintf("Error: %v", err))
+		return model.Province{}, err
+	}
+
+	s.log.Log("ProvinceService", "INFO", fmt.Sprintf("province updated: %v", p))
+
+	return p, nil
+}
+
+// Delete removes the province, refusing while any locality still belongs to it.
+func (s *ProvinceService) Delete(ctx context.Context, id int) error {
+	s.log.Log("ProvinceService", "INFO", fmt.Sprintf("initializing Delete function with id %d", id))
+
+	if _, err := s.Rp.GetByID(ctx, id); err != nil {
+		s.log.Log("ProvinceService", "ERROR", fmt.Sprintf("Error: %v", err))
+		return err
+	}
+
+	count, err := s.Rp.CountLocalities(ctx, id)
+	if err != nil {
+		s.log.Log("ProvinceService", "ERROR", fmt.Sprintf("Error: %v", err))
+		return err
+	}
+
+	if count > 0 {
+		err = customerror.HandleError("province", customerror.ErrorDep, "")
+		s.log.Log("ProvinceService", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return err
+	}
+
+	err = s.Rp.Delete(ctx, id)
+	if err != nil {
+		s.log.Log("ProvinceService", "ERROR", fmt.Sprintf("Error: %v", err))
+		return err
+	}
+
+	s.log.Log("ProvinceService", "INFO", fmt.Sprintf("province with id %d deleted", id))
+
+	return nil
+}
+
+func (s *ProvinceService) GetReport(ctx context.Context, id int) ([]model.ProvinceReport, error) {
+	s.log.Log("ProvinceService", "INFO", fmt.Sprintf("initializing GetReport function with id %d", id))
+
+	return s.Rp.GetReport(ctx, id)
+}
@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/maxwelbm/alkemy-g7.git/internal/mocks"
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+	"github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestProvinceService_Create(t *testing.T) {
+	rp := mocks.NewMockIProvinceRepo(t)
+	rpCountry := mocks.NewMockICountryRepo(t)
+	svc := NewProvinceService(rp, rpCountry, mocks.MockLog{})
+
+	t.Run("should create the province in an existing country", func(t *testing.T) {
+		province := model.Province{Name: "Mendoza", CountryID: 1}
+		rpCountry.On("GetByID", mock.Anything, 1).Return(model.Country{ID: 1, Name: "Argentina"}, nil).Once()
+		rp.On("Post", mock.Anything, province).Return(model.Province{ID: 3, Name: "Mendoza", CountryID: 1}, nil).Once()
+
+		created, err := svc.Create(context.Background(), province)
+
+		assert.NoError(t, err)
+		assert.Equal(t, model.Province{ID: 3, Name: "Mendoza", CountryID: 1}, created)
+	})
+
+	t.Run("should return invalid error when the country is missing", func(t *testing.T) {
+		created, err := svc.Create(context.Background(), model.Province{Name: "Mendoza"})
+
+		assert.Equal(t, customerror.HandleError("province", customerror.ErrorInvalid, "validation errors: country_id is required"), err)
+		assert.Empty(t, created)
+	})
+
+	t.Run("should return not found when the country does not exist", func(t *testing.T) {
+		expectedErr := customerror.HandleError("country", customerror.ErrorNotFound, "")
+		rpCountry.On("GetByID", mock.Anything, 99).Return(model.Country{}, expectedErr).Once()
+
+		created, err := svc.Create(context.Background(), model.Province{Name: "Mendoza", CountryID: 99})
+
+		assert.Equal(t, expectedErr, err)
+		assert.Empty(t, created)
+	})
+}
+
+func TestProvinceService_Update(t *testing.T) {
+	rp := mocks.NewMockIProvinceRepo(t)
+	rpCountry := mocks.NewMockICountryRepo(t)
+	svc := NewProvinceService(rp, rpCountry, mocks.MockLog{})
+
+	t.Run("should rename the province keeping its country", func(t *testing.T) {
+		rp.On("GetByID", mock.Anything, 1).Return(model.Province{ID: 1, Name: "Cordova", CountryID: 1}, nil).Once()
+		rp.On("Update", mock.Anything, 1, model.Province{ID: 1, Name: "Cordoba", CountryID: 1}).Return(model.Province{ID: 1, Name: "Cordoba", CountryID: 1}, nil).Once()
+
+		province, err := svc.Update(context.Background(), 1, model.Province{Name: "Cordoba"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, model.Province{ID: 1, Name: "Cordoba", CountryID: 1}, province)
+	})
+
+	t.Run("should move the province to another country", func(t *testing.T) {
+		rp.On("GetByID", mock.Anything, 1).Return(model.Province{ID: 1, Name: "Cordoba", CountryID: 1}, nil).Once()
+		rpCountry.On("GetByID", mock.Anything, 2).Return(model.Country{ID: 2, Name: "Spain"}, nil).Once()
+		rp.On("Update", mock.Anything, 1, model.Province{ID: 1, Name: "Cordoba", CountryID: 2}).Return(model.Province{ID: 1, Name: "Cordoba", CountryID: 2}, nil).Once()
+
+		province, err := svc.Update(context.Background(), 1, model.Province{CountryID: 2})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, province.CountryID)
+	})
+
+	t.Run("should return not found when the new country does not exist", func(t *testing.T) {
+		expectedErr := customerror.HandleError("country", customerror.ErrorNotFound, "")
+		rp.On("GetByID", mock.Anything, 1).Return(model.Province{ID: 1, Name: "Cordoba", CountryID: 1}, nil).Once()
+		rpCountry.On("GetByID", mock.Anything, 99).Return(model.Country{}, expectedErr).Once()
+
+		province, err := svc.Update(context.Background(), 1, model.Province{CountryID: 99})
+
+		assert.Equal(t, expectedErr, err)
+		assert.Empty(t, province)
+	})
+}
+
+func TestProvinceService_Delete(t *testing.T) {
+	rp := mocks.NewMockIProvinceRepo(t)
+	svc := NewProvinceService(rp, mocks.NewMockICountryRepo(t), mocks.MockLog{})
+
+	t.Run("should delete a province without localities", func(t *testing.T) {
+		rp.On("GetByID", mock.Anything, 1).Return(model.Province{ID: 1, Name: "Mendoza", CountryID: 1}, nil).Once()
+		rp.On("CountLocalities", mock.Anything, 1).Return(0, nil).Once()
+		rp.On("Delete", mock.Anything, 1).Return(nil).Once()
+
+		err := svc.Delete(context.Background(), 1)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should refuse to delete a province with localities", func(t *testing.T) {
+		rp.On("GetByID", mock.Anything, 2).Return(model.Province{ID: 2, Name: "Cordoba", CountryID: 1}, nil).Once()
+		rp.On("CountLocalities", mock.Anything, 2).Return(1, nil).Once()
+
+		err := svc.Delete(context.Background(), 2)
+
+		assert.Equal(t, customerror.HandleError("province", customerror.ErrorDep, ""), err)
+	})
+
+	t.Run("should return the delete error", func(t *testing.T) {
+		rp.On("GetByID", mock.Anything, 3).Return(model.Province{ID: 3, Name: "Salta", CountryID: 1}, nil).Once()
+		rp.On("CountLocalities", mock.Anything, 3).Return(0, nil).Once()
+		rp.On("Delete", mock.Anything, 3).Return(errors.New("db error")).Once()
+
+		err := svc.Delete(context.Background(), 3)
+
+		assert.EqualError(t, err, "db error")
+	})
+}
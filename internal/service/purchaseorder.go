@@ -1,25 +1,33 @@
 package service
 
 import (
+	"context"
+	"database/sql"
 	"fmt"
+	"net/http"
+
 	"github.com/maxwelbm/alkemy-g7.git/internal/model"
 	"github.com/maxwelbm/alkemy-g7.git/internal/repository/interfaces"
 	svc "github.com/maxwelbm/alkemy-g7.git/internal/service/interfaces"
+	"github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
 	"github.com/maxwelbm/alkemy-g7.git/pkg/logger"
 )
 
 type PurchaseOrderService struct {
 	Rp            interfaces.IPurchaseOrdersRepo
+	RpShipment    interfaces.IShipmentRepo
+	RpSection     interfaces.ISectionRepo
+	Uow           interfaces.IUnitOfWork
 	SvcBuyer      svc.IBuyerservice
 	SvcProductRec svc.IProductRecService
 	log           logger.Logger
 }
 
-func (p *PurchaseOrderService) CreatePurchaseOrder(newPurchaseOrder model.PurchaseOrder) (purchaseOrder model.PurchaseOrder, err error) {
+func (p *PurchaseOrderService) CreatePurchaseOrder(ctx context.Context, newPurchaseOrder model.PurchaseOrder) (purchaseOrder model.PurchaseOrder, err error) {
 	p.log.Log("PurchaseOrderService", "INFO", fmt.Sprintf("initializing CreatePurchaseOrder function with parameter: %v", newPurchaseOrder))
 
 	p.log.Log("PurchaseOrderService", "INFO", fmt.Sprintf("Searching Buyer with parameter ID: %d", newPurchaseOrder.BuyerID))
-	_, err = p.SvcBuyer.GetBuyerByID(newPurchaseOrder.BuyerID)
+	_, err = p.SvcBuyer.GetBuyerByID(ctx, newPurchaseOrder.BuyerID)
 
 	if err != nil {
 		p.log.Log("PurchaseOrderService", "ERROR", fmt.Sprintf("Error: %v", err))
@@ -27,35 +35,169 @@ func (p *PurchaseOrderService) CreatePurchaseOrder(newPurchaseOrder model.Purcha
 	}
 
 	p.log.Log("PurchaseOrderService", "INFO", "Buyer found")
-	p.log.Log("PurchaseOrderService", "INFO", fmt.Sprintf("Searching ProductRecord with parameter ID: %d", newPurchaseOrder.ProductRecordID))
-	_, err = p.SvcProductRec.GetProductRecordByID(newPurchaseOrder.ProductRecordID)
+
+	err = p.priceLines(ctx, &newPurchaseOrder)
 
 	if err != nil {
 		p.log.Log("PurchaseOrderService", "ERROR", fmt.Sprintf("Error: %v", err))
 		return
 	}
 
-	p.log.Log("PurchaseOrderService", "INFO", "Product Record found")
+	newPurchaseOrder.ComputeTotals()
+
+	err = p.Uow.Do(ctx, func(tx *sql.Tx) error {
+		rp := p.Rp.WithTx(tx)
+
+		id, err := rp.Post(ctx, newPurchaseOrder)
 
-	id, err := p.Rp.Post(newPurchaseOrder)
+		if err != nil {
+			return err
+		}
+
+		p.log.Log("PurchaseOrderService", "INFO", fmt.Sprintf("Purchase Order created with ID: %d", id))
+		purchaseOrder, err = rp.GetByID(ctx, int(id))
+
+		return err
+	})
 
 	if err != nil {
 		p.log.Log("PurchaseOrderService", "ERROR", fmt.Sprintf("Error: %v", err))
 
-		return
+		return model.PurchaseOrder{}, err
 	}
 
-	p.log.Log("PurchaseOrderService", "INFO", fmt.Sprintf("Purchase Order created with ID: %d", id))
-	purchaseOrder, err = p.Rp.GetByID(int(id))
-	p.log.Log("PurchaseOrderService", "INFO", fmt.Sprintf("Return Purchase Order created with ID: %d PUrchase: %v", id, purchaseOrder))
+	p.log.Log("PurchaseOrderService", "INFO", fmt.Sprintf("Return Purchase Order created with ID: %d PUrchase: %v", purchaseOrder.ID, purchaseOrder))
 
 	return
 }
 
-func (p *PurchaseOrderService) GetPurchaseOrderByID(id int) (purchaseOrder model.PurchaseOrder, err error) {
+// priceLines snapshots the unit price of every line from the sale price of the product's latest record.
+// An order placed for a single product record becomes a single line priced from that record.
+func (p *PurchaseOrderService) priceLines(ctx context.Context, purchaseOrder *model.PurchaseOrder) error {
+	if len(purchaseOrder.Lines) == 0 {
+		p.log.Log("PurchaseOrderService", "INFO", fmt.Sprintf("Searching ProductRecord with parameter ID: %d", purchaseOrder.ProductRecordID))
+		productRecord, err := p.SvcProductRec.GetProductRecordByID(ctx, purchaseOrder.ProductRecordID)
+
+		if err != nil {
+			return err
+		}
+
+		p.log.Log("PurchaseOrderService", "INFO", "Product Record found")
+		purchaseOrder.Lines = []model.PurchaseOrderLine{{
+			ProductID:       productRecord.ProductID,
+			ProductRecordID: productRecord.ID,
+			Quantity:        purchaseOrder.Quantity,
+			UnitPrice:       productRecord.SalePrice,
+		}}
+
+		return nil
+	}
+
+	for i := range purchaseOrder.Lines {
+		p.log.Log("PurchaseOrderService", "INFO", fmt.Sprintf("Searching latest ProductRecord of Product ID: %d", purchaseOrder.Lines[i].ProductID))
+		productRecord, err := p.SvcProductRec.GetLatestProductRecord(ctx, purchaseOrder.Lines[i].ProductID)
+
+		if err != nil {
+			return err
+		}
+
+		purchaseOrder.Lines[i].ProductRecordID = productRecord.ID
+		purchaseOrder.Lines[i].UnitPrice = productRecord.SalePrice
+	}
+
+	return nil
+}
+
+func (p *PurchaseOrderService) GetPurchaseOrderByID(ctx context.Context, id int) (purchaseOrder model.PurchaseOrder, err error) {
 	p.log.Log("PurchaseOrderService", "INFO", fmt.Sprintf("initializing GetPurchaseOrderByID function with parameter: %v", id))
-	return p.Rp.GetByID(id)
+	return p.Rp.GetByID(ctx, id)
+}
+
+func (p *PurchaseOrderService) GetPurchaseOrders(ctx context.Context, params model.ListParams) (purchaseOrders []model.PurchaseOrder, total int, err error) {
+	p.log.Log("PurchaseOrderService", "INFO", fmt.Sprintf("initializing GetPurchaseOrders function with parameter: %v", params))
+
+	purchaseOrders, total, err = p.Rp.Get(ctx, params)
+	if err != nil {
+		p.log.Log("PurchaseOrderService", "ERROR", fmt.Sprintf("Error: %v", err))
+	}
+
+	return
+}
+
+// UpdatePurchaseOrderStatus moves the purchase order along its lifecycle.
+// Cancelling an order gives its allocated quantity back to the product batches and their sections,
+// which is only allowed while none of its shipments is active and the sections can hold it again.
+func (p *PurchaseOrderService) UpdatePurchaseOrderStatus(ctx context.Context, id int, update model.PurchaseOrderStatusUpdate) (purchaseOrder model.PurchaseOrder, err error) {
+	p.log.Log("PurchaseOrderService", "INFO", fmt.Sprintf("initializing UpdatePurchaseOrderStatus function with parameters: %d, %v", id, update))
+
+	if err = update.Validate(); err != nil {
+		p.log.Log("PurchaseOrderService", "ERROR", fmt.Sprintf("Error: %v", err))
+		return purchaseOrder, customerror.NewPurcahseOrderError(http.StatusUnprocessableEntity, err.Error(), "Purchase Order")
+	}
+
+	err = p.Uow.Do(ctx, func(tx *sql.Tx) error {
+		rp := p.Rp.WithTx(tx)
+
+		// the order stays locked until the change is committed, so no shipment can be created for it
+		// and no other status change can start from the status read here
+		current, err := rp.GetByIDForUpdate(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if !model.CanPurchaseOrderTransition(current.Status, update.Status) {
+			return customerror.NewPurcahseOrderError(http.StatusConflict, fmt.Sprintf("cannot change status from %s to %s", current.Status, update.Status), "Purchase Order")
+		}
+
+		if update.Status == model.PurchaseOrderStatusCancelled {
+			active, err := p.RpShipment.WithTx(tx).CountActiveByPurchaseOrders(ctx, []int{id})
+			if err != nil {
+				return err
+			}
+
+			if active > 0 {
+				return customerror.NewPurcahseOrderError(http.StatusConflict, "cannot be cancelled while it has an active shipment", "Purchase Order")
+			}
+		}
+
+		if err = rp.UpdateStatus(ctx, id, current.Status, update.Status); err != nil {
+			return err
+		}
+
+		if update.Status == model.PurchaseOrderStatusCancelled {
+			allocations, err := rp.RestoreBatches(ctx, id)
+			if err != nil {
+				return err
+			}
+
+			// a section refilled since the order was placed may not fit the stock back,
+			// in which case the cancellation is refused
+			for _, allocation := range allocations {
+				if err = p.RpSection.WithTx(tx).IncreaseCurrentCapacity(ctx, allocation.SectionID, allocation.Quantity); err != nil {
+					return err
+				}
+			}
+		}
+
+		purchaseOrder, err = rp.GetByID(ctx, id)
+
+		return err
+	})
+
+	if err != nil {
+		p.log.Log("PurchaseOrderService", "ERROR", fmt.Sprintf("Error: %v", err))
+		return model.PurchaseOrder{}, err
+	}
+
+	p.log.Log("PurchaseOrderService", "INFO", fmt.Sprintf("Purchase Order %d is now %s", id, purchaseOrder.Status))
+
+	return
+}
+
+func (p *PurchaseOrderService) CancelPurchaseOrder(ctx context.Context, id int) (purchaseOrder model.PurchaseOrder, err error) {
+	p.log.Log("PurchaseOrderService", "INFO", fmt.Sprintf("initializing CancelPurchaseOrder function with parameter: %d", id))
+	return p.UpdatePurchaseOrderStatus(ctx, id, model.PurchaseOrderStatusUpdate{Status: model.PurchaseOrderStatusCancelled})
 }
-func NewPurchaseOrderService(rp interfaces.IPurchaseOrdersRepo, svcBuyer svc.IBuyerservice, svcProductRec svc.IProductRecService, log logger.Logger) *PurchaseOrderService {
-	return &PurchaseOrderService{Rp: rp, SvcBuyer: svcBuyer, SvcProductRec: svcProductRec, log: log}
+func NewPurchaseOrderService(rp interfaces.IPurchaseOrdersRepo, rpShipment interfaces.IShipmentRepo, rpSection interfaces.ISectionRepo, uow interfaces.IUnitOfWork, svcBuyer svc.IBuyerservice, svcProductRec svc.IProductRecService, log logger.Logger) *PurchaseOrderService {
+	return &PurchaseOrderService{Rp: rp, RpShipment: rpShipment, RpSection: rpSection, Uow: uow, SvcBuyer: svcBuyer, SvcProductRec: svcProductRec, log: log}
 }
@@ -1,6 +1,8 @@
 package service_test
 
 import (
+	"context"
+	"database/sql"
 	"github.com/maxwelbm/alkemy-g7.git/internal/mocks"
 	"net/http"
 	"testing"
@@ -10,11 +12,21 @@ import (
 	"github.com/maxwelbm/alkemy-g7.git/internal/service"
 	"github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func setupPurchaseOrderService(t *testing.T) *service.PurchaseOrderService {
 	mockRepo := mocks.NewMockIPurchaseOrdersRepo(t)
-	purchaseService := service.NewPurchaseOrderService(mockRepo, mocks.NewMockIBuyerservice(t), mocks.NewMockIProductRecService(t), logMock)
+	mockRepoShipment := mocks.NewMockIShipmentRepo(t)
+	mockRepoSection := mocks.NewMockISectionRepo(t)
+	mockUow := mocks.NewMockIUnitOfWork(t)
+
+	mockRepo.On("WithTx", mock.Anything).Return(mockRepo).Maybe()
+	mockRepoShipment.On("WithTx", mock.Anything).Return(mockRepoShipment).Maybe()
+	mockRepoSection.On("WithTx", mock.Anything).Return(mockRepoSection).Maybe()
+	mockUow.On("Do", mock.Anything, mock.Anything).Return(func(ctx context.Context, fn func(*sql.Tx) error) error { return fn(nil) }).Maybe()
+
+	purchaseService := service.NewPurchaseOrderService(mockRepo, mockRepoShipment, mockRepoSection, mockUow, mocks.NewMockIBuyerservice(t), mocks.NewMockIProductRecService(t), logMock)
 	return purchaseService
 }
 
@@ -33,10 +45,11 @@ func TestCreatePurchaseOrder(t *testing.T) {
 			TrackingCode:    "TC001",
 			BuyerID:         1,
 			ProductRecordID: 1,
+			Quantity:        1,
 		}
 
 		mockBuyerService := Svc.SvcBuyer.(*mocks.MockIBuyerservice)
-		mockBuyerService.On("GetBuyerByID", createdOrder.BuyerID).Return(model.Buyer{
+		mockBuyerService.On("GetBuyerByID", mock.Anything, createdOrder.BuyerID).Return(model.Buyer{
 			ID:           1,
 			CardNumberID: "CN001",
 			FirstName:    "Jhon",
@@ -44,7 +57,7 @@ func TestCreatePurchaseOrder(t *testing.T) {
 		}, nil)
 
 		mockProductRec := Svc.SvcProductRec.(*mocks.MockIProductRecService)
-		mockProductRec.On("GetProductRecordByID", createdOrder.ProductRecordID).Return(model.ProductRecords{
+		mockProductRec.On("GetProductRecordByID", mock.Anything, createdOrder.ProductRecordID).Return(model.ProductRecords{
 			ID:             1,
 			LastUpdateDate: parsedTime,
 			PurchasePrice:  1,
@@ -52,11 +65,15 @@ func TestCreatePurchaseOrder(t *testing.T) {
 			ProductID:      1,
 		}, nil)
 
+		pricedOrder := createdOrder
+		pricedOrder.Total = 1
+		pricedOrder.Lines = []model.PurchaseOrderLine{{LineNumber: 1, ProductID: 1, ProductRecordID: 1, Quantity: 1, UnitPrice: 1, Subtotal: 1}}
+
 		mockRepo := Svc.Rp.(*mocks.MockIPurchaseOrdersRepo)
-		mockRepo.On("Post", createdOrder).Return(int64(1), nil)
-		mockRepo.On("GetByID", createdOrder.ID).Return(createdOrder, nil)
+		mockRepo.On("Post", mock.Anything, pricedOrder).Return(int64(1), nil)
+		mockRepo.On("GetByID", mock.Anything, createdOrder.ID).Return(createdOrder, nil)
 
-		purchaser, err := Svc.CreatePurchaseOrder(createdOrder)
+		purchaser, err := Svc.CreatePurchaseOrder(context.Background(), createdOrder)
 		assert.NoError(t, err)
 		assert.Equal(t, createdOrder, purchaser)
 		mockRepo.AssertExpectations(t)
@@ -79,13 +96,14 @@ func TestCreatePurchaseOrder(t *testing.T) {
 			TrackingCode:    "TC001",
 			BuyerID:         99,
 			ProductRecordID: 1,
+			Quantity:        1,
 		}
 		expectedError := customerror.NewBuyerError(http.StatusNotFound, customerror.ErrNotFound.Error(), "Buyer")
 
 		mockBuyerService := Svc.SvcBuyer.(*mocks.MockIBuyerservice)
-		mockBuyerService.On("GetBuyerByID", createdOrder.BuyerID).Return(model.Buyer{}, expectedError)
+		mockBuyerService.On("GetBuyerByID", mock.Anything, createdOrder.BuyerID).Return(model.Buyer{}, expectedError)
 
-		purchaser, err := Svc.CreatePurchaseOrder(createdOrder)
+		purchaser, err := Svc.CreatePurchaseOrder(context.Background(), createdOrder)
 
 		assert.ErrorIs(t, err, expectedError)
 		assert.Equal(t, purchaser, model.PurchaseOrder{})
@@ -107,10 +125,11 @@ func TestCreatePurchaseOrder(t *testing.T) {
 			TrackingCode:    "TC001",
 			BuyerID:         1,
 			ProductRecordID: 99,
+			Quantity:        1,
 		}
 
 		mockBuyerService := Svc.SvcBuyer.(*mocks.MockIBuyerservice)
-		mockBuyerService.On("GetBuyerByID", createdOrder.BuyerID).Return(model.Buyer{
+		mockBuyerService.On("GetBuyerByID", mock.Anything, createdOrder.BuyerID).Return(model.Buyer{
 			ID:           1,
 			CardNumberID: "CN001",
 			FirstName:    "Jhon",
@@ -120,9 +139,9 @@ func TestCreatePurchaseOrder(t *testing.T) {
 		expectedError := customerror.HandleError("product record", customerror.ErrorNotFound, "")
 
 		mockProductRec := Svc.SvcProductRec.(*mocks.MockIProductRecService)
-		mockProductRec.On("GetProductRecordByID", createdOrder.ProductRecordID).Return(model.ProductRecords{}, expectedError)
+		mockProductRec.On("GetProductRecordByID", mock.Anything, createdOrder.ProductRecordID).Return(model.ProductRecords{}, expectedError)
 
-		purchaser, err := Svc.CreatePurchaseOrder(createdOrder)
+		purchaser, err := Svc.CreatePurchaseOrder(context.Background(), createdOrder)
 
 		assert.ErrorIs(t, err, expectedError)
 		assert.Equal(t, purchaser, model.PurchaseOrder{})
@@ -144,10 +163,11 @@ func TestCreatePurchaseOrder(t *testing.T) {
 			TrackingCode:    "TC001",
 			BuyerID:         1,
 			ProductRecordID: 1,
+			Quantity:        1,
 		}
 
 		mockBuyerService := Svc.SvcBuyer.(*mocks.MockIBuyerservice)
-		mockBuyerService.On("GetBuyerByID", createdOrder.BuyerID).Return(model.Buyer{
+		mockBuyerService.On("GetBuyerByID", mock.Anything, createdOrder.BuyerID).Return(model.Buyer{
 			ID:           1,
 			CardNumberID: "CN001",
 			FirstName:    "Jhon",
@@ -155,7 +175,7 @@ func TestCreatePurchaseOrder(t *testing.T) {
 		}, nil)
 
 		mockProductRec := Svc.SvcProductRec.(*mocks.MockIProductRecService)
-		mockProductRec.On("GetProductRecordByID", createdOrder.ProductRecordID).Return(model.ProductRecords{
+		mockProductRec.On("GetProductRecordByID", mock.Anything, createdOrder.ProductRecordID).Return(model.ProductRecords{
 			ID:             1,
 			LastUpdateDate: parsedTime,
 			PurchasePrice:  1,
@@ -165,10 +185,14 @@ func TestCreatePurchaseOrder(t *testing.T) {
 
 		expectedError := customerror.NewPurcahseOrderError(http.StatusConflict, customerror.ErrConflict.Error(), "order_number")
 
+		pricedOrder := createdOrder
+		pricedOrder.Total = 1
+		pricedOrder.Lines = []model.PurchaseOrderLine{{LineNumber: 1, ProductID: 1, ProductRecordID: 1, Quantity: 1, UnitPrice: 1, Subtotal: 1}}
+
 		mockRepo := Svc.Rp.(*mocks.MockIPurchaseOrdersRepo)
-		mockRepo.On("Post", createdOrder).Return(int64(0), expectedError)
+		mockRepo.On("Post", mock.Anything, pricedOrder).Return(int64(0), expectedError)
 
-		purchaser, err := Svc.CreatePurchaseOrder(createdOrder)
+		purchaser, err := Svc.CreatePurchaseOrder(context.Background(), createdOrder)
 		assert.ErrorIs(t, err, expectedError)
 		assert.Equal(t, model.PurchaseOrder{}, purchaser)
 		mockRepo.AssertExpectations(t)
@@ -177,6 +201,74 @@ func TestCreatePurchaseOrder(t *testing.T) {
 	})
 }
 
+func TestCreateMultiLinePurchaseOrder(t *testing.T) {
+	newOrder := model.PurchaseOrder{
+		OrderNumber:  "ON002",
+		TrackingCode: "TC002",
+		BuyerID:      1,
+		Lines: []model.PurchaseOrderLine{
+			{ProductID: 1, Quantity: 3},
+			{ProductID: 2, Quantity: 2},
+		},
+	}
+
+	t.Run("snapshot the latest sale price of each product and compute totals", func(t *testing.T) {
+		Svc := setupPurchaseOrderService(t)
+
+		Svc.SvcBuyer.(*mocks.MockIBuyerservice).On("GetBuyerByID", mock.Anything, 1).Return(model.Buyer{ID: 1}, nil)
+		mockProductRec := Svc.SvcProductRec.(*mocks.MockIProductRecService)
+		mockProductRec.On("GetLatestProductRecord", mock.Anything, 1).Return(model.ProductRecords{ID: 5, ProductID: 1, SalePrice: 2.35}, nil)
+		mockProductRec.On("GetLatestProductRecord", mock.Anything, 2).Return(model.ProductRecords{ID: 9, ProductID: 2, SalePrice: 10}, nil)
+
+		expectedOrder := model.PurchaseOrder{
+			OrderNumber:  "ON002",
+			TrackingCode: "TC002",
+			BuyerID:      1,
+			Quantity:     5,
+			Total:        27.05,
+			Lines: []model.PurchaseOrderLine{
+				{LineNumber: 1, ProductID: 1, ProductRecordID: 5, Quantity: 3, UnitPrice: 2.35, Subtotal: 7.05},
+				{LineNumber: 2, ProductID: 2, ProductRecordID: 9, Quantity: 2, UnitPrice: 10, Subtotal: 20},
+			},
+		}
+		createdOrder := expectedOrder
+		createdOrder.ID = 2
+		createdOrder.Status = model.PurchaseOrderStatusPending
+
+		mockRepo := Svc.Rp.(*mocks.MockIPurchaseOrdersRepo)
+		mockRepo.On("Post", mock.Anything, expectedOrder).Return(int64(2), nil)
+		mockRepo.On("GetByID", mock.Anything, 2).Return(createdOrder, nil)
+
+		order := newOrder
+		order.Lines = append([]model.PurchaseOrderLine{}, newOrder.Lines...)
+
+		purchaseOrder, err := Svc.CreatePurchaseOrder(context.Background(), order)
+
+		assert.NoError(t, err)
+		assert.Equal(t, createdOrder, purchaseOrder)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("return not found when a product has no records", func(t *testing.T) {
+		Svc := setupPurchaseOrderService(t)
+		expectedError := customerror.HandleError("product record", customerror.ErrorNotFound, "")
+
+		Svc.SvcBuyer.(*mocks.MockIBuyerservice).On("GetBuyerByID", mock.Anything, 1).Return(model.Buyer{ID: 1}, nil)
+		mockProductRec := Svc.SvcProductRec.(*mocks.MockIProductRecService)
+		mockProductRec.On("GetLatestProductRecord", mock.Anything, 1).Return(model.ProductRecords{ID: 5, ProductID: 1, SalePrice: 2.35}, nil)
+		mockProductRec.On("GetLatestProductRecord", mock.Anything, 2).Return(model.ProductRecords{}, expectedError)
+
+		order := newOrder
+		order.Lines = append([]model.PurchaseOrderLine{}, newOrder.Lines...)
+
+		purchaseOrder, err := Svc.CreatePurchaseOrder(context.Background(), order)
+
+		assert.ErrorIs(t, err, expectedError)
+		assert.Equal(t, model.PurchaseOrder{}, purchaseOrder)
+		Svc.Uow.(*mocks.MockIUnitOfWork).AssertNotCalled(t, "Do", mock.Anything, mock.Anything)
+	})
+}
+
 func TestGetPurchaseOrderByID(t *testing.T) {
 	t.Run("Get existing purchase order successfully", func(t *testing.T) {
 		Svc := setupPurchaseOrderService(t)
@@ -193,12 +285,13 @@ func TestGetPurchaseOrderByID(t *testing.T) {
 			TrackingCode:    "TC001",
 			BuyerID:         99,
 			ProductRecordID: 1,
+			Quantity:        1,
 		}
 
 		mockRepo := Svc.Rp.(*mocks.MockIPurchaseOrdersRepo)
-		mockRepo.On("GetByID", PurchaseSearch.ID).Return(PurchaseSearch, nil)
+		mockRepo.On("GetByID", mock.Anything, PurchaseSearch.ID).Return(PurchaseSearch, nil)
 
-		purchaser, err := Svc.GetPurchaseOrderByID(PurchaseSearch.ID)
+		purchaser, err := Svc.GetPurchaseOrderByID(context.Background(), PurchaseSearch.ID)
 
 		assert.NoError(t, err)
 		assert.Equal(t, PurchaseSearch, purchaser)
@@ -211,12 +304,145 @@ func TestGetPurchaseOrderByID(t *testing.T) {
 		exepctedError := customerror.NewPurcahseOrderError(http.StatusNotFound, customerror.ErrNotFound.Error(), "Purchase Order")
 
 		mockRepo := Svc.Rp.(*mocks.MockIPurchaseOrdersRepo)
-		mockRepo.On("GetByID", 99).Return(model.PurchaseOrder{}, exepctedError)
+		mockRepo.On("GetByID", mock.Anything, 99).Return(model.PurchaseOrder{}, exepctedError)
 
-		purchaser, err := Svc.GetPurchaseOrderByID(99)
+		purchaser, err := Svc.GetPurchaseOrderByID(context.Background(), 99)
 
 		assert.ErrorIs(t, err, exepctedError)
 		assert.Equal(t, model.PurchaseOrder{}, purchaser)
 		mockRepo.AssertExpectations(t)
 	})
 }
+
+func TestGetPurchaseOrders(t *testing.T) {
+	t.Run("list purchase orders with filters", func(t *testing.T) {
+		Svc := setupPurchaseOrderService(t)
+		params := model.ListParams{Page: 1, PageSize: 20, Sort: "id", Filters: map[string]string{"buyer_id": "1", "status": model.PurchaseOrderStatusPending}}
+		expected := []model.PurchaseOrder{{ID: 1, BuyerID: 1, Status: model.PurchaseOrderStatusPending}}
+
+		mockRepo := Svc.Rp.(*mocks.MockIPurchaseOrdersRepo)
+		mockRepo.On("Get", mock.Anything, params).Return(expected, 1, nil)
+
+		purchaseOrders, total, err := Svc.GetPurchaseOrders(context.Background(), params)
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, purchaseOrders)
+		assert.Equal(t, 1, total)
+	})
+
+	t.Run("return error from repository", func(t *testing.T) {
+		Svc := setupPurchaseOrderService(t)
+
+		mockRepo := Svc.Rp.(*mocks.MockIPurchaseOrdersRepo)
+		mockRepo.On("Get", mock.Anything, model.ListParams{}).Return(nil, 0, sql.ErrConnDone)
+
+		purchaseOrders, total, err := Svc.GetPurchaseOrders(context.Background(), model.ListParams{})
+
+		assert.ErrorIs(t, err, sql.ErrConnDone)
+		assert.Nil(t, purchaseOrders)
+		assert.Zero(t, total)
+	})
+}
+
+func TestUpdatePurchaseOrderStatus(t *testing.T) {
+	t.Run("confirm a pending purchase order", func(t *testing.T) {
+		Svc := setupPurchaseOrderService(t)
+
+		mockRepo := Svc.Rp.(*mocks.MockIPurchaseOrdersRepo)
+		mockRepo.On("GetByIDForUpdate", mock.Anything, 1).Return(model.PurchaseOrder{ID: 1, Status: model.PurchaseOrderStatusPending}, nil).Once()
+		mockRepo.On("UpdateStatus", mock.Anything, 1, model.PurchaseOrderStatusPending, model.PurchaseOrderStatusConfirmed).Return(nil)
+		mockRepo.On("GetByID", mock.Anything, 1).Return(model.PurchaseOrder{ID: 1, Status: model.PurchaseOrderStatusConfirmed}, nil).Once()
+
+		purchaseOrder, err := Svc.UpdatePurchaseOrderStatus(context.Background(), 1, model.PurchaseOrderStatusUpdate{Status: model.PurchaseOrderStatusConfirmed})
+
+		assert.NoError(t, err)
+		assert.Equal(t, model.PurchaseOrderStatusConfirmed, purchaseOrder.Status)
+		mockRepo.AssertNotCalled(t, "RestoreBatches", mock.Anything, mock.Anything)
+	})
+
+	t.Run("cancel a confirmed purchase order restoring its batches", func(t *testing.T) {
+		Svc := setupPurchaseOrderService(t)
+
+		mockRepo := Svc.Rp.(*mocks.MockIPurchaseOrdersRepo)
+		mockRepoShipment := Svc.RpShipment.(*mocks.MockIShipmentRepo)
+		mockRepo.On("GetByIDForUpdate", mock.Anything, 1).Return(model.PurchaseOrder{ID: 1, Status: model.PurchaseOrderStatusConfirmed}, nil).Once()
+		mockRepoShipment.On("CountActiveByPurchaseOrders", mock.Anything, []int{1}).Return(0, nil)
+		mockRepo.On("UpdateStatus", mock.Anything, 1, model.PurchaseOrderStatusConfirmed, model.PurchaseOrderStatusCancelled).Return(nil)
+		mockRepo.On("RestoreBatches", mock.Anything, 1).Return([]model.PurchaseOrderBatch{{ProductBatchID: 3, Quantity: 10, SectionID: 2}, {ProductBatchID: 1, Quantity: 5, SectionID: 4}}, nil)
+		mockRepoSection := Svc.RpSection.(*mocks.MockISectionRepo)
+		mockRepoSection.On("IncreaseCurrentCapacity", mock.Anything, 2, 10).Return(nil)
+		mockRepoSection.On("IncreaseCurrentCapacity", mock.Anything, 4, 5).Return(nil)
+		mockRepo.On("GetByID", mock.Anything, 1).Return(model.PurchaseOrder{ID: 1, Status: model.PurchaseOrderStatusCancelled}, nil).Once()
+
+		purchaseOrder, err := Svc.CancelPurchaseOrder(context.Background(), 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, model.PurchaseOrderStatusCancelled, purchaseOrder.Status)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("return conflict when a section cannot hold the restored quantity", func(t *testing.T) {
+		Svc := setupPurchaseOrderService(t)
+		expectedErr := customerror.NewError(http.StatusConflict, customerror.ErrCapacityExceeded.Error(), "section", "")
+
+		mockRepo := Svc.Rp.(*mocks.MockIPurchaseOrdersRepo)
+		mockRepo.On("GetByIDForUpdate", mock.Anything, 1).Return(model.PurchaseOrder{ID: 1, Status: model.PurchaseOrderStatusConfirmed}, nil)
+		Svc.RpShipment.(*mocks.MockIShipmentRepo).On("CountActiveByPurchaseOrders", mock.Anything, []int{1}).Return(0, nil)
+		mockRepo.On("UpdateStatus", mock.Anything, 1, model.PurchaseOrderStatusConfirmed, model.PurchaseOrderStatusCancelled).Return(nil)
+		mockRepo.On("RestoreBatches", mock.Anything, 1).Return([]model.PurchaseOrderBatch{{ProductBatchID: 3, Quantity: 10, SectionID: 2}}, nil)
+		Svc.RpSection.(*mocks.MockISectionRepo).On("IncreaseCurrentCapacity", mock.Anything, 2, 10).Return(expectedErr)
+
+		purchaseOrder, err := Svc.CancelPurchaseOrder(context.Background(), 1)
+
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, model.PurchaseOrder{}, purchaseOrder)
+		mockRepo.AssertNotCalled(t, "GetByID", mock.Anything, mock.Anything)
+	})
+
+	t.Run("return conflict when cancelling an order with an active shipment", func(t *testing.T) {
+		Svc := setupPurchaseOrderService(t)
+
+		mockRepo := Svc.Rp.(*mocks.MockIPurchaseOrdersRepo)
+		mockRepo.On("GetByIDForUpdate", mock.Anything, 1).Return(model.PurchaseOrder{ID: 1, Status: model.PurchaseOrderStatusConfirmed}, nil)
+		Svc.RpShipment.(*mocks.MockIShipmentRepo).On("CountActiveByPurchaseOrders", mock.Anything, []int{1}).Return(1, nil)
+
+		purchaseOrder, err := Svc.CancelPurchaseOrder(context.Background(), 1)
+
+		assert.Equal(t, customerror.NewPurcahseOrderError(http.StatusConflict, "cannot be cancelled while it has an active shipment", "Purchase Order"), err)
+		assert.Equal(t, model.PurchaseOrder{}, purchaseOrder)
+		mockRepo.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		mockRepo.AssertNotCalled(t, "RestoreBatches", mock.Anything, mock.Anything)
+	})
+
+	t.Run("return conflict for a transition not allowed", func(t *testing.T) {
+		Svc := setupPurchaseOrderService(t)
+
+		mockRepo := Svc.Rp.(*mocks.MockIPurchaseOrdersRepo)
+		mockRepo.On("GetByIDForUpdate", mock.Anything, 1).Return(model.PurchaseOrder{ID: 1, Status: model.PurchaseOrderStatusCancelled}, nil)
+
+		_, err := Svc.UpdatePurchaseOrderStatus(context.Background(), 1, model.PurchaseOrderStatusUpdate{Status: model.PurchaseOrderStatusConfirmed})
+
+		assert.Equal(t, customerror.NewPurcahseOrderError(http.StatusConflict, "cannot change status from cancelled to confirmed", "Purchase Order"), err)
+		mockRepo.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("return unprocessable entity for an unknown status", func(t *testing.T) {
+		Svc := setupPurchaseOrderService(t)
+
+		_, err := Svc.UpdatePurchaseOrderStatus(context.Background(), 1, model.PurchaseOrderStatusUpdate{Status: "shipped"})
+
+		assert.Equal(t, customerror.NewPurcahseOrderError(http.StatusUnprocessableEntity, "status must be one of pending, confirmed, cancelled or fulfilled", "Purchase Order"), err)
+		Svc.Uow.(*mocks.MockIUnitOfWork).AssertNotCalled(t, "Do", mock.Anything, mock.Anything)
+	})
+
+	t.Run("return not found for an unknown purchase order", func(t *testing.T) {
+		Svc := setupPurchaseOrderService(t)
+		expectedErr := customerror.NewPurcahseOrderError(http.StatusNotFound, customerror.ErrNotFound.Error(), "Purchase Order")
+
+		Svc.Rp.(*mocks.MockIPurchaseOrdersRepo).On("GetByIDForUpdate", mock.Anything, 99).Return(model.PurchaseOrder{}, expectedErr)
+
+		_, err := Svc.UpdatePurchaseOrderStatus(context.Background(), 99, model.PurchaseOrderStatusUpdate{Status: model.PurchaseOrderStatusConfirmed})
+
+		assert.Equal(t, expectedErr, err)
+	})
+}
@@ -1,38 +1,45 @@
 package service
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"time"
 
 	"github.com/maxwelbm/alkemy-g7.git/internal/model"
 	"github.com/maxwelbm/alkemy-g7.git/internal/repository/interfaces"
+	svc "github.com/maxwelbm/alkemy-g7.git/internal/service/interfaces"
 	"github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
 	"github.com/maxwelbm/alkemy-g7.git/pkg/logger"
 )
 
 type SectionService struct {
-	Rp  interfaces.ISectionRepo
-	log logger.Logger
+	Rp            interfaces.ISectionRepo
+	RpProductType interfaces.IProductTypeRepo
+	RpWarehouse   interfaces.IWarehouseRepo
+	SvcExc        svc.ITemperatureExcursionService
+	log           logger.Logger
 }
 
-func CreateServiceSection(rp interfaces.ISectionRepo, log logger.Logger) *SectionService {
-	return &SectionService{Rp: rp, log: log}
+func CreateServiceSection(rp interfaces.ISectionRepo, rpProductType interfaces.IProductTypeRepo, rpWarehouse interfaces.IWarehouseRepo, svcExc svc.ITemperatureExcursionService, log logger.Logger) *SectionService {
+	return &SectionService{Rp: rp, RpProductType: rpProductType, RpWarehouse: rpWarehouse, SvcExc: svcExc, log: log}
 }
 
-func (s *SectionService) Get() (sections []model.Section, err error) {
+func (s *SectionService) Get(ctx context.Context, params model.ListParams) (sections []model.Section, total int, err error) {
 	s.log.Log("SectionService", "INFO", "initializing Get function")
-	sections, err = s.Rp.Get()
+	sections, total, err = s.Rp.Get(ctx, params)
 
 	return
 }
 
-func (s *SectionService) GetByID(id int) (section model.Section, err error) {
+func (s *SectionService) GetByID(ctx context.Context, id int) (section model.Section, err error) {
 	s.log.Log("SectionService", "INFO", "initializing GetByID function with id param")
-	section, err = s.Rp.GetByID(id)
+	section, err = s.Rp.GetByID(ctx, id)
 
 	return
 }
 
-func (s *SectionService) Post(section *model.Section) (sec model.Section, err error) {
+func (s *SectionService) Post(ctx context.Context, section *model.Section) (sec model.Section, err error) {
 	s.log.Log("SectionService", "INFO", "initializing Post function with section param")
 
 	if err := section.Validate(); err != nil {
@@ -40,17 +47,31 @@ func (s *SectionService) Post(section *model.Section) (sec model.Section, err er
 		return model.Section{}, err
 	}
 
-	sec, err = s.Rp.Post(section)
+	_, err = s.RpProductType.GetByID(ctx, section.ProductTypeID)
+	if err != nil {
+		s.log.Log("SectionService", "ERROR", fmt.Sprintf("Error: %v", err))
+		return model.Section{}, err
+	}
+
+	if err = s.validateWarehouseTemperature(ctx, section); err != nil {
+		s.log.Log("SectionService", "ERROR", fmt.Sprintf("Error: %v", err))
+		return model.Section{}, err
+	}
+
+	// the current capacity is driven by the product batches stored in the section
+	section.CurrentCapacity = 0
+
+	sec, err = s.Rp.Post(ctx, section)
 
 	s.log.Log("SectionService", "INFO", "successfully executed post function")
 
 	return
 }
 
-func (s *SectionService) Update(id int, section *model.Section) (sec model.Section, err error) {
+func (s *SectionService) Update(ctx context.Context, id int, section *model.Section) (sec model.Section, err error) {
 	s.log.Log("SectionService", "INFO", "initializing Update function with id and section param")
 
-	existingSection, err := s.GetByID(id)
+	existingSection, err := s.GetByID(ctx, id)
 	if err != nil {
 		sec = model.Section{}
 
@@ -59,37 +80,99 @@ func (s *SectionService) Update(id int, section *model.Section) (sec model.Secti
 		return
 	}
 
+	if section.ProductTypeID != 0 {
+		_, err = s.RpProductType.GetByID(ctx, section.ProductTypeID)
+		if err != nil {
+			sec = model.Section{}
+
+			s.log.Log("SectionService", "ERROR", fmt.Sprintf("Error: %v", err))
+
+			return
+		}
+	}
+
+	previousTemperature := existingSection.CurrentTemperature
+
+	// the maximum capacity is checked against the stored current capacity by the repository
 	updateSectionFields(&existingSection, section)
 
-	sec, err = s.Rp.Update(id, &existingSection)
+	// any temperature change, or a move to another warehouse, is checked against the warehouse minimum
+	if section.WarehouseID != 0 || section.MinimumTemperature != 0 || section.CurrentTemperature != 0 {
+		if err = s.validateWarehouseTemperature(ctx, &existingSection); err != nil {
+			sec = model.Section{}
+
+			s.log.Log("SectionService", "ERROR", fmt.Sprintf("Error: %v", err))
+
+			return
+		}
+	}
+
+	sec, err = s.Rp.Update(ctx, id, &existingSection)
+	if err != nil {
+		s.log.Log("SectionService", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	if sec.CurrentTemperature != previousTemperature {
+		reading := model.TemperatureReading{SectionID: id, Temperature: sec.CurrentTemperature, RecordedAt: time.Now()}
+		if excErr := s.SvcExc.EvaluateSection(ctx, id, []model.TemperatureReading{reading}); excErr != nil {
+			s.log.Log("SectionService", "ERROR", fmt.Sprintf("unable to evaluate temperature excursions: %v", excErr))
+		}
+	}
 
 	s.log.Log("SectionService", "INFO", "successfully executed update function")
 
 	return
 }
 
-func (s *SectionService) Delete(id int) (err error) {
+func (s *SectionService) Delete(ctx context.Context, id int) (err error) {
 	s.log.Log("SectionService", "INFO", "initializing Delete function with id param")
 
-	_, err = s.GetByID(id)
+	_, err = s.GetByID(ctx, id)
 	if err != nil {
 		s.log.Log("SectionService", "ERROR", fmt.Sprintf("Error: %v", err))
 		return
 	}
 
-	secProdBatches, _ := s.Rp.CountProductBatchesBySectionID(id)
+	secProdBatches, _ := s.CountProductBatchesBySectionID(ctx, id)
 	if secProdBatches.ProductsCount > 0 {
 		s.log.Log("SectionService", "ERROR", fmt.Sprintf("Error: %v", err))
 		return customerror.HandleError("section", customerror.ErrorDep, "")
 	}
 
-	err = s.Rp.Delete(id)
+	err = s.Rp.Delete(ctx, id)
 
 	s.log.Log("SectionService", "INFO", "successfully executed delete function")
 
 	return
 }
 
+// validateWarehouseTemperature checks that neither the minimum nor the current temperature of the
+// section is colder than the minimum temperature of its warehouse.
+func (s *SectionService) validateWarehouseTemperature(ctx context.Context, section *model.Section) error {
+	warehouse, err := s.RpWarehouse.GetByIDWareHouse(ctx, section.WarehouseID)
+	if err != nil {
+		if e, ok := err.(*customerror.WareHouseError); ok && e.Code == http.StatusNotFound {
+			return customerror.HandleError("warehouse", customerror.ErrorNotFound, "")
+		}
+
+		return err
+	}
+
+	if section.MinimumTemperature < float64(warehouse.MinimunTemperature) {
+		return customerror.HandleError("section", customerror.ErrorInvalid,
+			fmt.Sprintf("MinimumTemperature cannot be lower than the warehouse minimum temperature of %d", warehouse.MinimunTemperature))
+	}
+
+	if section.CurrentTemperature < float64(warehouse.MinimunTemperature) {
+		return customerror.HandleError("section", customerror.ErrorInvalid,
+			fmt.Sprintf("CurrentTemperature cannot be lower than the warehouse minimum temperature of %d", warehouse.MinimunTemperature))
+	}
+
+	return nil
+}
+
 func updateSectionFields(existingSection *model.Section, updatedSection *model.Section) {
 	if updatedSection.SectionNumber != "" {
 		existingSection.SectionNumber = updatedSection.SectionNumber
@@ -103,10 +186,6 @@ func updateSectionFields(existingSection *model.Section, updatedSection *model.S
 		existingSection.MinimumTemperature = updatedSection.MinimumTemperature
 	}
 
-	if updatedSection.CurrentCapacity != 0 {
-		existingSection.CurrentCapacity = updatedSection.CurrentCapacity
-	}
-
 	if updatedSection.MinimumCapacity != 0 {
 		existingSection.MinimumCapacity = updatedSection.MinimumCapacity
 	}
@@ -120,20 +199,48 @@ func updateSectionFields(existingSection *model.Section, updatedSection *model.S
 	}
 
 	if updatedSection.ProductTypeID != 0 {
-		existingSection.ProductTypeID = updatedSection.WarehouseID
+		existingSection.ProductTypeID = updatedSection.ProductTypeID
 	}
 }
 
-func (s *SectionService) CountProductBatchesBySectionID(id int) (countProdBatches model.SectionProductBatches, err error) {
+func (s *SectionService) CountProductBatchesBySectionID(ctx context.Context, id int) (countProdBatches model.SectionProductBatches, err error) {
 	s.log.Log("SectionService", "INFO", "initializing CountProductBatchesBySectionID function with id param")
-	countProdBatches, err = s.Rp.CountProductBatchesBySectionID(id)
+	counts, err := s.Rp.CountProductBatchesSections(ctx, id)
+	if err != nil {
+		s.log.Log("SectionService", "ERROR", fmt.Sprintf("Error: %v", err))
+		return
+	}
+
+	if len(counts) == 0 {
+		err = customerror.HandleError("section", customerror.ErrorNotFound, "")
+		s.log.Log("SectionService", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	countProdBatches = counts[0]
 
 	return
 }
 
-func (s *SectionService) CountProductBatchesSections() (countProductBatches []model.SectionProductBatches, err error) {
+func (s *SectionService) CountProductBatchesSections(ctx context.Context) (countProductBatches []model.SectionProductBatches, err error) {
 	s.log.Log("SectionService", "INFO", "initializing CountProductBatchesSections function")
-	countProductBatches, err = s.Rp.CountProductBatchesSections()
+	countProductBatches, err = s.Rp.CountProductBatchesSections(ctx, 0)
+
+	return
+}
+
+func (s *SectionService) GetCapacityReport(ctx context.Context, nearFullRatio float64) (report []model.SectionCapacity, err error) {
+	s.log.Log("SectionService", "INFO", "initializing GetCapacityReport function")
+
+	if nearFullRatio <= 0 || nearFullRatio > 1 {
+		err = customerror.HandleError("section", customerror.ErrorInvalid, "near full ratio must be greater than 0 and at most 1")
+		s.log.Log("SectionService", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	report, err = s.Rp.GetCapacityReport(ctx, nearFullRatio)
 
 	return
 }
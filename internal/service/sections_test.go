@@ -1,6 +1,8 @@
 package service_test
 
 import (
+	"context"
+	"net/http"
 	"testing"
 
 	"github.com/maxwelbm/alkemy-g7.git/internal/mocks"
@@ -8,11 +10,18 @@ import (
 	"github.com/maxwelbm/alkemy-g7.git/internal/service"
 	"github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func setupRepMock(t *testing.T) *service.SectionService {
 	mockRep := mocks.NewMockISectionRepo(t)
-	return service.CreateServiceSection(mockRep, logMock)
+	mockRepProductType := mocks.NewMockIProductTypeRepo(t)
+	mockRepWarehouse := mocks.NewMockIWarehouseRepo(t)
+	mockSvcExc := mocks.NewMockITemperatureExcursionService(t)
+
+	mockSvcExc.On("EvaluateSection", mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	return service.CreateServiceSection(mockRep, mockRepProductType, mockRepWarehouse, mockSvcExc, logMock)
 }
 
 func TestGetSections(t *testing.T) {
@@ -22,11 +31,12 @@ func TestGetSections(t *testing.T) {
 		expectedSections := []model.Section{{ID: 1, SectionNumber: "S01", CurrentTemperature: 10.0, MinimumTemperature: 5.0, CurrentCapacity: 10, MinimumCapacity: 5, MaximumCapacity: 20, WarehouseID: 1, ProductTypeID: 1}, {ID: 2, SectionNumber: "S02", CurrentTemperature: 15.0, MinimumTemperature: 10.0, CurrentCapacity: 20, MinimumCapacity: 10, MaximumCapacity: 30, WarehouseID: 2, ProductTypeID: 2}}
 
 		mockRepo := svc.Rp.(*mocks.MockISectionRepo)
-		mockRepo.On("Get").Return(expectedSections, nil)
+		mockRepo.On("Get", mock.Anything, mock.Anything).Return(expectedSections, 2, nil)
 
-		sections, err := svc.Get()
+		sections, total, err := svc.Get(context.Background(), model.ListParams{})
 
 		assert.Equal(t, expectedSections, sections)
+		assert.Equal(t, 2, total)
 		assert.NoError(t, err)
 		mockRepo.AssertExpectations(t)
 	})
@@ -39,9 +49,9 @@ func TestSectionByID(t *testing.T) {
 		expectedSection := model.Section{ID: 1, SectionNumber: "S01", CurrentTemperature: 10.0, MinimumTemperature: 5.0, CurrentCapacity: 10, MinimumCapacity: 5, MaximumCapacity: 20, WarehouseID: 1, ProductTypeID: 1}
 
 		mockRepo := svc.Rp.(*mocks.MockISectionRepo)
-		mockRepo.On("GetByID", 1).Return(expectedSection, nil)
+		mockRepo.On("GetByID", mock.Anything, 1).Return(expectedSection, nil)
 
-		section, err := svc.GetByID(1)
+		section, err := svc.GetByID(context.Background(), 1)
 
 		assert.Equal(t, expectedSection, section)
 		assert.NoError(t, err)
@@ -54,9 +64,9 @@ func TestSectionByID(t *testing.T) {
 		expectedError := customerror.HandleError("section", customerror.ErrorNotFound, "")
 
 		mockRepo := svc.Rp.(*mocks.MockISectionRepo)
-		mockRepo.On("GetByID", 50).Return(model.Section{}, expectedError)
+		mockRepo.On("GetByID", mock.Anything, 50).Return(model.Section{}, expectedError)
 
-		section, err := svc.GetByID(50)
+		section, err := svc.GetByID(context.Background(), 50)
 
 		assert.Equal(t, model.Section{}, section)
 		assert.ErrorIs(t, err, expectedError)
@@ -68,12 +78,18 @@ func TestPostSection(t *testing.T) {
 	t.Run("given a valid section create it successfully", func(t *testing.T) {
 		svc := setupRepMock(t)
 
-		createdSection := model.Section{ID: 1, SectionNumber: "S01", CurrentTemperature: 10.0, MinimumTemperature: 5.0, CurrentCapacity: 10, MinimumCapacity: 5, MaximumCapacity: 20, WarehouseID: 1, ProductTypeID: 1}
+		createdSection := model.Section{ID: 1, SectionNumber: "S01", CurrentTemperature: 10.0, MinimumTemperature: 5.0, CurrentCapacity: 0, MinimumCapacity: 5, MaximumCapacity: 20, WarehouseID: 1, ProductTypeID: 1}
+
+		mockRepoProductType := svc.RpProductType.(*mocks.MockIProductTypeRepo)
+		mockRepoProductType.On("GetByID", mock.Anything, 1).Return(model.ProductType{ID: 1}, nil)
+
+		mockRepoWarehouse := svc.RpWarehouse.(*mocks.MockIWarehouseRepo)
+		mockRepoWarehouse.On("GetByIDWareHouse", mock.Anything, 1).Return(model.WareHouse{ID: 1, MinimunTemperature: 2}, nil)
 
 		mockRepo := svc.Rp.(*mocks.MockISectionRepo)
-		mockRepo.On("Post", &model.Section{ID: 1, SectionNumber: "S01", CurrentTemperature: 10.0, MinimumTemperature: 5.0, CurrentCapacity: 10, MinimumCapacity: 5, MaximumCapacity: 20, WarehouseID: 1, ProductTypeID: 1}).Return(createdSection, nil)
+		mockRepo.On("Post", mock.Anything, &model.Section{ID: 1, SectionNumber: "S01", CurrentTemperature: 10.0, MinimumTemperature: 5.0, CurrentCapacity: 0, MinimumCapacity: 5, MaximumCapacity: 20, WarehouseID: 1, ProductTypeID: 1}).Return(createdSection, nil)
 
-		section, err := svc.Post(&createdSection)
+		section, err := svc.Post(context.Background(), &model.Section{ID: 1, SectionNumber: "S01", CurrentTemperature: 10.0, MinimumTemperature: 5.0, CurrentCapacity: 10, MinimumCapacity: 5, MaximumCapacity: 20, WarehouseID: 1, ProductTypeID: 1})
 
 		assert.NoError(t, err)
 		assert.Equal(t, createdSection, section)
@@ -86,10 +102,16 @@ func TestPostSection(t *testing.T) {
 		expectedErrSection := customerror.HandleError("section", customerror.ErrorConflict, "")
 		createdSection := model.Section{ID: 1, SectionNumber: "S01", CurrentTemperature: 10.0, MinimumTemperature: 5.0, CurrentCapacity: 10, MinimumCapacity: 5, MaximumCapacity: 20, WarehouseID: 1, ProductTypeID: 1}
 
+		mockRepoProductType := svc.RpProductType.(*mocks.MockIProductTypeRepo)
+		mockRepoProductType.On("GetByID", mock.Anything, 1).Return(model.ProductType{ID: 1}, nil)
+
+		mockRepoWarehouse := svc.RpWarehouse.(*mocks.MockIWarehouseRepo)
+		mockRepoWarehouse.On("GetByIDWareHouse", mock.Anything, 1).Return(model.WareHouse{ID: 1, MinimunTemperature: 2}, nil)
+
 		mockRepo := svc.Rp.(*mocks.MockISectionRepo)
-		mockRepo.On("Post", &createdSection).Return(model.Section{}, expectedErrSection)
+		mockRepo.On("Post", mock.Anything, &createdSection).Return(model.Section{}, expectedErrSection)
 
-		section, err := svc.Post(&createdSection)
+		section, err := svc.Post(context.Background(), &createdSection)
 
 		assert.Equal(t, model.Section{}, section)
 		assert.ErrorIs(t, err, expectedErrSection)
@@ -102,11 +124,77 @@ func TestPostSection(t *testing.T) {
 
 		createdSection := model.Section{ID: 1, CurrentTemperature: 10.0, MinimumTemperature: 5.0, CurrentCapacity: 10, MinimumCapacity: 5, MaximumCapacity: 20, WarehouseID: 1, ProductTypeID: 1}
 
-		section, err := svc.Post(&createdSection)
+		section, err := svc.Post(context.Background(), &createdSection)
 
 		assert.Equal(t, model.Section{}, section)
 		assert.Error(t, err)
 	})
+
+	t.Run("given a section with an unknown product type then return not found", func(t *testing.T) {
+		svc := setupRepMock(t)
+
+		expectedErr := customerror.HandleError("product type", customerror.ErrorNotFound, "")
+		createdSection := model.Section{SectionNumber: "S01", CurrentTemperature: 10.0, MinimumTemperature: 5.0, CurrentCapacity: 10, MinimumCapacity: 5, MaximumCapacity: 20, WarehouseID: 1, ProductTypeID: 99}
+
+		mockRepoProductType := svc.RpProductType.(*mocks.MockIProductTypeRepo)
+		mockRepoProductType.On("GetByID", mock.Anything, 99).Return(model.ProductType{}, expectedErr)
+
+		section, err := svc.Post(context.Background(), &createdSection)
+
+		assert.Equal(t, model.Section{}, section)
+		assert.ErrorIs(t, err, expectedErr)
+	})
+
+	t.Run("given a section colder than its warehouse allows then return error", func(t *testing.T) {
+		svc := setupRepMock(t)
+
+		createdSection := model.Section{SectionNumber: "S01", CurrentTemperature: 10.0, MinimumTemperature: 5.0, MinimumCapacity: 5, MaximumCapacity: 20, WarehouseID: 1, ProductTypeID: 1}
+
+		mockRepoProductType := svc.RpProductType.(*mocks.MockIProductTypeRepo)
+		mockRepoProductType.On("GetByID", mock.Anything, 1).Return(model.ProductType{ID: 1}, nil)
+
+		mockRepoWarehouse := svc.RpWarehouse.(*mocks.MockIWarehouseRepo)
+		mockRepoWarehouse.On("GetByIDWareHouse", mock.Anything, 1).Return(model.WareHouse{ID: 1, MinimunTemperature: 8}, nil)
+
+		section, err := svc.Post(context.Background(), &createdSection)
+
+		assert.Equal(t, customerror.HandleError("section", customerror.ErrorInvalid, "MinimumTemperature cannot be lower than the warehouse minimum temperature of 8"), err)
+		assert.Equal(t, model.Section{}, section)
+	})
+
+	t.Run("given a section currently colder than its warehouse allows then return error", func(t *testing.T) {
+		svc := setupRepMock(t)
+
+		createdSection := model.Section{SectionNumber: "S01", CurrentTemperature: 5.0, MinimumTemperature: 6.0, MinimumCapacity: 5, MaximumCapacity: 20, WarehouseID: 1, ProductTypeID: 1}
+
+		mockRepoProductType := svc.RpProductType.(*mocks.MockIProductTypeRepo)
+		mockRepoProductType.On("GetByID", mock.Anything, 1).Return(model.ProductType{ID: 1}, nil)
+
+		mockRepoWarehouse := svc.RpWarehouse.(*mocks.MockIWarehouseRepo)
+		mockRepoWarehouse.On("GetByIDWareHouse", mock.Anything, 1).Return(model.WareHouse{ID: 1, MinimunTemperature: 6}, nil)
+
+		section, err := svc.Post(context.Background(), &createdSection)
+
+		assert.Equal(t, customerror.HandleError("section", customerror.ErrorInvalid, "CurrentTemperature cannot be lower than the warehouse minimum temperature of 6"), err)
+		assert.Equal(t, model.Section{}, section)
+	})
+
+	t.Run("given a section in an unknown warehouse then return not found", func(t *testing.T) {
+		svc := setupRepMock(t)
+
+		createdSection := model.Section{SectionNumber: "S01", CurrentTemperature: 10.0, MinimumTemperature: 5.0, MinimumCapacity: 5, MaximumCapacity: 20, WarehouseID: 99, ProductTypeID: 1}
+
+		mockRepoProductType := svc.RpProductType.(*mocks.MockIProductTypeRepo)
+		mockRepoProductType.On("GetByID", mock.Anything, 1).Return(model.ProductType{ID: 1}, nil)
+
+		mockRepoWarehouse := svc.RpWarehouse.(*mocks.MockIWarehouseRepo)
+		mockRepoWarehouse.On("GetByIDWareHouse", mock.Anything, 99).Return(model.WareHouse{}, customerror.NewWareHouseError(customerror.ErrNotFound.Error(), "warehouse", http.StatusNotFound))
+
+		section, err := svc.Post(context.Background(), &createdSection)
+
+		assert.Equal(t, customerror.HandleError("warehouse", customerror.ErrorNotFound, ""), err)
+		assert.Equal(t, model.Section{}, section)
+	})
 }
 
 func TestUpdateSection(t *testing.T) {
@@ -116,11 +204,17 @@ func TestUpdateSection(t *testing.T) {
 		updatedSection := model.Section{ID: 1, SectionNumber: "S01", CurrentTemperature: 10.0, MinimumTemperature: 5.0, CurrentCapacity: 10, MinimumCapacity: 5, MaximumCapacity: 20, WarehouseID: 1, ProductTypeID: 1}
 
 		mockRepo := svc.Rp.(*mocks.MockISectionRepo)
-		mockRepo.On("GetByID", 1).Return(updatedSection, nil)
+		mockRepo.On("GetByID", mock.Anything, 1).Return(updatedSection, nil)
 
-		mockRepo.On("Update", 1, &updatedSection).Return(updatedSection, nil)
+		mockRepoProductType := svc.RpProductType.(*mocks.MockIProductTypeRepo)
+		mockRepoProductType.On("GetByID", mock.Anything, 1).Return(model.ProductType{ID: 1}, nil)
 
-		section, err := svc.Update(1, &updatedSection)
+		mockRepoWarehouse := svc.RpWarehouse.(*mocks.MockIWarehouseRepo)
+		mockRepoWarehouse.On("GetByIDWareHouse", mock.Anything, 1).Return(model.WareHouse{ID: 1, MinimunTemperature: 5}, nil)
+
+		mockRepo.On("Update", mock.Anything, 1, &updatedSection).Return(updatedSection, nil)
+
+		section, err := svc.Update(context.Background(), 1, &updatedSection)
 
 		assert.NoError(t, err)
 		assert.Equal(t, updatedSection, section)
@@ -134,15 +228,91 @@ func TestUpdateSection(t *testing.T) {
 		mockRepo := svc.Rp.(*mocks.MockISectionRepo)
 
 		expectedError := customerror.HandleError("section", customerror.ErrorConflict, "")
-		mockRepo.On("GetByID", 50).Return(model.Section{}, expectedError)
+		mockRepo.On("GetByID", mock.Anything, 50).Return(model.Section{}, expectedError)
 
-		section, err := svc.Update(50, &updatedSection)
+		section, err := svc.Update(context.Background(), 50, &updatedSection)
 
 		assert.ErrorIs(t, err, expectedError)
 		assert.Error(t, err)
 		assert.Equal(t, model.Section{}, section)
 		mockRepo.AssertExpectations(t)
 	})
+
+	t.Run("given a maximum capacity lower than the current capacity then return error", func(t *testing.T) {
+		svc := setupRepMock(t)
+
+		existingSection := model.Section{ID: 1, SectionNumber: "S01", CurrentTemperature: 10.0, MinimumTemperature: 5.0, CurrentCapacity: 15, MinimumCapacity: 5, MaximumCapacity: 20, WarehouseID: 1, ProductTypeID: 1}
+
+		mockRepo := svc.Rp.(*mocks.MockISectionRepo)
+		mockRepo.On("GetByID", mock.Anything, 1).Return(existingSection, nil)
+
+		expectedErr := customerror.NewError(http.StatusConflict, customerror.ErrCapacityBelowCurrent.Error(), "section", "")
+		patched := existingSection
+		patched.MaximumCapacity = 10
+		mockRepo.On("Update", mock.Anything, 1, &patched).Return(model.Section{}, expectedErr)
+
+		section, err := svc.Update(context.Background(), 1, &model.Section{MaximumCapacity: 10})
+
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, model.Section{}, section)
+	})
+
+	t.Run("given a move to a warehouse warmer than the section then return error", func(t *testing.T) {
+		svc := setupRepMock(t)
+
+		existingSection := model.Section{ID: 1, SectionNumber: "S01", CurrentTemperature: 10.0, MinimumTemperature: 5.0, CurrentCapacity: 15, MinimumCapacity: 5, MaximumCapacity: 20, WarehouseID: 1, ProductTypeID: 1}
+
+		mockRepo := svc.Rp.(*mocks.MockISectionRepo)
+		mockRepo.On("GetByID", mock.Anything, 1).Return(existingSection, nil)
+
+		mockRepoWarehouse := svc.RpWarehouse.(*mocks.MockIWarehouseRepo)
+		mockRepoWarehouse.On("GetByIDWareHouse", mock.Anything, 2).Return(model.WareHouse{ID: 2, MinimunTemperature: 6}, nil)
+
+		section, err := svc.Update(context.Background(), 1, &model.Section{WarehouseID: 2})
+
+		assert.Equal(t, customerror.HandleError("section", customerror.ErrorInvalid, "MinimumTemperature cannot be lower than the warehouse minimum temperature of 6"), err)
+		assert.Equal(t, model.Section{}, section)
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, 1, mock.Anything)
+	})
+
+	t.Run("given a current temperature below the warehouse minimum then return error", func(t *testing.T) {
+		svc := setupRepMock(t)
+
+		existingSection := model.Section{ID: 1, SectionNumber: "S01", CurrentTemperature: 10.0, MinimumTemperature: 5.0, CurrentCapacity: 15, MinimumCapacity: 5, MaximumCapacity: 20, WarehouseID: 1, ProductTypeID: 1}
+
+		mockRepo := svc.Rp.(*mocks.MockISectionRepo)
+		mockRepo.On("GetByID", mock.Anything, 1).Return(existingSection, nil)
+
+		mockRepoWarehouse := svc.RpWarehouse.(*mocks.MockIWarehouseRepo)
+		mockRepoWarehouse.On("GetByIDWareHouse", mock.Anything, 1).Return(model.WareHouse{ID: 1, MinimunTemperature: 5}, nil)
+
+		section, err := svc.Update(context.Background(), 1, &model.Section{CurrentTemperature: 3.0})
+
+		assert.Equal(t, customerror.HandleError("section", customerror.ErrorInvalid, "CurrentTemperature cannot be lower than the warehouse minimum temperature of 5"), err)
+		assert.Equal(t, model.Section{}, section)
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, 1, mock.Anything)
+	})
+
+	t.Run("given a current temperature within the warehouse limits then update it", func(t *testing.T) {
+		svc := setupRepMock(t)
+
+		existingSection := model.Section{ID: 1, SectionNumber: "S01", CurrentTemperature: 10.0, MinimumTemperature: 5.0, CurrentCapacity: 15, MinimumCapacity: 5, MaximumCapacity: 20, WarehouseID: 1, ProductTypeID: 1}
+		patched := existingSection
+		patched.CurrentTemperature = 10.0
+
+		mockRepo := svc.Rp.(*mocks.MockISectionRepo)
+		mockRepo.On("GetByID", mock.Anything, 1).Return(existingSection, nil)
+		mockRepo.On("Update", mock.Anything, 1, &patched).Return(patched, nil)
+
+		mockRepoWarehouse := svc.RpWarehouse.(*mocks.MockIWarehouseRepo)
+		mockRepoWarehouse.On("GetByIDWareHouse", mock.Anything, 1).Return(model.WareHouse{ID: 1, MinimunTemperature: 5}, nil)
+
+		section, err := svc.Update(context.Background(), 1, &model.Section{CurrentTemperature: 10.0})
+
+		assert.NoError(t, err)
+		assert.Equal(t, patched, section)
+		mockRepoWarehouse.AssertExpectations(t)
+	})
 }
 
 func TestDeleteSection(t *testing.T) {
@@ -152,13 +322,13 @@ func TestDeleteSection(t *testing.T) {
 		deletedSection := model.Section{ID: 1, SectionNumber: "S01", CurrentTemperature: 10.0, MinimumTemperature: 5.0, CurrentCapacity: 10, MinimumCapacity: 5, MaximumCapacity: 20, WarehouseID: 1, ProductTypeID: 1}
 		mockRepo := svc.Rp.(*mocks.MockISectionRepo)
 
-		mockRepo.On("GetByID", 1).Return(deletedSection, nil)
+		mockRepo.On("GetByID", mock.Anything, 1).Return(deletedSection, nil)
 
-		mockRepo.On("CountProductBatchesBySectionID", 1).Return(model.SectionProductBatches{}, nil)
+		mockRepo.On("CountProductBatchesSections", mock.Anything, 1).Return([]model.SectionProductBatches{}, nil)
 
-		mockRepo.On("Delete", 1).Return(nil)
+		mockRepo.On("Delete", mock.Anything, 1).Return(nil)
 
-		err := svc.Delete(1)
+		err := svc.Delete(context.Background(), 1)
 
 		assert.NoError(t, err)
 		mockRepo.AssertExpectations(t)
@@ -171,9 +341,9 @@ func TestDeleteSection(t *testing.T) {
 
 		mockRepo := svc.Rp.(*mocks.MockISectionRepo)
 
-		mockRepo.On("GetByID", 50).Return(model.Section{}, expectedError)
+		mockRepo.On("GetByID", mock.Anything, 50).Return(model.Section{}, expectedError)
 
-		err := svc.Delete(50)
+		err := svc.Delete(context.Background(), 50)
 
 		assert.ErrorIs(t, err, expectedError)
 		assert.Error(t, err)
@@ -189,13 +359,13 @@ func TestDeleteSection(t *testing.T) {
 
 		mockRepo := svc.Rp.(*mocks.MockISectionRepo)
 
-		mockRepo.On("GetByID", 1).Return(deletedSection, nil)
+		mockRepo.On("GetByID", mock.Anything, 1).Return(deletedSection, nil)
 
-		mockRepo.On("CountProductBatchesBySectionID", 1).Return(prodBatches, expectedError)
+		mockRepo.On("CountProductBatchesSections", mock.Anything, 1).Return([]model.SectionProductBatches{prodBatches}, nil)
 
-		err := svc.Delete(1)
+		err := svc.Delete(context.Background(), 1)
 
-		assert.Error(t, err)
+		assert.Equal(t, expectedError, err)
 		mockRepo.AssertExpectations(t)
 	})
 }
@@ -208,14 +378,28 @@ func TestCountProductBatchesBySectionID(t *testing.T) {
 
 		mockRepo := svc.Rp.(*mocks.MockISectionRepo)
 
-		mockRepo.On("CountProductBatchesBySectionID", 1).Return(expectedSPB, nil)
+		mockRepo.On("CountProductBatchesSections", mock.Anything, 1).Return([]model.SectionProductBatches{expectedSPB}, nil)
 
-		count, err := svc.CountProductBatchesBySectionID(1)
+		count, err := svc.CountProductBatchesBySectionID(context.Background(), 1)
 
 		assert.Equal(t, expectedSPB, count)
 		assert.NoError(t, err)
 		mockRepo.AssertExpectations(t)
 	})
+
+	t.Run("given a section without product batches then return not found", func(t *testing.T) {
+		svc := setupRepMock(t)
+
+		mockRepo := svc.Rp.(*mocks.MockISectionRepo)
+
+		mockRepo.On("CountProductBatchesSections", mock.Anything, 2).Return([]model.SectionProductBatches{}, nil)
+
+		count, err := svc.CountProductBatchesBySectionID(context.Background(), 2)
+
+		assert.Equal(t, model.SectionProductBatches{}, count)
+		assert.Equal(t, customerror.HandleError("section", customerror.ErrorNotFound, ""), err)
+		mockRepo.AssertExpectations(t)
+	})
 }
 
 func TestCountProductBatchesBySection(t *testing.T) {
@@ -226,12 +410,41 @@ func TestCountProductBatchesBySection(t *testing.T) {
 
 		mockRepo := svc.Rp.(*mocks.MockISectionRepo)
 
-		mockRepo.On("CountProductBatchesSections").Return(expectedSPB, nil)
+		mockRepo.On("CountProductBatchesSections", mock.Anything, 0).Return(expectedSPB, nil)
 
-		count, err := svc.CountProductBatchesSections()
+		count, err := svc.CountProductBatchesSections(context.Background())
 
 		assert.Equal(t, expectedSPB, count)
 		assert.NoError(t, err)
 		mockRepo.AssertExpectations(t)
 	})
 }
+
+func TestGetSectionCapacityReport(t *testing.T) {
+	t.Run("return the sections outside the capacity thresholds", func(t *testing.T) {
+		svc := setupRepMock(t)
+
+		expected := []model.SectionCapacity{
+			{ID: 1, SectionNumber: "S01", WarehouseID: 1, CurrentCapacity: 2, MinimumCapacity: 5, MaximumCapacity: 20, Status: model.SectionCapacityBelowMinimum},
+			{ID: 2, SectionNumber: "S02", WarehouseID: 1, CurrentCapacity: 19, MinimumCapacity: 5, MaximumCapacity: 20, Status: model.SectionCapacityNearFull},
+		}
+
+		mockRepo := svc.Rp.(*mocks.MockISectionRepo)
+		mockRepo.On("GetCapacityReport", mock.Anything, model.DefaultNearFullRatio).Return(expected, nil)
+
+		report, err := svc.GetCapacityReport(context.Background(), model.DefaultNearFullRatio)
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, report)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("given an invalid ratio return error", func(t *testing.T) {
+		svc := setupRepMock(t)
+
+		report, err := svc.GetCapacityReport(context.Background(), 1.5)
+
+		assert.Equal(t, customerror.HandleError("section", customerror.ErrorInvalid, "near full ratio must be greater than 0 and at most 1"), err)
+		assert.Nil(t, report)
+	})
+}
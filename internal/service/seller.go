@@ -1,63 +1,67 @@
 package service
 
 import (
+	"context"
+	"database/sql"
 	"fmt"
+
 	"github.com/maxwelbm/alkemy-g7.git/internal/model"
 	"github.com/maxwelbm/alkemy-g7.git/internal/repository/interfaces"
 	serviceInterface "github.com/maxwelbm/alkemy-g7.git/internal/service/interfaces"
 	"github.com/maxwelbm/alkemy-g7.git/pkg/logger"
 )
 
-func CreateServiceSellers(rp interfaces.ISellerRepo, rpl serviceInterface.ILocalityService, log logger.Logger) *SellersService {
-	return &SellersService{Rp: rp, Rpl: rpl, log: log}
+func CreateServiceSellers(rp interfaces.ISellerRepo, rpl serviceInterface.ILocalityService, uow interfaces.IUnitOfWork, log logger.Logger) *SellersService {
+	return &SellersService{Rp: rp, Rpl: rpl, Uow: uow, log: log}
 }
 
 type SellersService struct {
 	Rp  interfaces.ISellerRepo
 	Rpl serviceInterface.ILocalityService
+	Uow interfaces.IUnitOfWork
 	log logger.Logger
 }
 
-func (s *SellersService) GetAll() (sellers []model.Seller, err error) {
-	sellers, err = s.Rp.Get()
+func (s *SellersService) GetAll(ctx context.Context, params model.ListParams) (sellers []model.Seller, total int, err error) {
+	sellers, total, err = s.Rp.Get(ctx, params)
 
 	s.log.Log("SellersService", "INFO", fmt.Sprintf("Retrieved sellers: %+v", sellers))
 
 	return
 }
 
-func (s *SellersService) GetByID(id int) (seller model.Seller, err error) {
-	seller, err = s.Rp.GetByID(id)
+func (s *SellersService) GetByID(ctx context.Context, id int) (seller model.Seller, err error) {
+	seller, err = s.Rp.GetByID(ctx, id)
 
 	s.log.Log("SellersService", "INFO", fmt.Sprintf("Retrieved seller: %+v", seller))
 
 	return
 }
 
-func (s *SellersService) CreateSeller(seller *model.Seller) (sl model.Seller, err error) {
+func (s *SellersService) CreateSeller(ctx context.Context, seller *model.Seller) (sl model.Seller, err error) {
 	if err := seller.ValidateEmptyFields(seller); err != nil {
 		s.log.Log("SellersService", "ERROR", fmt.Sprintf("Error: %v", err))
 
 		return sl, err
 	}
 
-	_, err = s.Rpl.GetByID(seller.Locality)
+	_, err = s.Rpl.GetByID(ctx, seller.Locality)
 	if err != nil {
 		s.log.Log("SellersService", "ERROR", fmt.Sprintf("Error: %v", err))
 
 		return
 	}
 
-	sl, err = s.Rp.Post(seller)
+	sl, err = s.Rp.Post(ctx, seller)
 
 	s.log.Log("SellersService", "INFO", fmt.Sprintf("Created seller: %+v", sl))
 
 	return
 }
 
-func (s *SellersService) UpdateSeller(id int, seller *model.Seller) (sl model.Seller, err error) {
+func (s *SellersService) UpdateSeller(ctx context.Context, id int, seller *model.Seller) (sl model.Seller, err error) {
 	if seller.Locality != 0 {
-		_, err := s.Rpl.GetByID(seller.Locality)
+		_, err := s.Rpl.GetByID(ctx, seller.Locality)
 		if err != nil {
 			s.log.Log("SellersService", "ERROR", fmt.Sprintf("Error: %v", err))
 
@@ -65,24 +69,33 @@ func (s *SellersService) UpdateSeller(id int, seller *model.Seller) (sl model.Se
 		}
 	}
 
-	existSl, _ := s.GetByID(id)
-	err = seller.ValidateUpdateFields(seller, &existSl)
+	err = s.Uow.Do(ctx, func(tx *sql.Tx) error {
+		rp := s.Rp.WithTx(tx)
+
+		existSl, _ := rp.GetByID(ctx, id)
+
+		if err := seller.ValidateUpdateFields(seller, &existSl); err != nil {
+			return err
+		}
+
+		sl, err = rp.Patch(ctx, id, seller)
+
+		return err
+	})
 
 	if err != nil {
 		s.log.Log("SellersService", "ERROR", fmt.Sprintf("Error: %v", err))
 
-		return
+		return model.Seller{}, err
 	}
 
-	sl, err = s.Rp.Patch(id, seller)
-
 	s.log.Log("SellersService", "INFO", fmt.Sprintf("Updated seller: %+v", sl))
 
 	return sl, err
 }
 
-func (s *SellersService) DeleteSeller(id int) error {
-	err := s.Rp.Delete(id)
+func (s *SellersService) DeleteSeller(ctx context.Context, id int) error {
+	err := s.Rp.Delete(ctx, id)
 
 	s.log.Log("SellersService", "INFO", fmt.Sprintf("Removed seller with ID: %d", id))
 
@@ -1,6 +1,8 @@
 package service_test
 
 import (
+	"context"
+	"database/sql"
 	"errors"
 	"github.com/maxwelbm/alkemy-g7.git/internal/mocks"
 	"testing"
@@ -9,17 +11,22 @@ import (
 	"github.com/maxwelbm/alkemy-g7.git/internal/service"
 	"github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
 	"github.com/stretchr/testify/assert"
+	testifymock "github.com/stretchr/testify/mock"
 )
 
 func setupSeller(t *testing.T) *service.SellersService {
 	mockSeller := mocks.NewMockISellerRepo(t)
-	mockLocality := mocks.NewMockILocalityRepo(t)
+	mockLocality := mocks.NewMockILocalityService(t)
+	mockUow := mocks.NewMockIUnitOfWork(t)
 
-	return service.CreateServiceSellers(mockSeller, mockLocality, logMock)
+	mockSeller.On("WithTx", testifymock.Anything).Return(mockSeller).Maybe()
+	mockUow.On("Do", testifymock.Anything, testifymock.Anything).Return(func(ctx context.Context, fn func(*sql.Tx) error) error { return fn(nil) }).Maybe()
+
+	return service.CreateServiceSellers(mockSeller, mockLocality, mockUow, logMock)
 }
 
 func setupLocality(mockLocality *mocks.MockILocalityRepo) *service.LocalitiesService {
-	return service.CreateServiceLocalities(mockLocality, logMock)
+	return service.CreateServiceLocalities(mockLocality, new(mocks.MockIProvinceRepo), logMock)
 }
 
 func TestSellersService_GetAll(t *testing.T) {
@@ -30,12 +37,13 @@ func TestSellersService_GetAll(t *testing.T) {
 		sl := []model.Seller{{ID: 1, CID: 1, CompanyName: "Enterprise Liberty", Address: "456 Elm St", Telephone: "4443335454", Locality: 1},
 			{ID: 2, CID: 2, CompanyName: "Libre Mercado", Address: "123 Montain St Avenue", Telephone: "5554545999", Locality: 2}}
 
-		mock.On("Get").Return(sl, nil).Once()
+		mock.On("Get", testifymock.Anything, testifymock.Anything).Return(sl, 2, nil).Once()
 
-		sellers, err := s.GetAll()
+		sellers, total, err := s.GetAll(context.Background(), model.ListParams{})
 
 		assert.NoError(t, err)
 		assert.Equal(t, sl, sellers)
+		assert.Equal(t, 2, total)
 		mock.AssertExpectations(t)
 	})
 
@@ -43,9 +51,9 @@ func TestSellersService_GetAll(t *testing.T) {
 		sl := []model.Seller{}
 		errS := errors.New("internal server error")
 
-		mock.On("Get").Return(sl, errS).Once()
+		mock.On("Get", testifymock.Anything, testifymock.Anything).Return(sl, 0, errS).Once()
 
-		sellers, err := s.GetAll()
+		sellers, _, err := s.GetAll(context.Background(), model.ListParams{})
 
 		assert.ErrorIs(t, errS, err)
 		assert.Equal(t, sl, sellers)
@@ -61,9 +69,9 @@ func TestSellersService_GetByID(t *testing.T) {
 		ID := 3
 		sl := model.Seller{ID: 3, CID: 3, CompanyName: "Enterprise Science", Address: "1200 Central Park Avenue", Telephone: "999444555", Locality: 3}
 
-		mock.On("GetByID", ID).Return(sl, nil).Once()
+		mock.On("GetByID", testifymock.Anything, ID).Return(sl, nil).Once()
 
-		seller, err := s.GetByID(ID)
+		seller, err := s.GetByID(context.Background(), ID)
 
 		assert.NoError(t, err)
 		assert.Equal(t, sl, seller)
@@ -75,9 +83,9 @@ func TestSellersService_GetByID(t *testing.T) {
 		ID := 999
 		errS := customerror.ErrSellerNotFound
 
-		mock.On("GetByID", ID).Return(sl, errS).Once()
+		mock.On("GetByID", testifymock.Anything, ID).Return(sl, errS).Once()
 
-		seller, err := s.GetByID(ID)
+		seller, err := s.GetByID(context.Background(), ID)
 
 		assert.ErrorIs(t, errS, err)
 		assert.Equal(t, sl, seller)
@@ -89,9 +97,9 @@ func TestSellersService_GetByID(t *testing.T) {
 		ID := 0
 		errS := customerror.ErrMissingSellerID
 
-		mock.On("GetByID", ID).Return(sl, errS).Once()
+		mock.On("GetByID", testifymock.Anything, ID).Return(sl, errS).Once()
 
-		seller, err := s.GetByID(ID)
+		seller, err := s.GetByID(context.Background(), ID)
 
 		assert.ErrorIs(t, errS, err)
 		assert.Equal(t, sl, seller)
@@ -103,9 +111,9 @@ func TestSellersService_GetByID(t *testing.T) {
 		ID := 4
 		errS := customerror.ErrDefaultSeller
 
-		mock.On("GetByID", ID).Return(sl, errS).Once()
+		mock.On("GetByID", testifymock.Anything, ID).Return(sl, errS).Once()
 
-		seller, err := s.GetByID(ID)
+		seller, err := s.GetByID(context.Background(), ID)
 
 		assert.ErrorIs(t, errS, err)
 		assert.Equal(t, sl, seller)
@@ -116,7 +124,7 @@ func TestSellersService_GetByID(t *testing.T) {
 func TestSellersService_CreateSeller(t *testing.T) {
 	s := setupSeller(t)
 	mockSeller := s.Rp.(*mocks.MockISellerRepo)
-	mockLocality := s.Rpl.(*mocks.MockILocalityRepo)
+	mockLocality := s.Rpl.(*mocks.MockILocalityService)
 
 	t.Run("test service method for create seller with success", func(t *testing.T) {
 		arg := model.Seller{CID: 5, CompanyName: "Enterprise Cypress", Address: "702 St Mark", Telephone: "33344455566", Locality: 5}
@@ -124,10 +132,10 @@ func TestSellersService_CreateSeller(t *testing.T) {
 		ID := 5
 		l := model.Locality{ID: 5, Locality: "Brooklyn", Province: "New York", Country: "EUA"}
 
-		mockSeller.On("Post", &arg).Return(sl, nil).Once()
-		mockLocality.On("GetByID", ID).Return(l, nil).Once()
+		mockSeller.On("Post", testifymock.Anything, &arg).Return(sl, nil).Once()
+		mockLocality.On("GetByID", testifymock.Anything, ID).Return(l, nil).Once()
 
-		seller, err := s.CreateSeller(&arg)
+		seller, err := s.CreateSeller(context.Background(), &arg)
 
 		assert.NoError(t, err)
 		assert.Equal(t, sl, seller)
@@ -140,7 +148,7 @@ func TestSellersService_CreateSeller(t *testing.T) {
 		sl := model.Seller{}
 		errSeller := customerror.ErrNullSellerAttribute
 
-		seller, err := s.CreateSeller(&arg)
+		seller, err := s.CreateSeller(context.Background(), &arg)
 
 		assert.ErrorIs(t, errSeller, err)
 		assert.Equal(t, sl, seller)
@@ -153,10 +161,10 @@ func TestSellersService_CreateSeller(t *testing.T) {
 		l := model.Locality{ID: 7, Locality: "Manhattan", Province: "New York", Country: "EUA"}
 		errSeller := customerror.ErrCIDSellerAlreadyExist
 
-		mockSeller.On("Post", &arg).Return(sl, errSeller).Once()
-		mockLocality.On("GetByID", ID).Return(l, nil).Once()
+		mockSeller.On("Post", testifymock.Anything, &arg).Return(sl, errSeller).Once()
+		mockLocality.On("GetByID", testifymock.Anything, ID).Return(l, nil).Once()
 
-		seller, err := s.CreateSeller(&arg)
+		seller, err := s.CreateSeller(context.Background(), &arg)
 
 		assert.ErrorIs(t, errSeller, err)
 		assert.Equal(t, sl, seller)
@@ -172,8 +180,8 @@ func TestSellersService_CreateSeller(t *testing.T) {
 		errSeller := customerror.ErrLocalityNotFound
 		errLocality := customerror.ErrLocalityNotFound
 
-		mockLocality.On("GetByID", ID).Return(l, errLocality).Once()
-		seller, err := s.CreateSeller(&arg)
+		mockLocality.On("GetByID", testifymock.Anything, ID).Return(l, errLocality).Once()
+		seller, err := s.CreateSeller(context.Background(), &arg)
 
 		assert.ErrorIs(t, errSeller, err)
 		assert.Equal(t, sl, seller)
@@ -184,8 +192,9 @@ func TestSellersService_CreateSeller(t *testing.T) {
 func TestSellersService_UpdateSeller(t *testing.T) {
 	serviceSeller := setupSeller(t)
 	mockSeller := serviceSeller.Rp.(*mocks.MockISellerRepo)
-	mockLocality := serviceSeller.Rpl.(*mocks.MockILocalityRepo)
-	serviceLocality := setupLocality(mockLocality)
+	mockLocality := serviceSeller.Rpl.(*mocks.MockILocalityService)
+	repoLocality := mocks.NewMockILocalityRepo(t)
+	serviceLocality := setupLocality(repoLocality)
 
 	t.Run("test service method for update seller with success", func(t *testing.T) {
 		arg := model.Seller{CID: 55, CompanyName: "Cypress Company", Address: "900 Central Park", Telephone: "55566777787", Locality: 10}
@@ -194,12 +203,13 @@ func TestSellersService_UpdateSeller(t *testing.T) {
 		localityID := 10
 		l := model.Locality{ID: 10, Locality: "Los Angeles", Province: "California", Country: "EUA"}
 
-		mockSeller.On("Patch", sellerID, &arg).Return(sl, nil)
-		mockSeller.On("GetByID", sellerID).Return(sl, nil)
-		mockLocality.On("GetByID", localityID).Return(l, nil)
+		mockSeller.On("Patch", testifymock.Anything, sellerID, &arg).Return(sl, nil)
+		mockSeller.On("GetByID", testifymock.Anything, sellerID).Return(sl, nil)
+		mockLocality.On("GetByID", testifymock.Anything, localityID).Return(l, nil)
+		repoLocality.On("GetByID", testifymock.Anything, localityID).Return(l, nil)
 
-		seller, err := serviceSeller.UpdateSeller(sellerID, &arg)
-		locality, errL := serviceLocality.GetByID(localityID)
+		seller, err := serviceSeller.UpdateSeller(context.Background(), sellerID, &arg)
+		locality, errL := serviceLocality.GetByID(context.Background(), localityID)
 
 		assert.NoError(t, err)
 		assert.NoError(t, errL)
@@ -217,12 +227,13 @@ func TestSellersService_UpdateSeller(t *testing.T) {
 		l := model.Locality{ID: 9, Locality: "Little Rock", Province: "Arkansas", Country: "EUA"}
 		errSeller := customerror.ErrSellerNotFound
 
-		mockSeller.On("Patch", sellerID, &arg).Return(sl, errSeller)
-		mockSeller.On("GetByID", sellerID).Return(sl, errSeller)
-		mockLocality.On("GetByID", localityID).Return(l, nil)
+		mockSeller.On("Patch", testifymock.Anything, sellerID, &arg).Return(sl, errSeller)
+		mockSeller.On("GetByID", testifymock.Anything, sellerID).Return(sl, errSeller)
+		mockLocality.On("GetByID", testifymock.Anything, localityID).Return(l, nil)
+		repoLocality.On("GetByID", testifymock.Anything, localityID).Return(l, nil)
 
-		seller, err := serviceSeller.UpdateSeller(sellerID, &arg)
-		locality, errL := serviceLocality.GetByID(localityID)
+		seller, err := serviceSeller.UpdateSeller(context.Background(), sellerID, &arg)
+		locality, errL := serviceLocality.GetByID(context.Background(), localityID)
 
 		assert.ErrorIs(t, errSeller, err)
 		assert.NoError(t, errL)
@@ -238,9 +249,9 @@ func TestSellersService_UpdateSeller(t *testing.T) {
 		sellerID := 2
 		errSeller := customerror.ErrNullSellerAttribute
 
-		mockSeller.On("GetByID", sellerID).Return(sl, errSeller)
+		mockSeller.On("GetByID", testifymock.Anything, sellerID).Return(sl, errSeller)
 
-		seller, err := serviceSeller.UpdateSeller(sellerID, &arg)
+		seller, err := serviceSeller.UpdateSeller(context.Background(), sellerID, &arg)
 
 		assert.ErrorIs(t, errSeller, err)
 		assert.Equal(t, sl, seller)
@@ -255,12 +266,13 @@ func TestSellersService_UpdateSeller(t *testing.T) {
 		l := model.Locality{ID: 17, Locality: "Phoenix", Province: "Arizona", Country: "EUA"}
 		errSeller := customerror.ErrCIDSellerAlreadyExist
 
-		mockSeller.On("Patch", sellerID, &arg).Return(sl, errSeller)
-		mockSeller.On("GetByID", sellerID).Return(sl, errSeller)
-		mockLocality.On("GetByID", localityID).Return(l, nil)
+		mockSeller.On("Patch", testifymock.Anything, sellerID, &arg).Return(sl, errSeller)
+		mockSeller.On("GetByID", testifymock.Anything, sellerID).Return(sl, errSeller)
+		mockLocality.On("GetByID", testifymock.Anything, localityID).Return(l, nil)
+		repoLocality.On("GetByID", testifymock.Anything, localityID).Return(l, nil)
 
-		seller, err := serviceSeller.UpdateSeller(sellerID, &arg)
-		locality, errL := serviceLocality.GetByID(localityID)
+		seller, err := serviceSeller.UpdateSeller(context.Background(), sellerID, &arg)
+		locality, errL := serviceLocality.GetByID(context.Background(), localityID)
 
 		assert.ErrorIs(t, errSeller, err)
 		assert.NoError(t, errL)
@@ -279,10 +291,11 @@ func TestSellersService_UpdateSeller(t *testing.T) {
 		errSeller := customerror.ErrLocalityNotFound
 		errLocality := customerror.ErrLocalityNotFound
 
-		mockLocality.On("GetByID", localityID).Return(l, errLocality)
+		mockLocality.On("GetByID", testifymock.Anything, localityID).Return(l, errLocality)
+		repoLocality.On("GetByID", testifymock.Anything, localityID).Return(l, errLocality)
 
-		seller, err := serviceSeller.UpdateSeller(sellerID, &arg)
-		locality, errL := serviceLocality.GetByID(localityID)
+		seller, err := serviceSeller.UpdateSeller(context.Background(), sellerID, &arg)
+		locality, errL := serviceLocality.GetByID(context.Background(), localityID)
 
 		assert.ErrorIs(t, errSeller, err)
 		assert.ErrorIs(t, errLocality, errL)
@@ -299,12 +312,13 @@ func TestSellersService_UpdateSeller(t *testing.T) {
 		l := model.Locality{ID: 17, Locality: "Denver", Province: "Colorado", Country: "EUA"}
 		errSeller := customerror.ErrMissingSellerID
 
-		mockSeller.On("Patch", sellerID, &arg).Return(sl, errSeller)
-		mockSeller.On("GetByID", sellerID).Return(sl, errSeller)
-		mockLocality.On("GetByID", localityID).Return(l, nil)
+		mockSeller.On("Patch", testifymock.Anything, sellerID, &arg).Return(sl, errSeller)
+		mockSeller.On("GetByID", testifymock.Anything, sellerID).Return(sl, errSeller)
+		mockLocality.On("GetByID", testifymock.Anything, localityID).Return(l, nil)
+		repoLocality.On("GetByID", testifymock.Anything, localityID).Return(l, nil)
 
-		seller, err := serviceSeller.UpdateSeller(sellerID, &arg)
-		locality, errL := serviceLocality.GetByID(localityID)
+		seller, err := serviceSeller.UpdateSeller(context.Background(), sellerID, &arg)
+		locality, errL := serviceLocality.GetByID(context.Background(), localityID)
 
 		assert.ErrorIs(t, errSeller, err)
 		assert.NoError(t, errL)
@@ -319,9 +333,9 @@ func TestSellersService_DeleteSeller(t *testing.T) {
 
 	t.Run("test service method for delete seller with success", func(t *testing.T) {
 		ID := 3
-		mock.On("Delete", ID).Return(nil)
+		mock.On("Delete", testifymock.Anything, ID).Return(nil)
 
-		err := s.DeleteSeller(ID)
+		err := s.DeleteSeller(context.Background(), ID)
 
 		assert.NoError(t, err)
 		mock.AssertExpectations(t)
@@ -330,9 +344,9 @@ func TestSellersService_DeleteSeller(t *testing.T) {
 	t.Run("test service method for delete seller by ID not found", func(t *testing.T) {
 		ID := 999
 		errS := customerror.ErrSellerNotFound
-		mock.On("Delete", ID).Return(errS)
+		mock.On("Delete", testifymock.Anything, ID).Return(errS)
 
-		err := s.DeleteSeller(ID)
+		err := s.DeleteSeller(context.Background(), ID)
 
 		assert.ErrorIs(t, errS, err)
 		mock.AssertExpectations(t)
@@ -341,9 +355,9 @@ func TestSellersService_DeleteSeller(t *testing.T) {
 	t.Run("test service method for delete seller by zero id", func(t *testing.T) {
 		ID := 0
 		errS := customerror.ErrMissingSellerID
-		mock.On("Delete", ID).Return(errS)
+		mock.On("Delete", testifymock.Anything, ID).Return(errS)
 
-		err := s.DeleteSeller(ID)
+		err := s.DeleteSeller(context.Background(), ID)
 
 		assert.ErrorIs(t, errS, err)
 		mock.AssertExpectations(t)
@@ -0,0 +1,202 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+	"github.com/maxwelbm/alkemy-g7.git/internal/repository/interfaces"
+	svc "github.com/maxwelbm/alkemy-g7.git/internal/service/interfaces"
+	"github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
+	"github.com/maxwelbm/alkemy-g7.git/pkg/logger"
+)
+
+type ShipmentService struct {
+	Rp              interfaces.IShipmentRepo
+	RpPurchaseOrder interfaces.IPurchaseOrdersRepo
+	SvcCarrier      svc.ICarrierService
+	Uow             interfaces.IUnitOfWork
+	log             logger.Logger
+}
+
+func NewShipmentService(rp interfaces.IShipmentRepo, rpPurchaseOrder interfaces.IPurchaseOrdersRepo, svcCarrier svc.ICarrierService, uow interfaces.IUnitOfWork, log logger.Logger) *ShipmentService {
+	return &ShipmentService{Rp: rp, RpPurchaseOrder: rpPurchaseOrder, SvcCarrier: svcCarrier, Uow: uow, log: log}
+}
+
+// Create assigns the purchase orders to the carrier under a new tracking code.
+// A purchase order can only be shipped again once its previous shipment has failed, and never once cancelled.
+func (s *ShipmentService) Create(ctx context.Context, newShipment model.ShipmentCreate) (shipment model.Shipment, err error) {
+	s.log.Log("ShipmentService", "INFO", fmt.Sprintf("initializing Create function for carrier %d", newShipment.CarrierID))
+
+	if err = newShipment.Validate(); err != nil {
+		s.log.Log("ShipmentService", "ERROR", fmt.Sprintf("Error: %v", err))
+		return shipment, customerror.HandleError("shipment", customerror.ErrorInvalid, err.Error())
+	}
+
+	if _, err = s.SvcCarrier.GetByID(ctx, newShipment.CarrierID); err != nil {
+		s.log.Log("ShipmentService", "ERROR", fmt.Sprintf("Error: %v", err))
+		return
+	}
+
+	trackingCode, err := model.NewTrackingCode()
+	if err != nil {
+		s.log.Log("ShipmentService", "ERROR", fmt.Sprintf("Error: %v", err))
+		return
+	}
+
+	now := time.Now()
+
+	err = s.Uow.Do(ctx, func(tx *sql.Tx) error {
+		rp := s.Rp.WithTx(tx)
+
+		// the orders stay locked until the shipment is stored, so they cannot be cancelled
+		// or shipped by someone else in between
+		statuses, err := s.RpPurchaseOrder.WithTx(tx).GetStatusesForUpdate(ctx, newShipment.PurchaseOrderIDs)
+		if err != nil {
+			return err
+		}
+
+		for _, id := range newShipment.PurchaseOrderIDs {
+			status, ok := statuses[id]
+			if !ok {
+				return customerror.NewPurcahseOrderError(http.StatusNotFound, customerror.ErrNotFound.Error(), "Purchase Order")
+			}
+
+			if status == model.PurchaseOrderStatusCancelled {
+				return customerror.NewError(http.StatusConflict, fmt.Sprintf("%d is cancelled", id), "purchase order", "")
+			}
+		}
+
+		active, err := rp.CountActiveByPurchaseOrders(ctx, newShipment.PurchaseOrderIDs)
+		if err != nil {
+			return err
+		}
+
+		if active > 0 {
+			return customerror.NewError(http.StatusConflict, "already has an active shipment", "purchase order", "")
+		}
+
+		id, err := rp.Create(ctx, model.Shipment{
+			TrackingCode: trackingCode,
+			CarrierID:    newShipment.CarrierID,
+			Status:       model.ShipmentStatusCreated,
+			CreatedAt:    now,
+			UpdatedAt:    now,
+		})
+		if err != nil {
+			return err
+		}
+
+		if err = rp.AddPurchaseOrders(ctx, id, newShipment.PurchaseOrderIDs, trackingCode); err != nil {
+			return err
+		}
+
+		err = rp.AddEvent(ctx, model.ShipmentEvent{ShipmentID: id, Status: model.ShipmentStatusCreated, Description: "shipment created", OccurredAt: now})
+		if err != nil {
+			return err
+		}
+
+		shipment, err = rp.GetByID(ctx, id)
+
+		return err
+	})
+
+	if err != nil {
+		s.log.Log("ShipmentService", "ERROR", fmt.Sprintf("Error: %v", err))
+		return model.Shipment{}, err
+	}
+
+	s.log.Log("ShipmentService", "INFO", fmt.Sprintf("shipment %d created with tracking code %s", shipment.ID, shipment.TrackingCode))
+
+	return
+}
+
+func (s *ShipmentService) GetByID(ctx context.Context, id int) (shipment model.Shipment, err error) {
+	s.log.Log("ShipmentService", "INFO", fmt.Sprintf("initializing GetByID function for shipment %d", id))
+
+	shipment, err = s.Rp.GetByID(ctx, id)
+	if err != nil {
+		s.log.Log("ShipmentService", "ERROR", fmt.Sprintf("Error: %v", err))
+	}
+
+	return
+}
+
+// UpdateStatus moves the shipment along its lifecycle and records the event.
+func (s *ShipmentService) UpdateStatus(ctx context.Context, id int, update model.ShipmentStatusUpdate) (shipment model.Shipment, err error) {
+	s.log.Log("ShipmentService", "INFO", fmt.Sprintf("initializing UpdateStatus function for shipment %d", id))
+
+	if err = update.Validate(); err != nil {
+		s.log.Log("ShipmentService", "ERROR", fmt.Sprintf("Error: %v", err))
+		return shipment, customerror.HandleError("shipment", customerror.ErrorInvalid, err.Error())
+	}
+
+	now := time.Now()
+
+	err = s.Uow.Do(ctx, func(tx *sql.Tx) error {
+		rp := s.Rp.WithTx(tx)
+
+		// the shipment stays locked until the change is committed, so two concurrent transitions
+		// cannot both start from the status read here
+		current, err := rp.GetByIDForUpdate(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if !model.CanShipmentTransition(current.Status, update.Status) {
+			return customerror.NewError(http.StatusConflict, fmt.Sprintf("cannot change status from %s to %s", current.Status, update.Status), "shipment", "")
+		}
+
+		if err = rp.UpdateStatus(ctx, id, update.Status, now); err != nil {
+			return err
+		}
+
+		err = rp.AddEvent(ctx, model.ShipmentEvent{ShipmentID: id, Status: update.Status, Description: update.Description, OccurredAt: now})
+		if err != nil {
+			return err
+		}
+
+		shipment, err = rp.GetByID(ctx, id)
+
+		return err
+	})
+
+	if err != nil {
+		s.log.Log("ShipmentService", "ERROR", fmt.Sprintf("Error: %v", err))
+		return model.Shipment{}, err
+	}
+
+	s.log.Log("ShipmentService", "INFO", fmt.Sprintf("shipment %d is now %s", id, shipment.Status))
+
+	return
+}
+
+// GetTracking returns the status and events of the latest shipment of the purchase order.
+func (s *ShipmentService) GetTracking(ctx context.Context, purchaseOrderID int) (tracking model.PurchaseOrderTracking, err error) {
+	s.log.Log("ShipmentService", "INFO", fmt.Sprintf("initializing GetTracking function for purchase order %d", purchaseOrderID))
+
+	if _, err = s.RpPurchaseOrder.GetByID(ctx, purchaseOrderID); err != nil {
+		s.log.Log("ShipmentService", "ERROR", fmt.Sprintf("Error: %v", err))
+		return
+	}
+
+	shipment, err := s.Rp.GetLatestByPurchaseOrder(ctx, purchaseOrderID)
+	if err != nil {
+		s.log.Log("ShipmentService", "ERROR", fmt.Sprintf("Error: %v", err))
+		return
+	}
+
+	tracking = model.PurchaseOrderTracking{
+		PurchaseOrderID: purchaseOrderID,
+		ShipmentID:      shipment.ID,
+		TrackingCode:    shipment.TrackingCode,
+		CarrierID:       shipment.CarrierID,
+		Status:          shipment.Status,
+		Events:          shipment.Events,
+	}
+
+	return
+}
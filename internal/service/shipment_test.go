@@ -0,0 +1,213 @@
+package service_test
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/maxwelbm/alkemy-g7.git/internal/mocks"
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+	"github.com/maxwelbm/alkemy-g7.git/internal/service"
+	"github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func setupShipment(t *testing.T) *service.ShipmentService {
+	mockRepo := mocks.NewMockIShipmentRepo(t)
+	mockRepoPO := mocks.NewMockIPurchaseOrdersRepo(t)
+	mockSvcCarrier := mocks.NewMockICarrierService(t)
+	mockUow := mocks.NewMockIUnitOfWork(t)
+
+	mockRepo.On("WithTx", mock.Anything).Return(mockRepo).Maybe()
+	mockRepoPO.On("WithTx", mock.Anything).Return(mockRepoPO).Maybe()
+	mockUow.On("Do", mock.Anything, mock.Anything).Return(func(ctx context.Context, fn func(*sql.Tx) error) error { return fn(nil) }).Maybe()
+
+	return service.NewShipmentService(mockRepo, mockRepoPO, mockSvcCarrier, mockUow, logMock)
+}
+
+func TestShipmentService_Create(t *testing.T) {
+	newShipment := model.ShipmentCreate{CarrierID: 1, PurchaseOrderIDs: []int{1, 2}}
+
+	t.Run("assign the purchase orders to the carrier with a new tracking code", func(t *testing.T) {
+		svc := setupShipment(t)
+		mockRepo := svc.Rp.(*mocks.MockIShipmentRepo)
+		mockRepoPO := svc.RpPurchaseOrder.(*mocks.MockIPurchaseOrdersRepo)
+		mockSvcCarrier := svc.SvcCarrier.(*mocks.MockICarrierService)
+
+		var trackingCode string
+
+		mockSvcCarrier.On("GetByID", mock.Anything, 1).Return(model.Carries{ID: 1}, nil)
+		mockRepoPO.On("GetStatusesForUpdate", mock.Anything, []int{1, 2}).Return(map[int]string{1: model.PurchaseOrderStatusPending, 2: model.PurchaseOrderStatusPending}, nil)
+		mockRepo.On("CountActiveByPurchaseOrders", mock.Anything, []int{1, 2}).Return(0, nil)
+		mockRepo.On("Create", mock.Anything, mock.MatchedBy(func(s model.Shipment) bool {
+			trackingCode = s.TrackingCode
+			return s.CarrierID == 1 && s.Status == model.ShipmentStatusCreated && len(s.TrackingCode) == model.TrackingCodeLength
+		})).Return(5, nil)
+		mockRepo.On("AddPurchaseOrders", mock.Anything, 5, []int{1, 2}, mock.AnythingOfType("string")).Return(nil)
+		mockRepo.On("AddEvent", mock.Anything, mock.MatchedBy(func(e model.ShipmentEvent) bool {
+			return e.ShipmentID == 5 && e.Status == model.ShipmentStatusCreated
+		})).Return(nil)
+		mockRepo.On("GetByID", mock.Anything, 5).Return(model.Shipment{ID: 5, CarrierID: 1, Status: model.ShipmentStatusCreated, PurchaseOrderIDs: []int{1, 2}}, nil)
+
+		shipment, err := svc.Create(context.Background(), newShipment)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 5, shipment.ID)
+		mockRepo.AssertCalled(t, "AddPurchaseOrders", mock.Anything, 5, []int{1, 2}, trackingCode)
+	})
+
+	t.Run("return error for invalid input", func(t *testing.T) {
+		svc := setupShipment(t)
+
+		_, err := svc.Create(context.Background(), model.ShipmentCreate{PurchaseOrderIDs: []int{1, 1}})
+
+		assert.Equal(t, customerror.HandleError("shipment", customerror.ErrorInvalid,
+			"validation errors: carrier_id is required; purchase order 1 is repeated"), err)
+	})
+
+	t.Run("return error for an unknown carrier", func(t *testing.T) {
+		svc := setupShipment(t)
+		expectedErr := customerror.NewCarrierError(customerror.ErrNotFound.Error(), "carrier", http.StatusNotFound)
+
+		svc.SvcCarrier.(*mocks.MockICarrierService).On("GetByID", mock.Anything, 1).Return(model.Carries{}, expectedErr)
+
+		_, err := svc.Create(context.Background(), newShipment)
+
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("return error for an unknown purchase order", func(t *testing.T) {
+		svc := setupShipment(t)
+		expectedErr := customerror.NewPurcahseOrderError(http.StatusNotFound, customerror.ErrNotFound.Error(), "Purchase Order")
+
+		svc.SvcCarrier.(*mocks.MockICarrierService).On("GetByID", mock.Anything, 1).Return(model.Carries{ID: 1}, nil)
+		svc.RpPurchaseOrder.(*mocks.MockIPurchaseOrdersRepo).On("GetStatusesForUpdate", mock.Anything, []int{1, 2}).Return(map[int]string{2: model.PurchaseOrderStatusPending}, nil)
+
+		_, err := svc.Create(context.Background(), newShipment)
+
+		assert.Equal(t, expectedErr, err)
+		svc.Rp.(*mocks.MockIShipmentRepo).AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("return conflict for a cancelled purchase order", func(t *testing.T) {
+		svc := setupShipment(t)
+
+		svc.SvcCarrier.(*mocks.MockICarrierService).On("GetByID", mock.Anything, 1).Return(model.Carries{ID: 1}, nil)
+		svc.RpPurchaseOrder.(*mocks.MockIPurchaseOrdersRepo).On("GetStatusesForUpdate", mock.Anything, []int{1, 2}).Return(map[int]string{1: model.PurchaseOrderStatusCancelled, 2: model.PurchaseOrderStatusPending}, nil)
+
+		_, err := svc.Create(context.Background(), newShipment)
+
+		assert.Equal(t, customerror.NewError(http.StatusConflict, "1 is cancelled", "purchase order", ""), err)
+		svc.Rp.(*mocks.MockIShipmentRepo).AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("return conflict when a purchase order is already being shipped", func(t *testing.T) {
+		svc := setupShipment(t)
+		mockRepo := svc.Rp.(*mocks.MockIShipmentRepo)
+		mockRepoPO := svc.RpPurchaseOrder.(*mocks.MockIPurchaseOrdersRepo)
+
+		svc.SvcCarrier.(*mocks.MockICarrierService).On("GetByID", mock.Anything, 1).Return(model.Carries{ID: 1}, nil)
+		mockRepoPO.On("GetStatusesForUpdate", mock.Anything, []int{1, 2}).Return(map[int]string{1: model.PurchaseOrderStatusPending, 2: model.PurchaseOrderStatusPending}, nil)
+		mockRepo.On("CountActiveByPurchaseOrders", mock.Anything, []int{1, 2}).Return(1, nil)
+
+		_, err := svc.Create(context.Background(), newShipment)
+
+		assert.Equal(t, customerror.NewError(http.StatusConflict, "already has an active shipment", "purchase order", ""), err)
+		mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestShipmentService_UpdateStatus(t *testing.T) {
+	t.Run("move the shipment to the next status and record the event", func(t *testing.T) {
+		svc := setupShipment(t)
+		mockRepo := svc.Rp.(*mocks.MockIShipmentRepo)
+
+		mockRepo.On("GetByIDForUpdate", mock.Anything, 1).Return(model.Shipment{ID: 1, Status: model.ShipmentStatusPicked}, nil).Once()
+		mockRepo.On("UpdateStatus", mock.Anything, 1, model.ShipmentStatusInTransit, mock.AnythingOfType("time.Time")).Return(nil)
+		mockRepo.On("AddEvent", mock.Anything, mock.MatchedBy(func(e model.ShipmentEvent) bool {
+			return e.ShipmentID == 1 && e.Status == model.ShipmentStatusInTransit && e.Description == "left the warehouse"
+		})).Return(nil)
+		mockRepo.On("GetByID", mock.Anything, 1).Return(model.Shipment{ID: 1, Status: model.ShipmentStatusInTransit}, nil).Once()
+
+		shipment, err := svc.UpdateStatus(context.Background(), 1, model.ShipmentStatusUpdate{Status: model.ShipmentStatusInTransit, Description: "left the warehouse"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, model.ShipmentStatusInTransit, shipment.Status)
+	})
+
+	t.Run("return conflict for a transition not allowed", func(t *testing.T) {
+		svc := setupShipment(t)
+		mockRepo := svc.Rp.(*mocks.MockIShipmentRepo)
+
+		mockRepo.On("GetByIDForUpdate", mock.Anything, 1).Return(model.Shipment{ID: 1, Status: model.ShipmentStatusCreated}, nil)
+
+		_, err := svc.UpdateStatus(context.Background(), 1, model.ShipmentStatusUpdate{Status: model.ShipmentStatusDelivered})
+
+		assert.Equal(t, customerror.NewError(http.StatusConflict, "cannot change status from created to delivered", "shipment", ""), err)
+		mockRepo.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("return conflict once the shipment is delivered", func(t *testing.T) {
+		svc := setupShipment(t)
+
+		svc.Rp.(*mocks.MockIShipmentRepo).On("GetByIDForUpdate", mock.Anything, 1).Return(model.Shipment{ID: 1, Status: model.ShipmentStatusDelivered}, nil)
+
+		_, err := svc.UpdateStatus(context.Background(), 1, model.ShipmentStatusUpdate{Status: model.ShipmentStatusFailed})
+
+		assert.ErrorContains(t, err, "cannot change status from delivered to failed")
+	})
+
+	t.Run("return error for an unknown status", func(t *testing.T) {
+		svc := setupShipment(t)
+
+		_, err := svc.UpdateStatus(context.Background(), 1, model.ShipmentStatusUpdate{Status: "lost"})
+
+		assert.ErrorContains(t, err, "status must be one of picked, in_transit, delivered or failed")
+	})
+
+	t.Run("return not found for an unknown shipment", func(t *testing.T) {
+		svc := setupShipment(t)
+		expectedErr := customerror.HandleError("shipment", customerror.ErrorNotFound, "")
+
+		svc.Rp.(*mocks.MockIShipmentRepo).On("GetByIDForUpdate", mock.Anything, 99).Return(model.Shipment{}, expectedErr)
+
+		_, err := svc.UpdateStatus(context.Background(), 99, model.ShipmentStatusUpdate{Status: model.ShipmentStatusPicked})
+
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestShipmentService_GetTracking(t *testing.T) {
+	occurredAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	t.Run("return the tracking of the latest shipment of the purchase order", func(t *testing.T) {
+		svc := setupShipment(t)
+		events := []model.ShipmentEvent{{ID: 1, ShipmentID: 3, Status: model.ShipmentStatusCreated, OccurredAt: occurredAt}}
+
+		svc.RpPurchaseOrder.(*mocks.MockIPurchaseOrdersRepo).On("GetByID", mock.Anything, 1).Return(model.PurchaseOrder{ID: 1}, nil)
+		svc.Rp.(*mocks.MockIShipmentRepo).On("GetLatestByPurchaseOrder", mock.Anything, 1).
+			Return(model.Shipment{ID: 3, TrackingCode: "ABCDEFGH2345", CarrierID: 2, Status: model.ShipmentStatusCreated, Events: events}, nil)
+
+		tracking, err := svc.GetTracking(context.Background(), 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, model.PurchaseOrderTracking{
+			PurchaseOrderID: 1, ShipmentID: 3, TrackingCode: "ABCDEFGH2345", CarrierID: 2, Status: model.ShipmentStatusCreated, Events: events,
+		}, tracking)
+	})
+
+	t.Run("return not found for a purchase order never shipped", func(t *testing.T) {
+		svc := setupShipment(t)
+		expectedErr := customerror.HandleError("shipment", customerror.ErrorNotFound, "")
+
+		svc.RpPurchaseOrder.(*mocks.MockIPurchaseOrdersRepo).On("GetByID", mock.Anything, 1).Return(model.PurchaseOrder{ID: 1}, nil)
+		svc.Rp.(*mocks.MockIShipmentRepo).On("GetLatestByPurchaseOrder", mock.Anything, 1).Return(model.Shipment{}, expectedErr)
+
+		_, err := svc.GetTracking(context.Background(), 1)
+
+		assert.Equal(t, expectedErr, err)
+	})
+}
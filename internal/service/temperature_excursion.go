@@ -0,0 +1,232 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+	"github.com/maxwelbm/alkemy-g7.git/internal/repository/interfaces"
+	"github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
+	"github.com/maxwelbm/alkemy-g7.git/pkg/logger"
+)
+
+type TemperatureExcursionService struct {
+	Rp    interfaces.ITemperatureExcursionRepo
+	RpSec interfaces.ISectionRepo
+	Uow   interfaces.IUnitOfWork
+	log   logger.Logger
+}
+
+func NewTemperatureExcursionService(rp interfaces.ITemperatureExcursionRepo, rpSec interfaces.ISectionRepo, uow interfaces.IUnitOfWork, log logger.Logger) *TemperatureExcursionService {
+	return &TemperatureExcursionService{Rp: rp, RpSec: rpSec, Uow: uow, log: log}
+}
+
+// EvaluateSection checks every batch stored in the section against the readings, in chronological order,
+// opening, worsening or closing their excursions. Readings taken before a batch's open excursion started are
+// ignored. Excursions of batches no longer in the section are closed.
+func (s *TemperatureExcursionService) EvaluateSection(ctx context.Context, sectionID int, readings []model.TemperatureReading) (err error) {
+	s.log.Log("TemperatureExcursionService", "INFO", fmt.Sprintf("initializing EvaluateSection function with %d readings for section %d", len(readings), sectionID))
+
+	if len(readings) == 0 {
+		return
+	}
+
+	sorted := make([]model.TemperatureReading, len(readings))
+	copy(sorted, readings)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].RecordedAt.Before(sorted[j].RecordedAt) })
+
+	err = s.Uow.Do(ctx, func(tx *sql.Tx) error {
+		rp := s.Rp.WithTx(tx)
+
+		limits, err := rp.GetBatchLimits(ctx, sectionID)
+		if err != nil {
+			return err
+		}
+
+		open, err := s.openByBatch(ctx, rp, sectionID)
+		if err != nil {
+			return err
+		}
+
+		for _, reading := range sorted {
+			for _, l := range limits {
+				if err = s.evaluate(ctx, rp, l, open, reading.Temperature, reading.RecordedAt); err != nil {
+					return err
+				}
+			}
+		}
+
+		stored := make(map[int]bool, len(limits))
+		for _, l := range limits {
+			stored[l.BatchID] = true
+		}
+
+		last := sorted[len(sorted)-1].RecordedAt
+
+		for batchID, excursion := range open {
+			if stored[batchID] {
+				continue
+			}
+
+			if err = rp.Close(ctx, excursion.ID, last); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		s.log.Log("TemperatureExcursionService", "ERROR", fmt.Sprintf("Error: %v", err))
+	}
+
+	return
+}
+
+// EvaluateBatch checks a single batch against the temperature it is kept at.
+func (s *TemperatureExcursionService) EvaluateBatch(ctx context.Context, batchID int, temperature float64, at time.Time) (err error) {
+	s.log.Log("TemperatureExcursionService", "INFO", fmt.Sprintf("initializing EvaluateBatch function for batch %d", batchID))
+
+	err = s.Uow.Do(ctx, func(tx *sql.Tx) error {
+		rp := s.Rp.WithTx(tx)
+
+		limits, err := rp.GetBatchLimitsByID(ctx, batchID)
+		if err != nil {
+			return err
+		}
+
+		open, err := s.openByBatch(ctx, rp, limits.SectionID)
+		if err != nil {
+			return err
+		}
+
+		return s.evaluate(ctx, rp, limits, open, temperature, at)
+	})
+
+	if err != nil {
+		s.log.Log("TemperatureExcursionService", "ERROR", fmt.Sprintf("Error: %v", err))
+	}
+
+	return
+}
+
+func (s *TemperatureExcursionService) GetBySection(ctx context.Context, sectionID int, status string) (excursions []model.TemperatureExcursion, err error) {
+	s.log.Log("TemperatureExcursionService", "INFO", fmt.Sprintf("initializing GetBySection function for section %d", sectionID))
+
+	if status != "" && status != model.ExcursionStatusOpen && status != model.ExcursionStatusClosed {
+		err = customerror.HandleError("temperature excursion", customerror.ErrorInvalid, "status must be open or closed")
+		s.log.Log("TemperatureExcursionService", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	if _, err = s.RpSec.GetByID(ctx, sectionID); err != nil {
+		s.log.Log("TemperatureExcursionService", "ERROR", fmt.Sprintf("Error: %v", err))
+		return
+	}
+
+	excursions, err = s.Rp.GetBySection(ctx, sectionID, status)
+	if err != nil {
+		s.log.Log("TemperatureExcursionService", "ERROR", fmt.Sprintf("Error: %v", err))
+		return nil, err
+	}
+
+	return
+}
+
+func (s *TemperatureExcursionService) GetReport(ctx context.Context, filter model.ExcursionReportFilter) (report []model.SectionExcursionReport, err error) {
+	s.log.Log("TemperatureExcursionService", "INFO", "initializing GetReport function")
+
+	if !filter.From.IsZero() && !filter.To.IsZero() && !filter.From.Before(filter.To) {
+		err = customerror.HandleError("temperature excursion", customerror.ErrorInvalid, "from must be before to")
+		s.log.Log("TemperatureExcursionService", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	report, err = s.Rp.GetReport(ctx, filter)
+	if err != nil {
+		s.log.Log("TemperatureExcursionService", "ERROR", fmt.Sprintf("Error: %v", err))
+		return nil, err
+	}
+
+	return
+}
+
+func (s *TemperatureExcursionService) openByBatch(ctx context.Context, rp interfaces.ITemperatureExcursionRepo, sectionID int) (map[int]model.TemperatureExcursion, error) {
+	excursions, err := rp.GetOpen(ctx, sectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	open := make(map[int]model.TemperatureExcursion, len(excursions))
+	for _, e := range excursions {
+		open[e.ProductBatchID] = e
+	}
+
+	return open, nil
+}
+
+// evaluate applies one temperature to a batch, keeping open up to date with the batch's open excursion.
+func (s *TemperatureExcursionService) evaluate(ctx context.Context, rp interfaces.ITemperatureExcursionRepo, l model.BatchTemperatureLimits, open map[int]model.TemperatureExcursion, temperature float64, at time.Time) error {
+	current, isOpen := open[l.BatchID]
+
+	// a reading taken before the open excursion started cannot close nor replace it
+	if isOpen && at.Before(current.StartedAt) {
+		return nil
+	}
+
+	kind, limit := l.Excursion(temperature)
+
+	if isOpen && current.Kind == kind {
+		if !current.IsWorse(temperature) {
+			return nil
+		}
+
+		if err := rp.UpdatePeak(ctx, current.ID, temperature); err != nil {
+			return err
+		}
+
+		current.PeakTemperature = temperature
+		open[l.BatchID] = current
+
+		return nil
+	}
+
+	if isOpen {
+		if err := rp.Close(ctx, current.ID, at); err != nil {
+			return err
+		}
+
+		delete(open, l.BatchID)
+		s.log.Log("TemperatureExcursionService", "INFO", fmt.Sprintf("temperature excursion %d of batch %d closed", current.ID, l.BatchID))
+	}
+
+	if kind == "" {
+		return nil
+	}
+
+	excursion := model.TemperatureExcursion{
+		SectionID:        l.SectionID,
+		ProductBatchID:   l.BatchID,
+		Kind:             kind,
+		LimitTemperature: limit,
+		PeakTemperature:  temperature,
+		StartedAt:        at,
+	}
+
+	id, err := rp.Open(ctx, excursion)
+	if err != nil {
+		return err
+	}
+
+	excursion.ID = id
+	open[l.BatchID] = excursion
+
+	s.log.Log("TemperatureExcursionService", "WARN", fmt.Sprintf("temperature excursion %d opened: batch %d at %.2f is %s (limit %.2f)", id, l.BatchID, temperature, kind, limit))
+
+	return nil
+}
@@ -0,0 +1,248 @@
+package service_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/maxwelbm/alkemy-g7.git/internal/mocks"
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+	"github.com/maxwelbm/alkemy-g7.git/internal/service"
+	"github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func setupTemperatureExcursion(t *testing.T) *service.TemperatureExcursionService {
+	mockRepo := mocks.NewMockITemperatureExcursionRepo(t)
+	mockRepoSec := mocks.NewMockISectionRepo(t)
+	mockUow := mocks.NewMockIUnitOfWork(t)
+
+	mockRepo.On("WithTx", mock.Anything).Return(mockRepo).Maybe()
+	mockUow.On("Do", mock.Anything, mock.Anything).Return(func(ctx context.Context, fn func(*sql.Tx) error) error { return fn(nil) }).Maybe()
+
+	return service.NewTemperatureExcursionService(mockRepo, mockRepoSec, mockUow, logMock)
+}
+
+func TestTemperatureExcursionService_EvaluateSection(t *testing.T) {
+	at := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	limits := []model.BatchTemperatureLimits{
+		{BatchID: 1, SectionID: 1, MinimumTemperature: -20, MaximumTemperature: -15},
+		{BatchID: 2, SectionID: 1, MinimumTemperature: -25, MaximumTemperature: -10},
+	}
+
+	t.Run("open an excursion for each batch outside its limits and keep the peak", func(t *testing.T) {
+		svc := setupTemperatureExcursion(t)
+		mockRepo := svc.Rp.(*mocks.MockITemperatureExcursionRepo)
+
+		mockRepo.On("GetBatchLimits", mock.Anything, 1).Return(limits, nil)
+		mockRepo.On("GetOpen", mock.Anything, 1).Return([]model.TemperatureExcursion{}, nil)
+		mockRepo.On("Open", mock.Anything, model.TemperatureExcursion{
+			SectionID: 1, ProductBatchID: 1, Kind: model.ExcursionAboveMaximum, LimitTemperature: -15, PeakTemperature: -12, StartedAt: at,
+		}).Return(7, nil).Once()
+		mockRepo.On("Open", mock.Anything, model.TemperatureExcursion{
+			SectionID: 1, ProductBatchID: 2, Kind: model.ExcursionAboveMaximum, LimitTemperature: -10, PeakTemperature: -8, StartedAt: at.Add(time.Minute),
+		}).Return(8, nil).Once()
+		mockRepo.On("UpdatePeak", mock.Anything, 7, -8.0).Return(nil).Once()
+
+		// Sent out of order: the warmer reading is the later one.
+		err := svc.EvaluateSection(context.Background(), 1, []model.TemperatureReading{
+			{Temperature: -8, RecordedAt: at.Add(time.Minute)},
+			{Temperature: -12, RecordedAt: at},
+		})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("close the excursion when the temperature is back within the limits", func(t *testing.T) {
+		svc := setupTemperatureExcursion(t)
+		mockRepo := svc.Rp.(*mocks.MockITemperatureExcursionRepo)
+
+		mockRepo.On("GetBatchLimits", mock.Anything, 1).Return(limits, nil)
+		mockRepo.On("GetOpen", mock.Anything, 1).Return([]model.TemperatureExcursion{
+			{ID: 7, SectionID: 1, ProductBatchID: 1, Kind: model.ExcursionAboveMaximum, LimitTemperature: -15, PeakTemperature: -12, StartedAt: at},
+		}, nil)
+		mockRepo.On("Close", mock.Anything, 7, at.Add(time.Hour)).Return(nil).Once()
+
+		err := svc.EvaluateSection(context.Background(), 1, []model.TemperatureReading{{Temperature: -18, RecordedAt: at.Add(time.Hour)}})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("replace the excursion when the batch goes to the other side of its limits", func(t *testing.T) {
+		svc := setupTemperatureExcursion(t)
+		mockRepo := svc.Rp.(*mocks.MockITemperatureExcursionRepo)
+
+		mockRepo.On("GetBatchLimits", mock.Anything, 1).Return(limits[:1], nil)
+		mockRepo.On("GetOpen", mock.Anything, 1).Return([]model.TemperatureExcursion{
+			{ID: 7, SectionID: 1, ProductBatchID: 1, Kind: model.ExcursionAboveMaximum, LimitTemperature: -15, PeakTemperature: -12, StartedAt: at},
+		}, nil)
+		mockRepo.On("Close", mock.Anything, 7, at.Add(time.Hour)).Return(nil).Once()
+		mockRepo.On("Open", mock.Anything, model.TemperatureExcursion{
+			SectionID: 1, ProductBatchID: 1, Kind: model.ExcursionBelowMinimum, LimitTemperature: -20, PeakTemperature: -22, StartedAt: at.Add(time.Hour),
+		}).Return(9, nil).Once()
+
+		err := svc.EvaluateSection(context.Background(), 1, []model.TemperatureReading{{Temperature: -22, RecordedAt: at.Add(time.Hour)}})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("ignore readings taken before the open excursion started", func(t *testing.T) {
+		svc := setupTemperatureExcursion(t)
+		mockRepo := svc.Rp.(*mocks.MockITemperatureExcursionRepo)
+
+		mockRepo.On("GetBatchLimits", mock.Anything, 1).Return(limits[:1], nil)
+		mockRepo.On("GetOpen", mock.Anything, 1).Return([]model.TemperatureExcursion{
+			{ID: 7, SectionID: 1, ProductBatchID: 1, Kind: model.ExcursionAboveMaximum, LimitTemperature: -15, PeakTemperature: -12, StartedAt: at},
+		}, nil)
+		mockRepo.On("UpdatePeak", mock.Anything, 7, -11.0).Return(nil).Once()
+
+		// The in range and the colder readings arrive late, the warmer one is newer than the excursion.
+		err := svc.EvaluateSection(context.Background(), 1, []model.TemperatureReading{
+			{Temperature: -18, RecordedAt: at.Add(-time.Minute)},
+			{Temperature: -11, RecordedAt: at.Add(time.Minute)},
+			{Temperature: -30, RecordedAt: at.Add(-2 * time.Minute)},
+		})
+
+		assert.NoError(t, err)
+		mockRepo.AssertNotCalled(t, "Close", mock.Anything, mock.Anything, mock.Anything)
+		mockRepo.AssertNotCalled(t, "Open", mock.Anything, mock.Anything)
+	})
+
+	t.Run("close the excursions of batches no longer stored in the section", func(t *testing.T) {
+		svc := setupTemperatureExcursion(t)
+		mockRepo := svc.Rp.(*mocks.MockITemperatureExcursionRepo)
+
+		mockRepo.On("GetBatchLimits", mock.Anything, 1).Return([]model.BatchTemperatureLimits{}, nil)
+		mockRepo.On("GetOpen", mock.Anything, 1).Return([]model.TemperatureExcursion{
+			{ID: 7, SectionID: 1, ProductBatchID: 3, Kind: model.ExcursionAboveMaximum, PeakTemperature: -12, StartedAt: at},
+		}, nil)
+		mockRepo.On("Close", mock.Anything, 7, at.Add(time.Hour)).Return(nil).Once()
+
+		err := svc.EvaluateSection(context.Background(), 1, []model.TemperatureReading{{Temperature: -12, RecordedAt: at.Add(time.Hour)}})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("return error when the incidents cannot be stored", func(t *testing.T) {
+		svc := setupTemperatureExcursion(t)
+		mockRepo := svc.Rp.(*mocks.MockITemperatureExcursionRepo)
+
+		mockRepo.On("GetBatchLimits", mock.Anything, 1).Return(limits[:1], nil)
+		mockRepo.On("GetOpen", mock.Anything, 1).Return([]model.TemperatureExcursion{}, nil)
+		mockRepo.On("Open", mock.Anything, mock.Anything).Return(0, errors.New("unmapped error"))
+
+		err := svc.EvaluateSection(context.Background(), 1, []model.TemperatureReading{{Temperature: -12, RecordedAt: at}})
+
+		assert.Error(t, err)
+	})
+}
+
+func TestTemperatureExcursionService_EvaluateBatch(t *testing.T) {
+	at := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	t.Run("open an excursion for a batch kept too warm", func(t *testing.T) {
+		svc := setupTemperatureExcursion(t)
+		mockRepo := svc.Rp.(*mocks.MockITemperatureExcursionRepo)
+
+		mockRepo.On("GetBatchLimitsByID", mock.Anything, 1).Return(model.BatchTemperatureLimits{BatchID: 1, SectionID: 2, MinimumTemperature: -20, MaximumTemperature: -15}, nil)
+		mockRepo.On("GetOpen", mock.Anything, 2).Return([]model.TemperatureExcursion{}, nil)
+		mockRepo.On("Open", mock.Anything, model.TemperatureExcursion{
+			SectionID: 2, ProductBatchID: 1, Kind: model.ExcursionAboveMaximum, LimitTemperature: -15, PeakTemperature: -5, StartedAt: at,
+		}).Return(1, nil).Once()
+
+		err := svc.EvaluateBatch(context.Background(), 1, -5, at)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("do nothing for a batch within its limits", func(t *testing.T) {
+		svc := setupTemperatureExcursion(t)
+		mockRepo := svc.Rp.(*mocks.MockITemperatureExcursionRepo)
+
+		mockRepo.On("GetBatchLimitsByID", mock.Anything, 1).Return(model.BatchTemperatureLimits{BatchID: 1, SectionID: 2, MinimumTemperature: -20, MaximumTemperature: -15}, nil)
+		mockRepo.On("GetOpen", mock.Anything, 2).Return([]model.TemperatureExcursion{}, nil)
+
+		err := svc.EvaluateBatch(context.Background(), 1, -18, at)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("return error for an unknown batch", func(t *testing.T) {
+		svc := setupTemperatureExcursion(t)
+		mockRepo := svc.Rp.(*mocks.MockITemperatureExcursionRepo)
+
+		mockRepo.On("GetBatchLimitsByID", mock.Anything, 99).Return(model.BatchTemperatureLimits{}, customerror.HandleError("product batches", customerror.ErrorNotFound, ""))
+
+		err := svc.EvaluateBatch(context.Background(), 99, -18, at)
+
+		assert.ErrorContains(t, err, "product batches not found")
+	})
+}
+
+func TestTemperatureExcursionService_GetBySection(t *testing.T) {
+	t.Run("return the excursions of the section", func(t *testing.T) {
+		svc := setupTemperatureExcursion(t)
+		mockRepo := svc.Rp.(*mocks.MockITemperatureExcursionRepo)
+		mockRepoSec := svc.RpSec.(*mocks.MockISectionRepo)
+
+		expected := []model.TemperatureExcursion{{ID: 1, SectionID: 1, ProductBatchID: 1, Kind: model.ExcursionAboveMaximum}}
+		mockRepoSec.On("GetByID", mock.Anything, 1).Return(model.Section{ID: 1}, nil)
+		mockRepo.On("GetBySection", mock.Anything, 1, model.ExcursionStatusOpen).Return(expected, nil)
+
+		excursions, err := svc.GetBySection(context.Background(), 1, model.ExcursionStatusOpen)
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, excursions)
+	})
+
+	t.Run("return error for an invalid status", func(t *testing.T) {
+		svc := setupTemperatureExcursion(t)
+
+		excursions, err := svc.GetBySection(context.Background(), 1, "pending")
+
+		assert.Nil(t, excursions)
+		assert.ErrorContains(t, err, "status must be open or closed")
+	})
+
+	t.Run("return error for an unknown section", func(t *testing.T) {
+		svc := setupTemperatureExcursion(t)
+		mockRepoSec := svc.RpSec.(*mocks.MockISectionRepo)
+
+		mockRepoSec.On("GetByID", mock.Anything, 99).Return(model.Section{}, customerror.HandleError("section", customerror.ErrorNotFound, ""))
+
+		excursions, err := svc.GetBySection(context.Background(), 99, "")
+
+		assert.Nil(t, excursions)
+		assert.ErrorContains(t, err, "section not found")
+	})
+}
+
+func TestTemperatureExcursionService_GetReport(t *testing.T) {
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("return the report of the period", func(t *testing.T) {
+		svc := setupTemperatureExcursion(t)
+		mockRepo := svc.Rp.(*mocks.MockITemperatureExcursionRepo)
+
+		filter := model.ExcursionReportFilter{From: from, To: from.Add(24 * time.Hour)}
+		expected := []model.SectionExcursionReport{{SectionID: 1, SectionNumber: "S01", WarehouseID: 1, IncidentsCount: 2, OpenCount: 1, TotalDurationMinutes: 90, LastStartedAt: from}}
+		mockRepo.On("GetReport", mock.Anything, filter).Return(expected, nil)
+
+		report, err := svc.GetReport(context.Background(), filter)
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, report)
+	})
+
+	t.Run("return error when from is not before to", func(t *testing.T) {
+		svc := setupTemperatureExcursion(t)
+
+		report, err := svc.GetReport(context.Background(), model.ExcursionReportFilter{From: from, To: from})
+
+		assert.Nil(t, report)
+		assert.ErrorContains(t, err, "from must be before to")
+	})
+}
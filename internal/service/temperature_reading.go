@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+	"github.com/maxwelbm/alkemy-g7.git/internal/repository/interfaces"
+	svc "github.com/maxwelbm/alkemy-g7.git/internal/service/interfaces"
+	"github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
+	"github.com/maxwelbm/alkemy-g7.git/pkg/logger"
+)
+
+type TemperatureReadingService struct {
+	Rp     interfaces.ITemperatureReadingRepo
+	RpSec  interfaces.ISectionRepo
+	Uow    interfaces.IUnitOfWork
+	SvcExc svc.ITemperatureExcursionService
+	log    logger.Logger
+}
+
+func NewTemperatureReadingService(rp interfaces.ITemperatureReadingRepo, rpSec interfaces.ISectionRepo, uow interfaces.IUnitOfWork, svcExc svc.ITemperatureExcursionService, log logger.Logger) *TemperatureReadingService {
+	return &TemperatureReadingService{Rp: rp, RpSec: rpSec, Uow: uow, SvcExc: svcExc, log: log}
+}
+
+// Ingest stores the sensor readings of a section and refreshes its current temperature in the same transaction.
+func (s *TemperatureReadingService) Ingest(ctx context.Context, sectionID int, readings []model.TemperatureReading) (result model.TemperatureIngestResult, err error) {
+	s.log.Log("TemperatureReadingService", "INFO", fmt.Sprintf("initializing Ingest function with %d readings for section %d", len(readings), sectionID))
+
+	if err = model.ValidateReadings(readings, time.Now()); err != nil {
+		s.log.Log("TemperatureReadingService", "ERROR", fmt.Sprintf("Error: %v", err))
+		return result, customerror.HandleError("temperature reading", customerror.ErrorInvalid, err.Error())
+	}
+
+	if _, err = s.RpSec.GetByID(ctx, sectionID); err != nil {
+		s.log.Log("TemperatureReadingService", "ERROR", fmt.Sprintf("Error: %v", err))
+		return
+	}
+
+	var latest time.Time
+
+	err = s.Uow.Do(ctx, func(tx *sql.Tx) error {
+		rp := s.Rp.WithTx(tx)
+
+		stored, err := rp.GetLatestRecordedAt(ctx, sectionID)
+		if err != nil {
+			return err
+		}
+
+		latest = stored
+
+		inserted, err := rp.BulkInsert(ctx, sectionID, readings)
+		if err != nil {
+			return err
+		}
+
+		result = model.TemperatureIngestResult{SectionID: sectionID, Inserted: inserted}
+
+		return s.RpSec.WithTx(tx).SyncCurrentTemperature(ctx, sectionID)
+	})
+
+	if err != nil {
+		s.log.Log("TemperatureReadingService", "ERROR", fmt.Sprintf("Error: %v", err))
+		return model.TemperatureIngestResult{}, err
+	}
+
+	// The readings are already stored, so a failure to evaluate them is only logged. Readings older than the
+	// ones already stored arrived late: the excursions were evaluated past them and are not reopened nor closed.
+	if excErr := s.SvcExc.EvaluateSection(ctx, sectionID, readingsSince(readings, latest)); excErr != nil {
+		s.log.Log("TemperatureReadingService", "ERROR", fmt.Sprintf("unable to evaluate temperature excursions: %v", excErr))
+	}
+
+	s.log.Log("TemperatureReadingService", "INFO", fmt.Sprintf("temperature readings ingested: %v", result))
+
+	return
+}
+
+func (s *TemperatureReadingService) GetAggregates(ctx context.Context, query model.TemperatureQuery) (aggregates []model.TemperatureAggregate, err error) {
+	s.log.Log("TemperatureReadingService", "INFO", fmt.Sprintf("initializing GetAggregates function for section %d", query.SectionID))
+
+	if err = query.Validate(); err != nil {
+		s.log.Log("TemperatureReadingService", "ERROR", fmt.Sprintf("Error: %v", err))
+		return nil, customerror.HandleError("temperature reading", customerror.ErrorInvalid, err.Error())
+	}
+
+	if _, err = s.RpSec.GetByID(ctx, query.SectionID); err != nil {
+		s.log.Log("TemperatureReadingService", "ERROR", fmt.Sprintf("Error: %v", err))
+		return
+	}
+
+	aggregates, err = s.Rp.GetAggregates(ctx, query)
+	if err != nil {
+		s.log.Log("TemperatureReadingService", "ERROR", fmt.Sprintf("Error: %v", err))
+		return nil, err
+	}
+
+	return
+}
+
+func readingsSince(readings []model.TemperatureReading, since time.Time) []model.TemperatureReading {
+	recent := make([]model.TemperatureReading, 0, len(readings))
+
+	for _, reading := range readings {
+		if !reading.RecordedAt.Before(since) {
+			recent = append(recent, reading)
+		}
+	}
+
+	return recent
+}
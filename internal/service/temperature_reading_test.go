@@ -0,0 +1,177 @@
+package service_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/maxwelbm/alkemy-g7.git/internal/mocks"
+	"github.com/maxwelbm/alkemy-g7.git/internal/model"
+	"github.com/maxwelbm/alkemy-g7.git/internal/service"
+	"github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func setupTemperatureReading(t *testing.T) *service.TemperatureReadingService {
+	mockRepo := mocks.NewMockITemperatureReadingRepo(t)
+	mockRepoSec := mocks.NewMockISectionRepo(t)
+	mockUow := mocks.NewMockIUnitOfWork(t)
+	mockSvcExc := mocks.NewMockITemperatureExcursionService(t)
+
+	mockRepo.On("WithTx", mock.Anything).Return(mockRepo).Maybe()
+	mockRepoSec.On("WithTx", mock.Anything).Return(mockRepoSec).Maybe()
+	mockUow.On("Do", mock.Anything, mock.Anything).Return(func(ctx context.Context, fn func(*sql.Tx) error) error { return fn(nil) }).Maybe()
+	mockSvcExc.On("EvaluateSection", mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	return service.NewTemperatureReadingService(mockRepo, mockRepoSec, mockUow, mockSvcExc, logMock)
+}
+
+func TestTemperatureReadingService_Ingest(t *testing.T) {
+	readings := []model.TemperatureReading{
+		{SensorID: "A1", Temperature: -18.5, RecordedAt: time.Now().Add(-time.Hour)},
+		{SensorID: "A1", Temperature: -18.0, RecordedAt: time.Now().Add(-30 * time.Minute)},
+	}
+
+	t.Run("store the readings and refresh the section current temperature", func(t *testing.T) {
+		svc := setupTemperatureReading(t)
+
+		mockRepoSec := svc.RpSec.(*mocks.MockISectionRepo)
+		mockRepoSec.On("GetByID", mock.Anything, 1).Return(model.Section{ID: 1}, nil)
+		mockRepoSec.On("SyncCurrentTemperature", mock.Anything, 1).Return(nil)
+
+		mockRepo := svc.Rp.(*mocks.MockITemperatureReadingRepo)
+		mockRepo.On("GetLatestRecordedAt", mock.Anything, 1).Return(time.Time{}, nil)
+		mockRepo.On("BulkInsert", mock.Anything, 1, readings).Return(2, nil)
+
+		result, err := svc.Ingest(context.Background(), 1, readings)
+
+		assert.NoError(t, err)
+		assert.Equal(t, model.TemperatureIngestResult{SectionID: 1, Inserted: 2}, result)
+	})
+
+	t.Run("keep the readings when the excursions cannot be evaluated", func(t *testing.T) {
+		svc := setupTemperatureReading(t)
+
+		mockRepoSec := svc.RpSec.(*mocks.MockISectionRepo)
+		mockRepoSec.On("GetByID", mock.Anything, 1).Return(model.Section{ID: 1}, nil)
+		mockRepoSec.On("SyncCurrentTemperature", mock.Anything, 1).Return(nil)
+
+		mockRepo := svc.Rp.(*mocks.MockITemperatureReadingRepo)
+		mockRepo.On("GetLatestRecordedAt", mock.Anything, 1).Return(time.Time{}, nil)
+		mockRepo.On("BulkInsert", mock.Anything, 1, readings).Return(2, nil)
+
+		mockSvcExc := mocks.NewMockITemperatureExcursionService(t)
+		mockSvcExc.On("EvaluateSection", mock.Anything, 1, readings).Return(errors.New("unmapped error")).Once()
+		svc.SvcExc = mockSvcExc
+
+		result, err := svc.Ingest(context.Background(), 1, readings)
+
+		assert.NoError(t, err)
+		assert.Equal(t, model.TemperatureIngestResult{SectionID: 1, Inserted: 2}, result)
+	})
+
+	t.Run("evaluate only the readings not older than the ones already stored", func(t *testing.T) {
+		svc := setupTemperatureReading(t)
+
+		mockRepoSec := svc.RpSec.(*mocks.MockISectionRepo)
+		mockRepoSec.On("GetByID", mock.Anything, 1).Return(model.Section{ID: 1}, nil)
+		mockRepoSec.On("SyncCurrentTemperature", mock.Anything, 1).Return(nil)
+
+		mockRepo := svc.Rp.(*mocks.MockITemperatureReadingRepo)
+		mockRepo.On("GetLatestRecordedAt", mock.Anything, 1).Return(readings[1].RecordedAt.Add(-time.Minute), nil)
+		mockRepo.On("BulkInsert", mock.Anything, 1, readings).Return(2, nil)
+
+		mockSvcExc := mocks.NewMockITemperatureExcursionService(t)
+		mockSvcExc.On("EvaluateSection", mock.Anything, 1, readings[1:]).Return(nil).Once()
+		svc.SvcExc = mockSvcExc
+
+		result, err := svc.Ingest(context.Background(), 1, readings)
+
+		assert.NoError(t, err)
+		assert.Equal(t, model.TemperatureIngestResult{SectionID: 1, Inserted: 2}, result)
+	})
+
+	t.Run("return error when the latest reading cannot be read", func(t *testing.T) {
+		svc := setupTemperatureReading(t)
+
+		mockRepoSec := svc.RpSec.(*mocks.MockISectionRepo)
+		mockRepoSec.On("GetByID", mock.Anything, 1).Return(model.Section{ID: 1}, nil)
+
+		mockRepo := svc.Rp.(*mocks.MockITemperatureReadingRepo)
+		mockRepo.On("GetLatestRecordedAt", mock.Anything, 1).Return(time.Time{}, errors.New("unmapped error"))
+
+		result, err := svc.Ingest(context.Background(), 1, readings)
+
+		assert.Error(t, err)
+		assert.Equal(t, model.TemperatureIngestResult{}, result)
+		mockRepo.AssertNotCalled(t, "BulkInsert", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("return not found for an unknown section", func(t *testing.T) {
+		svc := setupTemperatureReading(t)
+
+		expectedErr := customerror.HandleError("section", customerror.ErrorNotFound, "")
+
+		mockRepoSec := svc.RpSec.(*mocks.MockISectionRepo)
+		mockRepoSec.On("GetByID", mock.Anything, 99).Return(model.Section{}, expectedErr)
+
+		_, err := svc.Ingest(context.Background(), 99, readings)
+
+		assert.Equal(t, expectedErr, err)
+		svc.Rp.(*mocks.MockITemperatureReadingRepo).AssertNotCalled(t, "BulkInsert", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("return unprocessable entity for invalid readings", func(t *testing.T) {
+		svc := setupTemperatureReading(t)
+
+		_, err := svc.Ingest(context.Background(), 1, []model.TemperatureReading{
+			{Temperature: -18.0},
+			{Temperature: 150, RecordedAt: time.Now().Add(time.Hour)},
+		})
+
+		assert.Equal(t, customerror.HandleError("temperature reading", customerror.ErrorInvalid,
+			"validation errors: readings[0]: RecordedAt is required; readings[1]: RecordedAt cannot be in the future; readings[1]: Temperature must be between -100 and 100"), err)
+	})
+
+	t.Run("return unprocessable entity when no reading is sent", func(t *testing.T) {
+		svc := setupTemperatureReading(t)
+
+		_, err := svc.Ingest(context.Background(), 1, nil)
+
+		assert.Equal(t, customerror.HandleError("temperature reading", customerror.ErrorInvalid, "validation errors: at least one reading is required"), err)
+	})
+}
+
+func TestTemperatureReadingService_GetAggregates(t *testing.T) {
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("return the aggregates of the period", func(t *testing.T) {
+		svc := setupTemperatureReading(t)
+
+		query := model.TemperatureQuery{SectionID: 1, From: from, To: from.Add(24 * time.Hour), Interval: time.Hour}
+		expected := []model.TemperatureAggregate{{PeriodStart: from, MinTemperature: -19, MaxTemperature: -17, AvgTemperature: -18, ReadingsCount: 3}}
+
+		mockRepoSec := svc.RpSec.(*mocks.MockISectionRepo)
+		mockRepoSec.On("GetByID", mock.Anything, 1).Return(model.Section{ID: 1}, nil)
+
+		mockRepo := svc.Rp.(*mocks.MockITemperatureReadingRepo)
+		mockRepo.On("GetAggregates", mock.Anything, query).Return(expected, nil)
+
+		aggregates, err := svc.GetAggregates(context.Background(), query)
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, aggregates)
+	})
+
+	t.Run("return unprocessable entity for an inverted period", func(t *testing.T) {
+		svc := setupTemperatureReading(t)
+
+		aggregates, err := svc.GetAggregates(context.Background(), model.TemperatureQuery{SectionID: 1, From: from, To: from.Add(-time.Hour), Interval: time.Second})
+
+		assert.Equal(t, customerror.HandleError("temperature reading", customerror.ErrorInvalid, "validation errors: from must be before to; interval cannot be shorter than 1m0s"), err)
+		assert.Nil(t, aggregates)
+	})
+}
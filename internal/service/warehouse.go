@@ -1,26 +1,33 @@
 package service
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"net/http"
 
 	"github.com/maxwelbm/alkemy-g7.git/internal/model"
 	"github.com/maxwelbm/alkemy-g7.git/internal/repository/interfaces"
+	"github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
 	"github.com/maxwelbm/alkemy-g7.git/pkg/logger"
 )
 
+const defaultNearestWareHousesLimit = 10
+
 type WareHouseDefault struct {
-	Rp  interfaces.IWarehouseRepo
-	log logger.Logger
+	Rp         interfaces.IWarehouseRepo
+	RpLocality interfaces.ILocalityRepo
+	log        logger.Logger
 }
 
-func NewWareHouseService(rp interfaces.IWarehouseRepo, log logger.Logger) *WareHouseDefault {
-	return &WareHouseDefault{Rp: rp, log: log}
+func NewWareHouseService(rp interfaces.IWarehouseRepo, rpLocality interfaces.ILocalityRepo, log logger.Logger) *WareHouseDefault {
+	return &WareHouseDefault{Rp: rp, RpLocality: rpLocality, log: log}
 }
 
-func (wp *WareHouseDefault) DeleteByIDWareHouse(id int) error {
+func (wp *WareHouseDefault) DeleteByIDWareHouse(ctx context.Context, id int) error {
 	wp.log.Log("WareHouseService", "INFO", "initializing DeleteByIDWareHouse function")
 
-	_, err := wp.GetByIDWareHouse(id)
+	_, err := wp.GetByIDWareHouse(ctx, id)
 
 	if err != nil {
 		wp.log.Log("WareHouseService", "ERROR", fmt.Sprintf("Error: %v", err))
@@ -28,7 +35,7 @@ func (wp *WareHouseDefault) DeleteByIDWareHouse(id int) error {
 		return err
 	}
 
-	err = wp.Rp.DeleteByIDWareHouse(id)
+	err = wp.Rp.DeleteByIDWareHouse(ctx, id)
 
 	if err != nil {
 		wp.log.Log("WareHouseService", "ERROR", fmt.Sprintf("Error: %v", err))
@@ -40,28 +47,28 @@ func (wp *WareHouseDefault) DeleteByIDWareHouse(id int) error {
 	return nil
 }
 
-func (wp *WareHouseDefault) GetAllWareHouse() (w []model.WareHouse, err error) {
+func (wp *WareHouseDefault) GetAllWareHouse(ctx context.Context, params model.ListParams) (w []model.WareHouse, total int, err error) {
 	wp.log.Log("WareHouseService", "INFO", "initializing GetAllWareHouse function")
 
-	w, err = wp.Rp.GetAllWareHouse()
+	w, total, err = wp.Rp.GetAllWareHouse(ctx, params)
 
 	wp.log.Log("WareHouseService", "INFO", "GetAllWareHouse completed successfully")
 	return
 }
 
-func (wp *WareHouseDefault) GetByIDWareHouse(id int) (w model.WareHouse, err error) {
+func (wp *WareHouseDefault) GetByIDWareHouse(ctx context.Context, id int) (w model.WareHouse, err error) {
 	wp.log.Log("WareHouseService", "INFO", "initializing GetByIDWareHouse function")
 
-	w, err = wp.Rp.GetByIDWareHouse(id)
+	w, err = wp.Rp.GetByIDWareHouse(ctx, id)
 
 	wp.log.Log("WareHouseService", "INFO", "GetByIDWareHouse completed successfully")
 	return
 }
 
-func (wp *WareHouseDefault) PostWareHouse(warehouse model.WareHouse) (w model.WareHouse, err error) {
+func (wp *WareHouseDefault) PostWareHouse(ctx context.Context, warehouse model.WareHouse) (w model.WareHouse, err error) {
 	wp.log.Log("WareHouseService", "INFO", "initializing PostWareHouse function")
 
-	id, err := wp.Rp.PostWareHouse(warehouse)
+	id, err := wp.Rp.PostWareHouse(ctx, warehouse)
 
 	if err != nil {
 		wp.log.Log("WareHouseService", "ERROR", fmt.Sprintf("Error: %v", err))
@@ -69,16 +76,16 @@ func (wp *WareHouseDefault) PostWareHouse(warehouse model.WareHouse) (w model.Wa
 		return w, err
 	}
 
-	w, err = wp.GetByIDWareHouse(int(id))
+	w, err = wp.GetByIDWareHouse(ctx, int(id))
 
 	wp.log.Log("WareHouseService", "INFO", "PostWareHouse completed successfully")
 	return w, err
 }
 
-func (wp *WareHouseDefault) UpdateWareHouse(id int, warehouse model.WareHouse) (w model.WareHouse, err error) {
+func (wp *WareHouseDefault) UpdateWareHouse(ctx context.Context, id int, warehouse model.WareHouse) (w model.WareHouse, err error) {
 	wp.log.Log("WareHouseService", "INFO", "initializing UpdateWareHouse function")
 
-	warehouseExisting, err := wp.GetByIDWareHouse(id)
+	warehouseExisting, err := wp.GetByIDWareHouse(ctx, id)
 
 	if err != nil {
 		wp.log.Log("WareHouseService", "ERROR", fmt.Sprintf("Error: %v", err))
@@ -106,15 +113,123 @@ func (wp *WareHouseDefault) UpdateWareHouse(id int, warehouse model.WareHouse) (
 		warehouseExisting.MinimunTemperature = warehouse.MinimunTemperature
 	}
 
-	err = wp.Rp.UpdateWareHouse(id, warehouseExisting)
+	if warehouse.Latitude != nil || warehouse.Longitude != nil {
+		warehouseExisting.Latitude, warehouseExisting.Longitude = warehouse.Latitude, warehouse.Longitude
+	}
+
+	err = wp.Rp.UpdateWareHouse(ctx, id, warehouseExisting)
 
 	if err != nil {
 		wp.log.Log("WareHouseService", "ERROR", fmt.Sprintf("Error: %v", err))
 		return w, err
 	}
 
-	w, err = wp.GetByIDWareHouse(id)
+	w, err = wp.GetByIDWareHouse(ctx, id)
 
 	wp.log.Log("WareHouseService", "INFO", "UpdateWareHouse completed successfully")
 	return w, err
 }
+
+// GetNearestWareHouses ranks warehouses by distance to the given coordinates or, when
+// params.LocalityID is set, to the coordinates of that locality. Quantity defaults to a
+// single unit once a product type is asked for, and Limit to defaultNearestWareHousesLimit.
+func (wp *WareHouseDefault) GetNearestWareHouses(ctx context.Context, params model.NearestWareHouseParams) (w []model.NearestWareHouse, err error) {
+	wp.log.Log("WareHouseService", "INFO", "initializing GetNearestWareHouses function")
+
+	if params.LocalityID > 0 {
+		locality, err := wp.RpLocality.GetByID(ctx, params.LocalityID)
+		if err != nil {
+			wp.log.Log("WareHouseService", "ERROR", fmt.Sprintf("Error: %v", err))
+
+			if errors.Is(err, customerror.ErrLocalityNotFound) {
+				err = customerror.NewWareHouseError(customerror.ErrNotFound.Error(), "locality", http.StatusNotFound)
+			}
+
+			return nil, err
+		}
+
+		if locality.Latitude == nil || locality.Longitude == nil {
+			err = customerror.NewWareHouseError("has no coordinates", "locality", http.StatusUnprocessableEntity)
+			wp.log.Log("WareHouseService", "ERROR", fmt.Sprintf("Error: %v", err))
+
+			return nil, err
+		}
+
+		params.Latitude, params.Longitude = locality.Latitude, locality.Longitude
+	}
+
+	err = model.ValidateCoordinates(params.Latitude, params.Longitude)
+	if err == nil && params.Latitude == nil {
+		err = model.ErrCoordinatesIncomplete
+	}
+
+	if err != nil {
+		err = customerror.NewWareHouseError(err.Error(), "coordinates", http.StatusBadRequest)
+		wp.log.Log("WareHouseService", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return nil, err
+	}
+
+	if params.Quantity == 0 && params.ProductTypeID > 0 {
+		params.Quantity = 1
+	}
+
+	if params.Limit == 0 {
+		params.Limit = defaultNearestWareHousesLimit
+	}
+
+	w, err = wp.Rp.GetNearestWareHouses(ctx, params)
+
+	wp.log.Log("WareHouseService", "INFO", "GetNearestWareHouses completed successfully")
+
+	return
+}
+
+// GetInventoryWareHouse summarizes the stock held in the warehouse per product, with the section breakdown.
+func (wp *WareHouseDefault) GetInventoryWareHouse(ctx context.Context, id int) (inventory model.WareHouseInventory, err error) {
+	wp.log.Log("WareHouseService", "INFO", "initializing GetInventoryWareHouse function")
+
+	warehouse, err := wp.GetByIDWareHouse(ctx, id)
+	if err != nil {
+		wp.log.Log("WareHouseService", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	rows, err := wp.Rp.GetInventoryWareHouse(ctx, id)
+	if err != nil {
+		wp.log.Log("WareHouseService", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return
+	}
+
+	inventory = model.GroupWareHouseInventory(warehouse, rows)
+
+	wp.log.Log("WareHouseService", "INFO", "GetInventoryWareHouse completed successfully")
+
+	return
+}
+
+// GetCapacityReportWareHouse reports the used and total section capacity of every warehouse, or only of
+// the given one when id is greater than zero.
+func (wp *WareHouseDefault) GetCapacityReportWareHouse(ctx context.Context, id int) (report []model.WareHouseCapacity, err error) {
+	wp.log.Log("WareHouseService", "INFO", "initializing GetCapacityReportWareHouse function")
+
+	report, err = wp.Rp.GetCapacityReportWareHouse(ctx, id)
+	if err != nil {
+		wp.log.Log("WareHouseService", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return nil, err
+	}
+
+	if id > 0 && len(report) == 0 {
+		err = customerror.NewWareHouseError(customerror.ErrNotFound.Error(), "warehouse", http.StatusNotFound)
+		wp.log.Log("WareHouseService", "ERROR", fmt.Sprintf("Error: %v", err))
+
+		return nil, err
+	}
+
+	wp.log.Log("WareHouseService", "INFO", "GetCapacityReportWareHouse completed successfully")
+
+	return
+}
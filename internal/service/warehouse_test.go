@@ -1,18 +1,23 @@
 package service_test
 
 import (
+	"context"
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/maxwelbm/alkemy-g7.git/internal/mocks"
 	"github.com/maxwelbm/alkemy-g7.git/internal/model"
 	"github.com/maxwelbm/alkemy-g7.git/internal/service"
+	"github.com/maxwelbm/alkemy-g7.git/pkg/customerror"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func setupWarehouse(t *testing.T) *service.WareHouseDefault {
 	mockRepo := mocks.NewMockIWarehouseRepo(t)
 
-	return service.NewWareHouseService(mockRepo, logMock)
+	return service.NewWareHouseService(mockRepo, mocks.NewMockILocalityRepo(t), logMock)
 }
 
 func TestGetAllWarehouse(t *testing.T) {
@@ -40,11 +45,12 @@ func TestGetAllWarehouse(t *testing.T) {
 		}
 
 		mockRepo := svc.Rp.(*mocks.MockIWarehouseRepo)
-		mockRepo.On("GetAllWareHouse").Return(expectWarehouse, nil)
+		mockRepo.On("GetAllWareHouse", mock.Anything, mock.Anything).Return(expectWarehouse, 2, nil)
 
-		w, err := svc.GetAllWareHouse()
+		w, total, err := svc.GetAllWareHouse(context.Background(), model.ListParams{})
 
 		assert.Equal(t, expectWarehouse, w)
+		assert.Equal(t, 2, total)
 		assert.Nil(t, err)
 		mockRepo.AssertExpectations(t)
 	})
@@ -53,9 +59,9 @@ func TestGetAllWarehouse(t *testing.T) {
 		svc := setupWarehouse(t)
 
 		mockRepo := svc.Rp.(*mocks.MockIWarehouseRepo)
-		mockRepo.On("GetAllWareHouse").Return([]model.WareHouse{}, assert.AnError)
+		mockRepo.On("GetAllWareHouse", mock.Anything, mock.Anything).Return([]model.WareHouse{}, 0, assert.AnError)
 
-		w, err := svc.GetAllWareHouse()
+		w, _, err := svc.GetAllWareHouse(context.Background(), model.ListParams{})
 
 		assert.Empty(t, w)
 		assert.NotNil(t, err)
@@ -77,9 +83,9 @@ func TestGetByIdWareHouse(t *testing.T) {
 		}
 
 		mockRepo := svc.Rp.(*mocks.MockIWarehouseRepo)
-		mockRepo.On("GetByIDWareHouse", 1).Return(expectWarehouse, nil)
+		mockRepo.On("GetByIDWareHouse", mock.Anything, 1).Return(expectWarehouse, nil)
 
-		w, err := svc.GetByIDWareHouse(1)
+		w, err := svc.GetByIDWareHouse(context.Background(), 1)
 
 		assert.Equal(t, expectWarehouse, w)
 		assert.Nil(t, err)
@@ -90,9 +96,9 @@ func TestGetByIdWareHouse(t *testing.T) {
 		svc := setupWarehouse(t)
 
 		mockRepo := svc.Rp.(*mocks.MockIWarehouseRepo)
-		mockRepo.On("GetByIDWareHouse", 1).Return(model.WareHouse{}, assert.AnError)
+		mockRepo.On("GetByIDWareHouse", mock.Anything, 1).Return(model.WareHouse{}, assert.AnError)
 
-		w, err := svc.GetByIDWareHouse(1)
+		w, err := svc.GetByIDWareHouse(context.Background(), 1)
 
 		assert.Empty(t, w)
 		assert.NotNil(t, err)
@@ -114,10 +120,10 @@ func TestDeleteByIdWareHouse(t *testing.T) {
 		}
 
 		mockRepo := svc.Rp.(*mocks.MockIWarehouseRepo)
-		mockRepo.On("GetByIDWareHouse", 1).Return(expectedWarehouse, nil)
-		mockRepo.On("DeleteByIDWareHouse", 1).Return(nil)
+		mockRepo.On("GetByIDWareHouse", mock.Anything, 1).Return(expectedWarehouse, nil)
+		mockRepo.On("DeleteByIDWareHouse", mock.Anything, 1).Return(nil)
 
-		err := svc.DeleteByIDWareHouse(1)
+		err := svc.DeleteByIDWareHouse(context.Background(), 1)
 
 		assert.Nil(t, err)
 		mockRepo.AssertExpectations(t)
@@ -136,10 +142,10 @@ func TestDeleteByIdWareHouse(t *testing.T) {
 		}
 
 		mockRepo := svc.Rp.(*mocks.MockIWarehouseRepo)
-		mockRepo.On("GetByIDWareHouse", 1).Return(expectedWarehouse, nil)
-		mockRepo.On("DeleteByIDWareHouse", 1).Return(assert.AnError)
+		mockRepo.On("GetByIDWareHouse", mock.Anything, 1).Return(expectedWarehouse, nil)
+		mockRepo.On("DeleteByIDWareHouse", mock.Anything, 1).Return(assert.AnError)
 
-		err := svc.DeleteByIDWareHouse(1)
+		err := svc.DeleteByIDWareHouse(context.Background(), 1)
 
 		assert.NotNil(t, err)
 		mockRepo.AssertExpectations(t)
@@ -149,9 +155,9 @@ func TestDeleteByIdWareHouse(t *testing.T) {
 		svc := setupWarehouse(t)
 
 		mockRepo := svc.Rp.(*mocks.MockIWarehouseRepo)
-		mockRepo.On("GetByIDWareHouse", 1).Return(model.WareHouse{}, assert.AnError)
+		mockRepo.On("GetByIDWareHouse", mock.Anything, 1).Return(model.WareHouse{}, assert.AnError)
 
-		err := svc.DeleteByIDWareHouse(1)
+		err := svc.DeleteByIDWareHouse(context.Background(), 1)
 
 		assert.NotNil(t, err)
 		mockRepo.AssertExpectations(t)
@@ -171,11 +177,11 @@ func TestPostWareHouse(t *testing.T) {
 			MinimunTemperature: 1,
 			Address:            "test",
 		}
-		mockRepo.On("PostWareHouse", warehouse).Return(int64(1), nil)
+		mockRepo.On("PostWareHouse", mock.Anything, warehouse).Return(int64(1), nil)
 
-		mockRepo.On("GetByIDWareHouse", 1).Return(warehouse, nil)
+		mockRepo.On("GetByIDWareHouse", mock.Anything, 1).Return(warehouse, nil)
 
-		w, err := svc.PostWareHouse(warehouse)
+		w, err := svc.PostWareHouse(context.Background(), warehouse)
 
 		assert.Nil(t, err)
 		assert.Equal(t, warehouse, w)
@@ -195,9 +201,9 @@ func TestPostWareHouse(t *testing.T) {
 			Address:            "test",
 		}
 
-		mockRepo.On("PostWareHouse", warehouse).Return(int64(0), assert.AnError)
+		mockRepo.On("PostWareHouse", mock.Anything, warehouse).Return(int64(0), assert.AnError)
 
-		w, err := svc.PostWareHouse(warehouse)
+		w, err := svc.PostWareHouse(context.Background(), warehouse)
 
 		assert.NotNil(t, err)
 		assert.Equal(t, model.WareHouse{}, w)
@@ -218,10 +224,10 @@ func TestUpdateWarehouse(t *testing.T) {
 			MinimunTemperature: 1,
 			Address:            "test",
 		}
-		mockRepo.On("GetByIDWareHouse", 1).Return(warehouse, nil)
-		mockRepo.On("UpdateWareHouse", 1, warehouse).Return(nil)
+		mockRepo.On("GetByIDWareHouse", mock.Anything, 1).Return(warehouse, nil)
+		mockRepo.On("UpdateWareHouse", mock.Anything, 1, warehouse).Return(nil)
 
-		w, err := svc.UpdateWareHouse(1, warehouse)
+		w, err := svc.UpdateWareHouse(context.Background(), 1, warehouse)
 
 		assert.Nil(t, err)
 		assert.Equal(t, warehouse, w)
@@ -240,13 +246,182 @@ func TestUpdateWarehouse(t *testing.T) {
 			MinimunTemperature: 1,
 			Address:            "test",
 		}
-		mockRepo.On("GetByIDWareHouse", 3).Return(model.WareHouse{}, nil)
-		mockRepo.On("UpdateWareHouse", 3, warehouse).Return(assert.AnError)
+		mockRepo.On("GetByIDWareHouse", mock.Anything, 3).Return(model.WareHouse{}, nil)
+		mockRepo.On("UpdateWareHouse", mock.Anything, 3, warehouse).Return(assert.AnError)
 
-		w, err := svc.UpdateWareHouse(3, warehouse)
+		w, err := svc.UpdateWareHouse(context.Background(), 3, warehouse)
 
 		assert.NotNil(t, err)
 		assert.Equal(t, model.WareHouse{}, w)
 		mockRepo.AssertExpectations(t)
 	})
 }
+
+func TestGetNearestWareHouses(t *testing.T) {
+	latitude, longitude := -31.4201, -64.1888
+
+	t.Run("GetNearestFromLocality", func(t *testing.T) {
+		svc := setupWarehouse(t)
+
+		mockRepo := svc.Rp.(*mocks.MockIWarehouseRepo)
+		mockLocality := svc.RpLocality.(*mocks.MockILocalityRepo)
+
+		expected := []model.NearestWareHouse{{WareHouse: model.WareHouse{ID: 1, WareHouseCode: "test"}, DistanceKm: 12.5, AvailableCapacity: 30}}
+		mockLocality.On("GetByID", mock.Anything, 4).Return(model.Locality{ID: 4, Latitude: &latitude, Longitude: &longitude}, nil)
+		mockRepo.On("GetNearestWareHouses", mock.Anything, model.NearestWareHouseParams{
+			LocalityID: 4, Latitude: &latitude, Longitude: &longitude, ProductTypeID: 2, Quantity: 1, Limit: 10,
+		}).Return(expected, nil)
+
+		w, err := svc.GetNearestWareHouses(context.Background(), model.NearestWareHouseParams{LocalityID: 4, ProductTypeID: 2})
+
+		assert.Nil(t, err)
+		assert.Equal(t, expected, w)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("GetNearestFromCoordinates", func(t *testing.T) {
+		svc := setupWarehouse(t)
+
+		mockRepo := svc.Rp.(*mocks.MockIWarehouseRepo)
+
+		params := model.NearestWareHouseParams{Latitude: &latitude, Longitude: &longitude, Limit: 3}
+		mockRepo.On("GetNearestWareHouses", mock.Anything, params).Return([]model.NearestWareHouse{}, nil)
+
+		w, err := svc.GetNearestWareHouses(context.Background(), params)
+
+		assert.Nil(t, err)
+		assert.Empty(t, w)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("LocalityNotFound", func(t *testing.T) {
+		svc := setupWarehouse(t)
+
+		mockLocality := svc.RpLocality.(*mocks.MockILocalityRepo)
+		mockLocality.On("GetByID", mock.Anything, 99).Return(model.Locality{}, customerror.ErrLocalityNotFound)
+
+		w, err := svc.GetNearestWareHouses(context.Background(), model.NearestWareHouseParams{LocalityID: 99})
+
+		assert.Equal(t, customerror.NewWareHouseError(customerror.ErrNotFound.Error(), "locality", http.StatusNotFound), err)
+		assert.Nil(t, w)
+	})
+
+	t.Run("LocalityWithoutCoordinates", func(t *testing.T) {
+		svc := setupWarehouse(t)
+
+		mockLocality := svc.RpLocality.(*mocks.MockILocalityRepo)
+		mockLocality.On("GetByID", mock.Anything, 5).Return(model.Locality{ID: 5, Locality: "Santa Ana"}, nil)
+
+		w, err := svc.GetNearestWareHouses(context.Background(), model.NearestWareHouseParams{LocalityID: 5})
+
+		assert.Equal(t, customerror.NewWareHouseError("has no coordinates", "locality", http.StatusUnprocessableEntity), err)
+		assert.Nil(t, w)
+	})
+
+	t.Run("LatitudeOutOfRange", func(t *testing.T) {
+		svc := setupWarehouse(t)
+		invalid := 91.0
+
+		w, err := svc.GetNearestWareHouses(context.Background(), model.NearestWareHouseParams{Latitude: &invalid, Longitude: &longitude})
+
+		assert.Equal(t, customerror.NewWareHouseError(model.ErrLatitudeOutOfRange.Error(), "coordinates", http.StatusBadRequest), err)
+		assert.Nil(t, w)
+	})
+}
+
+func TestGetInventoryWareHouse(t *testing.T) {
+	t.Run("GetInventory", func(t *testing.T) {
+		svc := setupWarehouse(t)
+
+		mockRepo := svc.Rp.(*mocks.MockIWarehouseRepo)
+
+		earlier := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+		later := time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC)
+
+		mockRepo.On("GetByIDWareHouse", mock.Anything, 1).Return(model.WareHouse{ID: 1, WareHouseCode: "WH001"}, nil)
+		mockRepo.On("GetInventoryWareHouse", mock.Anything, 1).Return([]model.WareHouseInventoryRow{
+			{ProductID: 1, ProductCode: "P001", Description: "Milk", SectionID: 3, SectionNumber: "S3", Quantity: 120, BatchesCount: 2, NearestDueDate: &later},
+			{ProductID: 1, ProductCode: "P001", Description: "Milk", SectionID: 4, SectionNumber: "S4", Quantity: 30, BatchesCount: 1, NearestDueDate: &earlier},
+			{ProductID: 2, ProductCode: "P002", Description: "Cheese", SectionID: 3, SectionNumber: "S3", Quantity: 40, BatchesCount: 1},
+		}, nil)
+
+		inventory, err := svc.GetInventoryWareHouse(context.Background(), 1)
+
+		expected := model.WareHouseInventory{
+			WarehouseID:   1,
+			WarehouseCode: "WH001",
+			TotalQuantity: 190,
+			Products: []model.WareHouseInventoryProduct{
+				{
+					ProductID: 1, ProductCode: "P001", Description: "Milk", TotalQuantity: 150, BatchesCount: 3, NearestDueDate: &earlier,
+					Sections: []model.WareHouseInventorySection{
+						{SectionID: 3, SectionNumber: "S3", Quantity: 120, BatchesCount: 2, NearestDueDate: &later},
+						{SectionID: 4, SectionNumber: "S4", Quantity: 30, BatchesCount: 1, NearestDueDate: &earlier},
+					},
+				},
+				{
+					ProductID: 2, ProductCode: "P002", Description: "Cheese", TotalQuantity: 40, BatchesCount: 1,
+					Sections: []model.WareHouseInventorySection{{SectionID: 3, SectionNumber: "S3", Quantity: 40, BatchesCount: 1}},
+				},
+			},
+		}
+
+		assert.Nil(t, err)
+		assert.Equal(t, expected, inventory)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("GetInventoryEmptyWarehouse", func(t *testing.T) {
+		svc := setupWarehouse(t)
+
+		mockRepo := svc.Rp.(*mocks.MockIWarehouseRepo)
+		mockRepo.On("GetByIDWareHouse", mock.Anything, 2).Return(model.WareHouse{ID: 2, WareHouseCode: "WH002"}, nil)
+		mockRepo.On("GetInventoryWareHouse", mock.Anything, 2).Return(nil, nil)
+
+		inventory, err := svc.GetInventoryWareHouse(context.Background(), 2)
+
+		assert.Nil(t, err)
+		assert.Equal(t, model.WareHouseInventory{WarehouseID: 2, WarehouseCode: "WH002", Products: []model.WareHouseInventoryProduct{}}, inventory)
+	})
+
+	t.Run("GetInventoryWarehouseNotFound", func(t *testing.T) {
+		svc := setupWarehouse(t)
+
+		mockRepo := svc.Rp.(*mocks.MockIWarehouseRepo)
+		notFound := customerror.NewWareHouseError(customerror.ErrNotFound.Error(), "warehouse", http.StatusNotFound)
+		mockRepo.On("GetByIDWareHouse", mock.Anything, 99).Return(model.WareHouse{}, notFound)
+
+		inventory, err := svc.GetInventoryWareHouse(context.Background(), 99)
+
+		assert.Equal(t, notFound, err)
+		assert.Empty(t, inventory)
+	})
+}
+
+func TestGetCapacityReportWareHouse(t *testing.T) {
+	t.Run("GetCapacityReport", func(t *testing.T) {
+		svc := setupWarehouse(t)
+
+		mockRepo := svc.Rp.(*mocks.MockIWarehouseRepo)
+
+		expected := []model.WareHouseCapacity{{WarehouseID: 1, WarehouseCode: "WH001", MinimumCapacity: 100, SectionsCount: 2, TotalCapacity: 200, UsedCapacity: 50, AvailableCapacity: 150, Utilization: 0.25}}
+		mockRepo.On("GetCapacityReportWareHouse", mock.Anything, 0).Return(expected, nil)
+
+		report, err := svc.GetCapacityReportWareHouse(context.Background(), 0)
+
+		assert.Nil(t, err)
+		assert.Equal(t, expected, report)
+	})
+
+	t.Run("GetCapacityReportWarehouseNotFound", func(t *testing.T) {
+		svc := setupWarehouse(t)
+
+		mockRepo := svc.Rp.(*mocks.MockIWarehouseRepo)
+		mockRepo.On("GetCapacityReportWareHouse", mock.Anything, 99).Return(nil, nil)
+
+		report, err := svc.GetCapacityReportWareHouse(context.Background(), 99)
+
+		assert.Equal(t, customerror.NewWareHouseError(customerror.ErrNotFound.Error(), "warehouse", http.StatusNotFound), err)
+		assert.Nil(t, report)
+	})
+}
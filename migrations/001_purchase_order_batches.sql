@@ -0,0 +1,18 @@
+-- Records the product batches each purchase order consumed, allocated by earliest due date
+-- (FEFO), and the quantity ordered. Orders created before keep a quantity of 1 and no
+-- allocated batches.
+
+USE `meli_fresh`;
+
+ALTER TABLE `purchase_orders`
+    ADD COLUMN `quantity` int NOT NULL DEFAULT 1 AFTER `product_record_id`;
+
+CREATE TABLE `purchase_order_batches`(
+    `id` int(11) NOT NULL AUTO_INCREMENT,
+    `purchase_order_id` int(11) NOT NULL,
+    `product_batch_id` int(11) NOT NULL,
+    `quantity` int NOT NULL,
+    PRIMARY KEY(`id`),
+    FOREIGN KEY (`purchase_order_id`) REFERENCES `purchase_orders`(`id`),
+    FOREIGN KEY (`product_batch_id`) REFERENCES `product_batches`(`id`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8;
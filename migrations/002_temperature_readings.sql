@@ -0,0 +1,15 @@
+-- Stores the temperature readings sent by the sensors of each section, queried by section
+-- and time range for the aggregated history.
+
+USE `meli_fresh`;
+
+CREATE TABLE `temperature_readings`(
+    `id` int(11) NOT NULL AUTO_INCREMENT,
+    `section_id` int(11) NOT NULL,
+    `sensor_id` varchar(100),
+    `temperature` DECIMAL(19,2) NOT NULL,
+    `recorded_at` DATETIME(6) NOT NULL,
+    PRIMARY KEY(`id`),
+    INDEX `idx_temperature_readings_section_recorded_at` (`section_id`, `recorded_at`),
+    FOREIGN KEY (`section_id`) REFERENCES `sections`(`id`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8;
@@ -0,0 +1,18 @@
+-- Stores the temperature excursions of the product batches, open while `ended_at` is NULL.
+
+USE `meli_fresh`;
+
+CREATE TABLE `temperature_excursions`(
+    `id` int(11) NOT NULL AUTO_INCREMENT,
+    `section_id` int(11) NOT NULL,
+    `product_batch_id` int(11) NOT NULL,
+    `kind` varchar(20) NOT NULL,
+    `limit_temperature` DECIMAL(19,2) NOT NULL,
+    `peak_temperature` DECIMAL(19,2) NOT NULL,
+    `started_at` DATETIME(6) NOT NULL,
+    `ended_at` DATETIME(6) NULL,
+    PRIMARY KEY(`id`),
+    INDEX `idx_temperature_excursions_section_ended_at` (`section_id`, `ended_at`),
+    FOREIGN KEY (`section_id`) REFERENCES `sections`(`id`),
+    FOREIGN KEY (`product_batch_id`) REFERENCES `product_batches`(`id`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8;
@@ -0,0 +1,36 @@
+-- Adds the shipments that take purchase orders to a carrier, with the history of their
+-- tracking events.
+
+USE `meli_fresh`;
+
+CREATE TABLE `shipments`(
+    `id` int(11) NOT NULL AUTO_INCREMENT,
+    `tracking_code` varchar(50) NOT NULL,
+    `carrier_id` int(11) NOT NULL,
+    `status` varchar(20) NOT NULL,
+    `created_at` DATETIME(6) NOT NULL,
+    `updated_at` DATETIME(6) NOT NULL,
+    PRIMARY KEY(`id`),
+    UNIQUE(`tracking_code`),
+    FOREIGN KEY (`carrier_id`) REFERENCES `carriers`(`id`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8;
+
+CREATE TABLE `shipment_purchase_orders`(
+    `shipment_id` int(11) NOT NULL,
+    `purchase_order_id` int(11) NOT NULL,
+    PRIMARY KEY(`shipment_id`, `purchase_order_id`),
+    INDEX `idx_shipment_purchase_orders_purchase_order` (`purchase_order_id`),
+    FOREIGN KEY (`shipment_id`) REFERENCES `shipments`(`id`),
+    FOREIGN KEY (`purchase_order_id`) REFERENCES `purchase_orders`(`id`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8;
+
+CREATE TABLE `shipment_events`(
+    `id` int(11) NOT NULL AUTO_INCREMENT,
+    `shipment_id` int(11) NOT NULL,
+    `status` varchar(20) NOT NULL,
+    `description` varchar(255) NOT NULL DEFAULT '',
+    `occurred_at` DATETIME(6) NOT NULL,
+    PRIMARY KEY(`id`),
+    INDEX `idx_shipment_events_shipment_occurred_at` (`shipment_id`, `occurred_at`),
+    FOREIGN KEY (`shipment_id`) REFERENCES `shipments`(`id`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8;
@@ -0,0 +1,8 @@
+-- Adds the status lifecycle of purchase orders and indexes the listing by buyer and date.
+-- Existing orders start as pending.
+
+USE `meli_fresh`;
+
+ALTER TABLE `purchase_orders`
+    ADD COLUMN `status` varchar(20) NOT NULL DEFAULT 'pending' AFTER `quantity`,
+    ADD INDEX `idx_purchase_orders_buyer_date` (`buyer_id`, `order_date`);
@@ -0,0 +1,32 @@
+-- Adds the priced line items of purchase orders and their total. Existing orders become a
+-- single line of their product record at its sale price.
+
+USE `meli_fresh`;
+
+ALTER TABLE `purchase_orders`
+    ADD COLUMN `total_amount` DECIMAL(19,2) NOT NULL DEFAULT 0 AFTER `status`;
+
+CREATE TABLE `purchase_order_lines`(
+    `id` int(11) NOT NULL AUTO_INCREMENT,
+    `purchase_order_id` int(11) NOT NULL,
+    `line_number` int NOT NULL,
+    `product_id` int(11) NOT NULL,
+    `product_record_id` int(11) NOT NULL,
+    `quantity` int NOT NULL,
+    `unit_price` DECIMAL(19,2) NOT NULL,
+    PRIMARY KEY(`id`),
+    UNIQUE(`purchase_order_id`, `line_number`),
+    FOREIGN KEY (`purchase_order_id`) REFERENCES `purchase_orders`(`id`),
+    FOREIGN KEY (`product_id`) REFERENCES `products`(`id`),
+    FOREIGN KEY (`product_record_id`) REFERENCES `product_records`(`id`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8;
+
+INSERT INTO `purchase_order_lines` (`purchase_order_id`, `line_number`, `product_id`, `product_record_id`, `quantity`, `unit_price`)
+SELECT po.id, 1, pr.product_id, pr.id, po.quantity, COALESCE(pr.sale_price, 0)
+FROM `purchase_orders` po
+    INNER JOIN `product_records` pr ON pr.id = po.product_record_id
+WHERE pr.product_id IS NOT NULL;
+
+UPDATE `purchase_orders` po
+    INNER JOIN `purchase_order_lines` l ON l.purchase_order_id = po.id
+SET po.total_amount = l.quantity * l.unit_price;
@@ -0,0 +1,21 @@
+-- Lets an inbound order receive several product batches and indexes the listing by warehouse
+-- and date. The batch of each existing order becomes its only received batch.
+
+USE `meli_fresh`;
+
+ALTER TABLE `inbound_orders`
+    ADD INDEX `idx_inbound_orders_warehouse_date` (`warehouse_id`, `order_date`);
+
+CREATE TABLE `inbound_order_batches`(
+    `id` int(11) NOT NULL AUTO_INCREMENT,
+    `inbound_order_id` int(11) NOT NULL,
+    `product_batch_id` int(11) NOT NULL,
+    PRIMARY KEY(`id`),
+    UNIQUE(`product_batch_id`),
+    FOREIGN KEY (`inbound_order_id`) REFERENCES `inbound_orders`(`id`),
+    FOREIGN KEY (`product_batch_id`) REFERENCES `product_batches`(`id`)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8;
+
+-- a batch received by more than one order is kept on the first one
+INSERT IGNORE INTO `inbound_order_batches` (`inbound_order_id`, `product_batch_id`)
+SELECT `id`, `product_batch_id` FROM `inbound_orders` WHERE `product_batch_id` IS NOT NULL ORDER BY `id`;
@@ -0,0 +1,9 @@
+-- Indexes the columns the reports filter and group by.
+
+USE `meli_fresh`;
+
+ALTER TABLE `locality`
+    ADD INDEX `idx_locality_name` (`locality_name`);
+
+ALTER TABLE `product_records`
+    ADD INDEX `idx_product_records_product_updated` (`product_id`, `last_update_date`);
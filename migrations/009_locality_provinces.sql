@@ -0,0 +1,49 @@
+-- Links every locality to a province of the countries/provinces hierarchy, replacing the
+-- free-text `province_name` and `country_name` columns of `locality`.
+-- Names are trimmed and compared with the tables' case-insensitive collation, so
+-- 'Brazil', ' brazil' and 'BRAZIL' end up as a single country. Blank names are filed
+-- under 'Unknown' so every locality can be linked.
+
+USE `meli_fresh`;
+
+-- merge the countries and provinces that are already duplicated, keeping the lowest id
+UPDATE `countries` SET `country_name` = TRIM(`country_name`);
+
+UPDATE `provinces` p
+    INNER JOIN `countries` c ON c.id = p.id_country_fk
+    INNER JOIN (SELECT MIN(`id`) AS `id`, `country_name` FROM `countries` GROUP BY `country_name`) k ON k.country_name = c.country_name
+SET p.id_country_fk = k.id;
+
+DELETE c FROM `countries` c INNER JOIN `countries` k ON k.country_name = c.country_name AND k.id < c.id;
+
+ALTER TABLE `countries` ADD UNIQUE (`country_name`);
+
+UPDATE `provinces` SET `province_name` = TRIM(`province_name`);
+
+DELETE p FROM `provinces` p
+    INNER JOIN `provinces` k ON k.province_name = p.province_name AND k.id_country_fk <=> p.id_country_fk AND k.id < p.id;
+
+ALTER TABLE `provinces` ADD UNIQUE (`province_name`, `id_country_fk`);
+
+-- create the countries and provinces only known from the locality names
+INSERT IGNORE INTO `countries` (`country_name`)
+SELECT DISTINCT COALESCE(NULLIF(TRIM(l.country_name), ''), 'Unknown') FROM `locality` l;
+
+INSERT IGNORE INTO `provinces` (`province_name`, `id_country_fk`)
+SELECT DISTINCT COALESCE(NULLIF(TRIM(l.province_name), ''), 'Unknown'), c.id
+FROM `locality` l
+    INNER JOIN `countries` c ON c.country_name = COALESCE(NULLIF(TRIM(l.country_name), ''), 'Unknown');
+
+-- link the localities and drop the free-text columns
+ALTER TABLE `locality` ADD COLUMN `province_id` int(11) AFTER `locality_name`;
+
+UPDATE `locality` l
+    INNER JOIN `countries` c ON c.country_name = COALESCE(NULLIF(TRIM(l.country_name), ''), 'Unknown')
+    INNER JOIN `provinces` p ON p.id_country_fk = c.id AND p.province_name = COALESCE(NULLIF(TRIM(l.province_name), ''), 'Unknown')
+SET l.province_id = p.id;
+
+ALTER TABLE `locality`
+    MODIFY `province_id` int(11) NOT NULL,
+    ADD FOREIGN KEY (`province_id`) REFERENCES `provinces`(`id`),
+    DROP COLUMN `province_name`,
+    DROP COLUMN `country_name`;
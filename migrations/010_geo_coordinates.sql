@@ -0,0 +1,13 @@
+-- Adds optional coordinates to localities and warehouses, used to rank warehouses by
+-- great-circle distance. Existing rows keep NULL coordinates and are left out of the ranking
+-- until they are filled in.
+
+USE `meli_fresh`;
+
+ALTER TABLE `locality`
+    ADD COLUMN `latitude` decimal(9,6) NULL AFTER `province_id`,
+    ADD COLUMN `longitude` decimal(9,6) NULL AFTER `latitude`;
+
+ALTER TABLE `warehouses`
+    ADD COLUMN `latitude` decimal(9,6) NULL AFTER `minimum_temperature`,
+    ADD COLUMN `longitude` decimal(9,6) NULL AFTER `latitude`;
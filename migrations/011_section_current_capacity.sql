@@ -0,0 +1,9 @@
+-- Recomputes `sections.current_capacity` from the stock of the product batches stored in each
+-- section. The column is kept up to date by the batches and purchase orders from now on, but
+-- rows written before that may hold any value, and the capacity checks start from it.
+
+USE `meli_fresh`;
+
+UPDATE `sections` s
+    LEFT JOIN (SELECT `section_id`, SUM(`current_quantity`) AS `quantity` FROM `product_batches` GROUP BY `section_id`) pb ON pb.section_id = s.id
+SET s.current_capacity = COALESCE(pb.quantity, 0);
@@ -24,4 +24,7 @@ var (
 	ErrNotFoundErrorSection = errors.New("there's no section with this id")
 	ErrConflictSection      = errors.New("section with this id already exists")
 	ErrUnknow               = errors.New("unknow server error")
+	ErrInsufficientStock    = errors.New("insufficient stock to fulfill the order")
+	ErrCapacityExceeded     = errors.New("maximum capacity exceeded")
+	ErrCapacityBelowCurrent = errors.New("maximum capacity cannot be lower than current capacity")
 )
@@ -24,4 +24,7 @@ var (
 	InboundErrInvalidWarehouse      = NewInboundOrderErr("invalid warehouse id", http.StatusConflict)
 	InboundErrInvalidProductBatch   = NewInboundOrderErr("invalid product batch id", http.StatusConflict)
 	InboundErrDuplicatedOrderNumber = NewInboundOrderErr("order number already exists", http.StatusConflict)
+	InboundErrNotFound              = NewInboundOrderErr("inbound order not found", http.StatusNotFound)
+	InboundErrEmployeeWarehouse     = NewInboundOrderErr("employee does not work in the inbound order warehouse", http.StatusConflict)
+	InboundErrSectionWarehouse      = NewInboundOrderErr("product batch section does not belong to the inbound order warehouse", http.StatusConflict)
 )
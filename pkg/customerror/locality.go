@@ -22,6 +22,8 @@ func (e *LocalityError) Error() string {
 
 var (
 	ErrLocalityNotFound          = NewLocalityErr("locality not found", http.StatusNotFound)
+	ErrLocalityProvinceNotFound  = NewLocalityErr("province not found", http.StatusNotFound)
+	ErrLocalityDependencies      = NewLocalityErr("locality cannot be deleted while sellers or carriers reference it", http.StatusConflict)
 	ErrMissingLocalityID         = NewLocalityErr("missing 'id' parameter in the request", http.StatusBadRequest)
 	ErrInvalidLocalityJSONFormat = NewLocalityErr("invalid JSON format in the request body", http.StatusBadRequest)
 	ErrInvalidLocalityPathParam  = NewLocalityErr("invalid value for request path parameter", http.StatusUnprocessableEntity)